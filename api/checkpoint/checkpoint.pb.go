@@ -0,0 +1,1270 @@
+// Code generated by protoc-gen-gogo.
+// source: checkpoint.proto
+// DO NOT EDIT!
+
+/*
+	Package checkpoint is a generated protocol buffer package.
+
+	It is generated from these files:
+		checkpoint.proto
+
+	It has these top-level messages:
+		CheckpointRequest
+		CheckpointResponse
+		RestoreRequest
+*/
+package checkpoint
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import containerd_v1 "github.com/docker/containerd/api/execution"
+import containerd_v1_images "github.com/docker/containerd/api/images"
+
+import strings "strings"
+import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
+import sort "sort"
+import strconv "strconv"
+import reflect "reflect"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type CheckpointRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is the name of the checkpoint in the image store.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Exit stops the container once it is checkpointed, it is left running
+	// otherwise.
+	Exit           bool     `protobuf:"varint,3,opt,name=exit,proto3" json:"exit,omitempty"`
+	TCPEstablished bool     `protobuf:"varint,4,opt,name=tcp_established,json=tcpEstablished,proto3" json:"tcp_established,omitempty"`
+	UnixSockets    bool     `protobuf:"varint,5,opt,name=unix_sockets,json=unixSockets,proto3" json:"unix_sockets,omitempty"`
+	Shell          bool     `protobuf:"varint,6,opt,name=shell,proto3" json:"shell,omitempty"`
+	EmptyNS        []string `protobuf:"bytes,7,rep,name=empty_ns,json=emptyNs" json:"empty_ns,omitempty"`
+	// Rootfs includes the rootfs of the container in the checkpoint, as
+	// the changes of the snapshot it is mounted from when its spec names
+	// one, the whole tree otherwise. It requires exit, the rootfs being
+	// archived once the container is dumped.
+	Rootfs bool `protobuf:"varint,8,opt,name=rootfs,proto3" json:"rootfs,omitempty"`
+	// PreDumps is the number of pre-dumps of the memory of the container
+	// taken while it runs before it is checkpointed, each incremental to
+	// the previous one, for the container to be frozen for the memory
+	// changed since the last one only. The pre-dumps stop once one dumped
+	// fewer bytes than PreDumpThreshold. They are kept in the checkpoint
+	// as its parents.
+	PreDumps         uint32 `protobuf:"varint,9,opt,name=pre_dumps,json=preDumps,proto3" json:"pre_dumps,omitempty"`
+	PreDumpThreshold int64  `protobuf:"varint,10,opt,name=pre_dump_threshold,json=preDumpThreshold,proto3" json:"pre_dump_threshold,omitempty"`
+	// Snapshot is the key of the active snapshot the rootfs of the container
+	// is mounted from, its changes being included in the checkpoint as its
+	// writable layer, applied over the rootfs of the restored container,
+	// instead of the whole rootfs. It requires exit, like rootfs.
+	Snapshot string `protobuf:"bytes,11,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (m *CheckpointRequest) Reset()                    { *m = CheckpointRequest{} }
+func (*CheckpointRequest) ProtoMessage()               {}
+func (*CheckpointRequest) Descriptor() ([]byte, []int) { return fileDescriptorCheckpoint, []int{0} }
+
+type CheckpointResponse struct {
+	Image *containerd_v1_images.Image `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+}
+
+func (m *CheckpointResponse) Reset()                    { *m = CheckpointResponse{} }
+func (*CheckpointResponse) ProtoMessage()               {}
+func (*CheckpointResponse) Descriptor() ([]byte, []int) { return fileDescriptorCheckpoint, []int{1} }
+
+type RestoreRequest struct {
+	// Name is the name of the checkpoint in the image store.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Container is the container restored, under any ID and with its own io
+	// and network, the latter requiring the checkpoint to be taken with an
+	// empty network namespace. The rootfs of the checkpoint, if any, is
+	// extracted into the rootfs of its bundle and its writable layer applied
+	// over it, the bundle being created from the spec and rootfs of the
+	// checkpoint when it does not exist.
+	Container *containerd_v1.CreateContainerRequest `protobuf:"bytes,2,opt,name=container" json:"container,omitempty"`
+}
+
+func (m *RestoreRequest) Reset()                    { *m = RestoreRequest{} }
+func (*RestoreRequest) ProtoMessage()               {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptorCheckpoint, []int{2} }
+
+func init() {
+	proto.RegisterType((*CheckpointRequest)(nil), "containerd.v1.checkpoint.CheckpointRequest")
+	proto.RegisterType((*CheckpointResponse)(nil), "containerd.v1.checkpoint.CheckpointResponse")
+	proto.RegisterType((*RestoreRequest)(nil), "containerd.v1.checkpoint.RestoreRequest")
+}
+func (this *CheckpointRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&checkpoint.CheckpointRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Exit: "+fmt.Sprintf("%#v", this.Exit)+",\n")
+	s = append(s, "TCPEstablished: "+fmt.Sprintf("%#v", this.TCPEstablished)+",\n")
+	s = append(s, "UnixSockets: "+fmt.Sprintf("%#v", this.UnixSockets)+",\n")
+	s = append(s, "Shell: "+fmt.Sprintf("%#v", this.Shell)+",\n")
+	s = append(s, "EmptyNS: "+fmt.Sprintf("%#v", this.EmptyNS)+",\n")
+	s = append(s, "Rootfs: "+fmt.Sprintf("%#v", this.Rootfs)+",\n")
+	s = append(s, "PreDumps: "+fmt.Sprintf("%#v", this.PreDumps)+",\n")
+	s = append(s, "PreDumpThreshold: "+fmt.Sprintf("%#v", this.PreDumpThreshold)+",\n")
+	s = append(s, "Snapshot: "+fmt.Sprintf("%#v", this.Snapshot)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckpointResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&checkpoint.CheckpointResponse{")
+	if this.Image != nil {
+		s = append(s, "Image: "+fmt.Sprintf("%#v", this.Image)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RestoreRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&checkpoint.RestoreRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.Container != nil {
+		s = append(s, "Container: "+fmt.Sprintf("%#v", this.Container)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCheckpoint(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringCheckpoint(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for CheckpointService service
+
+type CheckpointServiceClient interface {
+	// Checkpoint checkpoints a running container, naming the checkpoint in
+	// the image store.
+	Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error)
+	// Restore creates a container restored from a checkpoint of the image
+	// store, it is running once restored.
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*containerd_v1.CreateContainerResponse, error)
+}
+
+type checkpointServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCheckpointServiceClient(cc *grpc.ClientConn) CheckpointServiceClient {
+	return &checkpointServiceClient{cc}
+}
+
+func (c *checkpointServiceClient) Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.checkpoint.CheckpointService/Checkpoint", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkpointServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*containerd_v1.CreateContainerResponse, error) {
+	out := new(containerd_v1.CreateContainerResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.checkpoint.CheckpointService/Restore", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for CheckpointService service
+
+type CheckpointServiceServer interface {
+	// Checkpoint checkpoints a running container, naming the checkpoint in
+	// the image store.
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+	// Restore creates a container restored from a checkpoint of the image
+	// store, it is running once restored.
+	Restore(context.Context, *RestoreRequest) (*containerd_v1.CreateContainerResponse, error)
+}
+
+func RegisterCheckpointServiceServer(s *grpc.Server, srv CheckpointServiceServer) {
+	s.RegisterService(&_CheckpointService_serviceDesc, srv)
+}
+
+func _CheckpointService_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckpointServiceServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.checkpoint.CheckpointService/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckpointServiceServer).Checkpoint(ctx, req.(*CheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckpointService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckpointServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.checkpoint.CheckpointService/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckpointServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CheckpointService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.v1.checkpoint.CheckpointService",
+	HandlerType: (*CheckpointServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Checkpoint",
+			Handler:    _CheckpointService_Checkpoint_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _CheckpointService_Restore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "checkpoint.proto",
+}
+
+func (m *CheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCheckpoint(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCheckpoint(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Exit {
+		dAtA[i] = 0x18
+		i++
+		if m.Exit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.TCPEstablished {
+		dAtA[i] = 0x20
+		i++
+		if m.TCPEstablished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.UnixSockets {
+		dAtA[i] = 0x28
+		i++
+		if m.UnixSockets {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Shell {
+		dAtA[i] = 0x30
+		i++
+		if m.Shell {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.EmptyNS) > 0 {
+		for _, s := range m.EmptyNS {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Rootfs {
+		dAtA[i] = 0x40
+		i++
+		if m.Rootfs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.PreDumps != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.PreDumps))
+	}
+	if m.PreDumpThreshold != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.PreDumpThreshold))
+	}
+	if len(m.Snapshot) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintCheckpoint(dAtA, i, uint64(len(m.Snapshot)))
+		i += copy(dAtA[i:], m.Snapshot)
+	}
+	return i, nil
+}
+
+func (m *CheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Image != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.Image.Size()))
+		n1, err := m.Image.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCheckpoint(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Container != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.Container.Size()))
+		n2, err := m.Container.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func encodeFixed64Checkpoint(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Checkpoint(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintCheckpoint(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *CheckpointRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	if m.Exit {
+		n += 2
+	}
+	if m.TCPEstablished {
+		n += 2
+	}
+	if m.UnixSockets {
+		n += 2
+	}
+	if m.Shell {
+		n += 2
+	}
+	if len(m.EmptyNS) > 0 {
+		for _, s := range m.EmptyNS {
+			l = len(s)
+			n += 1 + l + sovCheckpoint(uint64(l))
+		}
+	}
+	if m.Rootfs {
+		n += 2
+	}
+	if m.PreDumps != 0 {
+		n += 1 + sovCheckpoint(uint64(m.PreDumps))
+	}
+	if m.PreDumpThreshold != 0 {
+		n += 1 + sovCheckpoint(uint64(m.PreDumpThreshold))
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	return n
+}
+
+func (m *RestoreRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	return n
+}
+
+func sovCheckpoint(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCheckpoint(x uint64) (n int) {
+	return sovCheckpoint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CheckpointRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Exit:` + fmt.Sprintf("%v", this.Exit) + `,`,
+		`TCPEstablished:` + fmt.Sprintf("%v", this.TCPEstablished) + `,`,
+		`UnixSockets:` + fmt.Sprintf("%v", this.UnixSockets) + `,`,
+		`Shell:` + fmt.Sprintf("%v", this.Shell) + `,`,
+		`EmptyNS:` + fmt.Sprintf("%v", this.EmptyNS) + `,`,
+		`Rootfs:` + fmt.Sprintf("%v", this.Rootfs) + `,`,
+		`PreDumps:` + fmt.Sprintf("%v", this.PreDumps) + `,`,
+		`PreDumpThreshold:` + fmt.Sprintf("%v", this.PreDumpThreshold) + `,`,
+		`Snapshot:` + fmt.Sprintf("%v", this.Snapshot) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointResponse{`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "Image", "containerd_v1_images.Image", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RestoreRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestoreRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "CreateContainerRequest", "containerd_v1.CreateContainerRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCheckpoint(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exit = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPEstablished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TCPEstablished = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixSockets", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnixSockets = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shell = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyNS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmptyNS = append(m.EmptyNS, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rootfs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rootfs = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDumps", wireType)
+			}
+			m.PreDumps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreDumps |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDumpThreshold", wireType)
+			}
+			m.PreDumpThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreDumpThreshold |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Image == nil {
+				m.Image = &containerd_v1_images.Image{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &containerd_v1.CreateContainerRequest{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCheckpoint(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthCheckpoint
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowCheckpoint
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipCheckpoint(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthCheckpoint = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCheckpoint   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("checkpoint.proto", fileDescriptorCheckpoint) }
+
+var fileDescriptorCheckpoint = []byte{
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0xed, 0x26, 0xcd, 0xbf, 0xcd, 0xef, 0x17, 0xca, 0xaa, 0xaa, 0x56, 0x29, 0x72, 0x42, 0x24,
+	0x2a, 0x4b, 0x54, 0x8e, 0x1a, 0x38, 0xc1, 0x2d, 0x69, 0x85, 0x7a, 0xa9, 0xd0, 0xa6, 0x37, 0x0e,
+	0x91, 0x63, 0x0f, 0xf1, 0xaa, 0x89, 0x77, 0xf1, 0x6e, 0xaa, 0x70, 0xe3, 0xe3, 0xf5, 0xc8, 0x11,
+	0x2e, 0x11, 0xf1, 0x27, 0xe8, 0x47, 0x40, 0x5e, 0xdb, 0x71, 0x0a, 0xaa, 0xca, 0x69, 0x67, 0xde,
+	0x9b, 0x79, 0xf2, 0xcc, 0x1b, 0xe3, 0x03, 0x2f, 0x00, 0xef, 0x46, 0x0a, 0x1e, 0x6a, 0x47, 0x46,
+	0x42, 0x0b, 0x42, 0x3d, 0x11, 0x6a, 0x97, 0x87, 0x10, 0xf9, 0xce, 0xed, 0x99, 0x53, 0xf0, 0xed,
+	0xc3, 0x99, 0x98, 0x09, 0x53, 0xd4, 0x4f, 0xa2, 0xb4, 0xbe, 0xfd, 0x6e, 0xc6, 0x75, 0xb0, 0x9c,
+	0x3a, 0x9e, 0x58, 0xf4, 0x7d, 0xe1, 0xdd, 0x40, 0xd4, 0x2f, 0x14, 0xfa, 0xae, 0xe4, 0x7d, 0x58,
+	0x81, 0xb7, 0xd4, 0x5c, 0x84, 0x45, 0x94, 0xf5, 0xbe, 0x7d, 0xb2, 0x97, 0x2f, 0xdc, 0x19, 0xa8,
+	0xec, 0x49, 0xbb, 0x7a, 0xf7, 0x25, 0xfc, 0x7c, 0xb4, 0xfd, 0x2c, 0x06, 0x5f, 0x96, 0xa0, 0x34,
+	0x39, 0xc2, 0x25, 0xee, 0x53, 0xd4, 0x45, 0x76, 0x63, 0x58, 0x8d, 0xd7, 0x9d, 0xd2, 0xe5, 0x39,
+	0x2b, 0x71, 0x9f, 0x10, 0xbc, 0x1f, 0xba, 0x0b, 0xa0, 0xa5, 0x84, 0x61, 0x26, 0x4e, 0x30, 0x58,
+	0x71, 0x4d, 0xcb, 0x5d, 0x64, 0xd7, 0x99, 0x89, 0xc9, 0x7b, 0xfc, 0x4c, 0x7b, 0x72, 0x02, 0x4a,
+	0xbb, 0xd3, 0x39, 0x57, 0x01, 0xf8, 0x74, 0x3f, 0xa1, 0x87, 0x24, 0x5e, 0x77, 0x5a, 0xd7, 0xa3,
+	0x8f, 0x17, 0x05, 0xc3, 0x5a, 0xda, 0x93, 0x3b, 0x39, 0x79, 0x89, 0xff, 0x5b, 0x86, 0x7c, 0x35,
+	0x51, 0xc9, 0x10, 0x5a, 0xd1, 0x8a, 0x11, 0x6e, 0x26, 0xd8, 0x38, 0x85, 0xc8, 0x21, 0xae, 0xa8,
+	0x00, 0xe6, 0x73, 0x5a, 0x35, 0x5c, 0x9a, 0x90, 0x13, 0x5c, 0x87, 0x85, 0xd4, 0x5f, 0x27, 0xa1,
+	0xa2, 0xb5, 0x6e, 0xd9, 0x6e, 0x0c, 0x9b, 0xf1, 0xba, 0x53, 0xbb, 0x48, 0xb0, 0xab, 0x31, 0xab,
+	0x19, 0xf2, 0x4a, 0x91, 0x23, 0x5c, 0x8d, 0x84, 0xd0, 0x9f, 0x15, 0xad, 0x9b, 0xf6, 0x2c, 0x23,
+	0xc7, 0xb8, 0x21, 0x23, 0x98, 0xf8, 0xcb, 0x85, 0x54, 0xb4, 0xd1, 0x45, 0xf6, 0xff, 0xac, 0x2e,
+	0x23, 0x38, 0x4f, 0x72, 0x72, 0x8a, 0x49, 0x4e, 0x4e, 0x74, 0x10, 0x81, 0x0a, 0xc4, 0xdc, 0xa7,
+	0xb8, 0x8b, 0xec, 0x32, 0x3b, 0xc8, 0xaa, 0xae, 0x73, 0x9c, 0xb4, 0x71, 0x5d, 0x85, 0xae, 0x54,
+	0x81, 0xd0, 0xb4, 0x69, 0x96, 0xb5, 0xcd, 0x7b, 0x1f, 0x30, 0xd9, 0xdd, 0xb8, 0x92, 0x22, 0x54,
+	0x40, 0xce, 0x70, 0xc5, 0x18, 0x63, 0xb6, 0xde, 0x1c, 0x1c, 0x3b, 0x0f, 0x4f, 0x27, 0x33, 0xed,
+	0x32, 0x79, 0x58, 0x5a, 0xd9, 0xe3, 0xb8, 0xc5, 0x40, 0x69, 0x11, 0x41, 0xee, 0x5b, 0xee, 0x0f,
+	0xda, 0xf1, 0x67, 0x84, 0x1b, 0x5b, 0x29, 0x63, 0x5c, 0x73, 0xf0, 0xea, 0x0f, 0xf1, 0x51, 0x04,
+	0xae, 0x86, 0x51, 0x8e, 0x65, 0x6a, 0xac, 0xe8, 0x1b, 0xfc, 0x44, 0xbb, 0x67, 0x32, 0x86, 0xe8,
+	0x96, 0x7b, 0x40, 0x66, 0x18, 0x17, 0x20, 0x79, 0xed, 0x3c, 0x76, 0xed, 0xce, 0x5f, 0x17, 0xd6,
+	0x3e, 0xfd, 0xb7, 0xe2, 0x6c, 0x39, 0x9f, 0x70, 0x2d, 0x9b, 0x94, 0xd8, 0x8f, 0x37, 0x3e, 0x5c,
+	0x46, 0xfb, 0xe4, 0xa9, 0x29, 0x53, 0xf1, 0xe1, 0x8b, 0xbb, 0x8d, 0xb5, 0xf7, 0x63, 0x63, 0xed,
+	0xdd, 0x6f, 0x2c, 0xf4, 0x2d, 0xb6, 0xd0, 0x5d, 0x6c, 0xa1, 0xef, 0xb1, 0x85, 0x7e, 0xc5, 0x16,
+	0x9a, 0x56, 0xcd, 0x7f, 0xf2, 0xe6, 0xf7, 0x00, 0xc6, 0xc5, 0x43, 0xb8, 0xdd, 0x03, 0x00, 0x00,
+}
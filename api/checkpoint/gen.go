@@ -0,0 +1,3 @@
+package checkpoint
+
+//go:generate protoc -I.:../../vendor:../../vendor/github.com/gogo/protobuf:../../../../..:/usr/local/include --gogoctrd_out=plugins=grpc,import_path=github.com/docker/containerd/api/checkpoint,Mgogoproto/gogo.proto=github.com/gogo/protobuf/gogoproto,Mgoogle/protobuf/descriptor.proto=github.com/gogo/protobuf/protoc-gen-gogo/descriptor,Mgithub.com/docker/containerd/api/execution/execution.proto=github.com/docker/containerd/api/execution,Mgithub.com/docker/containerd/api/images/images.proto=github.com/docker/containerd/api/images:. checkpoint.proto
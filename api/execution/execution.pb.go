@@ -11,6 +11,11 @@
 	It has these top-level messages:
 		StartContainerRequest
 		CreateContainerRequest
+		HostEntry
+		PortMapping
+		SecretMount
+		IDMapping
+		RuntimeOptions
 		CreateContainerResponse
 		DeleteContainerRequest
 		ListContainersRequest
@@ -22,15 +27,51 @@
 		User
 		GetContainerRequest
 		GetContainerResponse
+		InspectContainerRequest
+		InspectContainerResponse
+		ProcessStdio
 		UpdateContainerRequest
+		CheckpointContainerRequest
 		PauseContainerRequest
 		ResumeContainerRequest
 		GetProcessRequest
 		GetProcessResponse
 		SignalProcessRequest
+		ResizeProcessRequest
 		DeleteProcessRequest
 		ListProcessesRequest
 		ListProcessesResponse
+		HostProcess
+		GetRuntimeLogsRequest
+		GetRuntimeLogsResponse
+		RuntimeLog
+		ListRuntimesRequest
+		ListRuntimesResponse
+		RuntimeInfo
+		RuntimeCapabilities
+		RuntimeFeatures
+		StatsContainerRequest
+		StatsContainerResponse
+		KillSandboxRequest
+		SandboxStatsRequest
+		SandboxStatsResponse
+		Sandbox
+		CreateSandboxRequest
+		CreateSandboxResponse
+		DeleteSandboxRequest
+		GetSandboxRequest
+		GetSandboxResponse
+		ListSandboxesRequest
+		ListSandboxesResponse
+		NetworkNamespace
+		CreateNetworkNamespaceRequest
+		CreateNetworkNamespaceResponse
+		DeleteNetworkNamespaceRequest
+		ListNetworkNamespacesRequest
+		ListNetworkNamespacesResponse
+		ListPortsRequest
+		ListPortsResponse
+		ForwardedPort
 */
 package execution
 
@@ -64,6 +105,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// NewPrivileges sets whether a process may gain privileges, such as through
+// setuid binaries.
+type NewPrivileges int32
+
+const (
+	// INHERIT_NEW_PRIVILEGES follows the no new privileges setting of the
+	// container's init process.
+	NewPrivileges_INHERIT_NEW_PRIVILEGES NewPrivileges = 0
+	NewPrivileges_DENY_NEW_PRIVILEGES    NewPrivileges = 1
+	NewPrivileges_ALLOW_NEW_PRIVILEGES   NewPrivileges = 2
+)
+
+var NewPrivileges_name = map[int32]string{
+	0: "INHERIT_NEW_PRIVILEGES",
+	1: "DENY_NEW_PRIVILEGES",
+	2: "ALLOW_NEW_PRIVILEGES",
+}
+var NewPrivileges_value = map[string]int32{
+	"INHERIT_NEW_PRIVILEGES": 0,
+	"DENY_NEW_PRIVILEGES":    1,
+	"ALLOW_NEW_PRIVILEGES":   2,
+}
+
+func (x NewPrivileges) String() string {
+	return proto.EnumName(NewPrivileges_name, int32(x))
+}
+func (NewPrivileges) EnumDescriptor() ([]byte, []int) { return fileDescriptorExecution, []int{0} }
+
 type Status int32
 
 const (
@@ -89,7 +158,7 @@ var Status_value = map[string]int32{
 func (x Status) String() string {
 	return proto.EnumName(Status_name, int32(x))
 }
-func (Status) EnumDescriptor() ([]byte, []int) { return fileDescriptorExecution, []int{0} }
+func (Status) EnumDescriptor() ([]byte, []int) { return fileDescriptorExecution, []int{1} }
 
 type StartContainerRequest struct {
 	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -100,18 +169,159 @@ func (*StartContainerRequest) ProtoMessage()               {}
 func (*StartContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{0} }
 
 type CreateContainerRequest struct {
-	ID         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BundlePath string `protobuf:"bytes,2,opt,name=bundle_path,json=bundlePath,proto3" json:"bundle_path,omitempty"`
-	Console    bool   `protobuf:"varint,3,opt,name=console,proto3" json:"console,omitempty"`
-	Stdin      string `protobuf:"bytes,4,opt,name=stdin,proto3" json:"stdin,omitempty"`
-	Stdout     string `protobuf:"bytes,5,opt,name=stdout,proto3" json:"stdout,omitempty"`
-	Stderr     string `protobuf:"bytes,6,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	ID             string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BundlePath     string          `protobuf:"bytes,2,opt,name=bundle_path,json=bundlePath,proto3" json:"bundle_path,omitempty"`
+	Console        bool            `protobuf:"varint,3,opt,name=console,proto3" json:"console,omitempty"`
+	Stdin          string          `protobuf:"bytes,4,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Stdout         string          `protobuf:"bytes,5,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr         string          `protobuf:"bytes,6,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	RuntimeOptions *RuntimeOptions `protobuf:"bytes,7,opt,name=runtime_options,json=runtimeOptions" json:"runtime_options,omitempty"`
+	// Runtime selects a runtime configured on the daemon, such as a
+	// sandboxed runtime. The default runtime is used when empty.
+	Runtime string `protobuf:"bytes,8,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// Sandbox adds the container to a sandbox, sharing the network
+	// namespace, cgroup parent and shim of its other containers. The
+	// container joins the namespaces of the sandbox's pause process when it
+	// was created with CreateSandbox, otherwise the sandbox is created with
+	// its first container.
+	Sandbox string `protobuf:"bytes,9,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	// PidsLimit limits the number of processes in the container when
+	// positive, -1 removes the limit set by the bundle.
+	PidsLimit int64 `protobuf:"varint,10,opt,name=pids_limit,json=pidsLimit,proto3" json:"pids_limit,omitempty"`
+	// CgroupParent places the container's cgroup under the parent, which
+	// is a slice with the systemd cgroup driver.
+	CgroupParent string `protobuf:"bytes,11,opt,name=cgroup_parent,json=cgroupParent,proto3" json:"cgroup_parent,omitempty"`
+	// SeccompProfile replaces the seccomp profile of the bundle: "default",
+	// "unconfined" or a profile as JSON. The bundle's profile is kept when
+	// empty.
+	SeccompProfile string `protobuf:"bytes,12,opt,name=seccomp_profile,json=seccompProfile,proto3" json:"seccomp_profile,omitempty"`
+	// ApparmorProfile replaces the AppArmor profile of the bundle's init
+	// process when set, "unconfined" leaves it unconfined.
+	ApparmorProfile string `protobuf:"bytes,13,opt,name=apparmor_profile,json=apparmorProfile,proto3" json:"apparmor_profile,omitempty"`
+	// NoSelinuxLabel leaves the container unlabeled when the host enforces
+	// SELinux. Containers are otherwise labeled with an MCS level of their
+	// own and their rootfs is relabeled, unless the bundle sets a label.
+	NoSelinuxLabel bool `protobuf:"varint,14,opt,name=no_selinux_label,json=noSelinuxLabel,proto3" json:"no_selinux_label,omitempty"`
+	// UIDMappings and GIDMappings run the container in a user namespace,
+	// replacing the mappings configured on the daemon. The files of the
+	// rootfs are handed to the host ids they map to.
+	UIDMappings []*IDMapping `protobuf:"bytes,15,rep,name=uid_mappings,json=uidMappings" json:"uid_mappings,omitempty"`
+	GIDMappings []*IDMapping `protobuf:"bytes,16,rep,name=gid_mappings,json=gidMappings" json:"gid_mappings,omitempty"`
+	// CapAdd and CapDrop adjust the capabilities of the bundle's init
+	// process, "ALL" drops all of them. Privileged grants all the
+	// capabilities before those dropped are removed.
+	CapAdd     []string `protobuf:"bytes,17,rep,name=cap_add,json=capAdd" json:"cap_add,omitempty"`
+	CapDrop    []string `protobuf:"bytes,18,rep,name=cap_drop,json=capDrop" json:"cap_drop,omitempty"`
+	Privileged bool     `protobuf:"varint,19,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	// MaskedPaths and ReadonlyPaths are masked and made read only in
+	// addition to those of the bundle. ReadonlyRootfs mounts the rootfs
+	// read only.
+	MaskedPaths    []string `protobuf:"bytes,20,rep,name=masked_paths,json=maskedPaths" json:"masked_paths,omitempty"`
+	ReadonlyPaths  []string `protobuf:"bytes,21,rep,name=readonly_paths,json=readonlyPaths" json:"readonly_paths,omitempty"`
+	ReadonlyRootfs bool     `protobuf:"varint,22,opt,name=readonly_rootfs,json=readonlyRootfs,proto3" json:"readonly_rootfs,omitempty"`
+	// Secrets are read from the secret backend of the daemon and mounted
+	// read only into the container, they are removed along with it.
+	Secrets []*SecretMount `protobuf:"bytes,23,rep,name=secrets" json:"secrets,omitempty"`
+	// Network is the name of a network namespace created through
+	// CreateNetworkNamespace, or the path of a network namespace, for the
+	// container to join.
+	Network string `protobuf:"bytes,24,opt,name=network,proto3" json:"network,omitempty"`
+	// Ports are forwarded from the host to the address of the container
+	// in the CNI network, until the container is deleted.
+	Ports []*PortMapping `protobuf:"bytes,25,rep,name=ports" json:"ports,omitempty"`
+	// Hostname, ExtraHosts and the DNS options generate the /etc/hostname,
+	// /etc/hosts and /etc/resolv.conf of the container, the files of its
+	// rootfs are used when they are all unset. The resolv.conf follows that
+	// of the host, the name servers, search domains and options set replace
+	// those of the host.
+	Hostname   string       `protobuf:"bytes,26,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	ExtraHosts []*HostEntry `protobuf:"bytes,27,rep,name=extra_hosts,json=extraHosts" json:"extra_hosts,omitempty"`
+	DNSServers []string     `protobuf:"bytes,28,rep,name=dns_servers,json=dnsServers" json:"dns_servers,omitempty"`
+	DNSSearch  []string     `protobuf:"bytes,29,rep,name=dns_search,json=dnsSearch" json:"dns_search,omitempty"`
+	DNSOptions []string     `protobuf:"bytes,30,rep,name=dns_options,json=dnsOptions" json:"dns_options,omitempty"`
+	// NetNSPath is the path of a network namespace created by the caller
+	// for the container to join, such as a namespace bind mounted under
+	// /var/run/netns. Its network is left to the caller: the namespace is
+	// not added to the CNI network of the daemon and ports cannot be
+	// forwarded to it. It excludes network.
+	NetNSPath string `protobuf:"bytes,31,opt,name=netns_path,json=netnsPath,proto3" json:"netns_path,omitempty"`
+	// CheckpointPath is the directory of a checkpoint of the container,
+	// the container is restored from it rather than created and runs once
+	// created.
+	CheckpointPath string `protobuf:"bytes,32,opt,name=checkpoint_path,json=checkpointPath,proto3" json:"checkpoint_path,omitempty"`
 }
 
 func (m *CreateContainerRequest) Reset()                    { *m = CreateContainerRequest{} }
 func (*CreateContainerRequest) ProtoMessage()               {}
 func (*CreateContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{1} }
 
+// HostEntry resolves names to an address in /etc/hosts.
+type HostEntry struct {
+	IP    string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Names []string `protobuf:"bytes,2,rep,name=names" json:"names,omitempty"`
+}
+
+func (m *HostEntry) Reset()                    { *m = HostEntry{} }
+func (*HostEntry) ProtoMessage()               {}
+func (*HostEntry) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{2} }
+
+// PortMapping forwards a port of the host to a port of a container.
+type PortMapping struct {
+	// Protocol is "tcp" or "udp", "tcp" when empty.
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// HostIP is the address of the host to listen on, all of them when
+	// empty.
+	HostIP        string `protobuf:"bytes,2,opt,name=host_ip,json=hostIp,proto3" json:"host_ip,omitempty"`
+	HostPort      uint32 `protobuf:"varint,3,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
+	ContainerPort uint32 `protobuf:"varint,4,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
+}
+
+func (m *PortMapping) Reset()                    { *m = PortMapping{} }
+func (*PortMapping) ProtoMessage()               {}
+func (*PortMapping) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{3} }
+
+// SecretMount mounts a secret of the daemon's backend into a container.
+type SecretMount struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Target is the path of the secret in the container.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Mode of the secret file, 0400 when unset.
+	Mode uint32 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (m *SecretMount) Reset()                    { *m = SecretMount{} }
+func (*SecretMount) ProtoMessage()               {}
+func (*SecretMount) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{4} }
+
+// IDMapping maps a range of ids of a container to ids of the host.
+type IDMapping struct {
+	ContainerID uint32 `protobuf:"varint,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	HostID      uint32 `protobuf:"varint,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	// Length is the number of ids mapped.
+	Length uint32 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *IDMapping) Reset()                    { *m = IDMapping{} }
+func (*IDMapping) ProtoMessage()               {}
+func (*IDMapping) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{5} }
+
+// RuntimeOptions carries runc specific settings for a single container. Unset
+// fields fall back to the daemon's defaults.
+type RuntimeOptions struct {
+	// SystemdCgroup selects the systemd cgroup driver.
+	SystemdCgroup bool `protobuf:"varint,1,opt,name=systemd_cgroup,json=systemdCgroup,proto3" json:"systemd_cgroup,omitempty"`
+	// Root is the runc state directory used for the container.
+	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// CriuPath is the criu binary used for checkpoint and restore.
+	CriuPath string `protobuf:"bytes,3,opt,name=criu_path,json=criuPath,proto3" json:"criu_path,omitempty"`
+	// Debug enables runc debug logging.
+	Debug bool `protobuf:"varint,4,opt,name=debug,proto3" json:"debug,omitempty"`
+}
+
+func (m *RuntimeOptions) Reset()                    { *m = RuntimeOptions{} }
+func (*RuntimeOptions) ProtoMessage()               {}
+func (*RuntimeOptions) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{6} }
+
 type CreateContainerResponse struct {
 	Container   *Container `protobuf:"bytes,1,opt,name=container" json:"container,omitempty"`
 	InitProcess *Process   `protobuf:"bytes,2,opt,name=initProcess" json:"initProcess,omitempty"`
@@ -119,15 +329,20 @@ type CreateContainerResponse struct {
 
 func (m *CreateContainerResponse) Reset()                    { *m = CreateContainerResponse{} }
 func (*CreateContainerResponse) ProtoMessage()               {}
-func (*CreateContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{2} }
+func (*CreateContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{7} }
 
 type DeleteContainerRequest struct {
 	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// force stops a container which is not stopped before deleting it, its
+	// init process is sent SIGTERM then SIGKILL once timeout is over.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// timeout in nanoseconds, 10 seconds when unset.
+	Timeout int64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
 func (m *DeleteContainerRequest) Reset()                    { *m = DeleteContainerRequest{} }
 func (*DeleteContainerRequest) ProtoMessage()               {}
-func (*DeleteContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{3} }
+func (*DeleteContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{8} }
 
 type ListContainersRequest struct {
 	Owner []string `protobuf:"bytes,1,rep,name=owner" json:"owner,omitempty"`
@@ -135,7 +350,7 @@ type ListContainersRequest struct {
 
 func (m *ListContainersRequest) Reset()                    { *m = ListContainersRequest{} }
 func (*ListContainersRequest) ProtoMessage()               {}
-func (*ListContainersRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{4} }
+func (*ListContainersRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{9} }
 
 type ListContainersResponse struct {
 	Containers []*Container `protobuf:"bytes,1,rep,name=containers" json:"containers,omitempty"`
@@ -143,7 +358,7 @@ type ListContainersResponse struct {
 
 func (m *ListContainersResponse) Reset()                    { *m = ListContainersResponse{} }
 func (*ListContainersResponse) ProtoMessage()               {}
-func (*ListContainersResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{5} }
+func (*ListContainersResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{10} }
 
 type StartProcessRequest struct {
 	ContainerID string   `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
@@ -152,11 +367,17 @@ type StartProcessRequest struct {
 	Stdin       string   `protobuf:"bytes,4,opt,name=stdin,proto3" json:"stdin,omitempty"`
 	Stdout      string   `protobuf:"bytes,5,opt,name=stdout,proto3" json:"stdout,omitempty"`
 	Stderr      string   `protobuf:"bytes,6,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// CapAdd, CapDrop and Privileged adjust the capabilities of the
+	// container's init process, which the process starts with.
+	CapAdd        []string      `protobuf:"bytes,7,rep,name=cap_add,json=capAdd" json:"cap_add,omitempty"`
+	CapDrop       []string      `protobuf:"bytes,8,rep,name=cap_drop,json=capDrop" json:"cap_drop,omitempty"`
+	Privileged    bool          `protobuf:"varint,9,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	NewPrivileges NewPrivileges `protobuf:"varint,10,opt,name=new_privileges,json=newPrivileges,proto3,enum=containerd.v1.NewPrivileges" json:"new_privileges,omitempty"`
 }
 
 func (m *StartProcessRequest) Reset()                    { *m = StartProcessRequest{} }
 func (*StartProcessRequest) ProtoMessage()               {}
-func (*StartProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{6} }
+func (*StartProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{11} }
 
 type StartProcessResponse struct {
 	Process *Process `protobuf:"bytes,1,opt,name=process" json:"process,omitempty"`
@@ -164,32 +385,43 @@ type StartProcessResponse struct {
 
 func (m *StartProcessResponse) Reset()                    { *m = StartProcessResponse{} }
 func (*StartProcessResponse) ProtoMessage()               {}
-func (*StartProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{7} }
+func (*StartProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{12} }
 
 type Container struct {
 	ID         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	BundlePath string `protobuf:"bytes,2,opt,name=bundle_path,json=bundlePath,proto3" json:"bundle_path,omitempty"`
 	Status     Status `protobuf:"varint,4,opt,name=status,proto3,enum=containerd.v1.Status" json:"status,omitempty"`
+	Sandbox    string `protobuf:"bytes,5,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	// IPs are the addresses assigned to the container by the CNI network
+	// of the daemon.
+	IPs []string `protobuf:"bytes,6,rep,name=ips" json:"ips,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{8} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{13} }
 
 type Process struct {
-	ID         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pid        int64    `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
-	Args       []string `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
-	Env        []string `protobuf:"bytes,4,rep,name=env" json:"env,omitempty"`
-	User       *User    `protobuf:"bytes,5,opt,name=user" json:"user,omitempty"`
-	Cwd        string   `protobuf:"bytes,6,opt,name=cwd,proto3" json:"cwd,omitempty"`
-	Terminal   bool     `protobuf:"varint,7,opt,name=terminal,proto3" json:"terminal,omitempty"`
-	ExitStatus uint32   `protobuf:"varint,8,opt,name=exit_status,json=exitStatus,proto3" json:"exit_status,omitempty"`
+	ID       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pid      int64    `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	Args     []string `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
+	Env      []string `protobuf:"bytes,4,rep,name=env" json:"env,omitempty"`
+	User     *User    `protobuf:"bytes,5,opt,name=user" json:"user,omitempty"`
+	Cwd      string   `protobuf:"bytes,6,opt,name=cwd,proto3" json:"cwd,omitempty"`
+	Terminal bool     `protobuf:"varint,7,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	// ExitStatus is set once the status of the process is stopped, when
+	// the executor reports it.
+	ExitStatus uint32 `protobuf:"varint,8,opt,name=exit_status,json=exitStatus,proto3" json:"exit_status,omitempty"`
+	// ApparmorProfile is the AppArmor profile of an exec process, it runs
+	// under the profile of the container when empty.
+	ApparmorProfile string `protobuf:"bytes,9,opt,name=apparmor_profile,json=apparmorProfile,proto3" json:"apparmor_profile,omitempty"`
+	// Status is the status of the process.
+	Status Status `protobuf:"varint,10,opt,name=status,proto3,enum=containerd.v1.Status" json:"status,omitempty"`
 }
 
 func (m *Process) Reset()                    { *m = Process{} }
 func (*Process) ProtoMessage()               {}
-func (*Process) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{9} }
+func (*Process) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{14} }
 
 type User struct {
 	Uid            uint32   `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
@@ -199,7 +431,7 @@ type User struct {
 
 func (m *User) Reset()                    { *m = User{} }
 func (*User) ProtoMessage()               {}
-func (*User) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{10} }
+func (*User) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{15} }
 
 type GetContainerRequest struct {
 	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -207,7 +439,7 @@ type GetContainerRequest struct {
 
 func (m *GetContainerRequest) Reset()                    { *m = GetContainerRequest{} }
 func (*GetContainerRequest) ProtoMessage()               {}
-func (*GetContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{11} }
+func (*GetContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{16} }
 
 type GetContainerResponse struct {
 	Container *Container `protobuf:"bytes,1,opt,name=container" json:"container,omitempty"`
@@ -215,16 +447,87 @@ type GetContainerResponse struct {
 
 func (m *GetContainerResponse) Reset()                    { *m = GetContainerResponse{} }
 func (*GetContainerResponse) ProtoMessage()               {}
-func (*GetContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{12} }
+func (*GetContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{17} }
+
+type InspectContainerRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *InspectContainerRequest) Reset()                    { *m = InspectContainerRequest{} }
+func (*InspectContainerRequest) ProtoMessage()               {}
+func (*InspectContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{18} }
+
+type InspectContainerResponse struct {
+	// spec is the json of the OCI spec the container runs with, including
+	// the changes made by the daemon to the spec of its bundle.
+	Spec  []byte          `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Stdio []*ProcessStdio `protobuf:"bytes,2,rep,name=stdio" json:"stdio,omitempty"`
+}
+
+func (m *InspectContainerResponse) Reset()                    { *m = InspectContainerResponse{} }
+func (*InspectContainerResponse) ProtoMessage()               {}
+func (*InspectContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{19} }
+
+type ProcessStdio struct {
+	ProcessID string `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	Stdin     string `protobuf:"bytes,2,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Stdout    string `protobuf:"bytes,3,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr    string `protobuf:"bytes,4,opt,name=stderr,proto3" json:"stderr,omitempty"`
+}
+
+func (m *ProcessStdio) Reset()                    { *m = ProcessStdio{} }
+func (*ProcessStdio) ProtoMessage()               {}
+func (*ProcessStdio) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{20} }
 
 type UpdateContainerRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	BundlePath  string `protobuf:"bytes,2,opt,name=bundle_path,json=bundlePath,proto3" json:"bundle_path,omitempty"`
+	// Resources is the JSON encoded OCI LinuxResources to apply to the
+	// container. On cgroup v2 hosts they are translated to the v2
+	// controllers.
+	Resources []byte `protobuf:"bytes,3,opt,name=resources,proto3" json:"resources,omitempty"`
 }
 
 func (m *UpdateContainerRequest) Reset()                    { *m = UpdateContainerRequest{} }
 func (*UpdateContainerRequest) ProtoMessage()               {}
-func (*UpdateContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{13} }
+func (*UpdateContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{21} }
+
+type CheckpointContainerRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Path is the directory the checkpoint is written to, it is created
+	// when missing.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Exit stops the container once it is checkpointed, it is left running
+	// otherwise.
+	Exit           bool `protobuf:"varint,3,opt,name=exit,proto3" json:"exit,omitempty"`
+	TCPEstablished bool `protobuf:"varint,4,opt,name=tcp_established,json=tcpEstablished,proto3" json:"tcp_established,omitempty"`
+	UnixSockets    bool `protobuf:"varint,5,opt,name=unix_sockets,json=unixSockets,proto3" json:"unix_sockets,omitempty"`
+	Shell          bool `protobuf:"varint,6,opt,name=shell,proto3" json:"shell,omitempty"`
+	// EmptyNS are the namespaces which are not restored with the
+	// container, such as network to join the network namespace of the
+	// restored container's bundle.
+	EmptyNS []string `protobuf:"bytes,7,rep,name=empty_ns,json=emptyNs" json:"empty_ns,omitempty"`
+	// PreDump dumps the memory of the container only, leaving it running,
+	// for a later checkpoint to only dump the memory changed since. A
+	// pre-dump cannot be restored.
+	PreDump bool `protobuf:"varint,8,opt,name=pre_dump,json=preDump,proto3" json:"pre_dump,omitempty"`
+	// ParentPath is the directory of the pre-dump the checkpoint is
+	// incremental to, relative to path.
+	ParentPath string `protobuf:"bytes,9,opt,name=parent_path,json=parentPath,proto3" json:"parent_path,omitempty"`
+	// PreDumps is the number of pre-dumps of the memory of the container
+	// taken while it runs before the checkpoint, each incremental to the
+	// previous one, for the container to be frozen for the memory changed
+	// since the last one only. They are taken in the "pre-dump" directory
+	// of path, each holding the previous one in its own.
+	PreDumps uint32 `protobuf:"varint,10,opt,name=pre_dumps,json=preDumps,proto3" json:"pre_dumps,omitempty"`
+	// PreDumpThreshold stops the pre-dumps once one dumped fewer bytes of
+	// memory, as the memory changed by the container no longer shrinks.
+	PreDumpThreshold int64 `protobuf:"varint,11,opt,name=pre_dump_threshold,json=preDumpThreshold,proto3" json:"pre_dump_threshold,omitempty"`
+}
+
+func (m *CheckpointContainerRequest) Reset()                    { *m = CheckpointContainerRequest{} }
+func (*CheckpointContainerRequest) ProtoMessage()               {}
+func (*CheckpointContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{22} }
 
 type PauseContainerRequest struct {
 	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -232,7 +535,7 @@ type PauseContainerRequest struct {
 
 func (m *PauseContainerRequest) Reset()                    { *m = PauseContainerRequest{} }
 func (*PauseContainerRequest) ProtoMessage()               {}
-func (*PauseContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{14} }
+func (*PauseContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{23} }
 
 type ResumeContainerRequest struct {
 	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -240,7 +543,7 @@ type ResumeContainerRequest struct {
 
 func (m *ResumeContainerRequest) Reset()                    { *m = ResumeContainerRequest{} }
 func (*ResumeContainerRequest) ProtoMessage()               {}
-func (*ResumeContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{15} }
+func (*ResumeContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{24} }
 
 type GetProcessRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
@@ -249,7 +552,7 @@ type GetProcessRequest struct {
 
 func (m *GetProcessRequest) Reset()                    { *m = GetProcessRequest{} }
 func (*GetProcessRequest) ProtoMessage()               {}
-func (*GetProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{16} }
+func (*GetProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{25} }
 
 type GetProcessResponse struct {
 	Process *Process `protobuf:"bytes,1,opt,name=process" json:"process,omitempty"`
@@ -257,17 +560,31 @@ type GetProcessResponse struct {
 
 func (m *GetProcessResponse) Reset()                    { *m = GetProcessResponse{} }
 func (*GetProcessResponse) ProtoMessage()               {}
-func (*GetProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{17} }
+func (*GetProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{26} }
 
 type SignalProcessRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	ProcessID   string `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
 	Signal      uint32 `protobuf:"varint,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	// all signals all the processes of the container, process_id is
+	// ignored.
+	All bool `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
 }
 
 func (m *SignalProcessRequest) Reset()                    { *m = SignalProcessRequest{} }
 func (*SignalProcessRequest) ProtoMessage()               {}
-func (*SignalProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{18} }
+func (*SignalProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{27} }
+
+type ResizeProcessRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ProcessID   string `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	Width       uint32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height      uint32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ResizeProcessRequest) Reset()                    { *m = ResizeProcessRequest{} }
+func (*ResizeProcessRequest) ProtoMessage()               {}
+func (*ResizeProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{28} }
 
 type DeleteProcessRequest struct {
 	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
@@ -276,7 +593,7 @@ type DeleteProcessRequest struct {
 
 func (m *DeleteProcessRequest) Reset()                    { *m = DeleteProcessRequest{} }
 func (*DeleteProcessRequest) ProtoMessage()               {}
-func (*DeleteProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{19} }
+func (*DeleteProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{29} }
 
 type ListProcessesRequest struct {
 	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -284,19 +601,325 @@ type ListProcessesRequest struct {
 
 func (m *ListProcessesRequest) Reset()                    { *m = ListProcessesRequest{} }
 func (*ListProcessesRequest) ProtoMessage()               {}
-func (*ListProcessesRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{20} }
+func (*ListProcessesRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{30} }
 
 type ListProcessesResponse struct {
 	Processes []*Process `protobuf:"bytes,1,rep,name=processes" json:"processes,omitempty"`
+	// HostProcesses are the processes of the cgroup of the container, those
+	// started by its processes included. They are listed when the runtime
+	// enumerates the processes of cgroups.
+	HostProcesses []*HostProcess `protobuf:"bytes,2,rep,name=host_processes,json=hostProcesses" json:"host_processes,omitempty"`
 }
 
 func (m *ListProcessesResponse) Reset()                    { *m = ListProcessesResponse{} }
 func (*ListProcessesResponse) ProtoMessage()               {}
-func (*ListProcessesResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{21} }
+func (*ListProcessesResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{31} }
+
+// HostProcess is a process of a container as seen from the host.
+type HostProcess struct {
+	Pid  int64    `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Args []string `protobuf:"bytes,2,rep,name=args" json:"args,omitempty"`
+	// ProcessID is the id of the process of the container with this pid,
+	// empty for the processes they started.
+	ProcessID string `protobuf:"bytes,3,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+}
+
+func (m *HostProcess) Reset()                    { *m = HostProcess{} }
+func (*HostProcess) ProtoMessage()               {}
+func (*HostProcess) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{32} }
+
+type GetRuntimeLogsRequest struct {
+	ContainerID string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// ProcessID defaults to the init process of the container when empty.
+	ProcessID string `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+}
+
+func (m *GetRuntimeLogsRequest) Reset()                    { *m = GetRuntimeLogsRequest{} }
+func (*GetRuntimeLogsRequest) ProtoMessage()               {}
+func (*GetRuntimeLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{33} }
+
+type GetRuntimeLogsResponse struct {
+	Logs []*RuntimeLog `protobuf:"bytes,1,rep,name=logs" json:"logs,omitempty"`
+}
+
+func (m *GetRuntimeLogsResponse) Reset()                    { *m = GetRuntimeLogsResponse{} }
+func (*GetRuntimeLogsResponse) ProtoMessage()               {}
+func (*GetRuntimeLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{34} }
+
+// RuntimeLog is an entry logged by the shim or the runtime while managing a
+// process.
+type RuntimeLog struct {
+	// Source is either "shim" or "runtime".
+	Source  string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Level   string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Timestamp is in nanoseconds since the unix epoch.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *RuntimeLog) Reset()                    { *m = RuntimeLog{} }
+func (*RuntimeLog) ProtoMessage()               {}
+func (*RuntimeLog) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{35} }
+
+type ListRuntimesRequest struct {
+}
+
+func (m *ListRuntimesRequest) Reset()                    { *m = ListRuntimesRequest{} }
+func (*ListRuntimesRequest) ProtoMessage()               {}
+func (*ListRuntimesRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{36} }
+
+type ListRuntimesResponse struct {
+	Runtimes []*RuntimeInfo `protobuf:"bytes,1,rep,name=runtimes" json:"runtimes,omitempty"`
+}
+
+func (m *ListRuntimesResponse) Reset()                    { *m = ListRuntimesResponse{} }
+func (*ListRuntimesResponse) ProtoMessage()               {}
+func (*ListRuntimesResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{37} }
+
+type RuntimeInfo struct {
+	Name         string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Default      bool                 `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
+	Capabilities *RuntimeCapabilities `protobuf:"bytes,3,opt,name=capabilities" json:"capabilities,omitempty"`
+	// Features is unset when the runtime does not report its features.
+	Features *RuntimeFeatures `protobuf:"bytes,4,opt,name=features" json:"features,omitempty"`
+}
+
+func (m *RuntimeInfo) Reset()                    { *m = RuntimeInfo{} }
+func (*RuntimeInfo) ProtoMessage()               {}
+func (*RuntimeInfo) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{38} }
+
+type RuntimeCapabilities struct {
+	Pause bool `protobuf:"varint,1,opt,name=pause,proto3" json:"pause,omitempty"`
+	Exec  bool `protobuf:"varint,2,opt,name=exec,proto3" json:"exec,omitempty"`
+	Stats bool `protobuf:"varint,3,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (m *RuntimeCapabilities) Reset()                    { *m = RuntimeCapabilities{} }
+func (*RuntimeCapabilities) ProtoMessage()               {}
+func (*RuntimeCapabilities) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{39} }
+
+// RuntimeFeatures are probed from the runtime and the host when the daemon
+// starts.
+type RuntimeFeatures struct {
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit  string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Spec is the version of the OCI runtime spec implemented.
+	Spec       string `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	Seccomp    bool   `protobuf:"varint,4,opt,name=seccomp,proto3" json:"seccomp,omitempty"`
+	Libseccomp string `protobuf:"bytes,5,opt,name=libseccomp,proto3" json:"libseccomp,omitempty"`
+	CgroupV2   bool   `protobuf:"varint,6,opt,name=cgroup_v2,json=cgroupV2,proto3" json:"cgroup_v2,omitempty"`
+	// Criu is the path of criu, empty when it is not installed.
+	Criu string `protobuf:"bytes,7,opt,name=criu,proto3" json:"criu,omitempty"`
+}
+
+func (m *RuntimeFeatures) Reset()                    { *m = RuntimeFeatures{} }
+func (*RuntimeFeatures) ProtoMessage()               {}
+func (*RuntimeFeatures) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{40} }
+
+type StatsContainerRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *StatsContainerRequest) Reset()                    { *m = StatsContainerRequest{} }
+func (*StatsContainerRequest) ProtoMessage()               {}
+func (*StatsContainerRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{41} }
+
+type StatsContainerResponse struct {
+	// Stats are the JSON encoded cgroup metrics of the container.
+	Stats []byte `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (m *StatsContainerResponse) Reset()                    { *m = StatsContainerResponse{} }
+func (*StatsContainerResponse) ProtoMessage()               {}
+func (*StatsContainerResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{42} }
+
+type KillSandboxRequest struct {
+	ID     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Signal uint32 `protobuf:"varint,2,opt,name=signal,proto3" json:"signal,omitempty"`
+}
+
+func (m *KillSandboxRequest) Reset()                    { *m = KillSandboxRequest{} }
+func (*KillSandboxRequest) ProtoMessage()               {}
+func (*KillSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{43} }
+
+type SandboxStatsRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *SandboxStatsRequest) Reset()                    { *m = SandboxStatsRequest{} }
+func (*SandboxStatsRequest) ProtoMessage()               {}
+func (*SandboxStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{44} }
+
+type SandboxStatsResponse struct {
+	// Stats are the JSON encoded cgroup metrics of the sandbox.
+	Stats []byte `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (m *SandboxStatsResponse) Reset()                    { *m = SandboxStatsResponse{} }
+func (*SandboxStatsResponse) ProtoMessage()               {}
+func (*SandboxStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{45} }
+
+type Sandbox struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Pid is the pid of the pause process.
+	Pid      int64  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	Hostname string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Running  bool   `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	// IPs are the addresses assigned to the sandbox by the CNI network of
+	// the daemon.
+	IPs []string `protobuf:"bytes,5,rep,name=ips" json:"ips,omitempty"`
+	// Containers are the ids of the containers of the sandbox.
+	Containers []string `protobuf:"bytes,6,rep,name=containers" json:"containers,omitempty"`
+}
+
+func (m *Sandbox) Reset()                    { *m = Sandbox{} }
+func (*Sandbox) ProtoMessage()               {}
+func (*Sandbox) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{46} }
+
+type CreateSandboxRequest struct {
+	ID       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+}
+
+func (m *CreateSandboxRequest) Reset()                    { *m = CreateSandboxRequest{} }
+func (*CreateSandboxRequest) ProtoMessage()               {}
+func (*CreateSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{47} }
+
+type CreateSandboxResponse struct {
+	Sandbox *Sandbox `protobuf:"bytes,1,opt,name=sandbox" json:"sandbox,omitempty"`
+}
+
+func (m *CreateSandboxResponse) Reset()                    { *m = CreateSandboxResponse{} }
+func (*CreateSandboxResponse) ProtoMessage()               {}
+func (*CreateSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{48} }
+
+type DeleteSandboxRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *DeleteSandboxRequest) Reset()                    { *m = DeleteSandboxRequest{} }
+func (*DeleteSandboxRequest) ProtoMessage()               {}
+func (*DeleteSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{49} }
+
+type GetSandboxRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *GetSandboxRequest) Reset()                    { *m = GetSandboxRequest{} }
+func (*GetSandboxRequest) ProtoMessage()               {}
+func (*GetSandboxRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{50} }
+
+type GetSandboxResponse struct {
+	Sandbox *Sandbox `protobuf:"bytes,1,opt,name=sandbox" json:"sandbox,omitempty"`
+}
+
+func (m *GetSandboxResponse) Reset()                    { *m = GetSandboxResponse{} }
+func (*GetSandboxResponse) ProtoMessage()               {}
+func (*GetSandboxResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{51} }
+
+type ListSandboxesRequest struct {
+}
+
+func (m *ListSandboxesRequest) Reset()                    { *m = ListSandboxesRequest{} }
+func (*ListSandboxesRequest) ProtoMessage()               {}
+func (*ListSandboxesRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{52} }
+
+type ListSandboxesResponse struct {
+	Sandboxes []*Sandbox `protobuf:"bytes,1,rep,name=sandboxes" json:"sandboxes,omitempty"`
+}
+
+func (m *ListSandboxesResponse) Reset()                    { *m = ListSandboxesResponse{} }
+func (*ListSandboxesResponse) ProtoMessage()               {}
+func (*ListSandboxesResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{53} }
+
+type NetworkNamespace struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// IPs are the addresses assigned to the namespace by the CNI network
+	// of the daemon.
+	IPs []string `protobuf:"bytes,3,rep,name=ips" json:"ips,omitempty"`
+}
+
+func (m *NetworkNamespace) Reset()                    { *m = NetworkNamespace{} }
+func (*NetworkNamespace) ProtoMessage()               {}
+func (*NetworkNamespace) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{54} }
+
+type CreateNetworkNamespaceRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *CreateNetworkNamespaceRequest) Reset()                    { *m = CreateNetworkNamespaceRequest{} }
+func (*CreateNetworkNamespaceRequest) ProtoMessage()               {}
+func (*CreateNetworkNamespaceRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{55} }
+
+type CreateNetworkNamespaceResponse struct {
+	NetworkNamespace *NetworkNamespace `protobuf:"bytes,1,opt,name=network_namespace,json=networkNamespace" json:"network_namespace,omitempty"`
+}
+
+func (m *CreateNetworkNamespaceResponse) Reset()                    { *m = CreateNetworkNamespaceResponse{} }
+func (*CreateNetworkNamespaceResponse) ProtoMessage()               {}
+func (*CreateNetworkNamespaceResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{56} }
+
+type DeleteNetworkNamespaceRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *DeleteNetworkNamespaceRequest) Reset()                    { *m = DeleteNetworkNamespaceRequest{} }
+func (*DeleteNetworkNamespaceRequest) ProtoMessage()               {}
+func (*DeleteNetworkNamespaceRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{57} }
+
+type ListNetworkNamespacesRequest struct {
+}
+
+func (m *ListNetworkNamespacesRequest) Reset()                    { *m = ListNetworkNamespacesRequest{} }
+func (*ListNetworkNamespacesRequest) ProtoMessage()               {}
+func (*ListNetworkNamespacesRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{58} }
+
+type ListNetworkNamespacesResponse struct {
+	NetworkNamespaces []*NetworkNamespace `protobuf:"bytes,1,rep,name=network_namespaces,json=networkNamespaces" json:"network_namespaces,omitempty"`
+}
+
+func (m *ListNetworkNamespacesResponse) Reset()                    { *m = ListNetworkNamespacesResponse{} }
+func (*ListNetworkNamespacesResponse) ProtoMessage()               {}
+func (*ListNetworkNamespacesResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{59} }
+
+type ListPortsRequest struct {
+	// ID restricts the ports to those of the container when set.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *ListPortsRequest) Reset()                    { *m = ListPortsRequest{} }
+func (*ListPortsRequest) ProtoMessage()               {}
+func (*ListPortsRequest) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{60} }
+
+type ListPortsResponse struct {
+	Ports []*ForwardedPort `protobuf:"bytes,1,rep,name=ports" json:"ports,omitempty"`
+}
+
+func (m *ListPortsResponse) Reset()                    { *m = ListPortsResponse{} }
+func (*ListPortsResponse) ProtoMessage()               {}
+func (*ListPortsResponse) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{61} }
+
+type ForwardedPort struct {
+	ContainerID string       `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Mapping     *PortMapping `protobuf:"bytes,2,opt,name=mapping" json:"mapping,omitempty"`
+	// ContainerIP is the address of the container the port is forwarded
+	// to.
+	ContainerIP string `protobuf:"bytes,3,opt,name=container_ip,json=containerIp,proto3" json:"container_ip,omitempty"`
+}
+
+func (m *ForwardedPort) Reset()                    { *m = ForwardedPort{} }
+func (*ForwardedPort) ProtoMessage()               {}
+func (*ForwardedPort) Descriptor() ([]byte, []int) { return fileDescriptorExecution, []int{62} }
 
 func init() {
 	proto.RegisterType((*StartContainerRequest)(nil), "containerd.v1.StartContainerRequest")
 	proto.RegisterType((*CreateContainerRequest)(nil), "containerd.v1.CreateContainerRequest")
+	proto.RegisterType((*HostEntry)(nil), "containerd.v1.HostEntry")
+	proto.RegisterType((*PortMapping)(nil), "containerd.v1.PortMapping")
+	proto.RegisterType((*SecretMount)(nil), "containerd.v1.SecretMount")
+	proto.RegisterType((*IDMapping)(nil), "containerd.v1.IDMapping")
+	proto.RegisterType((*RuntimeOptions)(nil), "containerd.v1.RuntimeOptions")
 	proto.RegisterType((*CreateContainerResponse)(nil), "containerd.v1.CreateContainerResponse")
 	proto.RegisterType((*DeleteContainerRequest)(nil), "containerd.v1.DeleteContainerRequest")
 	proto.RegisterType((*ListContainersRequest)(nil), "containerd.v1.ListContainersRequest")
@@ -308,15 +931,52 @@ func init() {
 	proto.RegisterType((*User)(nil), "containerd.v1.User")
 	proto.RegisterType((*GetContainerRequest)(nil), "containerd.v1.GetContainerRequest")
 	proto.RegisterType((*GetContainerResponse)(nil), "containerd.v1.GetContainerResponse")
+	proto.RegisterType((*InspectContainerRequest)(nil), "containerd.v1.InspectContainerRequest")
+	proto.RegisterType((*InspectContainerResponse)(nil), "containerd.v1.InspectContainerResponse")
+	proto.RegisterType((*ProcessStdio)(nil), "containerd.v1.ProcessStdio")
 	proto.RegisterType((*UpdateContainerRequest)(nil), "containerd.v1.UpdateContainerRequest")
+	proto.RegisterType((*CheckpointContainerRequest)(nil), "containerd.v1.CheckpointContainerRequest")
 	proto.RegisterType((*PauseContainerRequest)(nil), "containerd.v1.PauseContainerRequest")
 	proto.RegisterType((*ResumeContainerRequest)(nil), "containerd.v1.ResumeContainerRequest")
 	proto.RegisterType((*GetProcessRequest)(nil), "containerd.v1.GetProcessRequest")
 	proto.RegisterType((*GetProcessResponse)(nil), "containerd.v1.GetProcessResponse")
 	proto.RegisterType((*SignalProcessRequest)(nil), "containerd.v1.SignalProcessRequest")
+	proto.RegisterType((*ResizeProcessRequest)(nil), "containerd.v1.ResizeProcessRequest")
 	proto.RegisterType((*DeleteProcessRequest)(nil), "containerd.v1.DeleteProcessRequest")
 	proto.RegisterType((*ListProcessesRequest)(nil), "containerd.v1.ListProcessesRequest")
 	proto.RegisterType((*ListProcessesResponse)(nil), "containerd.v1.ListProcessesResponse")
+	proto.RegisterType((*HostProcess)(nil), "containerd.v1.HostProcess")
+	proto.RegisterType((*GetRuntimeLogsRequest)(nil), "containerd.v1.GetRuntimeLogsRequest")
+	proto.RegisterType((*GetRuntimeLogsResponse)(nil), "containerd.v1.GetRuntimeLogsResponse")
+	proto.RegisterType((*RuntimeLog)(nil), "containerd.v1.RuntimeLog")
+	proto.RegisterType((*ListRuntimesRequest)(nil), "containerd.v1.ListRuntimesRequest")
+	proto.RegisterType((*ListRuntimesResponse)(nil), "containerd.v1.ListRuntimesResponse")
+	proto.RegisterType((*RuntimeInfo)(nil), "containerd.v1.RuntimeInfo")
+	proto.RegisterType((*RuntimeCapabilities)(nil), "containerd.v1.RuntimeCapabilities")
+	proto.RegisterType((*RuntimeFeatures)(nil), "containerd.v1.RuntimeFeatures")
+	proto.RegisterType((*StatsContainerRequest)(nil), "containerd.v1.StatsContainerRequest")
+	proto.RegisterType((*StatsContainerResponse)(nil), "containerd.v1.StatsContainerResponse")
+	proto.RegisterType((*KillSandboxRequest)(nil), "containerd.v1.KillSandboxRequest")
+	proto.RegisterType((*SandboxStatsRequest)(nil), "containerd.v1.SandboxStatsRequest")
+	proto.RegisterType((*SandboxStatsResponse)(nil), "containerd.v1.SandboxStatsResponse")
+	proto.RegisterType((*Sandbox)(nil), "containerd.v1.Sandbox")
+	proto.RegisterType((*CreateSandboxRequest)(nil), "containerd.v1.CreateSandboxRequest")
+	proto.RegisterType((*CreateSandboxResponse)(nil), "containerd.v1.CreateSandboxResponse")
+	proto.RegisterType((*DeleteSandboxRequest)(nil), "containerd.v1.DeleteSandboxRequest")
+	proto.RegisterType((*GetSandboxRequest)(nil), "containerd.v1.GetSandboxRequest")
+	proto.RegisterType((*GetSandboxResponse)(nil), "containerd.v1.GetSandboxResponse")
+	proto.RegisterType((*ListSandboxesRequest)(nil), "containerd.v1.ListSandboxesRequest")
+	proto.RegisterType((*ListSandboxesResponse)(nil), "containerd.v1.ListSandboxesResponse")
+	proto.RegisterType((*NetworkNamespace)(nil), "containerd.v1.NetworkNamespace")
+	proto.RegisterType((*CreateNetworkNamespaceRequest)(nil), "containerd.v1.CreateNetworkNamespaceRequest")
+	proto.RegisterType((*CreateNetworkNamespaceResponse)(nil), "containerd.v1.CreateNetworkNamespaceResponse")
+	proto.RegisterType((*DeleteNetworkNamespaceRequest)(nil), "containerd.v1.DeleteNetworkNamespaceRequest")
+	proto.RegisterType((*ListNetworkNamespacesRequest)(nil), "containerd.v1.ListNetworkNamespacesRequest")
+	proto.RegisterType((*ListNetworkNamespacesResponse)(nil), "containerd.v1.ListNetworkNamespacesResponse")
+	proto.RegisterType((*ListPortsRequest)(nil), "containerd.v1.ListPortsRequest")
+	proto.RegisterType((*ListPortsResponse)(nil), "containerd.v1.ListPortsResponse")
+	proto.RegisterType((*ForwardedPort)(nil), "containerd.v1.ForwardedPort")
+	proto.RegisterEnum("containerd.v1.NewPrivileges", NewPrivileges_name, NewPrivileges_value)
 	proto.RegisterEnum("containerd.v1.Status", Status_name, Status_value)
 }
 func (this *StartContainerRequest) GoString() string {
@@ -333,7 +993,7 @@ func (this *CreateContainerRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 36)
 	s = append(s, "&execution.CreateContainerRequest{")
 	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
 	s = append(s, "BundlePath: "+fmt.Sprintf("%#v", this.BundlePath)+",\n")
@@ -341,6 +1001,105 @@ func (this *CreateContainerRequest) GoString() string {
 	s = append(s, "Stdin: "+fmt.Sprintf("%#v", this.Stdin)+",\n")
 	s = append(s, "Stdout: "+fmt.Sprintf("%#v", this.Stdout)+",\n")
 	s = append(s, "Stderr: "+fmt.Sprintf("%#v", this.Stderr)+",\n")
+	if this.RuntimeOptions != nil {
+		s = append(s, "RuntimeOptions: "+fmt.Sprintf("%#v", this.RuntimeOptions)+",\n")
+	}
+	s = append(s, "Runtime: "+fmt.Sprintf("%#v", this.Runtime)+",\n")
+	s = append(s, "Sandbox: "+fmt.Sprintf("%#v", this.Sandbox)+",\n")
+	s = append(s, "PidsLimit: "+fmt.Sprintf("%#v", this.PidsLimit)+",\n")
+	s = append(s, "CgroupParent: "+fmt.Sprintf("%#v", this.CgroupParent)+",\n")
+	s = append(s, "SeccompProfile: "+fmt.Sprintf("%#v", this.SeccompProfile)+",\n")
+	s = append(s, "ApparmorProfile: "+fmt.Sprintf("%#v", this.ApparmorProfile)+",\n")
+	s = append(s, "NoSelinuxLabel: "+fmt.Sprintf("%#v", this.NoSelinuxLabel)+",\n")
+	if this.UIDMappings != nil {
+		s = append(s, "UIDMappings: "+fmt.Sprintf("%#v", this.UIDMappings)+",\n")
+	}
+	if this.GIDMappings != nil {
+		s = append(s, "GIDMappings: "+fmt.Sprintf("%#v", this.GIDMappings)+",\n")
+	}
+	s = append(s, "CapAdd: "+fmt.Sprintf("%#v", this.CapAdd)+",\n")
+	s = append(s, "CapDrop: "+fmt.Sprintf("%#v", this.CapDrop)+",\n")
+	s = append(s, "Privileged: "+fmt.Sprintf("%#v", this.Privileged)+",\n")
+	s = append(s, "MaskedPaths: "+fmt.Sprintf("%#v", this.MaskedPaths)+",\n")
+	s = append(s, "ReadonlyPaths: "+fmt.Sprintf("%#v", this.ReadonlyPaths)+",\n")
+	s = append(s, "ReadonlyRootfs: "+fmt.Sprintf("%#v", this.ReadonlyRootfs)+",\n")
+	if this.Secrets != nil {
+		s = append(s, "Secrets: "+fmt.Sprintf("%#v", this.Secrets)+",\n")
+	}
+	s = append(s, "Network: "+fmt.Sprintf("%#v", this.Network)+",\n")
+	if this.Ports != nil {
+		s = append(s, "Ports: "+fmt.Sprintf("%#v", this.Ports)+",\n")
+	}
+	s = append(s, "Hostname: "+fmt.Sprintf("%#v", this.Hostname)+",\n")
+	if this.ExtraHosts != nil {
+		s = append(s, "ExtraHosts: "+fmt.Sprintf("%#v", this.ExtraHosts)+",\n")
+	}
+	s = append(s, "DNSServers: "+fmt.Sprintf("%#v", this.DNSServers)+",\n")
+	s = append(s, "DNSSearch: "+fmt.Sprintf("%#v", this.DNSSearch)+",\n")
+	s = append(s, "DNSOptions: "+fmt.Sprintf("%#v", this.DNSOptions)+",\n")
+	s = append(s, "NetNSPath: "+fmt.Sprintf("%#v", this.NetNSPath)+",\n")
+	s = append(s, "CheckpointPath: "+fmt.Sprintf("%#v", this.CheckpointPath)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HostEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&execution.HostEntry{")
+	s = append(s, "IP: "+fmt.Sprintf("%#v", this.IP)+",\n")
+	s = append(s, "Names: "+fmt.Sprintf("%#v", this.Names)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PortMapping) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&execution.PortMapping{")
+	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
+	s = append(s, "HostIP: "+fmt.Sprintf("%#v", this.HostIP)+",\n")
+	s = append(s, "HostPort: "+fmt.Sprintf("%#v", this.HostPort)+",\n")
+	s = append(s, "ContainerPort: "+fmt.Sprintf("%#v", this.ContainerPort)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SecretMount) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&execution.SecretMount{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Target: "+fmt.Sprintf("%#v", this.Target)+",\n")
+	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IDMapping) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&execution.IDMapping{")
+	s = append(s, "ContainerID: "+fmt.Sprintf("%#v", this.ContainerID)+",\n")
+	s = append(s, "HostID: "+fmt.Sprintf("%#v", this.HostID)+",\n")
+	s = append(s, "Length: "+fmt.Sprintf("%#v", this.Length)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RuntimeOptions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&execution.RuntimeOptions{")
+	s = append(s, "SystemdCgroup: "+fmt.Sprintf("%#v", this.SystemdCgroup)+",\n")
+	s = append(s, "Root: "+fmt.Sprintf("%#v", this.Root)+",\n")
+	s = append(s, "CriuPath: "+fmt.Sprintf("%#v", this.CriuPath)+",\n")
+	s = append(s, "Debug: "+fmt.Sprintf("%#v", this.Debug)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -363,9 +1122,11 @@ func (this *DeleteContainerRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 7)
 	s = append(s, "&execution.DeleteContainerRequest{")
 	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Force: "+fmt.Sprintf("%#v", this.Force)+",\n")
+	s = append(s, "Timeout: "+fmt.Sprintf("%#v", this.Timeout)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -395,7 +1156,7 @@ func (this *StartProcessRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 14)
 	s = append(s, "&execution.StartProcessRequest{")
 	s = append(s, "ContainerID: "+fmt.Sprintf("%#v", this.ContainerID)+",\n")
 	if this.Process != nil {
@@ -405,6 +1166,10 @@ func (this *StartProcessRequest) GoString() string {
 	s = append(s, "Stdin: "+fmt.Sprintf("%#v", this.Stdin)+",\n")
 	s = append(s, "Stdout: "+fmt.Sprintf("%#v", this.Stdout)+",\n")
 	s = append(s, "Stderr: "+fmt.Sprintf("%#v", this.Stderr)+",\n")
+	s = append(s, "CapAdd: "+fmt.Sprintf("%#v", this.CapAdd)+",\n")
+	s = append(s, "CapDrop: "+fmt.Sprintf("%#v", this.CapDrop)+",\n")
+	s = append(s, "Privileged: "+fmt.Sprintf("%#v", this.Privileged)+",\n")
+	s = append(s, "NewPrivileges: "+fmt.Sprintf("%#v", this.NewPrivileges)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -424,11 +1189,13 @@ func (this *Container) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 9)
 	s = append(s, "&execution.Container{")
 	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
 	s = append(s, "BundlePath: "+fmt.Sprintf("%#v", this.BundlePath)+",\n")
 	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Sandbox: "+fmt.Sprintf("%#v", this.Sandbox)+",\n")
+	s = append(s, "IPs: "+fmt.Sprintf("%#v", this.IPs)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -436,7 +1203,7 @@ func (this *Process) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 14)
 	s = append(s, "&execution.Process{")
 	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
 	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
@@ -448,6 +1215,8 @@ func (this *Process) GoString() string {
 	s = append(s, "Cwd: "+fmt.Sprintf("%#v", this.Cwd)+",\n")
 	s = append(s, "Terminal: "+fmt.Sprintf("%#v", this.Terminal)+",\n")
 	s = append(s, "ExitStatus: "+fmt.Sprintf("%#v", this.ExitStatus)+",\n")
+	s = append(s, "ApparmorProfile: "+fmt.Sprintf("%#v", this.ApparmorProfile)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -485,14 +1254,71 @@ func (this *GetContainerResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *UpdateContainerRequest) GoString() string {
+func (this *InspectContainerRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.InspectContainerRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InspectContainerResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
+	s = append(s, "&execution.InspectContainerResponse{")
+	s = append(s, "Spec: "+fmt.Sprintf("%#v", this.Spec)+",\n")
+	if this.Stdio != nil {
+		s = append(s, "Stdio: "+fmt.Sprintf("%#v", this.Stdio)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProcessStdio) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&execution.ProcessStdio{")
+	s = append(s, "ProcessID: "+fmt.Sprintf("%#v", this.ProcessID)+",\n")
+	s = append(s, "Stdin: "+fmt.Sprintf("%#v", this.Stdin)+",\n")
+	s = append(s, "Stdout: "+fmt.Sprintf("%#v", this.Stdout)+",\n")
+	s = append(s, "Stderr: "+fmt.Sprintf("%#v", this.Stderr)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateContainerRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
 	s = append(s, "&execution.UpdateContainerRequest{")
 	s = append(s, "ContainerID: "+fmt.Sprintf("%#v", this.ContainerID)+",\n")
 	s = append(s, "BundlePath: "+fmt.Sprintf("%#v", this.BundlePath)+",\n")
+	s = append(s, "Resources: "+fmt.Sprintf("%#v", this.Resources)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckpointContainerRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&execution.CheckpointContainerRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
+	s = append(s, "Exit: "+fmt.Sprintf("%#v", this.Exit)+",\n")
+	s = append(s, "TCPEstablished: "+fmt.Sprintf("%#v", this.TCPEstablished)+",\n")
+	s = append(s, "UnixSockets: "+fmt.Sprintf("%#v", this.UnixSockets)+",\n")
+	s = append(s, "Shell: "+fmt.Sprintf("%#v", this.Shell)+",\n")
+	s = append(s, "EmptyNS: "+fmt.Sprintf("%#v", this.EmptyNS)+",\n")
+	s = append(s, "PreDump: "+fmt.Sprintf("%#v", this.PreDump)+",\n")
+	s = append(s, "ParentPath: "+fmt.Sprintf("%#v", this.ParentPath)+",\n")
+	s = append(s, "PreDumps: "+fmt.Sprintf("%#v", this.PreDumps)+",\n")
+	s = append(s, "PreDumpThreshold: "+fmt.Sprintf("%#v", this.PreDumpThreshold)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -543,11 +1369,25 @@ func (this *SignalProcessRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&execution.SignalProcessRequest{")
 	s = append(s, "ContainerID: "+fmt.Sprintf("%#v", this.ContainerID)+",\n")
 	s = append(s, "ProcessID: "+fmt.Sprintf("%#v", this.ProcessID)+",\n")
 	s = append(s, "Signal: "+fmt.Sprintf("%#v", this.Signal)+",\n")
+	s = append(s, "All: "+fmt.Sprintf("%#v", this.All)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResizeProcessRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&execution.ResizeProcessRequest{")
+	s = append(s, "ContainerID: "+fmt.Sprintf("%#v", this.ContainerID)+",\n")
+	s = append(s, "ProcessID: "+fmt.Sprintf("%#v", this.ProcessID)+",\n")
+	s = append(s, "Width: "+fmt.Sprintf("%#v", this.Width)+",\n")
+	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -576,43 +1416,403 @@ func (this *ListProcessesResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&execution.ListProcessesResponse{")
 	if this.Processes != nil {
 		s = append(s, "Processes: "+fmt.Sprintf("%#v", this.Processes)+",\n")
 	}
+	if this.HostProcesses != nil {
+		s = append(s, "HostProcesses: "+fmt.Sprintf("%#v", this.HostProcesses)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringExecution(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *HostProcess) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	s := make([]string, 0, 7)
+	s = append(s, "&execution.HostProcess{")
+	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	s = append(s, "Args: "+fmt.Sprintf("%#v", this.Args)+",\n")
+	s = append(s, "ProcessID: "+fmt.Sprintf("%#v", this.ProcessID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func extensionToGoStringExecution(m github_com_gogo_protobuf_proto.Message) string {
-	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
-	if e == nil {
+func (this *GetRuntimeLogsRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
-	keys := make([]int, 0, len(e))
-	for k := range e {
-		keys = append(keys, int(k))
+	s := make([]string, 0, 6)
+	s = append(s, "&execution.GetRuntimeLogsRequest{")
+	s = append(s, "ContainerID: "+fmt.Sprintf("%#v", this.ContainerID)+",\n")
+	s = append(s, "ProcessID: "+fmt.Sprintf("%#v", this.ProcessID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetRuntimeLogsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	sort.Ints(keys)
-	ss := []string{}
-	for _, k := range keys {
-		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.GetRuntimeLogsResponse{")
+	if this.Logs != nil {
+		s = append(s, "Logs: "+fmt.Sprintf("%#v", this.Logs)+",\n")
 	}
-	s += strings.Join(ss, ",") + "})"
-	return s
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
+func (this *RuntimeLog) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&execution.RuntimeLog{")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "Level: "+fmt.Sprintf("%#v", this.Level)+",\n")
+	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListRuntimesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&execution.ListRuntimesRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListRuntimesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.ListRuntimesResponse{")
+	if this.Runtimes != nil {
+		s = append(s, "Runtimes: "+fmt.Sprintf("%#v", this.Runtimes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RuntimeInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&execution.RuntimeInfo{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Default: "+fmt.Sprintf("%#v", this.Default)+",\n")
+	if this.Capabilities != nil {
+		s = append(s, "Capabilities: "+fmt.Sprintf("%#v", this.Capabilities)+",\n")
+	}
+	if this.Features != nil {
+		s = append(s, "Features: "+fmt.Sprintf("%#v", this.Features)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RuntimeCapabilities) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&execution.RuntimeCapabilities{")
+	s = append(s, "Pause: "+fmt.Sprintf("%#v", this.Pause)+",\n")
+	s = append(s, "Exec: "+fmt.Sprintf("%#v", this.Exec)+",\n")
+	s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RuntimeFeatures) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&execution.RuntimeFeatures{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Commit: "+fmt.Sprintf("%#v", this.Commit)+",\n")
+	s = append(s, "Spec: "+fmt.Sprintf("%#v", this.Spec)+",\n")
+	s = append(s, "Seccomp: "+fmt.Sprintf("%#v", this.Seccomp)+",\n")
+	s = append(s, "Libseccomp: "+fmt.Sprintf("%#v", this.Libseccomp)+",\n")
+	s = append(s, "CgroupV2: "+fmt.Sprintf("%#v", this.CgroupV2)+",\n")
+	s = append(s, "Criu: "+fmt.Sprintf("%#v", this.Criu)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StatsContainerRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.StatsContainerRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StatsContainerResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.StatsContainerResponse{")
+	s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KillSandboxRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&execution.KillSandboxRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Signal: "+fmt.Sprintf("%#v", this.Signal)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SandboxStatsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.SandboxStatsRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SandboxStatsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.SandboxStatsResponse{")
+	s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Sandbox) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&execution.Sandbox{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	s = append(s, "Hostname: "+fmt.Sprintf("%#v", this.Hostname)+",\n")
+	s = append(s, "Running: "+fmt.Sprintf("%#v", this.Running)+",\n")
+	s = append(s, "IPs: "+fmt.Sprintf("%#v", this.IPs)+",\n")
+	s = append(s, "Containers: "+fmt.Sprintf("%#v", this.Containers)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateSandboxRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&execution.CreateSandboxRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Hostname: "+fmt.Sprintf("%#v", this.Hostname)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateSandboxResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.CreateSandboxResponse{")
+	if this.Sandbox != nil {
+		s = append(s, "Sandbox: "+fmt.Sprintf("%#v", this.Sandbox)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteSandboxRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.DeleteSandboxRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetSandboxRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.GetSandboxRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetSandboxResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.GetSandboxResponse{")
+	if this.Sandbox != nil {
+		s = append(s, "Sandbox: "+fmt.Sprintf("%#v", this.Sandbox)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListSandboxesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&execution.ListSandboxesRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListSandboxesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.ListSandboxesResponse{")
+	if this.Sandboxes != nil {
+		s = append(s, "Sandboxes: "+fmt.Sprintf("%#v", this.Sandboxes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NetworkNamespace) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&execution.NetworkNamespace{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Path: "+fmt.Sprintf("%#v", this.Path)+",\n")
+	s = append(s, "IPs: "+fmt.Sprintf("%#v", this.IPs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateNetworkNamespaceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.CreateNetworkNamespaceRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateNetworkNamespaceResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.CreateNetworkNamespaceResponse{")
+	if this.NetworkNamespace != nil {
+		s = append(s, "NetworkNamespace: "+fmt.Sprintf("%#v", this.NetworkNamespace)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteNetworkNamespaceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.DeleteNetworkNamespaceRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListNetworkNamespacesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&execution.ListNetworkNamespacesRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListNetworkNamespacesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.ListNetworkNamespacesResponse{")
+	if this.NetworkNamespaces != nil {
+		s = append(s, "NetworkNamespaces: "+fmt.Sprintf("%#v", this.NetworkNamespaces)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListPortsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.ListPortsRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListPortsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&execution.ListPortsResponse{")
+	if this.Ports != nil {
+		s = append(s, "Ports: "+fmt.Sprintf("%#v", this.Ports)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ForwardedPort) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&execution.ForwardedPort{")
+	s = append(s, "ContainerID: "+fmt.Sprintf("%#v", this.ContainerID)+",\n")
+	if this.Mapping != nil {
+		s = append(s, "Mapping: "+fmt.Sprintf("%#v", this.Mapping)+",\n")
+	}
+	s = append(s, "ContainerIP: "+fmt.Sprintf("%#v", this.ContainerIP)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringExecution(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringExecution(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
 var _ grpc.ClientConn
 
 // This is a compile-time assertion to ensure that this generated file
@@ -630,11 +1830,48 @@ type ExecutionServiceClient interface {
 	Delete(ctx context.Context, in *DeleteContainerRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	Get(ctx context.Context, in *GetContainerRequest, opts ...grpc.CallOption) (*GetContainerResponse, error)
 	List(ctx context.Context, in *ListContainersRequest, opts ...grpc.CallOption) (*ListContainersResponse, error)
+	// ListStream lists the containers as List does, in responses of at most
+	// a hundred containers each, for the containers of large hosts not to
+	// exceed the size of the messages.
+	ListStream(ctx context.Context, in *ListContainersRequest, opts ...grpc.CallOption) (ExecutionService_ListStreamClient, error)
+	Stats(ctx context.Context, in *StatsContainerRequest, opts ...grpc.CallOption) (*StatsContainerResponse, error)
+	// Checkpoint dumps the state of a running container with criu into a
+	// directory of the host, from which a container is restored by create.
+	Checkpoint(ctx context.Context, in *CheckpointContainerRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// Inspect returns the spec a container runs with and the stdio of its
+	// processes, as kept by the executor.
+	Inspect(ctx context.Context, in *InspectContainerRequest, opts ...grpc.CallOption) (*InspectContainerResponse, error)
 	StartProcess(ctx context.Context, in *StartProcessRequest, opts ...grpc.CallOption) (*StartProcessResponse, error)
 	GetProcess(ctx context.Context, in *GetProcessRequest, opts ...grpc.CallOption) (*GetProcessResponse, error)
 	SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// ResizeProcess resizes the console of a process running with a
+	// terminal.
+	ResizeProcess(ctx context.Context, in *ResizeProcessRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	DeleteProcess(ctx context.Context, in *DeleteProcessRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	ListProcesses(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (*ListProcessesResponse, error)
+	// ListProcessesStream lists the processes as ListProcesses does, in
+	// responses of at most a hundred processes and host processes each.
+	ListProcessesStream(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (ExecutionService_ListProcessesStreamClient, error)
+	GetRuntimeLogs(ctx context.Context, in *GetRuntimeLogsRequest, opts ...grpc.CallOption) (*GetRuntimeLogsResponse, error)
+	ListRuntimes(ctx context.Context, in *ListRuntimesRequest, opts ...grpc.CallOption) (*ListRuntimesResponse, error)
+	KillSandbox(ctx context.Context, in *KillSandboxRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	SandboxStats(ctx context.Context, in *SandboxStatsRequest, opts ...grpc.CallOption) (*SandboxStatsResponse, error)
+	// CreateSandbox starts the pause process of a sandbox, holding the
+	// network, IPC and UTS namespaces joined by the containers created in
+	// the sandbox. It is added to the CNI network of the daemon, if any,
+	// and outlives its containers until it is deleted.
+	CreateSandbox(ctx context.Context, in *CreateSandboxRequest, opts ...grpc.CallOption) (*CreateSandboxResponse, error)
+	DeleteSandbox(ctx context.Context, in *DeleteSandboxRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	GetSandbox(ctx context.Context, in *GetSandboxRequest, opts ...grpc.CallOption) (*GetSandboxResponse, error)
+	ListSandboxes(ctx context.Context, in *ListSandboxesRequest, opts ...grpc.CallOption) (*ListSandboxesResponse, error)
+	// CreateNetworkNamespace creates a named network namespace that
+	// containers join with their network, independently of their
+	// lifecycle. It is added to the CNI network of the daemon, if any.
+	CreateNetworkNamespace(ctx context.Context, in *CreateNetworkNamespaceRequest, opts ...grpc.CallOption) (*CreateNetworkNamespaceResponse, error)
+	DeleteNetworkNamespace(ctx context.Context, in *DeleteNetworkNamespaceRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	ListNetworkNamespaces(ctx context.Context, in *ListNetworkNamespacesRequest, opts ...grpc.CallOption) (*ListNetworkNamespacesResponse, error)
+	// ListPorts lists the ports of the host forwarded to containers.
+	ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error)
 }
 
 type executionServiceClient struct {
@@ -717,27 +1954,95 @@ func (c *executionServiceClient) List(ctx context.Context, in *ListContainersReq
 	return out, nil
 }
 
-func (c *executionServiceClient) StartProcess(ctx context.Context, in *StartProcessRequest, opts ...grpc.CallOption) (*StartProcessResponse, error) {
-	out := new(StartProcessResponse)
-	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/StartProcess", in, out, c.cc, opts...)
+func (c *executionServiceClient) ListStream(ctx context.Context, in *ListContainersRequest, opts ...grpc.CallOption) (ExecutionService_ListStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ExecutionService_serviceDesc.Streams[0], c.cc, "/containerd.v1.ExecutionService/ListStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *executionServiceClient) GetProcess(ctx context.Context, in *GetProcessRequest, opts ...grpc.CallOption) (*GetProcessResponse, error) {
-	out := new(GetProcessResponse)
-	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/GetProcess", in, out, c.cc, opts...)
-	if err != nil {
+	x := &executionServiceListStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *executionServiceClient) SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
-	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/SignalProcess", in, out, c.cc, opts...)
+type ExecutionService_ListStreamClient interface {
+	Recv() (*ListContainersResponse, error)
+	grpc.ClientStream
+}
+
+type executionServiceListStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *executionServiceListStreamClient) Recv() (*ListContainersResponse, error) {
+	m := new(ListContainersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executionServiceClient) Stats(ctx context.Context, in *StatsContainerRequest, opts ...grpc.CallOption) (*StatsContainerResponse, error) {
+	out := new(StatsContainerResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/Stats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) Checkpoint(ctx context.Context, in *CheckpointContainerRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/Checkpoint", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) Inspect(ctx context.Context, in *InspectContainerRequest, opts ...grpc.CallOption) (*InspectContainerResponse, error) {
+	out := new(InspectContainerResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/Inspect", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) StartProcess(ctx context.Context, in *StartProcessRequest, opts ...grpc.CallOption) (*StartProcessResponse, error) {
+	out := new(StartProcessResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/StartProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) GetProcess(ctx context.Context, in *GetProcessRequest, opts ...grpc.CallOption) (*GetProcessResponse, error) {
+	out := new(GetProcessResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/GetProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) SignalProcess(ctx context.Context, in *SignalProcessRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/SignalProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) ResizeProcess(ctx context.Context, in *ResizeProcessRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/ResizeProcess", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -762,6 +2067,146 @@ func (c *executionServiceClient) ListProcesses(ctx context.Context, in *ListProc
 	return out, nil
 }
 
+func (c *executionServiceClient) ListProcessesStream(ctx context.Context, in *ListProcessesRequest, opts ...grpc.CallOption) (ExecutionService_ListProcessesStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ExecutionService_serviceDesc.Streams[1], c.cc, "/containerd.v1.ExecutionService/ListProcessesStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executionServiceListProcessesStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExecutionService_ListProcessesStreamClient interface {
+	Recv() (*ListProcessesResponse, error)
+	grpc.ClientStream
+}
+
+type executionServiceListProcessesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *executionServiceListProcessesStreamClient) Recv() (*ListProcessesResponse, error) {
+	m := new(ListProcessesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executionServiceClient) GetRuntimeLogs(ctx context.Context, in *GetRuntimeLogsRequest, opts ...grpc.CallOption) (*GetRuntimeLogsResponse, error) {
+	out := new(GetRuntimeLogsResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/GetRuntimeLogs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) ListRuntimes(ctx context.Context, in *ListRuntimesRequest, opts ...grpc.CallOption) (*ListRuntimesResponse, error) {
+	out := new(ListRuntimesResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/ListRuntimes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) KillSandbox(ctx context.Context, in *KillSandboxRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/KillSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) SandboxStats(ctx context.Context, in *SandboxStatsRequest, opts ...grpc.CallOption) (*SandboxStatsResponse, error) {
+	out := new(SandboxStatsResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/SandboxStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) CreateSandbox(ctx context.Context, in *CreateSandboxRequest, opts ...grpc.CallOption) (*CreateSandboxResponse, error) {
+	out := new(CreateSandboxResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/CreateSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) DeleteSandbox(ctx context.Context, in *DeleteSandboxRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/DeleteSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) GetSandbox(ctx context.Context, in *GetSandboxRequest, opts ...grpc.CallOption) (*GetSandboxResponse, error) {
+	out := new(GetSandboxResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/GetSandbox", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) ListSandboxes(ctx context.Context, in *ListSandboxesRequest, opts ...grpc.CallOption) (*ListSandboxesResponse, error) {
+	out := new(ListSandboxesResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/ListSandboxes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) CreateNetworkNamespace(ctx context.Context, in *CreateNetworkNamespaceRequest, opts ...grpc.CallOption) (*CreateNetworkNamespaceResponse, error) {
+	out := new(CreateNetworkNamespaceResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/CreateNetworkNamespace", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) DeleteNetworkNamespace(ctx context.Context, in *DeleteNetworkNamespaceRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/DeleteNetworkNamespace", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) ListNetworkNamespaces(ctx context.Context, in *ListNetworkNamespacesRequest, opts ...grpc.CallOption) (*ListNetworkNamespacesResponse, error) {
+	out := new(ListNetworkNamespacesResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/ListNetworkNamespaces", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionServiceClient) ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error) {
+	out := new(ListPortsResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.ExecutionService/ListPorts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ExecutionService service
 
 type ExecutionServiceServer interface {
@@ -773,11 +2218,48 @@ type ExecutionServiceServer interface {
 	Delete(context.Context, *DeleteContainerRequest) (*google_protobuf.Empty, error)
 	Get(context.Context, *GetContainerRequest) (*GetContainerResponse, error)
 	List(context.Context, *ListContainersRequest) (*ListContainersResponse, error)
+	// ListStream lists the containers as List does, in responses of at most
+	// a hundred containers each, for the containers of large hosts not to
+	// exceed the size of the messages.
+	ListStream(*ListContainersRequest, ExecutionService_ListStreamServer) error
+	Stats(context.Context, *StatsContainerRequest) (*StatsContainerResponse, error)
+	// Checkpoint dumps the state of a running container with criu into a
+	// directory of the host, from which a container is restored by create.
+	Checkpoint(context.Context, *CheckpointContainerRequest) (*google_protobuf.Empty, error)
+	// Inspect returns the spec a container runs with and the stdio of its
+	// processes, as kept by the executor.
+	Inspect(context.Context, *InspectContainerRequest) (*InspectContainerResponse, error)
 	StartProcess(context.Context, *StartProcessRequest) (*StartProcessResponse, error)
 	GetProcess(context.Context, *GetProcessRequest) (*GetProcessResponse, error)
 	SignalProcess(context.Context, *SignalProcessRequest) (*google_protobuf.Empty, error)
+	// ResizeProcess resizes the console of a process running with a
+	// terminal.
+	ResizeProcess(context.Context, *ResizeProcessRequest) (*google_protobuf.Empty, error)
 	DeleteProcess(context.Context, *DeleteProcessRequest) (*google_protobuf.Empty, error)
 	ListProcesses(context.Context, *ListProcessesRequest) (*ListProcessesResponse, error)
+	// ListProcessesStream lists the processes as ListProcesses does, in
+	// responses of at most a hundred processes and host processes each.
+	ListProcessesStream(*ListProcessesRequest, ExecutionService_ListProcessesStreamServer) error
+	GetRuntimeLogs(context.Context, *GetRuntimeLogsRequest) (*GetRuntimeLogsResponse, error)
+	ListRuntimes(context.Context, *ListRuntimesRequest) (*ListRuntimesResponse, error)
+	KillSandbox(context.Context, *KillSandboxRequest) (*google_protobuf.Empty, error)
+	SandboxStats(context.Context, *SandboxStatsRequest) (*SandboxStatsResponse, error)
+	// CreateSandbox starts the pause process of a sandbox, holding the
+	// network, IPC and UTS namespaces joined by the containers created in
+	// the sandbox. It is added to the CNI network of the daemon, if any,
+	// and outlives its containers until it is deleted.
+	CreateSandbox(context.Context, *CreateSandboxRequest) (*CreateSandboxResponse, error)
+	DeleteSandbox(context.Context, *DeleteSandboxRequest) (*google_protobuf.Empty, error)
+	GetSandbox(context.Context, *GetSandboxRequest) (*GetSandboxResponse, error)
+	ListSandboxes(context.Context, *ListSandboxesRequest) (*ListSandboxesResponse, error)
+	// CreateNetworkNamespace creates a named network namespace that
+	// containers join with their network, independently of their
+	// lifecycle. It is added to the CNI network of the daemon, if any.
+	CreateNetworkNamespace(context.Context, *CreateNetworkNamespaceRequest) (*CreateNetworkNamespaceResponse, error)
+	DeleteNetworkNamespace(context.Context, *DeleteNetworkNamespaceRequest) (*google_protobuf.Empty, error)
+	ListNetworkNamespaces(context.Context, *ListNetworkNamespacesRequest) (*ListNetworkNamespacesResponse, error)
+	// ListPorts lists the ports of the host forwarded to containers.
+	ListPorts(context.Context, *ListPortsRequest) (*ListPortsResponse, error)
 }
 
 func RegisterExecutionServiceServer(s *grpc.Server, srv ExecutionServiceServer) {
@@ -928,6 +2410,81 @@ func _ExecutionService_List_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutionService_ListStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListContainersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionServiceServer).ListStream(m, &executionServiceListStreamServer{stream})
+}
+
+type ExecutionService_ListStreamServer interface {
+	Send(*ListContainersResponse) error
+	grpc.ServerStream
+}
+
+type executionServiceListStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionServiceListStreamServer) Send(m *ListContainersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).Stats(ctx, req.(*StatsContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).Checkpoint(ctx, req.(*CheckpointContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).Inspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/Inspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).Inspect(ctx, req.(*InspectContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecutionService_StartProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartProcessRequest)
 	if err := dec(in); err != nil {
@@ -982,6 +2539,24 @@ func _ExecutionService_SignalProcess_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecutionService_ResizeProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).ResizeProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/ResizeProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).ResizeProcess(ctx, req.(*ResizeProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExecutionService_DeleteProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteProcessRequest)
 	if err := dec(in); err != nil {
@@ -1018,54 +2593,307 @@ func _ExecutionService_ListProcesses_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ExecutionService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "containerd.v1.ExecutionService",
-	HandlerType: (*ExecutionServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _ExecutionService_Create_Handler,
-		},
-		{
-			MethodName: "Start",
-			Handler:    _ExecutionService_Start_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _ExecutionService_Update_Handler,
-		},
-		{
-			MethodName: "Pause",
-			Handler:    _ExecutionService_Pause_Handler,
-		},
-		{
-			MethodName: "Resume",
-			Handler:    _ExecutionService_Resume_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _ExecutionService_Delete_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _ExecutionService_Get_Handler,
-		},
-		{
-			MethodName: "List",
-			Handler:    _ExecutionService_List_Handler,
-		},
-		{
-			MethodName: "StartProcess",
-			Handler:    _ExecutionService_StartProcess_Handler,
-		},
-		{
-			MethodName: "GetProcess",
-			Handler:    _ExecutionService_GetProcess_Handler,
-		},
-		{
-			MethodName: "SignalProcess",
-			Handler:    _ExecutionService_SignalProcess_Handler,
-		},
+func _ExecutionService_ListProcessesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListProcessesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionServiceServer).ListProcessesStream(m, &executionServiceListProcessesStreamServer{stream})
+}
+
+type ExecutionService_ListProcessesStreamServer interface {
+	Send(*ListProcessesResponse) error
+	grpc.ServerStream
+}
+
+type executionServiceListProcessesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionServiceListProcessesStreamServer) Send(m *ListProcessesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionService_GetRuntimeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuntimeLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).GetRuntimeLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/GetRuntimeLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).GetRuntimeLogs(ctx, req.(*GetRuntimeLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_ListRuntimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuntimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).ListRuntimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/ListRuntimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).ListRuntimes(ctx, req.(*ListRuntimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_KillSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).KillSandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/KillSandbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).KillSandbox(ctx, req.(*KillSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_SandboxStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SandboxStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).SandboxStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/SandboxStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).SandboxStats(ctx, req.(*SandboxStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_CreateSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).CreateSandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/CreateSandbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).CreateSandbox(ctx, req.(*CreateSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_DeleteSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).DeleteSandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/DeleteSandbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).DeleteSandbox(ctx, req.(*DeleteSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_GetSandbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSandboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).GetSandbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/GetSandbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).GetSandbox(ctx, req.(*GetSandboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_ListSandboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSandboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).ListSandboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/ListSandboxes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).ListSandboxes(ctx, req.(*ListSandboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_CreateNetworkNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNetworkNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).CreateNetworkNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/CreateNetworkNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).CreateNetworkNamespace(ctx, req.(*CreateNetworkNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_DeleteNetworkNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNetworkNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).DeleteNetworkNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/DeleteNetworkNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).DeleteNetworkNamespace(ctx, req.(*DeleteNetworkNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_ListNetworkNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetworkNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).ListNetworkNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/ListNetworkNamespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).ListNetworkNamespaces(ctx, req.(*ListNetworkNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionService_ListPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionServiceServer).ListPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.ExecutionService/ListPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionServiceServer).ListPorts(ctx, req.(*ListPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ExecutionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.v1.ExecutionService",
+	HandlerType: (*ExecutionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ExecutionService_Create_Handler,
+		},
+		{
+			MethodName: "Start",
+			Handler:    _ExecutionService_Start_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ExecutionService_Update_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _ExecutionService_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _ExecutionService_Resume_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ExecutionService_Delete_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ExecutionService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ExecutionService_List_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _ExecutionService_Stats_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _ExecutionService_Checkpoint_Handler,
+		},
+		{
+			MethodName: "Inspect",
+			Handler:    _ExecutionService_Inspect_Handler,
+		},
+		{
+			MethodName: "StartProcess",
+			Handler:    _ExecutionService_StartProcess_Handler,
+		},
+		{
+			MethodName: "GetProcess",
+			Handler:    _ExecutionService_GetProcess_Handler,
+		},
+		{
+			MethodName: "SignalProcess",
+			Handler:    _ExecutionService_SignalProcess_Handler,
+		},
+		{
+			MethodName: "ResizeProcess",
+			Handler:    _ExecutionService_ResizeProcess_Handler,
+		},
 		{
 			MethodName: "DeleteProcess",
 			Handler:    _ExecutionService_DeleteProcess_Handler,
@@ -1074,8 +2902,67 @@ var _ExecutionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListProcesses",
 			Handler:    _ExecutionService_ListProcesses_Handler,
 		},
+		{
+			MethodName: "GetRuntimeLogs",
+			Handler:    _ExecutionService_GetRuntimeLogs_Handler,
+		},
+		{
+			MethodName: "ListRuntimes",
+			Handler:    _ExecutionService_ListRuntimes_Handler,
+		},
+		{
+			MethodName: "KillSandbox",
+			Handler:    _ExecutionService_KillSandbox_Handler,
+		},
+		{
+			MethodName: "SandboxStats",
+			Handler:    _ExecutionService_SandboxStats_Handler,
+		},
+		{
+			MethodName: "CreateSandbox",
+			Handler:    _ExecutionService_CreateSandbox_Handler,
+		},
+		{
+			MethodName: "DeleteSandbox",
+			Handler:    _ExecutionService_DeleteSandbox_Handler,
+		},
+		{
+			MethodName: "GetSandbox",
+			Handler:    _ExecutionService_GetSandbox_Handler,
+		},
+		{
+			MethodName: "ListSandboxes",
+			Handler:    _ExecutionService_ListSandboxes_Handler,
+		},
+		{
+			MethodName: "CreateNetworkNamespace",
+			Handler:    _ExecutionService_CreateNetworkNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteNetworkNamespace",
+			Handler:    _ExecutionService_DeleteNetworkNamespace_Handler,
+		},
+		{
+			MethodName: "ListNetworkNamespaces",
+			Handler:    _ExecutionService_ListNetworkNamespaces_Handler,
+		},
+		{
+			MethodName: "ListPorts",
+			Handler:    _ExecutionService_ListPorts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListStream",
+			Handler:       _ExecutionService_ListStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListProcessesStream",
+			Handler:       _ExecutionService_ListProcessesStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "execution.proto",
 }
 
@@ -1158,72 +3045,308 @@ func (m *CreateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stderr)))
 		i += copy(dAtA[i:], m.Stderr)
 	}
-	return i, nil
-}
-
-func (m *CreateContainerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateContainerResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Container != nil {
-		dAtA[i] = 0xa
+	if m.RuntimeOptions != nil {
+		dAtA[i] = 0x3a
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Container.Size()))
-		n1, err := m.Container.MarshalTo(dAtA[i:])
+		i = encodeVarintExecution(dAtA, i, uint64(m.RuntimeOptions.Size()))
+		n1, err := m.RuntimeOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n1
 	}
-	if m.InitProcess != nil {
-		dAtA[i] = 0x12
+	if len(m.Runtime) > 0 {
+		dAtA[i] = 0x42
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.InitProcess.Size()))
-		n2, err := m.InitProcess.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Runtime)))
+		i += copy(dAtA[i:], m.Runtime)
+	}
+	if len(m.Sandbox) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Sandbox)))
+		i += copy(dAtA[i:], m.Sandbox)
+	}
+	if m.PidsLimit != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.PidsLimit))
+	}
+	if len(m.CgroupParent) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.CgroupParent)))
+		i += copy(dAtA[i:], m.CgroupParent)
+	}
+	if len(m.SeccompProfile) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.SeccompProfile)))
+		i += copy(dAtA[i:], m.SeccompProfile)
+	}
+	if len(m.ApparmorProfile) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ApparmorProfile)))
+		i += copy(dAtA[i:], m.ApparmorProfile)
+	}
+	if m.NoSelinuxLabel {
+		dAtA[i] = 0x70
+		i++
+		if m.NoSelinuxLabel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n2
+		i++
 	}
-	return i, nil
-}
-
-func (m *DeleteContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.UIDMappings) > 0 {
+		for _, msg := range m.UIDMappings {
+			dAtA[i] = 0x7a
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
+	if len(m.GIDMappings) > 0 {
+		for _, msg := range m.GIDMappings {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.CapAdd) > 0 {
+		for _, s := range m.CapAdd {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.CapDrop) > 0 {
+		for _, s := range m.CapDrop {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Privileged {
+		dAtA[i] = 0x98
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+		dAtA[i] = 0x1
+		i++
+		if m.Privileged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.MaskedPaths) > 0 {
+		for _, s := range m.MaskedPaths {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ReadonlyPaths) > 0 {
+		for _, s := range m.ReadonlyPaths {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ReadonlyRootfs {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.ReadonlyRootfs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Secrets) > 0 {
+		for _, msg := range m.Secrets {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Network) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Network)))
+		i += copy(dAtA[i:], m.Network)
+	}
+	if len(m.Ports) > 0 {
+		for _, msg := range m.Ports {
+			dAtA[i] = 0xca
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if len(m.ExtraHosts) > 0 {
+		for _, msg := range m.ExtraHosts {
+			dAtA[i] = 0xda
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DNSServers) > 0 {
+		for _, s := range m.DNSServers {
+			dAtA[i] = 0xe2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DNSSearch) > 0 {
+		for _, s := range m.DNSSearch {
+			dAtA[i] = 0xea
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DNSOptions) > 0 {
+		for _, s := range m.DNSOptions {
+			dAtA[i] = 0xf2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NetNSPath) > 0 {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.NetNSPath)))
+		i += copy(dAtA[i:], m.NetNSPath)
+	}
+	if len(m.CheckpointPath) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.CheckpointPath)))
+		i += copy(dAtA[i:], m.CheckpointPath)
 	}
 	return i, nil
 }
 
-func (m *ListContainersRequest) Marshal() (dAtA []byte, err error) {
+func (m *HostEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1233,14 +3356,20 @@ func (m *ListContainersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListContainersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *HostEntry) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		for _, s := range m.Owner {
-			dAtA[i] = 0xa
+	if len(m.IP) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.IP)))
+		i += copy(dAtA[i:], m.IP)
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			dAtA[i] = 0x12
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -1256,7 +3385,7 @@ func (m *ListContainersRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListContainersResponse) Marshal() (dAtA []byte, err error) {
+func (m *PortMapping) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1266,27 +3395,37 @@ func (m *ListContainersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListContainersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PortMapping) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Containers) > 0 {
-		for _, msg := range m.Containers {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.Protocol) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Protocol)))
+		i += copy(dAtA[i:], m.Protocol)
+	}
+	if len(m.HostIP) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.HostIP)))
+		i += copy(dAtA[i:], m.HostIP)
+	}
+	if m.HostPort != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.HostPort))
+	}
+	if m.ContainerPort != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.ContainerPort))
 	}
 	return i, nil
 }
 
-func (m *StartProcessRequest) Marshal() (dAtA []byte, err error) {
+func (m *SecretMount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1296,59 +3435,32 @@ func (m *StartProcessRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StartProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretMount) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContainerID) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
-		i += copy(dAtA[i:], m.ContainerID)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Process != nil {
+	if len(m.Target) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Process.Size()))
-		n3, err := m.Process.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
 	}
-	if m.Console {
+	if m.Mode != 0 {
 		dAtA[i] = 0x18
 		i++
-		if m.Console {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.Stdin) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stdin)))
-		i += copy(dAtA[i:], m.Stdin)
-	}
-	if len(m.Stdout) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stdout)))
-		i += copy(dAtA[i:], m.Stdout)
-	}
-	if len(m.Stderr) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stderr)))
-		i += copy(dAtA[i:], m.Stderr)
+		i = encodeVarintExecution(dAtA, i, uint64(m.Mode))
 	}
 	return i, nil
 }
 
-func (m *StartProcessResponse) Marshal() (dAtA []byte, err error) {
+func (m *IDMapping) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1358,25 +3470,30 @@ func (m *StartProcessResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StartProcessResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *IDMapping) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Process != nil {
-		dAtA[i] = 0xa
+	if m.ContainerID != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Process.Size()))
-		n4, err := m.Process.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+		i = encodeVarintExecution(dAtA, i, uint64(m.ContainerID))
+	}
+	if m.HostID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.HostID))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Length))
 	}
 	return i, nil
 }
 
-func (m *Container) Marshal() (dAtA []byte, err error) {
+func (m *RuntimeOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1386,32 +3503,47 @@ func (m *Container) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Container) MarshalTo(dAtA []byte) (int, error) {
+func (m *RuntimeOptions) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
+	if m.SystemdCgroup {
+		dAtA[i] = 0x8
+		i++
+		if m.SystemdCgroup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
 	}
-	if len(m.BundlePath) > 0 {
+	if len(m.Root) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.BundlePath)))
-		i += copy(dAtA[i:], m.BundlePath)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
 	}
-	if m.Status != 0 {
+	if len(m.CriuPath) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.CriuPath)))
+		i += copy(dAtA[i:], m.CriuPath)
+	}
+	if m.Debug {
 		dAtA[i] = 0x20
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Status))
+		if m.Debug {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	return i, nil
 }
 
-func (m *Process) Marshal() (dAtA []byte, err error) {
+func (m *CreateContainerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1421,87 +3553,35 @@ func (m *Process) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Process) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateContainerResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
+	if m.Container != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
-	}
-	if m.Pid != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Pid))
-	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.Env) > 0 {
-		for _, s := range m.Env {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if m.User != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.User.Size()))
-		n5, err := m.User.MarshalTo(dAtA[i:])
+		i = encodeVarintExecution(dAtA, i, uint64(m.Container.Size()))
+		n2, err := m.Container.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
-	}
-	if len(m.Cwd) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.Cwd)))
-		i += copy(dAtA[i:], m.Cwd)
+		i += n2
 	}
-	if m.Terminal {
-		dAtA[i] = 0x38
+	if m.InitProcess != nil {
+		dAtA[i] = 0x12
 		i++
-		if m.Terminal {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintExecution(dAtA, i, uint64(m.InitProcess.Size()))
+		n3, err := m.InitProcess.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		i++
-	}
-	if m.ExitStatus != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.ExitStatus))
+		i += n3
 	}
 	return i, nil
 }
 
-func (m *User) Marshal() (dAtA []byte, err error) {
+func (m *DeleteContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1511,42 +3591,36 @@ func (m *User) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *User) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Uid != 0 {
-		dAtA[i] = 0x8
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Uid))
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	if m.Gid != 0 {
+	if m.Force {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Gid))
-	}
-	if len(m.AdditionalGids) > 0 {
-		dAtA7 := make([]byte, len(m.AdditionalGids)*10)
-		var j6 int
-		for _, num := range m.AdditionalGids {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(j6))
-		i += copy(dAtA[i:], dAtA7[:j6])
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Timeout))
 	}
 	return i, nil
 }
 
-func (m *GetContainerRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListContainersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1556,21 +3630,30 @@ func (m *GetContainerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListContainersRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if len(m.Owner) > 0 {
+		for _, s := range m.Owner {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	return i, nil
 }
 
-func (m *GetContainerResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListContainersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1580,25 +3663,27 @@ func (m *GetContainerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListContainersResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Container != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Container.Size()))
-		n8, err := m.Container.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Containers) > 0 {
+		for _, msg := range m.Containers {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n8
 	}
 	return i, nil
 }
 
-func (m *UpdateContainerRequest) Marshal() (dAtA []byte, err error) {
+func (m *StartProcessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1608,7 +3693,7 @@ func (m *UpdateContainerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartProcessRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1619,40 +3704,93 @@ func (m *UpdateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
 		i += copy(dAtA[i:], m.ContainerID)
 	}
-	if len(m.BundlePath) > 0 {
+	if m.Process != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.BundlePath)))
-		i += copy(dAtA[i:], m.BundlePath)
+		i = encodeVarintExecution(dAtA, i, uint64(m.Process.Size()))
+		n4, err := m.Process.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	return i, nil
-}
-
-func (m *PauseContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.Console {
+		dAtA[i] = 0x18
+		i++
+		if m.Console {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
+	if len(m.Stdin) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stdin)))
+		i += copy(dAtA[i:], m.Stdin)
+	}
+	if len(m.Stdout) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stdout)))
+		i += copy(dAtA[i:], m.Stdout)
+	}
+	if len(m.Stderr) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stderr)))
+		i += copy(dAtA[i:], m.Stderr)
+	}
+	if len(m.CapAdd) > 0 {
+		for _, s := range m.CapAdd {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.CapDrop) > 0 {
+		for _, s := range m.CapDrop {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Privileged {
+		dAtA[i] = 0x48
+		i++
+		if m.Privileged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	}
+	if m.NewPrivileges != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.NewPrivileges))
 	}
 	return i, nil
 }
 
-func (m *ResumeContainerRequest) Marshal() (dAtA []byte, err error) {
+func (m *StartProcessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1662,21 +3800,25 @@ func (m *ResumeContainerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResumeContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartProcessResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ID) > 0 {
+	if m.Process != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+		i = encodeVarintExecution(dAtA, i, uint64(m.Process.Size()))
+		n5, err := m.Process.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
 	return i, nil
 }
 
-func (m *GetProcessRequest) Marshal() (dAtA []byte, err error) {
+func (m *Container) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1686,27 +3828,53 @@ func (m *GetProcessRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Container) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContainerID) > 0 {
+	if len(m.ID) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
-		i += copy(dAtA[i:], m.ContainerID)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	if len(m.ProcessID) > 0 {
+	if len(m.BundlePath) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
-		i += copy(dAtA[i:], m.ProcessID)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.BundlePath)))
+		i += copy(dAtA[i:], m.BundlePath)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Sandbox) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Sandbox)))
+		i += copy(dAtA[i:], m.Sandbox)
+	}
+	if len(m.IPs) > 0 {
+		for _, s := range m.IPs {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	return i, nil
 }
 
-func (m *GetProcessResponse) Marshal() (dAtA []byte, err error) {
+func (m *Process) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1716,25 +3884,98 @@ func (m *GetProcessResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetProcessResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Process) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Process != nil {
+	if len(m.ID) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Process.Size()))
-		n9, err := m.Process.MarshalTo(dAtA[i:])
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Pid != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Pid))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.User != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.User.Size()))
+		n6, err := m.User.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n6
+	}
+	if len(m.Cwd) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Cwd)))
+		i += copy(dAtA[i:], m.Cwd)
+	}
+	if m.Terminal {
+		dAtA[i] = 0x38
+		i++
+		if m.Terminal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ExitStatus != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.ExitStatus))
+	}
+	if len(m.ApparmorProfile) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ApparmorProfile)))
+		i += copy(dAtA[i:], m.ApparmorProfile)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Status))
 	}
 	return i, nil
 }
 
-func (m *SignalProcessRequest) Marshal() (dAtA []byte, err error) {
+func (m *User) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1744,32 +3985,42 @@ func (m *SignalProcessRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignalProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContainerID) > 0 {
-		dAtA[i] = 0xa
+	if m.Uid != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
-		i += copy(dAtA[i:], m.ContainerID)
+		i = encodeVarintExecution(dAtA, i, uint64(m.Uid))
 	}
-	if len(m.ProcessID) > 0 {
-		dAtA[i] = 0x12
+	if m.Gid != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
-		i += copy(dAtA[i:], m.ProcessID)
+		i = encodeVarintExecution(dAtA, i, uint64(m.Gid))
 	}
-	if m.Signal != 0 {
-		dAtA[i] = 0x18
+	if len(m.AdditionalGids) > 0 {
+		dAtA8 := make([]byte, len(m.AdditionalGids)*10)
+		var j7 int
+		for _, num := range m.AdditionalGids {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(m.Signal))
+		i = encodeVarintExecution(dAtA, i, uint64(j7))
+		i += copy(dAtA[i:], dAtA8[:j7])
 	}
 	return i, nil
 }
 
-func (m *DeleteProcessRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1779,27 +4030,49 @@ func (m *DeleteProcessRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContainerID) > 0 {
+	if len(m.ID) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
-		i += copy(dAtA[i:], m.ContainerID)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	if len(m.ProcessID) > 0 {
-		dAtA[i] = 0x12
+	return i, nil
+}
+
+func (m *GetContainerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Container != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
-		i += copy(dAtA[i:], m.ProcessID)
+		i = encodeVarintExecution(dAtA, i, uint64(m.Container.Size()))
+		n9, err := m.Container.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
 	}
 	return i, nil
 }
 
-func (m *ListProcessesRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1809,7 +4082,7 @@ func (m *ListProcessesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListProcessesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1823,7 +4096,7 @@ func (m *ListProcessesRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListProcessesResponse) Marshal() (dAtA []byte, err error) {
+func (m *InspectContainerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1833,14 +4106,20 @@ func (m *ListProcessesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListProcessesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectContainerResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Processes) > 0 {
-		for _, msg := range m.Processes {
-			dAtA[i] = 0xa
+	if len(m.Spec) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Spec)))
+		i += copy(dAtA[i:], m.Spec)
+	}
+	if len(m.Stdio) > 0 {
+		for _, msg := range m.Stdio {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -1853,634 +4132,8935 @@ func (m *ListProcessesResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Execution(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Execution(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintExecution(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *StartContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+func (m *ProcessStdio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CreateContainerRequest) Size() (n int) {
+func (m *ProcessStdio) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
-	}
-	l = len(m.BundlePath)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.ProcessID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
+		i += copy(dAtA[i:], m.ProcessID)
 	}
-	if m.Console {
-		n += 2
+	if len(m.Stdin) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stdin)))
+		i += copy(dAtA[i:], m.Stdin)
 	}
-	l = len(m.Stdin)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.Stdout) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stdout)))
+		i += copy(dAtA[i:], m.Stdout)
 	}
-	l = len(m.Stdout)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.Stderr) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stderr)))
+		i += copy(dAtA[i:], m.Stderr)
 	}
-	l = len(m.Stderr)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	return i, nil
+}
+
+func (m *UpdateContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CreateContainerResponse) Size() (n int) {
+func (m *UpdateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Container != nil {
-		l = m.Container.Size()
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
 	}
-	if m.InitProcess != nil {
-		l = m.InitProcess.Size()
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.BundlePath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.BundlePath)))
+		i += copy(dAtA[i:], m.BundlePath)
 	}
-	return n
+	if len(m.Resources) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Resources)))
+		i += copy(dAtA[i:], m.Resources)
+	}
+	return i, nil
 }
 
-func (m *DeleteContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+func (m *CheckpointContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListContainersRequest) Size() (n int) {
+func (m *CheckpointContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		for _, s := range m.Owner {
-			l = len(s)
-			n += 1 + l + sovExecution(uint64(l))
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if m.Exit {
+		dAtA[i] = 0x18
+		i++
+		if m.Exit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
-}
-
-func (m *ListContainersResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Containers) > 0 {
-		for _, e := range m.Containers {
-			l = e.Size()
-			n += 1 + l + sovExecution(uint64(l))
+	if m.TCPEstablished {
+		dAtA[i] = 0x20
+		i++
+		if m.TCPEstablished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
-}
-
-func (m *StartProcessRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if m.UnixSockets {
+		dAtA[i] = 0x28
+		i++
+		if m.UnixSockets {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Process != nil {
-		l = m.Process.Size()
-		n += 1 + l + sovExecution(uint64(l))
+	if m.Shell {
+		dAtA[i] = 0x30
+		i++
+		if m.Shell {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Console {
-		n += 2
+	if len(m.EmptyNS) > 0 {
+		for _, s := range m.EmptyNS {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	l = len(m.Stdin)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if m.PreDump {
+		dAtA[i] = 0x40
+		i++
+		if m.PreDump {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.Stdout)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.ParentPath) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ParentPath)))
+		i += copy(dAtA[i:], m.ParentPath)
 	}
-	l = len(m.Stderr)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if m.PreDumps != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.PreDumps))
 	}
-	return n
+	if m.PreDumpThreshold != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.PreDumpThreshold))
+	}
+	return i, nil
 }
 
-func (m *StartProcessResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Process != nil {
-		l = m.Process.Size()
-		n += 1 + l + sovExecution(uint64(l))
+func (m *PauseContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Container) Size() (n int) {
+func (m *PauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
-	}
-	l = len(m.BundlePath)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovExecution(uint64(m.Status))
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Process) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
-	}
-	if m.Pid != 0 {
-		n += 1 + sovExecution(uint64(m.Pid))
-	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovExecution(uint64(l))
-		}
-	}
-	if len(m.Env) > 0 {
-		for _, s := range m.Env {
-			l = len(s)
-			n += 1 + l + sovExecution(uint64(l))
-		}
-	}
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovExecution(uint64(l))
-	}
-	l = len(m.Cwd)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
-	}
-	if m.Terminal {
-		n += 2
-	}
-	if m.ExitStatus != 0 {
-		n += 1 + sovExecution(uint64(m.ExitStatus))
+func (m *ResumeContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *User) Size() (n int) {
+func (m *ResumeContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Uid != 0 {
-		n += 1 + sovExecution(uint64(m.Uid))
-	}
-	if m.Gid != 0 {
-		n += 1 + sovExecution(uint64(m.Gid))
-	}
-	if len(m.AdditionalGids) > 0 {
-		l = 0
-		for _, e := range m.AdditionalGids {
-			l += sovExecution(uint64(e))
-		}
-		n += 1 + sovExecution(uint64(l)) + l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+func (m *GetProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetContainerResponse) Size() (n int) {
+func (m *GetProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Container != nil {
-		l = m.Container.Size()
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
 	}
-	return n
+	if len(m.ProcessID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
+		i += copy(dAtA[i:], m.ProcessID)
+	}
+	return i, nil
 }
 
-func (m *UpdateContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
-	}
-	l = len(m.BundlePath)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+func (m *GetProcessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PauseContainerRequest) Size() (n int) {
+func (m *GetProcessResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if m.Process != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Process.Size()))
+		n10, err := m.Process.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
-	return n
+	return i, nil
 }
 
-func (m *ResumeContainerRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+func (m *SignalProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetProcessRequest) Size() (n int) {
+func (m *SignalProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
 	}
-	l = len(m.ProcessID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.ProcessID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
+		i += copy(dAtA[i:], m.ProcessID)
 	}
-	return n
+	if m.Signal != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Signal))
+	}
+	if m.All {
+		dAtA[i] = 0x20
+		i++
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *GetProcessResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Process != nil {
-		l = m.Process.Size()
-		n += 1 + l + sovExecution(uint64(l))
+func (m *ResizeProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignalProcessRequest) Size() (n int) {
+func (m *ResizeProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
 	}
-	l = len(m.ProcessID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+	if len(m.ProcessID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
+		i += copy(dAtA[i:], m.ProcessID)
 	}
-	if m.Signal != 0 {
-		n += 1 + sovExecution(uint64(m.Signal))
+	if m.Width != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Width))
 	}
-	return n
+	if m.Height != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Height))
+	}
+	return i, nil
 }
 
-func (m *DeleteProcessRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ContainerID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
-	}
-	l = len(m.ProcessID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+func (m *DeleteProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListProcessesRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovExecution(uint64(l))
+func (m *DeleteProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
 	}
-	return n
+	if len(m.ProcessID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
+		i += copy(dAtA[i:], m.ProcessID)
+	}
+	return i, nil
 }
 
-func (m *ListProcessesResponse) Size() (n int) {
+func (m *ListProcessesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListProcessesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Processes) > 0 {
-		for _, e := range m.Processes {
-			l = e.Size()
-			n += 1 + l + sovExecution(uint64(l))
-		}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	return n
+	return i, nil
 }
 
-func sovExecution(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *ListProcessesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
-}
-func sozExecution(x uint64) (n int) {
-	return sovExecution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return dAtA[:n], nil
 }
-func (this *StartContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListProcessesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Processes) > 0 {
+		for _, msg := range m.Processes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	s := strings.Join([]string{`&StartContainerRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CreateContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.HostProcesses) > 0 {
+		for _, msg := range m.HostProcesses {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	s := strings.Join([]string{`&CreateContainerRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`BundlePath:` + fmt.Sprintf("%v", this.BundlePath) + `,`,
-		`Console:` + fmt.Sprintf("%v", this.Console) + `,`,
-		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
-		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
-		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *CreateContainerResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HostProcess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&CreateContainerResponse{`,
-		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "Container", 1) + `,`,
-		`InitProcess:` + strings.Replace(fmt.Sprintf("%v", this.InitProcess), "Process", "Process", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DeleteContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HostProcess) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Pid))
 	}
-	s := strings.Join([]string{`&DeleteContainerRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ListContainersRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	s := strings.Join([]string{`&ListContainersRequest{`,
-		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ListContainersResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ProcessID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
+		i += copy(dAtA[i:], m.ProcessID)
 	}
-	s := strings.Join([]string{`&ListContainersResponse{`,
-		`Containers:` + strings.Replace(fmt.Sprintf("%v", this.Containers), "Container", "Container", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *StartProcessRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetRuntimeLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&StartProcessRequest{`,
-		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
-		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "Process", "Process", 1) + `,`,
-		`Console:` + fmt.Sprintf("%v", this.Console) + `,`,
-		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
-		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
-		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *StartProcessResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetRuntimeLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
 	}
-	s := strings.Join([]string{`&StartProcessResponse{`,
-		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "Process", "Process", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Container) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ProcessID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ProcessID)))
+		i += copy(dAtA[i:], m.ProcessID)
 	}
-	s := strings.Join([]string{`&Container{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`BundlePath:` + fmt.Sprintf("%v", this.BundlePath) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *Process) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetRuntimeLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&Process{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
-		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
-		`Env:` + fmt.Sprintf("%v", this.Env) + `,`,
-		`User:` + strings.Replace(fmt.Sprintf("%v", this.User), "User", "User", 1) + `,`,
-		`Cwd:` + fmt.Sprintf("%v", this.Cwd) + `,`,
-		`Terminal:` + fmt.Sprintf("%v", this.Terminal) + `,`,
-		`ExitStatus:` + fmt.Sprintf("%v", this.ExitStatus) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *User) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetRuntimeLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, msg := range m.Logs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	s := strings.Join([]string{`&User{`,
-		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
-		`Gid:` + fmt.Sprintf("%v", this.Gid) + `,`,
-		`AdditionalGids:` + fmt.Sprintf("%v", this.AdditionalGids) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *GetContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RuntimeLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetContainerRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetContainerResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RuntimeLog) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
 	}
-	s := strings.Join([]string{`&GetContainerResponse{`,
-		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "Container", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Level) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Level)))
+		i += copy(dAtA[i:], m.Level)
 	}
-	s := strings.Join([]string{`&UpdateContainerRequest{`,
-		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
-		`BundlePath:` + fmt.Sprintf("%v", this.BundlePath) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PauseContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
 	}
-	s := strings.Join([]string{`&PauseContainerRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResumeContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Timestamp))
 	}
-	s := strings.Join([]string{`&ResumeContainerRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *GetProcessRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListRuntimesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetProcessRequest{`,
-		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
-		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetProcessResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListRuntimesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ListRuntimesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetProcessResponse{`,
-		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "Process", "Process", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *SignalProcessRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListRuntimesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runtimes) > 0 {
+		for _, msg := range m.Runtimes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	s := strings.Join([]string{`&SignalProcessRequest{`,
-		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
-		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
-		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *DeleteProcessRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RuntimeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DeleteProcessRequest{`,
-		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
-		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ListProcessesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RuntimeInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	s := strings.Join([]string{`&ListProcessesRequest{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Default {
+		dAtA[i] = 0x10
+		i++
+		if m.Default {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Capabilities != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Capabilities.Size()))
+		n11, err := m.Capabilities.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Features != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Features.Size()))
+		n12, err := m.Features.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
 }
-func (this *ListProcessesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RuntimeCapabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ListProcessesResponse{`,
-		`Processes:` + strings.Replace(fmt.Sprintf("%v", this.Processes), "Process", "Process", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func valueToStringExecution(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *RuntimeCapabilities) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pause {
+		dAtA[i] = 0x8
+		i++
+		if m.Pause {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	if m.Exec {
+		dAtA[i] = 0x10
+		i++
+		if m.Exec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Stats {
+		dAtA[i] = 0x18
+		i++
+		if m.Stats {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *RuntimeFeatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuntimeFeatures) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Commit) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Commit)))
+		i += copy(dAtA[i:], m.Commit)
+	}
+	if len(m.Spec) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Spec)))
+		i += copy(dAtA[i:], m.Spec)
+	}
+	if m.Seccomp {
+		dAtA[i] = 0x20
+		i++
+		if m.Seccomp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Libseccomp) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Libseccomp)))
+		i += copy(dAtA[i:], m.Libseccomp)
+	}
+	if m.CgroupV2 {
+		dAtA[i] = 0x30
+		i++
+		if m.CgroupV2 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Criu) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Criu)))
+		i += copy(dAtA[i:], m.Criu)
+	}
+	return i, nil
+}
+
+func (m *StatsContainerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsContainerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *StatsContainerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stats)))
+		i += copy(dAtA[i:], m.Stats)
+	}
+	return i, nil
+}
+
+func (m *KillSandboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KillSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Signal != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Signal))
+	}
+	return i, nil
+}
+
+func (m *SandboxStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SandboxStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *SandboxStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SandboxStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Stats)))
+		i += copy(dAtA[i:], m.Stats)
+	}
+	return i, nil
+}
+
+func (m *Sandbox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sandbox) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Pid != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Pid))
+	}
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if m.Running {
+		dAtA[i] = 0x20
+		i++
+		if m.Running {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.IPs) > 0 {
+		for _, s := range m.IPs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Containers) > 0 {
+		for _, s := range m.Containers {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *CreateSandboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	return i, nil
+}
+
+func (m *CreateSandboxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSandboxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sandbox != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Sandbox.Size()))
+		n13, err := m.Sandbox.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+
+func (m *DeleteSandboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *GetSandboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *GetSandboxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSandboxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sandbox != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Sandbox.Size()))
+		n14, err := m.Sandbox.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+
+func (m *ListSandboxesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSandboxesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ListSandboxesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSandboxesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sandboxes) > 0 {
+		for _, msg := range m.Sandboxes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *NetworkNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkNamespace) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.IPs) > 0 {
+		for _, s := range m.IPs {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *CreateNetworkNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateNetworkNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *CreateNetworkNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateNetworkNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NetworkNamespace != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.NetworkNamespace.Size()))
+		n15, err := m.NetworkNamespace.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+
+func (m *DeleteNetworkNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteNetworkNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *ListNetworkNamespacesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNetworkNamespacesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ListNetworkNamespacesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNetworkNamespacesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetworkNamespaces) > 0 {
+		for _, msg := range m.NetworkNamespaces {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ListPortsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPortsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	return i, nil
+}
+
+func (m *ListPortsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPortsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ports) > 0 {
+		for _, msg := range m.Ports {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExecution(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ForwardedPort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedPort) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContainerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerID)))
+		i += copy(dAtA[i:], m.ContainerID)
+	}
+	if m.Mapping != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(m.Mapping.Size()))
+		n16, err := m.Mapping.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if len(m.ContainerIP) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.ContainerIP)))
+		i += copy(dAtA[i:], m.ContainerIP)
+	}
+	return i, nil
+}
+
+func encodeFixed64Execution(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Execution(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintExecution(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *StartContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.BundlePath)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Console {
+		n += 2
+	}
+	l = len(m.Stdin)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.RuntimeOptions != nil {
+		l = m.RuntimeOptions.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Sandbox)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.PidsLimit != 0 {
+		n += 1 + sovExecution(uint64(m.PidsLimit))
+	}
+	l = len(m.CgroupParent)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.SeccompProfile)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.ApparmorProfile)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.NoSelinuxLabel {
+		n += 2
+	}
+	if len(m.UIDMappings) > 0 {
+		for _, e := range m.UIDMappings {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.GIDMappings) > 0 {
+		for _, e := range m.GIDMappings {
+			l = e.Size()
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.CapAdd) > 0 {
+		for _, s := range m.CapAdd {
+			l = len(s)
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.CapDrop) > 0 {
+		for _, s := range m.CapDrop {
+			l = len(s)
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	if m.Privileged {
+		n += 3
+	}
+	if len(m.MaskedPaths) > 0 {
+		for _, s := range m.MaskedPaths {
+			l = len(s)
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.ReadonlyPaths) > 0 {
+		for _, s := range m.ReadonlyPaths {
+			l = len(s)
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	if m.ReadonlyRootfs {
+		n += 3
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	l = len(m.Network)
+	if l > 0 {
+		n += 2 + l + sovExecution(uint64(l))
+	}
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 2 + l + sovExecution(uint64(l))
+	}
+	if len(m.ExtraHosts) > 0 {
+		for _, e := range m.ExtraHosts {
+			l = e.Size()
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.DNSServers) > 0 {
+		for _, s := range m.DNSServers {
+			l = len(s)
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.DNSSearch) > 0 {
+		for _, s := range m.DNSSearch {
+			l = len(s)
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.DNSOptions) > 0 {
+		for _, s := range m.DNSOptions {
+			l = len(s)
+			n += 2 + l + sovExecution(uint64(l))
+		}
+	}
+	l = len(m.NetNSPath)
+	if l > 0 {
+		n += 2 + l + sovExecution(uint64(l))
+	}
+	l = len(m.CheckpointPath)
+	if l > 0 {
+		n += 2 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *HostEntry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.IP)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PortMapping) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.HostIP)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.HostPort != 0 {
+		n += 1 + sovExecution(uint64(m.HostPort))
+	}
+	if m.ContainerPort != 0 {
+		n += 1 + sovExecution(uint64(m.ContainerPort))
+	}
+	return n
+}
+
+func (m *SecretMount) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovExecution(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *IDMapping) Size() (n int) {
+	var l int
+	_ = l
+	if m.ContainerID != 0 {
+		n += 1 + sovExecution(uint64(m.ContainerID))
+	}
+	if m.HostID != 0 {
+		n += 1 + sovExecution(uint64(m.HostID))
+	}
+	if m.Length != 0 {
+		n += 1 + sovExecution(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *RuntimeOptions) Size() (n int) {
+	var l int
+	_ = l
+	if m.SystemdCgroup {
+		n += 2
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.CriuPath)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Debug {
+		n += 2
+	}
+	return n
+}
+
+func (m *CreateContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.InitProcess != nil {
+		l = m.InitProcess.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovExecution(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *ListContainersRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		for _, s := range m.Owner {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListContainersResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StartProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Process != nil {
+		l = m.Process.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Console {
+		n += 2
+	}
+	l = len(m.Stdin)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if len(m.CapAdd) > 0 {
+		for _, s := range m.CapAdd {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.CapDrop) > 0 {
+		for _, s := range m.CapDrop {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if m.Privileged {
+		n += 2
+	}
+	if m.NewPrivileges != 0 {
+		n += 1 + sovExecution(uint64(m.NewPrivileges))
+	}
+	return n
+}
+
+func (m *StartProcessResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Process != nil {
+		l = m.Process.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *Container) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.BundlePath)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovExecution(uint64(m.Status))
+	}
+	l = len(m.Sandbox)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if len(m.IPs) > 0 {
+		for _, s := range m.IPs {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Process) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Pid != 0 {
+		n += 1 + sovExecution(uint64(m.Pid))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Cwd)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Terminal {
+		n += 2
+	}
+	if m.ExitStatus != 0 {
+		n += 1 + sovExecution(uint64(m.ExitStatus))
+	}
+	l = len(m.ApparmorProfile)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovExecution(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *User) Size() (n int) {
+	var l int
+	_ = l
+	if m.Uid != 0 {
+		n += 1 + sovExecution(uint64(m.Uid))
+	}
+	if m.Gid != 0 {
+		n += 1 + sovExecution(uint64(m.Gid))
+	}
+	if len(m.AdditionalGids) > 0 {
+		l = 0
+		for _, e := range m.AdditionalGids {
+			l += sovExecution(uint64(e))
+		}
+		n += 1 + sovExecution(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GetContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *GetContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *InspectContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *InspectContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if len(m.Stdio) > 0 {
+		for _, e := range m.Stdio {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProcessStdio) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ProcessID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Stdin)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.BundlePath)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Resources)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Exit {
+		n += 2
+	}
+	if m.TCPEstablished {
+		n += 2
+	}
+	if m.UnixSockets {
+		n += 2
+	}
+	if m.Shell {
+		n += 2
+	}
+	if len(m.EmptyNS) > 0 {
+		for _, s := range m.EmptyNS {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if m.PreDump {
+		n += 2
+	}
+	l = len(m.ParentPath)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.PreDumps != 0 {
+		n += 1 + sovExecution(uint64(m.PreDumps))
+	}
+	if m.PreDumpThreshold != 0 {
+		n += 1 + sovExecution(uint64(m.PreDumpThreshold))
+	}
+	return n
+}
+
+func (m *PauseContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *GetProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.ProcessID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *GetProcessResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Process != nil {
+		l = m.Process.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *SignalProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.ProcessID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Signal != 0 {
+		n += 1 + sovExecution(uint64(m.Signal))
+	}
+	if m.All {
+		n += 2
+	}
+	return n
+}
+
+func (m *ResizeProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.ProcessID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Width != 0 {
+		n += 1 + sovExecution(uint64(m.Width))
+	}
+	if m.Height != 0 {
+		n += 1 + sovExecution(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DeleteProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.ProcessID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *ListProcessesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *ListProcessesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Processes) > 0 {
+		for _, e := range m.Processes {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.HostProcesses) > 0 {
+		for _, e := range m.HostProcesses {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HostProcess) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		n += 1 + sovExecution(uint64(m.Pid))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	l = len(m.ProcessID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRuntimeLogsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.ProcessID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRuntimeLogsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RuntimeLog) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovExecution(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *ListRuntimesRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListRuntimesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Runtimes) > 0 {
+		for _, e := range m.Runtimes {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RuntimeInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Default {
+		n += 2
+	}
+	if m.Capabilities != nil {
+		l = m.Capabilities.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Features != nil {
+		l = m.Features.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *RuntimeCapabilities) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pause {
+		n += 2
+	}
+	if m.Exec {
+		n += 2
+	}
+	if m.Stats {
+		n += 2
+	}
+	return n
+}
+
+func (m *RuntimeFeatures) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Seccomp {
+		n += 2
+	}
+	l = len(m.Libseccomp)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.CgroupV2 {
+		n += 2
+	}
+	l = len(m.Criu)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *StatsContainerRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *StatsContainerResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Stats)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *KillSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Signal != 0 {
+		n += 1 + sovExecution(uint64(m.Signal))
+	}
+	return n
+}
+
+func (m *SandboxStatsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *SandboxStatsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Stats)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *Sandbox) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Pid != 0 {
+		n += 1 + sovExecution(uint64(m.Pid))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Running {
+		n += 2
+	}
+	if len(m.IPs) > 0 {
+		for _, s := range m.IPs {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if len(m.Containers) > 0 {
+		for _, s := range m.Containers {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateSandboxResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Sandbox != nil {
+		l = m.Sandbox.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSandboxRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSandboxResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Sandbox != nil {
+		l = m.Sandbox.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *ListSandboxesRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListSandboxesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Sandboxes) > 0 {
+		for _, e := range m.Sandboxes {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetworkNamespace) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if len(m.IPs) > 0 {
+		for _, s := range m.IPs {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateNetworkNamespaceRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateNetworkNamespaceResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.NetworkNamespace != nil {
+		l = m.NetworkNamespace.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteNetworkNamespaceRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *ListNetworkNamespacesRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListNetworkNamespacesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.NetworkNamespaces) > 0 {
+		for _, e := range m.NetworkNamespaces {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListPortsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *ListPortsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForwardedPort) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ContainerID)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Mapping != nil {
+		l = m.Mapping.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	l = len(m.ContainerIP)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func sovExecution(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozExecution(x uint64) (n int) {
+	return sovExecution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *StartContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartContainerRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateContainerRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`BundlePath:` + fmt.Sprintf("%v", this.BundlePath) + `,`,
+		`Console:` + fmt.Sprintf("%v", this.Console) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
+		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+		`RuntimeOptions:` + strings.Replace(fmt.Sprintf("%v", this.RuntimeOptions), "RuntimeOptions", "RuntimeOptions", 1) + `,`,
+		`Runtime:` + fmt.Sprintf("%v", this.Runtime) + `,`,
+		`Sandbox:` + fmt.Sprintf("%v", this.Sandbox) + `,`,
+		`PidsLimit:` + fmt.Sprintf("%v", this.PidsLimit) + `,`,
+		`CgroupParent:` + fmt.Sprintf("%v", this.CgroupParent) + `,`,
+		`SeccompProfile:` + fmt.Sprintf("%v", this.SeccompProfile) + `,`,
+		`ApparmorProfile:` + fmt.Sprintf("%v", this.ApparmorProfile) + `,`,
+		`NoSelinuxLabel:` + fmt.Sprintf("%v", this.NoSelinuxLabel) + `,`,
+		`UIDMappings:` + strings.Replace(fmt.Sprintf("%v", this.UIDMappings), "IDMapping", "IDMapping", 1) + `,`,
+		`GIDMappings:` + strings.Replace(fmt.Sprintf("%v", this.GIDMappings), "IDMapping", "IDMapping", 1) + `,`,
+		`CapAdd:` + fmt.Sprintf("%v", this.CapAdd) + `,`,
+		`CapDrop:` + fmt.Sprintf("%v", this.CapDrop) + `,`,
+		`Privileged:` + fmt.Sprintf("%v", this.Privileged) + `,`,
+		`MaskedPaths:` + fmt.Sprintf("%v", this.MaskedPaths) + `,`,
+		`ReadonlyPaths:` + fmt.Sprintf("%v", this.ReadonlyPaths) + `,`,
+		`ReadonlyRootfs:` + fmt.Sprintf("%v", this.ReadonlyRootfs) + `,`,
+		`Secrets:` + strings.Replace(fmt.Sprintf("%v", this.Secrets), "SecretMount", "SecretMount", 1) + `,`,
+		`Network:` + fmt.Sprintf("%v", this.Network) + `,`,
+		`Ports:` + strings.Replace(fmt.Sprintf("%v", this.Ports), "PortMapping", "PortMapping", 1) + `,`,
+		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
+		`ExtraHosts:` + strings.Replace(fmt.Sprintf("%v", this.ExtraHosts), "HostEntry", "HostEntry", 1) + `,`,
+		`DNSServers:` + fmt.Sprintf("%v", this.DNSServers) + `,`,
+		`DNSSearch:` + fmt.Sprintf("%v", this.DNSSearch) + `,`,
+		`DNSOptions:` + fmt.Sprintf("%v", this.DNSOptions) + `,`,
+		`NetNSPath:` + fmt.Sprintf("%v", this.NetNSPath) + `,`,
+		`CheckpointPath:` + fmt.Sprintf("%v", this.CheckpointPath) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HostEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HostEntry{`,
+		`IP:` + fmt.Sprintf("%v", this.IP) + `,`,
+		`Names:` + fmt.Sprintf("%v", this.Names) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PortMapping) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PortMapping{`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`HostIP:` + fmt.Sprintf("%v", this.HostIP) + `,`,
+		`HostPort:` + fmt.Sprintf("%v", this.HostPort) + `,`,
+		`ContainerPort:` + fmt.Sprintf("%v", this.ContainerPort) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SecretMount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SecretMount{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IDMapping) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IDMapping{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`HostID:` + fmt.Sprintf("%v", this.HostID) + `,`,
+		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RuntimeOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuntimeOptions{`,
+		`SystemdCgroup:` + fmt.Sprintf("%v", this.SystemdCgroup) + `,`,
+		`Root:` + fmt.Sprintf("%v", this.Root) + `,`,
+		`CriuPath:` + fmt.Sprintf("%v", this.CriuPath) + `,`,
+		`Debug:` + fmt.Sprintf("%v", this.Debug) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateContainerResponse{`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "Container", 1) + `,`,
+		`InitProcess:` + strings.Replace(fmt.Sprintf("%v", this.InitProcess), "Process", "Process", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteContainerRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListContainersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListContainersRequest{`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListContainersResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListContainersResponse{`,
+		`Containers:` + strings.Replace(fmt.Sprintf("%v", this.Containers), "Container", "Container", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartProcessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartProcessRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "Process", "Process", 1) + `,`,
+		`Console:` + fmt.Sprintf("%v", this.Console) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
+		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+		`CapAdd:` + fmt.Sprintf("%v", this.CapAdd) + `,`,
+		`CapDrop:` + fmt.Sprintf("%v", this.CapDrop) + `,`,
+		`Privileged:` + fmt.Sprintf("%v", this.Privileged) + `,`,
+		`NewPrivileges:` + fmt.Sprintf("%v", this.NewPrivileges) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartProcessResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartProcessResponse{`,
+		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "Process", "Process", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Container) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Container{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`BundlePath:` + fmt.Sprintf("%v", this.BundlePath) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Sandbox:` + fmt.Sprintf("%v", this.Sandbox) + `,`,
+		`IPs:` + fmt.Sprintf("%v", this.IPs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Process) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Process{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`Env:` + fmt.Sprintf("%v", this.Env) + `,`,
+		`User:` + strings.Replace(fmt.Sprintf("%v", this.User), "User", "User", 1) + `,`,
+		`Cwd:` + fmt.Sprintf("%v", this.Cwd) + `,`,
+		`Terminal:` + fmt.Sprintf("%v", this.Terminal) + `,`,
+		`ExitStatus:` + fmt.Sprintf("%v", this.ExitStatus) + `,`,
+		`ApparmorProfile:` + fmt.Sprintf("%v", this.ApparmorProfile) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *User) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&User{`,
+		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
+		`Gid:` + fmt.Sprintf("%v", this.Gid) + `,`,
+		`AdditionalGids:` + fmt.Sprintf("%v", this.AdditionalGids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetContainerRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetContainerResponse{`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "Container", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InspectContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InspectContainerRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InspectContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InspectContainerResponse{`,
+		`Spec:` + fmt.Sprintf("%v", this.Spec) + `,`,
+		`Stdio:` + strings.Replace(fmt.Sprintf("%v", this.Stdio), "ProcessStdio", "ProcessStdio", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProcessStdio) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProcessStdio{`,
+		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
+		`Stdin:` + fmt.Sprintf("%v", this.Stdin) + `,`,
+		`Stdout:` + fmt.Sprintf("%v", this.Stdout) + `,`,
+		`Stderr:` + fmt.Sprintf("%v", this.Stderr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateContainerRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`BundlePath:` + fmt.Sprintf("%v", this.BundlePath) + `,`,
+		`Resources:` + fmt.Sprintf("%v", this.Resources) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckpointContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckpointContainerRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Exit:` + fmt.Sprintf("%v", this.Exit) + `,`,
+		`TCPEstablished:` + fmt.Sprintf("%v", this.TCPEstablished) + `,`,
+		`UnixSockets:` + fmt.Sprintf("%v", this.UnixSockets) + `,`,
+		`Shell:` + fmt.Sprintf("%v", this.Shell) + `,`,
+		`EmptyNS:` + fmt.Sprintf("%v", this.EmptyNS) + `,`,
+		`PreDump:` + fmt.Sprintf("%v", this.PreDump) + `,`,
+		`ParentPath:` + fmt.Sprintf("%v", this.ParentPath) + `,`,
+		`PreDumps:` + fmt.Sprintf("%v", this.PreDumps) + `,`,
+		`PreDumpThreshold:` + fmt.Sprintf("%v", this.PreDumpThreshold) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseContainerRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResumeContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResumeContainerRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetProcessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetProcessRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetProcessResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetProcessResponse{`,
+		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "Process", "Process", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalProcessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalProcessRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
+		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
+		`All:` + fmt.Sprintf("%v", this.All) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResizeProcessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResizeProcessRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
+		`Width:` + fmt.Sprintf("%v", this.Width) + `,`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteProcessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteProcessRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListProcessesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListProcessesRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListProcessesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListProcessesResponse{`,
+		`Processes:` + strings.Replace(fmt.Sprintf("%v", this.Processes), "Process", "Process", 1) + `,`,
+		`HostProcesses:` + strings.Replace(fmt.Sprintf("%v", this.HostProcesses), "HostProcess", "HostProcess", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HostProcess) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HostProcess{`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRuntimeLogsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetRuntimeLogsRequest{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`ProcessID:` + fmt.Sprintf("%v", this.ProcessID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRuntimeLogsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetRuntimeLogsResponse{`,
+		`Logs:` + strings.Replace(fmt.Sprintf("%v", this.Logs), "RuntimeLog", "RuntimeLog", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RuntimeLog) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuntimeLog{`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListRuntimesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListRuntimesRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListRuntimesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListRuntimesResponse{`,
+		`Runtimes:` + strings.Replace(fmt.Sprintf("%v", this.Runtimes), "RuntimeInfo", "RuntimeInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RuntimeInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuntimeInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Default:` + fmt.Sprintf("%v", this.Default) + `,`,
+		`Capabilities:` + strings.Replace(fmt.Sprintf("%v", this.Capabilities), "RuntimeCapabilities", "RuntimeCapabilities", 1) + `,`,
+		`Features:` + strings.Replace(fmt.Sprintf("%v", this.Features), "RuntimeFeatures", "RuntimeFeatures", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RuntimeCapabilities) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuntimeCapabilities{`,
+		`Pause:` + fmt.Sprintf("%v", this.Pause) + `,`,
+		`Exec:` + fmt.Sprintf("%v", this.Exec) + `,`,
+		`Stats:` + fmt.Sprintf("%v", this.Stats) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RuntimeFeatures) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuntimeFeatures{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Commit:` + fmt.Sprintf("%v", this.Commit) + `,`,
+		`Spec:` + fmt.Sprintf("%v", this.Spec) + `,`,
+		`Seccomp:` + fmt.Sprintf("%v", this.Seccomp) + `,`,
+		`Libseccomp:` + fmt.Sprintf("%v", this.Libseccomp) + `,`,
+		`CgroupV2:` + fmt.Sprintf("%v", this.CgroupV2) + `,`,
+		`Criu:` + fmt.Sprintf("%v", this.Criu) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatsContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatsContainerRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatsContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatsContainerResponse{`,
+		`Stats:` + fmt.Sprintf("%v", this.Stats) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KillSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KillSandboxRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SandboxStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SandboxStatsRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SandboxStatsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SandboxStatsResponse{`,
+		`Stats:` + fmt.Sprintf("%v", this.Stats) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Sandbox) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Sandbox{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
+		`Running:` + fmt.Sprintf("%v", this.Running) + `,`,
+		`IPs:` + fmt.Sprintf("%v", this.IPs) + `,`,
+		`Containers:` + fmt.Sprintf("%v", this.Containers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateSandboxRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateSandboxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateSandboxResponse{`,
+		`Sandbox:` + strings.Replace(fmt.Sprintf("%v", this.Sandbox), "Sandbox", "Sandbox", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteSandboxRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSandboxRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSandboxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSandboxResponse{`,
+		`Sandbox:` + strings.Replace(fmt.Sprintf("%v", this.Sandbox), "Sandbox", "Sandbox", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListSandboxesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListSandboxesRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListSandboxesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListSandboxesResponse{`,
+		`Sandboxes:` + strings.Replace(fmt.Sprintf("%v", this.Sandboxes), "Sandbox", "Sandbox", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkNamespace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkNamespace{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`IPs:` + fmt.Sprintf("%v", this.IPs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateNetworkNamespaceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateNetworkNamespaceRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateNetworkNamespaceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateNetworkNamespaceResponse{`,
+		`NetworkNamespace:` + strings.Replace(fmt.Sprintf("%v", this.NetworkNamespace), "NetworkNamespace", "NetworkNamespace", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteNetworkNamespaceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteNetworkNamespaceRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListNetworkNamespacesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListNetworkNamespacesRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListNetworkNamespacesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListNetworkNamespacesResponse{`,
+		`NetworkNamespaces:` + strings.Replace(fmt.Sprintf("%v", this.NetworkNamespaces), "NetworkNamespace", "NetworkNamespace", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPortsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListPortsRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListPortsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListPortsResponse{`,
+		`Ports:` + strings.Replace(fmt.Sprintf("%v", this.Ports), "ForwardedPort", "ForwardedPort", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ForwardedPort) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ForwardedPort{`,
+		`ContainerID:` + fmt.Sprintf("%v", this.ContainerID) + `,`,
+		`Mapping:` + strings.Replace(fmt.Sprintf("%v", this.Mapping), "PortMapping", "PortMapping", 1) + `,`,
+		`ContainerIP:` + fmt.Sprintf("%v", this.ContainerIP) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringExecution(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundlePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Console", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Console = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stderr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RuntimeOptions == nil {
+				m.RuntimeOptions = &RuntimeOptions{}
+			}
+			if err := m.RuntimeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sandbox = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidsLimit", wireType)
+			}
+			m.PidsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PidsLimit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CgroupParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CgroupParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeccompProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeccompProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApparmorProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApparmorProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoSelinuxLabel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoSelinuxLabel = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UIDMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UIDMappings = append(m.UIDMappings, &IDMapping{})
+			if err := m.UIDMappings[len(m.UIDMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GIDMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GIDMappings = append(m.GIDMappings, &IDMapping{})
+			if err := m.GIDMappings[len(m.GIDMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapAdd = append(m.CapAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapDrop", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapDrop = append(m.CapDrop, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Privileged = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaskedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaskedPaths = append(m.MaskedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadonlyPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadonlyPaths = append(m.ReadonlyPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadonlyRootfs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadonlyRootfs = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &SecretMount{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Network = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, &PortMapping{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraHosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraHosts = append(m.ExtraHosts, &HostEntry{})
+			if err := m.ExtraHosts[len(m.ExtraHosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSServers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DNSServers = append(m.DNSServers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSSearch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DNSSearch = append(m.DNSSearch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DNSOptions = append(m.DNSOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetNSPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetNSPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostPort", wireType)
+			}
+			m.HostPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HostPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPort", wireType)
+			}
+			m.ContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretMount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretMount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretMount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			m.ContainerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContainerID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostID", wireType)
+			}
+			m.HostID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HostID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemdCgroup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SystemdCgroup = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriuPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CriuPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitProcess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitProcess == nil {
+				m.InitProcess = &Process{}
+			}
+			if err := m.InitProcess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListContainersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListContainersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListContainersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListContainersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListContainersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, &Container{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Process == nil {
+				m.Process = &Process{}
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Console", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Console = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stderr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapAdd = append(m.CapAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapDrop", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapDrop = append(m.CapDrop, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Privileged = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPrivileges", wireType)
+			}
+			m.NewPrivileges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPrivileges |= (NewPrivileges(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartProcessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartProcessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Process == nil {
+				m.Process = &Process{}
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Container) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundlePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sandbox = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IPs = append(m.IPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Process) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pid |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &User{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cwd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cwd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminal = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitStatus", wireType)
+			}
+			m.ExitStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitStatus |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApparmorProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApparmorProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uid |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+			}
+			m.Gid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gid |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecution
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthExecution
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecution
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AdditionalGids = append(m.AdditionalGids, v)
+				}
+			} else if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecution
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AdditionalGids = append(m.AdditionalGids, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalGids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectContainerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectContainerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = append(m.Spec[:0], dAtA[iNdEx:postIndex]...)
+			if m.Spec == nil {
+				m.Spec = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdio = append(m.Stdio, &ProcessStdio{})
+			if err := m.Stdio[len(m.Stdio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessStdio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessStdio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessStdio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stderr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundlePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundlePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources[:0], dAtA[iNdEx:postIndex]...)
+			if m.Resources == nil {
+				m.Resources = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exit = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPEstablished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TCPEstablished = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixSockets", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnixSockets = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shell = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyNS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmptyNS = append(m.EmptyNS, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDump", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreDump = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDumps", wireType)
+			}
+			m.PreDumps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreDumps |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDumpThreshold", wireType)
+			}
+			m.PreDumpThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreDumpThreshold |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProcessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProcessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Process == nil {
+				m.Process = &Process{}
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			m.Signal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Signal |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResizeProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResizeProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResizeProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			m.Width = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Width |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProcessesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProcessesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListProcessesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListProcessesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListProcessesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Processes = append(m.Processes, &Process{})
+			if err := m.Processes[len(m.Processes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostProcesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostProcesses = append(m.HostProcesses, &HostProcess{})
+			if err := m.HostProcesses[len(m.HostProcesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostProcess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostProcess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostProcess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pid |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRuntimeLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRuntimeLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRuntimeLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRuntimeLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRuntimeLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRuntimeLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &RuntimeLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRuntimesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRuntimesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRuntimesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *ListRuntimesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2503,17 +13083,17 @@ func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRuntimesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRuntimesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtimes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -2523,20 +13103,22 @@ func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Runtimes = append(m.Runtimes, &RuntimeInfo{})
+			if err := m.Runtimes[len(m.Runtimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2559,7 +13141,7 @@ func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *RuntimeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2582,15 +13164,15 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuntimeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuntimeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2615,13 +13197,13 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundlePath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -2631,26 +13213,17 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BundlePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Default = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Console", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -2660,17 +13233,30 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Console = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capabilities == nil {
+				m.Capabilities = &RuntimeCapabilities{}
+			}
+			if err := m.Capabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -2680,26 +13266,80 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stdin = string(dAtA[iNdEx:postIndex])
+			if m.Features == nil {
+				m.Features = &RuntimeFeatures{}
+			}
+			if err := m.Features.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeCapabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeCapabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -2709,26 +13349,17 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stdout = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			m.Pause = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -2738,21 +13369,32 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecution
+			m.Exec = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Stderr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Stats = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(dAtA[iNdEx:])
@@ -2774,7 +13416,7 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateContainerResponse) Unmarshal(dAtA []byte) error {
+func (m *RuntimeFeatures) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2797,17 +13439,104 @@ func (m *CreateContainerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateContainerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuntimeFeatures: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuntimeFeatures: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seccomp", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -2817,30 +13546,17 @@ func (m *CreateContainerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Container == nil {
-				m.Container = &Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.Seccomp = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitProcess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Libseccomp", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -2850,78 +13566,44 @@ func (m *CreateContainerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitProcess == nil {
-				m.InitProcess = &Process{}
-			}
-			if err := m.InitProcess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Libseccomp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExecution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthExecution
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExecution
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CgroupV2", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CgroupV2 = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Criu", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2946,7 +13628,7 @@ func (m *DeleteContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Criu = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2969,7 +13651,7 @@ func (m *DeleteContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListContainersRequest) Unmarshal(dAtA []byte) error {
+func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2992,15 +13674,15 @@ func (m *ListContainersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListContainersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsContainerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListContainersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3025,7 +13707,7 @@ func (m *ListContainersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner, string(dAtA[iNdEx:postIndex]))
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3048,7 +13730,7 @@ func (m *ListContainersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
+func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3071,17 +13753,17 @@ func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListContainersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsContainerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListContainersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -3091,21 +13773,21 @@ func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Containers = append(m.Containers, &Container{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Stats = append(m.Stats[:0], dAtA[iNdEx:postIndex]...)
+			if m.Stats == nil {
+				m.Stats = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3129,7 +13811,7 @@ func (m *ListContainersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *KillSandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3152,15 +13834,15 @@ func (m *StartProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KillSandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KillSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3185,124 +13867,13 @@ func (m *StartProcessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Process == nil {
-				m.Process = &Process{}
-			}
-			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Console", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Console = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stdin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stdout = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
 			}
-			var stringLen uint64
+			m.Signal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -3312,21 +13883,11 @@ func (m *StartProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				m.Signal |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Stderr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(dAtA[iNdEx:])
@@ -3348,7 +13909,7 @@ func (m *StartProcessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartProcessResponse) Unmarshal(dAtA []byte) error {
+func (m *SandboxStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3371,17 +13932,17 @@ func (m *StartProcessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartProcessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SandboxStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SandboxStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -3391,24 +13952,20 @@ func (m *StartProcessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Process == nil {
-				m.Process = &Process{}
-			}
-			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3431,7 +13988,7 @@ func (m *StartProcessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Container) Unmarshal(dAtA []byte) error {
+func (m *SandboxStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3454,17 +14011,17 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+			return fmt.Errorf("proto: SandboxStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SandboxStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -3474,69 +14031,23 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundlePath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Stats = append(m.Stats[:0], dAtA[iNdEx:postIndex]...)
+			if m.Stats == nil {
+				m.Stats = []byte{}
 			}
-			m.BundlePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= (Status(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(dAtA[iNdEx:])
@@ -3558,7 +14069,7 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Process) Unmarshal(dAtA []byte) error {
+func (m *Sandbox) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3581,10 +14092,10 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sandbox: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sandbox: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3637,7 +14148,7 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3662,13 +14173,13 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -3678,26 +14189,17 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Running = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IPs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -3707,28 +14209,24 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &User{}
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IPs = append(m.IPs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cwd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3753,47 +14251,8 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cwd = string(dAtA[iNdEx:postIndex])
+			m.Containers = append(m.Containers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminal", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Terminal = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitStatus", wireType)
-			}
-			m.ExitStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExitStatus |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(dAtA[iNdEx:])
@@ -3815,7 +14274,7 @@ func (m *Process) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *User) Unmarshal(dAtA []byte) error {
+func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3838,17 +14297,17 @@ func (m *User) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Uid = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -3858,16 +14317,26 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uid |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
-			m.Gid = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -3877,73 +14346,21 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gid |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowExecution
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthExecution
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowExecution
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AdditionalGids = append(m.AdditionalGids, v)
-				}
-			} else if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowExecution
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AdditionalGids = append(m.AdditionalGids, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalGids", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(dAtA[iNdEx:])
@@ -3965,7 +14382,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateSandboxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3988,17 +14405,17 @@ func (m *GetContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSandboxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -4008,20 +14425,24 @@ func (m *GetContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Sandbox == nil {
+				m.Sandbox = &Sandbox{}
+			}
+			if err := m.Sandbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4044,7 +14465,7 @@ func (m *GetContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetContainerResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteSandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4067,17 +14488,17 @@ func (m *GetContainerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetContainerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteSandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -4087,24 +14508,20 @@ func (m *GetContainerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4127,7 +14544,7 @@ func (m *GetContainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *GetSandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4150,44 +14567,15 @@ func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundlePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4212,7 +14600,7 @@ func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundlePath = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4235,7 +14623,7 @@ func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *GetSandboxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4258,17 +14646,17 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSandboxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSandboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -4278,20 +14666,24 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Sandbox == nil {
+				m.Sandbox = &Sandbox{}
+			}
+			if err := m.Sandbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4314,7 +14706,7 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *ListSandboxesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4337,17 +14729,67 @@ func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResumeContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSandboxesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResumeContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSandboxesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSandboxesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSandboxesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSandboxesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandboxes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -4357,20 +14799,22 @@ func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Sandboxes = append(m.Sandboxes, &Sandbox{})
+			if err := m.Sandboxes[len(m.Sandboxes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4393,7 +14837,7 @@ func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *NetworkNamespace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4416,15 +14860,73 @@ func (m *GetProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkNamespace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4449,11 +14951,61 @@ func (m *GetProcessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.IPs = append(m.IPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateNetworkNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateNetworkNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateNetworkNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4478,7 +15030,7 @@ func (m *GetProcessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4501,7 +15053,7 @@ func (m *GetProcessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetProcessResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateNetworkNamespaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4524,15 +15076,15 @@ func (m *GetProcessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetProcessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateNetworkNamespaceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateNetworkNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkNamespace", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4556,10 +15108,10 @@ func (m *GetProcessResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Process == nil {
-				m.Process = &Process{}
+			if m.NetworkNamespace == nil {
+				m.NetworkNamespace = &NetworkNamespace{}
 			}
-			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetworkNamespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4584,7 +15136,7 @@ func (m *GetProcessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteNetworkNamespaceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4607,15 +15159,15 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteNetworkNamespaceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteNetworkNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4640,56 +15192,58 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListNetworkNamespacesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
 			}
-			m.Signal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Signal |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListNetworkNamespacesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListNetworkNamespacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(dAtA[iNdEx:])
@@ -4711,7 +15265,7 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *ListNetworkNamespacesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4734,17 +15288,17 @@ func (m *DeleteProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListNetworkNamespacesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListNetworkNamespacesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkNamespaces", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -4754,24 +15308,76 @@ func (m *DeleteProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			m.NetworkNamespaces = append(m.NetworkNamespaces, &NetworkNamespace{})
+			if err := m.NetworkNamespaces[len(m.NetworkNamespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPortsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPortsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPortsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4796,7 +15402,7 @@ func (m *DeleteProcessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessID = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4819,7 +15425,7 @@ func (m *DeleteProcessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
+func (m *ListPortsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4842,17 +15448,17 @@ func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListProcessesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPortsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListProcessesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPortsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecution
@@ -4862,20 +15468,22 @@ func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Ports = append(m.Ports, &ForwardedPort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4898,7 +15506,7 @@ func (m *ListProcessesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListProcessesResponse) Unmarshal(dAtA []byte) error {
+func (m *ForwardedPort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4921,15 +15529,44 @@ func (m *ListProcessesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListProcessesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardedPort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListProcessesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardedPort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Processes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4953,11 +15590,42 @@ func (m *ListProcessesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Processes = append(m.Processes, &Process{})
-			if err := m.Processes[len(m.Processes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Mapping == nil {
+				m.Mapping = &PortMapping{}
+			}
+			if err := m.Mapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecution(dAtA[iNdEx:])
@@ -5087,68 +15755,203 @@ var (
 func init() { proto.RegisterFile("execution.proto", fileDescriptorExecution) }
 
 var fileDescriptorExecution = []byte{
-	// 1007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbc, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xae, 0xf3, 0xdb, 0x9c, 0xac, 0x77, 0xc3, 0x34, 0x35, 0x56, 0x40, 0x69, 0x31, 0xbb, 0x4b,
-	0x85, 0x68, 0x5a, 0x02, 0x42, 0x2b, 0x71, 0xb5, 0x6d, 0x42, 0x88, 0x54, 0x42, 0x98, 0x6c, 0xb4,
-	0x12, 0x37, 0x95, 0x1b, 0x0f, 0x59, 0x4b, 0xa9, 0x1d, 0x3c, 0xe3, 0x6e, 0xb9, 0xe3, 0x9e, 0x1b,
-	0x5e, 0x80, 0x87, 0xe0, 0x2d, 0xf6, 0x12, 0xee, 0x90, 0x90, 0x2a, 0x9a, 0x27, 0xe0, 0x11, 0xd0,
-	0x8c, 0xc7, 0xce, 0x8f, 0x9d, 0x1f, 0x15, 0xe8, 0xdd, 0xcc, 0x99, 0x6f, 0xce, 0x7c, 0xe7, 0xcc,
-	0x9c, 0x6f, 0x0e, 0x3c, 0x22, 0xd7, 0x64, 0xe0, 0x33, 0xdb, 0x75, 0x6a, 0x63, 0xcf, 0x65, 0x2e,
-	0x52, 0x07, 0xae, 0xc3, 0x4c, 0xdb, 0x21, 0x9e, 0x55, 0xbb, 0xfa, 0xb8, 0xf2, 0xce, 0xd0, 0x75,
-	0x87, 0x23, 0x72, 0x24, 0x16, 0x2f, 0xfc, 0xef, 0x8e, 0xc8, 0xe5, 0x98, 0xfd, 0x10, 0x60, 0x2b,
-	0xe5, 0xa1, 0x3b, 0x74, 0xc5, 0xf0, 0x88, 0x8f, 0x02, 0xab, 0x71, 0x04, 0xbb, 0x3d, 0x66, 0x7a,
-	0xec, 0x34, 0x74, 0x84, 0xc9, 0xf7, 0x3e, 0xa1, 0x0c, 0x69, 0x90, 0xb2, 0x2d, 0x5d, 0xd9, 0x57,
-	0x0e, 0x0a, 0x27, 0xb9, 0xc9, 0xcd, 0x5e, 0xaa, 0xdd, 0xc0, 0x29, 0xdb, 0x32, 0x7e, 0x55, 0x40,
-	0x3b, 0xf5, 0x88, 0xc9, 0xc8, 0xa6, 0x5b, 0xd0, 0x1e, 0x14, 0x2f, 0x7c, 0xc7, 0x1a, 0x91, 0xf3,
-	0xb1, 0xc9, 0x5e, 0xe9, 0x29, 0x0e, 0xc0, 0x10, 0x98, 0xba, 0x26, 0x7b, 0x85, 0x74, 0xc8, 0x0f,
-	0x5c, 0x87, 0xba, 0x23, 0xa2, 0xa7, 0xf7, 0x95, 0x83, 0x6d, 0x1c, 0x4e, 0x51, 0x19, 0xb2, 0x94,
-	0x59, 0xb6, 0xa3, 0x67, 0xc4, 0xa6, 0x60, 0x82, 0x34, 0xc8, 0x51, 0x66, 0xb9, 0x3e, 0xd3, 0xb3,
-	0xc2, 0x2c, 0x67, 0xd2, 0x4e, 0x3c, 0x4f, 0xcf, 0x45, 0x76, 0xe2, 0x79, 0xc6, 0x4f, 0x0a, 0xbc,
-	0x1d, 0xe3, 0x4c, 0xc7, 0xae, 0x43, 0x09, 0xfa, 0x0c, 0x0a, 0x51, 0x12, 0x05, 0xf7, 0x62, 0x5d,
-	0xaf, 0xcd, 0xa5, 0xb5, 0x36, 0xdd, 0x34, 0x85, 0xa2, 0x67, 0x50, 0xb4, 0x1d, 0x9b, 0x75, 0x3d,
-	0x77, 0x40, 0x28, 0x15, 0x41, 0x15, 0xeb, 0xda, 0xc2, 0x4e, 0xb9, 0x8a, 0x67, 0xa1, 0xc6, 0x31,
-	0x68, 0x0d, 0x32, 0x22, 0x9b, 0x27, 0xd0, 0x38, 0x84, 0xdd, 0x33, 0x9b, 0x4e, 0xef, 0x88, 0x86,
-	0x1b, 0xca, 0x90, 0x75, 0x5f, 0x07, 0xc4, 0xd3, 0x3c, 0x3d, 0x62, 0x62, 0x60, 0xd0, 0x16, 0xe1,
-	0x32, 0xd8, 0x67, 0x00, 0x11, 0x41, 0x2a, 0x36, 0xad, 0x8a, 0x76, 0x06, 0x6b, 0xfc, 0xa9, 0xc0,
-	0x8e, 0x78, 0x28, 0x61, 0x48, 0x92, 0x41, 0x1d, 0x1e, 0x44, 0xa8, 0xf3, 0x88, 0xfc, 0xa3, 0xc9,
-	0xcd, 0x5e, 0x31, 0x72, 0xd4, 0x6e, 0xe0, 0x62, 0x04, 0x6a, 0x5b, 0xe8, 0x18, 0xf2, 0xe3, 0x8d,
-	0xd2, 0x16, 0xc2, 0xfe, 0xf7, 0x07, 0xf2, 0x25, 0x94, 0xe7, 0x83, 0x93, 0xf9, 0x9a, 0x61, 0xaa,
-	0x6c, 0xc4, 0xd4, 0xa0, 0x50, 0x88, 0xe2, 0xbe, 0x7b, 0x41, 0x1c, 0x72, 0x9e, 0x26, 0xf3, 0xa9,
-	0x08, 0xeb, 0x61, 0x7d, 0x77, 0xe1, 0xd8, 0x9e, 0x58, 0xc4, 0x12, 0x64, 0xfc, 0xae, 0x40, 0x5e,
-	0x32, 0x59, 0x7a, 0x66, 0x09, 0xd2, 0x63, 0xdb, 0x12, 0x67, 0xa5, 0x31, 0x1f, 0x22, 0x04, 0x19,
-	0xd3, 0x1b, 0x52, 0x3d, 0x2d, 0xde, 0x8e, 0x18, 0x73, 0x14, 0x71, 0xae, 0xf4, 0x8c, 0x30, 0xf1,
-	0x21, 0xfa, 0x00, 0x32, 0x3e, 0x25, 0x9e, 0x48, 0x64, 0xb1, 0xbe, 0xb3, 0x40, 0xa4, 0x4f, 0x89,
-	0x87, 0x05, 0x80, 0x6f, 0x1d, 0xbc, 0xb6, 0x64, 0x62, 0xf9, 0x10, 0x55, 0x60, 0x9b, 0x11, 0xef,
-	0xd2, 0x76, 0xcc, 0x91, 0x9e, 0x17, 0xd7, 0x16, 0xcd, 0x79, 0x0a, 0xc8, 0xb5, 0xcd, 0xce, 0x65,
-	0x98, 0xdb, 0xfb, 0xca, 0x81, 0x8a, 0x81, 0x9b, 0x82, 0xd8, 0x0c, 0x0c, 0x99, 0xbe, 0x74, 0xeb,
-	0xcb, 0x80, 0x54, 0xcc, 0x87, 0xdc, 0x32, 0x94, 0x91, 0xa8, 0x98, 0x0f, 0xd1, 0x53, 0x78, 0x68,
-	0x5a, 0x96, 0xcd, 0x85, 0xd1, 0x1c, 0xb5, 0x6c, 0x2b, 0x88, 0x49, 0xc5, 0x0b, 0x56, 0xe3, 0x10,
-	0x76, 0x5a, 0x64, 0x73, 0xa9, 0xeb, 0x40, 0x79, 0x1e, 0xfe, 0xef, 0x24, 0xc3, 0xb8, 0x04, 0xad,
-	0x3f, 0xb6, 0x92, 0x94, 0xf3, 0x2e, 0x55, 0xb4, 0xee, 0x11, 0x71, 0x69, 0xef, 0x9a, 0x3e, 0xdd,
-	0x5c, 0x66, 0x8e, 0x41, 0xc3, 0x84, 0xfa, 0x97, 0x9b, 0xef, 0xf0, 0xe1, 0xad, 0x16, 0xf9, 0x2f,
-	0x24, 0xe1, 0x23, 0x00, 0x59, 0x41, 0xe7, 0xf2, 0x6a, 0x0b, 0x27, 0xea, 0xe4, 0x66, 0xaf, 0x20,
-	0x7d, 0xb7, 0x1b, 0xb8, 0x20, 0x01, 0x6d, 0xcb, 0xf8, 0x02, 0xd0, 0xec, 0xb1, 0x77, 0x2e, 0xd6,
-	0x9f, 0x15, 0x28, 0xf7, 0xec, 0xa1, 0x63, 0x8e, 0xee, 0x3b, 0x04, 0xa1, 0x44, 0xe2, 0x64, 0x21,
-	0x68, 0x2a, 0x96, 0x33, 0xe3, 0x1a, 0xca, 0xc1, 0xe7, 0x70, 0xef, 0x49, 0xad, 0x41, 0x99, 0xff,
-	0x1a, 0x72, 0x8d, 0xd0, 0x75, 0x77, 0xff, 0x55, 0xf0, 0x29, 0xcd, 0xe0, 0xe5, 0x3d, 0x7c, 0x0a,
-	0xa1, 0x57, 0x12, 0xfe, 0x31, 0xcb, 0x6e, 0x62, 0x0a, 0xfc, 0xf0, 0x73, 0xc8, 0x05, 0x95, 0x8f,
-	0x8a, 0x90, 0x3f, 0xc5, 0xcd, 0xe7, 0x2f, 0x9a, 0x8d, 0xd2, 0x16, 0x9f, 0xe0, 0x7e, 0xa7, 0xd3,
-	0xee, 0xb4, 0x4a, 0x0a, 0x9f, 0xf4, 0x5e, 0x7c, 0xdd, 0xed, 0x36, 0x1b, 0xa5, 0x14, 0x02, 0xc8,
-	0x75, 0x9f, 0xf7, 0x7b, 0xcd, 0x46, 0x29, 0x5d, 0xff, 0x65, 0x1b, 0x4a, 0xcd, 0xb0, 0x37, 0xea,
-	0x11, 0xef, 0xca, 0x1e, 0x10, 0xf4, 0x12, 0x72, 0xc1, 0xa7, 0x8f, 0x9e, 0x2c, 0x56, 0x67, 0x62,
-	0xff, 0x52, 0x79, 0xba, 0x0e, 0x26, 0x03, 0x6c, 0x42, 0x56, 0xfc, 0x16, 0xe8, 0x71, 0x5c, 0x96,
-	0xe3, 0x9d, 0x54, 0x45, 0xab, 0x05, 0x6d, 0x59, 0x2d, 0x6c, 0xcb, 0x6a, 0x4d, 0xde, 0x96, 0xa1,
-	0x16, 0xe4, 0x02, 0x39, 0x88, 0xf1, 0x4b, 0x56, 0x89, 0xa5, 0x8e, 0x9a, 0x90, 0x15, 0x85, 0x1e,
-	0xe3, 0x93, 0x58, 0xfe, 0xab, 0xf8, 0x04, 0xe5, 0x1f, 0xe3, 0x93, 0xac, 0x0a, 0xab, 0x1c, 0x05,
-	0x6f, 0x38, 0xe6, 0x28, 0xb9, 0xef, 0x59, 0xea, 0xa8, 0x03, 0xe9, 0x16, 0x61, 0xc8, 0x58, 0xf0,
-	0x92, 0xa0, 0xe1, 0x95, 0xf7, 0x57, 0x62, 0xe4, 0xc5, 0xf5, 0x20, 0xc3, 0x9f, 0x6c, 0x2c, 0x4f,
-	0x89, 0xcd, 0x55, 0xe5, 0xc9, 0x1a, 0x94, 0x74, 0xfa, 0x12, 0x1e, 0xcc, 0xf6, 0x0e, 0x31, 0xb6,
-	0x09, 0x5d, 0x53, 0x8c, 0x6d, 0x62, 0xf3, 0xf1, 0x0d, 0xc0, 0x54, 0xe5, 0xd0, 0x7e, 0x3c, 0xc0,
-	0x05, 0xa7, 0xef, 0xad, 0x40, 0x48, 0x97, 0x67, 0xa0, 0xce, 0xe9, 0x1d, 0x8a, 0x11, 0x49, 0x50,
-	0xc3, 0xa5, 0xd7, 0x73, 0x06, 0xea, 0x9c, 0x56, 0xc5, 0xbc, 0x25, 0x29, 0xd9, 0x52, 0x6f, 0xdf,
-	0x82, 0x3a, 0xa7, 0x27, 0x31, 0x6f, 0x49, 0xea, 0x54, 0x79, 0xbc, 0x1a, 0x14, 0xc4, 0x7d, 0xf2,
-	0xee, 0x9b, 0xdb, 0xea, 0xd6, 0x1f, 0xb7, 0xd5, 0xad, 0xbf, 0x6f, 0xab, 0xca, 0x8f, 0x93, 0xaa,
-	0xf2, 0x66, 0x52, 0x55, 0x7e, 0x9b, 0x54, 0x95, 0xbf, 0x26, 0x55, 0xe5, 0x22, 0x27, 0x98, 0x7c,
-	0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x83, 0x5f, 0xe2, 0xb2, 0x5f, 0x0d, 0x00, 0x00,
+	// 3154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x5f, 0x73, 0xdb, 0xc6,
+	0xb5, 0x37, 0x48, 0x49, 0x24, 0x0f, 0x45, 0x4a, 0x5e, 0x51, 0x32, 0x4c, 0xdb, 0x92, 0x02, 0xdb,
+	0xb1, 0x93, 0xd8, 0xb2, 0xc3, 0x64, 0xee, 0x24, 0x37, 0x4f, 0xb6, 0x48, 0xcb, 0xbc, 0x57, 0xa6,
+	0x59, 0xd0, 0x8a, 0xa7, 0xe9, 0x34, 0x2c, 0x04, 0xac, 0x29, 0x8c, 0x41, 0x00, 0xc5, 0x82, 0xfe,
+	0xd3, 0xe9, 0x74, 0xda, 0xbe, 0xf6, 0xa5, 0x93, 0x8f, 0xd0, 0xe9, 0x74, 0x3a, 0xd3, 0xe9, 0x67,
+	0xe8, 0x6b, 0x1e, 0xf3, 0xd8, 0x27, 0x4d, 0xa3, 0x4f, 0xd0, 0x87, 0x3e, 0x77, 0x3a, 0xfb, 0x0f,
+	0x04, 0x01, 0xf0, 0x4f, 0x9c, 0xd4, 0x6f, 0x7b, 0xce, 0xfe, 0xf6, 0xec, 0xd9, 0xb3, 0xbb, 0x67,
+	0xcf, 0x39, 0x00, 0xac, 0xe1, 0x57, 0xd8, 0x1c, 0x85, 0xb6, 0xe7, 0xee, 0xf9, 0x81, 0x17, 0x7a,
+	0xa8, 0x62, 0x7a, 0x6e, 0x68, 0xd8, 0x2e, 0x0e, 0xac, 0xbd, 0x17, 0x1f, 0xd6, 0x2f, 0x0d, 0x3c,
+	0x6f, 0xe0, 0xe0, 0x3b, 0xac, 0xf3, 0x78, 0xf4, 0xec, 0x0e, 0x1e, 0xfa, 0xe1, 0x6b, 0x8e, 0xad,
+	0xd7, 0x06, 0xde, 0xc0, 0x63, 0xcd, 0x3b, 0xb4, 0xc5, 0xb9, 0xda, 0x1d, 0xd8, 0xec, 0x85, 0x46,
+	0x10, 0xee, 0x4b, 0x41, 0x3a, 0xfe, 0xf9, 0x08, 0x93, 0x10, 0x6d, 0x41, 0xce, 0xb6, 0x54, 0x65,
+	0x57, 0xb9, 0x59, 0xba, 0xbf, 0x72, 0x76, 0xba, 0x93, 0x6b, 0x37, 0xf5, 0x9c, 0x6d, 0x69, 0x5f,
+	0x01, 0x6c, 0xed, 0x07, 0xd8, 0x08, 0xf1, 0xa2, 0x43, 0xd0, 0x0e, 0x94, 0x8f, 0x47, 0xae, 0xe5,
+	0xe0, 0xbe, 0x6f, 0x84, 0x27, 0x6a, 0x8e, 0x02, 0x74, 0xe0, 0xac, 0xae, 0x11, 0x9e, 0x20, 0x15,
+	0x0a, 0xa6, 0xe7, 0x12, 0xcf, 0xc1, 0x6a, 0x7e, 0x57, 0xb9, 0x59, 0xd4, 0x25, 0x89, 0x6a, 0xb0,
+	0x4c, 0x42, 0xcb, 0x76, 0xd5, 0x25, 0x36, 0x88, 0x13, 0x68, 0x0b, 0x56, 0x48, 0x68, 0x79, 0xa3,
+	0x50, 0x5d, 0x66, 0x6c, 0x41, 0x09, 0x3e, 0x0e, 0x02, 0x75, 0x25, 0xe2, 0xe3, 0x20, 0x40, 0x0f,
+	0x60, 0x2d, 0x18, 0xb9, 0xa1, 0x3d, 0xc4, 0x7d, 0xcf, 0xa7, 0xe6, 0x23, 0x6a, 0x61, 0x57, 0xb9,
+	0x59, 0x6e, 0x5c, 0xd9, 0x9b, 0x30, 0xe0, 0x9e, 0xce, 0x51, 0x8f, 0x39, 0x48, 0xaf, 0x06, 0x13,
+	0x34, 0xd5, 0x53, 0x70, 0xd4, 0x22, 0x9b, 0x40, 0x92, 0xb4, 0x87, 0x18, 0xae, 0x75, 0xec, 0xbd,
+	0x52, 0x4b, 0xbc, 0x47, 0x90, 0xe8, 0x0a, 0x80, 0x6f, 0x5b, 0xa4, 0xef, 0xd8, 0x43, 0x3b, 0x54,
+	0x61, 0x57, 0xb9, 0x99, 0xd7, 0x4b, 0x94, 0x73, 0x48, 0x19, 0xe8, 0x2a, 0x54, 0xcc, 0x41, 0xe0,
+	0x8d, 0xfc, 0xbe, 0x6f, 0x04, 0xd8, 0x0d, 0xd5, 0x32, 0x1b, 0xbe, 0xca, 0x99, 0x5d, 0xc6, 0x43,
+	0x37, 0x60, 0x8d, 0x60, 0xd3, 0xf4, 0x86, 0x7e, 0xdf, 0x0f, 0xbc, 0x67, 0xb6, 0x83, 0xd5, 0x55,
+	0x06, 0xab, 0x0a, 0x76, 0x97, 0x73, 0xd1, 0x7b, 0xb0, 0x6e, 0xf8, 0xbe, 0x11, 0x0c, 0xbd, 0x20,
+	0x42, 0x56, 0x18, 0x72, 0x4d, 0xf2, 0x25, 0xf4, 0x26, 0xac, 0xbb, 0x5e, 0x9f, 0x60, 0xc7, 0x76,
+	0x47, 0xaf, 0xfa, 0x8e, 0x71, 0x8c, 0x1d, 0xb5, 0xca, 0x8c, 0x5f, 0x75, 0xbd, 0x1e, 0x67, 0x1f,
+	0x52, 0x2e, 0x3a, 0x84, 0xd5, 0x91, 0x6d, 0xf5, 0x87, 0x86, 0xef, 0xdb, 0xee, 0x80, 0xa8, 0x6b,
+	0xbb, 0xf9, 0x9b, 0xe5, 0x86, 0x9a, 0x30, 0x5d, 0xbb, 0xf9, 0x88, 0x03, 0xee, 0xaf, 0x9d, 0x9d,
+	0xee, 0x94, 0x8f, 0x22, 0x9a, 0xe8, 0xe5, 0x91, 0x6d, 0x49, 0x82, 0x4a, 0x1b, 0xc4, 0xa5, 0xad,
+	0x2f, 0x22, 0xed, 0x20, 0x2e, 0x6d, 0x10, 0x93, 0x76, 0x01, 0x0a, 0xa6, 0xe1, 0xf7, 0x0d, 0xcb,
+	0x52, 0xcf, 0xef, 0xe6, 0xe9, 0x96, 0x9b, 0x86, 0x7f, 0xcf, 0xb2, 0xd0, 0x45, 0x28, 0xd2, 0x0e,
+	0x2b, 0xf0, 0x7c, 0x15, 0xb1, 0x1e, 0x0a, 0x6c, 0x06, 0x9e, 0x8f, 0xb6, 0x01, 0xfc, 0xc0, 0x7e,
+	0x61, 0x3b, 0x78, 0x80, 0x2d, 0x75, 0x83, 0xad, 0x39, 0xc6, 0x41, 0xef, 0xc0, 0xea, 0xd0, 0x20,
+	0xcf, 0xb1, 0xc5, 0x8e, 0x2b, 0x51, 0x6b, 0x6c, 0x78, 0x99, 0xf3, 0xe8, 0x79, 0x25, 0xe8, 0x3a,
+	0x54, 0x03, 0x6c, 0x58, 0x9e, 0xeb, 0xbc, 0x16, 0xa0, 0x4d, 0x06, 0xaa, 0x48, 0x2e, 0x87, 0xdd,
+	0x80, 0xb5, 0x08, 0x16, 0x78, 0x5e, 0xf8, 0x8c, 0xa8, 0x5b, 0xdc, 0xc4, 0x92, 0xad, 0x33, 0x2e,
+	0xfa, 0x18, 0x0a, 0x04, 0x9b, 0x01, 0x0e, 0x89, 0x7a, 0x81, 0xd9, 0xa3, 0x9e, 0xb0, 0x47, 0x8f,
+	0xf5, 0x3e, 0xf2, 0x46, 0x6e, 0xa8, 0x4b, 0x28, 0x3d, 0x74, 0x2e, 0x0e, 0x5f, 0x7a, 0xc1, 0x73,
+	0x55, 0xe5, 0x87, 0x4e, 0x90, 0xe8, 0x2e, 0x2c, 0xfb, 0x5e, 0x10, 0x12, 0xf5, 0x62, 0xa6, 0xb4,
+	0xae, 0x17, 0x84, 0xc2, 0x84, 0x3a, 0x07, 0xa2, 0x3a, 0x14, 0x4f, 0x3c, 0x12, 0xba, 0xc6, 0x10,
+	0xab, 0x75, 0x26, 0x2c, 0xa2, 0xd1, 0xa7, 0x50, 0xc6, 0xaf, 0xc2, 0xc0, 0xe8, 0x53, 0x0e, 0x51,
+	0x2f, 0x65, 0xee, 0xd8, 0x43, 0x8f, 0x84, 0x2d, 0x37, 0x0c, 0x5e, 0xeb, 0xc0, 0xc0, 0x94, 0x26,
+	0xe8, 0x0e, 0x94, 0x2d, 0x97, 0xf4, 0x09, 0x0e, 0x5e, 0xe0, 0x80, 0xa8, 0x97, 0xa9, 0x95, 0xee,
+	0x57, 0xcf, 0x4e, 0x77, 0xa0, 0xd9, 0xe9, 0xf5, 0x38, 0x57, 0x07, 0xcb, 0x25, 0xa2, 0x8d, 0x6e,
+	0x01, 0xf0, 0x01, 0x46, 0x60, 0x9e, 0xa8, 0x57, 0x18, 0xbe, 0x72, 0x76, 0xba, 0x53, 0x62, 0x78,
+	0xca, 0xd4, 0x4b, 0x0c, 0x4e, 0x9b, 0x52, 0xbc, 0xbc, 0xd4, 0xdb, 0x13, 0xe2, 0xe5, 0x2d, 0xa6,
+	0x02, 0x45, 0x9b, 0x8a, 0x77, 0x71, 0xe8, 0x12, 0xee, 0x89, 0x76, 0x76, 0x15, 0x29, 0xbe, 0x83,
+	0xc3, 0x4e, 0x8f, 0xee, 0x9a, 0x5e, 0x62, 0x00, 0xda, 0xa4, 0xfb, 0x67, 0x9e, 0x60, 0xf3, 0xb9,
+	0xef, 0xd9, 0x6e, 0xc8, 0x87, 0xec, 0xf2, 0x7b, 0x37, 0x66, 0x53, 0xa0, 0xf6, 0x29, 0x94, 0xa2,
+	0xf5, 0x33, 0x37, 0xe8, 0x4f, 0xb8, 0xc1, 0xae, 0x9e, 0xb3, 0x7d, 0xea, 0xcb, 0xa8, 0x39, 0x89,
+	0x9a, 0x63, 0x67, 0x85, 0x13, 0xda, 0x57, 0x0a, 0x94, 0x63, 0xfb, 0x41, 0x37, 0x82, 0x79, 0x66,
+	0xd3, 0x73, 0xb8, 0x0c, 0x3d, 0xa2, 0xd1, 0x55, 0x28, 0xd0, 0x2d, 0xe8, 0xdb, 0x3e, 0x77, 0xa2,
+	0xf7, 0xe1, 0xec, 0x74, 0x67, 0x85, 0xce, 0xdc, 0xee, 0xea, 0x2b, 0xb4, 0xab, 0xed, 0xa3, 0x4b,
+	0x50, 0x62, 0x20, 0xba, 0xaf, 0xcc, 0x9d, 0x56, 0xf8, 0x56, 0xd2, 0x49, 0xe8, 0xc1, 0x8d, 0xb6,
+	0x8d, 0x23, 0x96, 0x18, 0x62, 0xfc, 0x90, 0x50, 0x98, 0xf6, 0x08, 0xca, 0xb1, 0x13, 0x87, 0x10,
+	0x2c, 0xb1, 0x83, 0xc1, 0xf5, 0x61, 0x6d, 0xea, 0x6b, 0x43, 0x23, 0x18, 0xe0, 0x50, 0xf8, 0x73,
+	0x41, 0x51, 0xec, 0xd0, 0xb3, 0xb0, 0x98, 0x99, 0xb5, 0xb5, 0x5f, 0x42, 0x29, 0xba, 0xc0, 0xa8,
+	0x01, 0xab, 0x63, 0x15, 0xc4, 0x7b, 0x51, 0xe1, 0xd7, 0x3c, 0x7a, 0x51, 0xda, 0x4d, 0xbd, 0x1c,
+	0x81, 0xda, 0xd6, 0x78, 0xe1, 0x16, 0x9b, 0xad, 0x12, 0x5b, 0x78, 0x53, 0x2c, 0xdc, 0xa2, 0x1a,
+	0x39, 0xd8, 0x1d, 0x84, 0x27, 0x62, 0x6e, 0x41, 0x69, 0xbf, 0x82, 0xea, 0xa4, 0x5f, 0xa7, 0x56,
+	0x20, 0xaf, 0x49, 0x88, 0x87, 0x56, 0x9f, 0xfb, 0x59, 0xa6, 0x44, 0x51, 0xaf, 0x08, 0xee, 0x3e,
+	0x63, 0xd2, 0xa5, 0xd0, 0x5b, 0x2b, 0x16, 0xc8, 0xda, 0xd4, 0xba, 0x66, 0x60, 0x8f, 0xf8, 0x61,
+	0xc8, 0xf3, 0xfd, 0xa1, 0x0c, 0x76, 0x5e, 0x6a, 0xb0, 0x6c, 0xe1, 0xe3, 0xd1, 0x80, 0x19, 0xb5,
+	0xa8, 0x73, 0x42, 0xfb, 0x9d, 0x02, 0x17, 0x52, 0x2f, 0x26, 0xf1, 0x3d, 0x97, 0x60, 0xf4, 0x3f,
+	0x50, 0x8a, 0xd6, 0xc9, 0x94, 0x48, 0x5f, 0xac, 0xf1, 0xa0, 0x31, 0x14, 0x7d, 0x02, 0x65, 0xdb,
+	0xb5, 0xc3, 0x6e, 0xe0, 0x99, 0x98, 0x10, 0xa6, 0x61, 0xb9, 0xb1, 0x95, 0xbc, 0xe6, 0xbc, 0x57,
+	0x8f, 0x43, 0xb5, 0x9f, 0xc1, 0x56, 0x13, 0x3b, 0xf8, 0x3b, 0x3c, 0xdf, 0x35, 0x58, 0x7e, 0xe6,
+	0x05, 0x26, 0x66, 0xb3, 0x14, 0x75, 0x4e, 0x50, 0xe7, 0x43, 0x4d, 0x4a, 0x1f, 0xe1, 0x3c, 0x7b,
+	0xd4, 0x24, 0xa9, 0xdd, 0x86, 0xcd, 0x43, 0x9b, 0x8c, 0x23, 0x0a, 0x22, 0x27, 0xa8, 0xc1, 0xb2,
+	0xf7, 0x92, 0x2f, 0x94, 0x5d, 0x00, 0x46, 0x68, 0x3a, 0x6c, 0x25, 0xe1, 0xc2, 0x38, 0x9f, 0x00,
+	0x44, 0x0b, 0x22, 0x6c, 0xd0, 0x2c, 0xeb, 0xc4, 0xb0, 0xda, 0xbf, 0x72, 0xb0, 0xc1, 0xc2, 0x1a,
+	0x69, 0x02, 0xa1, 0x41, 0xd6, 0xd9, 0x2b, 0xcd, 0x39, 0x7b, 0x77, 0xa1, 0xe0, 0x2f, 0x64, 0x66,
+	0x09, 0xfb, 0xaf, 0x87, 0x33, 0xb1, 0x47, 0xaf, 0x30, 0xf5, 0xd1, 0x2b, 0xce, 0x7a, 0xf4, 0x4a,
+	0xa9, 0x47, 0x6f, 0x1f, 0xaa, 0x2e, 0x7e, 0xd9, 0x8f, 0x38, 0x84, 0x85, 0x2a, 0xd5, 0xc6, 0xe5,
+	0xc4, 0x62, 0x3b, 0xf8, 0x65, 0x37, 0xc2, 0xe8, 0x15, 0x37, 0x4e, 0x6a, 0x0f, 0xa1, 0x36, 0x69,
+	0x75, 0xb1, 0x91, 0x31, 0x13, 0x2a, 0x0b, 0x99, 0x50, 0xfb, 0xb3, 0x02, 0xa5, 0x68, 0x47, 0xde,
+	0x3c, 0xb0, 0xbc, 0x4d, 0x2d, 0x68, 0x84, 0x23, 0xc2, 0x0c, 0x5e, 0x6d, 0x6c, 0x26, 0x9f, 0x55,
+	0xd6, 0xa9, 0x0b, 0x50, 0x3c, 0x8a, 0x5b, 0x9e, 0x8c, 0xe2, 0x2e, 0x42, 0xde, 0xf6, 0x89, 0xba,
+	0xc2, 0x1e, 0x98, 0xc2, 0xd9, 0xe9, 0x4e, 0xbe, 0xdd, 0x25, 0x3a, 0xe5, 0x69, 0x7f, 0xc9, 0x41,
+	0x41, 0xe8, 0x3f, 0x55, 0xd1, 0x75, 0xc8, 0xfb, 0xc2, 0x77, 0xe5, 0x75, 0xda, 0xa4, 0xbe, 0xc5,
+	0x08, 0x06, 0x44, 0xcd, 0xb3, 0x6d, 0x62, 0x6d, 0x8a, 0xc2, 0xee, 0x0b, 0x75, 0x89, 0xb1, 0x68,
+	0x13, 0xdd, 0x80, 0xa5, 0x11, 0xc1, 0x01, 0xd3, 0xa6, 0xdc, 0xd8, 0x48, 0x68, 0x7f, 0x44, 0x70,
+	0xa0, 0x33, 0x00, 0x1d, 0x6a, 0xbe, 0xb4, 0xc4, 0x39, 0xa1, 0x4d, 0xfa, 0x8e, 0x84, 0x38, 0x18,
+	0xda, 0xae, 0xe1, 0xb0, 0x60, 0xb7, 0xa8, 0x47, 0x34, 0xb5, 0x1b, 0x7e, 0x65, 0x87, 0x7d, 0x61,
+	0x9b, 0x22, 0x73, 0x97, 0x40, 0x59, 0xdc, 0x20, 0x99, 0x71, 0x64, 0x29, 0x3b, 0x8e, 0x1c, 0x9b,
+	0x18, 0x16, 0x30, 0xb1, 0xa6, 0xc3, 0xd2, 0x91, 0x50, 0x78, 0x24, 0x9d, 0xbf, 0x4e, 0x9b, 0x94,
+	0x33, 0x90, 0xfe, 0x5d, 0xa7, 0x4d, 0xf4, 0x2e, 0x54, 0x0d, 0xcb, 0xb2, 0xa9, 0xcf, 0x36, 0x9c,
+	0x03, 0xdb, 0xe2, 0xd6, 0xaa, 0xe8, 0x09, 0xae, 0x76, 0x1b, 0x36, 0x0e, 0xf0, 0xe2, 0x19, 0x4c,
+	0x07, 0x6a, 0x93, 0xf0, 0xef, 0xe7, 0x8b, 0xb5, 0x0f, 0xe1, 0x42, 0xdb, 0x25, 0x3e, 0x36, 0x17,
+	0x57, 0xc1, 0x00, 0x35, 0x3d, 0x44, 0xa8, 0x81, 0x60, 0x89, 0xf6, 0xb0, 0x51, 0xab, 0x3a, 0x6b,
+	0xa3, 0x0f, 0xb9, 0xdf, 0xf0, 0x58, 0xe8, 0x50, 0x6e, 0x5c, 0xca, 0xbe, 0x3e, 0x3d, 0x0a, 0xe1,
+	0x4e, 0xc5, 0xd3, 0x7e, 0xab, 0xc0, 0x6a, 0x9c, 0x4f, 0x43, 0x1f, 0x71, 0xbb, 0xc6, 0x9e, 0x8f,
+	0x85, 0x3e, 0x02, 0xd5, 0x6e, 0xea, 0x25, 0x01, 0x68, 0x5b, 0x63, 0x4f, 0x95, 0xcb, 0xf6, 0x54,
+	0xf9, 0x29, 0x9e, 0x6a, 0x29, 0xee, 0xa9, 0xe8, 0xd3, 0xb7, 0x75, 0xe4, 0x5b, 0x59, 0xc9, 0xe2,
+	0x9b, 0xb8, 0xe2, 0xb9, 0xf7, 0xfd, 0x32, 0x94, 0x02, 0x4c, 0xbc, 0x51, 0x60, 0x62, 0xc2, 0x54,
+	0x5c, 0xd5, 0xc7, 0x0c, 0xed, 0x37, 0x79, 0xa8, 0xef, 0x47, 0x81, 0xdb, 0xc2, 0xef, 0x1f, 0x82,
+	0xa5, 0xd8, 0x74, 0xac, 0x4d, 0x79, 0xf4, 0xba, 0x08, 0xff, 0xce, 0xda, 0xe8, 0x33, 0x58, 0x0b,
+	0x4d, 0xbf, 0x8f, 0x49, 0x68, 0x1c, 0x3b, 0x36, 0x39, 0xc1, 0x16, 0x8f, 0x03, 0xee, 0xa3, 0xb3,
+	0xd3, 0x9d, 0xea, 0x93, 0xfd, 0x6e, 0x6b, 0xdc, 0xa3, 0x57, 0x43, 0xd3, 0x8f, 0xd1, 0x34, 0xe9,
+	0x18, 0xb9, 0xf6, 0xab, 0x3e, 0xf1, 0xcc, 0xe7, 0x34, 0x0d, 0x58, 0x66, 0x82, 0xcb, 0x94, 0xd7,
+	0xe3, 0x2c, 0xb6, 0x25, 0x27, 0xd8, 0x71, 0xd8, 0x2d, 0x2f, 0xea, 0x9c, 0x40, 0xef, 0x42, 0x91,
+	0x65, 0xf9, 0x7d, 0x96, 0xd4, 0x52, 0xf7, 0x54, 0x3e, 0x3b, 0xdd, 0x29, 0xb4, 0x28, 0xaf, 0xd3,
+	0xd3, 0x0b, 0xac, 0xb3, 0x43, 0xe8, 0xdb, 0xe0, 0x07, 0xb8, 0x6f, 0x8d, 0x86, 0x3e, 0xbb, 0xf0,
+	0x45, 0xea, 0x6c, 0x71, 0x73, 0x34, 0xf4, 0xa9, 0x59, 0x79, 0xf2, 0xc9, 0xcd, 0xca, 0x2f, 0x3a,
+	0x70, 0x16, 0x33, 0xeb, 0x25, 0x28, 0xc9, 0xb1, 0xfc, 0x9a, 0x57, 0xf4, 0xa2, 0x18, 0x4c, 0x43,
+	0x6a, 0x24, 0x3b, 0xfb, 0xe1, 0x49, 0x80, 0xc9, 0x89, 0xe7, 0x58, 0x2c, 0x8d, 0xcd, 0xeb, 0xeb,
+	0x02, 0xf5, 0x44, 0xf2, 0x69, 0xbd, 0xa1, 0x6b, 0x8c, 0xc8, 0xc2, 0xd1, 0x87, 0x76, 0x17, 0xb6,
+	0x74, 0x4c, 0x46, 0xc3, 0xc5, 0x47, 0x8c, 0xe0, 0xfc, 0x01, 0xfe, 0x21, 0x5e, 0xfe, 0xc9, 0x1b,
+	0x93, 0x9b, 0x7d, 0x63, 0xb4, 0x07, 0x80, 0xe2, 0xd3, 0xbe, 0xf1, 0xd3, 0xf7, 0x07, 0x05, 0x6a,
+	0x3d, 0x7b, 0xe0, 0x1a, 0xce, 0xdb, 0x5e, 0x02, 0xbb, 0xc6, 0x6c, 0x66, 0x19, 0x41, 0x73, 0x8a,
+	0xba, 0x66, 0xc3, 0x71, 0x44, 0x54, 0x4b, 0x9b, 0xda, 0x9f, 0x14, 0xa8, 0xe9, 0x98, 0xd8, 0xbf,
+	0xc0, 0x6f, 0x5d, 0xc9, 0x1a, 0x2c, 0xbf, 0xb4, 0xad, 0x28, 0xca, 0xe7, 0x04, 0x55, 0xfd, 0x04,
+	0xdb, 0x83, 0x13, 0x99, 0xd0, 0x08, 0x4a, 0x7b, 0x05, 0x35, 0x1e, 0xee, 0xbe, 0xf5, 0xf3, 0xb0,
+	0x07, 0x35, 0x1a, 0xd7, 0x8a, 0x3e, 0x4c, 0xe6, 0x1d, 0xdb, 0xdf, 0x2b, 0xb0, 0x99, 0x18, 0x20,
+	0xce, 0xd0, 0xc7, 0x20, 0xc5, 0x62, 0x19, 0x06, 0x4f, 0x3b, 0x45, 0x63, 0x20, 0xba, 0x07, 0x55,
+	0x9e, 0x07, 0x46, 0x43, 0x73, 0x99, 0xc5, 0x00, 0x9a, 0x45, 0xc9, 0xe1, 0x95, 0x13, 0x2f, 0xa6,
+	0x80, 0x66, 0x40, 0x39, 0xd6, 0x2b, 0xa3, 0x18, 0x25, 0x1d, 0xc5, 0xe4, 0x62, 0x51, 0xcc, 0xa4,
+	0x95, 0xf2, 0x73, 0xac, 0xf4, 0x1a, 0x36, 0x0f, 0x70, 0x28, 0xf2, 0xb3, 0x43, 0x6f, 0xf0, 0x16,
+	0x37, 0xe8, 0x00, 0xb6, 0x92, 0x53, 0x0b, 0x83, 0xdf, 0x86, 0x25, 0xc7, 0x1b, 0x48, 0x5b, 0x5f,
+	0xcc, 0x2e, 0x12, 0x1e, 0x7a, 0x03, 0x9d, 0xc1, 0xb4, 0x00, 0x60, 0xcc, 0x63, 0x97, 0x88, 0x3d,
+	0x38, 0x22, 0x5d, 0x16, 0x14, 0x3d, 0xb7, 0x0e, 0x7e, 0x81, 0x1d, 0xf9, 0xa2, 0x32, 0x82, 0x86,
+	0x9c, 0x43, 0x4c, 0x88, 0x31, 0xc0, 0xe2, 0x49, 0x95, 0x24, 0x7d, 0xcb, 0xa8, 0x48, 0x12, 0x1a,
+	0x43, 0x9f, 0x1d, 0xea, 0xbc, 0x3e, 0x66, 0x68, 0x9b, 0xb0, 0x41, 0x0f, 0x8b, 0x98, 0x57, 0x5a,
+	0x8d, 0xc6, 0x36, 0x93, 0xec, 0x28, 0xb6, 0x29, 0x8a, 0x52, 0xa5, 0x5c, 0x55, 0x3d, 0x7b, 0x55,
+	0x6d, 0xf7, 0x99, 0xa7, 0x47, 0x58, 0xed, 0x6f, 0x0a, 0x94, 0x63, 0x3d, 0x99, 0x95, 0x00, 0x15,
+	0x0a, 0x16, 0x7e, 0x66, 0x8c, 0x9c, 0x50, 0x64, 0x88, 0x92, 0x44, 0x0f, 0x60, 0xd5, 0x34, 0x7c,
+	0xe3, 0xd8, 0x76, 0xec, 0xd0, 0x16, 0x2f, 0x72, 0xb9, 0xa1, 0x65, 0xcf, 0xbc, 0x1f, 0x43, 0xea,
+	0x13, 0xe3, 0xd0, 0xff, 0x42, 0xf1, 0x19, 0x36, 0xc2, 0x51, 0x80, 0x79, 0x20, 0x5f, 0x6e, 0x6c,
+	0x67, 0xcb, 0x78, 0x20, 0x50, 0x7a, 0x84, 0xd7, 0x8e, 0x60, 0x23, 0x63, 0x02, 0xba, 0x1b, 0x3e,
+	0x7d, 0x87, 0x44, 0xe6, 0xcf, 0x09, 0xfe, 0xac, 0x63, 0x53, 0xac, 0x83, 0xb5, 0x79, 0x24, 0x64,
+	0x84, 0x44, 0xbc, 0xf5, 0x9c, 0xd0, 0xbe, 0x51, 0x60, 0x2d, 0x31, 0x29, 0x35, 0x04, 0xad, 0x61,
+	0xd9, 0x9e, 0x2b, 0xec, 0x23, 0x49, 0x7a, 0x26, 0x4c, 0x6f, 0x48, 0x0b, 0xc0, 0xa2, 0x58, 0xc2,
+	0xa9, 0x28, 0xd6, 0xe3, 0x5b, 0xcf, 0xda, 0x54, 0x8a, 0xa8, 0xea, 0x0a, 0x87, 0x2b, 0x49, 0x9a,
+	0xc3, 0x39, 0xf6, 0xb1, 0xec, 0xe4, 0x19, 0x4a, 0x8c, 0x83, 0xde, 0x83, 0x92, 0xa8, 0x25, 0xbf,
+	0x68, 0xf0, 0x20, 0xe1, 0xfe, 0xea, 0xd9, 0xe9, 0x4e, 0x91, 0x97, 0x33, 0x3e, 0x6f, 0xe8, 0x45,
+	0x53, 0xb4, 0xe8, 0xc4, 0xb4, 0x6a, 0xc1, 0x32, 0x83, 0x92, 0xce, 0xda, 0xe2, 0x53, 0x40, 0x48,
+	0x16, 0x7e, 0x68, 0xf7, 0x60, 0x2b, 0x39, 0x40, 0x1c, 0xb7, 0xc8, 0x66, 0x3c, 0x88, 0x15, 0x36,
+	0x6b, 0x02, 0xfa, 0x7f, 0xdb, 0x71, 0x7a, 0x3c, 0xa7, 0x9a, 0x23, 0x3d, 0xf6, 0x18, 0xe5, 0xe2,
+	0x8f, 0x11, 0x8d, 0xf6, 0x85, 0x04, 0x36, 0xf9, 0x3c, 0x25, 0x6f, 0x41, 0x6d, 0x12, 0x3e, 0x53,
+	0xc5, 0x3f, 0x2a, 0x50, 0x10, 0xf0, 0xef, 0x90, 0xcc, 0xc5, 0x8b, 0xa7, 0xf9, 0x44, 0xf1, 0x94,
+	0x7f, 0x33, 0x70, 0x6d, 0x57, 0x56, 0x85, 0x24, 0x29, 0x73, 0xca, 0xe5, 0x74, 0x4e, 0x49, 0x77,
+	0x3a, 0x56, 0xf9, 0x60, 0x59, 0xe7, 0x44, 0x7d, 0xe3, 0xff, 0xa0, 0xc6, 0x2b, 0x4a, 0x0b, 0xda,
+	0x32, 0xae, 0x60, 0x6e, 0x52, 0x41, 0xad, 0x0d, 0x9b, 0x09, 0x59, 0xe3, 0xd0, 0x45, 0x66, 0xc3,
+	0xd9, 0xa1, 0x8b, 0x1c, 0x20, 0x61, 0xda, 0x9e, 0x7c, 0x6c, 0x17, 0x53, 0x4b, 0xfb, 0x80, 0x45,
+	0x6a, 0x0b, 0x82, 0x79, 0x7c, 0xf5, 0xfd, 0x95, 0xdc, 0xe2, 0x2e, 0x52, 0xf0, 0xc7, 0xae, 0xf3,
+	0x11, 0x6c, 0x26, 0xf8, 0xe3, 0xe7, 0x97, 0x48, 0xe6, 0x94, 0xe7, 0x57, 0x4e, 0x32, 0x06, 0x6a,
+	0x47, 0xb0, 0xde, 0xe1, 0xd5, 0xf8, 0x0e, 0xad, 0xf3, 0xfa, 0x86, 0x89, 0x33, 0xbd, 0x67, 0x56,
+	0x76, 0x21, 0x4e, 0x46, 0x3e, 0xa3, 0xda, 0xf0, 0x11, 0x5c, 0xe1, 0xbb, 0x95, 0x14, 0x2e, 0xcd,
+	0x97, 0x31, 0x87, 0xe6, 0xc2, 0xf6, 0xb4, 0x41, 0x62, 0x8d, 0x87, 0x70, 0x5e, 0x7c, 0x3b, 0xe8,
+	0xbb, 0xb2, 0x53, 0x18, 0x74, 0x27, 0x55, 0x01, 0x4a, 0xc8, 0x58, 0x77, 0x13, 0x1c, 0xaa, 0x24,
+	0x3f, 0x07, 0xdf, 0x45, 0xc9, 0x6d, 0xb8, 0x4c, 0xed, 0x9f, 0x1c, 0x12, 0xed, 0x8f, 0x07, 0x57,
+	0xa6, 0xf4, 0x8b, 0x35, 0x74, 0x00, 0xa5, 0xd6, 0x20, 0x37, 0x6c, 0xee, 0x22, 0xce, 0x27, 0x17,
+	0x41, 0xb4, 0xf7, 0x61, 0x9d, 0xc5, 0x63, 0x5e, 0x30, 0xdf, 0xcb, 0x1c, 0xc0, 0xf9, 0x18, 0x56,
+	0x28, 0xd4, 0x90, 0x5f, 0x61, 0xb8, 0x0e, 0xc9, 0x52, 0xda, 0x03, 0x2f, 0x78, 0x69, 0x04, 0x16,
+	0xb6, 0xe8, 0x28, 0xf1, 0x1d, 0x46, 0xfb, 0xab, 0x02, 0x95, 0x89, 0x8e, 0x37, 0x0a, 0x84, 0x3e,
+	0x86, 0x82, 0xf8, 0xc0, 0x26, 0x6a, 0x96, 0xb3, 0xbe, 0x00, 0x49, 0x68, 0x62, 0x26, 0x5f, 0xcd,
+	0x67, 0xcd, 0xd4, 0x8d, 0xcf, 0xe4, 0xbf, 0xff, 0x25, 0x54, 0x26, 0x4a, 0x82, 0xa8, 0x0e, 0x5b,
+	0xed, 0xce, 0xc3, 0x96, 0xde, 0x7e, 0xd2, 0xef, 0xb4, 0x9e, 0xf6, 0xbb, 0x7a, 0xfb, 0xf3, 0xf6,
+	0x61, 0xeb, 0xa0, 0xd5, 0x5b, 0x3f, 0x87, 0x2e, 0xc0, 0x46, 0xb3, 0xd5, 0xf9, 0x71, 0xb2, 0x43,
+	0x41, 0x2a, 0xd4, 0xee, 0x1d, 0x1e, 0x3e, 0x7e, 0x9a, 0xec, 0xc9, 0xbd, 0xff, 0x19, 0xac, 0x88,
+	0x9a, 0x54, 0x19, 0x0a, 0xfb, 0x7a, 0xeb, 0xde, 0x93, 0x56, 0x73, 0xfd, 0x1c, 0x25, 0xf4, 0xa3,
+	0x4e, 0xa7, 0xdd, 0x39, 0x58, 0x57, 0x28, 0xd1, 0x7b, 0xf2, 0xb8, 0xdb, 0x6d, 0x35, 0xd7, 0x73,
+	0x08, 0x60, 0xa5, 0x7b, 0xef, 0xa8, 0xd7, 0x6a, 0xae, 0xe7, 0x1b, 0xff, 0xae, 0xc1, 0x7a, 0x4b,
+	0x7e, 0x32, 0xa7, 0x5f, 0x98, 0x6c, 0x13, 0xa3, 0xa7, 0xb0, 0xc2, 0x2f, 0x03, 0xba, 0x9e, 0xac,
+	0xee, 0x64, 0x7e, 0xd6, 0xae, 0xbf, 0x3b, 0x0f, 0x26, 0xb6, 0xbb, 0x05, 0xcb, 0xac, 0xfa, 0x89,
+	0xae, 0xa5, 0x4b, 0x60, 0xe9, 0x0f, 0xec, 0xf5, 0xad, 0x3d, 0xfe, 0xb5, 0x7e, 0x4f, 0x7e, 0xad,
+	0xdf, 0x63, 0x39, 0x3b, 0x3a, 0x80, 0x15, 0x5e, 0x32, 0x49, 0xe9, 0x97, 0x5d, 0x49, 0x99, 0x2a,
+	0xa8, 0x05, 0xcb, 0x2c, 0xd5, 0x4e, 0xe9, 0x93, 0x99, 0x80, 0xcf, 0xd2, 0x87, 0x27, 0xe0, 0x29,
+	0x7d, 0xb2, 0xf3, 0xf2, 0x59, 0x82, 0xb8, 0x57, 0x48, 0x09, 0xca, 0xfe, 0x20, 0x31, 0x55, 0x50,
+	0x07, 0xf2, 0x07, 0x38, 0x44, 0xc9, 0x38, 0x32, 0xa3, 0x06, 0x58, 0xbf, 0x3a, 0x13, 0x23, 0x36,
+	0xae, 0x07, 0x4b, 0xf4, 0xf2, 0xa6, 0xec, 0x94, 0xf9, 0x15, 0xa3, 0x7e, 0x7d, 0x0e, 0x4a, 0x08,
+	0xfd, 0x09, 0x00, 0x7b, 0x4e, 0xc2, 0x00, 0x1b, 0xc3, 0x1f, 0x54, 0xf4, 0x5d, 0x05, 0x3d, 0x61,
+	0x47, 0x2d, 0x24, 0x59, 0x47, 0x2d, 0x1d, 0xc0, 0xd5, 0xaf, 0xcf, 0x41, 0x09, 0x95, 0x1f, 0x03,
+	0x8c, 0xcb, 0x63, 0xe8, 0xbd, 0xe4, 0xb1, 0x9f, 0x5a, 0x39, 0x9b, 0xba, 0x51, 0x5f, 0x40, 0x41,
+	0x94, 0x39, 0x51, 0xf2, 0x12, 0x4d, 0xa9, 0x98, 0xd6, 0x6f, 0xcc, 0xc5, 0x09, 0x65, 0x9f, 0xc2,
+	0x6a, 0xfc, 0x5b, 0x43, 0xea, 0x34, 0x64, 0x7c, 0xfe, 0xa9, 0x5f, 0x9d, 0x89, 0x11, 0x82, 0x7f,
+	0x04, 0x30, 0xae, 0xe3, 0xa0, 0xdd, 0xf4, 0x01, 0x4a, 0x08, 0x7d, 0x67, 0x06, 0x22, 0x7a, 0x5d,
+	0x2b, 0x13, 0x15, 0x1d, 0x94, 0x52, 0x24, 0xa3, 0xde, 0x33, 0xd5, 0xaa, 0x87, 0x50, 0x99, 0x28,
+	0xbd, 0xa4, 0xa4, 0x65, 0x15, 0x66, 0x66, 0x49, 0x9b, 0x28, 0x90, 0xa4, 0xa4, 0x65, 0x95, 0x4f,
+	0x66, 0xec, 0x78, 0x65, 0xa2, 0x86, 0x91, 0x92, 0x96, 0x55, 0x12, 0xa9, 0x5f, 0x9b, 0x0d, 0x12,
+	0x56, 0x3c, 0x86, 0x8d, 0x89, 0x0e, 0x71, 0xb5, 0x7e, 0xb8, 0x19, 0xee, 0x2a, 0xe8, 0xa7, 0x50,
+	0x9d, 0xac, 0x09, 0xa4, 0x6e, 0x58, 0x66, 0xb5, 0xa2, 0x7e, 0x7d, 0x0e, 0x6a, 0x7c, 0x68, 0xe3,
+	0xe9, 0x79, 0xea, 0xd0, 0x66, 0xa4, 0xf4, 0xf5, 0xab, 0x33, 0x31, 0x42, 0xf0, 0x43, 0x28, 0xc7,
+	0x52, 0x2b, 0x94, 0x3c, 0x93, 0xe9, 0xb4, 0x6b, 0xea, 0x0e, 0xd2, 0x7b, 0x15, 0xcb, 0x97, 0xd2,
+	0xf7, 0x2a, 0x9d, 0x7b, 0xd5, 0xaf, 0xce, 0xc4, 0x08, 0x15, 0xbf, 0x80, 0xca, 0x44, 0x9e, 0x91,
+	0xda, 0xb8, 0xac, 0x8c, 0xa6, 0x7e, 0x6d, 0x36, 0x68, 0x7c, 0xc1, 0x26, 0x12, 0x8f, 0x29, 0x87,
+	0x78, 0x41, 0x13, 0x70, 0x0f, 0x20, 0x45, 0x65, 0x78, 0x80, 0x84, 0x9c, 0x77, 0x66, 0x20, 0xc6,
+	0x8b, 0x9f, 0x48, 0x2e, 0x32, 0x4f, 0x6d, 0x32, 0x25, 0xa9, 0x5f, 0x9b, 0x0d, 0x12, 0xb2, 0x47,
+	0xf2, 0x87, 0xbc, 0x54, 0xbe, 0x71, 0x2b, 0xd3, 0x78, 0x53, 0x82, 0xf2, 0xfa, 0xed, 0x05, 0xd1,
+	0x62, 0xda, 0x2f, 0xe5, 0x8f, 0x04, 0x73, 0xa7, 0x9d, 0x99, 0x0b, 0x4c, 0xdd, 0x85, 0x80, 0xe7,
+	0x63, 0xc9, 0x61, 0x04, 0x7d, 0x90, 0x61, 0x95, 0x69, 0x59, 0x43, 0xfd, 0xd6, 0x62, 0xe0, 0x28,
+	0x85, 0x28, 0x45, 0x61, 0x3c, 0xda, 0xc9, 0xf2, 0x19, 0xb1, 0x64, 0xa0, 0xbe, 0x3b, 0x1d, 0xc0,
+	0xe5, 0xdd, 0xbf, 0xfc, 0xf5, 0xb7, 0xdb, 0xe7, 0xfe, 0xfe, 0xed, 0xf6, 0xb9, 0x7f, 0x7e, 0xbb,
+	0xad, 0xfc, 0xfa, 0x6c, 0x5b, 0xf9, 0xfa, 0x6c, 0x5b, 0xf9, 0xe6, 0x6c, 0x5b, 0xf9, 0xc7, 0xd9,
+	0xb6, 0x72, 0xbc, 0xc2, 0x56, 0xfc, 0xd1, 0x7f, 0x06, 0x00, 0xd1, 0x93, 0xc0, 0xc6, 0xd7, 0x29,
+	0x00, 0x00,
 }
@@ -0,0 +1,2136 @@
+// Code generated by protoc-gen-gogo.
+// source: gc.proto
+// DO NOT EDIT!
+
+/*
+	Package gc is a generated protocol buffer package.
+
+	It is generated from these files:
+		gc.proto
+
+	It has these top-level messages:
+		CollectRequest
+		CollectResponse
+		ReportRequest
+		PruneRequest
+		PruneResponse
+		ReportResponse
+		Resource
+		PauseRequest
+		ResumeRequest
+*/
+package gc
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/empty"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import strings "strings"
+import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
+import sort "sort"
+import strconv "strconv"
+import reflect "reflect"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type CollectRequest struct {
+	// DryRun returns what would be removed without removing anything.
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *CollectRequest) Reset()                    { *m = CollectRequest{} }
+func (*CollectRequest) ProtoMessage()               {}
+func (*CollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorGc, []int{0} }
+
+type CollectResponse struct {
+	Removed []*Resource `protobuf:"bytes,1,rep,name=removed" json:"removed,omitempty"`
+	// Reclaimed is the disk space in bytes of the resources removed.
+	Reclaimed int64 `protobuf:"varint,2,opt,name=reclaimed,proto3" json:"reclaimed,omitempty"`
+	// Scanned is the number of blobs and committed snapshots which could
+	// have been removed.
+	Scanned int64 `protobuf:"varint,3,opt,name=scanned,proto3" json:"scanned,omitempty"`
+}
+
+func (m *CollectResponse) Reset()                    { *m = CollectResponse{} }
+func (*CollectResponse) ProtoMessage()               {}
+func (*CollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorGc, []int{1} }
+
+type ReportRequest struct {
+}
+
+func (m *ReportRequest) Reset()                    { *m = ReportRequest{} }
+func (*ReportRequest) ProtoMessage()               {}
+func (*ReportRequest) Descriptor() ([]byte, []int) { return fileDescriptorGc, []int{2} }
+
+type PruneRequest struct {
+	// Containers deletes the stopped containers of the namespace.
+	Containers bool `protobuf:"varint,1,opt,name=containers,proto3" json:"containers,omitempty"`
+	// Images removes the images of the namespace which are used by no
+	// container and pinned by no lease.
+	Images bool `protobuf:"varint,2,opt,name=images,proto3" json:"images,omitempty"`
+	// Content removes the blobs referenced by nothing.
+	Content bool `protobuf:"varint,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Snapshots removes the committed snapshots referenced by nothing.
+	Snapshots bool `protobuf:"varint,4,opt,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// DryRun returns what would be removed without removing anything.
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *PruneRequest) Reset()                    { *m = PruneRequest{} }
+func (*PruneRequest) ProtoMessage()               {}
+func (*PruneRequest) Descriptor() ([]byte, []int) { return fileDescriptorGc, []int{3} }
+
+type PruneResponse struct {
+	Removed []*Resource `protobuf:"bytes,1,rep,name=removed" json:"removed,omitempty"`
+	// Reclaimed is the disk space in bytes of the content and snapshots
+	// removed.
+	Reclaimed int64 `protobuf:"varint,2,opt,name=reclaimed,proto3" json:"reclaimed,omitempty"`
+}
+
+func (m *PruneResponse) Reset()                    { *m = PruneResponse{} }
+func (*PruneResponse) ProtoMessage()               {}
+func (*PruneResponse) Descriptor() ([]byte, []int) { return fileDescriptorGc, []int{4} }
+
+type ReportResponse struct {
+	// Removable are the resources a collection would remove.
+	Removable []*Resource `protobuf:"bytes,1,rep,name=removable" json:"removable,omitempty"`
+	// Reclaimable is the disk space in bytes of the removable resources.
+	Reclaimable int64 `protobuf:"varint,2,opt,name=reclaimable,proto3" json:"reclaimable,omitempty"`
+	// Scanned is the number of blobs and committed snapshots which could
+	// be removed.
+	Scanned int64 `protobuf:"varint,3,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	// Timestamp is when the dry run of the report ran, in nanoseconds
+	// since the epoch.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *ReportResponse) Reset()                    { *m = ReportResponse{} }
+func (*ReportResponse) ProtoMessage()               {}
+func (*ReportResponse) Descriptor() ([]byte, []int) { return fileDescriptorGc, []int{5} }
+
+// Resource is a resource removed by a collection or a prune.
+type Resource struct {
+	// Type is content or snapshot, or container or image for a prune.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// ID is the digest of the content, the name of the snapshot or of the
+	// image, or the id of the container.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Size is that of the disk space reclaimed.
+	Size_ int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *Resource) Reset()                    { *m = Resource{} }
+func (*Resource) ProtoMessage()               {}
+func (*Resource) Descriptor() ([]byte, []int) { return fileDescriptorGc, []int{6} }
+
+type PauseRequest struct {
+	// Duration is in nanoseconds, the pause expiring after the default of
+	// the daemon when it is zero.
+	Duration int64 `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *PauseRequest) Reset()                    { *m = PauseRequest{} }
+func (*PauseRequest) ProtoMessage()               {}
+func (*PauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorGc, []int{7} }
+
+type ResumeRequest struct {
+}
+
+func (m *ResumeRequest) Reset()                    { *m = ResumeRequest{} }
+func (*ResumeRequest) ProtoMessage()               {}
+func (*ResumeRequest) Descriptor() ([]byte, []int) { return fileDescriptorGc, []int{8} }
+
+func init() {
+	proto.RegisterType((*CollectRequest)(nil), "containerd.v1.gc.CollectRequest")
+	proto.RegisterType((*CollectResponse)(nil), "containerd.v1.gc.CollectResponse")
+	proto.RegisterType((*ReportRequest)(nil), "containerd.v1.gc.ReportRequest")
+	proto.RegisterType((*PruneRequest)(nil), "containerd.v1.gc.PruneRequest")
+	proto.RegisterType((*PruneResponse)(nil), "containerd.v1.gc.PruneResponse")
+	proto.RegisterType((*ReportResponse)(nil), "containerd.v1.gc.ReportResponse")
+	proto.RegisterType((*Resource)(nil), "containerd.v1.gc.Resource")
+	proto.RegisterType((*PauseRequest)(nil), "containerd.v1.gc.PauseRequest")
+	proto.RegisterType((*ResumeRequest)(nil), "containerd.v1.gc.ResumeRequest")
+}
+func (this *CollectRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&gc.CollectRequest{")
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CollectResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&gc.CollectResponse{")
+	if this.Removed != nil {
+		s = append(s, "Removed: "+fmt.Sprintf("%#v", this.Removed)+",\n")
+	}
+	s = append(s, "Reclaimed: "+fmt.Sprintf("%#v", this.Reclaimed)+",\n")
+	s = append(s, "Scanned: "+fmt.Sprintf("%#v", this.Scanned)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReportRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&gc.ReportRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PruneRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&gc.PruneRequest{")
+	s = append(s, "Containers: "+fmt.Sprintf("%#v", this.Containers)+",\n")
+	s = append(s, "Images: "+fmt.Sprintf("%#v", this.Images)+",\n")
+	s = append(s, "Content: "+fmt.Sprintf("%#v", this.Content)+",\n")
+	s = append(s, "Snapshots: "+fmt.Sprintf("%#v", this.Snapshots)+",\n")
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PruneResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&gc.PruneResponse{")
+	if this.Removed != nil {
+		s = append(s, "Removed: "+fmt.Sprintf("%#v", this.Removed)+",\n")
+	}
+	s = append(s, "Reclaimed: "+fmt.Sprintf("%#v", this.Reclaimed)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReportResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&gc.ReportResponse{")
+	if this.Removable != nil {
+		s = append(s, "Removable: "+fmt.Sprintf("%#v", this.Removable)+",\n")
+	}
+	s = append(s, "Reclaimable: "+fmt.Sprintf("%#v", this.Reclaimable)+",\n")
+	s = append(s, "Scanned: "+fmt.Sprintf("%#v", this.Scanned)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Resource) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&gc.Resource{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Size_: "+fmt.Sprintf("%#v", this.Size_)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&gc.PauseRequest{")
+	s = append(s, "Duration: "+fmt.Sprintf("%#v", this.Duration)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResumeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&gc.ResumeRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringGc(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringGc(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for GCService service
+
+type GCServiceClient interface {
+	// Collect removes the content and committed snapshots referenced by no
+	// lease, image, container or active snapshot, in all namespaces.
+	// It fails while the collections are paused, unless it is a dry run.
+	Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error)
+	// Report returns what a collection would remove and the disk space it
+	// would reclaim, without removing anything, even while the collections
+	// are paused. The report of the last dry run is returned unless it is
+	// older than a minute or a collection ran since.
+	Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportResponse, error)
+	// Prune deletes the stopped containers and removes the images used by
+	// no container of the namespace, then the content and committed
+	// snapshots referenced by nothing in all namespaces, as selected by
+	// the request. It fails while the collections are paused, unless it is
+	// a dry run.
+	Prune(ctx context.Context, in *PruneRequest, opts ...grpc.CallOption) (*PruneResponse, error)
+	// Pause interrupts the running collections and defers the next ones
+	// until Resume is called or the pause expires.
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// Resume lets the collections due run again.
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+}
+
+type gCServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGCServiceClient(cc *grpc.ClientConn) GCServiceClient {
+	return &gCServiceClient{cc}
+}
+
+func (c *gCServiceClient) Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error) {
+	out := new(CollectResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.gc.GCService/Collect", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gCServiceClient) Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportResponse, error) {
+	out := new(ReportResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.gc.GCService/Report", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gCServiceClient) Prune(ctx context.Context, in *PruneRequest, opts ...grpc.CallOption) (*PruneResponse, error) {
+	out := new(PruneResponse)
+	err := grpc.Invoke(ctx, "/containerd.v1.gc.GCService/Prune", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gCServiceClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/containerd.v1.gc.GCService/Pause", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gCServiceClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/containerd.v1.gc.GCService/Resume", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for GCService service
+
+type GCServiceServer interface {
+	// Collect removes the content and committed snapshots referenced by no
+	// lease, image, container or active snapshot, in all namespaces.
+	// It fails while the collections are paused, unless it is a dry run.
+	Collect(context.Context, *CollectRequest) (*CollectResponse, error)
+	// Report returns what a collection would remove and the disk space it
+	// would reclaim, without removing anything, even while the collections
+	// are paused. The report of the last dry run is returned unless it is
+	// older than a minute or a collection ran since.
+	Report(context.Context, *ReportRequest) (*ReportResponse, error)
+	// Prune deletes the stopped containers and removes the images used by
+	// no container of the namespace, then the content and committed
+	// snapshots referenced by nothing in all namespaces, as selected by
+	// the request. It fails while the collections are paused, unless it is
+	// a dry run.
+	Prune(context.Context, *PruneRequest) (*PruneResponse, error)
+	// Pause interrupts the running collections and defers the next ones
+	// until Resume is called or the pause expires.
+	Pause(context.Context, *PauseRequest) (*google_protobuf.Empty, error)
+	// Resume lets the collections due run again.
+	Resume(context.Context, *ResumeRequest) (*google_protobuf.Empty, error)
+}
+
+func RegisterGCServiceServer(s *grpc.Server, srv GCServiceServer) {
+	s.RegisterService(&_GCService_serviceDesc, srv)
+}
+
+func _GCService_Collect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GCServiceServer).Collect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.gc.GCService/Collect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GCServiceServer).Collect(ctx, req.(*CollectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GCService_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GCServiceServer).Report(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.gc.GCService/Report",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GCServiceServer).Report(ctx, req.(*ReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GCService_Prune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GCServiceServer).Prune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.gc.GCService/Prune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GCServiceServer).Prune(ctx, req.(*PruneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GCService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GCServiceServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.gc.GCService/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GCServiceServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GCService_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GCServiceServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/containerd.v1.gc.GCService/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GCServiceServer).Resume(ctx, req.(*ResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GCService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.v1.gc.GCService",
+	HandlerType: (*GCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Collect",
+			Handler:    _GCService_Collect_Handler,
+		},
+		{
+			MethodName: "Report",
+			Handler:    _GCService_Report_Handler,
+		},
+		{
+			MethodName: "Prune",
+			Handler:    _GCService_Prune_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _GCService_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _GCService_Resume_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gc.proto",
+}
+
+func (m *CollectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		dAtA[i] = 0x8
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *CollectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for _, msg := range m.Removed {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Reclaimed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(m.Reclaimed))
+	}
+	if m.Scanned != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(m.Scanned))
+	}
+	return i, nil
+}
+
+func (m *ReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *PruneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Containers {
+		dAtA[i] = 0x8
+		i++
+		if m.Containers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Images {
+		dAtA[i] = 0x10
+		i++
+		if m.Images {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Content {
+		dAtA[i] = 0x18
+		i++
+		if m.Content {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Snapshots {
+		dAtA[i] = 0x20
+		i++
+		if m.Snapshots {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DryRun {
+		dAtA[i] = 0x28
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PruneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for _, msg := range m.Removed {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Reclaimed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(m.Reclaimed))
+	}
+	return i, nil
+}
+
+func (m *ReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removable) > 0 {
+		for _, msg := range m.Removable {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Reclaimable != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(m.Reclaimable))
+	}
+	if m.Scanned != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(m.Scanned))
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func (m *Resource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(m.Size_))
+	}
+	return i, nil
+}
+
+func (m *PauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintGc(dAtA, i, uint64(m.Duration))
+	}
+	return i, nil
+}
+
+func (m *ResumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func encodeFixed64Gc(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Gc(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintGc(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *CollectRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *CollectResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovGc(uint64(l))
+		}
+	}
+	if m.Reclaimed != 0 {
+		n += 1 + sovGc(uint64(m.Reclaimed))
+	}
+	if m.Scanned != 0 {
+		n += 1 + sovGc(uint64(m.Scanned))
+	}
+	return n
+}
+
+func (m *ReportRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PruneRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Containers {
+		n += 2
+	}
+	if m.Images {
+		n += 2
+	}
+	if m.Content {
+		n += 2
+	}
+	if m.Snapshots {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *PruneResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovGc(uint64(l))
+		}
+	}
+	if m.Reclaimed != 0 {
+		n += 1 + sovGc(uint64(m.Reclaimed))
+	}
+	return n
+}
+
+func (m *ReportResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Removable) > 0 {
+		for _, e := range m.Removable {
+			l = e.Size()
+			n += 1 + l + sovGc(uint64(l))
+		}
+	}
+	if m.Reclaimable != 0 {
+		n += 1 + sovGc(uint64(m.Reclaimable))
+	}
+	if m.Scanned != 0 {
+		n += 1 + sovGc(uint64(m.Scanned))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovGc(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *Resource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovGc(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovGc(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovGc(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *PauseRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		n += 1 + sovGc(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *ResumeRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func sovGc(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozGc(x uint64) (n int) {
+	return sovGc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CollectRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CollectRequest{`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CollectResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CollectResponse{`,
+		`Removed:` + strings.Replace(fmt.Sprintf("%v", this.Removed), "Resource", "Resource", 1) + `,`,
+		`Reclaimed:` + fmt.Sprintf("%v", this.Reclaimed) + `,`,
+		`Scanned:` + fmt.Sprintf("%v", this.Scanned) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReportRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReportRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PruneRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PruneRequest{`,
+		`Containers:` + fmt.Sprintf("%v", this.Containers) + `,`,
+		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`Content:` + fmt.Sprintf("%v", this.Content) + `,`,
+		`Snapshots:` + fmt.Sprintf("%v", this.Snapshots) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PruneResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PruneResponse{`,
+		`Removed:` + strings.Replace(fmt.Sprintf("%v", this.Removed), "Resource", "Resource", 1) + `,`,
+		`Reclaimed:` + fmt.Sprintf("%v", this.Reclaimed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReportResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReportResponse{`,
+		`Removable:` + strings.Replace(fmt.Sprintf("%v", this.Removable), "Resource", "Resource", 1) + `,`,
+		`Reclaimable:` + fmt.Sprintf("%v", this.Reclaimable) + `,`,
+		`Scanned:` + fmt.Sprintf("%v", this.Scanned) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Resource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Resource{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseRequest{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResumeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResumeRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGc(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CollectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, &Resource{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reclaimed", wireType)
+			}
+			m.Reclaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reclaimed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scanned", wireType)
+			}
+			m.Scanned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scanned |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Containers = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Images = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Content = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snapshots = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, &Resource{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reclaimed", wireType)
+			}
+			m.Reclaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reclaimed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removable = append(m.Removable, &Resource{})
+			if err := m.Removable[len(m.Removable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reclaimable", wireType)
+			}
+			m.Reclaimable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reclaimable |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scanned", wireType)
+			}
+			m.Scanned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scanned |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Resource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthGc
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowGc
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipGc(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthGc = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGc   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("gc.proto", fileDescriptorGc) }
+
+var fileDescriptorGc = []byte{
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xee, 0x26, 0xad, 0xe3, 0x4c, 0xff, 0xd0, 0x0a, 0x15, 0xcb, 0x54, 0x8e, 0xf1, 0x29, 0x70,
+	0x70, 0x44, 0xe1, 0xc0, 0x0d, 0xd1, 0x82, 0xf8, 0x93, 0x50, 0xb5, 0x3c, 0x00, 0x72, 0xec, 0xc1,
+	0x58, 0x8a, 0xbd, 0x66, 0x77, 0x1d, 0x29, 0x5c, 0xe0, 0x2d, 0x78, 0x01, 0x1e, 0xa6, 0x47, 0x8e,
+	0x9c, 0x10, 0xc9, 0x13, 0x70, 0xe2, 0x8c, 0xbc, 0xb6, 0x13, 0x97, 0x26, 0x88, 0x0b, 0xb7, 0x9d,
+	0x99, 0xcf, 0xf3, 0x7d, 0xfb, 0xcd, 0x78, 0xc1, 0x8c, 0x43, 0x3f, 0x17, 0x5c, 0x71, 0x7a, 0x2d,
+	0xe4, 0x99, 0x0a, 0x92, 0x0c, 0x45, 0xe4, 0x4f, 0xef, 0xfa, 0x71, 0x68, 0xdf, 0x8c, 0x39, 0x8f,
+	0x27, 0x38, 0xd2, 0xf5, 0x71, 0xf1, 0x76, 0x84, 0x69, 0xae, 0x66, 0x15, 0xdc, 0xbe, 0x1e, 0xf3,
+	0x98, 0xeb, 0xe3, 0xa8, 0x3c, 0x55, 0x59, 0xef, 0x36, 0x1c, 0x9c, 0xf1, 0xc9, 0x04, 0x43, 0xc5,
+	0xf0, 0x7d, 0x81, 0x52, 0xd1, 0x1b, 0xd0, 0x8b, 0xc4, 0xec, 0x8d, 0x28, 0x32, 0x8b, 0xb8, 0x64,
+	0x68, 0x32, 0x23, 0x12, 0x33, 0x56, 0x64, 0xde, 0x47, 0x38, 0x5c, 0x42, 0x65, 0xce, 0x33, 0x89,
+	0xf4, 0x3e, 0xf4, 0x04, 0xa6, 0x7c, 0x8a, 0x91, 0x45, 0xdc, 0xee, 0x70, 0xf7, 0xc4, 0xf6, 0xff,
+	0x14, 0xe5, 0x33, 0x94, 0xbc, 0x10, 0x21, 0xb2, 0x06, 0x4a, 0x8f, 0xa1, 0x2f, 0x30, 0x9c, 0x04,
+	0x49, 0x8a, 0x91, 0xd5, 0x71, 0xc9, 0xb0, 0xcb, 0x56, 0x09, 0x6a, 0x41, 0x4f, 0x86, 0x41, 0x96,
+	0x61, 0x64, 0x75, 0x75, 0xad, 0x09, 0xbd, 0x43, 0xd8, 0x67, 0x98, 0x73, 0xd1, 0x48, 0xf5, 0x3e,
+	0x13, 0xd8, 0x3b, 0x17, 0x45, 0x86, 0x8d, 0x76, 0x07, 0x60, 0xc9, 0x2f, 0x6b, 0xf9, 0xad, 0x0c,
+	0x3d, 0x02, 0x23, 0x49, 0x83, 0x18, 0xa5, 0xa6, 0x35, 0x59, 0x1d, 0x95, 0x9c, 0x25, 0x0a, 0x33,
+	0xa5, 0x39, 0x4d, 0xd6, 0x84, 0xa5, 0x56, 0x99, 0x05, 0xb9, 0x7c, 0xc7, 0x95, 0xb4, 0xb6, 0x75,
+	0x6d, 0x95, 0x68, 0x7b, 0xb5, 0x73, 0xc9, 0xab, 0x10, 0xf6, 0x6b, 0x61, 0xff, 0xcf, 0x29, 0xef,
+	0x0b, 0x81, 0x83, 0xc6, 0x90, 0x9a, 0xe6, 0x41, 0xf9, 0x41, 0xca, 0xa7, 0xc1, 0x78, 0x82, 0xff,
+	0x40, 0xb4, 0x02, 0x53, 0x17, 0x76, 0xeb, 0xce, 0xfa, 0xdb, 0x8a, 0xac, 0x9d, 0xda, 0x3c, 0x98,
+	0x52, 0xa6, 0x4a, 0x52, 0x94, 0x2a, 0x48, 0x73, 0x6d, 0x52, 0x97, 0xad, 0x12, 0xde, 0x0b, 0x30,
+	0x1b, 0x42, 0x4a, 0x61, 0x5b, 0xcd, 0x72, 0xd4, 0xa3, 0xe9, 0x33, 0x7d, 0xa6, 0x47, 0xd0, 0x49,
+	0xaa, 0xdb, 0xf5, 0x4f, 0x8d, 0xc5, 0xf7, 0x41, 0xe7, 0xf9, 0x63, 0xd6, 0x49, 0xa2, 0x12, 0x2b,
+	0x93, 0x0f, 0x58, 0x93, 0xe9, 0xb3, 0x77, 0x07, 0xf6, 0xce, 0x83, 0x42, 0x2e, 0x07, 0x6e, 0x83,
+	0x19, 0x15, 0x22, 0x50, 0x09, 0xaf, 0xb6, 0xb5, 0xcb, 0x96, 0x71, 0xb5, 0x2e, 0xb2, 0x48, 0x1b,
+	0xf0, 0xc9, 0xaf, 0x0e, 0xf4, 0x9f, 0x9e, 0xbd, 0x46, 0x31, 0x4d, 0x42, 0xa4, 0xaf, 0xa0, 0x57,
+	0xaf, 0x33, 0x75, 0xaf, 0x5a, 0x74, 0xf9, 0xa7, 0xb0, 0x6f, 0xfd, 0x05, 0x51, 0x5b, 0xff, 0x12,
+	0x8c, 0x6a, 0x18, 0x74, 0xb0, 0xce, 0xf1, 0xd6, 0xde, 0xda, 0xee, 0x66, 0x40, 0xdd, 0xec, 0x19,
+	0xec, 0xe8, 0xfd, 0xa1, 0xce, 0x55, 0x68, 0x7b, 0xe3, 0xed, 0xc1, 0xc6, 0x7a, 0xdd, 0xe9, 0x21,
+	0xec, 0x68, 0xc7, 0xd6, 0x76, 0x6a, 0x59, 0x69, 0x1f, 0xf9, 0xd5, 0xeb, 0xe1, 0x37, 0xaf, 0x87,
+	0xff, 0xa4, 0x7c, 0x3d, 0xe8, 0x23, 0x30, 0x2a, 0x1b, 0xd7, 0xdf, 0xab, 0x65, 0xf0, 0xa6, 0x16,
+	0xa7, 0xc7, 0x17, 0x73, 0x67, 0xeb, 0xdb, 0xdc, 0xd9, 0xfa, 0x39, 0x77, 0xc8, 0xa7, 0x85, 0x43,
+	0x2e, 0x16, 0x0e, 0xf9, 0xba, 0x70, 0xc8, 0x8f, 0x85, 0x43, 0xc6, 0x86, 0x46, 0xdf, 0xfb, 0x3d,
+	0x00, 0xbd, 0x37, 0x15, 0xeb, 0xda, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,1876 @@
+// Code generated by protoc-gen-gogo.
+// source: migration.proto
+// DO NOT EDIT!
+
+/*
+	Package migration is a generated protocol buffer package.
+
+	It is generated from these files:
+		migration.proto
+
+	It has these top-level messages:
+		PreCopyRequest
+		HandoffRequest
+		PrepareRequest
+		CutoverRequest
+		Progress
+*/
+package migration
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import containerd_v1 "github.com/docker/containerd/api/execution"
+
+import strings "strings"
+import github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
+import sort "sort"
+import strconv "strconv"
+import reflect "reflect"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type PreCopyRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference is the repository the pre-copy is pushed to, such as
+	// "registry.example.com/migrations/web".
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (m *PreCopyRequest) Reset()                    { *m = PreCopyRequest{} }
+func (*PreCopyRequest) ProtoMessage()               {}
+func (*PreCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptorMigration, []int{0} }
+
+type HandoffRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference is the repository and tag the checkpoint is pushed to.
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Rootfs includes the whole rootfs of the container in the checkpoint,
+	// for the filesystem of the container to be restored along with it. The
+	// rootfs of the target bundle is replaced by it, the files missing from
+	// the checkpoint being removed.
+	Rootfs         bool     `protobuf:"varint,3,opt,name=rootfs,proto3" json:"rootfs,omitempty"`
+	TCPEstablished bool     `protobuf:"varint,4,opt,name=tcp_established,json=tcpEstablished,proto3" json:"tcp_established,omitempty"`
+	UnixSockets    bool     `protobuf:"varint,5,opt,name=unix_sockets,json=unixSockets,proto3" json:"unix_sockets,omitempty"`
+	Shell          bool     `protobuf:"varint,6,opt,name=shell,proto3" json:"shell,omitempty"`
+	EmptyNS        []string `protobuf:"bytes,7,rep,name=empty_ns,json=emptyNs" json:"empty_ns,omitempty"`
+	// Snapshot is the key of the active snapshot the rootfs of the container
+	// is mounted from, its changes being included in the checkpoint as its
+	// writable layer, applied with their deletions over the rootfs of the
+	// target bundle, prepared from the same image.
+	Snapshot string `protobuf:"bytes,8,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (m *HandoffRequest) Reset()                    { *m = HandoffRequest{} }
+func (*HandoffRequest) ProtoMessage()               {}
+func (*HandoffRequest) Descriptor() ([]byte, []int) { return fileDescriptorMigration, []int{1} }
+
+type PrepareRequest struct {
+	// Reference is the pre-copy, by digest, as reported by PreCopy.
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (m *PrepareRequest) Reset()                    { *m = PrepareRequest{} }
+func (*PrepareRequest) ProtoMessage()               {}
+func (*PrepareRequest) Descriptor() ([]byte, []int) { return fileDescriptorMigration, []int{2} }
+
+type CutoverRequest struct {
+	// Reference is the checkpoint, as reported by Handoff.
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Container is the container restored, its checkpoint path is set to
+	// the extracted checkpoint.
+	Container *containerd_v1.CreateContainerRequest `protobuf:"bytes,2,opt,name=container" json:"container,omitempty"`
+}
+
+func (m *CutoverRequest) Reset()                    { *m = CutoverRequest{} }
+func (*CutoverRequest) ProtoMessage()               {}
+func (*CutoverRequest) Descriptor() ([]byte, []int) { return fileDescriptorMigration, []int{3} }
+
+// Progress reports the stage of a migration, and the transfer of the blobs
+// of the checkpoints while they are pushed or fetched.
+type Progress struct {
+	// Stage is "pre-dump", "checkpoint", "push", "fetch", "extract",
+	// "restore" or "done".
+	Stage  string `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Digest string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Offset and Total are in bytes of the blob of digest.
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Total  int64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// Reference is set once done to the checkpoint pushed or fetched, by
+	// digest.
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Container is set once the cutover is done.
+	Container *containerd_v1.CreateContainerResponse `protobuf:"bytes,6,opt,name=container" json:"container,omitempty"`
+}
+
+func (m *Progress) Reset()                    { *m = Progress{} }
+func (*Progress) ProtoMessage()               {}
+func (*Progress) Descriptor() ([]byte, []int) { return fileDescriptorMigration, []int{4} }
+
+func init() {
+	proto.RegisterType((*PreCopyRequest)(nil), "containerd.v1.migration.PreCopyRequest")
+	proto.RegisterType((*HandoffRequest)(nil), "containerd.v1.migration.HandoffRequest")
+	proto.RegisterType((*PrepareRequest)(nil), "containerd.v1.migration.PrepareRequest")
+	proto.RegisterType((*CutoverRequest)(nil), "containerd.v1.migration.CutoverRequest")
+	proto.RegisterType((*Progress)(nil), "containerd.v1.migration.Progress")
+}
+func (this *PreCopyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&migration.PreCopyRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HandoffRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&migration.HandoffRequest{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	s = append(s, "Rootfs: "+fmt.Sprintf("%#v", this.Rootfs)+",\n")
+	s = append(s, "TCPEstablished: "+fmt.Sprintf("%#v", this.TCPEstablished)+",\n")
+	s = append(s, "UnixSockets: "+fmt.Sprintf("%#v", this.UnixSockets)+",\n")
+	s = append(s, "Shell: "+fmt.Sprintf("%#v", this.Shell)+",\n")
+	s = append(s, "EmptyNS: "+fmt.Sprintf("%#v", this.EmptyNS)+",\n")
+	s = append(s, "Snapshot: "+fmt.Sprintf("%#v", this.Snapshot)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PrepareRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&migration.PrepareRequest{")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CutoverRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&migration.CutoverRequest{")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	if this.Container != nil {
+		s = append(s, "Container: "+fmt.Sprintf("%#v", this.Container)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Progress) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&migration.Progress{")
+	s = append(s, "Stage: "+fmt.Sprintf("%#v", this.Stage)+",\n")
+	s = append(s, "Digest: "+fmt.Sprintf("%#v", this.Digest)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	if this.Container != nil {
+		s = append(s, "Container: "+fmt.Sprintf("%#v", this.Container)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMigration(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func extensionToGoStringMigration(m github_com_gogo_protobuf_proto.Message) string {
+	e := github_com_gogo_protobuf_proto.GetUnsafeExtensionsMap(m)
+	if e == nil {
+		return "nil"
+	}
+	s := "proto.NewUnsafeXXX_InternalExtensions(map[int32]proto.Extension{"
+	keys := make([]int, 0, len(e))
+	for k := range e {
+		keys = append(keys, int(k))
+	}
+	sort.Ints(keys)
+	ss := []string{}
+	for _, k := range keys {
+		ss = append(ss, strconv.Itoa(k)+": "+e[int32(k)].GoString())
+	}
+	s += strings.Join(ss, ",") + "})"
+	return s
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for MigrationService service
+
+type MigrationServiceClient interface {
+	// PreCopy pre-dumps the memory of a running container and pushes it
+	// to the repository of the reference, by digest.
+	PreCopy(ctx context.Context, in *PreCopyRequest, opts ...grpc.CallOption) (MigrationService_PreCopyClient, error)
+	// Handoff stops a running container once checkpointed, incrementally
+	// to its pre-copy if any, and pushes the checkpoint to the reference.
+	// The checkpoint is named by the reference in the image store.
+	Handoff(ctx context.Context, in *HandoffRequest, opts ...grpc.CallOption) (MigrationService_HandoffClient, error)
+	// Prepare fetches a pre-copy into the content store ahead of the
+	// cutover, it is pinned for an hour.
+	Prepare(ctx context.Context, in *PrepareRequest, opts ...grpc.CallOption) (MigrationService_PrepareClient, error)
+	// Cutover fetches a checkpoint, along with its pre-copy unless it was
+	// prepared, and restores the container from it. The rootfs of the
+	// checkpoint, if any, is extracted into the rootfs of the bundle of the
+	// container.
+	Cutover(ctx context.Context, in *CutoverRequest, opts ...grpc.CallOption) (MigrationService_CutoverClient, error)
+}
+
+type migrationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMigrationServiceClient(cc *grpc.ClientConn) MigrationServiceClient {
+	return &migrationServiceClient{cc}
+}
+
+func (c *migrationServiceClient) PreCopy(ctx context.Context, in *PreCopyRequest, opts ...grpc.CallOption) (MigrationService_PreCopyClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_MigrationService_serviceDesc.Streams[0], c.cc, "/containerd.v1.migration.MigrationService/PreCopy", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &migrationServicePreCopyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MigrationService_PreCopyClient interface {
+	Recv() (*Progress, error)
+	grpc.ClientStream
+}
+
+type migrationServicePreCopyClient struct {
+	grpc.ClientStream
+}
+
+func (x *migrationServicePreCopyClient) Recv() (*Progress, error) {
+	m := new(Progress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *migrationServiceClient) Handoff(ctx context.Context, in *HandoffRequest, opts ...grpc.CallOption) (MigrationService_HandoffClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_MigrationService_serviceDesc.Streams[1], c.cc, "/containerd.v1.migration.MigrationService/Handoff", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &migrationServiceHandoffClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MigrationService_HandoffClient interface {
+	Recv() (*Progress, error)
+	grpc.ClientStream
+}
+
+type migrationServiceHandoffClient struct {
+	grpc.ClientStream
+}
+
+func (x *migrationServiceHandoffClient) Recv() (*Progress, error) {
+	m := new(Progress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *migrationServiceClient) Prepare(ctx context.Context, in *PrepareRequest, opts ...grpc.CallOption) (MigrationService_PrepareClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_MigrationService_serviceDesc.Streams[2], c.cc, "/containerd.v1.migration.MigrationService/Prepare", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &migrationServicePrepareClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MigrationService_PrepareClient interface {
+	Recv() (*Progress, error)
+	grpc.ClientStream
+}
+
+type migrationServicePrepareClient struct {
+	grpc.ClientStream
+}
+
+func (x *migrationServicePrepareClient) Recv() (*Progress, error) {
+	m := new(Progress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *migrationServiceClient) Cutover(ctx context.Context, in *CutoverRequest, opts ...grpc.CallOption) (MigrationService_CutoverClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_MigrationService_serviceDesc.Streams[3], c.cc, "/containerd.v1.migration.MigrationService/Cutover", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &migrationServiceCutoverClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MigrationService_CutoverClient interface {
+	Recv() (*Progress, error)
+	grpc.ClientStream
+}
+
+type migrationServiceCutoverClient struct {
+	grpc.ClientStream
+}
+
+func (x *migrationServiceCutoverClient) Recv() (*Progress, error) {
+	m := new(Progress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for MigrationService service
+
+type MigrationServiceServer interface {
+	// PreCopy pre-dumps the memory of a running container and pushes it
+	// to the repository of the reference, by digest.
+	PreCopy(*PreCopyRequest, MigrationService_PreCopyServer) error
+	// Handoff stops a running container once checkpointed, incrementally
+	// to its pre-copy if any, and pushes the checkpoint to the reference.
+	// The checkpoint is named by the reference in the image store.
+	Handoff(*HandoffRequest, MigrationService_HandoffServer) error
+	// Prepare fetches a pre-copy into the content store ahead of the
+	// cutover, it is pinned for an hour.
+	Prepare(*PrepareRequest, MigrationService_PrepareServer) error
+	// Cutover fetches a checkpoint, along with its pre-copy unless it was
+	// prepared, and restores the container from it. The rootfs of the
+	// checkpoint, if any, is extracted into the rootfs of the bundle of the
+	// container.
+	Cutover(*CutoverRequest, MigrationService_CutoverServer) error
+}
+
+func RegisterMigrationServiceServer(s *grpc.Server, srv MigrationServiceServer) {
+	s.RegisterService(&_MigrationService_serviceDesc, srv)
+}
+
+func _MigrationService_PreCopy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PreCopyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MigrationServiceServer).PreCopy(m, &migrationServicePreCopyServer{stream})
+}
+
+type MigrationService_PreCopyServer interface {
+	Send(*Progress) error
+	grpc.ServerStream
+}
+
+type migrationServicePreCopyServer struct {
+	grpc.ServerStream
+}
+
+func (x *migrationServicePreCopyServer) Send(m *Progress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MigrationService_Handoff_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HandoffRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MigrationServiceServer).Handoff(m, &migrationServiceHandoffServer{stream})
+}
+
+type MigrationService_HandoffServer interface {
+	Send(*Progress) error
+	grpc.ServerStream
+}
+
+type migrationServiceHandoffServer struct {
+	grpc.ServerStream
+}
+
+func (x *migrationServiceHandoffServer) Send(m *Progress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MigrationService_Prepare_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PrepareRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MigrationServiceServer).Prepare(m, &migrationServicePrepareServer{stream})
+}
+
+type MigrationService_PrepareServer interface {
+	Send(*Progress) error
+	grpc.ServerStream
+}
+
+type migrationServicePrepareServer struct {
+	grpc.ServerStream
+}
+
+func (x *migrationServicePrepareServer) Send(m *Progress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MigrationService_Cutover_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CutoverRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MigrationServiceServer).Cutover(m, &migrationServiceCutoverServer{stream})
+}
+
+type MigrationService_CutoverServer interface {
+	Send(*Progress) error
+	grpc.ServerStream
+}
+
+type migrationServiceCutoverServer struct {
+	grpc.ServerStream
+}
+
+func (x *migrationServiceCutoverServer) Send(m *Progress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _MigrationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "containerd.v1.migration.MigrationService",
+	HandlerType: (*MigrationServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PreCopy",
+			Handler:       _MigrationService_PreCopy_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Handoff",
+			Handler:       _MigrationService_Handoff_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Prepare",
+			Handler:       _MigrationService_Prepare_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Cutover",
+			Handler:       _MigrationService_Cutover_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "migration.proto",
+}
+
+func (m *PreCopyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreCopyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Reference) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Reference)))
+		i += copy(dAtA[i:], m.Reference)
+	}
+	return i, nil
+}
+
+func (m *HandoffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandoffRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Reference) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Reference)))
+		i += copy(dAtA[i:], m.Reference)
+	}
+	if m.Rootfs {
+		dAtA[i] = 0x18
+		i++
+		if m.Rootfs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.TCPEstablished {
+		dAtA[i] = 0x20
+		i++
+		if m.TCPEstablished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.UnixSockets {
+		dAtA[i] = 0x28
+		i++
+		if m.UnixSockets {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Shell {
+		dAtA[i] = 0x30
+		i++
+		if m.Shell {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.EmptyNS) > 0 {
+		for _, s := range m.EmptyNS {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Snapshot) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Snapshot)))
+		i += copy(dAtA[i:], m.Snapshot)
+	}
+	return i, nil
+}
+
+func (m *PrepareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Reference)))
+		i += copy(dAtA[i:], m.Reference)
+	}
+	return i, nil
+}
+
+func (m *CutoverRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CutoverRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Reference)))
+		i += copy(dAtA[i:], m.Reference)
+	}
+	if m.Container != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(m.Container.Size()))
+		n1, err := m.Container.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *Progress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Progress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stage) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Stage)))
+		i += copy(dAtA[i:], m.Stage)
+	}
+	if len(m.Digest) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Digest)))
+		i += copy(dAtA[i:], m.Digest)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(m.Offset))
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(m.Total))
+	}
+	if len(m.Reference) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Reference)))
+		i += copy(dAtA[i:], m.Reference)
+	}
+	if m.Container != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMigration(dAtA, i, uint64(m.Container.Size()))
+		n2, err := m.Container.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func encodeFixed64Migration(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Migration(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintMigration(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *PreCopyRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	return n
+}
+
+func (m *HandoffRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	if m.Rootfs {
+		n += 2
+	}
+	if m.TCPEstablished {
+		n += 2
+	}
+	if m.UnixSockets {
+		n += 2
+	}
+	if m.Shell {
+		n += 2
+	}
+	if len(m.EmptyNS) > 0 {
+		for _, s := range m.EmptyNS {
+			l = len(s)
+			n += 1 + l + sovMigration(uint64(l))
+		}
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	return n
+}
+
+func (m *PrepareRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	return n
+}
+
+func (m *CutoverRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	return n
+}
+
+func (m *Progress) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Stage)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovMigration(uint64(m.Offset))
+	}
+	if m.Total != 0 {
+		n += 1 + sovMigration(uint64(m.Total))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	return n
+}
+
+func sovMigration(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMigration(x uint64) (n int) {
+	return sovMigration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PreCopyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PreCopyRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HandoffRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HandoffRequest{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`Rootfs:` + fmt.Sprintf("%v", this.Rootfs) + `,`,
+		`TCPEstablished:` + fmt.Sprintf("%v", this.TCPEstablished) + `,`,
+		`UnixSockets:` + fmt.Sprintf("%v", this.UnixSockets) + `,`,
+		`Shell:` + fmt.Sprintf("%v", this.Shell) + `,`,
+		`EmptyNS:` + fmt.Sprintf("%v", this.EmptyNS) + `,`,
+		`Snapshot:` + fmt.Sprintf("%v", this.Snapshot) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrepareRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrepareRequest{`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CutoverRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CutoverRequest{`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "CreateContainerRequest", "containerd_v1.CreateContainerRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Progress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Progress{`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "CreateContainerResponse", "containerd_v1.CreateContainerResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMigration(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PreCopyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreCopyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreCopyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandoffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandoffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandoffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rootfs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rootfs = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPEstablished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TCPEstablished = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixSockets", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnixSockets = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shell = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyNS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmptyNS = append(m.EmptyNS, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrepareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CutoverRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CutoverRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CutoverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &containerd_v1.CreateContainerRequest{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Progress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Progress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Progress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &containerd_v1.CreateContainerResponse{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMigration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthMigration
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowMigration
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipMigration(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthMigration = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMigration   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("migration.proto", fileDescriptorMigration) }
+
+var fileDescriptorMigration = []byte{
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xed, 0x38, 0x5f, 0xf3, 0x33, 0xfd, 0x94, 0xa2, 0x51, 0x55, 0xac, 0xa8, 0x72, 0xd2, 0x48,
+	0x94, 0xac, 0x1c, 0x28, 0x3b, 0xd8, 0xc5, 0x2d, 0x82, 0x05, 0x55, 0xe4, 0x20, 0xb1, 0x8c, 0x1c,
+	0xfb, 0xda, 0x19, 0x91, 0x78, 0xcc, 0xcc, 0x24, 0x6a, 0x77, 0x3c, 0x06, 0x8f, 0x54, 0xb1, 0x62,
+	0xc9, 0x2a, 0x22, 0x7e, 0x02, 0x56, 0xac, 0xd1, 0x8c, 0x9d, 0x1f, 0x57, 0x22, 0x44, 0x62, 0xe7,
+	0x73, 0xef, 0xf5, 0x49, 0xce, 0xb9, 0xe7, 0x1a, 0x1f, 0x4f, 0x69, 0xc4, 0x3d, 0x49, 0x59, 0x6c,
+	0x27, 0x9c, 0x49, 0x46, 0x1e, 0xfb, 0x2c, 0x96, 0x1e, 0x8d, 0x81, 0x07, 0xf6, 0xfc, 0xb9, 0xbd,
+	0x6e, 0x37, 0x4e, 0x22, 0x16, 0x31, 0x3d, 0xd3, 0x55, 0x4f, 0xd9, 0x78, 0xe3, 0x65, 0x44, 0xe5,
+	0x78, 0x36, 0xb2, 0x7d, 0x36, 0xed, 0x06, 0xcc, 0xff, 0x08, 0xbc, 0xbb, 0x21, 0xe8, 0x7a, 0x09,
+	0xed, 0xc2, 0x2d, 0xf8, 0x33, 0xc5, 0xb0, 0x79, 0xca, 0xde, 0x6d, 0xbf, 0xc6, 0xf5, 0x3e, 0x07,
+	0x87, 0x25, 0x77, 0x2e, 0x7c, 0x9a, 0x81, 0x90, 0xe4, 0x14, 0x1b, 0x34, 0x30, 0x51, 0x0b, 0x75,
+	0x6a, 0xbd, 0x72, 0xba, 0x68, 0x1a, 0x6f, 0xaf, 0x5c, 0x83, 0x06, 0xe4, 0x0c, 0xd7, 0x38, 0x84,
+	0xc0, 0x21, 0xf6, 0xc1, 0x34, 0x54, 0xdb, 0xdd, 0x14, 0xda, 0x5f, 0x0c, 0x5c, 0x7f, 0xe3, 0xc5,
+	0x01, 0x0b, 0xc3, 0x7f, 0x22, 0x22, 0xa7, 0xb8, 0xcc, 0x19, 0x93, 0xa1, 0x30, 0x4b, 0x2d, 0xd4,
+	0xa9, 0xba, 0x39, 0x22, 0xaf, 0xf0, 0xb1, 0xf4, 0x93, 0x21, 0x08, 0xe9, 0x8d, 0x26, 0x54, 0x8c,
+	0x21, 0x30, 0xff, 0x53, 0x03, 0x3d, 0x92, 0x2e, 0x9a, 0xf5, 0xf7, 0x4e, 0xff, 0x7a, 0xd3, 0x71,
+	0xeb, 0xd2, 0x4f, 0xb6, 0x30, 0x39, 0xc7, 0xff, 0xcf, 0x62, 0x7a, 0x3b, 0x14, 0xca, 0x1d, 0x29,
+	0xcc, 0x43, 0x4d, 0x7d, 0xa4, 0x6a, 0x83, 0xac, 0x44, 0x4e, 0xf0, 0xa1, 0x18, 0xc3, 0x64, 0x62,
+	0x96, 0x75, 0x2f, 0x03, 0xe4, 0x02, 0x57, 0x61, 0x9a, 0xc8, 0xbb, 0x61, 0x2c, 0xcc, 0x4a, 0xab,
+	0xd4, 0xa9, 0xf5, 0x8e, 0xd2, 0x45, 0xb3, 0x72, 0xad, 0x6a, 0x37, 0x03, 0xb7, 0xa2, 0x9b, 0x37,
+	0x82, 0x34, 0x70, 0x55, 0xc4, 0x5e, 0x22, 0xc6, 0x4c, 0x9a, 0x55, 0x2d, 0x69, 0x8d, 0xdb, 0xb6,
+	0xb6, 0x38, 0xf1, 0x38, 0xac, 0x9c, 0x29, 0x38, 0x80, 0x1e, 0x5a, 0x29, 0x70, 0xdd, 0x99, 0x49,
+	0x36, 0x07, 0xbe, 0xd7, 0x3c, 0x71, 0x70, 0x6d, 0xbd, 0x6e, 0xed, 0xe7, 0xd1, 0xe5, 0x13, 0xbb,
+	0x98, 0x20, 0x87, 0x83, 0x27, 0xc1, 0x59, 0xd5, 0x72, 0x5e, 0x77, 0xf3, 0x5e, 0xfb, 0x2b, 0xc2,
+	0xd5, 0x3e, 0x67, 0x11, 0x07, 0x91, 0x79, 0x21, 0xbd, 0x68, 0xf5, 0x5b, 0x19, 0x50, 0x9b, 0x09,
+	0x68, 0x04, 0x42, 0xe6, 0x4b, 0xcb, 0x91, 0xaa, 0xb3, 0x30, 0x14, 0x20, 0xf5, 0xc6, 0x4a, 0x6e,
+	0x8e, 0x14, 0x8b, 0x64, 0xd2, 0x9b, 0xe8, 0x3d, 0x95, 0xdc, 0x0c, 0x14, 0xb5, 0x1c, 0x3e, 0xd4,
+	0x72, 0xb5, 0xad, 0xa5, 0xac, 0xb5, 0x5c, 0xfc, 0x4d, 0x8b, 0x48, 0x58, 0x2c, 0x60, 0x4b, 0xcc,
+	0xe5, 0x2f, 0x03, 0x3f, 0x7a, 0xb7, 0x3a, 0x9a, 0x01, 0xf0, 0x39, 0xf5, 0x81, 0x7c, 0xc0, 0x95,
+	0x3c, 0xe9, 0xe4, 0xa9, 0xfd, 0x87, 0x03, 0xb3, 0x8b, 0xb7, 0xd0, 0x38, 0xdf, 0x31, 0x98, 0x79,
+	0xf5, 0x0c, 0x29, 0xe2, 0x3c, 0xf9, 0x3b, 0x88, 0x8b, 0xb7, 0xb1, 0x37, 0x71, 0x1e, 0x9c, 0xdd,
+	0xff, 0x78, 0x2b, 0x5a, 0x7b, 0x13, 0xe7, 0x09, 0xdb, 0x41, 0x5c, 0xcc, 0xe0, 0x5e, 0xc4, 0xbd,
+	0xb3, 0xfb, 0xa5, 0x75, 0xf0, 0x7d, 0x69, 0x1d, 0xfc, 0x5c, 0x5a, 0xe8, 0x73, 0x6a, 0xa1, 0xfb,
+	0xd4, 0x42, 0xdf, 0x52, 0x0b, 0xfd, 0x48, 0x2d, 0x34, 0x2a, 0xeb, 0x4f, 0xce, 0x8b, 0xdf, 0x03,
+	0x00, 0xdb, 0x33, 0x6d, 0xec, 0xf0, 0x04, 0x00, 0x00,
+}
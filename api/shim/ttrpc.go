@@ -0,0 +1,165 @@
+package shim
+
+import (
+	gocontext "context"
+
+	"github.com/docker/containerd/ttrpc"
+	google_protobuf "github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+)
+
+const (
+	serviceName        = "containerd.v1.shim.Shim"
+	sandboxServiceName = "containerd.v1.shim.Sandbox"
+)
+
+// RegisterShimTTRPC registers the shim service with a ttrpc server.
+func RegisterShimTTRPC(srv *ttrpc.Server, svc ShimServer) {
+	srv.Register(serviceName, map[string]ttrpc.Method{
+		"State": func(ctx gocontext.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req StateRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.State(ctx, &req)
+		},
+		"Start": func(ctx gocontext.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req StartRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.Start(ctx, &req)
+		},
+		"Signal": func(ctx gocontext.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SignalRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.Signal(ctx, &req)
+		},
+		"Wait": func(ctx gocontext.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req WaitRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.Wait(ctx, &req)
+		},
+		"Pty": func(ctx gocontext.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req PtyRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.Pty(ctx, &req)
+		},
+		"CloseStdin": func(ctx gocontext.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req CloseStdinRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.CloseStdin(ctx, &req)
+		},
+	})
+}
+
+type shimTTRPCClient struct {
+	client *ttrpc.Client
+}
+
+// NewShimTTRPCClient returns a ShimServer that forwards calls over client.
+func NewShimTTRPCClient(client *ttrpc.Client) ShimServer {
+	return &shimTTRPCClient{client: client}
+}
+
+func (c *shimTTRPCClient) State(ctx context.Context, req *StateRequest) (*StateResponse, error) {
+	var resp StateResponse
+	if err := c.client.Call(ctx, serviceName, "State", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *shimTTRPCClient) Start(ctx context.Context, req *StartRequest) (*google_protobuf.Empty, error) {
+	var resp google_protobuf.Empty
+	if err := c.client.Call(ctx, serviceName, "Start", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *shimTTRPCClient) Signal(ctx context.Context, req *SignalRequest) (*google_protobuf.Empty, error) {
+	var resp google_protobuf.Empty
+	if err := c.client.Call(ctx, serviceName, "Signal", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *shimTTRPCClient) Wait(ctx context.Context, req *WaitRequest) (*WaitResponse, error) {
+	var resp WaitResponse
+	if err := c.client.Call(ctx, serviceName, "Wait", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *shimTTRPCClient) Pty(ctx context.Context, req *PtyRequest) (*google_protobuf.Empty, error) {
+	var resp google_protobuf.Empty
+	if err := c.client.Call(ctx, serviceName, "Pty", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *shimTTRPCClient) CloseStdin(ctx context.Context, req *CloseStdinRequest) (*google_protobuf.Empty, error) {
+	var resp google_protobuf.Empty
+	if err := c.client.Call(ctx, serviceName, "CloseStdin", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RegisterSandboxTTRPC registers the sandbox service with a ttrpc server.
+func RegisterSandboxTTRPC(srv *ttrpc.Server, svc SandboxServer) {
+	srv.Register(sandboxServiceName, map[string]ttrpc.Method{
+		"Create": func(ctx gocontext.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req CreateRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.Create(ctx, &req)
+		},
+		"Shutdown": func(ctx gocontext.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ShutdownRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.Shutdown(ctx, &req)
+		},
+	})
+}
+
+type sandboxTTRPCClient struct {
+	client *ttrpc.Client
+}
+
+// NewSandboxTTRPCClient returns a SandboxServer that forwards calls over
+// client.
+func NewSandboxTTRPCClient(client *ttrpc.Client) SandboxServer {
+	return &sandboxTTRPCClient{client: client}
+}
+
+func (c *sandboxTTRPCClient) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	var resp CreateResponse
+	if err := c.client.Call(ctx, sandboxServiceName, "Create", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *sandboxTTRPCClient) Shutdown(ctx context.Context, req *ShutdownRequest) (*google_protobuf.Empty, error) {
+	var resp google_protobuf.Empty
+	if err := c.client.Call(ctx, sandboxServiceName, "Shutdown", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,57 @@
+// Package apparmor loads the default AppArmor profile of containers.
+package apparmor
+
+import (
+	"bytes"
+	"text/template"
+)
+
+const (
+	// DefaultProfile is the name of the profile containers are confined
+	// by unless they select another one.
+	DefaultProfile = "containerd-default"
+	// Unconfined leaves the processes of a container unconfined.
+	Unconfined = "unconfined"
+)
+
+var profileTemplate = template.Must(template.New("apparmor").Parse(`#include <tunables/global>
+
+profile {{.Name}} flags=(attach_disconnected,mediate_deleted) {
+  #include <abstractions/base>
+
+  network,
+  capability,
+  file,
+  umount,
+
+  deny @{PROC}/* w,   # deny write for all files directly in /proc (not in a subdir)
+  # deny write to files not in /proc/<number>/** or /proc/sys/**
+  deny @{PROC}/{[^1-9],[^1-9][^0-9],[^1-9s][^0-9y][^0-9s],[^1-9][^0-9][^0-9][^0-9]*}/** w,
+  deny @{PROC}/sys/[^k]** w,  # deny /proc/sys except /proc/sys/k* (effectively /proc/sys/kernel)
+  deny @{PROC}/sys/kernel/{?,??,[^s][^h][^m]**} w,  # deny everything except shm* in /proc/sys/kernel/
+  deny @{PROC}/sysrq-trigger rwklx,
+  deny @{PROC}/kcore rwklx,
+
+  deny mount,
+
+  deny /sys/[^f]*/** wklx,
+  deny /sys/f[^s]*/** wklx,
+  deny /sys/fs/[^c]*/** wklx,
+  deny /sys/fs/c[^g]*/** wklx,
+  deny /sys/fs/cg[^r]*/** wklx,
+  deny /sys/firmware/** rwklx,
+  deny /sys/kernel/security/** rwklx,
+
+  # suppress ptrace denials when using 'ps' inside a container
+  ptrace (trace,read) peer={{.Name}},
+}
+`))
+
+// generate returns the text of the default profile.
+func generate() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := profileTemplate.Execute(&buf, struct{ Name string }{DefaultProfile}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,64 @@
+package apparmor
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const profilesPath = "/sys/kernel/security/apparmor/profiles"
+
+// IsEnabled returns whether AppArmor is enabled on the host and profiles can
+// be loaded.
+func IsEnabled() bool {
+	data, err := ioutil.ReadFile("/sys/module/apparmor/parameters/enabled")
+	if err != nil || !bytes.HasPrefix(data, []byte("Y")) {
+		return false
+	}
+	_, err = exec.LookPath("apparmor_parser")
+	return err == nil
+}
+
+// IsLoaded returns whether the profile is loaded in the kernel.
+func IsLoaded(name string) (bool, error) {
+	f, err := os.Open(profilesPath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// lines are of the form "name (mode)"
+		if strings.SplitN(s.Text(), " ", 2)[0] == name {
+			return true, nil
+		}
+	}
+	return false, s.Err()
+}
+
+// LoadDefaultProfile loads the default profile unless it is loaded already,
+// so that profiles adjusted by the administrator are kept.
+func LoadDefaultProfile() error {
+	loaded, err := IsLoaded(DefaultProfile)
+	if err != nil {
+		return err
+	}
+	if loaded {
+		return nil
+	}
+	profile, err := generate()
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command("apparmor_parser", "-Kr")
+	cmd.Stdin = bytes.NewReader(profile)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "apparmor_parser: %s", strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package apparmor
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	profile, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(profile, []byte("profile "+DefaultProfile+" ")) {
+		t.Fatalf("expected the profile to be named %s:\n%s", DefaultProfile, profile)
+	}
+}
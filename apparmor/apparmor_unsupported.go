@@ -0,0 +1,24 @@
+// +build !linux
+
+package apparmor
+
+import (
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+// IsEnabled returns false, AppArmor is only available on linux.
+func IsEnabled() bool {
+	return false
+}
+
+// IsLoaded fails, AppArmor is only available on linux.
+func IsLoaded(name string) (bool, error) {
+	return false, errors.Errorf("apparmor is not supported on %s", runtime.GOOS)
+}
+
+// LoadDefaultProfile fails, AppArmor is only available on linux.
+func LoadDefaultProfile() error {
+	return errors.Errorf("apparmor is not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,121 @@
+// Package authz lets an external authorizer allow or deny the requests made
+// to the daemon.
+package authz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/docker/containerd/identity"
+	"github.com/pkg/errors"
+)
+
+// DefaultTimeout bounds the time an authorizer takes to decide on a request.
+const DefaultTimeout = 5 * time.Second
+
+// Request is a request made to the daemon, submitted to the authorizer.
+type Request struct {
+	// Method is the full GRPC method, such as
+	// "/containerd.v1.services.ExecutionService/Create".
+	Method string `json:"method"`
+	// Peer is the caller when the request came over the unix socket.
+	Peer *identity.Peer `json:"peer,omitempty"`
+	// Body is the request message, it is empty for streaming methods.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// Response is the decision of the authorizer.
+type Response struct {
+	Allow bool `json:"allow"`
+	// Reason explains a denial to the caller.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Authorizer decides whether requests are allowed.
+type Authorizer interface {
+	Authorize(ctx context.Context, r *Request) (*Response, error)
+}
+
+// NewSocketAuthorizer returns an authorizer posting the requests as JSON to
+// the /authorize endpoint of the HTTP service listening on the unix socket.
+func NewSocketAuthorizer(socket string, timeout time.Duration) Authorizer {
+	return &socketAuthorizer{
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				Dial: func(_, _ string) (net.Conn, error) {
+					return net.DialTimeout("unix", socket, timeout)
+				},
+			},
+		},
+	}
+}
+
+type socketAuthorizer struct {
+	client *http.Client
+}
+
+func (a *socketAuthorizer) Authorize(ctx context.Context, r *Request) (*Response, error) {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", "http://authz/authorize", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "authz: failed to reach authorizer")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("authz: authorizer responded with %s", resp.Status)
+	}
+	return decodeResponse(json.NewDecoder(resp.Body))
+}
+
+// NewExecAuthorizer returns an authorizer running the plugin binary for each
+// request, which reads the request as JSON on its stdin and writes its
+// response on its stdout.
+func NewExecAuthorizer(path string, timeout time.Duration) Authorizer {
+	return &execAuthorizer{
+		path:    path,
+		timeout: timeout,
+	}
+}
+
+type execAuthorizer struct {
+	path    string
+	timeout time.Duration
+}
+
+func (a *execAuthorizer) Authorize(ctx context.Context, r *Request) (*Response, error) {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, a.path)
+	cmd.Stdin = bytes.NewReader(body)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "authz: plugin %s failed", a.path)
+	}
+	return decodeResponse(json.NewDecoder(bytes.NewReader(out)))
+}
+
+func decodeResponse(d *json.Decoder) (*Response, error) {
+	var resp Response
+	if err := d.Decode(&resp); err != nil {
+		return nil, errors.Wrap(err, "authz: invalid authorizer response")
+	}
+	return &resp, nil
+}
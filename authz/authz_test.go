@@ -0,0 +1,91 @@
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/docker/containerd/identity"
+)
+
+func TestSocketAuthorizer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authz-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "authz.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go http.Serve(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := Response{Allow: req.Peer != nil && req.Peer.UID == 0}
+		if !resp.Allow {
+			resp.Reason = "only root may " + req.Method
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+
+	a := NewSocketAuthorizer(socket, time.Second)
+	for _, tc := range []struct {
+		peer  *identity.Peer
+		allow bool
+	}{
+		{&identity.Peer{UID: 0}, true},
+		{&identity.Peer{UID: 1000}, false},
+		{nil, false},
+	} {
+		resp, err := a.Authorize(context.Background(), &Request{Method: "/test/Create", Peer: tc.peer})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Allow != tc.allow {
+			t.Errorf("peer %v: expected allow %v, got %v", tc.peer, tc.allow, resp.Allow)
+		}
+		if !resp.Allow && resp.Reason != "only root may /test/Create" {
+			t.Errorf("unexpected reason %q", resp.Reason)
+		}
+	}
+}
+
+func TestExecAuthorizer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "authz-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	plugin := filepath.Join(dir, "plugin")
+	script := "#!/bin/sh\nif grep -q privileged; then echo '{\"allow\":false,\"reason\":\"privileged\"}'; else echo '{\"allow\":true}'; fi\n"
+	if err := ioutil.WriteFile(plugin, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewExecAuthorizer(plugin, time.Second)
+	resp, err := a.Authorize(context.Background(), &Request{Method: "/test/Create", Body: json.RawMessage(`{"privileged":true}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Allow || resp.Reason != "privileged" {
+		t.Fatalf("expected a denial, got %+v", resp)
+	}
+	resp, err = a.Authorize(context.Background(), &Request{Method: "/test/Create", Body: json.RawMessage(`{}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Allow {
+		t.Fatalf("expected the request to be allowed, got %+v", resp)
+	}
+}
@@ -2,8 +2,10 @@ package bundle
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -21,14 +23,7 @@ func New(path string, s *specs.Spec) (*Bundle, error) {
 		b.Delete()
 		return nil, err
 	}
-	f, err := os.Create(filepath.Join(path, configName))
-	if err != nil {
-		b.Delete()
-		return nil, err
-	}
-	err = json.NewEncoder(f).Encode(s)
-	f.Close()
-	if err != nil {
+	if err := b.SetConfig(s); err != nil {
 		b.Delete()
 		return nil, err
 	}
@@ -57,6 +52,69 @@ func (b *Bundle) Config() (*specs.Spec, error) {
 	return &s, err
 }
 
+// SetConfig replaces the spec of the bundle with s.
+func (b *Bundle) SetConfig(s *specs.Spec) error {
+	f, err := os.Create(b.ConfigPath())
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(f).Encode(s)
+	f.Close()
+	return err
+}
+
 func (b *Bundle) Delete() error {
 	return os.RemoveAll(b.Path)
 }
+
+// ConfigPath returns the path of the spec of the bundle.
+func (b *Bundle) ConfigPath() string {
+	return filepath.Join(b.Path, configName)
+}
+
+// Rootfs returns the path of the root filesystem of the bundle, as set in its
+// spec.
+func (b *Bundle) Rootfs() (string, error) {
+	s, err := b.Config()
+	if err != nil {
+		return "", err
+	}
+	if s.Root.Path == "" {
+		return "", fmt.Errorf("bundle %s has no rootfs", b.Path)
+	}
+	if filepath.IsAbs(s.Root.Path) {
+		return s.Root.Path, nil
+	}
+	return filepath.Join(b.Path, s.Root.Path), nil
+}
+
+// ExtractRootfs returns the rootfs of the bundle path for an archive to be
+// extracted into it as root, owners, devices and setuid bits included. The
+// bundle must be a directory private to the user of the process and its
+// rootfs a directory within it, missing or not, for the files extracted not
+// to be reached by the other users nor extracted outside of the bundle.
+func ExtractRootfs(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("bundle path %q is not absolute", path)
+	}
+	fi, err := os.Lstat(path)
+	if err != nil {
+		return "", err
+	}
+	if !fi.IsDir() || !ownedByUser(fi) || fi.Mode().Perm()&0077 != 0 {
+		return "", fmt.Errorf("bundle %s is not a directory private to the user", path)
+	}
+	rootfs, err := (&Bundle{Path: path}).Rootfs()
+	if err != nil {
+		return "", err
+	}
+	if rel, err := filepath.Rel(path, rootfs); err != nil || rel == "." || rel == ".." || strings.ContainsRune(rel, filepath.Separator) {
+		return "", fmt.Errorf("rootfs %s is not in bundle %s", rootfs, path)
+	}
+	if fi, err := os.Lstat(rootfs); err == nil && !fi.IsDir() {
+		return "", fmt.Errorf("rootfs %s of bundle %s is not a directory", rootfs, path)
+	} else if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	return rootfs, nil
+}
@@ -0,0 +1,72 @@
+package bundle
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestExtractRootfs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bundle-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, tc := range []struct {
+		name  string
+		perm  os.FileMode
+		root  string
+		setup func(path string) error
+		valid bool
+	}{
+		{"rootfs", 0700, "rootfs", nil, true},
+		{"missing rootfs", 0700, "rootfs", func(path string) error {
+			return os.Remove(filepath.Join(path, "rootfs"))
+		}, true},
+		{"shared bundle", 0755, "rootfs", nil, false},
+		{"absolute rootfs", 0700, "/", nil, false},
+		{"nested rootfs", 0700, "a/rootfs", nil, false},
+		{"parent rootfs", 0700, "..", nil, false},
+		{"bundle rootfs", 0700, ".", nil, false},
+		{"symlink rootfs", 0700, "rootfs", func(path string) error {
+			if err := os.Remove(filepath.Join(path, "rootfs")); err != nil {
+				return err
+			}
+			return os.Symlink("/", filepath.Join(path, "rootfs"))
+		}, false},
+	} {
+		path := filepath.Join(dir, tc.name)
+		if err := os.Mkdir(path, 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Mkdir(filepath.Join(path, "rootfs"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := (&Bundle{Path: path}).SetConfig(&specs.Spec{Root: specs.Root{Path: tc.root}}); err != nil {
+			t.Fatal(err)
+		}
+		if tc.setup != nil {
+			if err := tc.setup(path); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := os.Chmod(path, tc.perm); err != nil {
+			t.Fatal(err)
+		}
+		rootfs, err := ExtractRootfs(path)
+		if (err == nil) != tc.valid {
+			t.Errorf("%s: expected the bundle to be valid: %v, got %v", tc.name, tc.valid, err)
+			continue
+		}
+		if tc.valid && rootfs != filepath.Join(path, "rootfs") {
+			t.Errorf("%s: expected the rootfs of the bundle, got %s", tc.name, rootfs)
+		}
+	}
+	if _, err := ExtractRootfs("bundle"); err == nil {
+		t.Errorf("expected a relative bundle path to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,15 @@
+// +build !windows
+
+package bundle
+
+import (
+	"os"
+	"syscall"
+)
+
+// ownedByUser returns whether the file fi is owned by the effective user of
+// the process.
+func ownedByUser(fi os.FileInfo) bool {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	return ok && int(st.Uid) == os.Geteuid()
+}
@@ -0,0 +1,80 @@
+// Package cgroups manages container cgroups on hosts using the unified
+// (cgroup v2) hierarchy. Resources are expressed with the v1 oriented types of
+// the OCI runtime spec and translated to the v2 controllers.
+package cgroups
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Root is the mountpoint of the cgroup hierarchies.
+const Root = "/sys/fs/cgroup"
+
+var (
+	// ErrUnsupported is returned for resources that have no cgroup v2
+	// equivalent.
+	ErrUnsupported = errors.New("cgroups: resource not supported by cgroup v2")
+	// ErrNotUnified is returned when a process is not part of the unified
+	// hierarchy.
+	ErrNotUnified = errors.New("cgroups: process is not in the unified hierarchy")
+)
+
+// Mode is the layout of the cgroup hierarchies on the host.
+type Mode int
+
+const (
+	// Unavailable means cgroups are not mounted.
+	Unavailable Mode = iota
+	// Legacy hosts only use the v1 hierarchies.
+	Legacy
+	// Hybrid hosts use the v1 hierarchies with the unified hierarchy
+	// mounted alongside, without any controllers.
+	Hybrid
+	// Unified hosts only use the v2 hierarchy.
+	Unified
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Legacy:
+		return "legacy"
+	case Hybrid:
+		return "hybrid"
+	case Unified:
+		return "unified"
+	}
+	return "unavailable"
+}
+
+// PidPath returns the path of the process' cgroup in the unified hierarchy,
+// relative to Root.
+func PidPath(pid int) (string, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return parseCgroupFile(f)
+}
+
+// parseCgroupFile returns the unified hierarchy entry of a /proc/<pid>/cgroup
+// file, which has the form "0::/path".
+func parseCgroupFile(r io.Reader) (string, error) {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if path := strings.TrimPrefix(s.Text(), "0::"); path != s.Text() {
+			return filepath.Clean(path), nil
+		}
+	}
+	if err := s.Err(); err != nil {
+		return "", err
+	}
+	return "", ErrNotUnified
+}
@@ -0,0 +1,285 @@
+package cgroups
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+func uint64p(v uint64) *uint64 { return &v }
+func uint16p(v uint16) *uint16 { return &v }
+func stringp(v string) *string { return &v }
+
+func TestToUnified(t *testing.T) {
+	resources := &specs.LinuxResources{
+		CPU: &specs.LinuxCPU{
+			Shares: uint64p(1024),
+			Quota:  uint64p(50000),
+			Period: uint64p(100000),
+			Cpus:   stringp("0-1"),
+		},
+		Memory: &specs.LinuxMemory{
+			Limit:       uint64p(256 << 20),
+			Swap:        uint64p(512 << 20),
+			Reservation: uint64p(128 << 20),
+		},
+		Pids: &specs.LinuxPids{Limit: 0},
+		BlockIO: &specs.LinuxBlockIO{
+			Weight: uint16p(500),
+			ThrottleReadBpsDevice: []specs.LinuxThrottleDevice{
+				{Rate: 1048576},
+			},
+		},
+		HugepageLimits: []specs.LinuxHugepageLimit{
+			{Pagesize: "2MB", Limit: 4 << 20},
+		},
+	}
+	resources.BlockIO.ThrottleReadBpsDevice[0].Major = 8
+
+	settings, err := ToUnified(resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Setting{
+		{"cpu.weight", "39"},
+		{"cpu.max", "50000 100000"},
+		{"cpuset.cpus", "0-1"},
+		{"memory.low", "134217728"},
+		{"memory.max", "268435456"},
+		{"memory.swap.max", "268435456"},
+		{"pids.max", "max"},
+		{"io.weight", "default 4950"},
+		{"io.max", "8:0 rbps=1048576"},
+		{"hugetlb.2MB.max", "4194304"},
+	}
+	if !reflect.DeepEqual(settings, expected) {
+		t.Fatalf("unexpected settings:\n%v\nexpected:\n%v", settings, expected)
+	}
+
+	for _, r := range []*specs.LinuxResources{
+		{CPU: &specs.LinuxCPU{RealtimeRuntime: uint64p(1)}},
+		{Memory: &specs.LinuxMemory{Swappiness: uint64p(0)}},
+		{BlockIO: &specs.LinuxBlockIO{LeafWeight: uint16p(10)}},
+	} {
+		if _, err := ToUnified(r); errors.Cause(err) != ErrUnsupported {
+			t.Fatalf("expected %v to be unsupported, got %v", r, err)
+		}
+	}
+	if _, err := ToUnified(&specs.LinuxResources{
+		Memory: &specs.LinuxMemory{Swap: uint64p(1)},
+	}); err == nil {
+		t.Fatal("expected swap without a memory limit to fail")
+	}
+}
+
+func TestWeights(t *testing.T) {
+	for _, c := range []struct{ shares, weight uint64 }{
+		{2, 1}, {1024, 39}, {262144, 10000}, {0, 1},
+	} {
+		if w := CPUSharesToWeight(c.shares); w != c.weight {
+			t.Fatalf("expected shares %d to be weight %d, got %d", c.shares, c.weight, w)
+		}
+	}
+	for _, c := range []struct {
+		blkio uint16
+		io    uint64
+	}{
+		{10, 1}, {1000, 10000},
+	} {
+		if w := BlkioWeightToIOWeight(c.blkio); w != c.io {
+			t.Fatalf("expected blkio weight %d to be io weight %d, got %d", c.blkio, c.io, w)
+		}
+	}
+}
+
+func TestParseCgroupFile(t *testing.T) {
+	path, err := parseCgroupFile(strings.NewReader("12:pids:/user.slice\n0::/system.slice/containerd.service\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/system.slice/containerd.service" {
+		t.Fatalf("unexpected path %q", path)
+	}
+	if _, err := parseCgroupFile(strings.NewReader("4:memory:/docker\n")); err != ErrNotUnified {
+		t.Fatalf("expected %v, got %v", ErrNotUnified, err)
+	}
+}
+
+// cgroupEnv returns a manager for a directory mimicking a cgroup.
+func cgroupEnv(t *testing.T, files map[string]string) (*Manager, func()) {
+	tmpdir, err := ioutil.TempDir("", "cgroups-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(tmpdir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m, err := load(tmpdir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m, func() {
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func TestStat(t *testing.T) {
+	m, cleanup := cgroupEnv(t, map[string]string{
+		"pids.current":   "3\n",
+		"pids.max":       "max\n",
+		"memory.current": "4096\n",
+		"memory.max":     "8192\n",
+		"memory.stat":    "anon 1024\nfile 2048\n",
+		"cpu.stat":       "usage_usec 100\nuser_usec 60\nsystem_usec 40\n",
+		"io.stat":        "8:0 rbytes=1024 wbytes=512 rios=2 wios=1 dbytes=0 dios=0\n",
+		"memory.events":  "low 0\nhigh 0\nmax 1\noom 1\noom_kill 1\n",
+	})
+	defer cleanup()
+
+	metrics, err := m.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metrics.Pids.Current != 3 || metrics.Pids.Limit != 0 {
+		t.Fatalf("unexpected pids %+v", metrics.Pids)
+	}
+	if metrics.Memory.Usage != 4096 || metrics.Memory.Limit != 8192 || metrics.Memory.Stat["file"] != 2048 {
+		t.Fatalf("unexpected memory %+v", metrics.Memory)
+	}
+	if metrics.CPU.UsageUsec != 100 || metrics.CPU.SystemUsec != 40 {
+		t.Fatalf("unexpected cpu %+v", metrics.CPU)
+	}
+	expected := []IOStat{{Major: 8, Rbytes: 1024, Wbytes: 512, Rios: 2, Wios: 1}}
+	if !reflect.DeepEqual(metrics.IO, expected) {
+		t.Fatalf("unexpected io %+v", metrics.IO)
+	}
+
+	events, err := m.MemoryEvents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events.OOMKill != 1 {
+		t.Fatalf("unexpected memory events %+v", events)
+	}
+}
+
+func TestPids(t *testing.T) {
+	m, cleanup := cgroupEnv(t, map[string]string{
+		"cgroup.procs": "1\n12\n",
+	})
+	defer cleanup()
+	if err := os.Mkdir(filepath.Join(m.Path(), "child"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(m.Path(), "child", "cgroup.procs"), []byte("34\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	pids, err := m.Pids()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int{1, 12, 34}; !reflect.DeepEqual(pids, expected) {
+		t.Fatalf("expected pids %v, got %v", expected, pids)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	m, cleanup := cgroupEnv(t, map[string]string{
+		"memory.max": "max\n",
+		"pids.max":   "max\n",
+	})
+	defer cleanup()
+
+	if err := m.Update(&specs.LinuxResources{
+		Memory: &specs.LinuxMemory{Limit: uint64p(1 << 20)},
+		Pids:   &specs.LinuxPids{Limit: 32},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for file, value := range map[string]string{
+		"memory.max": "1048576",
+		"pids.max":   "32",
+	} {
+		data, err := ioutil.ReadFile(filepath.Join(m.Path(), file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != value {
+			t.Fatalf("expected %s to be %q, got %q", file, value, data)
+		}
+	}
+}
+
+func TestWatchOOM(t *testing.T) {
+	m, cleanup := cgroupEnv(t, map[string]string{
+		"memory.events": "oom 0\noom_kill 0\n",
+	})
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := m.WatchOOM(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(m.Path(), "memory.events"), []byte("oom 2\noom_kill 2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case n := <-ch:
+		if n != 2 {
+			t.Fatalf("expected 2 kills, got %d", n)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for oom event")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Fatal("expected channel to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watch to stop")
+	}
+}
+
+func TestParseNetDev(t *testing.T) {
+	const netDev = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:    1200      12    0    0    0     0          0         0     1200      12    0    0    0     0       0          0
+  eth0: 5061430    3711    1    2    0     0          0         0   245180    2455    3    4    0     0       0          0
+`
+	stats, err := parseNetDev(strings.NewReader(netDev))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []NetworkStat{
+		{
+			Interface: "eth0",
+			RxBytes:   5061430,
+			RxPackets: 3711,
+			RxErrors:  1,
+			RxDropped: 2,
+			TxBytes:   245180,
+			TxPackets: 2455,
+			TxErrors:  3,
+			TxDropped: 4,
+		},
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, stats)
+	}
+}
@@ -0,0 +1,150 @@
+package cgroups
+
+import (
+	"fmt"
+	"strconv"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// Setting is a value written to a cgroup v2 interface file.
+type Setting struct {
+	File  string
+	Value string
+}
+
+// ToUnified translates the resources to the settings of the v2 controllers,
+// in the order they are to be written. Devices are enforced by the runtime
+// with eBPF on v2 and the deprecated kernel memory limits are ignored, as
+// the runtime does.
+func ToUnified(r *specs.LinuxResources) ([]Setting, error) {
+	if r == nil {
+		return nil, nil
+	}
+	var settings []Setting
+	add := func(file, value string) {
+		settings = append(settings, Setting{File: file, Value: value})
+	}
+	if r.DisableOOMKiller != nil && *r.DisableOOMKiller {
+		return nil, errors.Wrap(ErrUnsupported, "disabling the oom killer")
+	}
+	if r.Network != nil {
+		return nil, errors.Wrap(ErrUnsupported, "network classes and priorities")
+	}
+	if cpu := r.CPU; cpu != nil {
+		if cpu.RealtimeRuntime != nil || cpu.RealtimePeriod != nil {
+			return nil, errors.Wrap(ErrUnsupported, "realtime scheduling")
+		}
+		if cpu.Shares != nil && *cpu.Shares != 0 {
+			add("cpu.weight", strconv.FormatUint(CPUSharesToWeight(*cpu.Shares), 10))
+		}
+		if cpu.Quota != nil || cpu.Period != nil {
+			quota, period := "max", ""
+			if cpu.Quota != nil && *cpu.Quota != 0 {
+				quota = strconv.FormatUint(*cpu.Quota, 10)
+			}
+			if cpu.Period != nil && *cpu.Period != 0 {
+				period = " " + strconv.FormatUint(*cpu.Period, 10)
+			}
+			add("cpu.max", quota+period)
+		}
+		if cpu.Cpus != nil {
+			add("cpuset.cpus", *cpu.Cpus)
+		}
+		if cpu.Mems != nil {
+			add("cpuset.mems", *cpu.Mems)
+		}
+	}
+	if mem := r.Memory; mem != nil {
+		if mem.Swappiness != nil {
+			return nil, errors.Wrap(ErrUnsupported, "memory swappiness")
+		}
+		if mem.Reservation != nil {
+			add("memory.low", strconv.FormatUint(*mem.Reservation, 10))
+		}
+		// memory.swap.max must be set after memory.max as v1 limits the
+		// total of memory and swap where v2 limits swap alone.
+		if mem.Limit != nil {
+			add("memory.max", strconv.FormatUint(*mem.Limit, 10))
+		}
+		if mem.Swap != nil {
+			if mem.Limit == nil {
+				return nil, errors.New("cgroups: a swap limit requires a memory limit")
+			}
+			if *mem.Swap < *mem.Limit {
+				return nil, errors.Errorf("cgroups: swap limit %d is lower than the memory limit %d", *mem.Swap, *mem.Limit)
+			}
+			add("memory.swap.max", strconv.FormatUint(*mem.Swap-*mem.Limit, 10))
+		}
+	}
+	if pids := r.Pids; pids != nil {
+		if pids.Limit > 0 {
+			add("pids.max", strconv.FormatInt(pids.Limit, 10))
+		} else {
+			add("pids.max", "max")
+		}
+	}
+	if io := r.BlockIO; io != nil {
+		if io.LeafWeight != nil {
+			return nil, errors.Wrap(ErrUnsupported, "blkio leaf weight")
+		}
+		if io.Weight != nil {
+			add("io.weight", fmt.Sprintf("default %d", BlkioWeightToIOWeight(*io.Weight)))
+		}
+		for _, d := range io.WeightDevice {
+			if d.LeafWeight != nil {
+				return nil, errors.Wrap(ErrUnsupported, "blkio leaf weight")
+			}
+			if d.Weight != nil {
+				add("io.weight", fmt.Sprintf("%d:%d %d", d.Major, d.Minor, BlkioWeightToIOWeight(*d.Weight)))
+			}
+		}
+		for _, t := range []struct {
+			key     string
+			devices []specs.LinuxThrottleDevice
+		}{
+			{"rbps", io.ThrottleReadBpsDevice},
+			{"wbps", io.ThrottleWriteBpsDevice},
+			{"riops", io.ThrottleReadIOPSDevice},
+			{"wiops", io.ThrottleWriteIOPSDevice},
+		} {
+			for _, d := range t.devices {
+				rate := "max"
+				if d.Rate != 0 {
+					rate = strconv.FormatUint(d.Rate, 10)
+				}
+				add("io.max", fmt.Sprintf("%d:%d %s=%s", d.Major, d.Minor, t.key, rate))
+			}
+		}
+	}
+	for _, h := range r.HugepageLimits {
+		add(fmt.Sprintf("hugetlb.%s.max", h.Pagesize), strconv.FormatUint(h.Limit, 10))
+	}
+	return settings, nil
+}
+
+// CPUSharesToWeight converts v1 cpu shares, in [2, 262144], to a v2 cpu
+// weight, in [1, 10000].
+func CPUSharesToWeight(shares uint64) uint64 {
+	if shares < 2 {
+		shares = 2
+	}
+	if shares > 262144 {
+		shares = 262144
+	}
+	return 1 + ((shares-2)*9999)/262142
+}
+
+// BlkioWeightToIOWeight converts a v1 blkio weight, in [10, 1000], to a v2
+// io weight, in [1, 10000].
+func BlkioWeightToIOWeight(weight uint16) uint64 {
+	w := uint64(weight)
+	if w < 10 {
+		w = 10
+	}
+	if w > 1000 {
+		w = 1000
+	}
+	return 1 + ((w-10)*9999)/990
+}
@@ -0,0 +1,306 @@
+package cgroups
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// Manager manages a cgroup of the unified hierarchy.
+type Manager struct {
+	path string
+}
+
+// Load returns a manager for the existing cgroup at path, relative to
+// Root.
+func Load(path string) (*Manager, error) {
+	return load(filepath.Join(Root, path))
+}
+
+// LoadPid returns a manager for the cgroup of the process.
+func LoadPid(pid int) (*Manager, error) {
+	path, err := PidPath(pid)
+	if err != nil {
+		return nil, err
+	}
+	return Load(path)
+}
+
+func load(path string) (*Manager, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "cgroups: failed to load cgroup")
+	}
+	if !fi.IsDir() {
+		return nil, errors.Errorf("cgroups: %s is not a cgroup", path)
+	}
+	return &Manager{path: path}, nil
+}
+
+// Path returns the absolute path of the cgroup.
+func (m *Manager) Path() string {
+	return m.path
+}
+
+// Update applies the resources to the cgroup.
+func (m *Manager) Update(resources *specs.LinuxResources) error {
+	settings, err := ToUnified(resources)
+	if err != nil {
+		return err
+	}
+	for _, s := range settings {
+		if err := ioutil.WriteFile(filepath.Join(m.path, s.File), []byte(s.Value), 0); err != nil {
+			return errors.Wrapf(err, "cgroups: failed to set %s to %q", s.File, s.Value)
+		}
+	}
+	return nil
+}
+
+// Metrics are the statistics of a cgroup. Values of controllers that are not
+// enabled for the cgroup are zero. Network is not read from the cgroup but
+// from the network namespace of its processes, see ReadNetworkStats.
+type Metrics struct {
+	Pids    PidsStat
+	CPU     CPUStat
+	Memory  MemoryStat
+	IO      []IOStat
+	Network []NetworkStat `json:",omitempty"`
+}
+
+type PidsStat struct {
+	Current uint64
+	// Limit is zero when there is no limit.
+	Limit uint64
+}
+
+// CPUStat holds the times in microseconds and the throttling statistics of
+// the cgroup.
+type CPUStat struct {
+	UsageUsec     uint64
+	UserUsec      uint64
+	SystemUsec    uint64
+	NrPeriods     uint64
+	NrThrottled   uint64
+	ThrottledUsec uint64
+}
+
+type MemoryStat struct {
+	Usage     uint64
+	SwapUsage uint64
+	// Limit is zero when there is no limit.
+	Limit uint64
+	// Stat holds the breakdown of memory.stat, such as anon and file.
+	Stat map[string]uint64
+}
+
+// IOStat holds the io statistics of a device.
+type IOStat struct {
+	Major  uint64
+	Minor  uint64
+	Rbytes uint64
+	Wbytes uint64
+	Rios   uint64
+	Wios   uint64
+}
+
+// MemoryEvents are the counters of memory.events.
+type MemoryEvents struct {
+	Low     uint64
+	High    uint64
+	Max     uint64
+	OOM     uint64
+	OOMKill uint64
+}
+
+// Stat returns the statistics of the cgroup.
+func (m *Manager) Stat() (*Metrics, error) {
+	var metrics Metrics
+	for _, v := range []struct {
+		file  string
+		value *uint64
+	}{
+		{"pids.current", &metrics.Pids.Current},
+		{"pids.max", &metrics.Pids.Limit},
+		{"memory.current", &metrics.Memory.Usage},
+		{"memory.swap.current", &metrics.Memory.SwapUsage},
+		{"memory.max", &metrics.Memory.Limit},
+	} {
+		if err := m.readUint(v.file, v.value); err != nil {
+			return nil, err
+		}
+	}
+
+	cpu, err := m.readKeyedFile("cpu.stat")
+	if err != nil {
+		return nil, err
+	}
+	metrics.CPU = CPUStat{
+		UsageUsec:     cpu["usage_usec"],
+		UserUsec:      cpu["user_usec"],
+		SystemUsec:    cpu["system_usec"],
+		NrPeriods:     cpu["nr_periods"],
+		NrThrottled:   cpu["nr_throttled"],
+		ThrottledUsec: cpu["throttled_usec"],
+	}
+	if metrics.Memory.Stat, err = m.readKeyedFile("memory.stat"); err != nil {
+		return nil, err
+	}
+	if metrics.IO, err = m.readIOStat(); err != nil {
+		return nil, err
+	}
+	return &metrics, nil
+}
+
+// MemoryEvents returns the memory event counters of the cgroup. OOMKill
+// counts the processes of the cgroup killed by the oom killer.
+func (m *Manager) MemoryEvents() (*MemoryEvents, error) {
+	events, err := m.readKeyedFile("memory.events")
+	if err != nil {
+		return nil, err
+	}
+	return &MemoryEvents{
+		Low:     events["low"],
+		High:    events["high"],
+		Max:     events["max"],
+		OOM:     events["oom"],
+		OOMKill: events["oom_kill"],
+	}, nil
+}
+
+// Pids returns the processes of the cgroup and of its descendants, read
+// from their cgroup.procs.
+func (m *Manager) Pids() ([]int, error) {
+	var pids []int
+	err := filepath.Walk(m.path, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			// descendants may be removed while walking
+			if os.IsNotExist(err) && path != m.path {
+				return nil
+			}
+			return err
+		}
+		if !fi.IsDir() {
+			return nil
+		}
+		data, err := ioutil.ReadFile(filepath.Join(path, "cgroup.procs"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		for _, field := range strings.Fields(string(data)) {
+			pid, err := strconv.Atoi(field)
+			if err != nil {
+				return errors.Wrapf(err, "cgroups: failed to parse %s", filepath.Join(path, "cgroup.procs"))
+			}
+			pids = append(pids, pid)
+		}
+		return nil
+	})
+	return pids, err
+}
+
+// readUint reads a single value file, where "max" is read as zero. Missing
+// files are skipped as the controller is not enabled.
+func (m *Manager) readUint(file string, v *uint64) error {
+	data, err := ioutil.ReadFile(filepath.Join(m.path, file))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	s := strings.TrimSpace(string(data))
+	if s == "max" {
+		return nil
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "cgroups: failed to parse %s", file)
+	}
+	*v = n
+	return nil
+}
+
+// readKeyedFile reads a flat keyed file of "key value" lines.
+func (m *Manager) readKeyedFile(file string) (map[string]uint64, error) {
+	values := make(map[string]uint64)
+	f, err := os.Open(filepath.Join(m.path, file))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return values, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		n, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cgroups: failed to parse %s", file)
+		}
+		values[fields[0]] = n
+	}
+	return values, s.Err()
+}
+
+// readIOStat reads io.stat, a nested keyed file of lines such as
+// "8:0 rbytes=1024 wbytes=0 rios=1 wios=0".
+func (m *Manager) readIOStat() ([]IOStat, error) {
+	f, err := os.Open(filepath.Join(m.path, "io.stat"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	var stats []IOStat
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		var stat IOStat
+		dev := strings.SplitN(fields[0], ":", 2)
+		if len(dev) != 2 {
+			continue
+		}
+		stat.Major, _ = strconv.ParseUint(dev[0], 10, 64)
+		stat.Minor, _ = strconv.ParseUint(dev[1], 10, 64)
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			n, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				continue
+			}
+			switch kv[0] {
+			case "rbytes":
+				stat.Rbytes = n
+			case "wbytes":
+				stat.Wbytes = n
+			case "rios":
+				stat.Rios = n
+			case "wios":
+				stat.Wios = n
+			}
+		}
+		stats = append(stats, stat)
+	}
+	return stats, s.Err()
+}
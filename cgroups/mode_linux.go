@@ -0,0 +1,25 @@
+package cgroups
+
+import (
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+)
+
+// cgroup2SuperMagic is the filesystem type of the unified hierarchy.
+const cgroup2SuperMagic = 0x63677270
+
+// DetectMode returns the layout of the cgroup hierarchies on the host.
+func DetectMode() Mode {
+	var fs unix.Statfs_t
+	if err := unix.Statfs(Root, &fs); err != nil {
+		return Unavailable
+	}
+	if fs.Type == cgroup2SuperMagic {
+		return Unified
+	}
+	if err := unix.Statfs(filepath.Join(Root, "unified"), &fs); err == nil && fs.Type == cgroup2SuperMagic {
+		return Hybrid
+	}
+	return Legacy
+}
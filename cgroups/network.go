@@ -0,0 +1,82 @@
+package cgroups
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NetworkStat holds the statistics of a network interface.
+type NetworkStat struct {
+	Interface string
+	RxBytes   uint64
+	RxPackets uint64
+	RxErrors  uint64
+	RxDropped uint64
+	TxBytes   uint64
+	TxPackets uint64
+	TxErrors  uint64
+	TxDropped uint64
+}
+
+// ReadNetworkStats returns the statistics of the interfaces of the network
+// namespace of the process pid, but for the loopback interface.
+func ReadNetworkStats(pid int) ([]NetworkStat, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/net/dev", pid))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseNetDev(f)
+}
+
+// parseNetDev parses the format of /proc/net/dev, two header lines followed by
+// a line per interface:
+//
+//	eth0: rx-bytes rx-packets rx-errs rx-drop fifo frame compressed multicast tx-bytes tx-packets tx-errs tx-drop ...
+func parseNetDev(r io.Reader) ([]NetworkStat, error) {
+	var stats []NetworkStat
+	s := bufio.NewScanner(r)
+	for line := 0; s.Scan(); line++ {
+		if line < 2 {
+			continue
+		}
+		parts := strings.SplitN(s.Text(), ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid net/dev line %q", s.Text())
+		}
+		name := strings.TrimSpace(parts[0])
+		if name == "lo" {
+			continue
+		}
+		fields := strings.Fields(parts[1])
+		if len(fields) < 12 {
+			return nil, errors.Errorf("invalid net/dev line %q", s.Text())
+		}
+		var values [12]uint64
+		for i := range values {
+			v, err := strconv.ParseUint(fields[i], 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid net/dev line %q", s.Text())
+			}
+			values[i] = v
+		}
+		stats = append(stats, NetworkStat{
+			Interface: name,
+			RxBytes:   values[0],
+			RxPackets: values[1],
+			RxErrors:  values[2],
+			RxDropped: values[3],
+			TxBytes:   values[8],
+			TxPackets: values[9],
+			TxErrors:  values[10],
+			TxDropped: values[11],
+		})
+	}
+	return stats, s.Err()
+}
@@ -0,0 +1,71 @@
+package cgroups
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// WatchOOM watches the cgroup for processes killed by the oom killer,
+// sending the number of processes killed since the previous event. The
+// channel is closed when ctx is done or the cgroup is removed.
+func (m *Manager) WatchOOM(ctx context.Context) (<-chan uint64, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, errors.Wrap(err, "cgroups: failed to create inotify instance")
+	}
+	if _, err := unix.InotifyAddWatch(fd, filepath.Join(m.path, "memory.events"), unix.IN_MODIFY); err != nil {
+		unix.Close(fd)
+		return nil, errors.Wrap(err, "cgroups: failed to watch memory events")
+	}
+	events, err := m.MemoryEvents()
+	if err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	// the non blocking fd is handled by the runtime poller, so closing the
+	// file interrupts a pending read.
+	f := os.NewFile(uintptr(fd), "inotify")
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		f.Close()
+	}()
+
+	ch := make(chan uint64)
+	go func() {
+		defer close(ch)
+		defer close(done)
+		last := events.OOMKill
+		buf := make([]byte, unix.SizeofInotifyEvent+unix.NAME_MAX+1)
+		for {
+			if _, err := f.Read(buf); err != nil {
+				return
+			}
+			// the watch is removed along with the cgroup
+			if _, err := os.Stat(filepath.Join(m.path, "memory.events")); err != nil {
+				return
+			}
+			events, err := m.MemoryEvents()
+			if err != nil {
+				return
+			}
+			if events.OOMKill <= last {
+				continue
+			}
+			select {
+			case ch <- events.OOMKill - last:
+			case <-ctx.Done():
+				return
+			}
+			last = events.OOMKill
+		}
+	}()
+	return ch, nil
+}
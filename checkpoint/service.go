@@ -0,0 +1,269 @@
+// Package checkpoint keeps the checkpoints of the containers in the content
+// store of the daemon, as images named in its image store.
+package checkpoint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	api "github.com/docker/containerd/api/checkpoint"
+	execapi "github.com/docker/containerd/api/execution"
+	imagesapi "github.com/docker/containerd/api/images"
+	"github.com/docker/containerd/bundle"
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/gc"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/snapshot/overlay"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// ImageStore names the images of the image store.
+type ImageStore interface {
+	GetImage(ctx context.Context, name string) (images.Image, error)
+	PutImage(ctx context.Context, name string, target images.Descriptor) error
+}
+
+// NewService returns the checkpoint service of the containers of execution,
+// the checkpoints being written to cs and named in store. They are taken and
+// extracted in directories under root, removed once done. The writable
+// layers of the containers are those of the active snapshots of snapshots,
+// which may be nil for the checkpoints to be taken without them.
+func NewService(root string, cs *content.ContentStore, execution *execution.Service, store ImageStore, snapshots *overlay.Overlayfs) *Service {
+	return &Service{
+		root:      root,
+		content:   cs,
+		execution: execution,
+		images:    store,
+		snapshots: snapshots,
+	}
+}
+
+type Service struct {
+	root      string
+	content   *content.ContentStore
+	execution *execution.Service
+	images    ImageStore
+	snapshots *overlay.Overlayfs
+}
+
+var _ = (api.CheckpointServiceServer)(&Service{})
+
+func (s *Service) Checkpoint(ctx context.Context, r *api.CheckpointRequest) (*api.CheckpointResponse, error) {
+	if r.Name == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "checkpoint name must be provided")
+	}
+	// the files are archived once the container is dumped, they would keep
+	// changing past its memory while it runs
+	if (r.Rootfs || r.Snapshot != "") && !r.Exit {
+		return nil, grpc.Errorf(codes.InvalidArgument, "the rootfs of a container is only checkpointed when it exits")
+	}
+	c, err := s.execution.Get(ctx, &execapi.GetContainerRequest{ID: r.ID})
+	if err != nil {
+		return nil, err
+	}
+	b, err := bundle.Load(c.Container.BundlePath)
+	if err != nil {
+		return nil, err
+	}
+	files := images.CheckpointFiles{Spec: b.ConfigPath()}
+	snapshot := r.Snapshot
+	if r.Rootfs && snapshot == "" && s.snapshots != nil {
+		// the rootfs mounted from a snapshot is checkpointed as its changes
+		spec, err := b.Config()
+		if err != nil {
+			return nil, err
+		}
+		snapshot = spec.Annotations[gc.AnnotationSnapshot]
+	}
+	if snapshot != "" {
+		// TODO: take the changes from the diff service once the snapshots
+		// are managed by the daemon.
+		if s.snapshots == nil {
+			return nil, grpc.Errorf(codes.FailedPrecondition, "snapshots are not supported by the daemon")
+		}
+		if files.RWLayer, err = s.snapshots.Upper(snapshot); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	} else if r.Rootfs {
+		if files.Rootfs, err = b.Rootfs(); err != nil {
+			return nil, err
+		}
+	}
+	if files.Dir, err = s.tempDir(); err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(files.Dir)
+
+	if _, err := s.execution.Checkpoint(ctx, &execapi.CheckpointContainerRequest{
+		ID:               r.ID,
+		Path:             files.Dir,
+		Exit:             r.Exit,
+		TCPEstablished:   r.TCPEstablished,
+		UnixSockets:      r.UnixSockets,
+		Shell:            r.Shell,
+		EmptyNS:          r.EmptyNS,
+		PreDumps:         r.PreDumps,
+		PreDumpThreshold: r.PreDumpThreshold,
+	}); err != nil {
+		return nil, err
+	}
+	// the checkpoint is not collected before it is named
+	release, err := s.content.Hold()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	desc, err := images.WriteCheckpoint(s.content, files, images.CheckpointConfig{
+		ContainerID: r.ID,
+		Created:     time.Now().UTC(),
+		EmptyNS:     r.EmptyNS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the checkpoint is collected as the content of the images once its
+	// name is removed
+	if err := s.images.PutImage(ctx, r.Name, desc); err != nil {
+		return nil, err
+	}
+	return &api.CheckpointResponse{
+		Image: &imagesapi.Image{
+			Name: r.Name,
+			Target: &imagesapi.Descriptor{
+				MediaType: desc.MediaType,
+				Digest:    desc.Digest.String(),
+				Size_:     desc.Size,
+			},
+		},
+	}, nil
+}
+
+// Restore creates the container of the request from a checkpoint, under any
+// ID and with its own io and network, for a checkpoint to be restored into as
+// many containers as needed. The bundle of the container is created from the
+// spec and rootfs of the checkpoint when it does not exist.
+func (s *Service) Restore(ctx context.Context, r *api.RestoreRequest) (resp *execapi.CreateContainerResponse, err error) {
+	if r.Container == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "container to restore must be provided")
+	}
+	image, err := s.images.GetImage(ctx, r.Name)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		files   images.CheckpointFiles
+		created *bundle.Bundle
+	)
+	if path := r.Container.BundlePath; path != "" {
+		if _, err := os.Stat(path); err == nil {
+			ok, err := images.CheckpointHasRootfs(s.content, image.Target)
+			if err != nil {
+				return nil, err
+			}
+			// the checkpoints pulled from a registry are extracted as root
+			if ok {
+				if files.Rootfs, err = bundle.ExtractRootfs(path); err != nil {
+					return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+				}
+			}
+		} else if os.IsNotExist(err) {
+			if !filepath.IsAbs(path) {
+				return nil, grpc.Errorf(codes.InvalidArgument, "bundle path %q is not absolute", path)
+			}
+			if err := os.Mkdir(path, 0700); err != nil {
+				return nil, err
+			}
+			created = &bundle.Bundle{Path: path}
+			defer func() {
+				if err != nil {
+					created.Delete()
+				}
+			}()
+			if err := os.Mkdir(filepath.Join(path, bundleRootfs), 0700); err != nil {
+				return nil, err
+			}
+			files.Spec = created.ConfigPath()
+			files.Rootfs = filepath.Join(path, bundleRootfs)
+		} else {
+			return nil, err
+		}
+	}
+	if files.Dir, err = s.tempDir(); err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(files.Dir)
+
+	config, err := images.ReadCheckpoint(s.content, image.Target, files)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNetwork(config, r.Container); err != nil {
+		return nil, err
+	}
+	if created != nil {
+		if err := setupBundle(created, files.Rootfs); err != nil {
+			return nil, errors.Wrapf(err, "failed to create bundle from checkpoint %s", r.Name)
+		}
+	}
+	req := *r.Container
+	req.CheckpointPath = files.Dir
+	return s.execution.Create(ctx, &req)
+}
+
+// bundleRootfs is the rootfs of the bundles created from a checkpoint,
+// relative to the bundle.
+const bundleRootfs = "rootfs"
+
+// setupBundle points the spec extracted into the bundle b created from a
+// checkpoint to the rootfs extracted into it, the rootfs of the container the
+// checkpoint was taken of being that of another bundle.
+func setupBundle(b *bundle.Bundle, rootfs string) error {
+	spec, err := b.Config()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.New("checkpoint has no spec")
+		}
+		return err
+	}
+	files, err := ioutil.ReadDir(rootfs)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return errors.New("checkpoint has no rootfs")
+	}
+	spec.Root.Path = bundleRootfs
+	return b.SetConfig(spec)
+}
+
+// checkNetwork fails when the container c is restored into a network other
+// than that of its bundle while the network namespace of the container the
+// checkpoint was taken of is part of it, the namespace being restored by
+// criu regardless.
+func checkNetwork(config images.CheckpointConfig, c *execapi.CreateContainerRequest) error {
+	if c.Network == "" && c.NetNSPath == "" && len(c.Ports) == 0 {
+		return nil
+	}
+	for _, ns := range config.EmptyNS {
+		if ns == string(specs.NetworkNamespace) {
+			return nil
+		}
+	}
+	return grpc.Errorf(codes.InvalidArgument, "checkpoint of %s was taken with its network namespace, it must be taken with an empty one to be restored into another network", config.ContainerID)
+}
+
+// tempDir returns a new directory under root to take or extract a
+// checkpoint in.
+func (s *Service) tempDir() (string, error) {
+	if err := os.MkdirAll(s.root, 0700); err != nil {
+		return "", err
+	}
+	return ioutil.TempDir(s.root, "")
+}
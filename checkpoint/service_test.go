@@ -0,0 +1,210 @@
+package checkpoint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	api "github.com/docker/containerd/api/checkpoint"
+	execapi "github.com/docker/containerd/api/execution"
+	"github.com/docker/containerd/bundle"
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/images"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type testImageStore map[string]images.Descriptor
+
+func (s testImageStore) GetImage(ctx context.Context, name string) (images.Image, error) {
+	target, ok := s[name]
+	if !ok {
+		return images.Image{}, grpc.Errorf(codes.NotFound, "image %v not found", name)
+	}
+	return images.Image{Name: name, Target: target}, nil
+}
+
+func (s testImageStore) PutImage(ctx context.Context, name string, target images.Descriptor) error {
+	s[name] = target
+	return nil
+}
+
+// serviceEnv returns a service without execution, the requests failing
+// before the container is created, and its temporary directory.
+func serviceEnv(t *testing.T) (*Service, string, func()) {
+	tmpdir, err := ioutil.TempDir("", "checkpoint-service-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err := content.OpenContentStore(filepath.Join(tmpdir, "content"))
+	if err != nil {
+		os.RemoveAll(tmpdir)
+		t.Fatal(err)
+	}
+	return NewService(filepath.Join(tmpdir, "checkpoints"), cs, nil, testImageStore{}, nil), tmpdir, func() {
+		os.RemoveAll(tmpdir)
+	}
+}
+
+// writeCheckpoint names a checkpoint of the files of dir, with a spec and a
+// rootfs when set, and the config.
+func writeCheckpoint(t *testing.T, s *Service, name, dir string, spec *specs.Spec, rootfs bool, config images.CheckpointConfig) {
+	src := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Join(src, "rootfs"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(src, "pages-1.img"), []byte("pages"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	files := images.CheckpointFiles{Dir: src}
+	if spec != nil {
+		b := &bundle.Bundle{Path: src}
+		if err := b.SetConfig(spec); err != nil {
+			t.Fatal(err)
+		}
+		files.Spec = b.ConfigPath()
+	}
+	if rootfs {
+		files.Rootfs = filepath.Join(src, "rootfs")
+		if err := ioutil.WriteFile(filepath.Join(files.Rootfs, "hello"), []byte("hello"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config.Created = time.Unix(1, 0).UTC()
+	desc, err := images.WriteCheckpoint(s.content, files, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.images.PutImage(context.Background(), name, desc); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckpointRootfsRequiresExit(t *testing.T) {
+	s := &Service{}
+	for _, r := range []*api.CheckpointRequest{
+		{ID: "c", Name: "checkpoint", Rootfs: true},
+		{ID: "c", Name: "checkpoint", Snapshot: "c"},
+	} {
+		if _, err := s.Checkpoint(context.Background(), r); grpc.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected the rootfs of a running container not to be checkpointed, got %v", err)
+		}
+	}
+}
+
+func TestCheckNetwork(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		emptyNS   []string
+		container execapi.CreateContainerRequest
+		valid     bool
+	}{
+		{"bundle network", nil, execapi.CreateContainerRequest{}, true},
+		{"network", nil, execapi.CreateContainerRequest{Network: "bridge"}, false},
+		{"netns", []string{"ipc"}, execapi.CreateContainerRequest{NetNSPath: "/proc/1/ns/net"}, false},
+		{"ports", nil, execapi.CreateContainerRequest{Ports: []*execapi.PortMapping{{}}}, false},
+		{"empty network", []string{"network"}, execapi.CreateContainerRequest{Network: "bridge"}, true},
+	} {
+		err := checkNetwork(images.CheckpointConfig{ContainerID: "c", EmptyNS: tc.emptyNS}, &tc.container)
+		if (err == nil) != tc.valid {
+			t.Errorf("%s: expected the network to be valid: %v, got %v", tc.name, tc.valid, err)
+		}
+	}
+}
+
+func TestSetupBundle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	b := &bundle.Bundle{Path: dir}
+	rootfs := filepath.Join(dir, bundleRootfs)
+	if err := os.Mkdir(rootfs, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := setupBundle(b, rootfs); err == nil {
+		t.Fatal("expected a checkpoint without spec to be rejected")
+	}
+	if err := b.SetConfig(&specs.Spec{Root: specs.Root{Path: "/var/lib/source/rootfs"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := setupBundle(b, rootfs); err == nil {
+		t.Fatal("expected a checkpoint without rootfs to be rejected")
+	}
+	if err := ioutil.WriteFile(filepath.Join(rootfs, "hello"), []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := setupBundle(b, rootfs); err != nil {
+		t.Fatal(err)
+	}
+	if path, err := b.Rootfs(); err != nil || path != rootfs {
+		t.Fatalf("expected the rootfs of the bundle to be %s, got %s: %v", rootfs, path, err)
+	}
+}
+
+func TestCheckpointName(t *testing.T) {
+	s := &Service{}
+	if _, err := s.Checkpoint(context.Background(), &api.CheckpointRequest{ID: "c"}); grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected a checkpoint without name to be rejected, got %v", err)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	s, dir, cleanup := serviceEnv(t)
+	defer cleanup()
+	ctx := context.Background()
+	spec := &specs.Spec{Root: specs.Root{Path: "/var/lib/source/rootfs"}}
+	writeCheckpoint(t, s, "memory", dir, nil, false, images.CheckpointConfig{ContainerID: "c"})
+	writeCheckpoint(t, s, "rootfs", dir, spec, true, images.CheckpointConfig{ContainerID: "c"})
+	writeCheckpoint(t, s, "network", dir, spec, true, images.CheckpointConfig{ContainerID: "c", EmptyNS: []string{"ipc"}})
+
+	shared := filepath.Join(dir, "shared")
+	if err := os.Mkdir(shared, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&bundle.Bundle{Path: shared}).SetConfig(&specs.Spec{Root: specs.Root{Path: "rootfs"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		r    api.RestoreRequest
+		code codes.Code
+	}{
+		{"no container", api.RestoreRequest{Name: "rootfs"}, codes.InvalidArgument},
+		{"unknown checkpoint", api.RestoreRequest{Name: "unknown", Container: &execapi.CreateContainerRequest{ID: "r"}}, codes.NotFound},
+		{"relative bundle", api.RestoreRequest{Name: "rootfs", Container: &execapi.CreateContainerRequest{ID: "r", BundlePath: "bundle"}}, codes.InvalidArgument},
+		{"shared bundle", api.RestoreRequest{Name: "rootfs", Container: &execapi.CreateContainerRequest{ID: "r", BundlePath: shared}}, codes.InvalidArgument},
+	} {
+		if _, err := s.Restore(ctx, &tc.r); grpc.Code(err) != tc.code {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.code, err)
+		}
+	}
+
+	// the bundles created from a checkpoint are removed when the restore
+	// fails
+	for _, tc := range []struct {
+		name      string
+		container execapi.CreateContainerRequest
+	}{
+		{"memory", execapi.CreateContainerRequest{ID: "r"}},
+		{"network", execapi.CreateContainerRequest{ID: "r", Network: "bridge"}},
+	} {
+		tc.container.BundlePath = filepath.Join(dir, "bundle")
+		if _, err := s.Restore(ctx, &api.RestoreRequest{Name: tc.name, Container: &tc.container}); err == nil {
+			t.Errorf("%s: expected the restore to fail", tc.name)
+		}
+		if _, err := os.Stat(tc.container.BundlePath); !os.IsNotExist(err) {
+			t.Errorf("%s: expected the bundle to be removed, got %v", tc.name, err)
+		}
+	}
+	if fis, err := ioutil.ReadDir(s.root); err != nil || len(fis) != 0 {
+		t.Fatalf("expected the checkpoints to be removed once extracted, got %v: %v", fis, err)
+	}
+}
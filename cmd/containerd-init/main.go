@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+const (
+	// binaryFd is the helper itself, executed by the runtime through
+	// /proc/self/fd as the binary is not in the root filesystem of the
+	// container.
+	binaryFd = 3
+	// syncFd is the socket the helper reports its pid on and receives the
+	// go ahead from the shim.
+	syncFd = 4
+)
+
+// containerd-init is the first process of the exec processes run by the shim.
+// The runtime has joined the namespaces of the container and set up the exec
+// spec when it executes the helper, which reports its pid to the shim and
+// waits for the shim to monitor it before executing the process, so that the
+// process cannot exit before the shim knows its pid.
+//
+// The pid is sent with the credentials of the socket, translated by the
+// kernel into the pid namespace of the shim.
+// Arg0..: the process to execute
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "containerd-init: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if len(os.Args) < 2 {
+		return fmt.Errorf("no process to execute")
+	}
+	if err := reportPid(syncFd); err != nil {
+		return err
+	}
+	syscall.Close(binaryFd)
+	syscall.Close(syncFd)
+	path, err := exec.LookPath(os.Args[1])
+	if err != nil {
+		return err
+	}
+	return syscall.Exec(path, os.Args[1:], os.Environ())
+}
+
+// reportPid sends the pid of the helper on the socket fd and waits for the
+// acknowledgment of the shim. The helper exits without executing the process
+// when the shim closes the socket instead.
+func reportPid(fd int) error {
+	creds := syscall.UnixCredentials(&syscall.Ucred{
+		Pid: int32(os.Getpid()),
+		Uid: uint32(os.Getuid()),
+		Gid: uint32(os.Getgid()),
+	})
+	if err := syscall.Sendmsg(fd, []byte{0}, creds, nil, 0); err != nil {
+		return fmt.Errorf("failed to report the pid: %v", err)
+	}
+	b := make([]byte, 1)
+	for {
+		n, err := syscall.Read(fd, b)
+		if err == syscall.EINTR {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to wait for the shim: %v", err)
+		}
+		if n == 0 {
+			return fmt.Errorf("the shim went away")
+		}
+		return nil
+	}
+}
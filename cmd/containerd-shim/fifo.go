@@ -0,0 +1,99 @@
+package main
+
+import (
+	"io"
+	"os"
+	"sync"
+	"syscall"
+
+	"github.com/docker/containerd/stdio"
+)
+
+// fifoWriter writes the output of the process to a stdio fifo, held open for
+// reading as well so that writes do not fail while no client reads it. The
+// fifo is reopened once it was removed and created again.
+type fifoWriter struct {
+	path string
+
+	mu sync.Mutex
+	w  *os.File
+	r  *os.File
+}
+
+func openFifoWriter(path string) (*fifoWriter, error) {
+	w, err := stdio.OpenWriter(path, stdio.DefaultOpenTimeout)
+	if err != nil {
+		return nil, err
+	}
+	r, err := stdio.OpenReader(path, stdio.DefaultOpenTimeout)
+	if err != nil {
+		w.Close()
+		return nil, err
+	}
+	return &fifoWriter{
+		path: path,
+		w:    w,
+		r:    r,
+	}, nil
+}
+
+func (f *fifoWriter) current() *os.File {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.w
+}
+
+// copy copies src to the fifo until EOF, carrying on to the reopened fifo
+// when the one it was copying to is closed by reopen.
+func (f *fifoWriter) copy(src io.Reader) error {
+	for {
+		w := f.current()
+		_, err := stdio.Copy(w, src)
+		if err == nil || f.current() == w {
+			return err
+		}
+	}
+}
+
+// reopen opens the fifo at the path again when it is not the one written to,
+// closing the removed one.
+func (f *fifoWriter) reopen() error {
+	fi, err := os.Stat(f.path)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	old, oldr := f.w, f.r
+	f.mu.Unlock()
+	if ofi, err := old.Stat(); err == nil && os.SameFile(fi, ofi) {
+		return nil
+	}
+	// the reader is opened first for the writer not to wait for a client
+	r, err := os.OpenFile(f.path, syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return err
+	}
+	w, err := stdio.OpenWriter(f.path, stdio.DefaultOpenTimeout)
+	if err != nil {
+		r.Close()
+		return err
+	}
+	f.mu.Lock()
+	f.w, f.r = w, r
+	f.mu.Unlock()
+	// a copy blocked on the full removed fifo fails and carries on to the
+	// new one
+	old.Close()
+	oldr.Close()
+	return nil
+}
+
+func (f *fifoWriter) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	err := f.w.Close()
+	if rerr := f.r.Close(); err == nil {
+		err = rerr
+	}
+	return err
+}
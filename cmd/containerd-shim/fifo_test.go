@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestFifoWriterReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shim-fifo-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "stdout")
+	if err := syscall.Mkfifo(path, 0700); err != nil {
+		t.Fatal(err)
+	}
+	client, err := os.OpenFile(path, syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	fw, err := openFifoWriter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fw.Close()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	copied := make(chan error, 1)
+	go func() {
+		copied <- fw.copy(r)
+	}()
+
+	// the copy blocks on the removed fifo no client can read anymore
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	go w.Write(append(bytes.Repeat([]byte("x"), 256<<10), 'b'))
+	time.Sleep(10 * time.Millisecond)
+	if err := syscall.Mkfifo(path, 0700); err != nil {
+		t.Fatal(err)
+	}
+	reopened, err := os.OpenFile(path, syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+	if err := fw.reopen(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the rest of the output is copied to the fifo created again
+	reopened.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buf := make([]byte, 64<<10)
+	for {
+		n, err := reopened.Read(buf)
+		if err != nil {
+			t.Fatalf("expected the output to be copied to the reopened fifo: %v", err)
+		}
+		if bytes.HasSuffix(buf[:n], []byte("b")) {
+			break
+		}
+	}
+	w.Close()
+	select {
+	case err := <-copied:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the copy to end with its source")
+	}
+}
@@ -0,0 +1,143 @@
+// +build !solaris
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+// execInitTimeout is how long the shim waits for containerd-init to report
+// its pid once the runtime has returned.
+const execInitTimeout = 10 * time.Second
+
+// execInit runs an exec process through containerd-init, which reports its
+// pid to the shim and waits before executing the process until the shim has
+// started to monitor it.
+type execInit struct {
+	// spec is the process spec of the exec, running the process through the
+	// helper.
+	spec string
+	// files are passed to the helper by the runtime: the helper binary
+	// and its end of the socket.
+	files []*os.File
+	conn  *net.UnixConn
+}
+
+// newExecInit prepares the exec of the process spec in process.json through
+// containerd-init. It returns nil when the helper is not installed, the pid
+// of the process being read from the pid file of the runtime instead.
+func newExecInit(cwd string) (*execInit, error) {
+	path, err := exec.LookPath("containerd-init")
+	if err != nil {
+		return nil, nil
+	}
+	binary, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM|syscall.SOCK_CLOEXEC, 0)
+	if err != nil {
+		binary.Close()
+		return nil, err
+	}
+	i := &execInit{
+		spec:  filepath.Join(cwd, "exec-process.json"),
+		files: []*os.File{binary, os.NewFile(uintptr(fds[1]), "containerd-init")},
+	}
+	f := os.NewFile(uintptr(fds[0]), "containerd-init")
+	defer f.Close()
+	if err := syscall.SetsockoptInt(fds[0], syscall.SOL_SOCKET, syscall.SO_PASSCRED, 1); err != nil {
+		i.Close()
+		return nil, err
+	}
+	c, err := net.FileConn(f)
+	if err != nil {
+		i.Close()
+		return nil, err
+	}
+	i.conn = c.(*net.UnixConn)
+	if err := writeExecSpec(filepath.Join(cwd, "process.json"), i.spec); err != nil {
+		i.Close()
+		return nil, err
+	}
+	return i, nil
+}
+
+// writeExecSpec writes the process spec from to to, executing the process
+// through the helper binary passed as fd 3.
+func writeExecSpec(from, to string) error {
+	data, err := ioutil.ReadFile(from)
+	if err != nil {
+		return err
+	}
+	var spec map[string]json.RawMessage
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return err
+	}
+	var args []string
+	if err := json.Unmarshal(spec["args"], &args); err != nil {
+		return err
+	}
+	if spec["args"], err = json.Marshal(append([]string{"/proc/self/fd/3"}, args...)); err != nil {
+		return err
+	}
+	if data, err = json.Marshal(spec); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(to, data, 0600)
+}
+
+// pid returns the pid of the helper, which becomes the exec process, in the
+// pid namespace of the shim.
+func (i *execInit) pid() (int, error) {
+	// the end of the helper is closed so that the read fails when the
+	// helper exits without reporting its pid
+	i.files[1].Close()
+	i.conn.SetReadDeadline(time.Now().Add(execInitTimeout))
+	var (
+		b   = make([]byte, 1)
+		oob = make([]byte, syscall.CmsgSpace(syscall.SizeofUcred))
+	)
+	_, oobn, _, _, err := i.conn.ReadMsgUnix(b, oob)
+	if err != nil {
+		return -1, fmt.Errorf("failed to read the pid of containerd-init: %v", err)
+	}
+	msgs, err := syscall.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		return -1, err
+	}
+	if len(msgs) != 1 {
+		return -1, fmt.Errorf("containerd-init sent no credentials")
+	}
+	creds, err := syscall.ParseUnixCredentials(&msgs[0])
+	if err != nil {
+		return -1, err
+	}
+	return int(creds.Pid), nil
+}
+
+// start lets the helper execute the process.
+func (i *execInit) start() error {
+	_, err := i.conn.Write([]byte{0})
+	return err
+}
+
+// Close closes the files of the helper, which exits without executing the
+// process unless it was started.
+func (i *execInit) Close() error {
+	for _, f := range i.files {
+		f.Close()
+	}
+	if i.conn != nil {
+		return i.conn.Close()
+	}
+	return nil
+}
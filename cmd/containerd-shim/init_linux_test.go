@@ -0,0 +1,85 @@
+// +build !solaris
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"syscall"
+	"testing"
+)
+
+func TestExecInit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shim-init-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "containerd-init"), nil, 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "process.json"), []byte(`{"args":["sh","-c","true"],"cwd":"/","exec":true}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	i, err := newExecInit(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i == nil {
+		t.Fatal("expected the exec to run through containerd-init")
+	}
+	defer i.Close()
+	data, err := ioutil.ReadFile(i.spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec struct {
+		Args []string `json:"args"`
+		Cwd  string   `json:"cwd"`
+		Exec bool     `json:"exec"`
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"/proc/self/fd/3", "sh", "-c", "true"}; !reflect.DeepEqual(spec.Args, expected) {
+		t.Fatalf("expected the args %v, got %v", expected, spec.Args)
+	}
+	if spec.Cwd != "/" || !spec.Exec {
+		t.Fatalf("expected the other fields of the spec to be kept, got %s", data)
+	}
+
+	// play the helper on a dup of its end of the socket, which the shim
+	// closes once the runtime has returned
+	fd, err := syscall.Dup(int(i.files[1].Fd()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer syscall.Close(fd)
+	creds := syscall.UnixCredentials(&syscall.Ucred{
+		Pid: int32(os.Getpid()),
+		Uid: uint32(os.Getuid()),
+		Gid: uint32(os.Getgid()),
+	})
+	if err := syscall.Sendmsg(fd, []byte{0}, creds, nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	pid, err := i.pid()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid != os.Getpid() {
+		t.Fatalf("expected the pid %d, got %d", os.Getpid(), pid)
+	}
+	if err := i.start(); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := syscall.Read(fd, make([]byte, 1)); err != nil || n != 1 {
+		t.Fatalf("expected the helper to be started, got %d, %v", n, err)
+	}
+}
@@ -1,22 +1,43 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"runtime"
 	"syscall"
+	"time"
 
+	shimapi "github.com/docker/containerd/api/shim"
+	"github.com/docker/containerd/reaper"
 	"github.com/docker/containerd/sys"
+	"github.com/docker/containerd/ttrpc"
 	"github.com/docker/docker/pkg/term"
 )
 
-var logFile *os.File
+var (
+	logFile *os.File
 
+	socketFlag = flag.String("socket", "", "unix socket address to serve the shim api on")
+)
+
+// writeMessage writes an entry to the shim log in the same json format as
+// the runtime log.
 func writeMessage(f *os.File, level string, err error) {
-	fmt.Fprintf(f, `{"level": "%s","msg": "%s"}`, level, err)
+	json.NewEncoder(f).Encode(struct {
+		Level string    `json:"level"`
+		Msg   string    `json:"msg"`
+		Time  time.Time `json:"time"`
+	}{
+		Level: level,
+		Msg:   err.Error(),
+		Time:  time.Now(),
+	})
 	f.Sync()
 }
 
@@ -34,8 +55,19 @@ type controlMessage struct {
 // Arg0: id of the container
 // Arg1: bundle path
 // Arg2: runtime binary
+//
+// When -socket is provided the shim serves the shim api over ttrpc on that
+// address for the lifetime of the process, to the user of the daemon only.
+// With -pause the shim is the pause process of a sandbox instead, see pause.
+// With -sandbox the shim is shared by the containers of a sandbox and takes
+// no arguments, it creates their processes as requested over the sandbox api
+// served on -socket, each in the state directory of the request, until it is
+// shut down.
 func main() {
 	flag.Parse()
+	if *pauseFlag {
+		os.Exit(pause())
+	}
 	cwd, err := os.Getwd()
 	if err != nil {
 		panic(err)
@@ -70,35 +102,105 @@ func start(log *os.File) error {
 	if err := sys.SetSubreaper(1); err != nil {
 		return err
 	}
-	// open the exit pipe
-	f, err := os.OpenFile("exit", syscall.O_WRONLY, 0)
-	if err != nil {
-		return err
+	// reap in its own goroutine as the runtime commands are waited for
+	// through the reaper, before the main loop is running.
+	go func() {
+		for s := range signals {
+			if s == syscall.SIGCHLD {
+				reaper.Reap()
+			}
+		}
+	}()
+	if *sandboxFlag {
+		return serveSandbox(*socketFlag)
 	}
-	defer f.Close()
-	control, err := os.OpenFile("control", syscall.O_RDWR, 0)
+	cwd, err := os.Getwd()
 	if err != nil {
 		return err
 	}
-	defer control.Close()
-	p, err := newProcess(flag.Arg(0), flag.Arg(1), flag.Arg(2))
+	sp, err := newShimProcess(log, cwd, flag.Arg(0), flag.Arg(1), flag.Arg(2), *socketFlag)
 	if err != nil {
 		return err
 	}
+	return sp.run()
+}
+
+// shimProcess is a process managed by the shim, controlled through the fifos
+// of its state directory and, with a socket, the shim api.
+type shimProcess struct {
+	*process
+	log     *os.File
+	svc     *service
+	exit    *os.File
+	control *os.File
+	server  *ttrpc.Server
+}
+
+// newShimProcess creates the process with the state directory root through
+// the runtime, serving the shim api of the process on socket unless it is
+// empty.
+func newShimProcess(log *os.File, root, id, bundle, runtimeName, socket string) (_ *shimProcess, err error) {
+	sp := &shimProcess{log: log}
+	defer func() {
+		if err != nil {
+			if sp.control != nil {
+				sp.control.Close()
+			}
+			sp.close()
+		}
+	}()
+	// open the exit pipe
+	if sp.exit, err = os.OpenFile(filepath.Join(root, "exit"), syscall.O_WRONLY, 0); err != nil {
+		return nil, err
+	}
+	if sp.control, err = os.OpenFile(filepath.Join(root, "control"), syscall.O_RDWR, 0); err != nil {
+		return nil, err
+	}
+	if sp.process, err = newProcess(root, id, bundle, runtimeName); err != nil {
+		return nil, err
+	}
 	// defer func() {
 	// 	if err := p.Close(); err != nil {
 	// 		writeMessage(log, "warn", err)
 	// 	}
 	// }()
-	if err := p.create(log); err != nil {
-		p.delete()
-		return err
+	sp.svc = newService(sp.process)
+	if socket != "" {
+		if sp.server, err = serveShimAPI(socket, sp.svc); err != nil {
+			return nil, err
+		}
+	}
+	if err := sp.create(log); err != nil {
+		sp.delete()
+		return nil, err
 	}
+	return sp, nil
+}
+
+// run waits for the process to exit, handling the messages of the control
+// fifo meanwhile.
+func (sp *shimProcess) run() error {
+	defer sp.close()
+	// the reader of the control fifo closes it once the process is done,
+	// woken up by a message of the shim, as a shim shared by a sandbox
+	// outlives the process.
+	done := make(chan struct{})
+	defer func() {
+		close(done)
+		fmt.Fprintf(sp.control, "%d %d %d\n", -1, 0, 0)
+	}()
 	msgC := make(chan controlMessage, 32)
 	go func() {
+		defer sp.control.Close()
 		for {
 			var m controlMessage
-			if _, err := fmt.Fscanf(control, "%d %d %d\n", &m.Type, &m.Width, &m.Height); err != nil {
+			_, err := fmt.Fscanf(sp.control, "%d %d %d\n", &m.Type, &m.Width, &m.Height)
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if err != nil {
 				continue
 			}
 			msgC <- m
@@ -107,107 +209,99 @@ func start(log *os.File) error {
 	if runtime.GOOS == "solaris" {
 		return nil
 	}
-	var exitShim bool
+	// the container process may already be reaped, its status is kept by
+	// the reaper until it is waited for.
+	exitCh := make(chan int, 1)
+	go func() {
+		exitCh <- reaper.Default.WaitPid(sp.pid())
+	}()
 	for {
 		select {
-		case s := <-signals:
-			switch s {
-			case syscall.SIGCHLD:
-				exits, _ := Reap(false)
-				for _, e := range exits {
-					// check to see if runtime is one of the processes that has exited
-					if e.Pid == p.pid() {
-						exitShim = true
-						writeInt("exitStatus", e.Status)
-					}
-				}
+		case status := <-exitCh:
+			if err := writeInt(filepath.Join(sp.root, "exitStatus"), status); err != nil {
+				writeMessage(sp.log, "error", err)
 			}
+			sp.svc.exit(status)
 			// runtime has exited so the shim can also exit
-			if exitShim {
-				// kill all processes in the container incase it was not running in
-				// its own PID namespace
-				p.killAll()
-				// wait for all the processes and IO to finish
-				p.Wait()
-				// delete the container from the runtime
-				p.delete()
-				// the close of the exit fifo will happen when the shim exits
-				return nil
-			}
+			// kill all processes in the container incase it was not running in
+			// its own PID namespace
+			sp.killAll()
+			// wait for all the processes and IO to finish
+			sp.Wait()
+			// delete the container from the runtime
+			sp.delete()
+			// the exit fifo is closed once the process is done
+			return nil
 		case msg := <-msgC:
 			switch msg.Type {
 			case 0:
 				// close stdin
-				if p.stdinCloser != nil {
-					p.stdinCloser.Close()
+				if sp.stdinCloser != nil {
+					sp.stdinCloser.Close()
 				}
 			case 1:
-				if p.console == nil {
+				if sp.console == nil {
 					continue
 				}
 				ws := term.Winsize{
 					Width:  uint16(msg.Width),
 					Height: uint16(msg.Height),
 				}
-				term.SetWinsize(p.console.Fd(), &ws)
+				term.SetWinsize(sp.console.Fd(), &ws)
+			case 2:
+				// reopen the stdio fifos created again
+				if err := sp.reopenIO(); err != nil {
+					writeMessage(sp.log, "warn", err)
+				}
 			}
 		}
 	}
-	return nil
 }
 
-func writeInt(path string, i int) error {
-	f, err := os.Create(path)
-	if err != nil {
-		return err
+// close stops serving the shim api and closes the exit fifo, telling the
+// daemon the process is gone.
+func (sp *shimProcess) close() {
+	if sp.server != nil {
+		// give clients blocked in Wait the chance to receive the exit
+		// status before the shim goes away.
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		sp.server.Shutdown(ctx)
+		cancel()
+	}
+	if sp.exit != nil {
+		sp.exit.Close()
 	}
-	defer f.Close()
-	_, err = fmt.Fprintf(f, "%d", i)
-	return err
 }
 
-// Exit is the wait4 information from an exited process
-type Exit struct {
-	Pid    int
-	Status int
+func serveShimAPI(address string, svc *service) (*ttrpc.Server, error) {
+	l, err := net.Listen("unix", address)
+	if err != nil {
+		return nil, err
+	}
+	server := ttrpc.NewServer(shimServerOpts()...)
+	shimapi.RegisterShimTTRPC(server, svc)
+	go server.Serve(l)
+	return server, nil
 }
 
-// Reap reaps all child processes for the calling process and returns their
-// exit information
-func Reap(wait bool) (exits []Exit, err error) {
-	var (
-		ws  syscall.WaitStatus
-		rus syscall.Rusage
-	)
-	flag := syscall.WNOHANG
-	if wait {
-		flag = 0
+// writeInt writes i to path. The value is synced and renamed into place so
+// the daemon, possibly restarting while the shim exits, never reads a
+// partial value.
+func writeInt(path string, i int) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
 	}
-	for {
-		pid, err := syscall.Wait4(-1, &ws, flag, &rus)
-		if err != nil {
-			if err == syscall.ECHILD {
-				return exits, nil
-			}
-			return exits, err
-		}
-		if pid <= 0 {
-			return exits, nil
-		}
-		exits = append(exits, Exit{
-			Pid:    pid,
-			Status: exitStatus(ws),
-		})
+	if _, err = fmt.Fprintf(f, "%d", i); err == nil {
+		err = f.Sync()
 	}
-}
-
-const exitSignalOffset = 128
-
-// exitStatus returns the correct exit status for a process based on if it
-// was signaled or exited cleanly
-func exitStatus(status syscall.WaitStatus) int {
-	if status.Signaled() {
-		return exitSignalOffset + int(status.Signal())
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
 	}
-	return status.ExitStatus()
+	return os.Rename(tmp, path)
 }
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/docker/containerd/reaper"
+)
+
+var (
+	pauseFlag    = flag.Bool("pause", false, "hold the namespaces of a sandbox until signaled")
+	hostnameFlag = flag.String("hostname", "", "hostname of the sandbox, with -pause")
+)
+
+// pause holds the namespaces the shim was started in, for the containers of a
+// sandbox to join, until it is terminated. Orphans are reaped in case it is
+// the init of a pid namespace.
+func pause() int {
+	signals := make(chan os.Signal, 32)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGCHLD)
+	if *hostnameFlag != "" {
+		if err := setHostname(*hostnameFlag); err != nil {
+			return 1
+		}
+	}
+	for s := range signals {
+		if s == syscall.SIGCHLD {
+			reaper.Reap()
+			continue
+		}
+		return 0
+	}
+	return 0
+}
@@ -11,9 +11,13 @@ import (
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
+
+	"github.com/docker/containerd/reaper"
+	"github.com/docker/containerd/stdio"
 )
 
 var errRuntime = errors.New("shim: runtime execution error")
@@ -36,7 +40,7 @@ type checkpoint struct {
 }
 
 type processState struct {
-	Terminal    bool     `json:terminal`
+	Terminal    bool     `json:"terminal"`
 	Exec        bool     `json:"exec"`
 	Stdin       string   `json:"containerdStdin"`
 	Stdout      string   `json:"containerdStdout"`
@@ -51,9 +55,11 @@ type processState struct {
 
 type process struct {
 	sync.WaitGroup
+	// root is the state directory of the process.
+	root           string
 	id             string
 	bundle         string
-	stdio          *stdio
+	stdio          *pipes
 	exec           bool
 	containerPid   int
 	checkpoint     *checkpoint
@@ -61,18 +67,24 @@ type process struct {
 	shimIO         *IO
 	stdinCloser    io.Closer
 	console        *os.File
-	consolePath    string
 	state          *processState
 	runtime        string
+	// consoleSocket receives the console of a terminal process from the
+	// runtime, it is nil once the console is attached.
+	consoleSocket *stdio.ConsoleSocket
+	// outputs are the stdout and stderr fifos the output of the process is
+	// copied to.
+	outputs []*fifoWriter
 }
 
-func newProcess(id, bundle, runtimeName string) (*process, error) {
+func newProcess(root, id, bundle, runtimeName string) (*process, error) {
 	p := &process{
+		root:    root,
 		id:      id,
 		bundle:  bundle,
 		runtime: runtimeName,
 	}
-	s, err := loadProcess()
+	s, err := loadProcess(root)
 	if err != nil {
 		return nil, err
 	}
@@ -85,14 +97,14 @@ func newProcess(id, bundle, runtimeName string) (*process, error) {
 		p.checkpoint = cpt
 		p.checkpointPath = s.CheckpointPath
 	}
-	// if err := p.openIO(); err != nil {
-	// 	return nil, err
-	// }
+	if err := p.openIO(); err != nil {
+		return nil, err
+	}
 	return p, nil
 }
 
-func loadProcess() (*processState, error) {
-	f, err := os.Open("process.json")
+func loadProcess(root string) (*processState, error) {
+	f, err := os.Open(filepath.Join(root, "process.json"))
 	if err != nil {
 		return nil, err
 	}
@@ -117,24 +129,49 @@ func loadCheckpoint(checkpointPath string) (*checkpoint, error) {
 	return &cpt, nil
 }
 
-func (p *process) create(log *os.File) error {
-	writeMessage(log, "error", fmt.Errorf("starting create\n"))
-	cwd, err := os.Getwd()
-	writeMessage(log, "error", fmt.Errorf("cwd: %s\n", cwd))
-	if err != nil {
-		return err
-	}
-	logPath := filepath.Join(cwd, "log.json")
-	args := append([]string{
-		"--log", logPath,
+// runtimeArgs returns the global flags for invocations of the runtime. The
+// runtime logs to log.json in the state directory so that its errors can be
+// reported by the daemon.
+func (p *process) runtimeArgs() []string {
+	return append([]string{
+		"--log", filepath.Join(p.root, "log.json"),
 		"--log-format", "json",
 	}, p.state.RuntimeArgs...)
+}
+
+func (p *process) create(log *os.File) error {
+	defer func() {
+		// the console socket is left behind when the runtime failed
+		if p.consoleSocket != nil {
+			p.consoleSocket.Close()
+		}
+	}()
+	var (
+		args = p.runtimeArgs()
+		init *execInit
+		err  error
+	)
 	if p.state.Exec {
-		args = append(args, "exec",
-			"-d",
-			"--process", filepath.Join(cwd, "process.json"),
-			//"--console", p.consolePath,
-		)
+		// the exec process is run through containerd-init when it is
+		// installed, which reports its pid and waits for the shim before
+		// executing the process. Otherwise the pid is only known once the
+		// runtime has written the pid file and returned, a process exiting
+		// before then being reaped by the shim, its status kept by the
+		// reaper until the process is waited for.
+		if init, err = newExecInit(p.root); err != nil {
+			return err
+		}
+		spec := filepath.Join(p.root, "process.json")
+		args = append(args, "exec", "-d")
+		if init != nil {
+			defer init.Close()
+			spec = init.spec
+			args = append(args, "--preserve-fds", strconv.Itoa(len(init.files)))
+		}
+		args = append(args, "--process", spec)
+		if p.consoleSocket != nil {
+			args = append(args, "--console-socket", p.consoleSocket.Path())
+		}
 	} else if p.checkpoint != nil {
 		args = append(args, "restore",
 			"-d",
@@ -163,27 +200,35 @@ func (p *process) create(log *os.File) error {
 	} else {
 		args = append(args, "create",
 			"--bundle", p.bundle,
-			"--console", p.consolePath,
 		)
+		if p.consoleSocket != nil {
+			args = append(args, "--console-socket", p.consoleSocket.Path())
+		}
 		if p.state.NoPivotRoot {
 			args = append(args, "--no-pivot")
 		}
 	}
 	args = append(args,
-		"--pid-file", filepath.Join(cwd, "pid"),
+		"--pid-file", filepath.Join(p.root, "pid"),
 		p.id,
 	)
 
-	writeMessage(log, "error", fmt.Errorf("runc command: %s\n", args))
+	writeMessage(log, "debug", fmt.Errorf("%s %s", p.runtime, strings.Join(args, " ")))
 	cmd := exec.Command(p.runtime, args...)
 	cmd.Dir = p.bundle
-	// cmd.Stdin = p.stdio.stdin
-	// cmd.Stdout = p.stdio.stdout
-	// cmd.Stderr = p.stdio.stderr
+	if init != nil {
+		cmd.ExtraFiles = init.files
+	}
+	if p.shimIO != nil {
+		cmd.Stdin = p.stdio.stdin
+		cmd.Stdout = p.stdio.stdout
+		cmd.Stderr = p.stdio.stderr
+	}
 	// Call out to setPDeathSig to set SysProcAttr as elements are platform specific
 	cmd.SysProcAttr = setPDeathSig()
 
-	if err := cmd.Start(); err != nil {
+	exitCh, err := reaper.Default.Start(cmd)
+	if err != nil {
 		if exErr, ok := err.(*exec.Error); ok {
 			if exErr.Err == exec.ErrNotFound || exErr.Err == os.ErrNotExist {
 				return fmt.Errorf("%s not installed on system", p.runtime)
@@ -191,20 +236,30 @@ func (p *process) create(log *os.File) error {
 		}
 		return err
 	}
-	if runtime.GOOS != "solaris" {
+	if runtime.GOOS != "solaris" && p.shimIO != nil {
 		// Since current logic dictates that we need a pid at the end of p.create
 		// we need to call runtime start as well on Solaris hence we need the
 		// pipes to stay open.
-		// p.stdio.stdout.Close()
-		// p.stdio.stderr.Close()
+		p.stdio.stdout.Close()
+		p.stdio.stderr.Close()
+	}
+	if status := reaper.Default.Wait(cmd, exitCh); status != 0 {
+		return errRuntime
 	}
-	if err := cmd.Wait(); err != nil {
-		if _, ok := err.(*exec.ExitError); ok {
-			return errRuntime
+	if p.consoleSocket != nil {
+		if err := p.attachConsole(); err != nil {
+			return err
 		}
-		return err
 	}
-	data, err := ioutil.ReadFile("pid")
+	if init != nil {
+		pid, err := init.pid()
+		if err != nil {
+			return err
+		}
+		p.containerPid = pid
+		return init.start()
+	}
+	data, err := ioutil.ReadFile(filepath.Join(p.root, "pid"))
 	if err != nil {
 		return err
 	}
@@ -224,7 +279,7 @@ func (p *process) delete() error {
 	if !p.state.Exec {
 		cmd := exec.Command(p.runtime, append(p.state.RuntimeArgs, "delete", p.id)...)
 		cmd.SysProcAttr = setPDeathSig()
-		out, err := cmd.CombinedOutput()
+		out, err := reaper.Default.CombinedOutput(cmd)
 		if err != nil {
 			return fmt.Errorf("%s: %v", out, err)
 		}
@@ -277,17 +332,30 @@ func (p *process) initializeIO(rootuid int) (i *IO, err error) {
 	}
 	return i, nil
 }
+
+// reopenIO reopens the stdout and stderr fifos created again after they were
+// removed.
+func (p *process) reopenIO() error {
+	for _, fw := range p.outputs {
+		if err := fw.reopen(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (p *process) Close() error {
 	return p.stdio.Close()
 }
 
-type stdio struct {
+// pipes are the stdio of the runtime, copied to and from the fifos.
+type pipes struct {
 	stdin  *os.File
 	stdout *os.File
 	stderr *os.File
 }
 
-func (s *stdio) Close() error {
+func (s *pipes) Close() error {
 	err := s.stdin.Close()
 	if oerr := s.stdout.Close(); err == nil {
 		err = oerr
@@ -7,8 +7,10 @@ import (
 	"io"
 	"os/exec"
 	"syscall"
-	"time"
 
+	"github.com/docker/containerd/reaper"
+	"github.com/docker/containerd/stdio"
+	"github.com/docker/containerd/ttrpc"
 	"github.com/tonistiigi/fifo"
 	"golang.org/x/net/context"
 )
@@ -21,51 +23,37 @@ func setPDeathSig() *syscall.SysProcAttr {
 	}
 }
 
+// shimServerOpts returns the options of the shim api server. The api is
+// served on an abstract socket, open to any user of the network namespace, so
+// connections from other users than that of the daemon are refused.
+func shimServerOpts() []ttrpc.ServerOpt {
+	return []ttrpc.ServerOpt{
+		ttrpc.WithServerHandshaker(ttrpc.UnixSocketRequireSameUser()),
+	}
+}
+
 // openIO opens the pre-created fifo's for use with the container
 // in RDWR so that they remain open if the other side stops listening
 func (p *process) openIO() error {
-	p.stdio = &stdio{}
-	var (
-		uid = p.state.RootUID
-		gid = p.state.RootGID
-	)
+	p.stdio = &pipes{}
+	uid := p.state.RootUID
 
-	ctx, _ := context.WithTimeout(context.Background(), 15*time.Second)
-
-	stdinCloser, err := fifo.OpenFifo(ctx, p.state.Stdin, syscall.O_WRONLY|syscall.O_NONBLOCK, 0)
+	// hold the stdin fifo open for writing so that the process does not
+	// see EOF before stdin is explicitly closed.
+	stdinCloser, err := fifo.OpenFifo(context.Background(), p.state.Stdin, syscall.O_WRONLY|syscall.O_NONBLOCK, 0)
 	if err != nil {
 		return err
 	}
 	p.stdinCloser = stdinCloser
 
 	if p.state.Terminal {
-		master, console, err := newConsole(uid, gid)
-		if err != nil {
-			return err
-		}
-		p.console = master
-		p.consolePath = console
-		stdin, err := fifo.OpenFifo(ctx, p.state.Stdin, syscall.O_RDONLY, 0)
-		if err != nil {
-			return err
-		}
-		go io.Copy(master, stdin)
-		stdoutw, err := fifo.OpenFifo(ctx, p.state.Stdout, syscall.O_WRONLY, 0)
+		// the runtime creates the pty and sends us the master once the
+		// process is created, see attachConsole.
+		socket, err := stdio.NewConsoleSocket()
 		if err != nil {
 			return err
 		}
-		stdoutr, err := fifo.OpenFifo(ctx, p.state.Stdout, syscall.O_RDONLY, 0)
-		if err != nil {
-			return err
-		}
-		p.Add(1)
-		go func() {
-			io.Copy(stdoutw, master)
-			master.Close()
-			stdoutr.Close()
-			stdoutw.Close()
-			p.Done()
-		}()
+		p.consoleSocket = socket
 		return nil
 	}
 	i, err := p.initializeIO(uid)
@@ -74,43 +62,29 @@ func (p *process) openIO() error {
 	}
 	p.shimIO = i
 	// non-tty
-	for name, dest := range map[string]func(wc io.WriteCloser, rc io.Closer){
-		p.state.Stdout: func(wc io.WriteCloser, rc io.Closer) {
-			p.Add(1)
-			go func() {
-				io.Copy(wc, i.Stdout)
-				p.Done()
-				wc.Close()
-				rc.Close()
-			}()
-		},
-		p.state.Stderr: func(wc io.WriteCloser, rc io.Closer) {
-			p.Add(1)
-			go func() {
-				io.Copy(wc, i.Stderr)
-				p.Done()
-				wc.Close()
-				rc.Close()
-			}()
-		},
+	for name, src := range map[string]io.Reader{
+		p.state.Stdout: i.Stdout,
+		p.state.Stderr: i.Stderr,
 	} {
-		fw, err := fifo.OpenFifo(ctx, name, syscall.O_WRONLY, 0)
+		fw, err := openFifoWriter(name)
 		if err != nil {
 			return fmt.Errorf("containerd-shim: opening %s failed: %s", name, err)
 		}
-		fr, err := fifo.OpenFifo(ctx, name, syscall.O_RDONLY, 0)
-		if err != nil {
-			return fmt.Errorf("containerd-shim: opening %s failed: %s", name, err)
-		}
-		dest(fw, fr)
+		p.outputs = append(p.outputs, fw)
+		p.Add(1)
+		go func(fw *fifoWriter, src io.Reader) {
+			fw.copy(src)
+			p.Done()
+			fw.Close()
+		}(fw, src)
 	}
 
-	f, err := fifo.OpenFifo(ctx, p.state.Stdin, syscall.O_RDONLY, 0)
+	f, err := stdio.OpenReader(p.state.Stdin, stdio.DefaultOpenTimeout)
 	if err != nil {
 		return fmt.Errorf("containerd-shim: opening %s failed: %s", p.state.Stdin, err)
 	}
 	go func() {
-		io.Copy(i.Stdin, f)
+		stdio.Copy(i.Stdin, f)
 		i.Stdin.Close()
 		f.Close()
 	}()
@@ -118,14 +92,50 @@ func (p *process) openIO() error {
 	return nil
 }
 
+// attachConsole receives the pty master of the process from the runtime and
+// copies it to and from the stdio fifos.
+func (p *process) attachConsole() error {
+	defer func() {
+		p.consoleSocket.Close()
+		p.consoleSocket = nil
+	}()
+	master, err := p.consoleSocket.ReceiveMaster(stdio.DefaultOpenTimeout)
+	if err != nil {
+		return err
+	}
+	p.console = master
+	stdin, err := stdio.OpenReader(p.state.Stdin, stdio.DefaultOpenTimeout)
+	if err != nil {
+		return err
+	}
+	go stdio.Copy(master, stdin)
+	stdout, err := openFifoWriter(p.state.Stdout)
+	if err != nil {
+		return err
+	}
+	p.outputs = append(p.outputs, stdout)
+	p.Add(1)
+	go func() {
+		stdout.copy(master)
+		master.Close()
+		stdout.Close()
+		p.Done()
+	}()
+	return nil
+}
+
 func (p *process) killAll() error {
 	if !p.state.Exec {
 		cmd := exec.Command(p.runtime, append(p.state.RuntimeArgs, "kill", "--all", p.id, "SIGKILL")...)
 		cmd.SysProcAttr = setPDeathSig()
-		out, err := cmd.CombinedOutput()
+		out, err := reaper.Default.CombinedOutput(cmd)
 		if err != nil {
 			return fmt.Errorf("%s: %v", out, err)
 		}
 	}
 	return nil
 }
+
+func setHostname(name string) error {
+	return syscall.Sethostname([]byte(name))
+}
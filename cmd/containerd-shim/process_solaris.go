@@ -3,9 +3,12 @@
 package main
 
 import (
+	"errors"
 	"io"
 	"os"
 	"syscall"
+
+	"github.com/docker/containerd/ttrpc"
 )
 
 // setPDeathSig is a no-op on Solaris as Pdeathsig is not defined.
@@ -13,13 +16,19 @@ func setPDeathSig() *syscall.SysProcAttr {
 	return nil
 }
 
+// shimServerOpts returns no options on Solaris, which has no abstract sockets,
+// the socket of the shim api being a file in the state directory.
+func shimServerOpts() []ttrpc.ServerOpt {
+	return nil
+}
+
 // TODO: Update to using fifo's package in openIO. Need to
 // 1. Merge and vendor changes in the package to use sys/unix.
 // 2. Figure out why context.Background is timing out.
 // openIO opens the pre-created fifo's for use with the container
 // in RDWR so that they remain open if the other side stops listening
 func (p *process) openIO() error {
-	p.stdio = &stdio{}
+	p.stdio = &pipes{}
 	var (
 		uid = p.state.RootUID
 	)
@@ -65,6 +74,38 @@ func (p *process) openIO() error {
 	return nil
 }
 
+// attachConsole is a no-op on Solaris as the runtime handles the console.
+func (p *process) attachConsole() error {
+	return nil
+}
+
 func (p *process) killAll() error {
 	return nil
 }
+
+func setHostname(name string) error {
+	return errors.New("setting the hostname of a sandbox is not supported")
+}
+
+// execInit is not supported on Solaris, the pid of the exec processes being
+// read from the pid file of the runtime.
+type execInit struct {
+	spec  string
+	files []*os.File
+}
+
+func newExecInit(cwd string) (*execInit, error) {
+	return nil, nil
+}
+
+func (i *execInit) pid() (int, error) {
+	return -1, errors.New("containerd-init is not supported on solaris")
+}
+
+func (i *execInit) start() error {
+	return nil
+}
+
+func (i *execInit) Close() error {
+	return nil
+}
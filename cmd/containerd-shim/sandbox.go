@@ -0,0 +1,121 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	shimapi "github.com/docker/containerd/api/shim"
+	"github.com/docker/containerd/ttrpc"
+	google_protobuf "github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+var sandboxFlag = flag.Bool("sandbox", false, "manage the processes of the containers of a sandbox, created over the -socket api")
+
+var errSandboxSocket = errors.New("shim: -sandbox requires -socket")
+
+// sandboxService creates the processes of the containers of a sandbox in the
+// shim shared by the sandbox.
+type sandboxService struct {
+	mu sync.Mutex
+	// processes is the number of processes created and not yet done.
+	processes int
+	// closing is set once the shim is shut down, it creates no more
+	// processes then.
+	closing bool
+	// shutdown is closed once the shim is shut down and its last process
+	// is done.
+	shutdown chan struct{}
+}
+
+// serveSandbox serves the sandbox api on socket until the shim is shut down.
+func serveSandbox(socket string) error {
+	if socket == "" {
+		return errSandboxSocket
+	}
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+	s := &sandboxService{
+		shutdown: make(chan struct{}),
+	}
+	server := ttrpc.NewServer(shimServerOpts()...)
+	shimapi.RegisterSandboxTTRPC(server, s)
+	go server.Serve(l)
+	<-s.shutdown
+	// let the response of a shutdown of a shim without processes reach
+	// the daemon
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	return server.Shutdown(ctx)
+}
+
+func (s *sandboxService) Create(ctx context.Context, r *shimapi.CreateRequest) (*shimapi.CreateResponse, error) {
+	s.mu.Lock()
+	if s.closing {
+		s.mu.Unlock()
+		return nil, ttrpc.Errorf(codes.FailedPrecondition, "the shim is shut down")
+	}
+	s.processes++
+	s.mu.Unlock()
+
+	log, err := os.OpenFile(filepath.Join(r.StateDir, "shim-log.json"), os.O_CREATE|os.O_WRONLY|os.O_APPEND|os.O_SYNC, 0666)
+	if err != nil {
+		s.done()
+		return nil, err
+	}
+	sp, err := newShimProcess(log, r.StateDir, r.ID, r.Bundle, r.Runtime, r.Socket)
+	if err != nil {
+		// the errors of the runtime are in its log
+		if err != errRuntime {
+			writeMessage(log, "error", err)
+		}
+		log.Close()
+		s.done()
+		return nil, err
+	}
+	go func() {
+		if err := sp.run(); err != nil {
+			writeMessage(log, "error", err)
+		}
+		log.Close()
+		s.done()
+	}()
+	return &shimapi.CreateResponse{
+		Pid: uint32(sp.pid()),
+	}, nil
+}
+
+func (s *sandboxService) Shutdown(ctx context.Context, r *shimapi.ShutdownRequest) (*google_protobuf.Empty, error) {
+	s.mu.Lock()
+	if !s.closing {
+		s.closing = true
+		s.exitIfDone()
+	}
+	s.mu.Unlock()
+	return empty, nil
+}
+
+// done releases a process once it is done.
+func (s *sandboxService) done() {
+	s.mu.Lock()
+	s.processes--
+	if s.closing {
+		s.exitIfDone()
+	}
+	s.mu.Unlock()
+}
+
+// exitIfDone lets the shim exit once it is shut down and has no process left.
+func (s *sandboxService) exitIfDone() {
+	if s.processes == 0 {
+		close(s.shutdown)
+	}
+}
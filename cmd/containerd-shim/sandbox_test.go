@@ -0,0 +1,176 @@
+// +build !solaris
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	shimapi "github.com/docker/containerd/api/shim"
+	"github.com/docker/containerd/reaper"
+	"github.com/docker/containerd/sys"
+	"github.com/docker/containerd/ttrpc"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// fakeRuntime creates the process of a container as a sleep in the
+// background, the other commands doing nothing.
+const fakeRuntime = `#!/bin/sh
+while [ $# -gt 0 ]; do
+	case "$1" in
+	--pid-file) pidfile=$2; shift ;;
+	create) create=1 ;;
+	esac
+	shift
+done
+if [ -n "$create" ]; then
+	sleep 60 &
+	echo -n $! > $pidfile
+fi
+`
+
+// newSandboxProcess creates the state directory of a process in dir, with
+// the fifos of the shim and the stdio fifos held open for the shim not to
+// block, returning the read side of the exit fifo and a func closing them.
+func newSandboxProcess(t *testing.T, dir string) (root string, exit *os.File, cleanup func()) {
+	root = filepath.Join(dir, "state")
+	if err := os.MkdirAll(root, 0700); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"exit", "control", "stdin", "stdout", "stderr"} {
+		if err := syscall.Mkfifo(filepath.Join(root, name), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var files []*os.File
+	for _, name := range []string{"stdin", "stdout", "stderr"} {
+		f, err := os.OpenFile(filepath.Join(root, name), os.O_RDWR, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	data, err := json.Marshal(processState{
+		Stdin:  filepath.Join(root, "stdin"),
+		Stdout: filepath.Join(root, "stdout"),
+		Stderr: filepath.Join(root, "stderr"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "process.json"), data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if exit, err = os.OpenFile(filepath.Join(root, "exit"), syscall.O_RDONLY|syscall.O_NONBLOCK, 0); err != nil {
+		t.Fatal(err)
+	}
+	files = append(files, exit)
+	return root, exit, func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+}
+
+// waitExited waits for the shim to close the exit fifo read by exit.
+func waitExited(t *testing.T, exit *os.File) {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		// the read returns EOF once the writer is gone, EAGAIN before
+		if n, err := syscall.Read(int(exit.Fd()), make([]byte, 1)); n == 0 && err == nil {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("timeout waiting for the exit fifo to be closed")
+}
+
+func TestSandboxShim(t *testing.T) {
+	if err := sys.SetSubreaper(1); err != nil {
+		t.Skipf("the test reaps the processes of the sandbox: %v", err)
+	}
+	defer sys.SetSubreaper(0)
+	signals := make(chan os.Signal, 32)
+	signal.Notify(signals, syscall.SIGCHLD)
+	defer signal.Stop(signals)
+	go func() {
+		for range signals {
+			reaper.Reap()
+		}
+	}()
+
+	dir, err := ioutil.TempDir("", "shim-sandbox-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	runtime := filepath.Join(dir, "runtime")
+	if err := ioutil.WriteFile(runtime, []byte(fakeRuntime), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &sandboxService{
+		shutdown: make(chan struct{}),
+	}
+	ctx := context.Background()
+	var (
+		pids  []int
+		roots []string
+		exits []*os.File
+	)
+	for _, id := range []string{"a", "b"} {
+		root, exit, cleanup := newSandboxProcess(t, filepath.Join(dir, id))
+		defer cleanup()
+		resp, err := s.Create(ctx, &shimapi.CreateRequest{
+			StateDir: root,
+			ID:       id,
+			Bundle:   dir,
+			Runtime:  runtime,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer syscall.Kill(int(resp.Pid), syscall.SIGKILL)
+		pids = append(pids, int(resp.Pid))
+		roots = append(roots, root)
+		exits = append(exits, exit)
+	}
+
+	// the shim is shut down once its processes are done
+	if _, err := s.Shutdown(ctx, &shimapi.ShutdownRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Create(ctx, &shimapi.CreateRequest{}); ttrpc.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected no process to be created once the shim is shut down, got %v", err)
+	}
+	for i, pid := range pids {
+		select {
+		case <-s.shutdown:
+			t.Fatalf("expected the shim to run until its last process is done, %d left", len(pids)-i)
+		default:
+		}
+		if err := syscall.Kill(pid, syscall.SIGKILL); err != nil {
+			t.Fatal(err)
+		}
+		waitExited(t, exits[i])
+		status, err := ioutil.ReadFile(filepath.Join(roots[i], "exitStatus"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(status) != "137" {
+			t.Fatalf("expected the exit status of the killed process, got %s", status)
+		}
+	}
+	select {
+	case <-s.shutdown:
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the shim to be shut down once its last process is done")
+	}
+}
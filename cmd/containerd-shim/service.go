@@ -0,0 +1,118 @@
+package main
+
+import (
+	"os/exec"
+	"strconv"
+	"syscall"
+
+	shimapi "github.com/docker/containerd/api/shim"
+	"github.com/docker/containerd/reaper"
+	"github.com/docker/containerd/ttrpc"
+	"github.com/docker/docker/pkg/term"
+	google_protobuf "github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+var empty = &google_protobuf.Empty{}
+
+// service implements the shim api for the process managed by this shim.
+type service struct {
+	p          *process
+	exited     chan struct{}
+	exitStatus int
+}
+
+func newService(p *process) *service {
+	return &service{
+		p:      p,
+		exited: make(chan struct{}),
+	}
+}
+
+// exit records the exit status of the process, releasing any waiters.
+func (s *service) exit(status int) {
+	s.exitStatus = status
+	close(s.exited)
+}
+
+func (s *service) State(ctx context.Context, r *shimapi.StateRequest) (*shimapi.StateResponse, error) {
+	resp := &shimapi.StateResponse{
+		ID:  s.p.id,
+		Pid: uint32(s.p.pid()),
+	}
+	select {
+	case <-s.exited:
+		resp.Exited = true
+		resp.ExitStatus = uint32(s.exitStatus)
+	default:
+	}
+	return resp, nil
+}
+
+func (s *service) Start(ctx context.Context, r *shimapi.StartRequest) (*google_protobuf.Empty, error) {
+	if s.p.state.Exec {
+		return nil, ttrpc.Errorf(codes.FailedPrecondition, "exec processes are started on creation")
+	}
+	cmd := exec.Command(s.p.runtime, append(s.p.runtimeArgs(), "start", s.p.id)...)
+	cmd.SysProcAttr = setPDeathSig()
+	if out, err := reaper.Default.CombinedOutput(cmd); err != nil {
+		return nil, ttrpc.Errorf(codes.Unknown, "%s start failed: %s: %v", s.p.runtime, out, err)
+	}
+	return empty, nil
+}
+
+func (s *service) Signal(ctx context.Context, r *shimapi.SignalRequest) (*google_protobuf.Empty, error) {
+	if r.All {
+		if s.p.state.Exec {
+			return nil, ttrpc.Errorf(codes.FailedPrecondition, "signaling all processes requires the init process")
+		}
+		cmd := exec.Command(s.p.runtime, append(s.p.runtimeArgs(), "kill", "--all", s.p.id, strconv.Itoa(int(r.Signal)))...)
+		cmd.SysProcAttr = setPDeathSig()
+		if out, err := reaper.Default.CombinedOutput(cmd); err != nil {
+			return nil, ttrpc.Errorf(codes.Unknown, "%s kill failed: %s: %v", s.p.runtime, out, err)
+		}
+		return empty, nil
+	}
+	if err := syscall.Kill(s.p.pid(), syscall.Signal(r.Signal)); err != nil {
+		if err == syscall.ESRCH {
+			return nil, ttrpc.Errorf(codes.NotFound, "process %d has exited", s.p.pid())
+		}
+		return nil, err
+	}
+	return empty, nil
+}
+
+func (s *service) Wait(ctx context.Context, r *shimapi.WaitRequest) (*shimapi.WaitResponse, error) {
+	select {
+	case <-s.exited:
+		return &shimapi.WaitResponse{
+			ExitStatus: uint32(s.exitStatus),
+		}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *service) Pty(ctx context.Context, r *shimapi.PtyRequest) (*google_protobuf.Empty, error) {
+	if s.p.console == nil {
+		return nil, ttrpc.Errorf(codes.FailedPrecondition, "process does not have a console")
+	}
+	ws := term.Winsize{
+		Width:  uint16(r.Width),
+		Height: uint16(r.Height),
+	}
+	if err := term.SetWinsize(s.p.console.Fd(), &ws); err != nil {
+		return nil, err
+	}
+	return empty, nil
+}
+
+func (s *service) CloseStdin(ctx context.Context, r *shimapi.CloseStdinRequest) (*google_protobuf.Empty, error) {
+	if s.p.stdinCloser != nil {
+		if err := s.p.stdinCloser.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return empty, nil
+}
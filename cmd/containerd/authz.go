@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+
+	gocontext "golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	leasesapi "github.com/docker/containerd/api/leases"
+	"github.com/docker/containerd/authz"
+	"github.com/docker/containerd/identity"
+	"github.com/docker/containerd/log"
+	"github.com/sirupsen/logrus"
+)
+
+// readOnlyMethods are the methods that leave the daemon unchanged, they are
+// not submitted to the authorizer. Any other method, including those added
+// later, is.
+var readOnlyMethods = map[string]bool{
+	"/containerd.v1.debug.DebugService/LogLevels":            true,
+	"/containerd.v1.debug.DebugService/Leaks":                true,
+	"/containerd.v1.debug.DebugService/Events":               true,
+	"/containerd.v1.debug.DebugService/DumpState":            true,
+	"/containerd.v1.ExecutionService/Get":                    true,
+	"/containerd.v1.ExecutionService/Inspect":                true,
+	"/containerd.v1.ExecutionService/List":                   true,
+	"/containerd.v1.ExecutionService/ListStream":             true,
+	"/containerd.v1.ExecutionService/Stats":                  true,
+	"/containerd.v1.ExecutionService/GetProcess":             true,
+	"/containerd.v1.ExecutionService/ListProcesses":          true,
+	"/containerd.v1.ExecutionService/ListProcessesStream":    true,
+	"/containerd.v1.ExecutionService/GetRuntimeLogs":         true,
+	"/containerd.v1.ExecutionService/ListRuntimes":           true,
+	"/containerd.v1.ExecutionService/SandboxStats":           true,
+	"/containerd.v1.ExecutionService/GetSandbox":             true,
+	"/containerd.v1.ExecutionService/ListSandboxes":          true,
+	"/containerd.v1.ExecutionService/ListNetworkNamespaces":  true,
+	"/containerd.v1.ExecutionService/ListPorts":              true,
+	"/containerd.v1.gc.GCService/Report":                     true,
+	"/containerd.v1.images.ImageService/Get":                 true,
+	"/containerd.v1.images.ImageService/List":                true,
+	"/containerd.v1.images.ImageService/Inspect":             true,
+	"/containerd.v1.introspection.IntrospectionService/Info": true,
+	"/containerd.v1.leases.LeaseService/List":                true,
+}
+
+// allNamespaces reports whether the read only request req reaches beyond
+// the namespace of the request, it is submitted to the authorizer
+// regardless.
+func allNamespaces(req interface{}) bool {
+	r, ok := req.(*leasesapi.ListLeasesRequest)
+	return ok && r.AllNamespaces
+}
+
+// authorize submits the request to the authorizer of its listener unless the
+// method is read only, within the namespace of the request. Requests are
+// denied when the authorizer fails to decide, and the requests of read only
+// listeners changing the daemon are denied.
+func (i *interceptor) authorize(ctx gocontext.Context, method string, req interface{}) error {
+	authorizer := i.authorizer
+	if policy, ok := listenerPolicyFrom(ctx); ok {
+		if policy.readOnly && !readOnlyMethods[method] {
+			return grpc.Errorf(codes.PermissionDenied, "%s is not allowed on a read only listener", method)
+		}
+		authorizer = policy.authorizer
+	}
+	if authorizer == nil || (readOnlyMethods[method] && !allNamespaces(req)) {
+		return nil
+	}
+	r := &authz.Request{
+		Method: method,
+	}
+	if p, ok := identity.PeerFromContext(ctx); ok {
+		r.Peer = &p
+	}
+	if req != nil {
+		body, err := json.Marshal(req)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "failed to encode request for authorization: %v", err)
+		}
+		r.Body = body
+	}
+	resp, err := authorizer.Authorize(ctx, r)
+	if err != nil {
+		log.G(ctx).WithError(err).Error("authorization failed")
+		return grpc.Errorf(codes.Unavailable, "authorization failed: %v", err)
+	}
+	if !resp.Allow {
+		log.G(ctx).WithFields(logrus.Fields{
+			"method": method,
+			"reason": resp.Reason,
+		}).Warn("request denied by authorizer")
+		return grpc.Errorf(codes.PermissionDenied, "request denied by authorizer: %s", resp.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,595 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/docker/containerd/authz"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/gc"
+	"github.com/docker/containerd/identity"
+	"github.com/docker/containerd/layout"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/remotes"
+	"github.com/docker/containerd/secrets"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+)
+
+// config is the daemon configuration read from the TOML --config file. The
+// flags set on the command line override it, and the settings it leaves
+// unset default to those of the flags.
+type config struct {
+	// Root is the directory of the state persisted across reboots: the
+	// content, images, leases and snapshots.
+	Root string `toml:"root"`
+	// State is the directory of the state of the running containers: the
+	// state of the runtimes, sandboxes and network namespaces. It may be
+	// the root.
+	State string `toml:"state"`
+	// Runtime is the executor of the containers, "shim" or "runc", or "hcs"
+	// on Windows.
+	Runtime string `toml:"runtime"`
+	// Snapshotter is the driver of the snapshots kept under the root, only
+	// "overlay" is supported.
+	Snapshotter string `toml:"snapshotter"`
+	// MetricsAddress is the tcp address prometheus metrics are served on.
+	MetricsAddress string `toml:"metricsAddress"`
+	// EventsAddress is the nats address events are served on.
+	EventsAddress string `toml:"eventsAddress"`
+	// Debug configures the logs and the tracing of requests.
+	Debug debugConfig `toml:"debug"`
+	// Registries configures TLS and proxy settings per registry host.
+	Registries map[string]remotes.HostConfig `toml:"registries"`
+	// Runtimes registers additional OCI runtimes, such as sandboxed
+	// runtimes, which containers can select by name.
+	Runtimes map[string]runtimeConfig `toml:"runtimes"`
+	// ShimHealth configures the health checking of the shims, defaulting
+	// to shim.DefaultHealthCheck.
+	ShimHealth shimHealthConfig `toml:"shimHealth"`
+	// StatsInterval is the interval the cgroups of the containers are
+	// sampled on, such as "10s". "0s" disables the sampling, reading the
+	// cgroups on each request instead.
+	StatsInterval string `toml:"statsInterval"`
+	// ShutdownTimeout is how long the requests in flight are waited for
+	// when the daemon is stopped, such as "30s". The requests left are
+	// canceled once it expires.
+	ShutdownTimeout string `toml:"shutdownTimeout"`
+	// LogLevels sets the log level of modules, such as
+	// "containerd/execution/shim" = "debug". The levels are reloaded when
+	// the daemon receives SIGHUP or SIGUSR1.
+	LogLevels map[string]string `toml:"logLevels"`
+	// UserNamespace runs the containers in a user namespace with the
+	// mappings, unless they set their own or their runtime configures
+	// another one. It requires the shim runtime.
+	UserNamespace *execution.UserNamespace `toml:"userNamespace"`
+	// Listeners serve the GRPC API on TCP addresses and unix sockets, in
+	// addition to the socket of the daemon, each with its own auth
+	// settings.
+	Listeners []listenerConfig `toml:"listeners"`
+	// GRPC sets the limits of the GRPC server.
+	GRPC grpcConfig `toml:"grpc"`
+	// Admission restricts the specs containers may be created with, unless
+	// their runtime configures its own policy.
+	Admission *execution.AdmissionPolicy `toml:"admission"`
+	// Socket configures the unix socket and restricts its callers.
+	Socket socketConfig `toml:"socket"`
+	// Authorization submits the requests changing the daemon to an
+	// external authorizer.
+	Authorization authzConfig `toml:"authorization"`
+	// CommitInterval is how long the changes to the images and leases wait
+	// for others to be written with them, such as "10ms". The stores are
+	// synced at most once per interval when they change in bursts, each
+	// change taking up to the interval longer. "0s", the default, only
+	// groups the changes made while the stores are being written.
+	CommitInterval string `toml:"commitInterval"`
+	// Plugins configures the optional components of the daemon, each in a
+	// section of its own such as [plugins.gc].
+	Plugins pluginsConfig `toml:"plugins"`
+}
+
+type pluginsConfig struct {
+	// GC configures when the unused content and snapshots are collected,
+	// in addition to the collections requested by the clients.
+	GC gcConfig `toml:"gc"`
+	// CNI adds the containers with a network namespace of their own to a
+	// CNI network.
+	CNI *cniConfig `toml:"cni"`
+	// Secrets is the backend of the secrets mounted into containers.
+	Secrets secretsConfig `toml:"secrets"`
+	// RegistryCache serves a pull-through cache of a registry.
+	RegistryCache registryCacheConfig `toml:"registry-cache"`
+}
+
+type gcConfig struct {
+	// DeletionThreshold is the number of deletions of containers, images,
+	// leases and resources of leases after which a collection runs.
+	DeletionThreshold int `toml:"deletionThreshold"`
+	// Windows are the times of the day, such as "01:00-05:00" in local
+	// time, the collections after deletions are deferred to.
+	Windows []string `toml:"windows"`
+	// Idle is how long no container must have started and no reference
+	// must have been deleted, such as "10m", for the collections after
+	// deletions to run.
+	Idle string `toml:"idle"`
+	// HighWatermark is the percentage of the disk of the root used at
+	// which a collection runs, whatever the windows. The next one runs once
+	// the usage fell below LowWatermark and reached the high watermark
+	// again.
+	HighWatermark float64 `toml:"highWatermark"`
+	LowWatermark  float64 `toml:"lowWatermark"`
+	// CheckInterval is the interval the disk usage and the windows are
+	// checked on, such as "1m", defaulting to gc.DefaultCheckInterval.
+	CheckInterval string `toml:"checkInterval"`
+}
+
+// policy returns the policy of the scheduled collections.
+func (c gcConfig) policy() (gc.Policy, error) {
+	p := gc.Policy{
+		DeletionThreshold: c.DeletionThreshold,
+		HighWatermark:     c.HighWatermark,
+		LowWatermark:      c.LowWatermark,
+	}
+	if c.DeletionThreshold < 0 {
+		return p, errors.New("invalid gc deletion threshold")
+	}
+	if c.HighWatermark < 0 || c.HighWatermark > 100 || c.LowWatermark < 0 || c.LowWatermark > c.HighWatermark {
+		return p, errors.New("invalid gc watermarks, expected 0 <= lowWatermark <= highWatermark <= 100")
+	}
+	for _, v := range c.Windows {
+		w, err := gc.ParseWindow(v)
+		if err != nil {
+			return p, err
+		}
+		p.Windows = append(p.Windows, w)
+	}
+	if c.Idle != "" {
+		d, err := time.ParseDuration(c.Idle)
+		if err != nil || d <= 0 {
+			return p, errors.Errorf("invalid gc idle time %q", c.Idle)
+		}
+		p.Idle = d
+	}
+	if c.CheckInterval != "" {
+		d, err := time.ParseDuration(c.CheckInterval)
+		if err != nil || d <= 0 {
+			return p, errors.Errorf("invalid gc check interval %q", c.CheckInterval)
+		}
+		p.CheckInterval = d
+	}
+	return p, nil
+}
+
+type cniConfig struct {
+	// ConfDir holds the network configuration, the first file in lexical
+	// order is used.
+	ConfDir string `toml:"confDir"`
+	// BinDirs are searched for the plugins, defaulting to cni.DefaultBinDir.
+	BinDirs []string `toml:"binDirs"`
+}
+
+type secretsConfig struct {
+	// Dir holds a file per secret, see secrets.NewDirBackend.
+	Dir string `toml:"dir"`
+	// Plugin is the path of a binary returning secrets by name, see
+	// secrets.NewExecBackend.
+	Plugin string `toml:"plugin"`
+	// Timeout bounds the time the plugin takes to return a secret,
+	// defaulting to secrets.DefaultTimeout.
+	Timeout string `toml:"timeout"`
+}
+
+// backend returns the configured secret backend, nil when none is.
+func (c secretsConfig) backend() (secrets.Backend, error) {
+	timeout := secrets.DefaultTimeout
+	if c.Timeout != "" {
+		d, err := time.ParseDuration(c.Timeout)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid secrets timeout")
+		}
+		timeout = d
+	}
+	switch {
+	case c.Dir != "" && c.Plugin != "":
+		return nil, errors.New("secrets dir and plugin are mutually exclusive")
+	case c.Dir != "":
+		return secrets.NewDirBackend(c.Dir), nil
+	case c.Plugin != "":
+		return secrets.NewExecBackend(c.Plugin, timeout), nil
+	}
+	return nil, nil
+}
+
+type authzConfig struct {
+	// Plugin is the path of a binary run for each request, see
+	// authz.NewExecAuthorizer.
+	Plugin string `toml:"plugin"`
+	// Socket is the path of the unix socket of an authorization service,
+	// see authz.NewSocketAuthorizer.
+	Socket string `toml:"socket"`
+	// Timeout bounds the time the authorizer takes to decide, defaulting
+	// to authz.DefaultTimeout.
+	Timeout string `toml:"timeout"`
+}
+
+// authorizer returns the configured authorizer, nil when requests are not
+// authorized.
+func (c authzConfig) authorizer() (authz.Authorizer, error) {
+	timeout := authz.DefaultTimeout
+	if c.Timeout != "" {
+		d, err := time.ParseDuration(c.Timeout)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid authorization timeout")
+		}
+		timeout = d
+	}
+	switch {
+	case c.Plugin != "" && c.Socket != "":
+		return nil, errors.New("authorization plugin and socket are mutually exclusive")
+	case c.Plugin != "":
+		return authz.NewExecAuthorizer(c.Plugin, timeout), nil
+	case c.Socket != "":
+		return authz.NewSocketAuthorizer(c.Socket, timeout), nil
+	}
+	return nil, nil
+}
+
+type socketConfig struct {
+	// Path of the unix socket the GRPC API is served on, unless systemd
+	// activates the daemon with its own sockets.
+	Path string `toml:"path"`
+	// AllowedUIDs and AllowedGIDs are the users and primary groups allowed
+	// to connect to the socket, in addition to root. Any caller the
+	// permissions of the socket allow may connect when both are empty.
+	AllowedUIDs []uint32 `toml:"allowedUIDs"`
+	AllowedGIDs []uint32 `toml:"allowedGIDs"`
+}
+
+// allowed returns whether the peer may connect to the socket.
+func (c socketConfig) allowed(p identity.Peer) bool {
+	if p.UID == 0 || len(c.AllowedUIDs) == 0 && len(c.AllowedGIDs) == 0 {
+		return true
+	}
+	for _, uid := range c.AllowedUIDs {
+		if p.UID == uid {
+			return true
+		}
+	}
+	for _, gid := range c.AllowedGIDs {
+		if p.GID == gid {
+			return true
+		}
+	}
+	return false
+}
+
+type debugConfig struct {
+	// Level is the log level of the modules without a level of their own,
+	// "info" by default.
+	Level string `toml:"level"`
+	// LogRequests logs the method, duration and result of each GRPC
+	// request at debug level.
+	LogRequests bool `toml:"logRequests"`
+	// Trace records the spans of all requests, not only of those sampled
+	// by the caller.
+	Trace bool `toml:"trace"`
+}
+
+type registryCacheConfig struct {
+	// Address is the tcp address the cache is served on, it is disabled
+	// when empty.
+	Address string `toml:"address"`
+	// Upstream is the registry host cached.
+	Upstream string `toml:"upstream"`
+}
+
+type listenerConfig struct {
+	// Address is the TCP address to listen on, such as "0.0.0.0:7443", or
+	// the path of a unix socket prefixed with "unix://".
+	Address string `toml:"address"`
+	// TLS serves the connections of a TCP address over TLS. It is required
+	// unless the address is a loopback one, whose plaintext requests are
+	// read only unless the listener is authorized.
+	TLS tlsConfig `toml:"tls"`
+	// AllowedUIDs and AllowedGIDs restrict the callers of a unix socket as
+	// they do for the socket of the daemon.
+	AllowedUIDs []uint32 `toml:"allowedUIDs"`
+	AllowedGIDs []uint32 `toml:"allowedGIDs"`
+	// Authorization replaces the authorization of the daemon for the
+	// requests of the listener when set, an empty one leaving them
+	// unauthorized.
+	Authorization *authzConfig `toml:"authorization"`
+	// ReadOnly rejects the requests changing the daemon.
+	ReadOnly bool `toml:"readOnly"`
+	// Insecure allows a TCP listener without client CA, whose callers are
+	// not authenticated.
+	Insecure bool `toml:"insecure"`
+}
+
+type grpcConfig struct {
+	// MaxRecvMessageSize is the size in bytes of the largest request
+	// accepted, 4MiB when zero.
+	MaxRecvMessageSize int `toml:"maxRecvMessageSize"`
+	// MaxSendMessageSize is the size in bytes of the largest response
+	// sent, unlimited when zero.
+	MaxSendMessageSize int `toml:"maxSendMessageSize"`
+	// MaxConcurrentStreams limits the requests served concurrently on each
+	// connection, unlimited when zero.
+	MaxConcurrentStreams uint32 `toml:"maxConcurrentStreams"`
+	// Keepalive is the interval of the TCP keepalive probes of the
+	// connections of the listeners, such as "1m", for the idle event
+	// streams of clients that went away to be closed. "0" disables the
+	// probes, and the default of the system applies when empty.
+	Keepalive string `toml:"keepalive"`
+	// Timeouts are the deadlines of the requests by method, such as
+	// "/containerd.v1.ExecutionService/List" = "30s". An earlier deadline
+	// of the caller still applies.
+	Timeouts map[string]string `toml:"timeouts"`
+	// Compression is the compression of the responses, "gzip" or none when
+	// empty. The clients must then accept gzip. Compressed requests are
+	// accepted either way.
+	Compression string `toml:"compression"`
+}
+
+// serverOptions returns the options of the GRPC server enforcing the
+// limits on the messages received and the streams, and compressing the
+// responses.
+func (c grpcConfig) serverOptions() ([]grpc.ServerOption, error) {
+	if c.MaxRecvMessageSize < 0 || c.MaxSendMessageSize < 0 {
+		return nil, errors.New("invalid grpc message size")
+	}
+	var opts []grpc.ServerOption
+	if c.MaxRecvMessageSize > 0 {
+		opts = append(opts, grpc.MaxMsgSize(c.MaxRecvMessageSize))
+	}
+	if c.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(c.MaxConcurrentStreams))
+	}
+	switch c.Compression {
+	case "":
+	case "gzip":
+		opts = append(opts, grpc.RPCCompressor(grpc.NewGZIPCompressor()))
+	default:
+		return nil, errors.Errorf("unsupported grpc compression %q", c.Compression)
+	}
+	opts = append(opts, grpc.RPCDecompressor(grpc.NewGZIPDecompressor()))
+	return opts, nil
+}
+
+// keepalive returns the interval of the TCP keepalive probes, zero when
+// they are disabled and negative when the default of the system applies.
+func (c grpcConfig) keepalive() (time.Duration, error) {
+	if c.Keepalive == "" {
+		return -1, nil
+	}
+	d, err := time.ParseDuration(c.Keepalive)
+	if err != nil || d < 0 {
+		return 0, errors.Errorf("invalid grpc keepalive %q", c.Keepalive)
+	}
+	return d, nil
+}
+
+// timeouts returns the deadlines of the requests by method.
+func (c grpcConfig) timeouts() (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration)
+	for method, v := range c.Timeouts {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			return nil, errors.Errorf("invalid grpc method %q, expected /package.Service/Method", method)
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, errors.Errorf("invalid grpc timeout %q of %s", v, method)
+		}
+		timeouts[method] = d
+	}
+	return timeouts, nil
+}
+
+type tlsConfig struct {
+	// Cert and Key are the paths of the server certificate and its key.
+	// They are loaded again when the files change.
+	Cert string `toml:"cert"`
+	Key  string `toml:"key"`
+	// ClientCA is the path of the CA bundle client certificates are
+	// verified against. Clients must present a certificate when set.
+	ClientCA string `toml:"clientCA"`
+}
+
+// enabled returns whether any of the TLS settings is set.
+func (c tlsConfig) enabled() bool {
+	return c.Cert != "" || c.Key != "" || c.ClientCA != ""
+}
+
+// moduleLevels returns the configured log levels of the modules.
+func (c *config) moduleLevels() (log.ModuleLevels, error) {
+	levels := log.ModuleLevels{}
+	for m, l := range c.LogLevels {
+		level, err := logrus.ParseLevel(l)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid log level of module %s", m)
+		}
+		levels[m] = level
+	}
+	return levels, nil
+}
+
+// statsInterval returns the configured stats interval, defaulting to
+// execution.DefaultStatsInterval.
+func (c *config) statsInterval() (time.Duration, error) {
+	if c.StatsInterval == "" {
+		return execution.DefaultStatsInterval, nil
+	}
+	d, err := time.ParseDuration(c.StatsInterval)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid stats interval")
+	}
+	return d, nil
+}
+
+// commitInterval returns the configured commit interval of the stores.
+func (c *config) commitInterval() (time.Duration, error) {
+	if c.CommitInterval == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(c.CommitInterval)
+	if err != nil || d < 0 {
+		return 0, errors.Errorf("invalid commit interval %q", c.CommitInterval)
+	}
+	return d, nil
+}
+
+// defaultShutdownTimeout is the time the requests in flight are waited for
+// when the daemon is stopped, unless configured otherwise.
+const defaultShutdownTimeout = 30 * time.Second
+
+// shutdownTimeout returns the configured shutdown timeout, defaulting to
+// defaultShutdownTimeout.
+func (c *config) shutdownTimeout() (time.Duration, error) {
+	if c.ShutdownTimeout == "" {
+		return defaultShutdownTimeout, nil
+	}
+	d, err := time.ParseDuration(c.ShutdownTimeout)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid shutdown timeout")
+	}
+	return d, nil
+}
+
+type shimHealthConfig struct {
+	// Interval between checks, such as "10s". "0s" disables the checks.
+	Interval string `toml:"interval"`
+	// Timeout of a single check.
+	Timeout string `toml:"timeout"`
+	// Policy is what happens to the processes of a failed shim, "fence"
+	// kills them and "keep" leaves them running.
+	Policy string `toml:"policy"`
+}
+
+type runtimeConfig struct {
+	// Path is the runtime binary, for example runsc or kata-runtime.
+	Path string `toml:"path"`
+	// Args are passed to the runtime before every command.
+	Args []string `toml:"args"`
+	// Unsupported lists the operations the runtime cannot perform, any of
+	// "pause", "exec" and "stats".
+	Unsupported []string `toml:"unsupported"`
+	// SpecDefaults, such as annotations and masked paths, are applied to
+	// the spec of each container created with the runtime.
+	execution.SpecDefaults
+	// Admission replaces the admission policy of the daemon for the
+	// containers of the runtime.
+	Admission *execution.AdmissionPolicy `toml:"admission"`
+}
+
+func (rc runtimeConfig) capabilities() (execution.Capabilities, error) {
+	c := execution.FullCapabilities
+	for _, op := range rc.Unsupported {
+		switch op {
+		case "pause":
+			c.Pause = false
+		case "exec":
+			c.Exec = false
+		case "stats":
+			c.Stats = false
+		default:
+			return c, errors.Errorf("unknown operation %q", op)
+		}
+	}
+	return c, nil
+}
+
+// layout returns the directories of the state of the daemon.
+func (c *config) layout() layout.Layout {
+	return layout.Layout{Root: c.Root, State: c.State}
+}
+
+// applyFlags overrides the configuration with the flags set on the command
+// line, and sets the settings left unset to the defaults of the flags.
+func (c *config) applyFlags(context *cli.Context) error {
+	for _, s := range []struct {
+		flag string
+		dst  *string
+	}{
+		{"root", &c.Root},
+		{"state", &c.State},
+		{"runtime", &c.Runtime},
+		{"socket", &c.Socket.Path},
+		{"metrics-address", &c.MetricsAddress},
+		{"events-address", &c.EventsAddress},
+		{"registry-cache-address", &c.Plugins.RegistryCache.Address},
+		{"registry-cache-upstream", &c.Plugins.RegistryCache.Upstream},
+	} {
+		if *s.dst == "" || context.GlobalIsSet(s.flag) {
+			*s.dst = context.GlobalString(s.flag)
+		}
+	}
+	if context.GlobalIsSet("log-levels") {
+		modules, err := log.ParseModuleLevels(context.GlobalString("log-levels"))
+		if err != nil {
+			return errors.Wrap(err, "invalid log levels")
+		}
+		if c.LogLevels == nil {
+			c.LogLevels = make(map[string]string)
+		}
+		for m, l := range modules {
+			c.LogLevels[m] = l.String()
+		}
+	}
+	if context.GlobalIsSet("log-requests") {
+		c.Debug.LogRequests = context.GlobalBool("log-requests")
+	}
+	if context.GlobalIsSet("trace") {
+		c.Debug.Trace = context.GlobalBool("trace")
+	}
+	if context.GlobalBool("debug") {
+		c.Debug.Level = "debug"
+	}
+	if c.Debug.Level == "" {
+		c.Debug.Level = "info"
+	}
+	if _, err := logrus.ParseLevel(c.Debug.Level); err != nil {
+		return errors.Wrap(err, "invalid debug level")
+	}
+	if c.Snapshotter == "" {
+		c.Snapshotter = "overlay"
+	}
+	if c.Snapshotter != "overlay" {
+		return errors.Errorf("unsupported snapshotter %q", c.Snapshotter)
+	}
+	if c.Root == "" {
+		return errors.New("the root directory cannot be empty")
+	}
+	if c.State == "" {
+		return errors.New("the state directory cannot be empty")
+	}
+	if c.Socket.Path == "" {
+		return errors.New("the socket path cannot be empty")
+	}
+	return nil
+}
+
+// loadConfig reads the TOML configuration at path. A missing file results
+// in the default configuration, and the settings the daemon does not know,
+// such as the sections of unknown plugins, are rejected.
+func loadConfig(path string) (*config, error) {
+	c := &config{}
+	md, err := toml.DecodeFile(path, c)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, errors.Wrapf(err, "failed to decode config %s", path)
+	}
+	if keys := md.Undecoded(); len(keys) > 0 {
+		unknown := make([]string, len(keys))
+		for i, k := range keys {
+			unknown[i] = k.String()
+		}
+		return nil, errors.Errorf("unknown settings %s in config %s", strings.Join(unknown, ", "), path)
+	}
+	return c, nil
+}
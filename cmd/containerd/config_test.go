@@ -0,0 +1,203 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfig writes the configuration data to a temporary file, returning
+// its path and a function removing it.
+func writeConfig(t *testing.T, data string) (string, func()) {
+	dir, err := ioutil.TempDir("", "containerd-config-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "config.toml")
+	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return path, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path, cleanup := writeConfig(t, `
+root = "/var/lib/containerd"
+state = "/run/containerd"
+runtime = "shim"
+
+[debug]
+level = "debug"
+
+[logLevels]
+"containerd/execution/shim" = "warn"
+
+[registries."docker.io"]
+mirrors = ["https://mirror.example.com"]
+insecure_skip_verify = true
+
+[runtimes.runsc]
+path = "/usr/local/bin/runsc"
+unsupported = ["pause"]
+maskedPaths = ["/proc/kcore"]
+
+[[listeners]]
+address = "127.0.0.1:7443"
+readOnly = true
+
+[plugins.gc]
+deletionThreshold = 10
+windows = ["01:00-05:00"]
+idle = "10m"
+
+[plugins.cni]
+confDir = "/etc/cni/net.d"
+
+[plugins.registry-cache]
+address = "127.0.0.1:5000"
+upstream = "docker.io"
+`)
+	defer cleanup()
+
+	c, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Root != "/var/lib/containerd" || c.State != "/run/containerd" || c.Runtime != "shim" || c.Debug.Level != "debug" {
+		t.Fatalf("unexpected settings %+v", c)
+	}
+	if c.LogLevels["containerd/execution/shim"] != "warn" {
+		t.Fatalf("expected the level of the shim module to be set, got %v", c.LogLevels)
+	}
+	if h := c.Registries["docker.io"]; len(h.Mirrors) != 1 || !h.InsecureSkipVerify {
+		t.Fatalf("unexpected registry settings %+v", h)
+	}
+	if rc := c.Runtimes["runsc"]; rc.Path != "/usr/local/bin/runsc" || len(rc.Unsupported) != 1 || len(rc.MaskedPaths) != 1 {
+		t.Fatalf("unexpected runtime settings %+v", rc)
+	}
+	if len(c.Listeners) != 1 || c.Listeners[0].Address != "127.0.0.1:7443" || !c.Listeners[0].ReadOnly {
+		t.Fatalf("unexpected listeners %+v", c.Listeners)
+	}
+	if p := c.Plugins; p.GC.DeletionThreshold != 10 || len(p.GC.Windows) != 1 || p.GC.Idle != "10m" || p.CNI == nil || p.CNI.ConfDir != "/etc/cni/net.d" || p.RegistryCache.Upstream != "docker.io" {
+		t.Fatalf("unexpected plugin settings %+v", p)
+	}
+}
+
+func TestLoadConfigMissing(t *testing.T) {
+	c, err := loadConfig(filepath.Join(os.TempDir(), "containerd-missing", "config.toml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Plugins.CNI != nil || c.Root != "" {
+		t.Fatalf("expected the default configuration, got %+v", c)
+	}
+}
+
+func TestLoadConfigUnknown(t *testing.T) {
+	for _, data := range []string{
+		"roots = \"/var/lib/containerd\"\n",
+		"[plugins.btrfs]\nroot = \"/var/lib/btrfs\"\n",
+		"[plugins.gc]\nthreshold = 10\n",
+	} {
+		path, cleanup := writeConfig(t, data)
+		_, err := loadConfig(path)
+		cleanup()
+		if err == nil || !strings.Contains(err.Error(), "unknown settings") {
+			t.Errorf("expected the settings of %q to be rejected, got %v", data, err)
+		}
+	}
+}
+
+func TestGRPCServerOptions(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config string
+		// opts is the number of options, the decompressor always being
+		// set
+		opts  int
+		valid bool
+	}{
+		{"default", "", 1, true},
+		{"limits", "maxRecvMessageSize = 1024\nmaxSendMessageSize = 2048\nmaxConcurrentStreams = 10\n", 3, true},
+		{"gzip", "compression = \"gzip\"\n", 2, true},
+		{"unsupported compression", "compression = \"zstd\"\n", 0, false},
+		{"negative receive size", "maxRecvMessageSize = -1\n", 0, false},
+		{"negative send size", "maxSendMessageSize = -1\n", 0, false},
+	} {
+		path, cleanup := writeConfig(t, "[grpc]\n"+tc.config)
+		c, err := loadConfig(path)
+		cleanup()
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		opts, err := c.GRPC.serverOptions()
+		if (err == nil) != tc.valid {
+			t.Errorf("%s: expected the settings to be valid: %v, got %v", tc.name, tc.valid, err)
+			continue
+		}
+		if len(opts) != tc.opts {
+			t.Errorf("%s: expected %d options, got %d", tc.name, tc.opts, len(opts))
+		}
+	}
+}
+
+func TestGRPCKeepalive(t *testing.T) {
+	for _, tc := range []struct {
+		keepalive string
+		d         time.Duration
+		valid     bool
+	}{
+		// the default of the system applies
+		{"", -1, true},
+		{"0", 0, true},
+		{"1m", time.Minute, true},
+		{"-1m", 0, false},
+		{"often", 0, false},
+	} {
+		d, err := grpcConfig{Keepalive: tc.keepalive}.keepalive()
+		if (err == nil) != tc.valid {
+			t.Errorf("%q: expected the keepalive to be valid: %v, got %v", tc.keepalive, tc.valid, err)
+			continue
+		}
+		if d != tc.d {
+			t.Errorf("%q: expected an interval of %v, got %v", tc.keepalive, tc.d, d)
+		}
+	}
+}
+
+func TestGRPCTimeouts(t *testing.T) {
+	path, cleanup := writeConfig(t, `
+[grpc.timeouts]
+"/containerd.v1.ExecutionService/List" = "30s"
+"/containerd.v1.ContentService/Status" = "1m"
+`)
+	defer cleanup()
+	c, err := loadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timeouts, err := c.GRPC.timeouts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(timeouts) != 2 || timeouts["/containerd.v1.ExecutionService/List"] != 30*time.Second || timeouts["/containerd.v1.ContentService/Status"] != time.Minute {
+		t.Fatalf("unexpected timeouts %v", timeouts)
+	}
+
+	for method, v := range map[string]string{
+		"containerd.v1.ExecutionService/List":  "30s",
+		"/containerd.v1.ExecutionService":      "30s",
+		"/containerd.v1.ExecutionService/List": "0s",
+		"/containerd.v1.ContentService/Status": "soon",
+	} {
+		if _, err := (grpcConfig{Timeouts: map[string]string{method: v}}).timeouts(); err == nil {
+			t.Errorf("expected the timeout %q of %s to be invalid", v, method)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	gocontext "golang.org/x/net/context"
+
+	api "github.com/docker/containerd/api/debug"
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/events"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/namespaces"
+	google_protobuf "github.com/golang/protobuf/ptypes/empty"
+	"github.com/sirupsen/logrus"
+)
+
+// debugService implements the debug API of the daemon.
+type debugService struct {
+	execution *execution.Service
+	journal   *events.Journal
+	content   *content.ContentStore
+}
+
+var _ api.DebugServiceServer = &debugService{}
+
+func (s *debugService) LogLevels(ctx gocontext.Context, r *api.LogLevelsRequest) (*api.LogLevelsResponse, error) {
+	def, modules := log.Levels()
+	resp := &api.LogLevelsResponse{
+		Default: def.String(),
+	}
+	for _, m := range modules.Modules() {
+		resp.Modules = append(resp.Modules, &api.ModuleLevel{
+			Module: m,
+			Level:  modules[m].String(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *debugService) SetLogLevel(ctx gocontext.Context, r *api.SetLogLevelRequest) (*google_protobuf.Empty, error) {
+	if r.Level == "" {
+		if r.Module != "" {
+			log.ResetModuleLevel(r.Module)
+		}
+		return &google_protobuf.Empty{}, nil
+	}
+	level, err := logrus.ParseLevel(r.Level)
+	if err != nil {
+		return nil, err
+	}
+	log.SetModuleLevel(r.Module, level)
+	log.G(ctx).WithFields(logrus.Fields{"target": r.Module, "level": level}).Info("log level changed")
+	return &google_protobuf.Empty{}, nil
+}
+
+func (s *debugService) Leaks(ctx gocontext.Context, r *api.LeaksRequest) (*api.LeaksResponse, error) {
+	leaks, err := s.execution.Leaks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.LeaksResponse{}
+	for id, n := range leaks.Monitors {
+		_, deleted := leaks.LeakedMonitors[id]
+		resp.Monitors = append(resp.Monitors, &api.ContainerMonitors{
+			ContainerID: id,
+			Goroutines:  uint32(n),
+			Deleted:     deleted,
+		})
+	}
+	sort.Sort(monitorsByContainer(resp.Monitors))
+	for typ, n := range leaks.FDs {
+		resp.Fds = append(resp.Fds, &api.FDCount{
+			Type:  typ,
+			Count: uint32(n),
+		})
+	}
+	sort.Sort(fdsByType(resp.Fds))
+	for p, id := range leaks.LeakedFifos {
+		resp.LeakedFifos = append(resp.LeakedFifos, &api.LeakedFifo{
+			Path:        p,
+			ContainerID: id,
+		})
+	}
+	sort.Sort(fifosByPath(resp.LeakedFifos))
+	return resp, nil
+}
+
+func (s *debugService) DumpState(ctx gocontext.Context, r *api.DumpStateRequest) (*api.DumpStateResponse, error) {
+	containers, err := s.execution.DumpState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.DumpStateResponse{}
+	for _, c := range containers {
+		resp.Containers = append(resp.Containers, toGRPCContainerState(c))
+	}
+	ingests, err := s.content.Active()
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range ingests {
+		resp.Ingests = append(resp.Ingests, &api.Ingest{
+			Ref:     i.Ref,
+			Written: i.Size,
+		})
+	}
+	for _, sub := range s.journal.Subscribers() {
+		resp.Subscribers = append(resp.Subscribers, &api.Subscriber{
+			Filter:   sub.Filter,
+			Queued:   uint32(sub.Queued),
+			Capacity: uint32(sub.Capacity),
+		})
+	}
+	sort.Sort(subscribersByQueued(resp.Subscribers))
+	return resp, nil
+}
+
+func toGRPCContainerState(c execution.ContainerState) *api.ContainerState {
+	state := &api.ContainerState{
+		ID:        c.ID,
+		Namespace: c.Namespace,
+		Runtime:   c.Runtime,
+		Sandbox:   c.Sandbox,
+		Bundle:    c.Bundle,
+		Status:    string(c.Status),
+		Monitors:  uint32(c.Monitors),
+		Fifos:     c.Fifos,
+	}
+	for _, p := range c.Processes {
+		state.Processes = append(state.Processes, &api.ProcessState{
+			ID:     p.ID,
+			Pid:    p.Pid,
+			Status: string(p.Status),
+			Alive:  p.Alive,
+		})
+	}
+	for _, pid := range c.CgroupPids {
+		state.CgroupPids = append(state.CgroupPids, int64(pid))
+	}
+	if c.Err != nil {
+		state.Error = c.Err.Error()
+	}
+	return state
+}
+
+func (s *debugService) Events(r *api.EventsRequest, stream api.DebugService_EventsServer) error {
+	var since time.Time
+	if r.Since != 0 {
+		since = time.Unix(0, r.Since)
+	}
+	ns := namespaces.Namespace(stream.Context())
+	replay, sub := s.journal.Subscribe(r.Filter, since)
+	defer sub.Close()
+	for _, e := range replay {
+		if eventNamespace(e) != ns {
+			continue
+		}
+		if err := stream.Send(toGRPCEvent(e)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case e, ok := <-sub.Events():
+			if !ok {
+				return sub.Err()
+			}
+			if eventNamespace(e) != ns {
+				continue
+			}
+			if err := stream.Send(toGRPCEvent(e)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// eventNamespace returns the namespace of the resource of the event, the
+// events not naming one belonging to the default namespace.
+func eventNamespace(e events.JournalEntry) string {
+	var v struct {
+		Namespace string
+	}
+	if err := json.Unmarshal(e.Data, &v); err != nil || v.Namespace == "" {
+		return namespaces.Default
+	}
+	return v.Namespace
+}
+
+func toGRPCEvent(e events.JournalEntry) *api.Event {
+	return &api.Event{
+		Timestamp: e.Timestamp.UnixNano(),
+		Topic:     e.Topic,
+		Data:      e.Data,
+	}
+}
+
+type monitorsByContainer []*api.ContainerMonitors
+
+func (m monitorsByContainer) Len() int           { return len(m) }
+func (m monitorsByContainer) Less(i, j int) bool { return m[i].ContainerID < m[j].ContainerID }
+func (m monitorsByContainer) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+
+type fdsByType []*api.FDCount
+
+func (f fdsByType) Len() int           { return len(f) }
+func (f fdsByType) Less(i, j int) bool { return f[i].Type < f[j].Type }
+func (f fdsByType) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+type fifosByPath []*api.LeakedFifo
+
+func (f fifosByPath) Len() int           { return len(f) }
+func (f fifosByPath) Less(i, j int) bool { return f[i].Path < f[j].Path }
+func (f fifosByPath) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+// subscribersByQueued sorts the subscribers from the most events queued.
+type subscribersByQueued []*api.Subscriber
+
+func (s subscribersByQueued) Len() int           { return len(s) }
+func (s subscribersByQueued) Less(i, j int) bool { return s[i].Queued > s[j].Queued }
+func (s subscribersByQueued) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
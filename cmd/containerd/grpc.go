@@ -0,0 +1,303 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	gocontext "golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	checkpointapi "github.com/docker/containerd/api/checkpoint"
+	debugapi "github.com/docker/containerd/api/debug"
+	api "github.com/docker/containerd/api/execution"
+	gcapi "github.com/docker/containerd/api/gc"
+	imagesapi "github.com/docker/containerd/api/images"
+	introspectionapi "github.com/docker/containerd/api/introspection"
+	leasesapi "github.com/docker/containerd/api/leases"
+	migrationapi "github.com/docker/containerd/api/migration"
+	"github.com/docker/containerd/authz"
+	"github.com/docker/containerd/events"
+	"github.com/docker/containerd/gc"
+	"github.com/docker/containerd/identity"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/namespaces"
+	"github.com/docker/containerd/tracing"
+	"github.com/sirupsen/logrus"
+)
+
+// requestIDKey is the metadata key carrying the id of a request. Clients may
+// set it to correlate their logs with the daemon's, otherwise an id is
+// generated. The id is returned in the response header.
+const requestIDKey = "x-request-id"
+
+// interceptor populates the context of each GRPC request with the module
+// path, the event poster, the identity of the unix socket peer, the span of
+// the request and a logger tagged with the request and trace ids and the
+// peer's uid and pid, and records the method, latency and error code
+// of the request. Requests changing the daemon are submitted to the
+// authorizer, if any, unless the listener they were received on has auth
+// settings of its own. The requests of the methods with a timeout are
+// cancelled once it expires, and the responses larger than
+// maxSendMessageSize are rejected when it is positive. The collections of gc
+// are held off during the latency critical requests and told about the
+// deletions.
+type interceptor struct {
+	poster             events.Poster
+	authorizer         authz.Authorizer
+	timeouts           map[string]time.Duration
+	maxSendMessageSize int
+	gc                 *gc.Scheduler
+	// logRequests is set to 1 to log each request at debug level, it is
+	// accessed atomically for the configuration to be reloaded.
+	logRequests int32
+}
+
+func (i *interceptor) setLogRequests(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&i.logRequests, v)
+}
+
+func (i *interceptor) unary(ctx gocontext.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span, header := i.context(ctx, info.Server, info.FullMethod)
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		log.G(ctx).WithError(err).Warn("failed to set response header")
+	}
+	ctx, cancel := i.withTimeout(ctx, info.FullMethod)
+	defer cancel()
+	err := validateNamespace(ctx)
+	if err == nil {
+		err = i.authorize(ctx, info.FullMethod, req)
+	}
+	var resp interface{}
+	if err == nil {
+		release := i.holdGC(info.FullMethod)
+		resp, err = handler(ctx, req)
+		release()
+		if err == nil && gcDeletionMethods[info.FullMethod] && i.gc != nil {
+			i.gc.Deleted()
+		}
+	}
+	if err == nil {
+		if err = checkMessageSize(resp, i.maxSendMessageSize); err != nil {
+			resp = nil
+		}
+	}
+	i.done(ctx, span, info.FullMethod, err)
+	return resp, err
+}
+
+func (i *interceptor) stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span, header := i.context(ss.Context(), srv, info.FullMethod)
+	if err := ss.SetHeader(header); err != nil {
+		log.G(ctx).WithError(err).Warn("failed to set response header")
+	}
+	ctx, cancel := i.withTimeout(ctx, info.FullMethod)
+	defer cancel()
+	err := validateNamespace(ctx)
+	if err == nil {
+		err = i.authorize(ctx, info.FullMethod, nil)
+	}
+	if err == nil {
+		err = handler(srv, &serverStream{ServerStream: ss, ctx: ctx, maxSendMessageSize: i.maxSendMessageSize})
+	}
+	i.done(ctx, span, info.FullMethod, err)
+	return err
+}
+
+// gcHoldMethods are the latency critical methods the collections are held
+// off during.
+var gcHoldMethods = map[string]bool{
+	"/containerd.v1.ExecutionService/Create":              true,
+	"/containerd.v1.ExecutionService/Start":               true,
+	"/containerd.v1.ExecutionService/StartProcess":        true,
+	"/containerd.v1.checkpoint.CheckpointService/Restore": true,
+}
+
+// gcDeletionMethods are the methods deleting references to content and
+// snapshots.
+var gcDeletionMethods = map[string]bool{
+	"/containerd.v1.ExecutionService/Delete":            true,
+	"/containerd.v1.images.ImageService/Untag":          true,
+	"/containerd.v1.leases.LeaseService/Delete":         true,
+	"/containerd.v1.leases.LeaseService/DeleteResource": true,
+}
+
+// holdGC holds off the collections during the requests of method when it is
+// latency critical, returning the function releasing them.
+func (i *interceptor) holdGC(method string) func() {
+	if i.gc == nil || !gcHoldMethods[method] {
+		return func() {}
+	}
+	return i.gc.Hold()
+}
+
+// context returns the context of the request along with the span of the
+// request and the header returning its request id and trace context.
+func (i *interceptor) context(ctx gocontext.Context, server interface{}, method string) (gocontext.Context, *tracing.Span, metadata.MD) {
+	ctx = log.WithModule(ctx, "containerd")
+	switch server.(type) {
+	case api.ExecutionServiceServer:
+		ctx = log.WithModule(ctx, "execution")
+		ctx = events.WithPoster(ctx, i.poster)
+	case imagesapi.ImageServiceServer:
+		ctx = log.WithModule(ctx, "images")
+	case debugapi.DebugServiceServer:
+		ctx = log.WithModule(ctx, "debug")
+	case introspectionapi.IntrospectionServiceServer:
+		ctx = log.WithModule(ctx, "introspection")
+	case leasesapi.LeaseServiceServer:
+		ctx = log.WithModule(ctx, "leases")
+	case gcapi.GCServiceServer:
+		ctx = log.WithModule(ctx, "gc")
+		ctx = events.WithPoster(ctx, i.poster)
+	case checkpointapi.CheckpointServiceServer:
+		ctx = log.WithModule(ctx, "checkpoint")
+		ctx = events.WithPoster(ctx, i.poster)
+	case migrationapi.MigrationServiceServer:
+		// the containers are checkpointed and restored by the execution
+		// service, publishing their events
+		ctx = log.WithModule(ctx, "migration")
+		ctx = events.WithPoster(ctx, i.poster)
+	default:
+		fmt.Printf("Unknown type: %#v\n", server)
+	}
+	fields := logrus.Fields{}
+	if p, ok := peer.FromContext(ctx); ok {
+		addr := p.Addr
+		if a, ok := addr.(policyAddr); ok {
+			ctx = withListenerPolicy(ctx, a.policy)
+			addr = a.Addr
+		}
+		if a, ok := addr.(peerAddr); ok {
+			ctx = identity.WithPeer(ctx, a.peer)
+			fields["uid"] = a.peer.UID
+			fields["pid"] = a.peer.PID
+		}
+	}
+	md, _ := metadata.FromContext(ctx)
+	ns := namespaces.Default
+	if v := md[namespaces.GRPCHeader]; len(v) > 0 {
+		ns = v[0]
+	}
+	ctx = namespaces.WithNamespace(ctx, ns)
+	fields["namespace"] = ns
+	id := requestID(md)
+	if tp := md[tracing.TraceParentKey]; len(tp) > 0 {
+		if sc, err := tracing.ParseTraceParent(tp[0]); err == nil {
+			ctx = tracing.WithRemoteParent(ctx, sc)
+		} else {
+			log.G(ctx).WithError(err).Debug("ignoring trace context of request")
+		}
+	}
+	span, ctx := tracing.StartSpan(ctx, "grpc "+method)
+	span.SetTag("request", id)
+	fields["request"] = id
+	fields["trace"] = span.Context.TraceID.String()
+	ctx = log.WithLogger(ctx, log.G(ctx).WithFields(fields))
+	return ctx, span, metadata.Pairs(
+		requestIDKey, id,
+		tracing.TraceParentKey, span.Context.TraceParent(),
+	)
+}
+
+func (i *interceptor) done(ctx gocontext.Context, span *tracing.Span, method string, err error) {
+	span.Finish(err)
+	var (
+		d             = span.End.Sub(span.Start)
+		code          = grpc.Code(err)
+		service, name = splitMethod(method)
+	)
+	grpcLatency.WithValues(service, name).Update(d)
+	grpcRequests.WithValues(service, name, code.String()).Inc()
+	if atomic.LoadInt32(&i.logRequests) == 1 {
+		log.G(ctx).WithFields(logrus.Fields{
+			"method":   method,
+			"duration": d,
+			"code":     code,
+		}).Debug("grpc request")
+	}
+}
+
+// withTimeout applies the timeout of the method to the context of the
+// request, if any.
+func (i *interceptor) withTimeout(ctx gocontext.Context, method string) (gocontext.Context, gocontext.CancelFunc) {
+	if d, ok := i.timeouts[method]; ok {
+		return gocontext.WithTimeout(ctx, d)
+	}
+	return gocontext.WithCancel(ctx)
+}
+
+// checkMessageSize rejects the messages larger than max bytes when it is
+// positive.
+func checkMessageSize(m interface{}, max int) error {
+	sized, ok := m.(interface {
+		Size() int
+	})
+	if max <= 0 || !ok {
+		return nil
+	}
+	if size := sized.Size(); size > max {
+		return grpc.Errorf(codes.ResourceExhausted, "response of %d bytes exceeds the limit of %d bytes", size, max)
+	}
+	return nil
+}
+
+// validateNamespace rejects the requests of invalid namespaces.
+func validateNamespace(ctx gocontext.Context) error {
+	if err := namespaces.Validate(namespaces.Namespace(ctx)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return nil
+}
+
+// requestID returns the id of the request set by the client, generating one
+// when it is missing.
+func requestID(md metadata.MD) string {
+	if ids := md[requestIDKey]; len(ids) > 0 && ids[0] != "" {
+		return ids[0]
+	}
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// splitMethod splits a full method name of the form /package.service/method
+// into the service and method names.
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// serverStream overrides the context of a stream with the one populated by
+// the interceptor, and rejects the messages larger than maxSendMessageSize.
+type serverStream struct {
+	grpc.ServerStream
+	ctx                gocontext.Context
+	maxSendMessageSize int
+}
+
+func (s *serverStream) Context() gocontext.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	if err := checkMessageSize(m, s.maxSendMessageSize); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"sync"
+
+	gocontext "golang.org/x/net/context"
+
+	"github.com/docker/containerd"
+	api "github.com/docker/containerd/api/introspection"
+)
+
+// Types of the components of the daemon.
+const (
+	serviceComponent     = "service"
+	runtimeComponent     = "runtime"
+	endpointComponent    = "endpoint"
+	snapshotterComponent = "snapshotter"
+)
+
+// introspectionService reports the version of the daemon and its components
+// along with the errors they failed to initialize with, and the last reload
+// of its configuration.
+type introspectionService struct {
+	mu         sync.Mutex
+	components []*api.Component
+	lastReload *api.Reload
+}
+
+var _ api.IntrospectionServiceServer = &introspectionService{}
+
+// add records a component, err is the error it failed to initialize with.
+func (s *introspectionService) add(typ, id string, err error) {
+	c := &api.Component{
+		Type: typ,
+		ID:   id,
+	}
+	if err != nil {
+		c.Error = err.Error()
+	}
+	s.mu.Lock()
+	s.components = append(s.components, c)
+	s.mu.Unlock()
+}
+
+// setReload records the last reload of the configuration.
+func (s *introspectionService) setReload(r *api.Reload) {
+	s.mu.Lock()
+	s.lastReload = r
+	s.mu.Unlock()
+}
+
+func (s *introspectionService) Info(ctx gocontext.Context, r *api.InfoRequest) (*api.InfoResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &api.InfoResponse{
+		Version:    containerd.Version,
+		Revision:   containerd.GitCommit,
+		Components: append([]*api.Component(nil), s.components...),
+		LastReload: s.lastReload,
+	}, nil
+}
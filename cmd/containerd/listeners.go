@@ -0,0 +1,130 @@
+package main
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/docker/containerd/authz"
+	"github.com/pkg/errors"
+	gocontext "golang.org/x/net/context"
+)
+
+// unixScheme prefixes the addresses of the listeners on unix sockets.
+const unixScheme = "unix://"
+
+// listenerPolicy are the auth settings of a listener of the GRPC API.
+type listenerPolicy struct {
+	// authorizer decides the requests changing the daemon, they are not
+	// authorized when it is nil.
+	authorizer authz.Authorizer
+	// readOnly rejects the requests changing the daemon.
+	readOnly bool
+}
+
+type policyKey struct{}
+
+func withListenerPolicy(ctx gocontext.Context, p *listenerPolicy) gocontext.Context {
+	return gocontext.WithValue(ctx, policyKey{}, p)
+}
+
+// listenerPolicyFrom returns the auth settings of the listener the request
+// was received on, if it has settings of its own.
+func listenerPolicyFrom(ctx gocontext.Context) (*listenerPolicy, bool) {
+	p, ok := ctx.Value(policyKey{}).(*listenerPolicy)
+	return p, ok
+}
+
+// createListener listens on the unix socket or TCP address of the listener
+// configuration. The authorizer of the daemon applies to its requests
+// unless it configures its own.
+func createListener(c listenerConfig, keepalive time.Duration, authorizer authz.Authorizer) (net.Listener, error) {
+	if c.Address == "" {
+		return nil, errors.New("listener address must be provided")
+	}
+	policy := &listenerPolicy{
+		authorizer: authorizer,
+		readOnly:   c.ReadOnly,
+	}
+	if c.Authorization != nil {
+		a, err := c.Authorization.authorizer()
+		if err != nil {
+			return nil, errors.Wrapf(err, "listener %s", c.Address)
+		}
+		policy.authorizer = a
+	}
+	if !strings.HasPrefix(c.Address, unixScheme) {
+		if len(c.AllowedUIDs) > 0 || len(c.AllowedGIDs) > 0 {
+			return nil, errors.Errorf("listener %s: allowed users and groups only apply to unix sockets", c.Address)
+		}
+		return createTCPListener(c, keepalive, policy)
+	}
+	if c.TLS.enabled() {
+		return nil, errors.Errorf("listener %s: tls is not supported on unix sockets", c.Address)
+	}
+	path := strings.TrimPrefix(c.Address, unixScheme)
+	l, err := createUnixSocket(path)
+	if err != nil {
+		return nil, err
+	}
+	return &policyListener{
+		Listener: &peerCredListener{
+			Listener: l,
+			config: socketConfig{
+				Path:        path,
+				AllowedUIDs: c.AllowedUIDs,
+				AllowedGIDs: c.AllowedGIDs,
+			},
+		},
+		policy: policy,
+	}, nil
+}
+
+// isLoopback returns whether the TCP address only accepts connections from
+// the host.
+func isLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// policyListener attaches the auth settings of the listener to the remote
+// address of its connections, from which the interceptor attaches them to
+// each request.
+type policyListener struct {
+	net.Listener
+	policy *listenerPolicy
+}
+
+func (l *policyListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &policyConn{
+		Conn: c,
+		addr: policyAddr{Addr: c.RemoteAddr(), policy: l.policy},
+	}, nil
+}
+
+// policyConn is a connection reporting the auth settings of its listener
+// along with its remote address.
+type policyConn struct {
+	net.Conn
+	addr policyAddr
+}
+
+func (c *policyConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+type policyAddr struct {
+	net.Addr
+	policy *listenerPolicy
+}
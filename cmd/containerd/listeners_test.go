@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	leasesapi "github.com/docker/containerd/api/leases"
+	"github.com/docker/containerd/authz"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type allowAuthorizer struct{}
+
+func (allowAuthorizer) Authorize(context.Context, *authz.Request) (*authz.Response, error) {
+	return &authz.Response{Allow: true}, nil
+}
+
+type denyAuthorizer struct{}
+
+func (denyAuthorizer) Authorize(context.Context, *authz.Request) (*authz.Response, error) {
+	return &authz.Response{Reason: "denied"}, nil
+}
+
+// acceptedPolicy connects to l and returns the policy attached to the
+// connection it accepted.
+func acceptedPolicy(t *testing.T, l net.Listener, network string) *listenerPolicy {
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			t.Error(err)
+		}
+		accepted <- c
+	}()
+	c, err := net.Dial(network, l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	conn := <-accepted
+	if conn == nil {
+		t.FailNow()
+	}
+	defer conn.Close()
+	addr, ok := conn.RemoteAddr().(policyAddr)
+	if !ok {
+		t.Fatalf("expected the connection to report its policy, got %T", conn.RemoteAddr())
+	}
+	return addr.policy
+}
+
+func TestCreateListener(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containerd-listeners-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, tc := range []struct {
+		name       string
+		config     listenerConfig
+		authorizer authz.Authorizer
+		readOnly   bool
+		authorized bool
+	}{
+		{
+			name:     "plaintext loopback",
+			config:   listenerConfig{Address: "127.0.0.1:0", Insecure: true},
+			readOnly: true,
+		},
+		{
+			name:       "plaintext loopback authorized by the daemon",
+			config:     listenerConfig{Address: "127.0.0.1:0", Insecure: true},
+			authorizer: allowAuthorizer{},
+			authorized: true,
+		},
+		{
+			name: "plaintext loopback with an empty authorization",
+			// an empty authorization leaves the requests unauthorized
+			config:     listenerConfig{Address: "127.0.0.1:0", Insecure: true, Authorization: &authzConfig{}},
+			authorizer: allowAuthorizer{},
+			readOnly:   true,
+		},
+		{
+			name:     "read only plaintext loopback",
+			config:   listenerConfig{Address: "127.0.0.1:0", Insecure: true, ReadOnly: true},
+			readOnly: true,
+		},
+		{
+			name:       "unix socket",
+			config:     listenerConfig{Address: unixScheme + filepath.Join(dir, "containerd.sock")},
+			authorizer: allowAuthorizer{},
+			authorized: true,
+		},
+		{
+			name:   "unix socket without authorization",
+			config: listenerConfig{Address: unixScheme + filepath.Join(dir, "containerd.sock")},
+		},
+	} {
+		l, err := createListener(tc.config, -1, tc.authorizer)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		network := "tcp"
+		if l.Addr().Network() == "unix" {
+			network = "unix"
+		}
+		policy := acceptedPolicy(t, l, network)
+		l.Close()
+		if policy.readOnly != tc.readOnly {
+			t.Errorf("%s: expected read only %v, got %v", tc.name, tc.readOnly, policy.readOnly)
+		}
+		if (policy.authorizer != nil) != tc.authorized {
+			t.Errorf("%s: expected authorized %v, got %v", tc.name, tc.authorized, policy.authorizer != nil)
+		}
+	}
+}
+
+func TestCreateListenerInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config listenerConfig
+	}{
+		{"no address", listenerConfig{}},
+		{"plaintext non loopback", listenerConfig{Address: "0.0.0.0:0", Insecure: true}},
+		{"plaintext loopback without insecure", listenerConfig{Address: "127.0.0.1:0"}},
+		{"tls without client CA", listenerConfig{Address: "0.0.0.0:0", TLS: tlsConfig{Cert: "cert.pem", Key: "key.pem"}}},
+		{"allowed users on tcp", listenerConfig{Address: "127.0.0.1:0", AllowedUIDs: []uint32{0}}},
+		{"tls on unix socket", listenerConfig{Address: unixScheme + "/tmp/containerd-test.sock", TLS: tlsConfig{Cert: "cert.pem", Key: "key.pem"}}},
+	} {
+		if l, err := createListener(tc.config, -1, nil); err == nil {
+			l.Close()
+			t.Errorf("%s: expected the listener to be refused", tc.name)
+		}
+	}
+}
+
+func TestAuthorizeReadOnlyListener(t *testing.T) {
+	i := &interceptor{authorizer: allowAuthorizer{}}
+	ctx := withListenerPolicy(context.Background(), &listenerPolicy{readOnly: true})
+	for _, method := range []string{
+		"/containerd.v1.ExecutionService/List",
+		"/containerd.v1.ExecutionService/ListStream",
+		"/containerd.v1.ExecutionService/ListProcesses",
+		"/containerd.v1.ExecutionService/ListProcessesStream",
+		"/containerd.v1.ExecutionService/Inspect",
+	} {
+		if err := i.authorize(ctx, method, nil); err != nil {
+			t.Errorf("expected the read only method %s to be allowed, got %v", method, err)
+		}
+	}
+	err := i.authorize(ctx, "/containerd.v1.ExecutionService/Create", nil)
+	if grpc.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected a change to be denied on a read only listener, got %v", err)
+	}
+}
+
+func TestAuthorizeAllNamespaces(t *testing.T) {
+	i := &interceptor{authorizer: denyAuthorizer{}}
+	ctx := context.Background()
+	const method = "/containerd.v1.leases.LeaseService/List"
+	if err := i.authorize(ctx, method, &leasesapi.ListLeasesRequest{}); err != nil {
+		t.Fatalf("expected the leases of the namespace to be listed, got %v", err)
+	}
+	err := i.authorize(ctx, method, &leasesapi.ListLeasesRequest{AllNamespaces: true})
+	if grpc.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected the leases of all namespaces to be submitted to the authorizer, got %v", err)
+	}
+}
@@ -12,18 +12,39 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	gocontext "golang.org/x/net/context"
 	"google.golang.org/grpc"
 
 	"github.com/docker/containerd"
+	checkpointapi "github.com/docker/containerd/api/checkpoint"
+	debugapi "github.com/docker/containerd/api/debug"
 	api "github.com/docker/containerd/api/execution"
+	gcapi "github.com/docker/containerd/api/gc"
+	imagesapi "github.com/docker/containerd/api/images"
+	introspectionapi "github.com/docker/containerd/api/introspection"
+	leasesapi "github.com/docker/containerd/api/leases"
+	migrationapi "github.com/docker/containerd/api/migration"
+	"github.com/docker/containerd/apparmor"
+	"github.com/docker/containerd/checkpoint"
+	"github.com/docker/containerd/cni"
+	"github.com/docker/containerd/content"
 	"github.com/docker/containerd/events"
 	"github.com/docker/containerd/execution"
-	"github.com/docker/containerd/execution/executors/oci"
-	"github.com/docker/containerd/execution/executors/shim"
+	"github.com/docker/containerd/gc"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/layout"
+	"github.com/docker/containerd/leases"
 	"github.com/docker/containerd/log"
-	metrics "github.com/docker/go-metrics"
+	"github.com/docker/containerd/migration"
+	"github.com/docker/containerd/netns"
+	"github.com/docker/containerd/remotes"
+	"github.com/docker/containerd/snapshot/overlay"
+	"github.com/docker/containerd/systemd"
+	"github.com/docker/containerd/tracing"
+	"github.com/opencontainers/go-digest"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 
@@ -49,15 +70,37 @@ high performance container runtime
 			Name:  "debug",
 			Usage: "enable debug output in logs",
 		},
+		cli.StringFlag{
+			Name:  "log-levels",
+			Usage: "log levels of modules, as module=level,module=level, overriding those of the configuration",
+		},
+		cli.BoolFlag{
+			Name:  "log-requests",
+			Usage: "log the method, duration and result of each GRPC request at debug level",
+		},
+		cli.BoolFlag{
+			Name:  "trace",
+			Usage: "record the spans of all requests, not only of those sampled by the caller",
+		},
+		cli.StringFlag{
+			Name:  "config, c",
+			Usage: "path to the daemon configuration file, the flags set override its settings",
+			Value: "/etc/containerd/config.toml",
+		},
 		cli.StringFlag{
 			Name:  "root",
-			Usage: "containerd state directory",
+			Usage: "containerd root directory, holding the content, images, leases and snapshots",
+			Value: "/var/lib/containerd",
+		},
+		cli.StringFlag{
+			Name:  "state",
+			Usage: "containerd state directory, holding the state of the running containers",
 			Value: "/run/containerd",
 		},
 		cli.StringFlag{
 			Name:  "runtime",
-			Usage: "runtime for execution",
-			Value: "runc",
+			Usage: "runtime for execution (shim runs each container under its own containerd-shim, hcs in the host compute service on windows)",
+			Value: defaultRuntime,
 		},
 		cli.StringFlag{
 			Name:  "socket, s",
@@ -66,7 +109,7 @@ high performance container runtime
 		},
 		cli.StringFlag{
 			Name:  "metrics-address, m",
-			Usage: "tcp address to serve metrics on",
+			Usage: "tcp address to serve prometheus metrics on at /metrics",
 			Value: "127.0.0.1:7897",
 		},
 		cli.StringFlag{
@@ -74,6 +117,15 @@ high performance container runtime
 			Usage: "nats address to serve events on",
 			Value: nats.DefaultURL,
 		},
+		cli.StringFlag{
+			Name:  "registry-cache-address",
+			Usage: "tcp address to serve a pull-through registry cache on",
+		},
+		cli.StringFlag{
+			Name:  "registry-cache-upstream",
+			Usage: "upstream registry host for the pull-through cache",
+			Value: "registry-1.docker.io",
+		},
 	}
 	app.Before = func(context *cli.Context) error {
 		if context.GlobalBool("debug") {
@@ -82,90 +134,305 @@ high performance container runtime
 		return nil
 	}
 	app.Action = func(context *cli.Context) error {
+		config, err := loadConfig(context.GlobalString("config"))
+		if err != nil {
+			return err
+		}
+		if err := config.applyFlags(context); err != nil {
+			return err
+		}
+		if err := setLogLevels(config); err != nil {
+			return err
+		}
+		tracing.SetSampleAll(config.Debug.Trace)
+		dirs := config.layout()
+		lock, err := layout.Open(dirs)
+		if err != nil {
+			return err
+		}
+		defer lock.Release()
+		shutdownTimeout, err := config.shutdownTimeout()
+		if err != nil {
+			return err
+		}
+		authorizer, err := config.Authorization.authorizer()
+		if err != nil {
+			return err
+		}
+		secretBackend, err := config.Plugins.Secrets.backend()
+		if err != nil {
+			return err
+		}
+		grpcOptions, err := config.GRPC.serverOptions()
+		if err != nil {
+			return err
+		}
+		grpcTimeouts, err := config.GRPC.timeouts()
+		if err != nil {
+			return err
+		}
+		gcPolicy, err := config.Plugins.GC.policy()
+		if err != nil {
+			return err
+		}
+		commitInterval, err := config.commitInterval()
+		if err != nil {
+			return err
+		}
+		var network *cni.Network
+		if config.Plugins.CNI != nil {
+			if network, err = cni.Load(config.Plugins.CNI.ConfDir, config.Plugins.CNI.BinDirs); err != nil {
+				return err
+			}
+			if network.State, err = cni.NewState(dirs.CNI()); err != nil {
+				return err
+			}
+		}
+		resolver := remotes.NewResolver(config.Registries)
+		introspection := &introspectionService{}
+
 		signals := make(chan os.Signal, 2048)
-		signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT, syscall.SIGUSR1)
+		signal.Notify(signals, handledSignals...)
 
-		if address := context.GlobalString("metrics-address"); address != "" {
+		if address := config.MetricsAddress; address != "" {
 			go serveMetrics(address)
+			introspection.add(endpointComponent, "metrics", nil)
 		}
 
-		s, err := startNATSServer(context)
+		s, err := startNATSServer(config.EventsAddress)
 		if err != nil {
 			return nil
 		}
 		defer s.Shutdown()
 
-		path := context.GlobalString("socket")
-		if path == "" {
-			return fmt.Errorf("--socket path cannot be empty")
+		unixListeners, err := activatedListeners(config.Socket)
+		if err != nil {
+			return err
+		}
+		if len(unixListeners) == 0 {
+			l, err := createUnixSocket(config.Socket.Path)
+			if err != nil {
+				return err
+			}
+			unixListeners = append(unixListeners, &peerCredListener{Listener: l, config: config.Socket})
 		}
-		l, err := createUnixSocket(path)
+		keepalive, err := config.GRPC.keepalive()
 		if err != nil {
 			return err
 		}
+		var listeners []net.Listener
+		for _, lc := range config.Listeners {
+			l, err := createListener(lc, keepalive, authorizer)
+			if err != nil {
+				return err
+			}
+			listeners = append(listeners, l)
+		}
 
 		// Get events publisher
-		nec, err := getNATSPublisher(context)
+		nec, err := getNATSPublisher(config.EventsAddress)
 		if err != nil {
 			return err
 		}
 		defer nec.Close()
+		journal := events.NewJournal(events.DefaultJournalSize)
+		journalSub, err := nec.Subscribe("containerd.>", func(m *nats.Msg) {
+			journal.Add(m.Subject, m.Data)
+		})
+		if err != nil {
+			return err
+		}
+		// the events are posted from a pool of workers, for the exits of
+		// the containers and the requests not to wait on the events server
+		poster := events.NewAsyncPoster(events.GetNATSPoster(nec), eventWorkers, eventQueueSize)
 		ctx := log.WithModule(gocontext.Background(), "containerd")
 		ctx = log.WithModule(ctx, "execution")
-		ctx = events.WithPoster(ctx, events.GetNATSPoster(nec))
+		ctx = events.WithPoster(ctx, poster)
 
-		var (
-			executor execution.Executor
-			runtime  = context.GlobalString("runtime")
-		)
-		switch runtime {
-		case "runc":
-			executor, err = oci.New(context.GlobalString("root"))
-			if err != nil {
-				return err
-			}
-		case "shim":
-			root := filepath.Join(context.GlobalString("root"), "shim")
-			err = os.Mkdir(root, 0700)
-			if err != nil && !os.IsExist(err) {
-				return err
+		// containers are confined by the default profile unless they select
+		// another one, it is loaded once and left for the administrator to
+		// adjust
+		if apparmor.IsEnabled() {
+			if err := apparmor.LoadDefaultProfile(); err != nil {
+				log.G(ctx).WithError(err).Warn("failed to load the default apparmor profile")
 			}
-			executor, err = shim.New(log.WithModule(ctx, "shim"), root, "containerd-shim", "runc", nil)
-			if err != nil {
-				return err
-			}
-		default:
-			return fmt.Errorf("oci: runtime %q not implemented", runtime)
 		}
 
-		execService, err := execution.New(ctx, executor)
+		runtimes, err := newRuntimes(ctx, dirs, config, introspection)
 		if err != nil {
 			return err
 		}
 
-		// Intercept the GRPC call in order to populate the correct module path
-		interceptor := func(ctx gocontext.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-			ctx = log.WithModule(ctx, "containerd")
-			switch info.Server.(type) {
-			case api.ExecutionServiceServer:
-				ctx = log.WithModule(ctx, "execution")
-				ctx = events.WithPoster(ctx, events.GetNATSPoster(nec))
-			default:
-				fmt.Printf("Unknown type: %#v\n", info.Server)
+		stats, err := newStatsReader(ctx, runtimes, config)
+		if err != nil {
+			return err
+		}
+		netnsStore, err := netns.NewStore(dirs.NetNS())
+		if err != nil {
+			return err
+		}
+		sandboxStore, err := newSandboxStore(dirs)
+		if err != nil {
+			return err
+		}
+		execService, err := execution.New(ctx, runtimes, stats, secretBackend, network, netnsStore, sandboxStore)
+		if err != nil {
+			return err
+		}
+		go execService.RunWatchdog(ctx, execution.DefaultLeakCheckInterval)
+		prometheus.MustRegister(
+			&containerCollector{ctx: ctx, runtimes: runtimes, stats: stats},
+			&eventsCollector{nc: nec.Conn},
+		)
+
+		imageStore, err := images.NewStore(dirs.Images())
+		if err != nil {
+			return err
+		}
+		leaseStore, err := leases.NewStore(dirs.Leases())
+		if err != nil {
+			return err
+		}
+		imageStore.SetCommitInterval(commitInterval)
+		leaseStore.SetCommitInterval(commitInterval)
+
+		contentStore, err := content.OpenContentStore(dirs.Content())
+		if err != nil {
+			return err
+		}
+		// the snapshots are managed by the clients on the host, the driver
+		// is opened for its directories to be set up and the unused
+		// snapshots to be collected
+		snapshotter, err := overlay.NewOverlayfs(dirs.Snapshots(config.Snapshotter))
+		introspection.add(snapshotterComponent, config.Snapshotter, err)
+
+		var cache *remotes.Cache
+		if address := config.Plugins.RegistryCache.Address; address != "" {
+			cache, err = newRegistryCache(config, resolver, contentStore)
+			if err != nil {
+				return err
+			}
+			go serveRegistryCache(address, cache)
+			introspection.add(endpointComponent, "registry-cache", nil)
+		}
+
+		var unpacked images.UnpackedFunc
+		if snapshotter != nil {
+			// the layers are unpacked into the snapshots committed under
+			// their chain id
+			unpacked = func(chainID digest.Digest) bool {
+				return snapshotter.Committed(chainID.String())
 			}
-			return handler(ctx, req)
 		}
-		server := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+		imageService := images.NewService(imageStore, contentStore, unpacked)
+		var scheduler *gc.Scheduler
+		// stopGC interrupts the running collection and stops the scheduler
+		stopGC := func() {}
+		if snapshotter != nil {
+			collector := gc.NewCollector(contentStore, snapshotter, leaseStore, imageService, execService)
+			if cache != nil {
+				collector.AddManifests("registry-cache", cache)
+			}
+			collector.SetPruners(execService, imageService)
+			scheduler = gc.NewScheduler(collector, dirs.Root, gcPolicy)
+			gcCtx, cancel := gocontext.WithCancel(log.WithModule(log.WithModule(gocontext.Background(), "containerd"), "gc"))
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				scheduler.Run(events.WithPoster(gcCtx, poster))
+			}()
+			stopGC = func() {
+				cancel()
+				select {
+				case <-done:
+				case <-time.After(shutdownTimeout):
+					logrus.Warn("containerd: timed out stopping the gc")
+				}
+			}
+			// the resources of the expired leases are released as the
+			// deleted ones
+			leaseStore.OnExpire(func(leases.Lease) {
+				scheduler.Deleted()
+			})
+		}
+		go leaseStore.Run(log.WithModule(log.WithModule(gocontext.Background(), "containerd"), "leases"), time.Minute)
+
+		interceptor := &interceptor{
+			poster:             poster,
+			authorizer:         authorizer,
+			timeouts:           grpcTimeouts,
+			maxSendMessageSize: config.GRPC.MaxSendMessageSize,
+			gc:                 scheduler,
+		}
+		interceptor.setLogRequests(config.Debug.LogRequests)
+		server := grpc.NewServer(append(grpcOptions,
+			grpc.UnaryInterceptor(interceptor.unary),
+			grpc.StreamInterceptor(interceptor.stream),
+		)...)
 		api.RegisterExecutionServiceServer(server, execService)
-		go serveGRPC(server, l)
+		debugapi.RegisterDebugServiceServer(server, &debugService{execution: execService, journal: journal, content: contentStore})
+		imagesapi.RegisterImageServiceServer(server, imageService)
+		leasesapi.RegisterLeaseServiceServer(server, leases.NewService(leaseStore))
+		checkpointapi.RegisterCheckpointServiceServer(server, checkpoint.NewService(dirs.Checkpoints(), contentStore, execService, imageService, snapshotter))
+		migrationapi.RegisterMigrationServiceServer(server, migration.NewService(dirs.Migrations(), contentStore, leaseStore, resolver, execService, imageService, snapshotter))
+		introspectionapi.RegisterIntrospectionServiceServer(server, introspection)
+		for _, name := range []string{"execution", "debug", "images", "introspection", "leases", "checkpoint", "migration"} {
+			introspection.add(serviceComponent, name, nil)
+		}
+		if scheduler != nil {
+			gcapi.RegisterGCServiceServer(server, gc.NewService(scheduler))
+			introspection.add(serviceComponent, "gc", nil)
+		}
+		stopping := make(chan struct{})
+		for _, l := range unixListeners {
+			go serveGRPC(server, l, stopping)
+		}
+		for _, l := range listeners {
+			go serveGRPC(server, l, stopping)
+			introspection.add(endpointComponent, "grpc "+l.Addr().String(), nil)
+		}
 
+		reloader := &reloader{
+			context:       context,
+			resolver:      resolver,
+			interceptor:   interceptor,
+			introspection: introspection,
+			config:        config,
+		}
+		notifySystemd(systemd.Ready)
 		for s := range signals {
 			switch s {
-			case syscall.SIGUSR1:
+			case syscall.SIGHUP:
+				notifySystemd(systemd.Reloading)
+				reloader.reload()
+				notifySystemd(systemd.Ready)
+			case dumpSignal:
 				dumpStacks()
+				notifySystemd(systemd.Reloading)
+				reloader.reload()
+				notifySystemd(systemd.Ready)
 			default:
 				logrus.WithField("signal", s).Info("containerd: stopping GRPC server")
-				server.Stop()
+				notifySystemd(systemd.Stopping)
+				close(stopping)
+				// the report of the interrupted collection is posted
+				// with the queued events
+				stopGC()
+				// the events are flushed while the event streams of the
+				// clients are still served, the events of the requests
+				// still in flight being posted synchronously from then on
+				if err := poster.Close(shutdownTimeout); err != nil {
+					logrus.WithError(err).Warn("containerd: failed to post the queued events")
+				}
+				if err := flushEvents(nec.Conn, journalSub, shutdownTimeout); err != nil {
+					logrus.WithError(err).Warn("containerd: failed to flush the events to the journal")
+				}
+				stopGRPC(server, shutdownTimeout)
+				// the events of the last requests reach the journal before
+				// the connection to the events server is closed
+				if err := flushEvents(nec.Conn, journalSub, shutdownTimeout); err != nil {
+					logrus.WithError(err).Warn("containerd: failed to flush the events to the journal")
+				}
 				return nil
 			}
 		}
@@ -177,6 +444,35 @@ high performance container runtime
 	}
 }
 
+// activatedListeners returns the unix sockets systemd activated the daemon
+// with, restricted to the callers allowed by config.
+func activatedListeners(config socketConfig) ([]net.Listener, error) {
+	listeners, err := systemd.Listeners()
+	if err != nil {
+		return nil, err
+	}
+	var out []net.Listener
+	for _, l := range listeners {
+		if _, ok := l.(*net.UnixListener); !ok {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, fmt.Errorf("socket activated on %s: only unix sockets can be activated", l.Addr())
+		}
+		logrus.WithField("address", l.Addr()).Info("containerd: serving socket activated by systemd")
+		out = append(out, &peerCredListener{Listener: l, config: config})
+	}
+	return out, nil
+}
+
+// notifySystemd reports the state of the daemon to systemd, when it runs
+// the daemon as a notify service.
+func notifySystemd(state string) {
+	if _, err := systemd.Notify(state); err != nil {
+		logrus.WithError(err).WithField("state", state).Warn("containerd: failed to notify systemd")
+	}
+}
+
 func createUnixSocket(path string) (net.Listener, error) {
 	if err := os.MkdirAll(filepath.Dir(path), 0660); err != nil {
 		return nil, err
@@ -187,22 +483,72 @@ func createUnixSocket(path string) (net.Listener, error) {
 	return net.Listen("unix", path)
 }
 
-func serveMetrics(address string) {
-	m := http.NewServeMux()
-	m.Handle("/metrics", metrics.Handler())
-	if err := http.ListenAndServe(address, m); err != nil {
-		logrus.WithError(err).Fatal("containerd: metrics server failure")
+// newStatsReader returns a cache sampling the stats of the containers in the
+// background, or the runtimes themselves when sampling is disabled.
+func newStatsReader(ctx gocontext.Context, runtimes *execution.Runtimes, c *config) (execution.StatsReader, error) {
+	interval, err := c.statsInterval()
+	if err != nil {
+		return nil, err
+	}
+	if interval == 0 {
+		return runtimes, nil
 	}
+	cache, err := execution.NewStatsCache(runtimes, interval)
+	if err != nil {
+		return nil, err
+	}
+	go cache.Run(ctx)
+	return cache, nil
 }
 
-func serveGRPC(server *grpc.Server, l net.Listener) {
+func newRegistryCache(c *config, resolver *remotes.Resolver, cs *content.ContentStore) (*remotes.Cache, error) {
+	upstream, err := resolver.Registry(c.Plugins.RegistryCache.Upstream)
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := images.NewStore(c.layout().RegistryCache())
+	if err != nil {
+		return nil, err
+	}
+	return remotes.NewCache(upstream, cs, manifests), nil
+}
+
+func serveRegistryCache(address string, cache *remotes.Cache) {
+	if err := http.ListenAndServe(address, cache); err != nil {
+		logrus.WithError(err).Fatal("containerd: registry cache server failure")
+	}
+}
+
+// serveGRPC serves the requests of l, the daemon exiting when it fails
+// unless stopping is closed.
+func serveGRPC(server *grpc.Server, l net.Listener, stopping <-chan struct{}) {
 	defer l.Close()
 	if err := server.Serve(l); err != nil {
+		select {
+		case <-stopping:
+			return
+		default:
+		}
 		l.Close()
 		logrus.WithError(err).Fatal("containerd: GRPC server failure")
 	}
 }
 
+// setLogLevels applies the log levels of the modules configured, along with
+// the default level of the debug settings.
+func setLogLevels(c *config) error {
+	modules, err := c.moduleLevels()
+	if err != nil {
+		return err
+	}
+	level, err := logrus.ParseLevel(c.Debug.Level)
+	if err != nil {
+		return err
+	}
+	log.SetLevels(level, modules)
+	return nil
+}
+
 // DumpStacks dumps the runtime stack.
 func dumpStacks() {
 	var (
@@ -219,8 +565,8 @@ func dumpStacks() {
 	logrus.Infof("=== BEGIN goroutine stack dump ===\n%s\n=== END goroutine stack dump ===", buf)
 }
 
-func startNATSServer(context *cli.Context) (e *stand.StanServer, err error) {
-	eventsURL, err := url.Parse(context.GlobalString("events-address"))
+func startNATSServer(address string) (e *stand.StanServer, err error) {
+	eventsURL, err := url.Parse(address)
 	if err != nil {
 		return nil, err
 	}
@@ -250,8 +596,8 @@ func startNATSServer(context *cli.Context) (e *stand.StanServer, err error) {
 	return s, nil
 }
 
-func getNATSPublisher(context *cli.Context) (*nats.EncodedConn, error) {
-	nc, err := nats.Connect(context.GlobalString("events-address"))
+func getNATSPublisher(address string) (*nats.EncodedConn, error) {
+	nc, err := nats.Connect(address)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,148 @@
+// +build !windows
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	gocontext "golang.org/x/net/context"
+
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/execution/executors/oci"
+	"github.com/docker/containerd/execution/executors/shim"
+	"github.com/docker/containerd/layout"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/sandbox"
+	"github.com/pkg/errors"
+)
+
+const defaultRuntime = "shim"
+
+var handledSignals = []os.Signal{syscall.SIGTERM, syscall.SIGINT, syscall.SIGUSR1, syscall.SIGHUP}
+
+// dumpSignal dumps the stacks of the daemon and reloads its configuration.
+var dumpSignal os.Signal = syscall.SIGUSR1
+
+// newRuntimes registers the default executor, the runtime of the
+// configuration, along with the runtimes
+// configured for the daemon. Configured runtimes are run under the shim, those
+// failing to initialize, such as when their binary is missing, are reported
+// and left out rather than preventing the daemon from starting. The user
+// namespace and admission policy of the daemon apply to the runtimes that do
+// not configure their own.
+func newRuntimes(ctx gocontext.Context, dirs layout.Layout, c *config, introspection *introspectionService) (*execution.Runtimes, error) {
+	health, err := c.ShimHealth.healthCheck()
+	if err != nil {
+		return nil, err
+	}
+	var executor execution.Executor
+	switch c.Runtime {
+	case "runc":
+		executor, err = oci.New(c.State)
+		if err != nil {
+			return nil, err
+		}
+	case "shim":
+		root := dirs.Runtime("shim")
+		err = os.Mkdir(root, 0700)
+		if err != nil && !os.IsExist(err) {
+			return nil, err
+		}
+		executor, err = shim.New(log.WithModule(ctx, "shim"), root, shim.DefaultShimBinary, "runc", nil, health)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("oci: runtime %q not implemented", c.Runtime)
+	}
+	userns := c.UserNamespace
+	if userns != nil {
+		if err := userns.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := executor.(*oci.OCIRuntime); ok {
+			return nil, oci.ErrUserNamespaceUnsupported
+		}
+	}
+	runtimes := []execution.Runtime{
+		{
+			Name:         execution.DefaultRuntime,
+			Executor:     executor,
+			Capabilities: execution.FullCapabilities,
+			SpecDefaults: execution.SpecDefaults{UserNamespace: userns},
+			Admission:    c.Admission,
+		},
+	}
+	introspection.add(runtimeComponent, execution.DefaultRuntime, nil)
+	for name, rc := range c.Runtimes {
+		if name == execution.DefaultRuntime {
+			return nil, fmt.Errorf("runtime name %q is reserved for the default runtime", name)
+		}
+		if rc.Path == "" {
+			return nil, fmt.Errorf("runtime %q: path must be provided", name)
+		}
+		capabilities, err := rc.capabilities()
+		if err != nil {
+			return nil, fmt.Errorf("runtime %q: %v", name, err)
+		}
+		if rc.UserNamespace == nil {
+			rc.UserNamespace = userns
+		} else if err := rc.UserNamespace.Validate(); err != nil {
+			return nil, fmt.Errorf("runtime %q: %v", name, err)
+		}
+		if rc.Admission == nil {
+			rc.Admission = c.Admission
+		}
+		dir := dirs.Runtime("shim-" + name)
+		if err := os.Mkdir(dir, 0700); err != nil && !os.IsExist(err) {
+			return nil, err
+		}
+		e, err := shim.New(log.WithModule(ctx, name), dir, shim.DefaultShimBinary, rc.Path, rc.Args, health)
+		introspection.add(runtimeComponent, name, err)
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("runtime", name).Error("failed to initialize runtime")
+			continue
+		}
+		runtimes = append(runtimes, execution.Runtime{
+			Name:         name,
+			Executor:     e,
+			Capabilities: capabilities,
+			SpecDefaults: rc.SpecDefaults,
+			Admission:    rc.Admission,
+		})
+	}
+	return execution.NewRuntimes(ctx, execution.DefaultRuntime, runtimes...)
+}
+
+// newSandboxStore returns the store of the sandboxes, whose pause processes
+// are shims started with -pause.
+func newSandboxStore(dirs layout.Layout) (*sandbox.Store, error) {
+	return sandbox.NewStore(dirs.Sandboxes(), shim.DefaultShimBinary)
+}
+
+func (hc shimHealthConfig) healthCheck() (shim.HealthCheck, error) {
+	h := shim.DefaultHealthCheck
+	for _, d := range []struct {
+		value string
+		dst   *time.Duration
+	}{
+		{hc.Interval, &h.Interval},
+		{hc.Timeout, &h.Timeout},
+	} {
+		if d.value == "" {
+			continue
+		}
+		v, err := time.ParseDuration(d.value)
+		if err != nil {
+			return h, errors.Wrap(err, "invalid shim health check duration")
+		}
+		*d.dst = v
+	}
+	if hc.Policy != "" {
+		h.Policy = shim.FailurePolicy(hc.Policy)
+	}
+	return h, nil
+}
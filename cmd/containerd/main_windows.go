@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	gocontext "golang.org/x/net/context"
+
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/execution/executors/hcs"
+	"github.com/docker/containerd/layout"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/sandbox"
+)
+
+const defaultRuntime = "hcs"
+
+// hcsOwner owns the compute systems of the daemon in HCS, for those left by a
+// previous daemon to be terminated.
+const hcsOwner = "containerd"
+
+var handledSignals = []os.Signal{syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP}
+
+// dumpSignal is not delivered on Windows, the stacks are not dumped.
+var dumpSignal os.Signal
+
+// newRuntimes registers hcs as the default runtime, the only runtime of the
+// daemon on Windows. The containers are not run under shims, no runtimes can
+// be configured.
+func newRuntimes(ctx gocontext.Context, dirs layout.Layout, c *config, introspection *introspectionService) (*execution.Runtimes, error) {
+	if c.Runtime != "hcs" {
+		return nil, fmt.Errorf("runtime %q not supported on windows", c.Runtime)
+	}
+	if c.UserNamespace != nil {
+		return nil, hcs.ErrUserNamespaceUnsupported
+	}
+	if len(c.Runtimes) > 0 {
+		return nil, fmt.Errorf("runtimes cannot be configured on windows")
+	}
+	executor, err := hcs.New(log.WithModule(ctx, "hcs"), dirs.Runtime("hcs"), hcsOwner)
+	introspection.add(runtimeComponent, execution.DefaultRuntime, err)
+	if err != nil {
+		return nil, err
+	}
+	return execution.NewRuntimes(ctx, execution.DefaultRuntime, execution.Runtime{
+		Name:     execution.DefaultRuntime,
+		Executor: executor,
+		// the stats of the compute systems are not read
+		Capabilities: execution.Capabilities{
+			Pause: true,
+			Exec:  true,
+		},
+		Admission: c.Admission,
+	})
+}
+
+// newSandboxStore returns no store, sandboxes with a pause process are not
+// supported on Windows.
+func newSandboxStore(dirs layout.Layout) (*sandbox.Store, error) {
+	return nil, nil
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	gocontext "golang.org/x/net/context"
+
+	"github.com/docker/containerd/cgroups"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/log"
+	metrics "github.com/docker/go-metrics"
+	"github.com/nats-io/go-nats"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// collectTimeout bounds the time spent listing containers and reading their
+// cgroups on a scrape.
+const collectTimeout = 10 * time.Second
+
+var (
+	grpcNamespace = metrics.NewNamespace("containerd", "grpc", nil)
+	grpcRequests  = grpcNamespace.NewLabeledCounter("requests", "The number of GRPC requests by method and error code", "service", "method", "code")
+	grpcLatency   = grpcNamespace.NewLabeledTimer("request", "The time taken to serve GRPC requests by method", "service", "method")
+)
+
+func init() {
+	metrics.Register(grpcNamespace)
+}
+
+func serveMetrics(address string) {
+	m := http.NewServeMux()
+	m.Handle("/metrics", metrics.Handler())
+	if err := http.ListenAndServe(address, m); err != nil {
+		logrus.WithError(err).Fatal("containerd: metrics server failure")
+	}
+}
+
+// containerCollector exports the number of containers by runtime and status
+// along with the resource usage of their cgroups. The containers are listed
+// on each scrape, their resource usage is read from stats.
+type containerCollector struct {
+	ctx      gocontext.Context
+	runtimes *execution.Runtimes
+	stats    execution.StatsReader
+}
+
+var (
+	containersDesc = prometheus.NewDesc("containerd_containers", "The number of containers by runtime and status", []string{"runtime", "status"}, nil)
+
+	cpuUsageDesc       = newContainerDesc("cpu_usage_seconds_total", "The total cpu time consumed by the container")
+	cpuThrottledDesc   = newContainerDesc("cpu_throttled_seconds_total", "The total time the container was throttled for")
+	memoryUsageDesc    = newContainerDesc("memory_usage_bytes", "The memory used by the container")
+	memoryLimitDesc    = newContainerDesc("memory_limit_bytes", "The memory limit of the container, zero without a limit")
+	memorySwapDesc     = newContainerDesc("memory_swap_usage_bytes", "The swap used by the container")
+	pidsDesc           = newContainerDesc("pids", "The number of processes in the container")
+	pidsLimitDesc      = newContainerDesc("pids_limit", "The process limit of the container, zero without a limit")
+	ioReadBytesDesc    = newContainerDesc("io_read_bytes_total", "The bytes read by the container from block devices")
+	ioWrittenBytesDesc = newContainerDesc("io_written_bytes_total", "The bytes written by the container to block devices")
+
+	networkRxBytesDesc   = newNetworkDesc("receive_bytes_total", "The bytes received by the container on the interface")
+	networkRxPacketsDesc = newNetworkDesc("receive_packets_total", "The packets received by the container on the interface")
+	networkRxDroppedDesc = newNetworkDesc("receive_packets_dropped_total", "The packets dropped while received by the container on the interface")
+	networkTxBytesDesc   = newNetworkDesc("transmit_bytes_total", "The bytes transmitted by the container on the interface")
+	networkTxPacketsDesc = newNetworkDesc("transmit_packets_total", "The packets transmitted by the container on the interface")
+	networkTxDroppedDesc = newNetworkDesc("transmit_packets_dropped_total", "The packets dropped while transmitted by the container on the interface")
+)
+
+func newContainerDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc("containerd_container_"+name, help, []string{"id", "runtime"}, nil)
+}
+
+func newNetworkDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc("containerd_container_network_"+name, help, []string{"id", "runtime", "interface"}, nil)
+}
+
+func (cc *containerCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		containersDesc,
+		cpuUsageDesc,
+		cpuThrottledDesc,
+		memoryUsageDesc,
+		memoryLimitDesc,
+		memorySwapDesc,
+		pidsDesc,
+		pidsLimitDesc,
+		ioReadBytesDesc,
+		ioWrittenBytesDesc,
+		networkRxBytesDesc,
+		networkRxPacketsDesc,
+		networkRxDroppedDesc,
+		networkTxBytesDesc,
+		networkTxPacketsDesc,
+		networkTxDroppedDesc,
+	} {
+		ch <- d
+	}
+}
+
+func (cc *containerCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := gocontext.WithTimeout(cc.ctx, collectTimeout)
+	defer cancel()
+	containers, err := cc.runtimes.List(ctx)
+	if err != nil {
+		log.G(ctx).WithError(err).Error("metrics: failed to list containers")
+		return
+	}
+	type key struct {
+		runtime string
+		status  execution.Status
+	}
+	counts := make(map[key]int)
+	for _, c := range containers {
+		rt, err := cc.runtimes.RuntimeOf(c.ID())
+		if err != nil {
+			continue
+		}
+		status := c.Status()
+		counts[key{rt.Name, status}]++
+		if status != execution.Running && status != execution.Paused {
+			continue
+		}
+		stats, err := cc.stats.Stats(ctx, c)
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("container", c.ID()).Debug("metrics: failed to read container stats")
+			continue
+		}
+		collectStats(ch, stats, c.ID(), rt.Name)
+	}
+	for k, n := range counts {
+		ch <- prometheus.MustNewConstMetric(containersDesc, prometheus.GaugeValue, float64(n), k.runtime, string(k.status))
+	}
+}
+
+func collectStats(ch chan<- prometheus.Metric, stats *cgroups.Metrics, labels ...string) {
+	var rbytes, wbytes uint64
+	for _, io := range stats.IO {
+		rbytes += io.Rbytes
+		wbytes += io.Wbytes
+	}
+	for _, m := range []struct {
+		desc  *prometheus.Desc
+		typ   prometheus.ValueType
+		value float64
+	}{
+		{cpuUsageDesc, prometheus.CounterValue, usecToSeconds(stats.CPU.UsageUsec)},
+		{cpuThrottledDesc, prometheus.CounterValue, usecToSeconds(stats.CPU.ThrottledUsec)},
+		{memoryUsageDesc, prometheus.GaugeValue, float64(stats.Memory.Usage)},
+		{memoryLimitDesc, prometheus.GaugeValue, float64(stats.Memory.Limit)},
+		{memorySwapDesc, prometheus.GaugeValue, float64(stats.Memory.SwapUsage)},
+		{pidsDesc, prometheus.GaugeValue, float64(stats.Pids.Current)},
+		{pidsLimitDesc, prometheus.GaugeValue, float64(stats.Pids.Limit)},
+		{ioReadBytesDesc, prometheus.CounterValue, float64(rbytes)},
+		{ioWrittenBytesDesc, prometheus.CounterValue, float64(wbytes)},
+	} {
+		ch <- prometheus.MustNewConstMetric(m.desc, m.typ, m.value, labels...)
+	}
+	for _, n := range stats.Network {
+		for _, m := range []struct {
+			desc  *prometheus.Desc
+			value uint64
+		}{
+			{networkRxBytesDesc, n.RxBytes},
+			{networkRxPacketsDesc, n.RxPackets},
+			{networkRxDroppedDesc, n.RxDropped},
+			{networkTxBytesDesc, n.TxBytes},
+			{networkTxPacketsDesc, n.TxPackets},
+			{networkTxDroppedDesc, n.TxDropped},
+		} {
+			ch <- prometheus.MustNewConstMetric(m.desc, prometheus.CounterValue, float64(m.value), append(labels, n.Interface)...)
+		}
+	}
+}
+
+func usecToSeconds(usec uint64) float64 {
+	return float64(usec) / float64(time.Second/time.Microsecond)
+}
+
+// eventsCollector exports the statistics of the connection events are
+// published on.
+type eventsCollector struct {
+	nc *nats.Conn
+}
+
+var (
+	eventsPublishedDesc  = prometheus.NewDesc("containerd_events_published_total", "The number of events published", nil, nil)
+	eventsBytesDesc      = prometheus.NewDesc("containerd_events_published_bytes_total", "The size of the events published", nil, nil)
+	eventsReconnectsDesc = prometheus.NewDesc("containerd_events_reconnects_total", "The number of reconnections to the events server", nil, nil)
+	eventsConnectedDesc  = prometheus.NewDesc("containerd_events_connected", "Whether the daemon is connected to the events server", nil, nil)
+)
+
+func (ec *eventsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- eventsPublishedDesc
+	ch <- eventsBytesDesc
+	ch <- eventsReconnectsDesc
+	ch <- eventsConnectedDesc
+}
+
+func (ec *eventsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := ec.nc.Stats()
+	var connected float64
+	if ec.nc.IsConnected() {
+		connected = 1
+	}
+	ch <- prometheus.MustNewConstMetric(eventsPublishedDesc, prometheus.CounterValue, float64(stats.OutMsgs))
+	ch <- prometheus.MustNewConstMetric(eventsBytesDesc, prometheus.CounterValue, float64(stats.OutBytes))
+	ch <- prometheus.MustNewConstMetric(eventsReconnectsDesc, prometheus.CounterValue, float64(stats.Reconnects))
+	ch <- prometheus.MustNewConstMetric(eventsConnectedDesc, prometheus.GaugeValue, connected)
+}
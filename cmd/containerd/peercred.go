@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/docker/containerd/identity"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// errPeerCredentialsUnsupported is returned by peerCredentials on the
+// platforms not reporting the credentials of unix socket peers.
+var errPeerCredentialsUnsupported = errors.New("the credentials of socket peers are not supported")
+
+// peerCredListener accepts the connections of the unix socket from the
+// allowed users and groups, beyond the permissions of the socket file. The
+// credentials of the peer are exposed as the remote address of the
+// connection, from which the interceptor attaches them to each request.
+type peerCredListener struct {
+	net.Listener
+	config socketConfig
+}
+
+func (l *peerCredListener) Accept() (net.Conn, error) {
+	for {
+		c, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		uc, ok := c.(*net.UnixConn)
+		if !ok {
+			return c, nil
+		}
+		p, err := peerCredentials(uc)
+		if err == errPeerCredentialsUnsupported && len(l.config.AllowedUIDs) == 0 && len(l.config.AllowedGIDs) == 0 {
+			// the socket is left to the permissions of its file
+			return c, nil
+		}
+		if err != nil {
+			logrus.WithError(err).Error("containerd: failed to read the credentials of a socket peer")
+			c.Close()
+			continue
+		}
+		if !l.config.allowed(p) {
+			logrus.WithFields(logrus.Fields{
+				"pid": p.PID,
+				"uid": p.UID,
+				"gid": p.GID,
+			}).Warn("containerd: rejected connection from disallowed socket peer")
+			c.Close()
+			continue
+		}
+		return &peerConn{
+			Conn: c,
+			addr: peerAddr{peer: p},
+		}, nil
+	}
+}
+
+// peerConn is a connection of the unix socket reporting the credentials of
+// its peer as its remote address.
+type peerConn struct {
+	net.Conn
+	addr peerAddr
+}
+
+func (c *peerConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// peerAddr identifies the peer of a unix socket connection, which has no
+// address of its own.
+type peerAddr struct {
+	peer identity.Peer
+}
+
+func (a peerAddr) Network() string {
+	return "unix"
+}
+
+func (a peerAddr) String() string {
+	return fmt.Sprintf("pid=%d,uid=%d,gid=%d", a.peer.PID, a.peer.UID, a.peer.GID)
+}
@@ -0,0 +1,22 @@
+// +build !windows
+
+package main
+
+import (
+	"net"
+
+	"github.com/docker/containerd/identity"
+	"github.com/docker/containerd/sys"
+)
+
+func peerCredentials(uc *net.UnixConn) (identity.Peer, error) {
+	cred, err := sys.GetPeerCredentials(uc)
+	if err != nil {
+		return identity.Peer{}, err
+	}
+	return identity.Peer{
+		PID: cred.Pid,
+		UID: cred.Uid,
+		GID: cred.Gid,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	introspectionapi "github.com/docker/containerd/api/introspection"
+	"github.com/docker/containerd/remotes"
+	"github.com/docker/containerd/tracing"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// reloadable are the settings of the configuration, by TOML name, applied
+// by a reload. The others are only applied when the daemon is restarted.
+var reloadable = map[string]bool{
+	"debug":      true,
+	"logLevels":  true,
+	"registries": true,
+}
+
+// reloader applies the configuration file again when the daemon receives
+// SIGHUP, leaving the containers untouched.
+type reloader struct {
+	context       *cli.Context
+	resolver      *remotes.Resolver
+	interceptor   *interceptor
+	introspection *introspectionService
+
+	mu     sync.Mutex
+	config *config
+}
+
+func (r *reloader) reload() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := &introspectionapi.Reload{
+		Timestamp: time.Now().UnixNano(),
+	}
+	defer r.introspection.setReload(result)
+
+	c, err := loadConfig(r.context.GlobalString("config"))
+	if err == nil {
+		err = c.applyFlags(r.context)
+	}
+	if err != nil {
+		result.Error = err.Error()
+		logrus.WithError(err).Error("containerd: failed to reload the configuration")
+		return
+	}
+	changed, ignored := diffConfig(r.config, c)
+	// the levels are applied even when unchanged, replacing those set
+	// through the debug API
+	if err := setLogLevels(c); err != nil {
+		result.Error = err.Error()
+		logrus.WithError(err).Error("containerd: failed to reload the configuration")
+		return
+	}
+	tracing.SetSampleAll(c.Debug.Trace)
+	r.interceptor.setLogRequests(c.Debug.LogRequests)
+	if err := r.resolver.SetHosts(c.Registries); err != nil {
+		result.Error = err.Error()
+		logrus.WithError(err).Error("containerd: failed to reload the registries")
+	}
+	result.Changed, result.Ignored = changed, ignored
+	r.config = c
+	logrus.WithFields(logrus.Fields{
+		"changed": strings.Join(changed, ","),
+		"ignored": strings.Join(ignored, ","),
+	}).Info("containerd: reloaded the configuration")
+	if len(ignored) > 0 {
+		logrus.WithField("settings", strings.Join(ignored, ",")).Warn("containerd: the daemon must be restarted for settings to apply")
+	}
+}
+
+// diffConfig returns the TOML names of the settings which differ between
+// prev and next, split between those a reload applies and the others.
+func diffConfig(prev, next *config) (changed, ignored []string) {
+	var (
+		pv = reflect.ValueOf(prev).Elem()
+		nv = reflect.ValueOf(next).Elem()
+		t  = pv.Type()
+	)
+	for i := 0; i < t.NumField(); i++ {
+		if reflect.DeepEqual(pv.Field(i).Interface(), nv.Field(i).Interface()) {
+			continue
+		}
+		name := strings.Split(t.Field(i).Tag.Get("toml"), ",")[0]
+		if reloadable[name] {
+			changed = append(changed, name)
+		} else {
+			ignored = append(ignored, name)
+		}
+	}
+	return changed, ignored
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"time"
+
+	"github.com/nats-io/go-nats"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	// eventWorkers is the number of goroutines posting the events, and
+	// eventQueueSize the number of events each of them queues.
+	eventWorkers   = 4
+	eventQueueSize = 1024
+)
+
+// stopGRPC stops accepting new requests and waits for those in flight to
+// complete. The requests still in flight once timeout expires are canceled.
+func stopGRPC(server *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		logrus.WithField("timeout", timeout).Warn("containerd: canceling the GRPC requests still in flight")
+		server.Stop()
+		<-done
+	}
+}
+
+// flushEvents waits for the events published to reach the server and for
+// those of sub to be handled, within timeout.
+func flushEvents(nc *nats.Conn, sub *nats.Subscription, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	if err := nc.FlushTimeout(timeout); err != nil {
+		return errors.Wrap(err, "failed to flush the events published")
+	}
+	for {
+		n, _, err := sub.Pending()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return errors.Errorf("%d events left unhandled", n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// createTCPListener listens on the address of the listener configuration,
+// serving connections over TLS unless the address is a loopback one and no
+// certificate is configured. The connections are probed on the keepalive
+// interval when positive, they are not when zero, and the default of the
+// system applies when negative.
+//
+// The callers are authenticated by their certificate, a listener without
+// client CA being refused unless it is insecure. The callers of a plaintext
+// listener are not identified, any local user may connect to it, so its
+// requests are read only unless an authorizer decides them.
+func createTCPListener(c listenerConfig, keepalive time.Duration, policy *listenerPolicy) (net.Listener, error) {
+	if c.TLS.ClientCA == "" && !c.Insecure {
+		return nil, errors.Errorf("listener %s: a client CA is required unless the listener is insecure", c.Address)
+	}
+	var certs *certReloader
+	if c.TLS.enabled() || !isLoopback(c.Address) {
+		var err error
+		if certs, err = newCertReloader(c.TLS); err != nil {
+			return nil, errors.Wrapf(err, "listener %s", c.Address)
+		}
+	} else if policy.authorizer == nil && !policy.readOnly {
+		logrus.WithField("address", c.Address).Warn("containerd: plaintext listener without authorization is read only")
+		policy.readOnly = true
+	}
+	l, err := net.Listen("tcp", c.Address)
+	if err != nil {
+		return nil, err
+	}
+	if keepalive >= 0 {
+		l = &keepaliveListener{TCPListener: l.(*net.TCPListener), period: keepalive}
+	}
+	l = &policyListener{Listener: l, policy: policy}
+	if certs == nil {
+		return l, nil
+	}
+	return tls.NewListener(l, &tls.Config{
+		GetConfigForClient: certs.configForClient,
+	}), nil
+}
+
+// keepaliveListener sets the TCP keepalive of the connections it accepts.
+type keepaliveListener struct {
+	*net.TCPListener
+	period time.Duration
+}
+
+func (l *keepaliveListener) Accept() (net.Conn, error) {
+	conn, err := l.AcceptTCP()
+	if err != nil {
+		return nil, err
+	}
+	if l.period == 0 {
+		conn.SetKeepAlive(false)
+		return conn, nil
+	}
+	conn.SetKeepAlive(true)
+	conn.SetKeepAlivePeriod(l.period)
+	return conn, nil
+}
+
+// certReloader provides the TLS configuration of a listener, loading the
+// certificates again when their files change so that they can be rotated
+// without restarting the daemon.
+type certReloader struct {
+	config tlsConfig
+
+	mu      sync.Mutex
+	modTime time.Time
+	tls     *tls.Config
+}
+
+func newCertReloader(c tlsConfig) (*certReloader, error) {
+	if c.Cert == "" || c.Key == "" {
+		return nil, errors.New("tls certificate and key must be provided")
+	}
+	r := &certReloader{config: c}
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(modTime); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// configForClient returns the configuration to handshake with a client,
+// reloading the certificates first when they changed. The previous
+// certificates are kept when the new ones fail to load, such as while they
+// are being replaced.
+func (r *certReloader) configForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	modTime, err := r.latestModTime()
+	if err == nil && modTime.After(r.modTime) {
+		err = r.load(modTime)
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("cert", r.config.Cert).Warn("containerd: failed to reload tls certificates")
+	}
+	return r.tls, nil
+}
+
+// load loads the certificates, requiring clients to present a certificate
+// signed by the client CA when one is configured.
+func (r *certReloader) load(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.config.Cert, r.config.Key)
+	if err != nil {
+		return errors.Wrap(err, "failed to load tls certificate")
+	}
+	c := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		// GRPC clients negotiate HTTP/2 through ALPN
+		NextProtos: []string{"h2"},
+	}
+	if r.config.ClientCA != "" {
+		data, err := ioutil.ReadFile(r.config.ClientCA)
+		if err != nil {
+			return errors.Wrap(err, "failed to read client CA")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return errors.Errorf("no certificates found in client CA %s", r.config.ClientCA)
+		}
+		c.ClientCAs = pool
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	r.tls = c
+	r.modTime = modTime
+	return nil
+}
+
+// latestModTime returns the time the certificate files were last changed.
+func (r *certReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, p := range []string{r.config.Cert, r.config.Key, r.config.ClientCA} {
+		if p == "" {
+			continue
+		}
+		fi, err := os.Stat(p)
+		if err != nil {
+			return latest, err
+		}
+		if fi.ModTime().After(latest) {
+			latest = fi.ModTime()
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert writes a self signed certificate for name and its key to dir,
+// returning their paths.
+func writeCert(t *testing.T, dir, name string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, keyPath := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err := ioutil.WriteFile(cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return cert, keyPath
+}
+
+// touch moves the modification time of the files forward, for their change
+// to be seen within the resolution of the filesystem.
+func touch(t *testing.T, at time.Time, paths ...string) {
+	for _, p := range paths {
+		if err := os.Chtimes(p, at, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func commonName(t *testing.T, c *tls.Config) string {
+	cert, err := x509.ParseCertificate(c.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert.Subject.CommonName
+}
+
+func TestCertReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containerd-tls-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := newCertReloader(tlsConfig{Cert: filepath.Join(dir, "cert.pem")}); err == nil {
+		t.Fatal("expected a certificate without key to be refused")
+	}
+	cert, key := writeCert(t, dir, "first")
+	r, err := newCertReloader(tlsConfig{Cert: cert, Key: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := r.configForClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := commonName(t, c); name != "first" {
+		t.Fatalf("expected the first certificate, got %s", name)
+	}
+	if c.ClientAuth != tls.NoClientCert {
+		t.Fatalf("expected no client certificate to be required without client CA, got %v", c.ClientAuth)
+	}
+
+	// rotated certificates are loaded by the next handshake
+	writeCert(t, dir, "second")
+	touch(t, time.Now().Add(time.Minute), cert, key)
+	if c, err = r.configForClient(nil); err != nil {
+		t.Fatal(err)
+	}
+	if name := commonName(t, c); name != "second" {
+		t.Fatalf("expected the rotated certificate, got %s", name)
+	}
+
+	// the previous certificates are kept while the new ones are invalid
+	if err := ioutil.WriteFile(key, []byte("partial"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	touch(t, time.Now().Add(2*time.Minute), key)
+	if c, err = r.configForClient(nil); err != nil {
+		t.Fatal(err)
+	}
+	if name := commonName(t, c); name != "second" {
+		t.Fatalf("expected the previous certificate to be kept, got %s", name)
+	}
+}
+
+func TestCertReloaderClientCA(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containerd-tls-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cert, key := writeCert(t, dir, "server")
+
+	empty := filepath.Join(dir, "empty.pem")
+	if err := ioutil.WriteFile(empty, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newCertReloader(tlsConfig{Cert: cert, Key: key, ClientCA: empty}); err == nil {
+		t.Fatal("expected a client CA without certificates to be refused")
+	}
+
+	r, err := newCertReloader(tlsConfig{Cert: cert, Key: key, ClientCA: cert})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := r.configForClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.ClientAuth != tls.RequireAndVerifyClientCert || c.ClientCAs == nil {
+		t.Fatalf("expected client certificates to be verified against the client CA, got %v", c.ClientAuth)
+	}
+}
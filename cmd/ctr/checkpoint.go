@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+
+	gocontext "context"
+
+	"github.com/docker/containerd/api/checkpoint"
+	"github.com/docker/containerd/api/execution"
+	"github.com/docker/go-units"
+	"github.com/urfave/cli"
+)
+
+var checkpointCommand = cli.Command{
+	Name:      "checkpoint",
+	Usage:     "checkpoint a running container into the content store of the daemon as an image, which is pushed and pulled as such",
+	ArgsUsage: "CONTAINER NAME",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "exit",
+			Usage: "stop the container once it is checkpointed",
+		},
+		cli.BoolFlag{
+			Name:  "tcp-established",
+			Usage: "checkpoint the established tcp connections of the container",
+		},
+		cli.BoolFlag{
+			Name:  "unix-sockets",
+			Usage: "checkpoint the external unix sockets of the container",
+		},
+		cli.BoolFlag{
+			Name:  "shell",
+			Usage: "checkpoint a shell job, a container attached to a terminal",
+		},
+		cli.StringSliceFlag{
+			Name:  "empty-ns",
+			Usage: "namespace not to restore with the container, such as network to join that of the bundle",
+		},
+		cli.UintFlag{
+			Name:  "pre-dumps",
+			Usage: "number of pre-dumps of the memory of the container taken while it runs, for it to be frozen for the memory changed since the last one only",
+		},
+		cli.StringFlag{
+			Name:  "pre-dump-threshold",
+			Usage: "stop the pre-dumps once one dumped less memory, such as 64MB",
+		},
+		cli.BoolFlag{
+			Name:  "rootfs",
+			Usage: "include the rootfs of the container in the checkpoint, as the changes of the snapshot named by its spec with ctr spec --snapshot or the whole tree, restored into that of the bundle of the restored container; requires --exit",
+		},
+		cli.StringFlag{
+			Name:  "snapshot",
+			Usage: "key of the active snapshot the rootfs of the container is mounted from, its changes being included in the checkpoint and applied over the rootfs of the restored container; requires --exit",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		if context.NArg() != 2 {
+			return fmt.Errorf("a container and the name of the checkpoint must be provided")
+		}
+		var (
+			threshold int64
+			err       error
+		)
+		if v := context.String("pre-dump-threshold"); v != "" {
+			if threshold, err = units.RAMInBytes(v); err != nil {
+				return err
+			}
+		}
+		checkpointService, err := getCheckpointService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := checkpointService.Checkpoint(gocontext.Background(), &checkpoint.CheckpointRequest{
+			ID:               context.Args().First(),
+			Name:             context.Args().Get(1),
+			Exit:             context.Bool("exit"),
+			TCPEstablished:   context.Bool("tcp-established"),
+			UnixSockets:      context.Bool("unix-sockets"),
+			Shell:            context.Bool("shell"),
+			EmptyNS:          context.StringSlice("empty-ns"),
+			Rootfs:           context.Bool("rootfs"),
+			Snapshot:         context.String("snapshot"),
+			PreDumps:         uint32(context.Uint("pre-dumps")),
+			PreDumpThreshold: threshold,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: %s\n", resp.Image.Name, resp.Image.Target.Digest)
+		return nil
+	},
+}
+
+// errRestoreTTY fails the containers restored from a checkpoint attached to a
+// terminal.
+// TODO: the shim does not pass a console socket to the runtime on restore.
+var errRestoreTTY = fmt.Errorf("restored containers cannot be attached to a terminal")
+
+var restoreCommand = cli.Command{
+	Name:      "restore",
+	Usage:     "restore a container from a checkpoint of the image store, attached to its io until it exits with its exit status, the bundle being created from the checkpoint when it does not exist",
+	ArgsUsage: "CONTAINER CHECKPOINT",
+	Flags:     runCommand.Flags,
+	Action: func(context *cli.Context) error {
+		if context.NArg() != 2 {
+			return fmt.Errorf("a container and a checkpoint must be provided")
+		}
+		if context.Bool("tty") {
+			return errRestoreTTY
+		}
+		checkpointService, err := getCheckpointService(context)
+		if err != nil {
+			return err
+		}
+		return runContainer(context, func(r *execution.CreateContainerRequest) (*execution.CreateContainerResponse, error) {
+			return checkpointService.Restore(gocontext.Background(), &checkpoint.RestoreRequest{
+				Name:      context.Args().Get(1),
+				Container: r,
+			})
+		})
+	},
+}
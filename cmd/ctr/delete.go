@@ -3,8 +3,10 @@ package main
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
 	"github.com/docker/containerd/api/execution"
+	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 )
 
@@ -16,6 +18,15 @@ var deleteCommand = cli.Command{
 			Name:  "pid, p",
 			Usage: "new process id",
 		},
+		cli.BoolFlag{
+			Name:  "force, f",
+			Usage: "stop the container if it is running, sending SIGKILL once the timeout is over, and remove the bundle and snapshot of a container run from an image",
+		},
+		cli.DurationFlag{
+			Name:  "timeout, t",
+			Usage: "time to wait for the container to exit on SIGTERM with --force",
+			Value: 10 * time.Second,
+		},
 	},
 	Action: func(context *cli.Context) error {
 		executionService, err := getExecutionService(context)
@@ -39,12 +50,34 @@ var deleteCommand = cli.Command{
 			}
 		}
 
+		// the bundle created by ctr run for a container run from an image
+		// is removed along with the container, should the run be gone
+		var bundle *imageBundle
+		if context.Bool("force") {
+			resp, err := executionService.Get(gocontext.Background(), &execution.GetContainerRequest{
+				ID: id,
+			})
+			if err != nil {
+				return err
+			}
+			if bundle, err = loadImageBundle(context, resp.Container.BundlePath); err != nil {
+				return err
+			}
+		}
+
 		if _, err := executionService.Delete(gocontext.Background(), &execution.DeleteContainerRequest{
-			ID: id,
+			ID:      id,
+			Force:   context.Bool("force"),
+			Timeout: int64(context.Duration("timeout")),
 		}); err != nil {
 			return err
 		}
 
+		if bundle != nil {
+			if err := bundle.remove(); err != nil {
+				return errors.Wrapf(err, "failed to remove the bundle of %s", id)
+			}
+		}
 		return nil
 	},
 }
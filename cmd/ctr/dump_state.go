@@ -0,0 +1,68 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/containerd/api/debug"
+	"github.com/urfave/cli"
+)
+
+var dumpStateCommand = cli.Command{
+	Name:  "dump-state",
+	Usage: "dump the daemon's view of the containers, processes, ingests and event subscribers of the namespace",
+	Flags: []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		debugService, err := getDebugService(context)
+		if err != nil {
+			return err
+		}
+
+		resp, err := debugService.DumpState(gocontext.Background(), &debug.DumpStateRequest{})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(context, resp, func(w io.Writer) {
+			fmt.Fprintln(w, "NAMESPACE\tCONTAINER\tRUNTIME\tSTATUS\tMONITORS\tCGROUP PIDS\tERROR")
+			for _, c := range resp.Containers {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n", c.Namespace, c.ID, c.Runtime, c.Status, c.Monitors, formatPids(c.CgroupPids), c.Error)
+			}
+			fmt.Fprintln(w, "\nNAMESPACE\tCONTAINER\tPROCESS\tPID\tSTATUS\tALIVE")
+			for _, c := range resp.Containers {
+				for _, p := range c.Processes {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%t\n", c.Namespace, c.ID, p.ID, p.Pid, p.Status, p.Alive)
+				}
+			}
+			if len(resp.Ingests) > 0 {
+				fmt.Fprintln(w, "\nINGEST\tWRITTEN")
+				for _, i := range resp.Ingests {
+					fmt.Fprintf(w, "%s\t%d\n", i.Ref, i.Written)
+				}
+			}
+			if len(resp.Subscribers) > 0 {
+				fmt.Fprintln(w, "\nSUBSCRIBER\tQUEUED\tCAPACITY")
+				for _, s := range resp.Subscribers {
+					filter := s.Filter
+					if filter == "" {
+						filter = "*"
+					}
+					fmt.Fprintf(w, "%s\t%d\t%d\n", filter, s.Queued, s.Capacity)
+				}
+			}
+		})
+	},
+}
+
+func formatPids(pids []int64) string {
+	if pids == nil {
+		return "-"
+	}
+	s := make([]string, len(pids))
+	for i, pid := range pids {
+		s[i] = fmt.Sprint(pid)
+	}
+	return strings.Join(s, ",")
+}
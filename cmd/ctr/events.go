@@ -1,61 +1,105 @@
 package main
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"time"
 
-	"github.com/nats-io/go-nats"
+	gocontext "context"
+
+	"github.com/docker/containerd/api/debug"
 	"github.com/urfave/cli"
 )
 
 var eventsCommand = cli.Command{
 	Name:  "events",
-	Usage: "display containerd events",
+	Usage: "display containerd events as they are published",
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  "subject, s",
-			Usage: "subjects filter",
-			Value: "containerd.>",
+			Name:  "filter, f",
+			Usage: "glob of the topics to display, such as containerd.execution.container.*",
+		},
+		cli.StringFlag{
+			Name:  "since",
+			Usage: "replay the events of the daemon's journal since a duration ago, such as 10m, or an RFC 3339 time",
+		},
+		cli.StringFlag{
+			Name:  "output, o",
+			Usage: "output format: text, json, or go-template=TEMPLATE executed on each event, such as {{.Topic}}",
+			Value: "text",
 		},
 	},
 	Action: func(context *cli.Context) error {
-		nc, err := nats.Connect(nats.DefaultURL)
+		since, err := parseSince(context.String("since"))
 		if err != nil {
 			return err
 		}
-		nec, err := nats.NewEncodedConn(nc, nats.JSON_ENCODER)
+		output := context.String("output")
+		tmpl, err := parseTemplate(output)
 		if err != nil {
-			nc.Close()
 			return err
 		}
-		defer nec.Close()
-
-		evCh := make(chan *nats.Msg, 64)
-		sub, err := nec.Subscribe(context.String("subject"), func(e *nats.Msg) {
-			evCh <- e
+		if output != "text" && output != "json" && tmpl == nil {
+			return fmt.Errorf("unknown output format %q", output)
+		}
+		debugService, err := getDebugService(context)
+		if err != nil {
+			return err
+		}
+		stream, err := debugService.Events(gocontext.Background(), &debug.EventsRequest{
+			Filter: context.String("filter"),
+			Since:  since,
 		})
 		if err != nil {
 			return err
 		}
-		defer sub.Unsubscribe()
-
 		for {
-			e, more := <-evCh
-			if !more {
-				break
-			}
-
-			var prettyJSON bytes.Buffer
-
-			err := json.Indent(&prettyJSON, e.Data, "", "\t")
+			e, err := stream.Recv()
 			if err != nil {
-				fmt.Println(string(e.Data))
-			} else {
-				fmt.Println(prettyJSON.String())
+				if err == io.EOF {
+					return nil
+				}
+				return err
 			}
+			timestamp := time.Unix(0, e.Timestamp)
+			event := struct {
+				Timestamp time.Time       `json:"timestamp"`
+				Topic     string          `json:"topic"`
+				Event     json.RawMessage `json:"event"`
+			}{timestamp, e.Topic, e.Data}
+			switch {
+			case output == "json":
+				data, err := json.Marshal(event)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(data))
+				continue
+			case tmpl != nil:
+				if err := executeTemplate(os.Stdout, tmpl, event); err != nil {
+					return err
+				}
+				continue
+			}
+			fmt.Printf("%s %s %s\n", timestamp.Format(time.RFC3339Nano), e.Topic, e.Data)
 		}
-
-		return nil
 	},
 }
+
+// parseSince returns the time in nanoseconds since the unix epoch of a
+// duration ago or of an RFC 3339 time, zero when since is empty.
+func parseSince(since string) (int64, error) {
+	if since == "" {
+		return 0, nil
+	}
+	if d, err := time.ParseDuration(since); err == nil {
+		return time.Now().Add(-d).UnixNano(), nil
+	}
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return 0, fmt.Errorf("invalid since %q, expected a duration or an RFC 3339 time", since)
+	}
+	return t.UnixNano(), nil
+}
@@ -1,18 +1,17 @@
 package main
 
 import (
-	"os"
-	"path/filepath"
-
 	gocontext "context"
+	"os"
 
 	"github.com/docker/containerd/api/execution"
+	"github.com/docker/docker/pkg/term"
 	"github.com/urfave/cli"
 )
 
 var execCommand = cli.Command{
 	Name:  "exec",
-	Usage: "exec a new process in a running container",
+	Usage: "exec a new process in a running container, attached to its io until it exits with its exit status",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  "id, i",
@@ -35,6 +34,26 @@ var execCommand = cli.Command{
 			Value: &cli.StringSlice{},
 			Usage: "environment variables for the process",
 		},
+		cli.StringSliceFlag{
+			Name:  "cap-add",
+			Usage: "capability to add to those of the container",
+		},
+		cli.StringSliceFlag{
+			Name:  "cap-drop",
+			Usage: "capability to drop from those of the container, ALL to drop all of them",
+		},
+		cli.BoolFlag{
+			Name:  "privileged",
+			Usage: "grant all capabilities to the process",
+		},
+		cli.StringFlag{
+			Name:  "apparmor",
+			Usage: "apparmor profile of the process, the container's profile when unset",
+		},
+		cli.StringFlag{
+			Name:  "new-privileges",
+			Usage: "allow or deny the process gaining privileges through setuid binaries, as the container's init process when unset",
+		},
 	},
 	Action: func(context *cli.Context) error {
 		executionService, err := getExecutionService(context)
@@ -43,48 +62,78 @@ var execCommand = cli.Command{
 		}
 
 		id := context.String("id")
-		tmpDir, err := getTempDir(id)
+		fifos, attach, err := prepareStdio(id, context.Bool("tty"))
 		if err != nil {
 			return err
 		}
-		defer os.RemoveAll(tmpDir)
+		defer fifos.Close()
+		defer attach.Close()
 
+		newPrivileges, err := parseNewPrivileges(context.String("new-privileges"))
+		if err != nil {
+			return err
+		}
 		sOpts := &execution.StartProcessRequest{
 			ContainerID: id,
 			Process: &execution.Process{
-				ID:       context.String("pid"),
-				Cwd:      context.String("cwd"),
-				Terminal: context.Bool("tty"),
-				Args:     context.Args(),
-				Env:      context.StringSlice("env"),
+				ID:              context.String("pid"),
+				Cwd:             context.String("cwd"),
+				Terminal:        context.Bool("tty"),
+				Args:            context.Args(),
+				Env:             context.StringSlice("env"),
+				ApparmorProfile: context.String("apparmor"),
 			},
-			Stdin:   filepath.Join(tmpDir, "stdin"),
-			Stdout:  filepath.Join(tmpDir, "stdout"),
-			Stderr:  filepath.Join(tmpDir, "stderr"),
-			Console: context.Bool("tty"),
+			Stdin:         fifos.Stdin,
+			Stdout:        fifos.Stdout,
+			Stderr:        fifos.Stderr,
+			Console:       context.Bool("tty"),
+			CapAdd:        context.StringSlice("cap-add"),
+			CapDrop:       context.StringSlice("cap-drop"),
+			Privileged:    context.Bool("privileged"),
+			NewPrivileges: newPrivileges,
 		}
 
-		fwg, err := prepareStdio(sOpts.Stdin, sOpts.Stdout, sOpts.Stderr, sOpts.Console)
+		exits, err := subscribeExits(context.GlobalString("namespace"))
 		if err != nil {
 			return err
 		}
+		defer exits.Close()
+
+		if sOpts.Console {
+			oldState, err := term.SetRawTerminal(os.Stdin.Fd())
+			if err != nil {
+				return err
+			}
+			defer term.RestoreTerminal(os.Stdin.Fd(), oldState)
+		}
 
 		sr, err := executionService.StartProcess(gocontext.Background(), sOpts)
 		if err != nil {
 			return err
 		}
+		pid := sr.Process.ID
+		if sOpts.Console {
+			defer forwardResize(executionService, id, pid)()
+		}
+		ec, err := exits.wait(executionService, id, pid)
+		if err != nil {
+			return err
+		}
 
 		_, err = executionService.DeleteProcess(gocontext.Background(), &execution.DeleteProcessRequest{
 			ContainerID: id,
-			ProcessID:   sr.Process.ID,
+			ProcessID:   pid,
 		})
 		if err != nil {
 			return err
 		}
 
 		// Ensure we read all io
-		fwg.Wait()
+		attach.Wait()
 
+		if ec != 0 {
+			return cli.NewExitError("", int(ec))
+		}
 		return nil
 	},
 }
@@ -0,0 +1,113 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/containerd/api/gc"
+	"github.com/docker/go-units"
+	"github.com/urfave/cli"
+)
+
+var gcCommand = cli.Command{
+	Name:  "gc",
+	Usage: "control the garbage collector of the daemon",
+	Subcommands: []cli.Command{
+		gcCollectCommand,
+		gcReportCommand,
+		gcPauseCommand,
+		gcResumeCommand,
+	},
+}
+
+var gcCollectCommand = cli.Command{
+	Name:  "collect",
+	Usage: "remove the content and committed snapshots referenced by no container, image, lease or active snapshot",
+	Flags: []cli.Flag{
+		outputFlag,
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "display what would be removed without removing anything",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		gcService, err := getGCService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := gcService.Collect(gocontext.Background(), &gc.CollectRequest{
+			DryRun: context.Bool("dry-run"),
+		})
+		if err != nil {
+			return err
+		}
+		return printOutput(context, resp, func(w io.Writer) {
+			fmt.Fprintln(w, "TYPE\tID\tSIZE")
+			for _, r := range resp.Removed {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", r.Type, r.ID, units.HumanSize(float64(r.Size_)))
+			}
+			fmt.Fprintf(w, "\n%d of %d resources removed, %s reclaimed\n", len(resp.Removed), resp.Scanned, units.HumanSize(float64(resp.Reclaimed)))
+		})
+	},
+}
+
+var gcReportCommand = cli.Command{
+	Name:  "report",
+	Usage: "display what a collection would remove without removing anything, as of the last dry run of the daemon",
+	Flags: []cli.Flag{
+		outputFlag,
+	},
+	Action: func(context *cli.Context) error {
+		gcService, err := getGCService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := gcService.Report(gocontext.Background(), &gc.ReportRequest{})
+		if err != nil {
+			return err
+		}
+		return printOutput(context, resp, func(w io.Writer) {
+			fmt.Fprintln(w, "TYPE\tID\tSIZE")
+			for _, r := range resp.Removable {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", r.Type, r.ID, units.HumanSize(float64(r.Size_)))
+			}
+			fmt.Fprintf(w, "\n%d of %d resources removable, %s reclaimable, as of %s\n", len(resp.Removable), resp.Scanned, units.HumanSize(float64(resp.Reclaimable)), time.Unix(0, resp.Timestamp).Format(time.RFC3339))
+		})
+	},
+}
+
+var gcPauseCommand = cli.Command{
+	Name:  "pause",
+	Usage: "interrupt the running collections and defer the next ones",
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name:  "duration",
+			Usage: "time after which the collections resume, the default of the daemon when zero",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		gcService, err := getGCService(context)
+		if err != nil {
+			return err
+		}
+		_, err = gcService.Pause(gocontext.Background(), &gc.PauseRequest{
+			Duration: int64(context.Duration("duration")),
+		})
+		return err
+	},
+}
+
+var gcResumeCommand = cli.Command{
+	Name:  "resume",
+	Usage: "let the collections due run again",
+	Action: func(context *cli.Context) error {
+		gcService, err := getGCService(context)
+		if err != nil {
+			return err
+		}
+		_, err = gcService.Resume(gocontext.Background(), &gc.ResumeRequest{})
+		return err
+	},
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	gocontext "context"
+
+	api "github.com/docker/containerd/api/images"
+	"github.com/docker/containerd/images"
+	"github.com/docker/go-units"
+	"github.com/opencontainers/go-digest"
+	"github.com/urfave/cli"
+)
+
+var imagesCommand = cli.Command{
+	Name:  "images",
+	Usage: "manage the images of the image store",
+	Subcommands: []cli.Command{
+		imagesListCommand,
+		imagesRemoveCommand,
+		imagesTagCommand,
+		imagesInspectCommand,
+		imagesImportCommand,
+		imagesExportCommand,
+	},
+}
+
+var imagesListCommand = cli.Command{
+	Name:    "list",
+	Aliases: []string{"ls"},
+	Usage:   "list the images",
+	Flags: []cli.Flag{
+		outputFlag,
+		cli.BoolFlag{
+			Name:  "quiet, q",
+			Usage: "print the names of the images only",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		imagesService, err := getImagesService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := imagesService.List(gocontext.Background(), &api.ListImagesRequest{})
+		if err != nil {
+			return err
+		}
+		if context.Bool("quiet") {
+			for _, image := range resp.Images {
+				fmt.Println(image.Name)
+			}
+			return nil
+		}
+		return printOutput(context, resp.Images, func(w io.Writer) {
+			fmt.Fprintln(w, "NAME\tTYPE\tDIGEST\tSIZE")
+			for _, image := range resp.Images {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", image.Name, image.Target.MediaType, image.Target.Digest, units.HumanSize(float64(image.Target.Size_)))
+			}
+		})
+	},
+}
+
+var imagesRemoveCommand = cli.Command{
+	Name:      "remove",
+	Aliases:   []string{"rm"},
+	Usage:     "remove names of images, their content is left in the content store",
+	ArgsUsage: "IMAGE [IMAGE...]",
+	Action: func(context *cli.Context) error {
+		if context.NArg() == 0 {
+			return fmt.Errorf("at least one image must be provided")
+		}
+		imagesService, err := getImagesService(context)
+		if err != nil {
+			return err
+		}
+		var failed []string
+		for _, name := range context.Args() {
+			if _, err := imagesService.Untag(gocontext.Background(), &api.UntagImageRequest{
+				Name: name,
+			}); err != nil {
+				fmt.Fprintf(os.Stderr, "ctr: failed to remove %s: %v\n", name, err)
+				failed = append(failed, name)
+				continue
+			}
+			fmt.Println(name)
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to remove %s", strings.Join(failed, ", "))
+		}
+		return nil
+	},
+}
+
+var imagesTagCommand = cli.Command{
+	Name:      "tag",
+	Usage:     "name the target of an image, or a digest, with an additional name",
+	ArgsUsage: "SOURCE NAME",
+	Action: func(context *cli.Context) error {
+		if context.NArg() != 2 {
+			return fmt.Errorf("a source and a name must be provided")
+		}
+		imagesService, err := getImagesService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := imagesService.Tag(gocontext.Background(), &api.TagImageRequest{
+			Source: context.Args().Get(0),
+			Name:   context.Args().Get(1),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: %s\n", resp.Image.Name, resp.Image.Target.Digest)
+		return nil
+	},
+}
+
+var imagesInspectCommand = cli.Command{
+	Name:      "inspect",
+	Usage:     "display the config and layers of the manifest of an image for the platform of the daemon",
+	ArgsUsage: "IMAGE",
+	Flags:     []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		imagesService, err := getImagesService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := imagesService.Inspect(gocontext.Background(), &api.InspectImageRequest{
+			Name: context.Args().First(),
+		})
+		if err != nil {
+			return err
+		}
+		return printOutput(context, resp, func(w io.Writer) {
+			config := resp.Config
+			fmt.Fprintf(w, "Name:\t%s\n", resp.Image.Name)
+			fmt.Fprintf(w, "Target:\t%s\n", resp.Image.Target.Digest)
+			fmt.Fprintf(w, "Manifest:\t%s\n", resp.Manifest.Digest)
+			fmt.Fprintf(w, "Platform:\t%s/%s\n", config.OS, config.Architecture)
+			fmt.Fprintf(w, "Entrypoint:\t%s\n", strings.Join(config.Entrypoint, " "))
+			fmt.Fprintf(w, "Cmd:\t%s\n", strings.Join(config.Cmd, " "))
+			fmt.Fprintf(w, "WorkingDir:\t%s\n", config.WorkingDir)
+			fmt.Fprintf(w, "User:\t%s\n", config.User)
+			for _, env := range config.Env {
+				fmt.Fprintf(w, "Env:\t%s\n", env)
+			}
+			for _, l := range config.Labels {
+				fmt.Fprintf(w, "Label:\t%s=%s\n", l.Key, l.Value)
+			}
+			fmt.Fprintf(w, "Unpacked:\t%t\n", resp.Unpacked)
+			fmt.Fprintln(w, "\nLAYER\tSIZE\tDIFF ID\tUNPACKED")
+			for _, l := range resp.Layers {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", l.Blob.Digest, units.HumanSize(float64(l.Blob.Size_)), l.DiffID, l.Unpacked)
+			}
+		})
+	},
+}
+
+var imagesImportCommand = cli.Command{
+	Name:      "import",
+	Usage:     "import the images of a tar archive of an OCI image layout, '-' reads stdin",
+	ArgsUsage: "FILE",
+	Action: func(context *cli.Context) error {
+		path := context.Args().First()
+		if path == "" {
+			return fmt.Errorf("the archive must be provided")
+		}
+		var r io.Reader = os.Stdin
+		if path != "-" {
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			r = f
+		}
+		cs, err := getContentStore(context)
+		if err != nil {
+			return err
+		}
+		imagesService, err := getImagesService(context)
+		if err != nil {
+			return err
+		}
+		// the blobs are not collected before the images are named
+		release, err := cs.Hold()
+		if err != nil {
+			return err
+		}
+		defer release()
+		imported, err := images.Import(cs, r)
+		if err != nil {
+			return err
+		}
+		if len(imported) == 0 {
+			return fmt.Errorf("the archive has no named image")
+		}
+		for _, image := range imported {
+			if _, err := imagesService.Put(gocontext.Background(), &api.PutImageRequest{
+				Image: &api.Image{
+					Name: image.Name,
+					Target: &api.Descriptor{
+						MediaType: image.Target.MediaType,
+						Digest:    image.Target.Digest.String(),
+						Size_:     image.Target.Size,
+					},
+				},
+			}); err != nil {
+				return err
+			}
+			fmt.Printf("%s: %s\n", image.Name, image.Target.Digest)
+		}
+		return nil
+	},
+}
+
+var imagesExportCommand = cli.Command{
+	Name:      "export",
+	Usage:     "export images as a tar archive of an OCI image layout, '-' writes stdout",
+	ArgsUsage: "FILE IMAGE [IMAGE...]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "platform",
+			Usage: "platform of the manifests exported from indexes as os/architecture[/variant], that of ctr when unset",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		if context.NArg() < 2 {
+			return fmt.Errorf("the archive and at least one image must be provided")
+		}
+		platform, err := parsePlatform(context.String("platform"))
+		if err != nil {
+			return err
+		}
+		imagesService, err := getImagesService(context)
+		if err != nil {
+			return err
+		}
+		var exported []images.Image
+		for _, name := range context.Args()[1:] {
+			resp, err := imagesService.Get(gocontext.Background(), &api.GetImageRequest{
+				Name: name,
+			})
+			if err != nil {
+				return err
+			}
+			dgst, err := digest.Parse(resp.Image.Target.Digest)
+			if err != nil {
+				return err
+			}
+			exported = append(exported, images.Image{
+				Name: resp.Image.Name,
+				Target: images.Descriptor{
+					MediaType: resp.Image.Target.MediaType,
+					Digest:    dgst,
+					Size:      resp.Image.Target.Size_,
+				},
+			})
+		}
+		cs, err := getContentStore(context)
+		if err != nil {
+			return err
+		}
+		path := context.Args().First()
+		if path == "-" {
+			return images.Export(cs, os.Stdout, exported, platform)
+		}
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		if err := images.Export(cs, f, exported, platform); err != nil {
+			f.Close()
+			os.Remove(path)
+			return err
+		}
+		return f.Close()
+	},
+}
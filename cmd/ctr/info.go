@@ -0,0 +1,49 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/docker/containerd/api/introspection"
+	"github.com/urfave/cli"
+)
+
+var infoCommand = cli.Command{
+	Name:  "info",
+	Usage: "print the version and components of the daemon",
+	Flags: []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		introspectionService, err := getIntrospectionService(context)
+		if err != nil {
+			return err
+		}
+
+		resp, err := introspectionService.Info(gocontext.Background(), &introspection.InfoRequest{})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(context, resp, func(w io.Writer) {
+			fmt.Fprintf(w, "Version:\t%s\nRevision:\t%s\n\n", resp.Version, resp.Revision)
+			fmt.Fprintln(w, "TYPE\tID\tSTATUS")
+			for _, c := range resp.Components {
+				status := "ok"
+				if c.Error != "" {
+					status = c.Error
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", c.Type, c.ID, status)
+			}
+			if r := resp.LastReload; r != nil {
+				fmt.Fprintf(w, "\nLast reload:\t%s\n", time.Unix(0, r.Timestamp).Format(time.RFC3339))
+				if r.Error != "" {
+					fmt.Fprintf(w, "Error:\t%s\n", r.Error)
+				}
+				fmt.Fprintf(w, "Changed:\t%s\n", strings.Join(r.Changed, ", "))
+				fmt.Fprintf(w, "Ignored:\t%s\n", strings.Join(r.Ignored, ", "))
+			}
+		})
+	},
+}
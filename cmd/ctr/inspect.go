@@ -0,0 +1,119 @@
+package main
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docker/containerd/api/execution"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/urfave/cli"
+)
+
+// containerInspect merges the state of a container kept by the daemon into
+// a single document.
+type containerInspect struct {
+	ID      string   `json:"id"`
+	Status  string   `json:"status"`
+	Bundle  string   `json:"bundle"`
+	Sandbox string   `json:"sandbox,omitempty"`
+	IPs     []string `json:"ips,omitempty"`
+	// Labels are the annotations of the spec.
+	Labels        map[string]string    `json:"labels,omitempty"`
+	Processes     []inspectProcess     `json:"processes"`
+	HostProcesses []inspectHostProcess `json:"hostProcesses,omitempty"`
+	Spec          *specs.Spec          `json:"spec"`
+}
+
+type inspectProcess struct {
+	ID         string   `json:"id"`
+	Pid        int64    `json:"pid"`
+	Args       []string `json:"args"`
+	ExitStatus uint32   `json:"exitStatus"`
+	Stdin      string   `json:"stdin,omitempty"`
+	Stdout     string   `json:"stdout,omitempty"`
+	Stderr     string   `json:"stderr,omitempty"`
+}
+
+type inspectHostProcess struct {
+	Pid       int64    `json:"pid"`
+	Args      []string `json:"args"`
+	ProcessID string   `json:"processId,omitempty"`
+}
+
+var inspectCommand = cli.Command{
+	Name:      "inspect",
+	Usage:     "display the record, spec, processes and stdio of a container as json",
+	ArgsUsage: "CONTAINER",
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("container id must be provided")
+		}
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+		ctx := gocontext.Background()
+		get, err := executionService.Get(ctx, &execution.GetContainerRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+		inspect, err := executionService.Inspect(ctx, &execution.InspectContainerRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+		processes, err := listProcesses(ctx, executionService, id)
+		if err != nil {
+			return err
+		}
+
+		c := get.Container
+		doc := containerInspect{
+			ID:        c.ID,
+			Status:    strings.ToLower(c.Status.String()),
+			Bundle:    c.BundlePath,
+			Sandbox:   c.Sandbox,
+			IPs:       c.IPs,
+			Processes: []inspectProcess{},
+		}
+		if err := json.Unmarshal(inspect.Spec, &doc.Spec); err != nil {
+			return fmt.Errorf("invalid spec of container %s: %v", id, err)
+		}
+		if doc.Spec != nil {
+			doc.Labels = doc.Spec.Annotations
+		}
+		stdio := make(map[string]*execution.ProcessStdio)
+		for _, s := range inspect.Stdio {
+			stdio[s.ProcessID] = s
+		}
+		for _, p := range processes.Processes {
+			ip := inspectProcess{
+				ID:         p.ID,
+				Pid:        p.Pid,
+				Args:       p.Args,
+				ExitStatus: p.ExitStatus,
+			}
+			if s, ok := stdio[p.ID]; ok {
+				ip.Stdin, ip.Stdout, ip.Stderr = s.Stdin, s.Stdout, s.Stderr
+			}
+			doc.Processes = append(doc.Processes, ip)
+		}
+		for _, p := range processes.HostProcesses {
+			doc.HostProcesses = append(doc.HostProcesses, inspectHostProcess{
+				Pid:       p.Pid,
+				Args:      p.Args,
+				ProcessID: p.ProcessID,
+			})
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(doc)
+	},
+}
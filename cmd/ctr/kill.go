@@ -0,0 +1,107 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/urfave/cli"
+)
+
+var signals = map[string]syscall.Signal{
+	"ABRT":   syscall.SIGABRT,
+	"ALRM":   syscall.SIGALRM,
+	"BUS":    syscall.SIGBUS,
+	"CHLD":   syscall.SIGCHLD,
+	"CONT":   syscall.SIGCONT,
+	"FPE":    syscall.SIGFPE,
+	"HUP":    syscall.SIGHUP,
+	"ILL":    syscall.SIGILL,
+	"INT":    syscall.SIGINT,
+	"IO":     syscall.SIGIO,
+	"KILL":   syscall.SIGKILL,
+	"PIPE":   syscall.SIGPIPE,
+	"PROF":   syscall.SIGPROF,
+	"PWR":    syscall.SIGPWR,
+	"QUIT":   syscall.SIGQUIT,
+	"SEGV":   syscall.SIGSEGV,
+	"STOP":   syscall.SIGSTOP,
+	"SYS":    syscall.SIGSYS,
+	"TERM":   syscall.SIGTERM,
+	"TRAP":   syscall.SIGTRAP,
+	"TSTP":   syscall.SIGTSTP,
+	"TTIN":   syscall.SIGTTIN,
+	"TTOU":   syscall.SIGTTOU,
+	"URG":    syscall.SIGURG,
+	"USR1":   syscall.SIGUSR1,
+	"USR2":   syscall.SIGUSR2,
+	"VTALRM": syscall.SIGVTALRM,
+	"WINCH":  syscall.SIGWINCH,
+	"XCPU":   syscall.SIGXCPU,
+	"XFSZ":   syscall.SIGXFSZ,
+}
+
+// parseSignal parses a signal number or name, with or without the SIG
+// prefix.
+func parseSignal(s string) (syscall.Signal, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		if n <= 0 || n > 64 {
+			return 0, fmt.Errorf("invalid signal number %d", n)
+		}
+		return syscall.Signal(n), nil
+	}
+	sig, ok := signals[strings.TrimPrefix(strings.ToUpper(s), "SIG")]
+	if !ok {
+		return 0, fmt.Errorf("unknown signal %q", s)
+	}
+	return sig, nil
+}
+
+var killCommand = cli.Command{
+	Name:      "kill",
+	Usage:     "signal the init process of a container",
+	ArgsUsage: "CONTAINER",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "signal, s",
+			Usage: "signal to send, as a name such as SIGTERM or a number",
+			Value: "SIGTERM",
+		},
+		cli.StringFlag{
+			Name:  "pid, p",
+			Usage: "process to signal instead of the init process",
+			Value: "init",
+		},
+		cli.BoolFlag{
+			Name:  "all, a",
+			Usage: "signal all the processes of the container",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("container id must be provided")
+		}
+		if context.Bool("all") && context.IsSet("pid") {
+			return fmt.Errorf("--all and --pid cannot be used together")
+		}
+		sig, err := parseSignal(context.String("signal"))
+		if err != nil {
+			return err
+		}
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+		_, err = executionService.SignalProcess(gocontext.Background(), &execution.SignalProcessRequest{
+			ContainerID: id,
+			ProcessID:   context.String("pid"),
+			Signal:      uint32(sig),
+			All:         context.Bool("all"),
+		})
+		return err
+	},
+}
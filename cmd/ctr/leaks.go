@@ -0,0 +1,44 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+
+	"github.com/docker/containerd/api/debug"
+	"github.com/urfave/cli"
+)
+
+var leaksCommand = cli.Command{
+	Name:  "leaks",
+	Usage: "list the goroutines monitoring containers and the fds open in the daemon, along with those leaked",
+	Flags: []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		debugService, err := getDebugService(context)
+		if err != nil {
+			return err
+		}
+
+		resp, err := debugService.Leaks(gocontext.Background(), &debug.LeaksRequest{})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(context, resp, func(w io.Writer) {
+			fmt.Fprintln(w, "CONTAINER\tGOROUTINES\tLEAKED")
+			for _, m := range resp.Monitors {
+				fmt.Fprintf(w, "%s\t%d\t%t\n", m.ContainerID, m.Goroutines, m.Deleted)
+			}
+			fmt.Fprintln(w, "\nFD TYPE\tCOUNT")
+			for _, fd := range resp.Fds {
+				fmt.Fprintf(w, "%s\t%d\n", fd.Type, fd.Count)
+			}
+			if len(resp.LeakedFifos) > 0 {
+				fmt.Fprintln(w, "\nLEAKED FIFO\tCONTAINER")
+				for _, f := range resp.LeakedFifos {
+					fmt.Fprintf(w, "%s\t%s\n", f.Path, f.ContainerID)
+				}
+			}
+		})
+	},
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/docker/containerd/api/leases"
+	"github.com/urfave/cli"
+)
+
+var leasesCommand = cli.Command{
+	Name:  "leases",
+	Usage: "manage the leases pinning content, snapshots and images against garbage collection",
+	Subcommands: []cli.Command{
+		leasesCreateCommand,
+		leasesRenewCommand,
+		leasesDeleteCommand,
+		leasesListCommand,
+		leasesAddCommand,
+		leasesRemoveCommand,
+	},
+}
+
+var leasesCreateCommand = cli.Command{
+	Name:      "create",
+	Usage:     "create a lease",
+	ArgsUsage: "ID",
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name:  "ttl",
+			Usage: "time after which the lease expires, it never does when zero",
+			Value: 24 * time.Hour,
+		},
+	},
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("lease id must be provided")
+		}
+		leaseService, err := getLeaseService(context)
+		if err != nil {
+			return err
+		}
+		_, err = leaseService.Create(gocontext.Background(), &leases.CreateLeaseRequest{
+			ID:  id,
+			TTL: int64(context.Duration("ttl")),
+		})
+		return err
+	},
+}
+
+var leasesRenewCommand = cli.Command{
+	Name:      "renew",
+	Usage:     "extend a lease to expire after its ttl from now",
+	ArgsUsage: "ID",
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name:  "ttl",
+			Usage: "time after which the lease expires, it never does when zero",
+			Value: 24 * time.Hour,
+		},
+	},
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("lease id must be provided")
+		}
+		leaseService, err := getLeaseService(context)
+		if err != nil {
+			return err
+		}
+		_, err = leaseService.Renew(gocontext.Background(), &leases.RenewLeaseRequest{
+			ID:  id,
+			TTL: int64(context.Duration("ttl")),
+		})
+		return err
+	},
+}
+
+var leasesDeleteCommand = cli.Command{
+	Name:      "delete",
+	Usage:     "delete a lease, releasing all of its resources",
+	ArgsUsage: "ID",
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("lease id must be provided")
+		}
+		leaseService, err := getLeaseService(context)
+		if err != nil {
+			return err
+		}
+		_, err = leaseService.Delete(gocontext.Background(), &leases.DeleteLeaseRequest{
+			ID: id,
+		})
+		return err
+	},
+}
+
+var leasesListCommand = cli.Command{
+	Name:  "list",
+	Usage: "list the leases which have not expired",
+	Flags: []cli.Flag{
+		outputFlag,
+		cli.BoolFlag{
+			Name:  "all-namespaces",
+			Usage: "list the leases of all namespaces",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		leaseService, err := getLeaseService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := leaseService.List(gocontext.Background(), &leases.ListLeasesRequest{
+			AllNamespaces: context.Bool("all-namespaces"),
+		})
+		if err != nil {
+			return err
+		}
+		return printOutput(context, resp.Leases, func(w io.Writer) {
+			fmt.Fprintln(w, "NAMESPACE\tID\tEXPIRES\tRESOURCES")
+			for _, l := range resp.Leases {
+				expires := "never"
+				if l.ExpiresAt != 0 {
+					expires = time.Unix(0, l.ExpiresAt).Format(time.RFC3339)
+				}
+				var resources []string
+				for _, r := range l.Resources {
+					resources = append(resources, r.Type+":"+r.ID)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", l.Namespace, l.ID, expires, strings.Join(resources, ","))
+			}
+		})
+	},
+}
+
+var leasesAddCommand = cli.Command{
+	Name:      "add",
+	Usage:     "pin a resource with a lease, TYPE being content, snapshot or image",
+	ArgsUsage: "ID TYPE RESOURCE",
+	Action: func(context *cli.Context) error {
+		id, resource, err := leaseResourceArgs(context)
+		if err != nil {
+			return err
+		}
+		leaseService, err := getLeaseService(context)
+		if err != nil {
+			return err
+		}
+		_, err = leaseService.AddResource(gocontext.Background(), &leases.AddResourceRequest{
+			ID:       id,
+			Resource: resource,
+		})
+		return err
+	},
+}
+
+var leasesRemoveCommand = cli.Command{
+	Name:      "remove",
+	Usage:     "release a resource of a lease",
+	ArgsUsage: "ID TYPE RESOURCE",
+	Action: func(context *cli.Context) error {
+		id, resource, err := leaseResourceArgs(context)
+		if err != nil {
+			return err
+		}
+		leaseService, err := getLeaseService(context)
+		if err != nil {
+			return err
+		}
+		_, err = leaseService.DeleteResource(gocontext.Background(), &leases.DeleteResourceRequest{
+			ID:       id,
+			Resource: resource,
+		})
+		return err
+	},
+}
+
+func leaseResourceArgs(context *cli.Context) (string, *leases.Resource, error) {
+	args := context.Args()
+	if len(args) != 3 {
+		return "", nil, fmt.Errorf("lease id, resource type and resource must be provided")
+	}
+	return args[0], &leases.Resource{
+		Type: args[1],
+		ID:   args[2],
+	}, nil
+}
+
+// keepLease renews the lease id with ttl until ctx is done or the returned
+// function is called, a few times per ttl for a renewal to be missed without
+// the lease expiring. The lease then expires soon after ctr stops, such as
+// when it crashes. The returned function returns once the renewals stopped.
+func keepLease(ctx gocontext.Context, leaseService leases.LeaseServiceClient, id string, ttl time.Duration) (func(), error) {
+	ctx, cancel := gocontext.WithCancel(ctx)
+	renew := func() error {
+		_, err := leaseService.Renew(ctx, &leases.RenewLeaseRequest{
+			ID:  id,
+			TTL: int64(ttl),
+		})
+		return err
+	}
+	if err := renew(); err != nil {
+		cancel()
+		return nil, err
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := renew(); err != nil && ctx.Err() == nil {
+				fmt.Fprintf(os.Stderr, "failed to renew lease %s: %v\n", id, err)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}, nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+
+	"github.com/docker/containerd/api/debug"
+	"github.com/urfave/cli"
+)
+
+var logLevelCommand = cli.Command{
+	Name:  "log-level",
+	Usage: "list and change the log levels of the daemon's modules",
+	Subcommands: []cli.Command{
+		logLevelSetCommand,
+		logLevelResetCommand,
+	},
+	Flags: []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		debugService, err := getDebugService(context)
+		if err != nil {
+			return err
+		}
+
+		resp, err := debugService.LogLevels(gocontext.Background(), &debug.LogLevelsRequest{})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(context, resp, func(w io.Writer) {
+			fmt.Fprintln(w, "MODULE\tLEVEL")
+			fmt.Fprintf(w, "%s\t%s\n", "(default)", resp.Default)
+			for _, m := range resp.Modules {
+				fmt.Fprintf(w, "%s\t%s\n", m.Module, m.Level)
+			}
+		})
+	},
+}
+
+var logLevelSetCommand = cli.Command{
+	Name:      "set",
+	Usage:     "set the log level of a module, or the default level without a module",
+	ArgsUsage: "LEVEL [MODULE]",
+	Action: func(context *cli.Context) error {
+		level := context.Args().First()
+		if level == "" {
+			return fmt.Errorf("log level must be provided")
+		}
+		return setLogLevel(context, context.Args().Get(1), level)
+	},
+}
+
+var logLevelResetCommand = cli.Command{
+	Name:      "reset",
+	Usage:     "remove the log level of a module, which falls back to the level of its parent",
+	ArgsUsage: "MODULE",
+	Action: func(context *cli.Context) error {
+		module := context.Args().First()
+		if module == "" {
+			return fmt.Errorf("module must be provided")
+		}
+		return setLogLevel(context, module, "")
+	},
+}
+
+func setLogLevel(context *cli.Context, module, level string) error {
+	debugService, err := getDebugService(context)
+	if err != nil {
+		return err
+	}
+	_, err = debugService.SetLogLevel(gocontext.Background(), &debug.SetLogLevelRequest{
+		Module: module,
+		Level:  level,
+	})
+	return err
+}
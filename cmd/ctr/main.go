@@ -3,8 +3,10 @@ package main
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/docker/containerd"
+	"github.com/docker/containerd/namespaces"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
@@ -28,9 +30,56 @@ containerd client
 			Usage: "enable debug output in logs",
 		},
 		cli.StringFlag{
-			Name:  "socket, s",
-			Usage: "socket path for containerd's GRPC server",
-			Value: "/run/containerd/containerd.sock",
+			Name:   "address, a",
+			Usage:  "address of containerd's GRPC server, a unix socket path or unix://PATH, or tcp://HOST:PORT served over TLS",
+			Value:  defaultAddress,
+			EnvVar: "CONTAINERD_ADDRESS",
+		},
+		cli.StringFlag{
+			Name:   "socket, s",
+			Usage:  "socket path for containerd's GRPC server, deprecated by --address",
+			Hidden: true,
+		},
+		cli.BoolFlag{
+			Name:  "plaintext",
+			Usage: "connect to a tcp address without TLS, as served by the daemon on loopback addresses only",
+		},
+		cli.StringFlag{
+			Name:   "tls-ca",
+			Usage:  "CA bundle the certificate of a tcp address is verified against, the system roots when unset",
+			EnvVar: "CONTAINERD_TLS_CA",
+		},
+		cli.StringFlag{
+			Name:   "tls-cert",
+			Usage:  "client certificate presented to a tcp address",
+			EnvVar: "CONTAINERD_TLS_CERT",
+		},
+		cli.StringFlag{
+			Name:   "tls-key",
+			Usage:  "key of the client certificate",
+			EnvVar: "CONTAINERD_TLS_KEY",
+		},
+		cli.StringFlag{
+			Name:   "tls-server-name",
+			Usage:  "name the certificate of a tcp address is verified for, its host when unset",
+			EnvVar: "CONTAINERD_TLS_SERVER_NAME",
+		},
+		cli.DurationFlag{
+			Name:   "timeout",
+			Usage:  "time to wait for the connection to containerd",
+			Value:  10 * time.Second,
+			EnvVar: "CONTAINERD_TIMEOUT",
+		},
+		cli.StringFlag{
+			Name:   "namespace, n",
+			Usage:  "namespace of the requests",
+			Value:  namespaces.Default,
+			EnvVar: "CONTAINERD_NAMESPACE",
+		},
+		cli.StringFlag{
+			Name:  "root",
+			Usage: "containerd root directory, holding the content store of pull, push and image import and export and the snapshots",
+			Value: "/var/lib/containerd",
 		},
 	}
 	app.Commands = []cli.Command{
@@ -38,6 +87,32 @@ containerd client
 		execCommand,
 		eventsCommand,
 		deleteCommand,
+		killCommand,
+		inspectCommand,
+		waitCommand,
+		runtimeLogsCommand,
+		runtimesCommand,
+		updateCommand,
+		statsCommand,
+		topCommand,
+		sandboxCommand,
+		netnsCommand,
+		portsCommand,
+		specCommand,
+		logLevelCommand,
+		infoCommand,
+		leaksCommand,
+		dumpStateCommand,
+		pullCommand,
+		pushCommand,
+		imagesCommand,
+		leasesCommand,
+		gcCommand,
+		pruneCommand,
+		snapshotCommand,
+		checkpointCommand,
+		restoreCommand,
+		migrateCommand,
 	}
 	app.Before = func(context *cli.Context) error {
 		if context.GlobalBool("debug") {
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	gocontext "context"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/docker/containerd/api/migration"
+	"github.com/docker/containerd/images"
+	"github.com/opencontainers/go-digest"
+	"github.com/urfave/cli"
+)
+
+var migrateCommand = cli.Command{
+	Name:      "migrate",
+	Usage:     "migrate a running container from the daemon of another host to that of ctr through a registry, attached to its io until it exits with its exit status",
+	ArgsUsage: "CONTAINER REFERENCE",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "source",
+			Usage: "address of the daemon the container is migrated from, as --address",
+		},
+		cli.BoolFlag{
+			Name:  "rootfs",
+			Usage: "migrate the whole rootfs of the container, replacing that of its bundle, for hosts not sharing it",
+		},
+		cli.StringFlag{
+			Name:  "snapshot",
+			Usage: "migrate the changes of the active snapshot the rootfs of the container is mounted from, applied over the rootfs of its bundle prepared from the same image",
+		},
+		cli.BoolFlag{
+			Name:  "no-pre-copy",
+			Usage: "stop the container without pre-copying its memory while it runs",
+		},
+		cli.BoolFlag{
+			Name:  "tcp-established",
+			Usage: "migrate the established tcp connections of the container",
+		},
+		cli.BoolFlag{
+			Name:  "unix-sockets",
+			Usage: "migrate the external unix sockets of the container",
+		},
+		cli.StringSliceFlag{
+			Name:  "empty-ns",
+			Usage: "namespace not to restore with the container, such as network to join that of the bundle",
+		},
+	}, runCommand.Flags...),
+	Action: func(context *cli.Context) error {
+		if context.NArg() != 2 {
+			return fmt.Errorf("a container and the reference of its checkpoint must be provided")
+		}
+		if context.String("source") == "" {
+			return fmt.Errorf("--source must be provided")
+		}
+		if context.Bool("tty") {
+			return errRestoreTTY
+		}
+		id, reference := context.Args().First(), context.Args().Get(1)
+		conn, err := dial(context, context.String("source"))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		source := migration.NewMigrationServiceClient(conn)
+		sourceExecution := execution.NewExecutionServiceClient(conn)
+		target, err := getMigrationService(context)
+		if err != nil {
+			return err
+		}
+		ctx := gocontext.Background()
+
+		if !context.Bool("no-pre-copy") {
+			stream, err := source.PreCopy(ctx, &migration.PreCopyRequest{
+				ID:        id,
+				Reference: reference,
+			})
+			if err != nil {
+				return err
+			}
+			done, err := followMigration(stream)
+			if err != nil {
+				return err
+			}
+			if stream, err := target.Prepare(ctx, &migration.PrepareRequest{
+				Reference: done.Reference,
+			}); err != nil {
+				return err
+			} else if _, err := followMigration(stream); err != nil {
+				return err
+			}
+		}
+
+		stream, err := source.Handoff(ctx, &migration.HandoffRequest{
+			ID:             id,
+			Reference:      reference,
+			Rootfs:         context.Bool("rootfs"),
+			Snapshot:       context.String("snapshot"),
+			TCPEstablished: context.Bool("tcp-established"),
+			UnixSockets:    context.Bool("unix-sockets"),
+			EmptyNS:        context.StringSlice("empty-ns"),
+		})
+		if err != nil {
+			return err
+		}
+		handoff, err := followMigration(stream)
+		if err != nil {
+			return err
+		}
+		return runContainer(context, func(r *execution.CreateContainerRequest) (*execution.CreateContainerResponse, error) {
+			stream, err := target.Cutover(ctx, &migration.CutoverRequest{
+				Reference: handoff.Reference,
+				Container: r,
+			})
+			if err != nil {
+				return nil, err
+			}
+			done, err := followMigration(stream)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "the container stopped on the source can be restored from %s\n", handoff.Reference)
+				return nil, err
+			}
+			if _, err := sourceExecution.Delete(ctx, &execution.DeleteContainerRequest{
+				ID: id,
+			}); err != nil {
+				fmt.Fprintf(os.Stderr, "failed to delete the container on the source: %v\n", err)
+			}
+			return done.Container, nil
+		})
+	},
+}
+
+// migrationStream is the stream of the progress of a step of a migration.
+type migrationStream interface {
+	Recv() (*migration.Progress, error)
+}
+
+// followMigration displays the progress of a step of a migration until it is
+// done, returning its final progress.
+func followMigration(stream migrationStream) (*migration.Progress, error) {
+	var bars *progressBars
+	defer func() {
+		if bars != nil {
+			bars.Stop()
+		}
+	}()
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			return nil, fmt.Errorf("migration ended before it was done")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if p.Digest != "" {
+			if bars == nil {
+				bars = newProgressBars()
+			}
+			bars.update(images.Descriptor{Digest: digest.Digest(p.Digest), Size: p.Total}, p.Offset)
+			continue
+		}
+		if bars != nil {
+			bars.Stop()
+			bars = nil
+		}
+		if p.Stage == "done" {
+			return p, nil
+		}
+		fmt.Printf("%s\n", p.Stage)
+	}
+}
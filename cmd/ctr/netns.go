@@ -0,0 +1,93 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/urfave/cli"
+)
+
+var netnsCommand = cli.Command{
+	Name:  "netns",
+	Usage: "manage network namespaces shared by containers",
+	Subcommands: []cli.Command{
+		netnsCreateCommand,
+		netnsDeleteCommand,
+		netnsListCommand,
+	},
+}
+
+var netnsCreateCommand = cli.Command{
+	Name:      "create",
+	Usage:     "create a network namespace",
+	ArgsUsage: "NAME",
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		name := context.Args().First()
+		if name == "" {
+			return fmt.Errorf("network namespace name must be provided")
+		}
+
+		resp, err := executionService.CreateNetworkNamespace(gocontext.Background(), &execution.CreateNetworkNamespaceRequest{
+			Name: name,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(os.Stdout, resp.NetworkNamespace.Path)
+		return err
+	},
+}
+
+var netnsDeleteCommand = cli.Command{
+	Name:      "delete",
+	Usage:     "delete a network namespace",
+	ArgsUsage: "NAME",
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		name := context.Args().First()
+		if name == "" {
+			return fmt.Errorf("network namespace name must be provided")
+		}
+
+		_, err = executionService.DeleteNetworkNamespace(gocontext.Background(), &execution.DeleteNetworkNamespaceRequest{
+			Name: name,
+		})
+		return err
+	},
+}
+
+var netnsListCommand = cli.Command{
+	Name:  "list",
+	Usage: "list the network namespaces",
+	Flags: []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		resp, err := executionService.ListNetworkNamespaces(gocontext.Background(), &execution.ListNetworkNamespacesRequest{})
+		if err != nil {
+			return err
+		}
+		return printOutput(context, resp.NetworkNamespaces, func(w io.Writer) {
+			fmt.Fprintln(w, "NAME\tPATH\tIPS")
+			for _, ns := range resp.NetworkNamespaces {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", ns.Name, ns.Path, strings.Join(ns.IPs, ","))
+			}
+		})
+	},
+}
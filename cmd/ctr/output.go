@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/urfave/cli"
+)
+
+const goTemplatePrefix = "go-template="
+
+var outputFlag = cli.StringFlag{
+	Name:  "output, o",
+	Usage: "output format: table, json, or go-template=TEMPLATE executed on each item listed, with the field names of the json output in Go, such as {{.ID}}",
+	Value: "table",
+}
+
+// templateFuncs are available to the templates of -o go-template.
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		p, err := json.Marshal(v)
+		return string(p), err
+	},
+	"join": strings.Join,
+}
+
+// parseTemplate returns the template of a go-template output, nil for the
+// other outputs.
+func parseTemplate(output string) (*template.Template, error) {
+	if !strings.HasPrefix(output, goTemplatePrefix) {
+		return nil, nil
+	}
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(strings.TrimPrefix(output, goTemplatePrefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid output template: %v", err)
+	}
+	return tmpl, nil
+}
+
+// executeTemplate writes the template executed on v followed by a newline,
+// on each of its elements when v is a slice.
+func executeTemplate(w io.Writer, tmpl *template.Template, v interface{}) error {
+	items := []interface{}{v}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+		items = items[:0]
+		for i := 0; i < rv.Len(); i++ {
+			items = append(items, rv.Index(i).Interface())
+		}
+	}
+	for _, item := range items {
+		if err := tmpl.Execute(w, item); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printOutput prints v in the format of the output flag: as json, with a
+// go template, or as the table written by table.
+func printOutput(context *cli.Context, v interface{}, table func(w io.Writer)) error {
+	output := context.String("output")
+	switch {
+	case output == "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case strings.HasPrefix(output, goTemplatePrefix):
+		tmpl, err := parseTemplate(output)
+		if err != nil {
+			return err
+		}
+		return executeTemplate(os.Stdout, tmpl, v)
+	case output == "table" || output == "":
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		table(w)
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown output format %q", output)
+	}
+}
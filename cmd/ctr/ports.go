@@ -0,0 +1,41 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/urfave/cli"
+)
+
+var portsCommand = cli.Command{
+	Name:      "ports",
+	Usage:     "list the ports forwarded to containers",
+	ArgsUsage: "[CONTAINER]",
+	Flags:     []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		resp, err := executionService.ListPorts(gocontext.Background(), &execution.ListPortsRequest{
+			ID: context.Args().First(),
+		})
+		if err != nil {
+			return err
+		}
+		return printOutput(context, resp.Ports, func(w io.Writer) {
+			fmt.Fprintln(w, "CONTAINER\tHOST\tCONTAINER PORT\tPROTOCOL")
+			for _, p := range resp.Ports {
+				protocol := p.Mapping.Protocol
+				if protocol == "" {
+					protocol = "tcp"
+				}
+				host := fmt.Sprintf("%s:%d", p.Mapping.HostIP, p.Mapping.HostPort)
+				fmt.Fprintf(w, "%s\t%s\t%s:%d\t%s\n", p.ContainerID, host, p.ContainerIP, p.Mapping.ContainerPort, protocol)
+			}
+		})
+	},
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/containerd/images"
+	"github.com/docker/docker/pkg/term"
+	"github.com/docker/go-units"
+	"github.com/opencontainers/go-digest"
+)
+
+const progressBarWidth = 30
+
+// progressBars display the progress of the blobs of an image being
+// transferred, redrawn in place on a terminal and as a line per blob done
+// otherwise.
+type progressBars struct {
+	out      io.Writer
+	terminal bool
+
+	mu    sync.Mutex
+	order []digest.Digest
+	blobs map[digest.Digest]*blobProgress
+	lines int
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+type blobProgress struct {
+	desc     images.Descriptor
+	n        int64
+	reported bool
+}
+
+func newProgressBars() *progressBars {
+	p := &progressBars{
+		out:      os.Stdout,
+		terminal: term.IsTerminal(os.Stdout.Fd()),
+		blobs:    make(map[digest.Digest]*blobProgress),
+		done:     make(chan struct{}),
+	}
+	if p.terminal {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			t := time.NewTicker(100 * time.Millisecond)
+			defer t.Stop()
+			for {
+				select {
+				case <-t.C:
+					p.draw()
+				case <-p.done:
+					return
+				}
+			}
+		}()
+	}
+	return p
+}
+
+// update is the remotes.Progress of the transfer.
+func (p *progressBars) update(desc images.Descriptor, n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	b, ok := p.blobs[desc.Digest]
+	if !ok {
+		b = &blobProgress{desc: desc}
+		p.blobs[desc.Digest] = b
+		p.order = append(p.order, desc.Digest)
+	}
+	b.n = n
+	if !p.terminal && !b.reported && b.desc.Size > 0 && n >= b.desc.Size {
+		b.reported = true
+		fmt.Fprintf(p.out, "%s: done %s\n", shortDigest(desc.Digest), units.HumanSize(float64(desc.Size)))
+	}
+}
+
+// Stop draws the final progress.
+func (p *progressBars) Stop() {
+	close(p.done)
+	p.wg.Wait()
+	if p.terminal {
+		p.draw()
+	}
+}
+
+func (p *progressBars) draw() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.lines > 0 {
+		// move back to the first line of the bars
+		fmt.Fprintf(p.out, "\x1b[%dA", p.lines)
+	}
+	for _, d := range p.order {
+		b := p.blobs[d]
+		fmt.Fprintf(p.out, "\x1b[2K%s: %s %s/%s\n", shortDigest(d), bar(b.n, b.desc.Size),
+			units.HumanSize(float64(b.n)), units.HumanSize(float64(b.desc.Size)))
+	}
+	p.lines = len(p.order)
+}
+
+func bar(n, size int64) string {
+	filled := progressBarWidth
+	if size > 0 && n < size {
+		filled = int(n * progressBarWidth / size)
+	}
+	if filled == progressBarWidth {
+		return "[" + strings.Repeat("=", filled) + "]"
+	}
+	return "[" + strings.Repeat("=", filled) + ">" + strings.Repeat(" ", progressBarWidth-filled-1) + "]"
+}
+
+func shortDigest(d digest.Digest) string {
+	hex := d.Hex()
+	if len(hex) > 12 {
+		hex = hex[:12]
+	}
+	return hex
+}
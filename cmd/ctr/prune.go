@@ -0,0 +1,75 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+
+	"github.com/docker/containerd/api/gc"
+	"github.com/docker/go-units"
+	"github.com/urfave/cli"
+)
+
+var pruneCommand = cli.Command{
+	Name:  "prune",
+	Usage: "remove the resources which are no longer used",
+	Flags: []cli.Flag{
+		outputFlag,
+		cli.BoolFlag{
+			Name:  "containers",
+			Usage: "delete the stopped containers of the namespace",
+		},
+		cli.BoolFlag{
+			Name:  "images",
+			Usage: "remove the images of the namespace used by no container and pinned by no lease",
+		},
+		cli.BoolFlag{
+			Name:  "content",
+			Usage: "remove the blobs of the content store referenced by nothing",
+		},
+		cli.BoolFlag{
+			Name:  "snapshots",
+			Usage: "remove the committed snapshots referenced by nothing",
+		},
+		cli.BoolFlag{
+			Name:  "all, a",
+			Usage: "prune all of the above",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "display what would be removed without removing anything",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		all := context.Bool("all")
+		r := &gc.PruneRequest{
+			Containers: all || context.Bool("containers"),
+			Images:     all || context.Bool("images"),
+			Content:    all || context.Bool("content"),
+			Snapshots:  all || context.Bool("snapshots"),
+			DryRun:     context.Bool("dry-run"),
+		}
+		if !r.Containers && !r.Images && !r.Content && !r.Snapshots {
+			return fmt.Errorf("at least one of --containers, --images, --content, --snapshots or --all must be set")
+		}
+		gcService, err := getGCService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := gcService.Prune(gocontext.Background(), r)
+		if err != nil {
+			return err
+		}
+		return printOutput(context, resp, func(w io.Writer) {
+			fmt.Fprintln(w, "TYPE\tID\tSIZE")
+			for _, res := range resp.Removed {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", res.Type, res.ID, units.HumanSize(float64(res.Size_)))
+			}
+			if r.DryRun {
+				fmt.Fprintf(w, "\nwould reclaim %s\n", units.HumanSize(float64(resp.Reclaimed)))
+			} else {
+				fmt.Fprintf(w, "\nreclaimed %s\n", units.HumanSize(float64(resp.Reclaimed)))
+			}
+		})
+	},
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	gocontext "context"
+
+	"github.com/docker/containerd/api/images"
+	"github.com/docker/containerd/api/leases"
+	imagesstore "github.com/docker/containerd/images"
+	leasestore "github.com/docker/containerd/leases"
+	"github.com/docker/containerd/remotes"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var pullCommand = cli.Command{
+	Name:      "pull",
+	Usage:     "fetch an image from its registry into the content store and name it",
+	ArgsUsage: "REFERENCE",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  "lease",
+			Usage: "lease pinning the content and name of the image, created unless it exists, for it not to be collected before it is used",
+		},
+		cli.DurationFlag{
+			Name:  "lease-ttl",
+			Usage: "renew the lease with this ttl while pulling, for it to expire soon after the pull if it is interrupted; it no longer expires once the image is named",
+		},
+	}, registryFlags...),
+	Action: func(context *cli.Context) error {
+		ref, err := remotes.ParseReference(context.Args().First())
+		if err != nil {
+			return err
+		}
+		platform, err := parsePlatform(context.String("platform"))
+		if err != nil {
+			return err
+		}
+		registry, err := getRegistry(context, ref)
+		if err != nil {
+			return err
+		}
+		cs, err := getContentStore(context)
+		if err != nil {
+			return err
+		}
+		imagesService, err := getImagesService(context)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := gocontext.WithCancel(gocontext.Background())
+		defer cancel()
+
+		var (
+			pinner       *leasePinner
+			leaseService leases.LeaseServiceClient
+			stopLease    func()
+			ttl          = context.Duration("lease-ttl")
+		)
+		if id := context.String("lease"); id != "" {
+			if leaseService, err = getLeaseService(context); err != nil {
+				return err
+			}
+			// the lease pins the image before it is fetched and its blobs
+			// as they are, for them to be kept if the pull is interrupted
+			if _, err := leaseService.Create(ctx, &leases.CreateLeaseRequest{
+				ID:  id,
+				TTL: int64(ttl),
+			}); err != nil && grpc.Code(err) != codes.AlreadyExists {
+				return err
+			}
+			if ttl > 0 {
+				if stopLease, err = keepLease(ctx, leaseService, id, ttl); err != nil {
+					return err
+				}
+				defer stopLease()
+			}
+			pinner = newLeasePinner(leaseService, id)
+			if err := pinner.pin(ctx, leasestore.ResourceImage, ref.String()); err != nil {
+				return err
+			}
+		} else if ttl != 0 {
+			return fmt.Errorf("--lease-ttl requires --lease")
+		}
+
+		// the blobs are not collected before the image is named
+		release, err := cs.Hold()
+		if err != nil {
+			return err
+		}
+		defer release()
+		progress := newProgressBars()
+		target, err := remotes.Fetch(ctx, registry, cs, ref.Name, ref.Object(), platform, func(desc imagesstore.Descriptor, done int64) {
+			if pinner != nil {
+				if err := pinner.pin(ctx, leasestore.ResourceContent, desc.Digest.String()); err != nil {
+					cancel()
+				}
+			}
+			progress.update(desc, done)
+		})
+		progress.Stop()
+		if pinner != nil && pinner.err != nil {
+			return pinner.err
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := imagesService.Put(ctx, &images.PutImageRequest{
+			Image: &images.Image{
+				Name: ref.String(),
+				Target: &images.Descriptor{
+					MediaType: target.MediaType,
+					Digest:    target.Digest.String(),
+					Size_:     target.Size,
+				},
+			},
+		}); err != nil {
+			return err
+		}
+		if stopLease != nil {
+			// the image is named, the lease no longer expires for it to be
+			// kept until the lease is deleted
+			stopLease()
+			if _, err := leaseService.Renew(ctx, &leases.RenewLeaseRequest{
+				ID: context.String("lease"),
+			}); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("%s: %s\n", ref, target.Digest)
+		return nil
+	},
+}
+
+// leasePinner adds the resources of a pull to a lease, once each.
+type leasePinner struct {
+	service leases.LeaseServiceClient
+	id      string
+
+	mu     sync.Mutex
+	pinned map[leases.Resource]bool
+	// err is the first error pinning a resource
+	err error
+}
+
+func newLeasePinner(service leases.LeaseServiceClient, id string) *leasePinner {
+	return &leasePinner{
+		service: service,
+		id:      id,
+		pinned:  make(map[leases.Resource]bool),
+	}
+}
+
+// pin adds the resource id of type typ to the lease unless it was already.
+func (p *leasePinner) pin(ctx gocontext.Context, typ, id string) error {
+	r := leases.Resource{Type: typ, ID: id}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pinned[r] {
+		return nil
+	}
+	if _, err := p.service.AddResource(ctx, &leases.AddResourceRequest{
+		ID:       p.id,
+		Resource: &r,
+	}); err != nil {
+		if p.err == nil {
+			p.err = err
+		}
+		return err
+	}
+	p.pinned[r] = true
+	return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+
+	gocontext "context"
+
+	"github.com/docker/containerd/api/images"
+	imagesstore "github.com/docker/containerd/images"
+	"github.com/docker/containerd/remotes"
+	"github.com/opencontainers/go-digest"
+	"github.com/urfave/cli"
+)
+
+var pushCommand = cli.Command{
+	Name:      "push",
+	Usage:     "push an image of the content store to a registry",
+	ArgsUsage: "REFERENCE [IMAGE]",
+	Description: `Push the manifest of the platform of the image named IMAGE, REFERENCE
+when unset, to the registry and repository of REFERENCE under its tag or
+digest.`,
+	Flags: registryFlags,
+	Action: func(context *cli.Context) error {
+		ref, err := remotes.ParseReference(context.Args().First())
+		if err != nil {
+			return err
+		}
+		name := ref.String()
+		if context.NArg() > 1 {
+			local, err := remotes.ParseReference(context.Args().Get(1))
+			if err != nil {
+				return err
+			}
+			name = local.String()
+		}
+		platform, err := parsePlatform(context.String("platform"))
+		if err != nil {
+			return err
+		}
+		imagesService, err := getImagesService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := imagesService.Get(gocontext.Background(), &images.GetImageRequest{
+			Name: name,
+		})
+		if err != nil {
+			return err
+		}
+		dgst, err := digest.Parse(resp.Image.Target.Digest)
+		if err != nil {
+			return err
+		}
+		target := imagesstore.Descriptor{
+			MediaType: resp.Image.Target.MediaType,
+			Digest:    dgst,
+			Size:      resp.Image.Target.Size_,
+		}
+		registry, err := getRegistry(context, ref)
+		if err != nil {
+			return err
+		}
+		cs, err := getContentStore(context)
+		if err != nil {
+			return err
+		}
+
+		progress := newProgressBars()
+		err = remotes.Push(gocontext.Background(), registry, cs, ref.Name, ref.Object(), target, platform, progress.update)
+		progress.Stop()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: pushed\n", ref)
+		return nil
+	},
+}
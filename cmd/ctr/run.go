@@ -4,14 +4,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"time"
+	"syscall"
 
 	gocontext "context"
 
 	"github.com/docker/containerd/api/execution"
-	execEvents "github.com/docker/containerd/execution"
 	"github.com/docker/docker/pkg/term"
-	"github.com/nats-io/go-nats"
 	"github.com/urfave/cli"
 )
 
@@ -33,135 +31,312 @@ type runConfig struct {
 }
 
 var runCommand = cli.Command{
-	Name:  "run",
-	Usage: "run a container",
+	Name:      "run",
+	ArgsUsage: "CONTAINER [ARGS...]",
+	Usage:     "create and start a container from a bundle or an image, attached to its io until it exits with its exit status",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  "bundle, b",
 			Usage: "path to the container's bundle",
 		},
+		cli.StringFlag{
+			Name:  "image",
+			Usage: "image to unpack as the rootfs of a bundle created for the container, running ARGS after its entrypoint",
+		},
 		cli.BoolFlag{
 			Name:  "tty, t",
 			Usage: "allocate a TTY for the container",
 		},
+		cli.StringFlag{
+			Name:  "runtime",
+			Usage: "name of the runtime configured on the daemon to run the container with",
+		},
+		cli.StringFlag{
+			Name:  "sandbox",
+			Usage: "id of the sandbox to run the container in",
+		},
+		cli.Int64Flag{
+			Name:  "pids-limit",
+			Usage: "maximum number of processes in the container, -1 for no limit",
+		},
+		cli.StringFlag{
+			Name:  "cgroup-parent",
+			Usage: "parent cgroup of the container, a slice with the systemd cgroup driver",
+		},
+		cli.BoolFlag{
+			Name:  "systemd-cgroup",
+			Usage: "use the systemd cgroup driver for the container",
+		},
+		cli.StringFlag{
+			Name:  "runtime-root",
+			Usage: "runc state directory for the container",
+		},
+		cli.StringFlag{
+			Name:  "criu",
+			Usage: "path to the criu binary used for checkpoint and restore",
+		},
+		cli.BoolFlag{
+			Name:  "runtime-debug",
+			Usage: "enable runc debug logging for the container",
+		},
+		cli.StringFlag{
+			Name:  "apparmor",
+			Usage: "replace the apparmor profile of the bundle, unconfined to run the container unconfined",
+		},
+		cli.StringSliceFlag{
+			Name:  "cap-add",
+			Usage: "capability to add to those of the bundle",
+		},
+		cli.StringSliceFlag{
+			Name:  "cap-drop",
+			Usage: "capability to drop from those of the bundle, ALL to drop all of them",
+		},
+		cli.BoolFlag{
+			Name:  "privileged",
+			Usage: "grant all capabilities to the container",
+		},
+		cli.StringSliceFlag{
+			Name:  "uidmap",
+			Usage: "run the container in a user namespace mapping uids as container-id:host-id:size",
+		},
+		cli.StringSliceFlag{
+			Name:  "gidmap",
+			Usage: "run the container in a user namespace mapping gids as container-id:host-id:size",
+		},
+		cli.BoolFlag{
+			Name:  "no-selinux-label",
+			Usage: "leave the container unlabeled when selinux is enforcing",
+		},
+		cli.StringSliceFlag{
+			Name:  "masked-path",
+			Usage: "mask a path in addition to those of the bundle",
+		},
+		cli.StringSliceFlag{
+			Name:  "readonly-path",
+			Usage: "make a path read only in addition to those of the bundle",
+		},
+		cli.BoolFlag{
+			Name:  "readonly-rootfs",
+			Usage: "mount the rootfs of the container read only",
+		},
+		cli.StringFlag{
+			Name:  "network",
+			Usage: "name or path of a network namespace for the container to join",
+		},
+		cli.StringFlag{
+			Name:  "netns",
+			Usage: "path of a network namespace, set up by the caller, for the container to join",
+		},
+		cli.StringSliceFlag{
+			Name:  "publish, p",
+			Usage: "forward a port of the host to the container as [host-ip:]host-port:container-port[/protocol]",
+		},
+		cli.StringFlag{
+			Name:  "hostname",
+			Usage: "hostname of the container",
+		},
+		cli.StringSliceFlag{
+			Name:  "add-host",
+			Usage: "add a host to the container's /etc/hosts as name:ip",
+		},
+		cli.StringSliceFlag{
+			Name:  "dns",
+			Usage: "name server of the container, replacing those of the host",
+		},
+		cli.StringSliceFlag{
+			Name:  "dns-search",
+			Usage: "dns search domain of the container, replacing those of the host",
+		},
+		cli.StringSliceFlag{
+			Name:  "dns-option",
+			Usage: "resolver option of the container, replacing those of the host",
+		},
+		cli.StringSliceFlag{
+			Name:  "secret",
+			Usage: "mount a secret of the daemon into the container as name:target",
+		},
+		cli.StringFlag{
+			Name:  "seccomp",
+			Usage: "replace the seccomp profile of the bundle: default, unconfined or the path of a JSON profile",
+		},
 	},
 	Action: func(context *cli.Context) error {
 		// var config runConfig
 		// if _, err := toml.DecodeFile(context.Args().First(), &config); err != nil {
 		// 	return err
 		// }
-		id := context.Args().First()
-		if id == "" {
-			return fmt.Errorf("container id must be provided")
-		}
-		executionService, err := getExecutionService(context)
-		if err != nil {
-			return err
-		}
+		return runContainer(context, nil)
+	},
+}
 
-		// setup our event subscriber
-		nc, err := nats.Connect(nats.DefaultURL)
-		if err != nil {
-			return err
-		}
-		nec, err := nats.NewEncodedConn(nc, nats.JSON_ENCODER)
-		if err != nil {
-			nc.Close()
-			return err
-		}
-		defer nec.Close()
+// restoreFunc restores the container of a create request, which is running
+// once restored.
+type restoreFunc func(*execution.CreateContainerRequest) (*execution.CreateContainerResponse, error)
 
-		evCh := make(chan *execEvents.ContainerExitEvent, 64)
-		sub, err := nec.Subscribe(execEvents.ContainersEventsSubjectSubscriber, func(e *execEvents.ContainerExitEvent) {
-			evCh <- e
-		})
-		if err != nil {
-			return err
-		}
-		defer sub.Unsubscribe()
+// runContainer creates and starts the container of the run flags, or
+// restores it with restore when set, and waits for it to exit, returning its
+// exit status.
+func runContainer(context *cli.Context, restore restoreFunc) error {
+	id := context.Args().First()
+	if id == "" {
+		return fmt.Errorf("container id must be provided")
+	}
+	executionService, err := getExecutionService(context)
+	if err != nil {
+		return err
+	}
 
-		tmpDir, err := getTempDir(id)
-		if err != nil {
-			return err
-		}
-		defer os.RemoveAll(tmpDir)
-
-		bundle, err := filepath.Abs(context.String("bundle"))
-		if err != nil {
-			return err
-		}
-		crOpts := &execution.CreateContainerRequest{
-			ID:         id,
-			BundlePath: bundle,
-			Console:    context.Bool("tty"),
-			Stdin:      filepath.Join(tmpDir, "stdin"),
-			Stdout:     filepath.Join(tmpDir, "stdout"),
-			Stderr:     filepath.Join(tmpDir, "stderr"),
-		}
+	exits, err := subscribeExits(context.GlobalString("namespace"))
+	if err != nil {
+		return err
+	}
+	defer exits.Close()
 
-		var oldState *term.State
-		restoreTerm := func() {
-			if oldState != nil {
-				term.RestoreTerminal(os.Stdin.Fd(), oldState)
-			}
-		}
+	fifos, attach, err := prepareStdio(id, context.Bool("tty"))
+	if err != nil {
+		return err
+	}
+	defer fifos.Close()
+	defer attach.Close()
 
-		if crOpts.Console {
-			oldState, err = term.SetRawTerminal(os.Stdin.Fd())
-			if err != nil {
-				return err
-			}
-			defer restoreTerm()
+	var bundle string
+	if name := context.String("image"); name != "" {
+		if restore != nil || context.String("bundle") != "" {
+			return fmt.Errorf("an image cannot be run with a bundle or restored")
 		}
-
-		fwg, err := prepareStdio(crOpts.Stdin, crOpts.Stdout, crOpts.Stderr, crOpts.Console)
+		b, err := createImageBundle(context, name, id, context.Args().Tail())
 		if err != nil {
 			return err
 		}
+		// the bundle is rolled back whether the container failed to be
+		// created or ran
+		defer func() {
+			if err := b.remove(); err != nil {
+				fmt.Fprintf(os.Stderr, "ctr: failed to remove the bundle of %s: %v\n", id, err)
+			}
+		}()
+		bundle = b.path
+	} else if bundle, err = filepath.Abs(context.String("bundle")); err != nil {
+		return err
+	}
+	seccomp, err := seccompProfile(context.String("seccomp"))
+	if err != nil {
+		return err
+	}
+	secrets, err := parseSecretMounts(context.StringSlice("secret"))
+	if err != nil {
+		return err
+	}
+	ports, err := parsePortMappings(context.StringSlice("publish"))
+	if err != nil {
+		return err
+	}
+	extraHosts, err := parseHostEntries(context.StringSlice("add-host"))
+	if err != nil {
+		return err
+	}
+	uidMappings, err := parseIDMappings(context.StringSlice("uidmap"))
+	if err != nil {
+		return err
+	}
+	gidMappings, err := parseIDMappings(context.StringSlice("gidmap"))
+	if err != nil {
+		return err
+	}
+	crOpts := &execution.CreateContainerRequest{
+		ID:              id,
+		BundlePath:      bundle,
+		Runtime:         context.String("runtime"),
+		Sandbox:         context.String("sandbox"),
+		Console:         context.Bool("tty"),
+		Stdin:           fifos.Stdin,
+		Stdout:          fifos.Stdout,
+		Stderr:          fifos.Stderr,
+		PidsLimit:       context.Int64("pids-limit"),
+		CgroupParent:    context.String("cgroup-parent"),
+		SeccompProfile:  seccomp,
+		ApparmorProfile: context.String("apparmor"),
+		NoSelinuxLabel:  context.Bool("no-selinux-label"),
+		UIDMappings:     uidMappings,
+		GIDMappings:     gidMappings,
+		CapAdd:          context.StringSlice("cap-add"),
+		CapDrop:         context.StringSlice("cap-drop"),
+		Privileged:      context.Bool("privileged"),
+		MaskedPaths:     context.StringSlice("masked-path"),
+		ReadonlyPaths:   context.StringSlice("readonly-path"),
+		ReadonlyRootfs:  context.Bool("readonly-rootfs"),
+		Secrets:         secrets,
+		Network:         context.String("network"),
+		NetNSPath:       context.String("netns"),
+		Ports:           ports,
+		Hostname:        context.String("hostname"),
+		ExtraHosts:      extraHosts,
+		DNSServers:      context.StringSlice("dns"),
+		DNSSearch:       context.StringSlice("dns-search"),
+		DNSOptions:      context.StringSlice("dns-option"),
+		RuntimeOptions: &execution.RuntimeOptions{
+			SystemdCgroup: context.Bool("systemd-cgroup"),
+			Root:          context.String("runtime-root"),
+			CriuPath:      context.String("criu"),
+			Debug:         context.Bool("runtime-debug"),
+		},
+	}
 
-		cr, err := executionService.Create(gocontext.Background(), crOpts)
+	var oldState *term.State
+	if crOpts.Console {
+		oldState, err = term.SetRawTerminal(os.Stdin.Fd())
 		if err != nil {
 			return err
 		}
+		defer term.RestoreTerminal(os.Stdin.Fd(), oldState)
+	}
+
+	var cr *execution.CreateContainerResponse
+	if restore != nil {
+		cr, err = restore(crOpts)
+	} else {
+		cr, err = executionService.Create(gocontext.Background(), crOpts)
+	}
+	if err != nil {
+		return err
+	}
+	id, pid := cr.Container.ID, cr.InitProcess.ID
 
+	if restore == nil {
 		if _, err := executionService.Start(gocontext.Background(), &execution.StartContainerRequest{
-			ID: cr.Container.ID,
+			ID: id,
 		}); err != nil {
+			executionService.SignalProcess(gocontext.Background(), &execution.SignalProcessRequest{
+				ContainerID: id,
+				ProcessID:   pid,
+				Signal:      uint32(syscall.SIGKILL),
+			})
+			executionService.Delete(gocontext.Background(), &execution.DeleteContainerRequest{
+				ID: id,
+			})
 			return err
 		}
+	}
 
-		var ec uint32
-	eventLoop:
-		for {
-			select {
-			case e, more := <-evCh:
-				if !more {
-					break eventLoop
-				}
-
-				if e.ID == cr.Container.ID && e.PID == cr.InitProcess.ID {
-					ec = e.StatusCode
-					break eventLoop
-				}
-			case <-time.After(1 * time.Second):
-				if nec.Conn.Status() != nats.CONNECTED {
-					break eventLoop
-				}
-			}
-		}
-
-		if _, err := executionService.Delete(gocontext.Background(), &execution.DeleteContainerRequest{
-			ID: cr.Container.ID,
-		}); err != nil {
-			return err
-		}
+	if crOpts.Console {
+		defer forwardResize(executionService, id, pid)()
+	}
+	ec, err := exits.wait(executionService, id, pid)
+	if err != nil {
+		return err
+	}
 
-		// Ensure we read all io
-		fwg.Wait()
+	if _, err := executionService.Delete(gocontext.Background(), &execution.DeleteContainerRequest{
+		ID: id,
+	}); err != nil {
+		return err
+	}
 
-		restoreTerm()
-		os.Exit(int(ec))
+	// Ensure we read all io
+	attach.Wait()
 
-		return nil
-	},
+	if ec != 0 {
+		return cli.NewExitError("", int(ec))
+	}
+	return nil
 }
@@ -0,0 +1,51 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/urfave/cli"
+)
+
+var runtimeLogsCommand = cli.Command{
+	Name:      "runtime-logs",
+	Usage:     "show the shim and runtime logs of a process",
+	ArgsUsage: "CONTAINER",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pid, p",
+			Usage: "process id, defaults to the init process",
+		},
+		outputFlag,
+	},
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("container id must be provided")
+		}
+
+		resp, err := executionService.GetRuntimeLogs(gocontext.Background(), &execution.GetRuntimeLogsRequest{
+			ContainerID: id,
+			ProcessID:   context.String("pid"),
+		})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(context, resp.Logs, func(w io.Writer) {
+			fmt.Fprintln(w, "TIME\tSOURCE\tLEVEL\tMESSAGE")
+			for _, l := range resp.Logs {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+					time.Unix(0, l.Timestamp).Format(time.RFC3339Nano), l.Source, l.Level, l.Message)
+			}
+		})
+	},
+}
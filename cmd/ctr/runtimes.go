@@ -0,0 +1,43 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/urfave/cli"
+)
+
+var runtimesCommand = cli.Command{
+	Name:  "runtimes",
+	Usage: "list the runtimes configured on the daemon",
+	Flags: []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		resp, err := executionService.ListRuntimes(gocontext.Background(), &execution.ListRuntimesRequest{})
+		if err != nil {
+			return err
+		}
+
+		return printOutput(context, resp.Runtimes, func(w io.Writer) {
+			fmt.Fprintln(w, "NAME\tDEFAULT\tVERSION\tSPEC\tSECCOMP\tCGROUPV2\tCRIU\tPAUSE\tEXEC")
+			for _, rt := range resp.Runtimes {
+				f := rt.Features
+				if f == nil {
+					f = &execution.RuntimeFeatures{}
+				}
+				c := rt.Capabilities
+				if c == nil {
+					c = &execution.RuntimeCapabilities{}
+				}
+				fmt.Fprintf(w, "%s\t%t\t%s\t%s\t%t\t%t\t%t\t%t\t%t\n",
+					rt.Name, rt.Default, f.Version, f.Spec, f.Seccomp, f.CgroupV2, f.Criu != "", c.Pause, c.Exec)
+			}
+		})
+	},
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/urfave/cli"
+)
+
+var sandboxCommand = cli.Command{
+	Name:  "sandbox",
+	Usage: "manage sandboxes grouping containers",
+	Subcommands: []cli.Command{
+		sandboxCreateCommand,
+		sandboxDeleteCommand,
+		sandboxListCommand,
+		sandboxKillCommand,
+		sandboxStatsCommand,
+	},
+}
+
+var sandboxCreateCommand = cli.Command{
+	Name:      "create",
+	Usage:     "create a sandbox with a pause process holding the namespaces of its containers",
+	ArgsUsage: "SANDBOX",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "hostname",
+			Usage: "hostname of the sandbox",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("sandbox id must be provided")
+		}
+
+		resp, err := executionService.CreateSandbox(gocontext.Background(), &execution.CreateSandboxRequest{
+			ID:       id,
+			Hostname: context.String("hostname"),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(os.Stdout, resp.Sandbox.Pid)
+		return err
+	},
+}
+
+var sandboxDeleteCommand = cli.Command{
+	Name:      "delete",
+	Usage:     "delete a sandbox without containers, stopping its pause process",
+	ArgsUsage: "SANDBOX",
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("sandbox id must be provided")
+		}
+
+		_, err = executionService.DeleteSandbox(gocontext.Background(), &execution.DeleteSandboxRequest{
+			ID: id,
+		})
+		return err
+	},
+}
+
+var sandboxListCommand = cli.Command{
+	Name:  "list",
+	Usage: "list the sandboxes with a pause process",
+	Flags: []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		resp, err := executionService.ListSandboxes(gocontext.Background(), &execution.ListSandboxesRequest{})
+		if err != nil {
+			return err
+		}
+		return printOutput(context, resp.Sandboxes, func(w io.Writer) {
+			fmt.Fprintln(w, "ID\tPID\tSTATUS\tIPS\tCONTAINERS")
+			for _, sb := range resp.Sandboxes {
+				status := "stopped"
+				if sb.Running {
+					status = "running"
+				}
+				fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", sb.ID, sb.Pid, status, strings.Join(sb.IPs, ","), strings.Join(sb.Containers, ","))
+			}
+		})
+	},
+}
+
+var sandboxKillCommand = cli.Command{
+	Name:      "kill",
+	Usage:     "signal all the processes of a sandbox",
+	ArgsUsage: "SANDBOX",
+	Flags: []cli.Flag{
+		cli.IntFlag{
+			Name:  "signal, s",
+			Usage: "signal to send",
+			Value: int(syscall.SIGKILL),
+		},
+	},
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("sandbox id must be provided")
+		}
+
+		_, err = executionService.KillSandbox(gocontext.Background(), &execution.KillSandboxRequest{
+			ID:     id,
+			Signal: uint32(context.Int("signal")),
+		})
+		return err
+	},
+}
+
+var sandboxStatsCommand = cli.Command{
+	Name:      "stats",
+	Usage:     "print the resource usage of a sandbox as json",
+	ArgsUsage: "SANDBOX",
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("sandbox id must be provided")
+		}
+
+		resp, err := executionService.SandboxStats(gocontext.Background(), &execution.SandboxStatsRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(os.Stdout, string(resp.Stats))
+		return err
+	},
+}
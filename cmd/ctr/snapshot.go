@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/docker/containerd/snapshot/overlay"
+	"github.com/docker/go-units"
+	"github.com/urfave/cli"
+)
+
+var snapshotCommand = cli.Command{
+	Name:  "snapshot",
+	Usage: "inspect and remove the overlay snapshots of the host",
+	Subcommands: []cli.Command{
+		snapshotListCommand,
+		snapshotTreeCommand,
+		snapshotUsageCommand,
+		snapshotRemoveCommand,
+		snapshotMountCommand,
+	},
+}
+
+var snapshotListCommand = cli.Command{
+	Name:    "list",
+	Aliases: []string{"ls"},
+	Usage:   "list the committed and active snapshots",
+	Flags:   []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		snapshotter, err := getSnapshotter(context)
+		if err != nil {
+			return err
+		}
+		infos, err := snapshotter.List()
+		if err != nil {
+			return err
+		}
+		return printOutput(context, infos, func(w io.Writer) {
+			fmt.Fprintln(w, "NAME\tPARENT\tSTATE")
+			for _, info := range infos {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", info.Name, info.Parent, snapshotState(info))
+			}
+		})
+	},
+}
+
+var snapshotTreeCommand = cli.Command{
+	Name:  "tree",
+	Usage: "display the snapshots as the trees of their parents",
+	Action: func(context *cli.Context) error {
+		snapshotter, err := getSnapshotter(context)
+		if err != nil {
+			return err
+		}
+		infos, err := snapshotter.List()
+		if err != nil {
+			return err
+		}
+		var (
+			names    = make(map[string]bool)
+			children = make(map[string][]overlay.Info)
+		)
+		for _, info := range infos {
+			if !info.Active {
+				names[info.Name] = true
+			}
+		}
+		for _, info := range infos {
+			parent := info.Parent
+			if !names[parent] {
+				// snapshots whose parent is missing are displayed as roots
+				parent = ""
+			}
+			children[parent] = append(children[parent], info)
+		}
+		var printTree func(parent, indent string)
+		printTree = func(parent, indent string) {
+			for _, info := range children[parent] {
+				fmt.Printf("%s%s (%s)\n", indent, info.Name, snapshotState(info))
+				if !info.Active {
+					printTree(info.Name, indent+"  ")
+				}
+			}
+		}
+		printTree("", "")
+		return nil
+	},
+}
+
+var snapshotUsageCommand = cli.Command{
+	Name:      "usage",
+	Usage:     "display the disk usage of the changes of snapshots, all of them when none is provided",
+	ArgsUsage: "[NAME...]",
+	Flags:     []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		snapshotter, err := getSnapshotter(context)
+		if err != nil {
+			return err
+		}
+		names := []string(context.Args())
+		if len(names) == 0 {
+			infos, err := snapshotter.List()
+			if err != nil {
+				return err
+			}
+			for _, info := range infos {
+				names = append(names, info.Name)
+			}
+		}
+		var usages []snapshotUsage
+		for _, name := range names {
+			usage, err := snapshotter.Usage(name)
+			if err != nil {
+				return err
+			}
+			usages = append(usages, snapshotUsage{Name: name, Usage: usage})
+		}
+		sort.Sort(bySize(usages))
+		return printOutput(context, usages, func(w io.Writer) {
+			fmt.Fprintln(w, "NAME\tSIZE\tINODES")
+			for _, u := range usages {
+				fmt.Fprintf(w, "%s\t%s\t%d\n", u.Name, units.HumanSize(float64(u.Size)), u.Inodes)
+			}
+		})
+	},
+}
+
+var snapshotRemoveCommand = cli.Command{
+	Name:      "remove",
+	Aliases:   []string{"rm"},
+	Usage:     "remove snapshots, committed ones must not be the parent of another snapshot",
+	ArgsUsage: "NAME [NAME...]",
+	Action: func(context *cli.Context) error {
+		if context.NArg() == 0 {
+			return fmt.Errorf("at least one snapshot must be provided")
+		}
+		snapshotter, err := getSnapshotter(context)
+		if err != nil {
+			return err
+		}
+		var failed []string
+		for _, name := range context.Args() {
+			if err := snapshotter.Remove(name); err != nil {
+				fmt.Fprintf(os.Stderr, "ctr: failed to remove %s: %v\n", name, err)
+				failed = append(failed, name)
+				continue
+			}
+			fmt.Println(name)
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to remove %s", strings.Join(failed, ", "))
+		}
+		return nil
+	},
+}
+
+var snapshotMountCommand = cli.Command{
+	Name:      "mount",
+	Usage:     "print the mount commands of an active snapshot on a target",
+	ArgsUsage: "TARGET KEY",
+	Action: func(context *cli.Context) error {
+		if context.NArg() != 2 {
+			return fmt.Errorf("a target and the key of an active snapshot must be provided")
+		}
+		snapshotter, err := getSnapshotter(context)
+		if err != nil {
+			return err
+		}
+		mounts, err := snapshotter.Mounts(context.Args().Get(1))
+		if err != nil {
+			return err
+		}
+		for _, m := range mounts {
+			fmt.Printf("mount -t %s %s %s -o %s\n", m.Type, m.Source, context.Args().First(), strings.Join(m.Options, ","))
+		}
+		return nil
+	},
+}
+
+func snapshotState(info overlay.Info) string {
+	if info.Active {
+		return "active"
+	}
+	return "committed"
+}
+
+type snapshotUsage struct {
+	Name string `json:"name"`
+	overlay.Usage
+}
+
+// bySize sorts the usages from the largest snapshot.
+type bySize []snapshotUsage
+
+func (b bySize) Len() int           { return len(b) }
+func (b bySize) Less(i, j int) bool { return b[i].Size > b[j].Size }
+func (b bySize) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/docker/containerd/gc"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/specification"
+	"github.com/urfave/cli"
+)
+
+var specCommand = cli.Command{
+	Name:      "spec",
+	Usage:     "generate the config.json of a bundle",
+	ArgsUsage: "[ARGS...]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "output, o",
+			Usage: "file to write the spec to instead of stdout",
+		},
+		cli.BoolFlag{
+			Name:  "tty, t",
+			Usage: "allocate a TTY for the process",
+		},
+		cli.StringFlag{
+			Name:  "cwd",
+			Usage: "working directory of the process",
+		},
+		cli.StringSliceFlag{
+			Name:  "env, e",
+			Usage: "environment variable of the process",
+		},
+		cli.StringFlag{
+			Name:  "user, u",
+			Usage: "user of the process as uid[:gid]",
+		},
+		cli.StringFlag{
+			Name:  "hostname",
+			Usage: "hostname of the container",
+		},
+		cli.BoolFlag{
+			Name:  "readonly",
+			Usage: "mount the rootfs read only",
+		},
+		cli.BoolFlag{
+			Name:  "privileged",
+			Usage: "grant all capabilities and devices to the container",
+		},
+		cli.StringSliceFlag{
+			Name:  "cap-add",
+			Usage: "capability to add to the defaults",
+		},
+		cli.StringSliceFlag{
+			Name:  "cap-drop",
+			Usage: "capability to drop from the defaults",
+		},
+		cli.StringFlag{
+			Name:  "apparmor",
+			Usage: "apparmor profile of the container, the default profile when apparmor is enabled",
+		},
+		cli.StringFlag{
+			Name:  "seccomp",
+			Usage: "seccomp profile of the container: default, unconfined or the path of a JSON profile",
+		},
+		cli.StringFlag{
+			Name:  "image",
+			Usage: "name of the image the rootfs was unpacked from, kept from garbage collection while the container exists",
+		},
+		cli.StringFlag{
+			Name:  "snapshot",
+			Usage: "name of the snapshot mounted as the rootfs, kept from garbage collection while the container exists",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		seccomp, err := seccompProfile(context.String("seccomp"))
+		if err != nil {
+			return err
+		}
+		annotations := make(map[string]string)
+		if name := context.String("image"); name != "" {
+			annotations[images.AnnotationImageName] = name
+		}
+		if key := context.String("snapshot"); key != "" {
+			annotations[gc.AnnotationSnapshot] = key
+		}
+		s, err := specification.Generate(specification.Opts{
+			Args:           context.Args(),
+			Env:            context.StringSlice("env"),
+			Cwd:            context.String("cwd"),
+			User:           context.String("user"),
+			Terminal:       context.Bool("tty"),
+			Hostname:       context.String("hostname"),
+			ReadonlyRootfs: context.Bool("readonly"),
+			Annotations:    annotations,
+			Security: specification.SecurityOpts{
+				Privileged:      context.Bool("privileged"),
+				CapAdd:          context.StringSlice("cap-add"),
+				CapDrop:         context.StringSlice("cap-drop"),
+				Seccomp:         seccomp,
+				ApparmorProfile: context.String("apparmor"),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		out := os.Stdout
+		if path := context.String("output"); path != "" {
+			f, err := os.Create(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "\t")
+		return enc.Encode(s)
+	},
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/docker/containerd/cgroups"
+	"github.com/docker/docker/pkg/term"
+	"github.com/docker/go-units"
+	"github.com/urfave/cli"
+)
+
+// statsInterval is the interval between the samples of ctr stats, the cpu
+// usage is that of the interval.
+const statsInterval = time.Second
+
+var statsCommand = cli.Command{
+	Name:      "stats",
+	Usage:     "display the resource usage of containers, all the running ones when none is provided",
+	ArgsUsage: "[CONTAINER...]",
+	Flags: []cli.Flag{
+		outputFlag,
+		cli.BoolFlag{
+			Name:  "stream",
+			Usage: "refresh the usage every second until interrupted",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+		ids := []string(context.Args())
+		all := len(ids) == 0
+		if all {
+			if err := listContainers(executionService, func(c *execution.Container) error {
+				if c.Status == execution.Status_RUNNING || c.Status == execution.Status_PAUSED {
+					ids = append(ids, c.ID)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		previous, err := sampleStats(executionService, ids, all, nil)
+		if err != nil {
+			return err
+		}
+		clear := context.Bool("stream") && context.String("output") != "json" && term.IsTerminal(os.Stdout.Fd())
+		for {
+			time.Sleep(statsInterval)
+			stats, err := sampleStats(executionService, ids, all, previous)
+			if err != nil {
+				return err
+			}
+			if clear {
+				fmt.Print("\x1b[2J\x1b[H")
+			}
+			if err := printOutput(context, stats, func(w io.Writer) {
+				fmt.Fprintln(w, "CONTAINER\tCPU %\tMEM USAGE / LIMIT\tMEM %\tPIDS\tIO READ / WRITE")
+				for _, s := range stats {
+					m := s.Metrics
+					limit, percent := "-", "-"
+					if m.Memory.Limit > 0 {
+						limit = units.BytesSize(float64(m.Memory.Limit))
+						percent = fmt.Sprintf("%.2f%%", float64(m.Memory.Usage)*100/float64(m.Memory.Limit))
+					}
+					var read, write uint64
+					for _, d := range m.IO {
+						read += d.Rbytes
+						write += d.Wbytes
+					}
+					fmt.Fprintf(w, "%s\t%.2f%%\t%s / %s\t%s\t%d\t%s / %s\n", s.ID, s.CPUPercent,
+						units.BytesSize(float64(m.Memory.Usage)), limit, percent, m.Pids.Current,
+						units.HumanSize(float64(read)), units.HumanSize(float64(write)))
+				}
+			}); err != nil {
+				return err
+			}
+			if !context.Bool("stream") {
+				return nil
+			}
+			previous = stats
+		}
+	},
+}
+
+// containerStats is the resource usage of a container. CPUPercent is the cpu
+// usage since the previous sample, in percent of a cpu.
+type containerStats struct {
+	ID         string           `json:"id"`
+	CPUPercent float64          `json:"cpuPercent"`
+	Metrics    *cgroups.Metrics `json:"metrics"`
+
+	sampled time.Time
+}
+
+// sampleStats reads the usage of the containers, computing their cpu usage
+// since the previous samples. The containers which are gone are skipped when
+// all is set, as the running containers were listed.
+func sampleStats(executionService execution.ExecutionServiceClient, ids []string, all bool, previous []*containerStats) ([]*containerStats, error) {
+	last := make(map[string]*containerStats)
+	for _, s := range previous {
+		last[s.ID] = s
+	}
+	var stats []*containerStats
+	for _, id := range ids {
+		resp, err := executionService.Stats(gocontext.Background(), &execution.StatsContainerRequest{
+			ID: id,
+		})
+		if err != nil {
+			if all {
+				continue
+			}
+			return nil, err
+		}
+		s := &containerStats{
+			ID:      id,
+			Metrics: &cgroups.Metrics{},
+			sampled: time.Now(),
+		}
+		if err := json.Unmarshal(resp.Stats, s.Metrics); err != nil {
+			return nil, err
+		}
+		if l, ok := last[id]; ok && s.Metrics.CPU.UsageUsec >= l.Metrics.CPU.UsageUsec {
+			elapsed := s.sampled.Sub(l.sampled)
+			usage := time.Duration(s.Metrics.CPU.UsageUsec-l.Metrics.CPU.UsageUsec) * time.Microsecond
+			s.CPUPercent = float64(usage) * 100 / float64(elapsed)
+		}
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
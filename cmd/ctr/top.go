@@ -0,0 +1,49 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/urfave/cli"
+)
+
+var topCommand = cli.Command{
+	Name:      "top",
+	Usage:     "list the processes of a container with their host pids, those started by its processes included",
+	ArgsUsage: "CONTAINER",
+	Flags:     []cli.Flag{outputFlag},
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("container id must be provided")
+		}
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+		resp, err := listProcesses(gocontext.Background(), executionService, id)
+		if err != nil {
+			return err
+		}
+		processes := resp.HostProcesses
+		if len(processes) == 0 {
+			// the runtime does not enumerate the processes of the cgroup
+			for _, p := range resp.Processes {
+				processes = append(processes, &execution.HostProcess{
+					Pid:       p.Pid,
+					Args:      p.Args,
+					ProcessID: p.ID,
+				})
+			}
+		}
+		return printOutput(context, processes, func(w io.Writer) {
+			fmt.Fprintln(w, "PID\tPROCESS\tARGS")
+			for _, p := range processes {
+				fmt.Fprintf(w, "%d\t%s\t%s\n", p.Pid, p.ProcessID, strings.Join(p.Args, " "))
+			}
+		})
+	},
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/docker/containerd"
+	api "github.com/docker/containerd/api/images"
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/gc"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/snapshot/overlay"
+	"github.com/docker/containerd/specification"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+// snapshotFilename is the file of the bundles created from an image holding
+// the key of the snapshot of their rootfs, telling them from the bundles
+// managed by the user.
+const snapshotFilename = "snapshot"
+
+// imageBundle is the bundle of a container run from an image, whose rootfs
+// is an active snapshot of the layers of the image.
+type imageBundle struct {
+	path string
+	// key is the active snapshot of the rootfs, empty until it is prepared
+	key         string
+	snapshotter *overlay.Overlayfs
+}
+
+// createImageBundle resolves the image name, unpacks its layers and creates
+// a bundle for the container id in a temporary directory, running args
+// after the entrypoint of the image, or its command when args are empty.
+func createImageBundle(context *cli.Context, name, id string, args []string) (_ *imageBundle, err error) {
+	imagesService, err := getImagesService(context)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := imagesService.Get(gocontext.Background(), &api.GetImageRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	dgst, err := digest.Parse(resp.Image.Target.Digest)
+	if err != nil {
+		return nil, err
+	}
+	cs, err := getContentStore(context)
+	if err != nil {
+		return nil, err
+	}
+	details, err := images.Resolve(cs, images.Image{
+		Name: name,
+		Target: images.Descriptor{
+			MediaType: resp.Image.Target.MediaType,
+			Digest:    dgst,
+			Size:      resp.Image.Target.Size_,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	snapshotter, err := getSnapshotter(context)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := unpackLayers(cs, snapshotter, details.Layers)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unpack %s", name)
+	}
+
+	path, err := ioutil.TempDir("", "ctr-run-")
+	if err != nil {
+		return nil, err
+	}
+	b := &imageBundle{
+		path:        path,
+		snapshotter: snapshotter,
+	}
+	defer func() {
+		if err != nil {
+			b.remove()
+		}
+	}()
+	rootfs := filepath.Join(path, "rootfs")
+	if err := os.Mkdir(rootfs, 0755); err != nil {
+		return nil, err
+	}
+	key := context.GlobalString("namespace") + "/" + id
+	mounts, err := snapshotter.Prepare(key, parent.String())
+	if err != nil {
+		return nil, err
+	}
+	b.key = key
+	if err := ioutil.WriteFile(filepath.Join(path, snapshotFilename), []byte(key), 0600); err != nil {
+		return nil, err
+	}
+	if err := containerd.MountFS(mounts, rootfs); err != nil {
+		return nil, errors.Wrap(err, "failed to mount the rootfs")
+	}
+	spec, err := specification.Generate(specification.Opts{
+		Image:     &details.Config.Config,
+		Args:      args,
+		Terminal:  context.Bool("tty"),
+		RootfsDir: rootfs,
+		// the image and the snapshot are kept from collection as long as
+		// the container exists
+		Annotations: map[string]string{
+			images.AnnotationImageName: name,
+			gc.AnnotationSnapshot:      b.key,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(path, "config.json"), data, 0600); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// loadImageBundle returns the bundle at path when it was created from an
+// image, nil otherwise.
+func loadImageBundle(context *cli.Context, path string) (*imageBundle, error) {
+	key, err := ioutil.ReadFile(filepath.Join(path, snapshotFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	snapshotter, err := getSnapshotter(context)
+	if err != nil {
+		return nil, err
+	}
+	return &imageBundle{
+		path:        path,
+		key:         string(key),
+		snapshotter: snapshotter,
+	}, nil
+}
+
+// remove unmounts the rootfs, removing its snapshot, and the bundle. It does
+// nothing once the bundle was removed, such as along with its container by
+// a forced delete.
+func (b *imageBundle) remove() error {
+	if _, err := os.Stat(b.path); os.IsNotExist(err) {
+		return nil
+	}
+	rootfs := filepath.Join(b.path, "rootfs")
+	if err := syscall.Unmount(rootfs, 0); err != nil && err != syscall.EINVAL && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to unmount the rootfs")
+	}
+	if b.key != "" {
+		if err := b.snapshotter.Remove(b.key); err != nil {
+			return err
+		}
+	}
+	return os.RemoveAll(b.path)
+}
+
+// unpackLayers applies the layers missing from the snapshotter, committing
+// each under its chain id, and returns the chain id of the last one.
+func unpackLayers(cs *content.ContentStore, snapshotter *overlay.Overlayfs, layers []images.Layer) (digest.Digest, error) {
+	var parent digest.Digest
+	for _, l := range layers {
+		if !snapshotter.Committed(l.ChainID.String()) {
+			if err := unpackLayer(cs, snapshotter, l, parent); err != nil {
+				return "", errors.Wrapf(err, "failed to unpack layer %s", l.Descriptor.Digest)
+			}
+		}
+		parent = l.ChainID
+	}
+	return parent, nil
+}
+
+func unpackLayer(cs *content.ContentStore, snapshotter *overlay.Overlayfs, l images.Layer, parent digest.Digest) (err error) {
+	// the key is unique for layers unpacked concurrently not to share it
+	key := fmt.Sprintf("unpack-%s-%d", l.ChainID, time.Now().UnixNano())
+	mounts, err := snapshotter.Prepare(key, parent.String())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			snapshotter.Remove(key)
+		}
+	}()
+	dir, err := ioutil.TempDir("", "ctr-unpack-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(dir)
+	if err := containerd.MountFS(mounts, dir); err != nil {
+		return err
+	}
+	err = images.ApplyLayer(cs, l, dir)
+	if uerr := syscall.Unmount(dir, 0); err == nil {
+		err = uerr
+	}
+	if err != nil {
+		return err
+	}
+	if err := snapshotter.Commit(l.ChainID.String(), key); err != nil {
+		// the layer may have been unpacked by another run in between
+		if snapshotter.Committed(l.ChainID.String()) {
+			snapshotter.Remove(key)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/docker/containerd/api/execution"
+	"github.com/urfave/cli"
+)
+
+var updateCommand = cli.Command{
+	Name:      "update",
+	Usage:     "update the resources of a container",
+	ArgsUsage: "CONTAINER",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "resources, r",
+			Usage: "path to a file with the OCI linux resources as json, '-' reads stdin",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("container id must be provided")
+		}
+
+		var resources []byte
+		switch path := context.String("resources"); path {
+		case "":
+			return fmt.Errorf("resources must be provided")
+		case "-":
+			resources, err = ioutil.ReadAll(os.Stdin)
+		default:
+			resources, err = ioutil.ReadFile(path)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = executionService.Update(gocontext.Background(), &execution.UpdateContainerRequest{
+			ContainerID: id,
+			Resources:   resources,
+		})
+		return err
+	},
+}
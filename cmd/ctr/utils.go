@@ -1,6 +1,9 @@
 package main
 
 import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -8,117 +11,470 @@ import (
 	"net"
 	"os"
 	"path/filepath"
-	"sync"
-	"syscall"
+	"strconv"
+	"strings"
 	"time"
 
-	gocontext "context"
-
+	"github.com/docker/containerd/api/checkpoint"
+	"github.com/docker/containerd/api/debug"
 	"github.com/docker/containerd/api/execution"
-	"github.com/tonistiigi/fifo"
+	"github.com/docker/containerd/api/gc"
+	"github.com/docker/containerd/api/images"
+	"github.com/docker/containerd/api/introspection"
+	"github.com/docker/containerd/api/leases"
+	"github.com/docker/containerd/api/migration"
+	"github.com/docker/containerd/content"
+	imagesstore "github.com/docker/containerd/images"
+	"github.com/docker/containerd/layout"
+	"github.com/docker/containerd/namespaces"
+	"github.com/docker/containerd/remotes"
+	"github.com/docker/containerd/snapshot/overlay"
+	"github.com/docker/containerd/specification"
+	"github.com/docker/containerd/stdio"
 	"github.com/urfave/cli"
+	netcontext "golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
 )
 
 var grpcConn *grpc.ClientConn
 
-func prepareStdio(stdin, stdout, stderr string, console bool) (*sync.WaitGroup, error) {
-	var wg sync.WaitGroup
-	ctx := gocontext.Background()
+// prepareStdio creates the fifos for the stdio of a process and attaches
+// the stdio of ctr to them.
+func prepareStdio(id string, console bool) (*stdio.FIFOSet, *stdio.Attachment, error) {
+	fifos, err := stdio.NewFIFOSet(filepath.Join(os.TempDir(), "ctr"), id, console)
+	if err != nil {
+		return nil, nil, err
+	}
+	attach, err := fifos.Attach(os.Stdin, os.Stdout, os.Stderr)
+	if err != nil {
+		fifos.Close()
+		return nil, nil, err
+	}
+	return fifos, attach, nil
+}
+
+const defaultAddress = "/run/containerd/containerd.sock"
 
-	f, err := fifo.OpenFifo(ctx, stdin, syscall.O_WRONLY|syscall.O_CREAT|syscall.O_NONBLOCK, 0700)
+func getGRPCConnection(context *cli.Context) (*grpc.ClientConn, error) {
+	if grpcConn != nil {
+		return grpcConn, nil
+	}
+
+	address := context.GlobalString("address")
+	if socket := context.GlobalString("socket"); socket != "" && !context.GlobalIsSet("address") {
+		address = socket
+	}
+	conn, err := dial(context, address)
 	if err != nil {
 		return nil, err
 	}
-	defer func(c io.Closer) {
+	grpcConn = conn
+	return grpcConn, nil
+}
+
+// dial connects to the daemon at address with the global flags, such as
+// another daemon than that of ctr.
+func dial(context *cli.Context, address string) (*grpc.ClientConn, error) {
+	target := address
+	namespace := context.GlobalString("namespace")
+	if err := namespaces.Validate(namespace); err != nil {
+		return nil, err
+	}
+	// reset the logger for grpc to log to dev/null so that it does not mess with our stdio
+	grpclog.SetLogger(log.New(ioutil.Discard, "", log.LstdFlags))
+	dialOpts := []grpc.DialOption{
+		grpc.WithBlock(),
+		// the daemon may be configured to compress its responses
+		grpc.WithDecompressor(grpc.NewGZIPDecompressor()),
+		grpc.WithTimeout(context.GlobalDuration("timeout")),
+		grpc.WithUnaryInterceptor(func(ctx netcontext.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(withNamespace(ctx, namespace), method, req, reply, cc, opts...)
+		}),
+		grpc.WithStreamInterceptor(func(ctx netcontext.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(withNamespace(ctx, namespace), desc, cc, method, opts...)
+		}),
+	}
+	switch {
+	case strings.HasPrefix(address, "tcp://"):
+		address = strings.TrimPrefix(address, "tcp://")
+		if context.GlobalBool("plaintext") {
+			dialOpts = append(dialOpts, grpc.WithInsecure())
+			break
+		}
+		config, err := clientTLSConfig(context, address)
 		if err != nil {
-			c.Close()
+			return nil, err
 		}
-	}(f)
-	go func(w io.Writer) {
-		io.Copy(w, os.Stdin)
-		f.Close()
-	}(f)
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	case strings.Contains(address, "://") && !strings.HasPrefix(address, "unix://"):
+		return nil, fmt.Errorf("unsupported address %s, the scheme must be unix or tcp", address)
+	default:
+		socket := strings.TrimPrefix(address, "unix://")
+		address = "unix://" + socket
+		dialOpts = append(dialOpts,
+			grpc.WithInsecure(),
+			grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+				return net.DialTimeout("unix", socket, timeout)
+			}),
+		)
+	}
 
-	f, err = fifo.OpenFifo(ctx, stdout, syscall.O_RDONLY|syscall.O_CREAT|syscall.O_NONBLOCK, 0700)
+	conn, err := grpc.Dial(address, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %v", target, err)
+	}
+	return conn, nil
+}
+
+// withNamespace sets the namespace in the metadata of the request.
+func withNamespace(ctx netcontext.Context, namespace string) netcontext.Context {
+	md, _ := metadata.FromContext(ctx)
+	return metadata.NewContext(ctx, metadata.Join(md, metadata.Pairs(namespaces.GRPCHeader, namespace)))
+}
+
+// clientTLSConfig returns the TLS configuration of the connection to the
+// tcp address, from the tls flags.
+func clientTLSConfig(context *cli.Context, address string) (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName: context.GlobalString("tls-server-name"),
+		MinVersion: tls.VersionTLS12,
+	}
+	if config.ServerName == "" {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %s: %v", address, err)
+		}
+		config.ServerName = host
+	}
+	if ca := context.GlobalString("tls-ca"); ca != "" {
+		data, err := ioutil.ReadFile(ca)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA: %v", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in CA %s", ca)
+		}
+	}
+	cert, key := context.GlobalString("tls-cert"), context.GlobalString("tls-key")
+	if (cert == "") != (key == "") {
+		return nil, fmt.Errorf("--tls-cert and --tls-key must be set together")
+	}
+	if cert != "" {
+		pair, err := tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{pair}
+	}
+	return config, nil
+}
+
+func getExecutionService(context *cli.Context) (execution.ExecutionServiceClient, error) {
+	conn, err := getGRPCConnection(context)
 	if err != nil {
 		return nil, err
 	}
-	defer func(c io.Closer) {
+	return execution.NewExecutionServiceClient(conn), nil
+}
+
+// listContainers calls fn with each container of the namespace as they are
+// streamed by the daemon.
+func listContainers(executionService execution.ExecutionServiceClient, fn func(*execution.Container) error) error {
+	stream, err := executionService.ListStream(netcontext.Background(), &execution.ListContainersRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
 		if err != nil {
-			c.Close()
+			if err == io.EOF {
+				return nil
+			}
+			return err
 		}
-	}(f)
-	wg.Add(1)
-	go func(r io.Reader) {
-		io.Copy(os.Stdout, r)
-		f.Close()
-		wg.Done()
-	}(f)
+		for _, c := range resp.Containers {
+			if err := fn(c); err != nil {
+				return err
+			}
+		}
+	}
+}
 
-	f, err = fifo.OpenFifo(ctx, stderr, syscall.O_RDONLY|syscall.O_CREAT|syscall.O_NONBLOCK, 0700)
+// listProcesses returns the processes of the container id, as streamed by
+// the daemon.
+func listProcesses(ctx netcontext.Context, executionService execution.ExecutionServiceClient, id string) (*execution.ListProcessesResponse, error) {
+	stream, err := executionService.ListProcessesStream(ctx, &execution.ListProcessesRequest{
+		ID: id,
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer func(c io.Closer) {
+	processes := &execution.ListProcessesResponse{}
+	for {
+		resp, err := stream.Recv()
 		if err != nil {
-			c.Close()
+			if err == io.EOF {
+				return processes, nil
+			}
+			return nil, err
 		}
-	}(f)
-	if !console {
-		wg.Add(1)
-		go func(r io.Reader) {
-			io.Copy(os.Stderr, r)
-			f.Close()
-			wg.Done()
-		}(f)
+		processes.Processes = append(processes.Processes, resp.Processes...)
+		processes.HostProcesses = append(processes.HostProcesses, resp.HostProcesses...)
 	}
+}
 
-	return &wg, nil
+func getDebugService(context *cli.Context) (debug.DebugServiceClient, error) {
+	conn, err := getGRPCConnection(context)
+	if err != nil {
+		return nil, err
+	}
+	return debug.NewDebugServiceClient(conn), nil
 }
 
-func getGRPCConnection(context *cli.Context) (*grpc.ClientConn, error) {
-	if grpcConn != nil {
-		return grpcConn, nil
+func getImagesService(context *cli.Context) (images.ImageServiceClient, error) {
+	conn, err := getGRPCConnection(context)
+	if err != nil {
+		return nil, err
 	}
+	return images.NewImageServiceClient(conn), nil
+}
 
-	bindSocket := context.GlobalString("socket")
-	// reset the logger for grpc to log to dev/null so that it does not mess with our stdio
-	grpclog.SetLogger(log.New(ioutil.Discard, "", log.LstdFlags))
-	dialOpts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithTimeout(100 * time.Second)}
-	dialOpts = append(dialOpts,
-		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
-			return net.DialTimeout("unix", bindSocket, timeout)
+// getLayout returns the directories of the daemon under --root, the state
+// of its running containers being left to the daemon.
+func getLayout(context *cli.Context) layout.Layout {
+	return layout.Layout{Root: context.GlobalString("root")}
+}
+
+// getContentStore opens the content store of the daemon, on the same host.
+func getContentStore(context *cli.Context) (*content.ContentStore, error) {
+	return content.OpenContentStore(getLayout(context).Content())
+}
+
+// getSnapshotter opens the overlay snapshots of the daemon, on the same host.
+func getSnapshotter(context *cli.Context) (*overlay.Overlayfs, error) {
+	return overlay.NewOverlayfs(getLayout(context).Snapshots("overlay"))
+}
+
+// registryFlags configure the registry of pull and push.
+var registryFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "platform",
+		Usage: "platform of the manifest as os/architecture[/variant], that of ctr when unset",
+	},
+	cli.StringFlag{
+		Name:  "user, u",
+		Usage: "credentials of the registry as user[:password], the password is read from stdin when omitted",
+	},
+	cli.BoolFlag{
+		Name:  "plain-http",
+		Usage: "contact the registry over http rather than https",
+	},
+	cli.BoolFlag{
+		Name:  "skip-verify",
+		Usage: "skip the verification of the certificate of the registry",
+	},
+	cli.StringFlag{
+		Name:  "ca-file",
+		Usage: "PEM bundle of the certificate authorities of the registry, in addition to those of the system",
+	},
+}
+
+// getRegistry returns the registry of ref configured by the registryFlags.
+func getRegistry(context *cli.Context, ref remotes.Reference) (*remotes.Registry, error) {
+	host := ref.RegistryHost()
+	resolver := remotes.NewResolver(map[string]remotes.HostConfig{
+		host: {
+			PlainHTTP:          context.Bool("plain-http"),
+			InsecureSkipVerify: context.Bool("skip-verify"),
+			CAFile:             context.String("ca-file"),
 		},
-		))
+	})
+	registry, err := resolver.Registry(host)
+	if err != nil {
+		return nil, err
+	}
+	if user := context.String("user"); user != "" {
+		parts := strings.SplitN(user, ":", 2)
+		if len(parts) == 1 {
+			password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			parts = append(parts, strings.TrimRight(password, "\r\n"))
+		}
+		registry.SetCredentials(parts[0], parts[1])
+	}
+	return registry, nil
+}
 
-	conn, err := grpc.Dial(fmt.Sprintf("unix://%s", bindSocket), dialOpts...)
+func parsePlatform(s string) (imagesstore.Platform, error) {
+	if s == "" {
+		return imagesstore.DefaultPlatform(), nil
+	}
+	return imagesstore.ParsePlatform(s)
+}
+
+func getGCService(context *cli.Context) (gc.GCServiceClient, error) {
+	conn, err := getGRPCConnection(context)
 	if err != nil {
 		return nil, err
 	}
+	return gc.NewGCServiceClient(conn), nil
+}
 
-	grpcConn = conn
-	return grpcConn, nil
+func getIntrospectionService(context *cli.Context) (introspection.IntrospectionServiceClient, error) {
+	conn, err := getGRPCConnection(context)
+	if err != nil {
+		return nil, err
+	}
+	return introspection.NewIntrospectionServiceClient(conn), nil
 }
 
-func getExecutionService(context *cli.Context) (execution.ExecutionServiceClient, error) {
+func getLeaseService(context *cli.Context) (leases.LeaseServiceClient, error) {
 	conn, err := getGRPCConnection(context)
 	if err != nil {
 		return nil, err
 	}
-	return execution.NewExecutionServiceClient(conn), nil
+	return leases.NewLeaseServiceClient(conn), nil
 }
 
-func getTempDir(id string) (string, error) {
-	err := os.MkdirAll(filepath.Join(os.TempDir(), "ctr"), 0700)
+func getCheckpointService(context *cli.Context) (checkpoint.CheckpointServiceClient, error) {
+	conn, err := getGRPCConnection(context)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	return checkpoint.NewCheckpointServiceClient(conn), nil
+}
+
+func getMigrationService(context *cli.Context) (migration.MigrationServiceClient, error) {
+	conn, err := getGRPCConnection(context)
+	if err != nil {
+		return nil, err
 	}
+	return migration.NewMigrationServiceClient(conn), nil
+}
 
-	tmpDir, err := ioutil.TempDir(filepath.Join(os.TempDir(), "ctr"), fmt.Sprintf("%s-", id))
+// seccompProfile returns the builtin profile named by value, or the content
+// of the profile file at the path value.
+func seccompProfile(value string) (string, error) {
+	switch value {
+	case "", specification.SeccompDefault, specification.SeccompUnconfined:
+		return value, nil
+	}
+	data, err := ioutil.ReadFile(value)
 	if err != nil {
 		return "", err
 	}
-	return tmpDir, nil
+	return string(data), nil
+}
+
+// parseIDMappings parses mappings of the form container-id:host-id:size.
+func parseIDMappings(values []string) ([]*execution.IDMapping, error) {
+	var mappings []*execution.IDMapping
+	for _, v := range values {
+		parts := strings.Split(v, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid id mapping %q, expected container-id:host-id:size", v)
+		}
+		var ids [3]uint32
+		for i, p := range parts {
+			id, err := strconv.ParseUint(p, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid id mapping %q: %v", v, err)
+			}
+			ids[i] = uint32(id)
+		}
+		mappings = append(mappings, &execution.IDMapping{
+			ContainerID: ids[0],
+			HostID:      ids[1],
+			Length:      ids[2],
+		})
+	}
+	return mappings, nil
+}
+
+// parseNewPrivileges parses the new privileges setting of a process, allow or
+// deny, following the container's init process when it is empty.
+func parseNewPrivileges(v string) (execution.NewPrivileges, error) {
+	switch v {
+	case "":
+		return execution.NewPrivileges_INHERIT_NEW_PRIVILEGES, nil
+	case "allow":
+		return execution.NewPrivileges_ALLOW_NEW_PRIVILEGES, nil
+	case "deny":
+		return execution.NewPrivileges_DENY_NEW_PRIVILEGES, nil
+	}
+	return 0, fmt.Errorf("invalid new privileges setting %q, expected allow or deny", v)
+}
+
+// parseSecretMounts parses secret mounts of the form name:target.
+func parseSecretMounts(values []string) ([]*execution.SecretMount, error) {
+	var mounts []*execution.SecretMount
+	for _, v := range values {
+		parts := strings.SplitN(v, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid secret %q, expected name:target", v)
+		}
+		mounts = append(mounts, &execution.SecretMount{
+			Name:   parts[0],
+			Target: parts[1],
+		})
+	}
+	return mounts, nil
+}
+
+// parseHostEntries parses hosts given as name:ip, the address may be an IPv6
+// address.
+func parseHostEntries(values []string) ([]*execution.HostEntry, error) {
+	var entries []*execution.HostEntry
+	for _, v := range values {
+		parts := strings.SplitN(v, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || net.ParseIP(parts[1]) == nil {
+			return nil, fmt.Errorf("invalid host %q, expected name:ip", v)
+		}
+		entries = append(entries, &execution.HostEntry{
+			IP:    parts[1],
+			Names: []string{parts[0]},
+		})
+	}
+	return entries, nil
+}
+
+// parsePortMappings parses ports to forward given as
+// [host-ip:]host-port:container-port[/protocol].
+func parsePortMappings(values []string) ([]*execution.PortMapping, error) {
+	var ports []*execution.PortMapping
+	for _, v := range values {
+		m := &execution.PortMapping{}
+		spec := v
+		if i := strings.LastIndex(spec, "/"); i >= 0 {
+			m.Protocol = spec[i+1:]
+			spec = spec[:i]
+		}
+		i := strings.LastIndex(spec, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid port mapping %q, expected [host-ip:]host-port:container-port[/protocol]", v)
+		}
+		containerPort, err := strconv.ParseUint(spec[i+1:], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid container port in %q", v)
+		}
+		host, port, err := net.SplitHostPort(spec[:i])
+		if err != nil {
+			host, port = "", spec[:i]
+		}
+		hostPort, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid host port in %q", v)
+		}
+		m.HostIP = host
+		m.HostPort = uint32(hostPort)
+		m.ContainerPort = uint32(containerPort)
+		ports = append(ports, m)
+	}
+	return ports, nil
 }
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	gocontext "context"
+
+	"github.com/docker/containerd/api/execution"
+	execEvents "github.com/docker/containerd/execution"
+	"github.com/docker/docker/pkg/term"
+	"github.com/nats-io/go-nats"
+	"github.com/urfave/cli"
+)
+
+// waitTimeoutCode is the exit code of wait once the timeout is over, as that
+// of timeout(1).
+const waitTimeoutCode = 124
+
+var waitCommand = cli.Command{
+	Name:      "wait",
+	Usage:     "wait for a process of a container to exit, printing its exit status and exiting with it",
+	ArgsUsage: "CONTAINER",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pid, p",
+			Usage: "process to wait for instead of the init process",
+			Value: "init",
+		},
+		cli.DurationFlag{
+			Name:  "timeout, t",
+			Usage: "time to wait for before exiting with 124, no limit when 0",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		id := context.Args().First()
+		if id == "" {
+			return fmt.Errorf("container id must be provided")
+		}
+		pid := context.String("pid")
+		executionService, err := getExecutionService(context)
+		if err != nil {
+			return err
+		}
+		exits, err := subscribeExits(context.GlobalString("namespace"))
+		if err != nil {
+			return err
+		}
+		defer exits.Close()
+
+		// the process may have exited before the subscription
+		resp, err := executionService.GetProcess(gocontext.Background(), &execution.GetProcessRequest{
+			ContainerID: id,
+			ProcessID:   pid,
+		})
+		if err != nil {
+			return err
+		}
+		ec := resp.Process.ExitStatus
+		if resp.Process.Status != execution.Status_STOPPED {
+			var exited bool
+			if ec, exited, err = exits.waitTimeout(id, pid, context.Duration("timeout")); err != nil {
+				return err
+			}
+			if !exited {
+				return cli.NewExitError(fmt.Sprintf("ctr: process %s of container %s still running after %s", pid, id, context.Duration("timeout")), waitTimeoutCode)
+			}
+		}
+		fmt.Println(ec)
+		if ec != 0 {
+			return cli.NewExitError("", int(ec))
+		}
+		return nil
+	},
+}
+
+// forwardedSignals are forwarded by run and exec to the process they wait
+// for.
+var forwardedSignals = []os.Signal{
+	syscall.SIGHUP,
+	syscall.SIGINT,
+	syscall.SIGQUIT,
+	syscall.SIGTERM,
+	syscall.SIGUSR1,
+	syscall.SIGUSR2,
+}
+
+// exitSubscription receives the exit events of the processes of the
+// daemon. It is subscribed to before the process starts, for its exit not to
+// be missed.
+type exitSubscription struct {
+	nec    *nats.EncodedConn
+	sub    *nats.Subscription
+	events chan *execEvents.ContainerExitEvent
+}
+
+func subscribeExits(namespace string) (*exitSubscription, error) {
+	nc, err := nats.Connect(nats.DefaultURL)
+	if err != nil {
+		return nil, err
+	}
+	nec, err := nats.NewEncodedConn(nc, nats.JSON_ENCODER)
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+	s := &exitSubscription{
+		nec:    nec,
+		events: make(chan *execEvents.ContainerExitEvent, 64),
+	}
+	if s.sub, err = nec.Subscribe(execEvents.ContainersEventsSubject(namespace), func(e *execEvents.ContainerExitEvent) {
+		s.events <- e
+	}); err != nil {
+		nec.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *exitSubscription) Close() {
+	s.sub.Unsubscribe()
+	s.nec.Close()
+}
+
+// wait returns the exit status of the process pid of the container id. The
+// signals received meanwhile are forwarded to the process, those of the
+// terminal reach it through its console.
+func (s *exitSubscription) wait(executionService execution.ExecutionServiceClient, id, pid string) (uint32, error) {
+	signals := make(chan os.Signal, 64)
+	signal.Notify(signals, forwardedSignals...)
+	defer signal.Stop(signals)
+	for {
+		select {
+		case e := <-s.events:
+			if e.ID == id && e.PID == pid {
+				return e.StatusCode, nil
+			}
+		case sig := <-signals:
+			if _, err := executionService.SignalProcess(gocontext.Background(), &execution.SignalProcessRequest{
+				ContainerID: id,
+				ProcessID:   pid,
+				Signal:      uint32(sig.(syscall.Signal)),
+			}); err != nil {
+				fmt.Fprintf(os.Stderr, "ctr: failed to forward %s: %v\n", sig, err)
+			}
+		case <-time.After(1 * time.Second):
+			if s.nec.Conn.Status() != nats.CONNECTED {
+				return 0, fmt.Errorf("lost the connection to the events of container %s before the exit of process %s", id, pid)
+			}
+		}
+	}
+}
+
+// waitTimeout returns the exit status of the process pid of the container
+// id, or false once timeout is over, waiting indefinitely when it is 0.
+func (s *exitSubscription) waitTimeout(id, pid string, timeout time.Duration) (uint32, bool, error) {
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		deadline = t.C
+	}
+	for {
+		select {
+		case e := <-s.events:
+			if e.ID == id && e.PID == pid {
+				return e.StatusCode, true, nil
+			}
+		case <-deadline:
+			return 0, false, nil
+		case <-time.After(1 * time.Second):
+			if s.nec.Conn.Status() != nats.CONNECTED {
+				return 0, false, fmt.Errorf("lost the connection to the events of container %s before the exit of process %s", id, pid)
+			}
+		}
+	}
+}
+
+// forwardResize resizes the console of the process pid of the container id
+// to the size of the terminal of ctr, and again each time the terminal is
+// resized, until the returned func is called.
+func forwardResize(executionService execution.ExecutionServiceClient, id, pid string) func() {
+	resize := func() {
+		ws, err := term.GetWinsize(os.Stdin.Fd())
+		if err != nil || ws.Width == 0 || ws.Height == 0 {
+			return
+		}
+		if _, err := executionService.ResizeProcess(gocontext.Background(), &execution.ResizeProcessRequest{
+			ContainerID: id,
+			ProcessID:   pid,
+			Width:       uint32(ws.Width),
+			Height:      uint32(ws.Height),
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "ctr: failed to resize the console: %v\r\n", err)
+		}
+	}
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+	done := make(chan struct{})
+	go func() {
+		resize()
+		for {
+			select {
+			case <-winch:
+				resize()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(winch)
+		close(done)
+	}
+}
@@ -0,0 +1,251 @@
+// Package cni sets up the network of containers by invoking the CNI plugins
+// of a network configuration.
+package cni
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultBinDir is the directory the CNI plugins are installed to by default.
+const DefaultBinDir = "/opt/cni/bin"
+
+// Network is a network configuration, a chain of plugins.
+type Network struct {
+	Name       string
+	CNIVersion string
+	// State records the containers added to the network when set, so that
+	// they are torn down once the daemon restarts.
+	State   *State
+	plugins []map[string]json.RawMessage
+	binDirs []string
+}
+
+// Result is the outcome of setting up a container's network.
+type Result struct {
+	// Raw is the result of the last plugin, it is passed to the plugins
+	// when tearing the network down.
+	Raw json.RawMessage `json:"raw"`
+	// IPs are the addresses assigned to the container, in CIDR notation.
+	IPs []string `json:"ips,omitempty"`
+}
+
+// Load loads the first network configuration of confDir in lexical order,
+// either a list of plugins (.conflist) or a single plugin (.conf or .json).
+// The plugins are looked up in binDirs.
+func Load(confDir string, binDirs []string) (*Network, error) {
+	files, err := ioutil.ReadDir(confDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "cni: failed to read configuration directory")
+	}
+	var names []string
+	for _, f := range files {
+		switch filepath.Ext(f.Name()) {
+		case ".conflist", ".conf", ".json":
+			names = append(names, f.Name())
+		}
+	}
+	if len(names) == 0 {
+		return nil, errors.Errorf("cni: no network configuration in %s", confDir)
+	}
+	sort.Strings(names)
+	data, err := ioutil.ReadFile(filepath.Join(confDir, names[0]))
+	if err != nil {
+		return nil, err
+	}
+	n, err := parse(data, filepath.Ext(names[0]) == ".conflist")
+	if err != nil {
+		return nil, errors.Wrapf(err, "cni: invalid network configuration %s", names[0])
+	}
+	if len(binDirs) == 0 {
+		binDirs = []string{DefaultBinDir}
+	}
+	n.binDirs = binDirs
+	return n, nil
+}
+
+func parse(data []byte, list bool) (*Network, error) {
+	var conf struct {
+		Name       string                       `json:"name"`
+		CNIVersion string                       `json:"cniVersion"`
+		Plugins    []map[string]json.RawMessage `json:"plugins"`
+	}
+	if err := json.Unmarshal(data, &conf); err != nil {
+		return nil, err
+	}
+	n := &Network{
+		Name:       conf.Name,
+		CNIVersion: conf.CNIVersion,
+		plugins:    conf.Plugins,
+	}
+	if !list {
+		var plugin map[string]json.RawMessage
+		if err := json.Unmarshal(data, &plugin); err != nil {
+			return nil, err
+		}
+		n.plugins = []map[string]json.RawMessage{plugin}
+	}
+	if n.Name == "" {
+		return nil, errors.New("missing network name")
+	}
+	if len(n.plugins) == 0 {
+		return nil, errors.New("no plugins")
+	}
+	return n, nil
+}
+
+// Setup adds the container id to the network, creating the interface ifname
+// in the network namespace at netns. The container is torn down when a plugin
+// fails, for the others to release what they allocated.
+func (n *Network) Setup(ctx context.Context, id, netns, ifname string) (*Result, error) {
+	a := Attachment{
+		ID:     id,
+		NetNS:  netns,
+		IfName: ifname,
+	}
+	if n.State != nil {
+		if err := n.State.Put(a); err != nil {
+			return nil, errors.Wrap(err, "cni: failed to record attachment")
+		}
+	}
+	var prev json.RawMessage
+	for _, p := range n.plugins {
+		out, err := n.exec(ctx, "ADD", id, netns, ifname, p, prev)
+		if err != nil {
+			n.Teardown(ctx, id, netns, ifname, nil)
+			return nil, err
+		}
+		prev = out
+	}
+	a.Result = &Result{
+		Raw: prev,
+		IPs: resultIPs(prev),
+	}
+	if n.State != nil {
+		if err := n.State.Put(a); err != nil {
+			n.Teardown(ctx, id, netns, ifname, a.Result)
+			return nil, errors.Wrap(err, "cni: failed to record attachment")
+		}
+	}
+	return a.Result, nil
+}
+
+// Teardown removes the container id from the network, in the reverse order
+// of the plugins. The network namespace may be gone already, such as when the
+// container exited, in which case netns is empty. The attachment of the
+// container is only forgotten once all the plugins succeeded.
+func (n *Network) Teardown(ctx context.Context, id, netns, ifname string, r *Result) error {
+	var prev json.RawMessage
+	if r != nil {
+		prev = r.Raw
+	}
+	for i := len(n.plugins) - 1; i >= 0; i-- {
+		if _, err := n.exec(ctx, "DEL", id, netns, ifname, n.plugins[i], prev); err != nil {
+			return err
+		}
+	}
+	if n.State != nil {
+		return n.State.Remove(id)
+	}
+	return nil
+}
+
+// exec invokes the plugin with the command, passing it its configuration
+// along with the result of the previous plugin.
+func (n *Network) exec(ctx context.Context, command, id, netns, ifname string, plugin map[string]json.RawMessage, prev json.RawMessage) (json.RawMessage, error) {
+	var typ string
+	if err := json.Unmarshal(plugin["type"], &typ); err != nil || typ == "" {
+		return nil, errors.New("cni: plugin without a type")
+	}
+	conf := make(map[string]json.RawMessage, len(plugin)+3)
+	for k, v := range plugin {
+		conf[k] = v
+	}
+	conf["name"], _ = json.Marshal(n.Name)
+	conf["cniVersion"], _ = json.Marshal(n.CNIVersion)
+	if len(prev) > 0 {
+		conf["prevResult"] = prev
+	}
+	stdin, err := json.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+	path, err := n.find(typ)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Env = append(os.Environ(),
+		"CNI_COMMAND="+command,
+		"CNI_CONTAINERID="+id,
+		"CNI_NETNS="+netns,
+		"CNI_IFNAME="+ifname,
+		"CNI_PATH="+strings.Join(n.binDirs, string(os.PathListSeparator)),
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		// plugins report their errors on stdout
+		var perr struct {
+			Code    int    `json:"code"`
+			Msg     string `json:"msg"`
+			Details string `json:"details"`
+		}
+		if json.Unmarshal(out, &perr) == nil && perr.Msg != "" {
+			return nil, errors.Errorf("cni: plugin %s %s failed: %s %s", typ, command, perr.Msg, perr.Details)
+		}
+		return nil, errors.Wrapf(err, "cni: plugin %s %s failed: %s", typ, command, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// find returns the path of the plugin binary.
+func (n *Network) find(typ string) (string, error) {
+	for _, dir := range n.binDirs {
+		p := filepath.Join(dir, typ)
+		if fi, err := os.Stat(p); err == nil && !fi.IsDir() {
+			return p, nil
+		}
+	}
+	return "", errors.Errorf("cni: plugin %s not found in %s", typ, strings.Join(n.binDirs, ", "))
+}
+
+// resultIPs returns the addresses of a result, of any version of the spec.
+func resultIPs(raw json.RawMessage) []string {
+	var r struct {
+		IPs []struct {
+			Address string `json:"address"`
+		} `json:"ips"`
+		IP4 *struct {
+			IP string `json:"ip"`
+		} `json:"ip4"`
+		IP6 *struct {
+			IP string `json:"ip"`
+		} `json:"ip6"`
+	}
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return nil
+	}
+	var ips []string
+	for _, ip := range r.IPs {
+		ips = append(ips, ip.Address)
+	}
+	if r.IP4 != nil {
+		ips = append(ips, r.IP4.IP)
+	}
+	if r.IP6 != nil {
+		ips = append(ips, r.IP6.IP)
+	}
+	return ips
+}
@@ -0,0 +1,155 @@
+package cni
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// plugin records its environment and configuration to the log, and returns
+// the address of the container on ADD.
+const plugin = `#!/bin/sh
+conf=$(cat)
+echo "$CNI_COMMAND $CNI_CONTAINERID $CNI_NETNS $CNI_IFNAME $(basename $0) $conf" >> "$(dirname $0)/log"
+if [ "$CNI_COMMAND" = ADD ]; then
+	echo '{"cniVersion":"0.3.1","ips":[{"version":"4","address":"10.88.0.2/16"}]}'
+fi
+`
+
+func TestNetwork(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cni-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bin := filepath.Join(dir, "bin")
+	conf := filepath.Join(dir, "net.d")
+	for _, d := range []string{bin, conf} {
+		if err := os.Mkdir(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"bridge", "portmap"} {
+		if err := ioutil.WriteFile(filepath.Join(bin, name), []byte(plugin), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	list := `{"cniVersion":"0.3.1","name":"test","plugins":[{"type":"bridge","bridge":"cni0"},{"type":"portmap"}]}`
+	if err := ioutil.WriteFile(filepath.Join(conf, "10-test.conflist"), []byte(list), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(conf, "99-other.conf"), []byte(`{"name":"other","type":"loopback"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := Load(conf, []string{bin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Name != "test" {
+		t.Fatalf("expected the first configuration to be loaded, got %q", n.Name)
+	}
+	r, err := n.Setup(context.Background(), "c1", "/proc/1/ns/net", "eth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(r.IPs, []string{"10.88.0.2/16"}) {
+		t.Fatalf("unexpected ips %v", r.IPs)
+	}
+	if err := n.Teardown(context.Background(), "c1", "", "eth0", r); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(bin, "log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected 4 plugin invocations, got %q", lines)
+	}
+	for i, expected := range []string{
+		"ADD c1 /proc/1/ns/net eth0 bridge ",
+		"ADD c1 /proc/1/ns/net eth0 portmap ",
+		"DEL c1  eth0 portmap ",
+		"DEL c1  eth0 bridge ",
+	} {
+		if !strings.HasPrefix(lines[i], expected) {
+			t.Errorf("expected invocation %q, got %q", expected, lines[i])
+		}
+	}
+	if strings.Contains(lines[0], "prevResult") || !strings.Contains(lines[1], `"prevResult":{"cniVersion"`) {
+		t.Errorf("expected the result of the previous plugin to be passed, got %q", lines[:2])
+	}
+	if !strings.Contains(lines[0], `"name":"test"`) || !strings.Contains(lines[0], `"bridge":"cni0"`) {
+		t.Errorf("expected the plugin configuration to be passed, got %q", lines[0])
+	}
+}
+
+func TestNetworkState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cni-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "bridge"), []byte(plugin), 0755); err != nil {
+		t.Fatal(err)
+	}
+	// failing fails its ADD, the attachment is torn down
+	failing := "#!/bin/sh\ncat > /dev/null\n[ \"$CNI_COMMAND\" = DEL ] || { echo '{\"code\":11,\"msg\":\"no addresses left\"}'; exit 1; }\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "failing"), []byte(failing), 0755); err != nil {
+		t.Fatal(err)
+	}
+	state, err := NewState(filepath.Join(dir, "state"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := parse([]byte(`{"cniVersion":"0.3.1","name":"test","type":"bridge"}`), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.binDirs = []string{dir}
+	n.State = state
+
+	r, err := n.Setup(context.Background(), "c1", "/proc/1/ns/net", "eth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := state.Get("c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == nil || a.Result == nil || !reflect.DeepEqual(a.Result.IPs, r.IPs) || a.NetNS != "/proc/1/ns/net" {
+		t.Fatalf("unexpected attachment %+v", a)
+	}
+	if err := n.Teardown(context.Background(), "c1", "", "eth0", a.Result); err != nil {
+		t.Fatal(err)
+	}
+	if attachments, err := state.List(); err != nil || len(attachments) != 0 {
+		t.Fatalf("expected no attachments after teardown, got %v %v", attachments, err)
+	}
+
+	f, err := parse([]byte(`{"cniVersion":"0.3.1","name":"test","plugins":[{"type":"bridge"},{"type":"failing"}]}`), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.binDirs = []string{dir}
+	f.State = state
+	if _, err := f.Setup(context.Background(), "c2", "/proc/1/ns/net", "eth0"); err == nil || !strings.Contains(err.Error(), "no addresses left") {
+		t.Fatalf("expected the failure of the plugin, got %v", err)
+	}
+	if a, err := state.Get("c2"); err != nil || a != nil {
+		t.Fatalf("expected the failed attachment to be torn down, got %+v %v", a, err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "DEL c2 /proc/1/ns/net eth0 bridge") {
+		t.Fatalf("expected the bridge plugin to be torn down, got %q", data)
+	}
+}
@@ -0,0 +1,134 @@
+package cni
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const attachmentExt = ".json"
+
+// Attachment records a container added to the network, for it to be torn
+// down with the result of its plugins even after a restart of the daemon, so
+// that the plugins release its addresses.
+type Attachment struct {
+	ID     string `json:"id"`
+	NetNS  string `json:"netns"`
+	IfName string `json:"ifname"`
+	// Result is nil while the container is being added, the addition was
+	// interrupted when it is still nil after a restart.
+	Result *Result `json:"result,omitempty"`
+}
+
+// State holds the attachments of a network in a directory, a file each.
+type State struct {
+	dir string
+}
+
+// NewState returns the state kept in dir.
+func NewState(dir string) (*State, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &State{dir: dir}, nil
+}
+
+// Put records the attachment, replacing any previous record of its id. The
+// record is synced to disk before Put returns.
+func (s *State) Put(a Attachment) error {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(s.dir, ".attachment-")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), s.path(a.ID)); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return syncDir(s.dir)
+}
+
+// Get returns the attachment of the container id, nil when there is none.
+func (s *State) Get(id string) (*Attachment, error) {
+	data, err := ioutil.ReadFile(s.path(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var a Attachment
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, errors.Wrapf(err, "cni: invalid attachment of %s", id)
+	}
+	return &a, nil
+}
+
+// Remove removes the attachment of the container id, if any.
+func (s *State) Remove(id string) error {
+	if err := os.Remove(s.path(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// List returns the attachments, sorted by id.
+func (s *State) List() ([]*Attachment, error) {
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, e := range entries {
+		if name := e.Name(); !strings.HasPrefix(name, ".") && filepath.Ext(name) == attachmentExt {
+			ids = append(ids, strings.TrimSuffix(name, attachmentExt))
+		}
+	}
+	sort.Strings(ids)
+	var out []*Attachment
+	for _, id := range ids {
+		a, err := s.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		if a != nil {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (s *State) path(id string) string {
+	return filepath.Join(s.dir, id+attachmentExt)
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -104,6 +104,13 @@ func (cs *ContentStore) Walk(fn func(path string, dgst digest.Digest) error) err
 	root := filepath.Join(cs.root, "blobs")
 	var alg digest.Algorithm
 	return filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if path == root && os.IsNotExist(err) {
+				// no blob was written yet
+				return nil
+			}
+			return err
+		}
 		if !fi.IsDir() && !alg.Available() {
 			return nil
 		}
@@ -151,6 +158,17 @@ func (cs *ContentStore) GetPath(dgst digest.Digest) (string, error) {
 	return p, nil
 }
 
+// Delete removes the blob dgst from the store.
+func (cs *ContentStore) Delete(dgst digest.Digest) error {
+	if err := os.Remove(filepath.Join(cs.root, "blobs", dgst.Algorithm().String(), dgst.Hex())); err != nil {
+		if os.IsNotExist(err) {
+			return ErrBlobNotFound
+		}
+		return err
+	}
+	return nil
+}
+
 // Begin starts a new write transaction against the blob store.
 //
 // The argument `ref` is used to identify the transaction. It must be a valid
@@ -238,6 +256,22 @@ func (cs *ContentStore) Resume(ref string) (*ContentWriter, error) {
 	}, nil
 }
 
+// Abort removes the transaction ref along with the progress of its write. It
+// fails if the transaction is in use.
+func (cs *ContentStore) Abort(ref string) error {
+	path, _, lock, err := cs.ingestPaths(ref)
+	if err != nil {
+		return err
+	}
+
+	if err := tryLock(lock); err != nil {
+		return err
+	}
+	defer unlock(lock)
+
+	return os.RemoveAll(path)
+}
+
 func (cs *ContentStore) ingestPaths(ref string) (string, string, lockfile.Lockfile, error) {
 	cref := filepath.Clean(ref)
 	if cref != ref {
@@ -152,6 +152,28 @@ func TestWalkBlobs(t *testing.T) {
 	}
 }
 
+func TestDeleteBlob(t *testing.T) {
+	_, cs, cleanup := contentStoreEnv(t)
+	defer cleanup()
+
+	if err := cs.Walk(func(path string, dgst digest.Digest) error {
+		t.Fatalf("unexpected blob %v in an empty store", dgst)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	dgst := checkWrite(t, cs, []byte("blob"))
+	if err := cs.Delete(dgst); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cs.GetPath(dgst); err != ErrBlobNotFound {
+		t.Fatalf("expected the blob to be deleted, got %v", err)
+	}
+	if err := cs.Delete(dgst); err != ErrBlobNotFound {
+		t.Fatalf("expected ErrBlobNotFound, got %v", err)
+	}
+}
+
 func contentStoreEnv(t interface {
 	Fatal(args ...interface{})
 }) (string, *ContentStore, func()) {
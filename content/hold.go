@@ -0,0 +1,62 @@
+package content
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/docker/containerd/sys"
+	"github.com/pkg/errors"
+)
+
+// holdFilename is the file locked by the holds of the store, shared by the
+// processes writing to it.
+const holdFilename = "hold"
+
+// holdRetry is the interval the collection retries to lock the store while
+// it is held.
+var holdRetry = 100 * time.Millisecond
+
+// Hold keeps the store from being collected until the returned function is
+// called, waiting for a running collection to complete. Writers hold the
+// store from before they ingest blobs until the blobs are referenced, by an
+// image or a lease, for a collection not to remove the blobs in between.
+// Holds are shared by any number of writers, in any process.
+func (cs *ContentStore) Hold() (func() error, error) {
+	return cs.lockHold(false, true)
+}
+
+// LockCollection waits for the holds of the store to be released and keeps
+// new ones from being taken until the returned function is called. It fails
+// once ctx is done, such as when the store is held continuously.
+func (cs *ContentStore) LockCollection(ctx context.Context) (func() error, error) {
+	for {
+		unlock, err := cs.lockHold(true, false)
+		if errors.Cause(err) != syscall.EWOULDBLOCK {
+			return unlock, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "content store is held by writers")
+		case <-time.After(holdRetry):
+		}
+	}
+}
+
+func (cs *ContentStore) lockHold(exclusive, wait bool) (func() error, error) {
+	f, err := os.OpenFile(filepath.Join(cs.root, holdFilename), os.O_RDONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := sys.LockFile(f, exclusive, wait); err != nil {
+		f.Close()
+		if err == syscall.EWOULDBLOCK {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to lock content store")
+	}
+	// closing the file releases the lock
+	return f.Close, nil
+}
@@ -0,0 +1,116 @@
+package events
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AsyncPoster posts the events with a pool of workers, for a slow poster not
+// to stall those posting them. The events of an ordering key are posted by
+// the same worker, in the order they were posted in.
+type AsyncPoster struct {
+	poster Poster
+	queues []chan postedEvent
+	wg     sync.WaitGroup
+	// closing is closed by Close, for the posts blocked on a full queue to
+	// post their event synchronously rather than hold mu.
+	closing   chan struct{}
+	closeOnce sync.Once
+
+	// mu guards closed, the queues are closed once the events posted
+	// before Close are queued.
+	mu     sync.RWMutex
+	closed bool
+}
+
+type postedEvent struct {
+	ctx   context.Context
+	event Event
+}
+
+// NewAsyncPoster returns a poster posting the events with poster from
+// workers goroutines, each queuing up to size events. Post blocks while the
+// queue of the ordering key of its event is full.
+func NewAsyncPoster(poster Poster, workers, size int) *AsyncPoster {
+	p := &AsyncPoster{
+		poster:  poster,
+		queues:  make([]chan postedEvent, workers),
+		closing: make(chan struct{}),
+	}
+	for i := range p.queues {
+		p.queues[i] = make(chan postedEvent, size)
+		p.wg.Add(1)
+		go p.run(p.queues[i])
+	}
+	return p
+}
+
+// Post queues the event for one of the workers, it is posted synchronously
+// once the poster is closing. The events still queued are then posted after
+// it, regardless of their ordering key.
+func (p *AsyncPoster) Post(ctx context.Context, event Event) {
+	if !p.queue(ctx, event) {
+		p.poster.Post(ctx, event)
+	}
+}
+
+// queue queues the event, returning false once the poster is closing.
+func (p *AsyncPoster) queue(ctx context.Context, event Event) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return false
+	}
+	h := fnv.New32a()
+	h.Write([]byte(getOrderingKey(ctx)))
+	queuedEvents.Inc()
+	select {
+	case p.queues[h.Sum32()%uint32(len(p.queues))] <- postedEvent{ctx: ctx, event: event}:
+		return true
+	case <-p.closing:
+		queuedEvents.Dec()
+		return false
+	}
+}
+
+// Close waits for the queued events to be posted, for at most timeout.
+func (p *AsyncPoster) Close(timeout time.Duration) error {
+	p.closeOnce.Do(func() {
+		close(p.closing)
+	})
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		for _, q := range p.queues {
+			close(q)
+		}
+	}
+	p.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		var n int
+		for _, q := range p.queues {
+			n += len(q)
+		}
+		return errors.Errorf("%d events left unposted", n)
+	}
+}
+
+func (p *AsyncPoster) run(queue <-chan postedEvent) {
+	defer p.wg.Done()
+	for e := range queue {
+		queuedEvents.Dec()
+		p.poster.Post(e.ctx, e.event)
+	}
+}
@@ -0,0 +1,118 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingPoster records the events posted by topic.
+type recordingPoster struct {
+	delay time.Duration
+
+	mu     sync.Mutex
+	events map[string][]Event
+}
+
+func (p *recordingPoster) Post(ctx context.Context, e Event) {
+	time.Sleep(p.delay)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	topic := getTopic(ctx)
+	p.events[topic] = append(p.events[topic], e)
+}
+
+func TestAsyncPoster(t *testing.T) {
+	recorder := &recordingPoster{delay: time.Millisecond, events: make(map[string][]Event)}
+	p := NewAsyncPoster(recorder, 4, 2)
+
+	topics := []string{"container.a", "container.b", "container.c"}
+	for i := 0; i < 10; i++ {
+		for _, topic := range topics {
+			p.Post(WithTopic(context.Background(), topic), i)
+		}
+	}
+	if err := p.Close(time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	for _, topic := range topics {
+		events := recorder.events[topic]
+		if len(events) != 10 {
+			t.Fatalf("expected the 10 events of %s to be posted once closed, got %v", topic, events)
+		}
+		for i, e := range events {
+			if e != i {
+				t.Fatalf("expected the events of %s to be posted in order, got %v", topic, events)
+			}
+		}
+	}
+
+	p.Post(WithTopic(context.Background(), "late"), "late")
+	if fmt.Sprint(recorder.events["late"]) != "[late]" {
+		t.Fatalf("expected the events posted once closed to be posted synchronously, got %v", recorder.events["late"])
+	}
+}
+
+func TestAsyncPosterCloseTimeout(t *testing.T) {
+	recorder := &recordingPoster{delay: time.Second, events: make(map[string][]Event)}
+	p := NewAsyncPoster(recorder, 1, 2)
+	p.Post(context.Background(), "slow")
+	p.Post(context.Background(), "queued")
+	if err := p.Close(10 * time.Millisecond); err == nil {
+		t.Fatal("expected the close to time out while the events are posted")
+	}
+}
+
+func TestAsyncPosterCloseBlockedPost(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	p := NewAsyncPoster(posterFunc(func(ctx context.Context, e Event) {
+		if e == "stalled" {
+			<-release
+		}
+	}), 1, 1)
+	p.Post(context.Background(), "stalled")
+	p.Post(context.Background(), "queued")
+	// the queue is full, the post blocks until the poster is closing
+	posted := make(chan struct{})
+	go func() {
+		p.Post(context.Background(), "blocked")
+		close(posted)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if err := p.Close(10 * time.Millisecond); err == nil {
+		t.Fatal("expected the close to time out while the poster is stalled")
+	}
+	select {
+	case <-posted:
+	case <-time.After(time.Second):
+		t.Fatal("expected the blocked post to be posted once the poster is closing")
+	}
+}
+
+func TestAsyncPosterOrderingKey(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		posted []Event
+	)
+	p := NewAsyncPoster(posterFunc(func(ctx context.Context, e Event) {
+		mu.Lock()
+		posted = append(posted, e)
+		mu.Unlock()
+	}), 8, 16)
+	// the events of the topics of a container share its ordering key
+	ctx := WithOrderingKey(context.Background(), "ns/app")
+	for i := 0; i < 10; i++ {
+		p.Post(WithTopic(ctx, fmt.Sprintf("ns.container.app.%d", i)), i)
+	}
+	if err := p.Close(time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range posted {
+		if e != i {
+			t.Fatalf("expected the events of an ordering key to be posted in order, got %v", posted)
+		}
+	}
+}
@@ -31,3 +31,24 @@ func TestBasicEvent(t *testing.T) {
 
 	commit()
 }
+
+type classifiedEvent string
+
+func (e classifiedEvent) Class() string {
+	return string(e)
+}
+
+func TestClassOf(t *testing.T) {
+	for _, tc := range []struct {
+		event Event
+		class string
+	}{
+		{classifiedEvent("exit"), "exit"},
+		{classifiedEvent(""), "other"},
+		{"pull ubuntu", "other"},
+	} {
+		if class := classOf(tc.event); class != tc.class {
+			t.Errorf("expected class %q for %v, got %q", tc.class, tc.event, class)
+		}
+	}
+}
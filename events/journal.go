@@ -0,0 +1,179 @@
+package events
+
+import (
+	"errors"
+	"path"
+	"sync"
+	"time"
+)
+
+// DefaultJournalSize is the number of events kept by the journal of the
+// daemon.
+const DefaultJournalSize = 4096
+
+// ErrSubscriberBehind ends the subscription of a subscriber that did not keep
+// up with the events.
+var ErrSubscriberBehind = errors.New("events: subscriber fell behind")
+
+// subscriberBuffer is the number of events buffered for a subscriber before
+// it is considered behind.
+const subscriberBuffer = 256
+
+// JournalEntry is an event published on a topic, as encoded on the bus.
+type JournalEntry struct {
+	Timestamp time.Time
+	Topic     string
+	Data      []byte
+}
+
+// Journal keeps the latest events published, for the subscribers that join
+// later to replay them before following the new events.
+type Journal struct {
+	mu          sync.Mutex
+	entries     []JournalEntry
+	next        int
+	full        bool
+	subscribers map[*Subscription]struct{}
+}
+
+// NewJournal returns a journal keeping the latest size events.
+func NewJournal(size int) *Journal {
+	return &Journal{
+		entries:     make([]JournalEntry, size),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Add records the event published on topic and sends it to the subscribers.
+func (j *Journal) Add(topic string, data []byte) {
+	e := JournalEntry{
+		Timestamp: time.Now(),
+		Topic:     topic,
+		Data:      data,
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if len(j.entries) > 0 {
+		j.entries[j.next] = e
+		j.next = (j.next + 1) % len(j.entries)
+		j.full = j.full || j.next == 0
+	}
+	for s := range j.subscribers {
+		if !MatchTopic(s.filter, topic) {
+			continue
+		}
+		select {
+		case s.c <- e:
+		default:
+			s.err = ErrSubscriberBehind
+			j.remove(s)
+		}
+	}
+}
+
+// Subscribe returns the events of the journal matching filter published
+// after since, oldest first, along with the subscription to the events
+// following them.
+func (j *Journal) Subscribe(filter string, since time.Time) ([]JournalEntry, *Subscription) {
+	s := &Subscription{
+		j:      j,
+		filter: filter,
+		c:      make(chan JournalEntry, subscriberBuffer),
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	var replay []JournalEntry
+	if !since.IsZero() {
+		start := 0
+		if j.full {
+			start = j.next
+		}
+		for i := 0; i < j.len(); i++ {
+			e := j.entries[(start+i)%len(j.entries)]
+			if e.Timestamp.After(since) && MatchTopic(filter, e.Topic) {
+				replay = append(replay, e)
+			}
+		}
+	}
+	j.subscribers[s] = struct{}{}
+	return replay, s
+}
+
+// SubscriberStatus is the queue of a subscriber of the journal.
+type SubscriberStatus struct {
+	Filter string
+	// Queued is the number of events buffered for the subscriber, which
+	// falls behind once the buffer is full.
+	Queued   int
+	Capacity int
+}
+
+// Subscribers returns the queues of the subscribers of the journal.
+func (j *Journal) Subscribers() []SubscriberStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	var subscribers []SubscriberStatus
+	for s := range j.subscribers {
+		subscribers = append(subscribers, SubscriberStatus{
+			Filter:   s.filter,
+			Queued:   len(s.c),
+			Capacity: cap(s.c),
+		})
+	}
+	return subscribers
+}
+
+func (j *Journal) len() int {
+	if j.full {
+		return len(j.entries)
+	}
+	return j.next
+}
+
+func (j *Journal) remove(s *Subscription) {
+	if _, ok := j.subscribers[s]; ok {
+		delete(j.subscribers, s)
+		close(s.c)
+	}
+}
+
+// Subscription receives the events published after it was subscribed.
+type Subscription struct {
+	j      *Journal
+	filter string
+	c      chan JournalEntry
+	// err is set by the journal before it closes c.
+	err error
+}
+
+// Events is closed once the subscription is closed, or the subscriber fell
+// behind.
+func (s *Subscription) Events() <-chan JournalEntry {
+	return s.c
+}
+
+// Err returns why the events were closed, nil when the subscription was
+// closed.
+func (s *Subscription) Err() error {
+	s.j.mu.Lock()
+	defer s.j.mu.Unlock()
+	return s.err
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	s.j.mu.Lock()
+	s.j.remove(s)
+	s.j.mu.Unlock()
+}
+
+// MatchTopic returns whether the topic matches the glob filter, such as
+// "containerd.execution.container.*". A "*" matches any sequence of
+// characters, dots included. An empty filter matches every topic.
+func MatchTopic(filter, topic string) bool {
+	if filter == "" {
+		return true
+	}
+	ok, _ := path.Match(filter, topic)
+	return ok
+}
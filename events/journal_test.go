@@ -0,0 +1,80 @@
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJournal(t *testing.T) {
+	j := NewJournal(3)
+	start := time.Now().Add(-time.Second)
+	for _, topic := range []string{"a.create", "a.exit", "b.create", "b.exit"} {
+		j.Add(topic, []byte(topic))
+	}
+
+	// the oldest event was dropped
+	replay, s := j.Subscribe("", start)
+	if len(replay) != 3 || replay[0].Topic != "a.exit" || replay[2].Topic != "b.exit" {
+		t.Fatalf("unexpected replay %v", replay)
+	}
+	s.Close()
+
+	replay, s = j.Subscribe("*.exit", start)
+	defer s.Close()
+	if len(replay) != 2 || replay[0].Topic != "a.exit" || replay[1].Topic != "b.exit" {
+		t.Fatalf("unexpected filtered replay %v", replay)
+	}
+	if replay, live := j.Subscribe("", time.Time{}); len(replay) != 0 {
+		t.Fatalf("expected no replay without since, got %v", replay)
+	} else {
+		live.Close()
+	}
+
+	j.Add("c.create", nil)
+	j.Add("c.exit", nil)
+	select {
+	case e := <-s.Events():
+		if e.Topic != "c.exit" {
+			t.Fatalf("expected the filter to apply to new events, got %s", e.Topic)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the new event")
+	}
+
+	s.Close()
+	if _, ok := <-s.Events(); ok || s.Err() != nil {
+		t.Fatalf("expected the events to be closed without an error, got %v", s.Err())
+	}
+}
+
+func TestJournalSubscriberBehind(t *testing.T) {
+	j := NewJournal(0)
+	_, s := j.Subscribe("", time.Time{})
+	for i := 0; i <= subscriberBuffer; i++ {
+		j.Add("a.exit", nil)
+	}
+	n := 0
+	for range s.Events() {
+		n++
+	}
+	if n != subscriberBuffer || s.Err() != ErrSubscriberBehind {
+		t.Fatalf("expected %d events and ErrSubscriberBehind, got %d and %v", subscriberBuffer, n, s.Err())
+	}
+	s.Close()
+}
+
+func TestJournalSubscribers(t *testing.T) {
+	j := NewJournal(0)
+	_, s := j.Subscribe("*.exit", time.Time{})
+	defer s.Close()
+	j.Add("a.exit", nil)
+	j.Add("a.create", nil)
+
+	subscribers := j.Subscribers()
+	if len(subscribers) != 1 {
+		t.Fatalf("expected a subscriber, got %v", subscribers)
+	}
+	if sub := subscribers[0]; sub.Filter != "*.exit" || sub.Queued != 1 || sub.Capacity != subscriberBuffer {
+		t.Fatalf("unexpected subscriber %+v", sub)
+	}
+}
@@ -0,0 +1,29 @@
+package events
+
+import metrics "github.com/docker/go-metrics"
+
+// Classifier is implemented by events reporting the class they are counted
+// under, such as "exit" or "oom".
+type Classifier interface {
+	Class() string
+}
+
+var (
+	eventsNamespace = metrics.NewNamespace("containerd", "events", nil)
+	postedEvents    = eventsNamespace.NewLabeledCounter("posted", "The number of events posted by class", "class")
+	deliveredEvents = eventsNamespace.NewLabeledCounter("delivered", "The number of events handed to the events server by class", "class")
+	droppedEvents   = eventsNamespace.NewLabeledCounter("dropped", "The number of events that failed to be published by class", "class")
+	queuedEvents    = eventsNamespace.NewGauge("queued", "The number of events queued to be posted", metrics.Unit("events"))
+)
+
+func init() {
+	metrics.Register(eventsNamespace)
+}
+
+// classOf returns the class of the event, "other" when it has none.
+func classOf(e Event) string {
+	if c, ok := e.(Classifier); ok && c.Class() != "" {
+		return c.Class()
+	}
+	return "other"
+}
@@ -17,6 +17,9 @@ func GetNATSPoster(nec *nats.EncodedConn) Poster {
 }
 
 func (p *natsPoster) Post(ctx context.Context, e Event) {
+	class := classOf(e)
+	postedEvents.WithValues(class).Inc()
+
 	subject := strings.Replace(log.GetModulePath(ctx), "/", ".", -1)
 	topic := getTopic(ctx)
 	if topic != "" {
@@ -25,7 +28,14 @@ func (p *natsPoster) Post(ctx context.Context, e Event) {
 
 	if subject == "" {
 		log.GetLogger(ctx).WithField("event", e).Warn("unable to post event, subject is empty")
+		droppedEvents.WithValues(class).Inc()
+		return
 	}
 
-	p.nec.Publish(subject, e)
+	if err := p.nec.Publish(subject, e); err != nil {
+		log.GetLogger(ctx).WithError(err).WithField("event", e).Warn("failed to publish event")
+		droppedEvents.WithValues(class).Inc()
+		return
+	}
+	deliveredEvents.WithValues(class).Inc()
 }
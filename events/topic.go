@@ -29,6 +29,25 @@ func getTopic(ctx context.Context) string {
 	return topic.(string)
 }
 
+type orderingKey struct{}
+
+// WithOrderingKey returns a context whose events are kept in order with the
+// other events of key, such as the events of the processes of a container
+// with those of the container, when they are posted asynchronously. The
+// events are otherwise kept in order within their topic.
+func WithOrderingKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, orderingKey{}, key)
+}
+
+// getOrderingKey returns the ordering key of the events of ctx, their topic
+// when it has none.
+func getOrderingKey(ctx context.Context) string {
+	if key, ok := ctx.Value(orderingKey{}).(string); ok {
+		return key
+	}
+	return getTopic(ctx)
+}
+
 // RegisterCompactor sets the compacter for the given topic.
 func RegisterCompactor(topic string, compactor interface{}) {
 	panic("not implemented")
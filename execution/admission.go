@@ -0,0 +1,203 @@
+package execution
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// Rules of the admission policy.
+const (
+	RuleHostNamespace = "host-namespace"
+	RuleMountSource   = "mount-source"
+	RuleSeccomp       = "seccomp"
+)
+
+// AdmissionPolicy restricts the specs containers may be created with. The
+// spec is checked once the create options are applied to it, before the
+// runtime creates the container.
+type AdmissionPolicy struct {
+	// DenyHostNamespaces rejects specs sharing the namespaces of these
+	// types with the host, such as "pid" or "network". Joining the
+	// namespace of another process by path is allowed, unless the path
+	// resolves to the namespace of the host.
+	DenyHostNamespaces []specs.LinuxNamespaceType `json:"denyHostNamespaces,omitempty" toml:"denyHostNamespaces"`
+	// DenyMountSources rejects specs mounting or using as their root these
+	// host paths, such as "/etc" or "/var/run/docker.sock", a path within
+	// them or one of their parents. Symlinks are resolved first.
+	DenyMountSources []string `json:"denyMountSources,omitempty" toml:"denyMountSources"`
+	// RequireSeccomp rejects specs without a seccomp profile.
+	RequireSeccomp bool `json:"requireSeccomp,omitempty" toml:"requireSeccomp"`
+}
+
+// Violation is a rule of the admission policy broken by a spec.
+type Violation struct {
+	Rule   string
+	Detail string
+}
+
+func (v Violation) String() string {
+	return v.Rule + ": " + v.Detail
+}
+
+// AdmissionError reports the violations of the admission policy by a spec.
+type AdmissionError struct {
+	Violations []Violation
+}
+
+func (e *AdmissionError) Error() string {
+	details := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		details[i] = v.String()
+	}
+	return fmt.Sprintf("spec rejected by admission policy: %s", strings.Join(details, "; "))
+}
+
+// Check returns an *AdmissionError listing the violations of the policy by
+// spec, if any, the root of the spec being relative to bundle. A nil policy
+// admits any spec.
+func (p *AdmissionPolicy) Check(spec *specs.Spec, bundle string) error {
+	if p == nil {
+		return nil
+	}
+	var violations []Violation
+	for _, typ := range p.DenyHostNamespaces {
+		host, err := sharesHostNamespace(spec, typ)
+		if err != nil {
+			violations = append(violations, Violation{
+				Rule:   RuleHostNamespace,
+				Detail: err.Error(),
+			})
+			continue
+		}
+		if host {
+			violations = append(violations, Violation{
+				Rule:   RuleHostNamespace,
+				Detail: fmt.Sprintf("shares the %s namespace of the host", typ),
+			})
+		}
+	}
+	if root := spec.Root.Path; root != "" {
+		if !filepath.IsAbs(root) {
+			root = filepath.Join(bundle, root)
+		}
+		if src, ok := p.deniedSource(root); ok {
+			violations = append(violations, Violation{
+				Rule:   RuleMountSource,
+				Detail: fmt.Sprintf("uses %s as its root, denied by %s", spec.Root.Path, src),
+			})
+		}
+	}
+	for _, m := range spec.Mounts {
+		if !filepath.IsAbs(m.Source) {
+			// not a host path, such as the source of proc or tmpfs
+			continue
+		}
+		if src, ok := p.deniedSource(m.Source); ok {
+			violations = append(violations, Violation{
+				Rule:   RuleMountSource,
+				Detail: fmt.Sprintf("mounts %s at %s, denied by %s", m.Source, m.Destination, src),
+			})
+		}
+	}
+	if p.RequireSeccomp && (spec.Linux == nil || spec.Linux.Seccomp == nil) {
+		violations = append(violations, Violation{
+			Rule:   RuleSeccomp,
+			Detail: "no seccomp profile",
+		})
+	}
+	if len(violations) > 0 {
+		return &AdmissionError{Violations: violations}
+	}
+	return nil
+}
+
+// HasNamespace returns whether the spec creates or joins a namespace of the
+// type rather than staying in the host's.
+func HasNamespace(spec *specs.Spec, typ specs.LinuxNamespaceType) bool {
+	if spec.Linux == nil {
+		return false
+	}
+	for _, ns := range spec.Linux.Namespaces {
+		if ns.Type == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// deniedSource returns the denied source the host path is, is within or
+// contains, symlinks resolved.
+func (p *AdmissionPolicy) deniedSource(path string) (string, bool) {
+	path = resolvePath(path)
+	for _, src := range p.DenyMountSources {
+		denied := resolvePath(src)
+		if pathWithin(path, denied) || pathWithin(denied, path) {
+			return src, true
+		}
+	}
+	return "", false
+}
+
+// resolvePath returns the path with its symlinks resolved, or cleaned only
+// when it cannot be resolved.
+func resolvePath(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return filepath.Clean(path)
+}
+
+// pathWithin returns whether the clean path is dir or within it.
+func pathWithin(path, dir string) bool {
+	return path == dir || dir == "/" || strings.HasPrefix(path, dir+"/")
+}
+
+// nsFiles are the names of the files of the namespaces of a process in
+// /proc/<pid>/ns.
+var nsFiles = map[specs.LinuxNamespaceType]string{
+	specs.PIDNamespace:     "pid",
+	specs.NetworkNamespace: "net",
+	specs.MountNamespace:   "mnt",
+	specs.IPCNamespace:     "ipc",
+	specs.UTSNamespace:     "uts",
+	specs.UserNamespace:    "user",
+	specs.CgroupNamespace:  "cgroup",
+}
+
+// sharesHostNamespace returns whether the spec stays in the namespace of the
+// type of the host, or joins it by a path. The namespaces of the daemon and
+// of init are those of the host. A path which does not exist cannot be
+// joined, the runtime failing on it.
+func sharesHostNamespace(spec *specs.Spec, typ specs.LinuxNamespaceType) (bool, error) {
+	if !HasNamespace(spec, typ) {
+		return true, nil
+	}
+	var path string
+	for _, ns := range spec.Linux.Namespaces {
+		if ns.Type == typ {
+			path = ns.Path
+		}
+	}
+	if path == "" {
+		// a new namespace is created
+		return false, nil
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("cannot resolve the %s namespace %s: %v", typ, path, err)
+	}
+	for _, pid := range []string{"self", "1"} {
+		host, err := os.Stat(filepath.Join("/proc", pid, "ns", nsFiles[typ]))
+		if err == nil && os.SameFile(fi, host) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,119 @@
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestAdmissionPolicy(t *testing.T) {
+	p := &AdmissionPolicy{
+		DenyHostNamespaces: []specs.LinuxNamespaceType{specs.PIDNamespace, specs.NetworkNamespace},
+		DenyMountSources:   []string{"/var/run/docker.sock"},
+		RequireSeccomp:     true,
+	}
+	spec := &specs.Spec{
+		Mounts: []specs.Mount{
+			{Destination: "/proc", Type: "proc", Source: "proc"},
+			{Destination: "/host", Type: "bind", Source: "/"},
+			{Destination: "/data", Type: "bind", Source: "/srv/data"},
+		},
+		Linux: &specs.Linux{
+			Namespaces: []specs.LinuxNamespace{
+				{Type: specs.NetworkNamespace, Path: "/var/run/netns/sandbox"},
+			},
+		},
+	}
+	err := p.Check(spec, "")
+	aerr, ok := err.(*AdmissionError)
+	if !ok {
+		t.Fatalf("expected an admission error, got %v", err)
+	}
+	var rules []string
+	for _, v := range aerr.Violations {
+		rules = append(rules, v.Rule)
+	}
+	if !reflect.DeepEqual(rules, []string{RuleHostNamespace, RuleMountSource, RuleSeccomp}) {
+		t.Fatalf("unexpected violations %v", aerr.Violations)
+	}
+
+	spec.Mounts = spec.Mounts[:1]
+	spec.Linux.Namespaces = append(spec.Linux.Namespaces, specs.LinuxNamespace{Type: specs.PIDNamespace})
+	spec.Linux.Seccomp = &specs.LinuxSeccomp{DefaultAction: specs.ActAllow}
+	if err := p.Check(spec, ""); err != nil {
+		t.Fatalf("expected the spec to be admitted, got %v", err)
+	}
+	if err := (*AdmissionPolicy)(nil).Check(&specs.Spec{}, ""); err != nil {
+		t.Fatalf("expected a nil policy to admit any spec, got %v", err)
+	}
+}
+
+func TestAdmissionPolicyHostPaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "execution-admission-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	denied := filepath.Join(dir, "run", "docker.sock")
+	if err := os.MkdirAll(filepath.Dir(denied), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(denied, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(dir, "run"), filepath.Join(dir, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("/proc/self/ns/pid", filepath.Join(dir, "pidns")); err != nil {
+		t.Fatal(err)
+	}
+	p := &AdmissionPolicy{
+		DenyHostNamespaces: []specs.LinuxNamespaceType{specs.PIDNamespace, specs.NetworkNamespace},
+		DenyMountSources:   []string{denied},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		root       string
+		source     string
+		namespaces []specs.LinuxNamespace
+		admitted   bool
+	}{
+		{name: "new namespaces", namespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.NetworkNamespace}}, admitted: true},
+		{name: "missing namespace path", namespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace, Path: filepath.Join(dir, "missing")}, {Type: specs.NetworkNamespace}}, admitted: true},
+		{name: "host pid namespace path", namespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace, Path: "/proc/self/ns/pid"}, {Type: specs.NetworkNamespace}}},
+		{name: "host network namespace path", namespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.NetworkNamespace, Path: "/proc/1/ns/net"}}},
+		{name: "host namespace symlink", namespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace, Path: filepath.Join(dir, "pidns")}, {Type: specs.NetworkNamespace}}},
+		{name: "parent of source", source: filepath.Join(dir, "run")},
+		{name: "same source", source: filepath.Join(dir, "run", "docker.sock", "..", "docker.sock")},
+		{name: "symlink to source", source: filepath.Join(dir, "link", "docker.sock")},
+		{name: "other source", source: filepath.Join(dir, "data"), admitted: true},
+		{name: "root containing source", root: dir},
+		{name: "relative root", root: "run"},
+		{name: "other root", root: "rootfs", admitted: true},
+	} {
+		spec := &specs.Spec{
+			Root: specs.Root{Path: tc.root},
+			Linux: &specs.Linux{
+				Namespaces: tc.namespaces,
+			},
+		}
+		if tc.namespaces == nil {
+			spec.Linux.Namespaces = []specs.LinuxNamespace{{Type: specs.PIDNamespace}, {Type: specs.NetworkNamespace}}
+		}
+		if tc.source != "" {
+			spec.Mounts = []specs.Mount{{Destination: "/mnt", Type: "bind", Source: tc.source}}
+		}
+		err := p.Check(spec, dir)
+		if _, ok := err.(*AdmissionError); !ok && err != nil {
+			t.Fatalf("%s: expected an admission error, got %v", tc.name, err)
+		}
+		if (err == nil) != tc.admitted {
+			t.Errorf("%s: expected the spec to be admitted: %v, got %v", tc.name, tc.admitted, err)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// +build !windows
+
+package execution
+
+import "syscall"
+
+// alive returns whether the process pid exists, a zombie process being
+// alive until it is waited for.
+func alive(pid int) bool {
+	return syscall.Kill(pid, 0) != syscall.ESRCH
+}
@@ -0,0 +1,14 @@
+package execution
+
+import "os"
+
+// alive returns whether the process pid exists, an exited process being
+// alive until its last handle is closed.
+func alive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	p.Release()
+	return true
+}
@@ -0,0 +1,57 @@
+package execution
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+// containerCache keeps the containers loaded from the executor, for the
+// requests not to load them from the runtime each time. A container is
+// evicted by the requests and events changing its state, to be loaded again
+// by the next request.
+type containerCache struct {
+	mu         sync.RWMutex
+	containers map[string]*Container
+	// generation is incremented by each eviction, the containers loaded
+	// across one are not cached as they may have been loaded before it.
+	generation uint64
+}
+
+func newContainerCache() *containerCache {
+	return &containerCache{
+		containers: make(map[string]*Container),
+	}
+}
+
+// load returns the container id from the cache, loading it with executor
+// when it is not cached.
+func (c *containerCache) load(ctx context.Context, executor Executor, id string) (*Container, error) {
+	c.mu.RLock()
+	container, ok := c.containers[id]
+	generation := c.generation
+	c.mu.RUnlock()
+	if ok {
+		containerCacheHits.Inc()
+		return container, nil
+	}
+	containerCacheMisses.Inc()
+	container, err := executor.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	if c.generation == generation {
+		c.containers[id] = container
+	}
+	c.mu.Unlock()
+	return container, nil
+}
+
+// evict removes the container id from the cache.
+func (c *containerCache) evict(id string) {
+	c.mu.Lock()
+	delete(c.containers, id)
+	c.generation++
+	c.mu.Unlock()
+}
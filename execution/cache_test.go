@@ -0,0 +1,67 @@
+package execution
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// countingExecutor counts the containers loaded, calling onLoad before each
+// load.
+type countingExecutor struct {
+	*testExecutor
+	loads  int
+	onLoad func()
+}
+
+func (e *countingExecutor) Load(ctx context.Context, id string) (*Container, error) {
+	e.loads++
+	if e.onLoad != nil {
+		e.onLoad()
+	}
+	return e.testExecutor.Load(ctx, id)
+}
+
+func TestContainerCache(t *testing.T) {
+	root, err := ioutil.TempDir("", "execution-cache-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	c, err := NewContainer(root, "cached", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	executor := &countingExecutor{testExecutor: &testExecutor{root: root, containers: map[string]*Container{"cached": c}}}
+	cache := newContainerCache()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if loaded, err := cache.load(ctx, executor, "cached"); err != nil || loaded != c {
+			t.Fatalf("expected the container to be loaded, got %v: %v", loaded, err)
+		}
+	}
+	if executor.loads != 1 {
+		t.Fatalf("expected the container to be loaded once, got %d loads", executor.loads)
+	}
+	cache.evict("cached")
+	if _, err := cache.load(ctx, executor, "cached"); err != nil || executor.loads != 2 {
+		t.Fatalf("expected the evicted container to be loaded again, got %d loads: %v", executor.loads, err)
+	}
+	if _, err := cache.load(ctx, executor, "missing"); err == nil {
+		t.Fatal("expected a missing container to fail to load")
+	}
+
+	// a container evicted while it is loaded may be loaded as it was
+	// before, it is not cached
+	cache.evict("cached")
+	executor.onLoad = func() { cache.evict("cached") }
+	if _, err := cache.load(ctx, executor, "cached"); err != nil {
+		t.Fatal(err)
+	}
+	executor.onLoad = nil
+	if _, err := cache.load(ctx, executor, "cached"); err != nil || executor.loads != 5 {
+		t.Fatalf("expected the container loaded across an eviction not to be cached, got %d loads: %v", executor.loads, err)
+	}
+}
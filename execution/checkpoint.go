@@ -0,0 +1,78 @@
+package execution
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/docker/containerd/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// PreDumpDir is the directory of a checkpoint holding the pre-dumps taken
+// before it, each pre-dump holding the previous one in its own PreDumpDir.
+const PreDumpDir = "pre-dump"
+
+// preDump takes up to n pre-dumps of the memory of c into the PreDumpDir of
+// the path of opts, each incremental to the previous one, stopping once one
+// dumped fewer than threshold bytes.
+func preDump(ctx context.Context, checkpointer Checkpointer, c *Container, opts CheckpointOpts, n int, threshold int64) error {
+	dir := filepath.Join(opts.Path, PreDumpDir)
+	// each pre-dump is taken next to the previous one, which is then moved
+	// into it for the parent path to be the same at each level
+	next := dir + ".next"
+	for i := 0; i < n; i++ {
+		o := opts
+		o.Path, o.Exit, o.PreDump, o.ParentPath = dir, false, true, ""
+		if i > 0 {
+			if err := os.RemoveAll(next); err != nil {
+				return err
+			}
+			if err := os.MkdirAll(next, 0700); err != nil {
+				return err
+			}
+			if err := os.Rename(dir, filepath.Join(next, PreDumpDir)); err != nil {
+				return err
+			}
+			o.Path, o.ParentPath = next, PreDumpDir
+		}
+		if err := checkpointer.Checkpoint(ctx, c, o); err != nil {
+			return errors.Wrapf(err, "pre-dump %d failed", i+1)
+		}
+		if i > 0 {
+			if err := os.Rename(next, dir); err != nil {
+				return err
+			}
+		}
+		size, err := dumpedPages(dir)
+		if err != nil {
+			return err
+		}
+		log.G(ctx).WithFields(logrus.Fields{
+			"pre-dump": i + 1,
+			"size":     size,
+		}).Debug("memory pre-dumped")
+		if size < threshold {
+			return nil
+		}
+	}
+	return nil
+}
+
+// dumpedPages returns the size of the memory pages dumped by criu in dir.
+func dumpedPages(dir string) (int64, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "pages-*.img"))
+	if err != nil {
+		return 0, err
+	}
+	var size int64
+	for _, m := range matches {
+		fi, err := os.Stat(m)
+		if err != nil {
+			return 0, err
+		}
+		size += fi.Size()
+	}
+	return size, nil
+}
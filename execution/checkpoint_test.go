@@ -0,0 +1,70 @@
+package execution
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testCheckpointer writes pages of the sizes of dumps in turn.
+type testCheckpointer struct {
+	dumps []int
+	opts  []CheckpointOpts
+}
+
+func (c *testCheckpointer) Checkpoint(ctx context.Context, container *Container, o CheckpointOpts) error {
+	size := c.dumps[len(c.opts)]
+	c.opts = append(c.opts, o)
+	if err := os.MkdirAll(o.Path, 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(o.Path, "pages-1.img"), make([]byte, size), 0600)
+}
+
+func TestPreDump(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		dumps     []int
+		n         int
+		threshold int64
+		taken     int
+	}{
+		{"all", []int{100, 50, 20}, 3, 0, 3},
+		{"converged", []int{100, 50, 20}, 3, 60, 2},
+	} {
+		dir, err := ioutil.TempDir("", "execution-checkpoint-")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		c := &testCheckpointer{dumps: tc.dumps}
+		if err := preDump(context.Background(), c, nil, CheckpointOpts{Path: dir, Exit: true}, tc.n, tc.threshold); err != nil {
+			t.Fatal(err)
+		}
+		if len(c.opts) != tc.taken {
+			t.Fatalf("%s: expected %d pre-dumps, got %d", tc.name, tc.taken, len(c.opts))
+		}
+		for i, o := range c.opts {
+			if !o.PreDump || o.Exit || (i == 0) != (o.ParentPath == "") {
+				t.Fatalf("%s: unexpected options of pre-dump %d: %+v", tc.name, i+1, o)
+			}
+		}
+		// the last pre-dump holds the previous ones
+		path := dir
+		for i := tc.taken - 1; i >= 0; i-- {
+			path = filepath.Join(path, PreDumpDir)
+			fi, err := os.Stat(filepath.Join(path, "pages-1.img"))
+			if err != nil {
+				t.Fatalf("%s: expected pre-dump %d in %s: %v", tc.name, i+1, path, err)
+			}
+			if fi.Size() != int64(tc.dumps[i]) {
+				t.Fatalf("%s: expected pre-dump %d in %s, got %d bytes of pages", tc.name, i+1, path, fi.Size())
+			}
+		}
+		if _, err := os.Stat(filepath.Join(dir, PreDumpDir+".next")); !os.IsNotExist(err) {
+			t.Fatalf("%s: expected no pre-dump to be left in progress: %v", tc.name, err)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package execution
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 func NewContainer(stateRoot, id, bundle string) (*Container, error) {
 	stateDir, err := NewStateDir(stateRoot, id)
@@ -30,9 +33,13 @@ type Container struct {
 	id       string
 	bundle   string
 	stateDir StateDir
-	initPid  int64
-	status   Status
 
+	// mu guards the processes of the container and its status, the
+	// containers being used by concurrent requests.
+	mu        sync.RWMutex
+	initPid   int64
+	status    Status
+	sandbox   string
 	processes map[string]Process
 }
 
@@ -41,6 +48,8 @@ func (c *Container) ID() string {
 }
 
 func (c *Container) Status() Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	for _, p := range c.processes {
 		if p.Pid() == c.initPid {
 			c.status = p.Status()
@@ -54,20 +63,47 @@ func (c *Container) Bundle() string {
 	return c.bundle
 }
 
+// Sandbox returns the id of the sandbox the container belongs to, empty
+// when it is not in a sandbox.
+func (c *Container) Sandbox() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.sandbox
+}
+
+func (c *Container) SetSandbox(id string) {
+	c.mu.Lock()
+	c.sandbox = id
+	c.mu.Unlock()
+}
+
 func (c *Container) StateDir() StateDir {
 	return c.stateDir
 }
 
 func (c *Container) Wait() (uint32, error) {
+	// the process is waited for unlocked, for its exit not to block the
+	// other users of the container
+	if p := c.initProcess(); p != nil {
+		return p.Wait()
+	}
+	return 0, fmt.Errorf("no init process")
+}
+
+func (c *Container) initProcess() Process {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	for _, p := range c.processes {
 		if p.Pid() == c.initPid {
-			return p.Wait()
+			return p
 		}
 	}
-	return 0, fmt.Errorf("no init process")
+	return nil
 }
 
 func (c *Container) AddProcess(p Process, isInit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	if isInit {
 		c.initPid = p.Pid()
 	}
@@ -75,14 +111,20 @@ func (c *Container) AddProcess(p Process, isInit bool) {
 }
 
 func (c *Container) GetProcess(id string) Process {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.processes[id]
 }
 
 func (c *Container) RemoveProcess(id string) {
+	c.mu.Lock()
 	delete(c.processes, id)
+	c.mu.Unlock()
 }
 
 func (c *Container) Processes() []Process {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	var out []Process
 	for _, p := range c.processes {
 		out = append(out, p)
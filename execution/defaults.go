@@ -0,0 +1,89 @@
+package execution
+
+import specs "github.com/opencontainers/runtime-spec/specs-go"
+
+// SpecDefaults are settings a runtime applies to the spec of each of its
+// containers, so they are standardized across containers.
+type SpecDefaults struct {
+	// Annotations are added to the spec, unless the container sets them.
+	Annotations map[string]string `json:"annotations,omitempty" toml:"annotations"`
+	// MaskedPaths are masked in addition to those of the spec.
+	MaskedPaths []string `json:"maskedPaths,omitempty" toml:"maskedPaths"`
+	// ReadonlyPaths are made read only in addition to those of the spec.
+	ReadonlyPaths []string `json:"readonlyPaths,omitempty" toml:"readonlyPaths"`
+	// UserNamespace runs the containers in a user namespace, unless they
+	// set their own mappings. It is applied by the executor along with the
+	// ownership of the rootfs, not by Apply.
+	UserNamespace *UserNamespace `json:"userNamespace,omitempty" toml:"userNamespace"`
+}
+
+// IsZero returns whether the defaults leave specs unchanged.
+func (d SpecDefaults) IsZero() bool {
+	return len(d.Annotations) == 0 && len(d.MaskedPaths) == 0 && len(d.ReadonlyPaths) == 0 && d.UserNamespace == nil
+}
+
+// Apply applies the defaults to spec, but for the user namespace.
+func (d SpecDefaults) Apply(spec *specs.Spec) {
+	if len(d.Annotations) > 0 && spec.Annotations == nil {
+		spec.Annotations = make(map[string]string)
+	}
+	for k, v := range d.Annotations {
+		if _, ok := spec.Annotations[k]; !ok {
+			spec.Annotations[k] = v
+		}
+	}
+	if len(d.MaskedPaths) == 0 && len(d.ReadonlyPaths) == 0 {
+		return
+	}
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	spec.Linux.MaskedPaths = appendMissing(spec.Linux.MaskedPaths, d.MaskedPaths)
+	spec.Linux.ReadonlyPaths = appendMissing(spec.Linux.ReadonlyPaths, d.ReadonlyPaths)
+}
+
+// PathOpts restrict the paths of a container.
+type PathOpts struct {
+	// MaskedPaths are masked in addition to those of the spec.
+	MaskedPaths []string
+	// ReadonlyPaths are made read only in addition to those of the spec.
+	ReadonlyPaths []string
+	// ReadonlyRootfs mounts the rootfs read only.
+	ReadonlyRootfs bool
+}
+
+// IsZero returns whether the options leave specs unchanged.
+func (o PathOpts) IsZero() bool {
+	return len(o.MaskedPaths) == 0 && len(o.ReadonlyPaths) == 0 && !o.ReadonlyRootfs
+}
+
+// Apply merges the options into spec.
+func (o PathOpts) Apply(spec *specs.Spec) {
+	if o.ReadonlyRootfs {
+		spec.Root.Readonly = true
+	}
+	if len(o.MaskedPaths) == 0 && len(o.ReadonlyPaths) == 0 {
+		return
+	}
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	spec.Linux.MaskedPaths = appendMissing(spec.Linux.MaskedPaths, o.MaskedPaths)
+	spec.Linux.ReadonlyPaths = appendMissing(spec.Linux.ReadonlyPaths, o.ReadonlyPaths)
+}
+
+func appendMissing(paths, defaults []string) []string {
+	for _, d := range defaults {
+		found := false
+		for _, p := range paths {
+			if p == d {
+				found = true
+				break
+			}
+		}
+		if !found {
+			paths = append(paths, d)
+		}
+	}
+	return paths
+}
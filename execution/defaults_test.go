@@ -0,0 +1,64 @@
+package execution
+
+import (
+	"reflect"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestSpecDefaults(t *testing.T) {
+	d := SpecDefaults{
+		Annotations:   map[string]string{"team": "platform", "tier": "default"},
+		MaskedPaths:   []string{"/proc/kcore", "/proc/keys"},
+		ReadonlyPaths: []string{"/proc/sys"},
+	}
+	spec := &specs.Spec{
+		Annotations: map[string]string{"tier": "gold"},
+		Linux: &specs.Linux{
+			MaskedPaths: []string{"/proc/kcore"},
+		},
+	}
+	d.Apply(spec)
+
+	if !reflect.DeepEqual(spec.Annotations, map[string]string{"team": "platform", "tier": "gold"}) {
+		t.Fatalf("unexpected annotations %v", spec.Annotations)
+	}
+	if !reflect.DeepEqual(spec.Linux.MaskedPaths, []string{"/proc/kcore", "/proc/keys"}) {
+		t.Fatalf("unexpected masked paths %v", spec.Linux.MaskedPaths)
+	}
+	if !reflect.DeepEqual(spec.Linux.ReadonlyPaths, []string{"/proc/sys"}) {
+		t.Fatalf("unexpected readonly paths %v", spec.Linux.ReadonlyPaths)
+	}
+	if !(SpecDefaults{}).IsZero() || d.IsZero() {
+		t.Fatal("unexpected IsZero result")
+	}
+}
+
+func TestPathOpts(t *testing.T) {
+	o := PathOpts{
+		MaskedPaths:    []string{"/proc/kcore", "/proc/timer_list"},
+		ReadonlyPaths:  []string{"/proc/sys"},
+		ReadonlyRootfs: true,
+	}
+	spec := &specs.Spec{
+		Root: specs.Root{Path: "rootfs"},
+		Linux: &specs.Linux{
+			MaskedPaths: []string{"/proc/kcore"},
+		},
+	}
+	o.Apply(spec)
+
+	if !spec.Root.Readonly {
+		t.Fatal("expected a read only rootfs")
+	}
+	if !reflect.DeepEqual(spec.Linux.MaskedPaths, []string{"/proc/kcore", "/proc/timer_list"}) {
+		t.Fatalf("unexpected masked paths %v", spec.Linux.MaskedPaths)
+	}
+	if !reflect.DeepEqual(spec.Linux.ReadonlyPaths, []string{"/proc/sys"}) {
+		t.Fatalf("unexpected readonly paths %v", spec.Linux.ReadonlyPaths)
+	}
+	if !(PathOpts{}).IsZero() || o.IsZero() {
+		t.Fatal("unexpected IsZero result")
+	}
+}
@@ -0,0 +1,95 @@
+package execution
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// ContainerState is the daemon's view of a container, along with what the
+// host reports of its processes.
+type ContainerState struct {
+	ID        string
+	Namespace string
+	Runtime   string
+	Sandbox   string
+	Bundle    string
+	Status    Status
+	Processes []ProcessState
+	// CgroupPids are the processes of the cgroup of the container, nil
+	// when the runtime does not list them.
+	CgroupPids []int
+	// Monitors is the number of goroutines monitoring the container.
+	Monitors int
+	// Fifos are the stdio fifos of the processes of the container.
+	Fifos []string
+	// Err is set when part of the state could not be read.
+	Err error
+}
+
+// ProcessState is the daemon's view of a process.
+type ProcessState struct {
+	ID     string
+	Pid    int64
+	Status Status
+	// Alive is set when the pid exists on the host.
+	Alive bool
+}
+
+// DumpState returns the state of the containers of the namespace of ctx,
+// ordered by id.
+func (s *Service) DumpState(ctx context.Context) ([]ContainerState, error) {
+	containers, err := s.executor.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var states []ContainerState
+	for _, c := range containers {
+		if !inNamespace(ctx, c.ID()) {
+			continue
+		}
+		states = append(states, s.containerState(ctx, c))
+	}
+	sort.Sort(statesByID(states))
+	return states, nil
+}
+
+func (s *Service) containerState(ctx context.Context, c *Container) ContainerState {
+	ns, id := splitID(c.ID())
+	state := ContainerState{
+		ID:        id,
+		Namespace: ns,
+		Sandbox:   unscopedID(c.Sandbox()),
+		Bundle:    c.Bundle(),
+		Status:    c.Status(),
+	}
+	state.Monitors, state.Fifos = s.watchdog.containerState(c.ID())
+	if runtimes, ok := s.executor.(*Runtimes); ok {
+		if rt, err := runtimes.RuntimeOf(c.ID()); err == nil {
+			state.Runtime = rt.Name
+		}
+	}
+	for _, p := range c.Processes() {
+		state.Processes = append(state.Processes, ProcessState{
+			ID:     p.ID(),
+			Pid:    p.Pid(),
+			Status: p.Status(),
+			Alive:  p.Pid() > 0 && alive(int(p.Pid())),
+		})
+	}
+	if lister, ok := s.executor.(PidLister); ok {
+		pids, err := lister.Pids(ctx, c)
+		if err != nil && errors.Cause(err) != ErrNotSupported {
+			state.Err = errors.Wrap(err, "failed to list the processes of the cgroup")
+		}
+		state.CgroupPids = pids
+	}
+	return state
+}
+
+type statesByID []ContainerState
+
+func (s statesByID) Len() int           { return len(s) }
+func (s statesByID) Less(i, j int) bool { return s[i].ID < s[j].ID }
+func (s statesByID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -3,8 +3,12 @@ package execution
 import "fmt"
 
 var (
-	ErrProcessNotFound   = fmt.Errorf("process not found")
-	ErrProcessNotExited  = fmt.Errorf("process has not exited")
-	ErrContainerNotFound = fmt.Errorf("container not found")
-	ErrContainerExists   = fmt.Errorf("container already exists")
+	ErrProcessNotFound    = fmt.Errorf("process not found")
+	ErrProcessNotExited   = fmt.Errorf("process has not exited")
+	ErrContainerNotFound  = fmt.Errorf("container not found")
+	ErrContainerExists    = fmt.Errorf("container already exists")
+	ErrRuntimeNotFound    = fmt.Errorf("runtime not found")
+	ErrNotSupported       = fmt.Errorf("operation not supported by runtime")
+	ErrFeatureUnavailable = fmt.Errorf("feature not available")
+	ErrSandboxNotFound    = fmt.Errorf("sandbox not found")
 )
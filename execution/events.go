@@ -1,24 +1,81 @@
 package execution
 
-import "time"
+import (
+	"time"
+
+	"github.com/docker/containerd/namespaces"
+)
 
 type ContainerEvent struct {
 	Timestamp time.Time
+	// Namespace is the namespace of the container, ID its id in it.
+	Namespace string
 	ID        string
 	Action    string
 }
 
+// Class returns the action of the event, which events are counted by.
+func (e *ContainerEvent) Class() string {
+	return e.Action
+}
+
 type ContainerExitEvent struct {
 	ContainerEvent
 	PID        string
 	StatusCode uint32
 }
 
+// RuntimeFailureEvent is published with the "runtime-failure" action when
+// the shim of a process failed.
+type RuntimeFailureEvent struct {
+	ContainerEvent
+	PID    string
+	Reason string
+	Fenced bool
+}
+
+// SandboxEvent is published with the "create", "exit" and "delete" actions
+// along the lifecycle of the pause process of a sandbox.
+type SandboxEvent struct {
+	Timestamp time.Time
+	Namespace string
+	ID        string
+	Action    string
+}
+
+// Class returns the action of the event, which events are counted by.
+func (e *SandboxEvent) Class() string {
+	return e.Action
+}
+
+// The subjects of the events of the containers and sandboxes of the default
+// namespace. The topics of the events of the other namespaces are prefixed
+// by their namespace.
 const (
 	ContainersEventsSubjectSubscriber = "containerd.execution.container.>"
+	SandboxesEventsSubjectSubscriber  = "containerd.execution.sandbox.>"
 )
 
+// ContainersEventsSubject returns the subject of the events of the
+// containers of namespace.
+func ContainersEventsSubject(namespace string) string {
+	if namespace == namespaces.Default {
+		return ContainersEventsSubjectSubscriber
+	}
+	return "containerd.execution." + namespace + ".container.>"
+}
+
+// SandboxesEventsSubject returns the subject of the events of the sandboxes
+// of namespace.
+func SandboxesEventsSubject(namespace string) string {
+	if namespace == namespaces.Default {
+		return SandboxesEventsSubjectSubscriber
+	}
+	return "containerd.execution." + namespace + ".sandbox.>"
+}
+
 const (
 	containerEventsTopicFormat        = "container.%s"
 	containerProcessEventsTopicFormat = "container.%s.%s"
+	sandboxEventsTopicFormat          = "sandbox.%s"
 )
@@ -2,17 +2,139 @@ package execution
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/docker/containerd/cgroups"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 type CreateOpts struct {
-	Bundle  string
-	Console bool
-	Stdin   string
-	Stdout  string
-	Stderr  string
+	// Runtime is the name of the runtime to create the container with. The
+	// default runtime is used when empty.
+	Runtime string
+	// Sandbox is the id of the sandbox to add the container to, if any.
+	Sandbox        string
+	Bundle         string
+	Console        bool
+	Stdin          string
+	Stdout         string
+	Stderr         string
+	RuntimeOptions RuntimeOptions
+	// PidsLimit limits the number of processes in the container when
+	// positive, -1 removes the limit of the bundle's spec.
+	PidsLimit int64
+	// CgroupParent places the container's cgroup under the parent. With
+	// the systemd cgroup driver the parent is a slice.
+	CgroupParent string
+	// SpecDefaults are the defaults of the runtime, applied to the spec of
+	// the bundle.
+	SpecDefaults SpecDefaults
+	// Admission is the policy of the runtime, the spec the container is
+	// created with is checked against it.
+	Admission *AdmissionPolicy
+	// SeccompProfile replaces the seccomp profile of the bundle's spec when
+	// set. It is a builtin profile name or a profile as JSON, see
+	// specification.SeccompProfile.
+	SeccompProfile string
+	// ApparmorProfile replaces the AppArmor profile of the init process
+	// when set.
+	ApparmorProfile string
+	// NoSelinuxLabel leaves the container unlabeled when the host enforces
+	// SELinux, rather than labeling it with an MCS level of its own.
+	NoSelinuxLabel bool
+	// UserNamespace runs the container in a user namespace with the
+	// mappings, replacing the user namespace of the runtime's defaults.
+	UserNamespace *UserNamespace
+	// Capabilities adjust the capabilities of the bundle's init process.
+	Capabilities CapabilityOpts
+	// Paths are merged into the bundle's spec.
+	Paths PathOpts
+	// Secrets are mounted read only into the container.
+	Secrets []Secret
+	// NetNSPath is the path of a network namespace for the container to
+	// join, replacing the network namespace of the bundle and the sandbox.
+	// The namespace is not added to the CNI network of the daemon, its
+	// network is left to its creator.
+	NetNSPath string
+	// Namespaces are joined by the container after its sandbox, such as
+	// the namespaces of the sandbox's pause process.
+	Namespaces []specs.LinuxNamespace
+	// NetworkFiles generate the /etc/hostname, /etc/hosts and
+	// /etc/resolv.conf of the container when set.
+	NetworkFiles NetworkFiles
+	// CheckpointPath is the directory of a checkpoint written by a
+	// Checkpointer, the container is restored from it rather than created.
+	CheckpointPath string
+}
+
+// ChangesSpec returns whether the options change the spec of the bundle, the
+// container then running with a spec of its own rather than that of the
+// bundle.
+func (o CreateOpts) ChangesSpec() bool {
+	return o.Sandbox != "" || o.PidsLimit != 0 || o.CgroupParent != "" ||
+		!o.SpecDefaults.IsZero() || o.SeccompProfile != "" || o.ApparmorProfile != "" ||
+		o.UserNamespace != nil || !o.Capabilities.IsZero() || !o.Paths.IsZero() ||
+		len(o.Secrets) > 0 || o.NetNSPath != "" || len(o.Namespaces) > 0 || !o.NetworkFiles.IsZero()
+}
+
+// Secret is a file mounted into a container, backed by memory only.
+type Secret struct {
+	Name string
+	// Target is the path of the secret in the container.
+	Target string
+	// Mode of the file, 0400 when zero.
+	Mode os.FileMode
+	Data []byte
+}
+
+// String describes the secret without its data, so that it is not logged
+// along with the options it is part of.
+func (s Secret) String() string {
+	return fmt.Sprintf("{%s %s %v}", s.Name, s.Target, s.Mode)
+}
+
+// CapabilityOpts adjust the capabilities of a process, which apply to its
+// bounding, effective, inheritable and permitted sets alike.
+type CapabilityOpts struct {
+	Add  []string
+	Drop []string
+	// Privileged grants all the capabilities before those dropped are
+	// removed.
+	Privileged bool
+}
+
+// IsZero returns whether the options leave the capabilities unchanged.
+func (o CapabilityOpts) IsZero() bool {
+	return len(o.Add) == 0 && len(o.Drop) == 0 && !o.Privileged
+}
+
+// RuntimeOptions are runc settings applied to every runtime invocation for
+// a container. The zero value uses the executor's defaults.
+type RuntimeOptions struct {
+	SystemdCgroup bool   `json:"systemdCgroup,omitempty"`
+	Root          string `json:"root,omitempty"`
+	CriuPath      string `json:"criuPath,omitempty"`
+	Debug         bool   `json:"debug,omitempty"`
+}
+
+// Args returns the runc global flags for the options.
+func (o RuntimeOptions) Args() []string {
+	var args []string
+	if o.Root != "" {
+		args = append(args, "--root", o.Root)
+	}
+	if o.CriuPath != "" {
+		args = append(args, "--criu", o.CriuPath)
+	}
+	if o.SystemdCgroup {
+		args = append(args, "--systemd-cgroup")
+	}
+	if o.Debug {
+		args = append(args, "--debug")
+	}
+	return args
 }
 
 type StartProcessOpts struct {
@@ -22,6 +144,12 @@ type StartProcessOpts struct {
 	Stdin   string
 	Stdout  string
 	Stderr  string
+	// Capabilities adjust the capabilities of the container's init
+	// process, which the process starts with when the spec sets none.
+	Capabilities CapabilityOpts
+	// NoNewPrivileges replaces the no new privileges setting of the spec,
+	// the process follows the container's init process when it is nil.
+	NoNewPrivileges *bool
 }
 
 type Executor interface {
@@ -37,3 +165,106 @@ type Executor interface {
 	SignalProcess(ctx context.Context, c *Container, id string, sig os.Signal) error
 	DeleteProcess(ctx context.Context, c *Container, id string) error
 }
+
+// Sources of runtime logs.
+const (
+	RuntimeLogSourceShim    = "shim"
+	RuntimeLogSourceRuntime = "runtime"
+)
+
+// RuntimeLog is an entry logged by the shim or the runtime while managing a
+// process.
+type RuntimeLog struct {
+	Source    string
+	Level     string
+	Message   string
+	Timestamp time.Time
+}
+
+// RuntimeLogger is implemented by executors that capture the logs of the
+// shim and runtime managing the processes of a container.
+type RuntimeLogger interface {
+	// RuntimeLogs returns the logs for the process, ordered by time. The
+	// init process is used when id is empty.
+	RuntimeLogs(ctx context.Context, c *Container, id string) ([]RuntimeLog, error)
+}
+
+// Updater is implemented by executors that update the resources of a
+// running container.
+type Updater interface {
+	Update(ctx context.Context, c *Container, resources *specs.LinuxResources) error
+}
+
+// OOMWatcher is implemented by executors that report the processes of a
+// container killed by the oom killer.
+type OOMWatcher interface {
+	// WatchOOM sends the number of processes killed on each oom event. The
+	// channel is closed once the container is gone.
+	WatchOOM(ctx context.Context, c *Container) (<-chan uint64, error)
+}
+
+// StatsReader is implemented by executors that report the resource usage of
+// a container's cgroup.
+type StatsReader interface {
+	Stats(ctx context.Context, c *Container) (*cgroups.Metrics, error)
+}
+
+// PidLister is implemented by executors that enumerate the processes of a
+// container's cgroup, including those started by the container's processes.
+type PidLister interface {
+	Pids(ctx context.Context, c *Container) ([]int, error)
+}
+
+// Stdio are the paths of the stdio of a process.
+type Stdio struct {
+	Stdin  string
+	Stdout string
+	Stderr string
+}
+
+// ContainerInfo is the state of a container kept by an executor.
+type ContainerInfo struct {
+	// Spec is the spec the container runs with, including the changes
+	// made by the executor to the spec of its bundle.
+	Spec *specs.Spec
+	// Stdio are the stdio of the processes of the container by process id.
+	Stdio map[string]Stdio
+}
+
+// Inspector is implemented by executors that report the state they keep for
+// a container.
+type Inspector interface {
+	Inspect(ctx context.Context, c *Container) (*ContainerInfo, error)
+}
+
+// Killer is implemented by executors that signal all the processes of a
+// container, including those started by the container's processes.
+type Killer interface {
+	KillAll(ctx context.Context, c *Container, sig os.Signal) error
+}
+
+// CheckpointOpts configure the checkpoint of a container.
+type CheckpointOpts struct {
+	// Path is the directory the checkpoint is written to.
+	Path string
+	// Exit stops the container once it is checkpointed.
+	Exit           bool
+	TCPEstablished bool
+	UnixSockets    bool
+	Shell          bool
+	// EmptyNS are the namespaces which are not restored with the container.
+	EmptyNS []string
+	// PreDump dumps the memory of the container only, leaving it running,
+	// for a later checkpoint to only dump the memory changed since.
+	PreDump bool
+	// ParentPath is the directory of the pre-dump the checkpoint is
+	// incremental to, relative to Path.
+	ParentPath string
+}
+
+// Checkpointer is implemented by executors that checkpoint running
+// containers, the containers are restored with the CheckpointPath of their
+// CreateOpts.
+type Checkpointer interface {
+	Checkpoint(ctx context.Context, c *Container, o CheckpointOpts) error
+}
@@ -0,0 +1,8 @@
+// Package hcs implements an executor running Windows Server containers
+// through the Host Compute Service.
+//
+// The root of the bundle's spec is the writable sandbox layer of the
+// container, a layer folder of the windowsfilter driver. Its layerchain.json
+// lists the paths of the read only layers it is stacked on, from the topmost
+// to the base layer, as written by the graph driver that created it.
+package hcs
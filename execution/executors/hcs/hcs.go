@@ -0,0 +1,563 @@
+// +build windows
+
+package hcs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/Microsoft/hcsshim"
+	"github.com/docker/containerd/bundle"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/log"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+const (
+	initProcessID = "init"
+
+	// layerChainFilename lists the parent layers of a sandbox layer.
+	layerChainFilename = "layerchain.json"
+	// filterDriver is the flavour of the layers of the windowsfilter driver.
+	filterDriver = 1
+)
+
+var (
+	ErrRootEmpty                 = errors.New("hcs: runtime root cannot be an empty string")
+	ErrRuntimeOptionsUnsupported = errors.New("hcs: runc runtime options are not supported")
+	ErrSpecDefaultsUnsupported   = errors.New("hcs: runtime spec defaults are not supported")
+	ErrCgroupOptionsUnsupported  = errors.New("hcs: pids limit and cgroup parent are not supported")
+	ErrSecurityUnsupported       = errors.New("hcs: security profiles, capabilities and path restrictions are not supported")
+	ErrUserNamespaceUnsupported  = errors.New("hcs: user namespaces are not supported")
+	ErrSecretsUnsupported        = errors.New("hcs: secrets are not supported")
+	ErrNamespacesUnsupported     = errors.New("hcs: joining namespaces is not supported")
+	ErrNetworkFilesUnsupported   = errors.New("hcs: managed hostname, hosts and resolv.conf are not supported")
+	ErrCheckpointUnsupported     = errors.New("hcs: restoring a checkpoint is not supported")
+	ErrNoLayers                  = errors.New("hcs: the root of the bundle is not stacked on any layer")
+)
+
+// New returns an executor creating its containers in HCS with the owner,
+// under which the state of the containers is kept in root. The containers of
+// the owner left by a previous daemon are terminated, their stdio being
+// lost with it.
+func New(ctx context.Context, root, owner string) (*Runtime, error) {
+	if root == "" {
+		return nil, ErrRootEmpty
+	}
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+	r := &Runtime{
+		ctx:        ctx,
+		root:       root,
+		owner:      owner,
+		containers: make(map[string]*container),
+	}
+	if err := r.cleanup(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type Runtime struct {
+	ctx   context.Context
+	root  string
+	owner string
+
+	mu         sync.Mutex
+	containers map[string]*container
+}
+
+// container is a compute system created for an execution container.
+type container struct {
+	c        *execution.Container
+	hcs      hcsshim.Container
+	spec     *specs.Spec
+	layer    hcsshim.DriverInfo
+	id       string // id of the sandbox layer
+	stdio    execution.Stdio
+	terminal bool
+}
+
+func (r *Runtime) Create(ctx context.Context, id string, o execution.CreateOpts) (c *execution.Container, err error) {
+	if o.Bundle == "" {
+		return nil, errors.New("bundle path cannot be an empty string")
+	}
+	if o.RuntimeOptions != (execution.RuntimeOptions{}) {
+		return nil, ErrRuntimeOptionsUnsupported
+	}
+	if !o.SpecDefaults.IsZero() {
+		return nil, ErrSpecDefaultsUnsupported
+	}
+	if o.PidsLimit != 0 || o.CgroupParent != "" {
+		return nil, ErrCgroupOptionsUnsupported
+	}
+	if o.SeccompProfile != "" || o.ApparmorProfile != "" || !o.Capabilities.IsZero() || !o.Paths.IsZero() {
+		return nil, ErrSecurityUnsupported
+	}
+	if o.UserNamespace != nil {
+		return nil, ErrUserNamespaceUnsupported
+	}
+	if len(o.Secrets) > 0 {
+		return nil, ErrSecretsUnsupported
+	}
+	if len(o.Namespaces) > 0 || o.NetNSPath != "" {
+		return nil, ErrNamespacesUnsupported
+	}
+	if !o.NetworkFiles.IsZero() {
+		return nil, ErrNetworkFilesUnsupported
+	}
+	if o.CheckpointPath != "" {
+		return nil, ErrCheckpointUnsupported
+	}
+	spec, err := bundleSpec(o.Bundle)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Root.Path == "" {
+		return nil, errors.New("hcs: the spec of the bundle has no root")
+	}
+	if o.Admission != nil {
+		if err := o.Admission.Check(spec, o.Bundle); err != nil {
+			return nil, err
+		}
+	}
+
+	ctr := &container{
+		spec:     spec,
+		layer:    hcsshim.DriverInfo{Flavour: filterDriver, HomeDir: filepath.Dir(spec.Root.Path)},
+		id:       filepath.Base(spec.Root.Path),
+		stdio:    execution.Stdio{Stdin: o.Stdin, Stdout: o.Stdout, Stderr: o.Stderr},
+		terminal: o.Console || spec.Process.Terminal,
+	}
+	config, err := r.containerConfig(id, ctr)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.prepareLayer(ctr, config); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			r.releaseLayer(ctr)
+		}
+	}()
+
+	if c, err = execution.NewContainer(r.root, id, o.Bundle); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			c.StateDir().Delete()
+		}
+	}()
+	if _, err := c.StateDir().NewProcess(initProcessID); err != nil {
+		return nil, err
+	}
+
+	if ctr.hcs, err = hcsshim.CreateContainer(id, config); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			ctr.hcs.Terminate()
+			ctr.hcs.Close()
+		}
+	}()
+	// the compute system is booted as the container is created, its init
+	// process only being started by Start
+	if err := ctr.hcs.Start(); err != nil {
+		return nil, err
+	}
+	ctr.c = c
+	c.AddProcess(newProcess(initProcessID, true), true)
+
+	r.mu.Lock()
+	r.containers[id] = ctr
+	r.mu.Unlock()
+	return c, nil
+}
+
+// containerConfig returns the configuration of the compute system of a
+// container, without its volume and layers.
+func (r *Runtime) containerConfig(id string, ctr *container) (*hcsshim.ContainerConfig, error) {
+	config := &hcsshim.ContainerConfig{
+		SystemType:              "Container",
+		Name:                    id,
+		Owner:                   r.owner,
+		HostName:                ctr.spec.Hostname,
+		IgnoreFlushesDuringBoot: true,
+	}
+	for _, m := range ctr.spec.Mounts {
+		if m.Type != "" && m.Type != "bind" {
+			return nil, fmt.Errorf("hcs: unsupported mount type %q for %s", m.Type, m.Destination)
+		}
+		md := hcsshim.MappedDir{
+			HostPath:      m.Source,
+			ContainerPath: m.Destination,
+		}
+		for _, opt := range m.Options {
+			if opt == "ro" {
+				md.ReadOnly = true
+			}
+		}
+		config.MappedDirectories = append(config.MappedDirectories, md)
+	}
+	if ctr.spec.Windows == nil || ctr.spec.Windows.Resources == nil {
+		return config, nil
+	}
+	res := ctr.spec.Windows.Resources
+	if res.Memory != nil && res.Memory.Limit != nil {
+		config.MemoryMaximumInMB = int64(*res.Memory.Limit) / 1024 / 1024
+	}
+	if res.CPU != nil {
+		if res.CPU.Count != nil {
+			config.ProcessorCount = uint32(*res.CPU.Count)
+		}
+		if res.CPU.Shares != nil {
+			config.ProcessorWeight = uint64(*res.CPU.Shares)
+		}
+		if res.CPU.Percent != nil {
+			// the maximum is a percentage times 100
+			config.ProcessorMaximum = int64(*res.CPU.Percent) * 100
+		}
+	}
+	if res.Storage != nil {
+		if res.Storage.Iops != nil {
+			config.StorageIOPSMaximum = *res.Storage.Iops
+		}
+		if res.Storage.Bps != nil {
+			config.StorageBandwidthMaximum = *res.Storage.Bps
+		}
+		if res.Storage.SandboxSize != nil {
+			config.StorageSandboxSize = *res.Storage.SandboxSize
+		}
+	}
+	return config, nil
+}
+
+// prepareLayer activates the sandbox layer of a container, stacked on the
+// layers of its chain, setting the volume and layers of the configuration.
+func (r *Runtime) prepareLayer(ctr *container, config *hcsshim.ContainerConfig) error {
+	parents, err := layerChain(ctr.spec.Root.Path)
+	if err != nil {
+		return err
+	}
+	for _, p := range parents {
+		guid, err := hcsshim.NameToGuid(filepath.Base(p))
+		if err != nil {
+			return err
+		}
+		config.Layers = append(config.Layers, hcsshim.Layer{
+			ID:   guid.ToString(),
+			Path: p,
+		})
+	}
+	if err := hcsshim.ActivateLayer(ctr.layer, ctr.id); err != nil {
+		return err
+	}
+	if err := hcsshim.PrepareLayer(ctr.layer, ctr.id, parents); err != nil {
+		hcsshim.DeactivateLayer(ctr.layer, ctr.id)
+		return err
+	}
+	volume, err := hcsshim.GetLayerMountPath(ctr.layer, ctr.id)
+	if err != nil {
+		r.releaseLayer(ctr)
+		return err
+	}
+	config.VolumePath = volume
+	config.LayerFolderPath = ctr.spec.Root.Path
+	return nil
+}
+
+// releaseLayer unprepares and deactivates the sandbox layer of a container.
+func (r *Runtime) releaseLayer(ctr *container) {
+	if err := hcsshim.UnprepareLayer(ctr.layer, ctr.id); err != nil {
+		log.G(r.ctx).WithError(err).WithField("layer", ctr.id).Warn("hcs: failed to unprepare layer")
+	}
+	if err := hcsshim.DeactivateLayer(ctr.layer, ctr.id); err != nil {
+		log.G(r.ctx).WithError(err).WithField("layer", ctr.id).Warn("hcs: failed to deactivate layer")
+	}
+}
+
+// layerChain returns the paths of the parent layers of the layer at path.
+func layerChain(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(path, layerChainFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoLayers
+		}
+		return nil, err
+	}
+	var parents []string
+	if err := json.Unmarshal(data, &parents); err != nil {
+		return nil, fmt.Errorf("hcs: invalid %s: %v", layerChainFilename, err)
+	}
+	if len(parents) == 0 {
+		return nil, ErrNoLayers
+	}
+	return parents, nil
+}
+
+func (r *Runtime) Start(ctx context.Context, c *execution.Container) error {
+	ctr, err := r.container(c.ID())
+	if err != nil {
+		return err
+	}
+	p, ok := c.GetProcess(initProcessID).(*process)
+	if !ok {
+		return execution.ErrProcessNotFound
+	}
+	if err := p.start(ctr.hcs, processConfig(&ctr.spec.Process, ctr.terminal), ctr.stdio); err != nil {
+		return err
+	}
+	// the pid of the init process is only known once it is started
+	c.AddProcess(p, true)
+	return nil
+}
+
+func (r *Runtime) List(ctx context.Context) ([]*execution.Container, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	containers := make([]*execution.Container, 0, len(r.containers))
+	for _, ctr := range r.containers {
+		containers = append(containers, ctr.c)
+	}
+	return containers, nil
+}
+
+func (r *Runtime) Load(ctx context.Context, id string) (*execution.Container, error) {
+	ctr, err := r.container(id)
+	if err != nil {
+		return nil, err
+	}
+	return ctr.c, nil
+}
+
+func (r *Runtime) Delete(ctx context.Context, c *execution.Container) error {
+	ctr, err := r.container(c.ID())
+	if err != nil {
+		return err
+	}
+	if c.Status() != execution.Stopped {
+		if err := ctr.hcs.Terminate(); err != nil && !hcsshim.IsPending(err) && !hcsshim.IsAlreadyStopped(err) {
+			return err
+		}
+		if err := ctr.hcs.Wait(); err != nil && !hcsshim.IsAlreadyStopped(err) {
+			return err
+		}
+	}
+	for _, p := range c.Processes() {
+		p.(*process).close()
+	}
+	if err := ctr.hcs.Close(); err != nil {
+		log.G(r.ctx).WithError(err).WithField("container", c.ID()).Warn("hcs: failed to close container")
+	}
+	r.releaseLayer(ctr)
+	r.mu.Lock()
+	delete(r.containers, c.ID())
+	r.mu.Unlock()
+	return c.StateDir().Delete()
+}
+
+func (r *Runtime) Pause(ctx context.Context, c *execution.Container) error {
+	ctr, err := r.container(c.ID())
+	if err != nil {
+		return err
+	}
+	if err := ctr.hcs.Pause(); err != nil {
+		return err
+	}
+	r.setStatus(c, execution.Paused)
+	return nil
+}
+
+func (r *Runtime) Resume(ctx context.Context, c *execution.Container) error {
+	ctr, err := r.container(c.ID())
+	if err != nil {
+		return err
+	}
+	if err := ctr.hcs.Resume(); err != nil {
+		return err
+	}
+	r.setStatus(c, execution.Running)
+	return nil
+}
+
+// setStatus sets the status of the running processes of a container, HCS
+// pausing the compute system as a whole.
+func (r *Runtime) setStatus(c *execution.Container, status execution.Status) {
+	for _, p := range c.Processes() {
+		p.(*process).setStatus(status)
+	}
+}
+
+func (r *Runtime) StartProcess(ctx context.Context, c *execution.Container, o execution.StartProcessOpts) (_ execution.Process, err error) {
+	if !o.Capabilities.IsZero() || o.NoNewPrivileges != nil {
+		return nil, ErrSecurityUnsupported
+	}
+	ctr, err := r.container(c.ID())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.StateDir().NewProcess(o.ID); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			c.StateDir().DeleteProcess(o.ID)
+		}
+	}()
+	p := newProcess(o.ID, false)
+	stdio := execution.Stdio{Stdin: o.Stdin, Stdout: o.Stdout, Stderr: o.Stderr}
+	if err := p.start(ctr.hcs, processConfig(&o.Spec, o.Console || o.Spec.Terminal), stdio); err != nil {
+		return nil, err
+	}
+	c.AddProcess(p, false)
+	return p, nil
+}
+
+func (r *Runtime) SignalProcess(ctx context.Context, c *execution.Container, id string, sig os.Signal) error {
+	p := c.GetProcess(id)
+	if p == nil {
+		return execution.ErrProcessNotFound
+	}
+	if id != initProcessID {
+		return p.Signal(sig)
+	}
+	ctr, err := r.container(c.ID())
+	if err != nil {
+		return err
+	}
+	// the compute system is stopped along with its init process, shutting
+	// it down lets its services exit cleanly
+	switch sig {
+	case syscall.SIGKILL:
+		err = ctr.hcs.Terminate()
+	case syscall.SIGTERM, syscall.SIGINT:
+		err = ctr.hcs.Shutdown()
+	default:
+		return ErrSignalUnsupported
+	}
+	if err != nil && !hcsshim.IsPending(err) && !hcsshim.IsAlreadyStopped(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *Runtime) DeleteProcess(ctx context.Context, c *execution.Container, id string) error {
+	if p, ok := c.GetProcess(id).(*process); ok {
+		p.close()
+	}
+	c.RemoveProcess(id)
+	return c.StateDir().DeleteProcess(id)
+}
+
+// Pids returns the pids of the processes running in the compute system of
+// a container.
+func (r *Runtime) Pids(ctx context.Context, c *execution.Container) ([]int, error) {
+	ctr, err := r.container(c.ID())
+	if err != nil {
+		return nil, err
+	}
+	list, err := ctr.hcs.ProcessList()
+	if err != nil {
+		return nil, err
+	}
+	pids := make([]int, 0, len(list))
+	for _, p := range list {
+		pids = append(pids, int(p.ProcessId))
+	}
+	return pids, nil
+}
+
+func (r *Runtime) container(id string) (*container, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ctr, ok := r.containers[id]
+	if !ok {
+		return nil, execution.ErrContainerNotFound
+	}
+	return ctr, nil
+}
+
+// cleanup terminates the compute systems of the owner and removes their
+// state.
+func (r *Runtime) cleanup() error {
+	systems, err := hcsshim.GetContainers(hcsshim.ComputeSystemQuery{
+		Owners: []string{r.owner},
+	})
+	if err != nil {
+		return err
+	}
+	for _, s := range systems {
+		logger := log.G(r.ctx).WithField("container", s.ID)
+		c, err := hcsshim.OpenContainer(s.ID)
+		if err != nil {
+			logger.WithError(err).Warn("hcs: failed to open leftover container")
+			continue
+		}
+		if err := c.Terminate(); err == nil || hcsshim.IsPending(err) {
+			c.Wait()
+		} else if !hcsshim.IsAlreadyStopped(err) {
+			logger.WithError(err).Warn("hcs: failed to terminate leftover container")
+		}
+		c.Close()
+		logger.Info("hcs: terminated leftover container")
+	}
+	dirs, err := ioutil.ReadDir(r.root)
+	if err != nil {
+		return err
+	}
+	for _, d := range dirs {
+		if err := os.RemoveAll(filepath.Join(r.root, d.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processConfig returns the configuration of an HCS process for spec.
+func processConfig(spec *specs.Process, terminal bool) *hcsshim.ProcessConfig {
+	args := make([]string, len(spec.Args))
+	for i, a := range spec.Args {
+		args[i] = syscall.EscapeArg(a)
+	}
+	env := make(map[string]string)
+	for _, kv := range spec.Env {
+		if i := strings.Index(kv, "="); i > 0 {
+			env[kv[:i]] = kv[i+1:]
+		}
+	}
+	config := &hcsshim.ProcessConfig{
+		CommandLine:      strings.Join(args, " "),
+		User:             spec.User.Username,
+		WorkingDirectory: spec.Cwd,
+		Environment:      env,
+		EmulateConsole:   terminal,
+		CreateStdInPipe:  true,
+		CreateStdOutPipe: true,
+		CreateStdErrPipe: !terminal,
+	}
+	if terminal {
+		config.ConsoleSize = [2]uint{spec.ConsoleSize.Height, spec.ConsoleSize.Width}
+	}
+	return config
+}
+
+// bundleSpec returns the spec of the bundle at path.
+func bundleSpec(path string) (*specs.Spec, error) {
+	b, err := bundle.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return b.Config()
+}
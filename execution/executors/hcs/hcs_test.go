@@ -0,0 +1,65 @@
+// +build windows
+
+package hcs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestProcessConfig(t *testing.T) {
+	config := processConfig(&specs.Process{
+		Args: []string{`C:\Program Files\app.exe`, "-v"},
+		Env:  []string{"PATH=C:\\Windows", "EMPTY=", "INVALID"},
+		Cwd:  `C:\`,
+		User: specs.User{Username: "ContainerUser"},
+	}, false)
+	if config.CommandLine != `"C:\Program Files\app.exe" -v` {
+		t.Errorf("unexpected command line %q", config.CommandLine)
+	}
+	if !reflect.DeepEqual(config.Environment, map[string]string{"PATH": `C:\Windows`, "EMPTY": ""}) {
+		t.Errorf("unexpected environment %v", config.Environment)
+	}
+	if config.User != "ContainerUser" || config.WorkingDirectory != `C:\` {
+		t.Errorf("unexpected user %q or working directory %q", config.User, config.WorkingDirectory)
+	}
+	if !config.CreateStdErrPipe || config.EmulateConsole {
+		t.Error("expected a process without terminal to have a stderr pipe")
+	}
+
+	config = processConfig(&specs.Process{
+		Args:        []string{"cmd"},
+		ConsoleSize: specs.Box{Height: 24, Width: 80},
+	}, true)
+	if config.CreateStdErrPipe || !config.EmulateConsole || config.ConsoleSize != [2]uint{24, 80} {
+		t.Errorf("expected a console of 24x80 without stderr pipe, got %+v", config)
+	}
+}
+
+func TestLayerChain(t *testing.T) {
+	root, err := ioutil.TempDir("", "hcs-layers-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if _, err := layerChain(root); err != ErrNoLayers {
+		t.Fatalf("expected a layer without chain to fail with %v, got %v", ErrNoLayers, err)
+	}
+	chain := []byte(`["C:\\layers\\top","C:\\layers\\base"]`)
+	if err := ioutil.WriteFile(filepath.Join(root, layerChainFilename), chain, 0600); err != nil {
+		t.Fatal(err)
+	}
+	parents, err := layerChain(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parents, []string{`C:\layers\top`, `C:\layers\base`}) {
+		t.Fatalf("unexpected parents %v", parents)
+	}
+}
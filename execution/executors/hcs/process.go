@@ -0,0 +1,247 @@
+// +build windows
+
+package hcs
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Microsoft/go-winio"
+	"github.com/Microsoft/hcsshim"
+	"github.com/docker/containerd/execution"
+)
+
+// pipeTimeout is the time the named pipes of the stdio of a process are
+// waited for.
+var pipeTimeout = 10 * time.Second
+
+var ErrSignalUnsupported = errors.New("hcs: only SIGKILL, SIGTERM and SIGINT are supported")
+
+func newProcess(id string, init bool) *process {
+	status := execution.Running
+	if init {
+		status = execution.Created
+	}
+	return &process{
+		id:       id,
+		status:   status,
+		exitCode: execution.UnknownStatusCode,
+		exited:   make(chan struct{}),
+	}
+}
+
+// process is a process of a compute system. The init process is created
+// before its process in HCS, which is started by the start of the container.
+type process struct {
+	id string
+
+	mu       sync.Mutex
+	hcs      hcsshim.Process
+	pid      int
+	status   execution.Status
+	exitCode uint32
+	exited   chan struct{}
+	pipes    []net.Conn
+}
+
+func (p *process) ID() string {
+	return p.id
+}
+
+func (p *process) Pid() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return int64(p.pid)
+}
+
+// start creates the process in the compute system c, copying its stdio
+// from and to the named pipes of stdio.
+func (p *process) start(c hcsshim.Container, config *hcsshim.ProcessConfig, stdio execution.Stdio) (err error) {
+	pipes, err := dialPipes(stdio)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			for _, c := range pipes {
+				if c != nil {
+					c.Close()
+				}
+			}
+		}
+	}()
+	hp, err := c.CreateProcess(config)
+	if err != nil {
+		return err
+	}
+	stdin, stdout, stderr, err := hp.Stdio()
+	if err != nil {
+		hp.Kill()
+		hp.Close()
+		return err
+	}
+	copyPipes(hp, pipes, stdin, stdout, stderr)
+
+	p.mu.Lock()
+	p.hcs = hp
+	p.pid = hp.Pid()
+	p.status = execution.Running
+	p.pipes = pipes
+	p.mu.Unlock()
+	go p.wait()
+	return nil
+}
+
+// wait waits for the process to exit, collecting its exit code.
+func (p *process) wait() {
+	code := uint32(execution.UnknownStatusCode)
+	if err := p.hcs.Wait(); err == nil {
+		if c, err := p.hcs.ExitCode(); err == nil {
+			code = uint32(c)
+		}
+	}
+	p.mu.Lock()
+	p.status = execution.Stopped
+	p.exitCode = code
+	p.mu.Unlock()
+	close(p.exited)
+}
+
+func (p *process) Wait() (uint32, error) {
+	<-p.exited
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.exitCode, nil
+}
+
+// ExitStatus returns the exit code of the stopped process.
+func (p *process) ExitStatus() (uint32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.status != execution.Stopped {
+		return 0, errors.New("hcs: process is not stopped")
+	}
+	return p.exitCode, nil
+}
+
+// Signal terminates the process on SIGKILL, SIGTERM and SIGINT, processes
+// of a compute system receiving no other signals.
+func (p *process) Signal(s os.Signal) error {
+	switch s {
+	case syscall.SIGKILL, syscall.SIGTERM, syscall.SIGINT:
+	default:
+		return ErrSignalUnsupported
+	}
+	p.mu.Lock()
+	hp, status := p.hcs, p.status
+	p.mu.Unlock()
+	if hp == nil || status == execution.Stopped {
+		return execution.ErrProcessNotFound
+	}
+	if err := hp.Kill(); err != nil && !hcsshim.IsAlreadyStopped(err) {
+		return err
+	}
+	return nil
+}
+
+func (p *process) Status() execution.Status {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.status
+}
+
+// setStatus sets the status of the process unless it is not running, for
+// the pause of a container not to hide the exit of its processes.
+func (p *process) setStatus(status execution.Status) {
+	p.mu.Lock()
+	if p.status == execution.Running || p.status == execution.Paused {
+		p.status = status
+	}
+	p.mu.Unlock()
+}
+
+// Resize resizes the console of the process.
+func (p *process) Resize(width, height uint32) error {
+	p.mu.Lock()
+	hp := p.hcs
+	p.mu.Unlock()
+	if hp == nil {
+		return execution.ErrProcessNotFound
+	}
+	return hp.ResizeConsole(uint16(width), uint16(height))
+}
+
+// close releases the handle of the process and its stdio. An init process
+// which was never started is stopped, for its waiters to return.
+func (p *process) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.hcs == nil && p.status != execution.Stopped {
+		p.status = execution.Stopped
+		close(p.exited)
+	}
+	for _, c := range p.pipes {
+		if c != nil {
+			c.Close()
+		}
+	}
+	if p.hcs != nil {
+		p.hcs.Close()
+	}
+}
+
+// dialPipes connects to the named pipes of stdio, the pipes left empty
+// being nil.
+func dialPipes(stdio execution.Stdio) ([]net.Conn, error) {
+	pipes := make([]net.Conn, 3)
+	for i, path := range []string{stdio.Stdin, stdio.Stdout, stdio.Stderr} {
+		if path == "" {
+			continue
+		}
+		c, err := winio.DialPipe(path, &pipeTimeout)
+		if err != nil {
+			for _, c := range pipes[:i] {
+				if c != nil {
+					c.Close()
+				}
+			}
+			return nil, err
+		}
+		pipes[i] = c
+	}
+	return pipes, nil
+}
+
+// copyPipes copies the stdio of the process hp from and to the pipes. The
+// stdin of the process is closed once its pipe is, for the process to read
+// the end of its input.
+func copyPipes(hp hcsshim.Process, pipes []net.Conn, stdin io.WriteCloser, stdout, stderr io.ReadCloser) {
+	if stdin != nil {
+		if pipes[0] != nil {
+			go func() {
+				io.Copy(stdin, pipes[0])
+				hp.CloseStdin()
+			}()
+		} else {
+			hp.CloseStdin()
+		}
+	}
+	for i, r := range []io.ReadCloser{stdout, stderr} {
+		if r == nil {
+			continue
+		}
+		go func(w io.Writer, r io.ReadCloser) {
+			if w == nil {
+				w = ioutil.Discard
+			}
+			io.Copy(w, r)
+			r.Close()
+		}(pipes[i+1], r)
+	}
+}
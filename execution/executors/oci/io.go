@@ -1,10 +1,10 @@
 package oci
 
 import (
-	"io"
 	"os"
 
 	"github.com/crosbymichael/go-runc"
+	"github.com/docker/containerd/stdio"
 )
 
 type OIO struct {
@@ -20,13 +20,13 @@ func newOIO(stdin, stdout, stderr string, console bool) (o OIO, err error) {
 		}
 	}()
 
-	if o.rio.Stdin, err = os.OpenFile(stdin, os.O_RDONLY, 0); err != nil {
+	if o.rio.Stdin, err = stdio.OpenReader(stdin, stdio.DefaultOpenTimeout); err != nil {
 		return
 	}
-	if o.rio.Stdout, err = os.OpenFile(stdout, os.O_WRONLY, 0); err != nil {
+	if o.rio.Stdout, err = stdio.OpenWriter(stdout, stdio.DefaultOpenTimeout); err != nil {
 		return
 	}
-	if o.rio.Stderr, err = os.OpenFile(stderr, os.O_WRONLY, 0); err != nil {
+	if o.rio.Stderr, err = stdio.OpenWriter(stderr, stdio.DefaultOpenTimeout); err != nil {
 		return
 	}
 
@@ -35,9 +35,9 @@ func newOIO(stdin, stdout, stderr string, console bool) (o OIO, err error) {
 		if err != nil {
 			return
 		}
-		go io.Copy(o.master, o.rio.Stdin)
+		go stdio.Copy(o.master, o.rio.Stdin)
 		go func() {
-			io.Copy(o.rio.Stdout, o.master)
+			stdio.Copy(o.rio.Stdout, o.master)
 			o.master.Close()
 		}()
 	}
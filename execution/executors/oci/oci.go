@@ -6,11 +6,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"syscall"
 
 	"github.com/crosbymichael/go-runc"
+	"github.com/docker/containerd/bundle"
 	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/reaper"
 	"github.com/docker/containerd/sys"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 const (
@@ -23,19 +27,32 @@ const (
 )
 
 var (
-	ErrRootEmpty = errors.New("oci: runtime root cannot be an empty string")
+	ErrRootEmpty                 = errors.New("oci: runtime root cannot be an empty string")
+	ErrRuntimeOptionsUnsupported = errors.New("oci: per-container runtime options require the shim runtime")
+	ErrSpecDefaultsUnsupported   = errors.New("oci: runtime spec defaults require the shim runtime")
+	ErrCgroupOptionsUnsupported  = errors.New("oci: pids limit and cgroup parent require the shim runtime")
+	ErrSecurityUnsupported       = errors.New("oci: security profiles, capabilities and path restrictions require the shim runtime")
+	ErrUserNamespaceUnsupported  = errors.New("oci: user namespace remapping requires the shim runtime")
+	ErrSecretsUnsupported        = errors.New("oci: secrets require the shim runtime")
+	ErrNetworkUnsupported        = errors.New("oci: joining a network namespace requires the shim runtime")
+	ErrNetworkFilesUnsupported   = errors.New("oci: managed hostname, hosts and resolv.conf require the shim runtime")
+	ErrNamespacesUnsupported     = errors.New("oci: joining the namespaces of a sandbox requires the shim runtime")
 )
 
 func New(root string) (*OCIRuntime, error) {
+	// containers are waited for by pid, a wait for any child would take the
+	// statuses of the runtime commands from their callers.
 	err := sys.SetSubreaper(1)
 	if err != nil {
 		return nil, err
 	}
-	go func() {
-		syscall.Wait4(-1, nil, 0, nil)
-	}()
+	poller, err := reaper.NewPoller()
+	if err != nil {
+		return nil, err
+	}
 	return &OCIRuntime{
-		root: root,
+		root:   root,
+		poller: poller,
 		runc: &runc.Runc{
 			Root: filepath.Join(root, "runc"),
 		},
@@ -46,13 +63,54 @@ func New(root string) (*OCIRuntime, error) {
 type OCIRuntime struct {
 	root string
 	runc *runc.Runc
-	ios  map[string]OIO // ios tracks created process io for cleanup purpose on delete
+	// poller notifies the exits of the processes of the containers.
+	poller *reaper.Poller
+
+	mu  sync.Mutex
+	ios map[string]OIO // ios tracks created process io for cleanup purpose on delete
 }
 
 func (r *OCIRuntime) Create(ctx context.Context, id string, o execution.CreateOpts) (container *execution.Container, err error) {
 	if o.Bundle == "" {
 		return nil, errors.New("bundle path cannot be an empty string")
 	}
+	if o.RuntimeOptions != (execution.RuntimeOptions{}) {
+		// all containers share a single runc root and configuration
+		return nil, ErrRuntimeOptionsUnsupported
+	}
+	if !o.SpecDefaults.IsZero() {
+		return nil, ErrSpecDefaultsUnsupported
+	}
+	if o.PidsLimit != 0 || o.CgroupParent != "" {
+		return nil, ErrCgroupOptionsUnsupported
+	}
+	if o.SeccompProfile != "" || o.ApparmorProfile != "" || !o.Capabilities.IsZero() || !o.Paths.IsZero() {
+		return nil, ErrSecurityUnsupported
+	}
+	if o.UserNamespace != nil {
+		return nil, ErrUserNamespaceUnsupported
+	}
+	if len(o.Secrets) > 0 {
+		return nil, ErrSecretsUnsupported
+	}
+	if len(o.Namespaces) > 0 {
+		return nil, ErrNamespacesUnsupported
+	}
+	if o.NetNSPath != "" {
+		return nil, ErrNetworkUnsupported
+	}
+	if !o.NetworkFiles.IsZero() {
+		return nil, ErrNetworkFilesUnsupported
+	}
+	if o.Admission != nil {
+		spec, err := bundleSpec(o.Bundle)
+		if err != nil {
+			return nil, err
+		}
+		if err := o.Admission.Check(spec, o.Bundle); err != nil {
+			return nil, err
+		}
+	}
 	oio, err := newOIO(o.Stdin, o.Stdout, o.Stderr, o.Console)
 	if err != nil {
 		return nil, err
@@ -92,14 +150,14 @@ func (r *OCIRuntime) Create(ctx context.Context, id string, o execution.CreateOp
 		}
 	}()
 
-	process, err := newProcess(initProcessID, initStateDir, execution.Created)
+	process, err := newProcess(initProcessID, initStateDir, execution.Created, r.poller)
 	if err != nil {
 		return nil, err
 	}
 
 	container.AddProcess(process, true)
 
-	r.ios[id] = oio
+	r.addIO(id, oio)
 
 	return container, nil
 }
@@ -129,7 +187,7 @@ func (r *OCIRuntime) load(runcC *runc.Container) (*execution.Container, error) {
 		return nil, err
 	}
 	for _, d := range dirs {
-		process, err := newProcess(filepath.Base(d), d, execution.Running)
+		process, err := newProcess(filepath.Base(d), d, execution.Running, r.poller)
 		if err != nil {
 			return nil, err
 		}
@@ -177,8 +235,7 @@ func (r *OCIRuntime) Delete(ctx context.Context, c *execution.Container) error {
 		return err
 	}
 	c.StateDir().Delete()
-	r.ios[id].cleanup()
-	delete(r.ios, id)
+	r.removeIO(id).cleanup()
 	return nil
 }
 
@@ -191,6 +248,18 @@ func (r *OCIRuntime) Resume(ctx context.Context, c *execution.Container) error {
 }
 
 func (r *OCIRuntime) StartProcess(ctx context.Context, c *execution.Container, o execution.StartProcessOpts) (p execution.Process, err error) {
+	if !o.Capabilities.IsZero() {
+		return nil, ErrSecurityUnsupported
+	}
+	if o.NoNewPrivileges == nil {
+		spec, err := bundleSpec(c.Bundle())
+		if err != nil {
+			return nil, err
+		}
+		o.Spec.NoNewPrivileges = spec.Process.NoNewPrivileges
+	} else {
+		o.Spec.NoNewPrivileges = *o.NoNewPrivileges
+	}
 	oio, err := newOIO(o.Stdin, o.Stdout, o.Stderr, o.Console)
 	if err != nil {
 		return nil, err
@@ -222,14 +291,14 @@ func (r *OCIRuntime) StartProcess(ctx context.Context, c *execution.Container, o
 		return nil, err
 	}
 
-	process, err := newProcess(o.ID, procStateDir, execution.Running)
+	process, err := newProcess(o.ID, procStateDir, execution.Running, r.poller)
 	if err != nil {
 		return nil, err
 	}
 
 	c.AddProcess(process, false)
 
-	r.ios[fmt.Sprintf("%s-%s", c.ID(), process.ID())] = oio
+	r.addIO(fmt.Sprintf("%s-%s", c.ID(), process.ID()), oio)
 
 	return process, nil
 }
@@ -243,9 +312,31 @@ func (r *OCIRuntime) SignalProcess(ctx context.Context, c *execution.Container,
 }
 
 func (r *OCIRuntime) DeleteProcess(ctx context.Context, c *execution.Container, id string) error {
-	ioID := fmt.Sprintf("%s-%s", c.ID(), id)
-	r.ios[ioID].cleanup()
-	delete(r.ios, ioID)
+	r.removeIO(fmt.Sprintf("%s-%s", c.ID(), id)).cleanup()
 	c.RemoveProcess(id)
 	return c.StateDir().DeleteProcess(id)
 }
+
+// bundleSpec returns the spec of the bundle at path.
+func bundleSpec(path string) (*specs.Spec, error) {
+	b, err := bundle.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return b.Config()
+}
+
+func (r *OCIRuntime) addIO(id string, oio OIO) {
+	r.mu.Lock()
+	r.ios[id] = oio
+	r.mu.Unlock()
+}
+
+// removeIO removes the io of the process id, returning it to be cleaned up.
+func (r *OCIRuntime) removeIO(id string) OIO {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	oio := r.ios[id]
+	delete(r.ios, id)
+	return oio
+}
@@ -9,10 +9,11 @@ import (
 
 	"github.com/crosbymichael/go-runc"
 	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/reaper"
 	starttime "github.com/opencontainers/runc/libcontainer/system"
 )
 
-func newProcess(id, stateDir string, status execution.Status) (execution.Process, error) {
+func newProcess(id, stateDir string, status execution.Status, poller *reaper.Poller) (execution.Process, error) {
 	pid, err := runc.ReadPidFile(filepath.Join(stateDir, PidFilename))
 	if err != nil {
 		return nil, err
@@ -24,6 +25,7 @@ func newProcess(id, stateDir string, status execution.Status) (execution.Process
 			return nil, err
 		}
 	}
+	var startTime string
 	if status != execution.Stopped {
 		stime, err := starttime.GetProcessStartTime(pid)
 		switch {
@@ -44,13 +46,16 @@ func newProcess(id, stateDir string, status execution.Status) (execution.Process
 			case string(b) != stime:
 				status = execution.Stopped
 			}
+			startTime = stime
 		}
 	}
 	return &process{
-		id:       id,
-		pid:      pid,
-		status:   status,
-		exitCode: execution.UnknownStatusCode,
+		id:        id,
+		pid:       pid,
+		status:    status,
+		exitCode:  execution.UnknownStatusCode,
+		startTime: startTime,
+		poller:    poller,
 	}, nil
 }
 
@@ -59,6 +64,9 @@ type process struct {
 	pid      int
 	status   execution.Status
 	exitCode uint32
+	// startTime tells the process from another one reusing its pid
+	startTime string
+	poller    *reaper.Poller
 }
 
 func (p *process) ID() string {
@@ -86,6 +94,17 @@ func (p *process) Wait() (uint32, error) {
 
 }
 
+// NotifyExit calls fn once the process exited, its status then being
+// collected by Wait. The process may have exited since it was loaded, its
+// start time is checked again before watching its pid.
+func (p *process) NotifyExit(fn func()) error {
+	if p.status == execution.Stopped {
+		fn()
+		return nil
+	}
+	return p.poller.Watch(p.pid, p.startTime, fn)
+}
+
 func (p *process) Signal(s os.Signal) error {
 	if p.status != execution.Stopped {
 		sig, ok := s.(syscall.Signal)
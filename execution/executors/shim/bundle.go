@@ -0,0 +1,204 @@
+package shim
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/specification"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const runtimeBundleDirname = "bundle"
+
+// writeRuntimeBundle writes a bundle with the spec to the container's state
+// directory, for containers whose spec is modified by the executor. The
+// rootfs of the original bundle is used and the original bundle is left
+// untouched. The path of the written bundle is returned.
+func writeRuntimeBundle(c *execution.Container, bundle string, spec *specs.Spec) (string, error) {
+	if !filepath.IsAbs(spec.Root.Path) {
+		spec.Root.Path = filepath.Join(bundle, spec.Root.Path)
+	}
+	dir := filepath.Join(string(c.StateDir()), runtimeBundleDirname)
+	if err := os.Mkdir(dir, 0700); err != nil {
+		return "", errors.Wrap(err, "failed to create runtime bundle")
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "config.json"), data, 0600); err != nil {
+		return "", errors.Wrap(err, "failed to write runtime bundle config")
+	}
+	return dir, nil
+}
+
+// runtimeBundle returns the bundle the runtime manages the container with.
+func runtimeBundle(c *execution.Container) string {
+	dir := filepath.Join(string(c.StateDir()), runtimeBundleDirname)
+	if _, err := os.Stat(dir); err == nil {
+		return dir
+	}
+	return c.Bundle()
+}
+
+// applyCgroupOpts places the container under the cgroup parent and limits
+// its processes as set in the create options.
+func applyCgroupOpts(id string, o execution.CreateOpts, spec *specs.Spec) error {
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	if o.CgroupParent != "" {
+		var cgroupsPath string
+		if o.RuntimeOptions.SystemdCgroup {
+			if !strings.HasSuffix(o.CgroupParent, ".slice") {
+				return errors.Errorf("cgroup parent %q is not a systemd slice", o.CgroupParent)
+			}
+			// the systemd cgroup driver expects slice:prefix:name
+			cgroupsPath = o.CgroupParent + ":containerd:" + id
+		} else {
+			cgroupsPath = path.Join(o.CgroupParent, id)
+		}
+		spec.Linux.CgroupsPath = &cgroupsPath
+	}
+	if o.PidsLimit != 0 {
+		if spec.Linux.Resources == nil {
+			spec.Linux.Resources = &specs.LinuxResources{}
+		}
+		spec.Linux.Resources.Pids = &specs.LinuxPids{
+			Limit: o.PidsLimit,
+		}
+	}
+	return nil
+}
+
+// applySeccompProfile replaces the seccomp profile of the spec, the default
+// profile being derived from the capabilities of the init process.
+func applySeccompProfile(profile string, spec *specs.Spec) error {
+	seccomp, err := specification.SeccompProfile(profile, spec.Process.Capabilities)
+	if err != nil {
+		return err
+	}
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	spec.Linux.Seccomp = seccomp
+	return nil
+}
+
+// applyUserNamespace runs the container in a user namespace with the
+// mappings. The owners of the files of the rootfs are remapped separately,
+// by remapRootfs, once the container is admitted.
+func applyUserNamespace(u *execution.UserNamespace, spec *specs.Spec) error {
+	if err := u.Validate(); err != nil {
+		return err
+	}
+	u.Apply(spec)
+	return nil
+}
+
+// ownerChange is the owner of a file before it was remapped.
+type ownerChange struct {
+	path     string
+	uid, gid int
+	mode     os.FileMode
+}
+
+// remapRootfs hands the files of the rootfs owned by ids of the container to
+// the ids they map to on the host. Files owned by unmapped ids, such as
+// those remapped for a previous container, are left as they are. The
+// returned function restores the previous owners, for a container failing
+// to be created not to leave the rootfs of its caller remapped; the files
+// remapped before a failure are restored by remapRootfs itself.
+func remapRootfs(u *execution.UserNamespace, rootfs string) (func() error, error) {
+	var changes []ownerChange
+	restore := func() error {
+		var rerr error
+		for i := len(changes) - 1; i >= 0; i-- {
+			c := changes[i]
+			if err := os.Lchown(c.path, c.uid, c.gid); err != nil {
+				if rerr == nil {
+					rerr = errors.Wrapf(err, "failed to restore the owner of %s", c.path)
+				}
+				continue
+			}
+			if c.mode&(os.ModeSetuid|os.ModeSetgid) != 0 && c.mode&os.ModeSymlink == 0 {
+				os.Chmod(c.path, c.mode)
+			}
+		}
+		return rerr
+	}
+	err := filepath.Walk(rootfs, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		st, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			return nil
+		}
+		uid, uok := u.HostUID(st.Uid)
+		gid, gok := u.HostGID(st.Gid)
+		if !uok && !gok {
+			return nil
+		}
+		if !uok {
+			uid = st.Uid
+		}
+		if !gok {
+			gid = st.Gid
+		}
+		if err := os.Lchown(p, int(uid), int(gid)); err != nil {
+			return errors.Wrapf(err, "failed to remap the owner of %s", p)
+		}
+		changes = append(changes, ownerChange{path: p, uid: int(st.Uid), gid: int(st.Gid), mode: info.Mode()})
+		// chown clears the setuid and setgid bits
+		if info.Mode()&(os.ModeSetuid|os.ModeSetgid) != 0 && info.Mode()&os.ModeSymlink == 0 {
+			return os.Chmod(p, info.Mode())
+		}
+		return nil
+	})
+	if err != nil {
+		restore()
+		return nil, err
+	}
+	return restore, nil
+}
+
+// adjustCapabilities returns the capabilities caps adjusted by the options.
+func adjustCapabilities(caps []string, o execution.CapabilityOpts) ([]string, error) {
+	return specification.AdjustCapabilities(caps, specification.SecurityOpts{
+		Privileged: o.Privileged,
+		CapAdd:     o.Add,
+		CapDrop:    o.Drop,
+	})
+}
+
+// initProcess returns the process spec of the container's init process,
+// read from the spec it runs with.
+func initProcess(c *execution.Container) (specs.Process, error) {
+	spec, err := runtimeSpec(c)
+	if err != nil {
+		return specs.Process{}, err
+	}
+	return spec.Process, nil
+}
+
+// runtimeSpec returns the spec the runtime runs the container with.
+func runtimeSpec(c *execution.Container) (*specs.Spec, error) {
+	f, err := os.Open(filepath.Join(runtimeBundle(c), "config.json"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open config.json")
+	}
+	defer f.Close()
+	var spec specs.Spec
+	if err := json.NewDecoder(f).Decode(&spec); err != nil {
+		return nil, errors.Wrap(err, "failed to decode container OCI specs")
+	}
+	return &spec, nil
+}
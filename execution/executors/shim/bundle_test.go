@@ -0,0 +1,118 @@
+package shim
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/docker/containerd/execution"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestApplyCgroupOpts(t *testing.T) {
+	for _, tc := range []struct {
+		opts        execution.CreateOpts
+		cgroupsPath string
+		err         bool
+	}{
+		{
+			opts:        execution.CreateOpts{CgroupParent: "/tenants/a", PidsLimit: 100},
+			cgroupsPath: "/tenants/a/test",
+		},
+		{
+			opts: execution.CreateOpts{
+				CgroupParent:   "tenant-a.slice",
+				RuntimeOptions: execution.RuntimeOptions{SystemdCgroup: true},
+			},
+			cgroupsPath: "tenant-a.slice:containerd:test",
+		},
+		{
+			opts: execution.CreateOpts{
+				CgroupParent:   "/tenants/a",
+				RuntimeOptions: execution.RuntimeOptions{SystemdCgroup: true},
+			},
+			err: true,
+		},
+	} {
+		var spec specs.Spec
+		err := applyCgroupOpts("test", tc.opts, &spec)
+		if tc.err {
+			if err == nil {
+				t.Errorf("expected %+v to fail", tc.opts)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *spec.Linux.CgroupsPath != tc.cgroupsPath {
+			t.Errorf("expected cgroups path %q, got %q", tc.cgroupsPath, *spec.Linux.CgroupsPath)
+		}
+		if tc.opts.PidsLimit != 0 && spec.Linux.Resources.Pids.Limit != tc.opts.PidsLimit {
+			t.Errorf("expected pids limit %d, got %d", tc.opts.PidsLimit, spec.Linux.Resources.Pids.Limit)
+		}
+	}
+}
+
+func TestApplyUserNamespace(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("remapping the owner of files requires root")
+	}
+	bundle, err := ioutil.TempDir("", "shim-bundle-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(bundle)
+	rootfs := filepath.Join(bundle, "rootfs")
+	if err := os.MkdirAll(filepath.Join(rootfs, "home"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(filepath.Join(rootfs, "home"), 1000, 1000); err != nil {
+		t.Fatal(err)
+	}
+
+	u := &execution.UserNamespace{
+		UIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}},
+		GIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}},
+	}
+	spec := specs.Spec{Root: specs.Root{Path: "rootfs"}}
+	if err := applyUserNamespace(u, &spec); err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Linux.UIDMappings) != 1 {
+		t.Errorf("expected the mappings in the spec, got %v", spec.Linux.UIDMappings)
+	}
+	// remapping twice leaves the remapped files as they are
+	var restore func() error
+	for i := 0; i < 2; i++ {
+		r, err := remapRootfs(u, rootfs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			restore = r
+		}
+	}
+	checkOwners(t, map[string]uint32{rootfs: 100000, filepath.Join(rootfs, "home"): 101000})
+
+	// a failed create hands the rootfs back to its previous owners
+	if err := restore(); err != nil {
+		t.Fatal(err)
+	}
+	checkOwners(t, map[string]uint32{rootfs: 0, filepath.Join(rootfs, "home"): 1000})
+}
+
+func checkOwners(t *testing.T, owners map[string]uint32) {
+	for path, id := range owners {
+		fi, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		st := fi.Sys().(*syscall.Stat_t)
+		if st.Uid != id || st.Gid != id {
+			t.Errorf("expected %s to be owned by %d, got %d:%d", path, id, st.Uid, st.Gid)
+		}
+	}
+}
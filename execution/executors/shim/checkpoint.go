@@ -0,0 +1,102 @@
+package shim
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// checkpointConfigFilename holds the options of a checkpoint, read by the
+// shim to restore the container with the same options.
+const checkpointConfigFilename = "config.json"
+
+// checkpointConfig mirrors the checkpoint read by the shim.
+type checkpointConfig struct {
+	Created     time.Time `json:"created"`
+	Name        string    `json:"name"`
+	TCP         bool      `json:"tcp"`
+	UnixSockets bool      `json:"unixSockets"`
+	Shell       bool      `json:"shell"`
+	Exit        bool      `json:"exit"`
+	EmptyNS     []string  `json:"emptyNS,omitempty"`
+}
+
+// Checkpoint dumps the container with criu into the path of the options,
+// along with the options the shim restores it with.
+func (s *ShimRuntime) Checkpoint(ctx context.Context, c *execution.Container, o execution.CheckpointOpts) error {
+	log.G(s.ctx).WithFields(logrus.Fields{"container": c.ID(), "options": o}).Debug("Checkpoint()")
+
+	s.mutex.RLock()
+	criu := s.options[c.ID()].CriuPath
+	s.mutex.RUnlock()
+	if criu == "" && s.features.Criu == "" {
+		return errors.Wrap(execution.ErrFeatureUnavailable, "criu is not installed")
+	}
+	if err := os.MkdirAll(o.Path, 0700); err != nil {
+		return err
+	}
+	args := append(s.containerRuntimeArgs(c), "checkpoint",
+		"--image-path", o.Path,
+		"--work-path", filepath.Join(o.Path, "criu.work", "checkpoint-"+time.Now().Format(time.RFC3339)),
+	)
+	switch {
+	case o.PreDump:
+		args = append(args, "--pre-dump")
+	case !o.Exit:
+		args = append(args, "--leave-running")
+	}
+	if o.ParentPath != "" {
+		args = append(args, "--parent-path", o.ParentPath)
+	}
+	if o.TCPEstablished {
+		args = append(args, "--tcp-established")
+	}
+	if o.UnixSockets {
+		args = append(args, "--ext-unix-sk")
+	}
+	if o.Shell {
+		args = append(args, "--shell-job")
+	}
+	for _, ns := range o.EmptyNS {
+		args = append(args, "--empty-ns", ns)
+	}
+	cmd := exec.CommandContext(ctx, s.runtime, append(args, c.ID())...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "'%s checkpoint' failed with output: %v", s.runtime, string(out))
+	}
+	data, err := json.Marshal(checkpointConfig{
+		Created:     time.Now(),
+		Name:        c.ID(),
+		TCP:         o.TCPEstablished,
+		UnixSockets: o.UnixSockets,
+		Shell:       o.Shell,
+		Exit:        o.Exit,
+		EmptyNS:     o.EmptyNS,
+	})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(o.Path, checkpointConfigFilename), data, 0600)
+}
+
+// checkCheckpoint fails when path does not hold a checkpoint written by
+// Checkpoint, rather than leaving the shim to fail once started.
+func checkCheckpoint(path string) error {
+	if _, err := os.Stat(filepath.Join(path, checkpointConfigFilename)); err != nil {
+		if os.IsNotExist(err) {
+			return errors.Errorf("%s is not a checkpoint", path)
+		}
+		return err
+	}
+	return nil
+}
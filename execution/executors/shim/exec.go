@@ -0,0 +1,143 @@
+package shim
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/log"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// execSpares is the number of state directories of processes kept
+	// ready for the execs of each container.
+	execSpares = 4
+
+	execSparesDirName = "exec-spares"
+)
+
+// execScaffold is shared by the execs of a container, for those started
+// often, such as health checks, not to decode its spec and create the fifos
+// of their shim each time. It is set up on the first exec of the container.
+type execScaffold struct {
+	dir string
+
+	once sync.Once
+	// init is the process of the spec of the container, whose capabilities
+	// and privileges the execs inherit by default.
+	init specs.Process
+	err  error
+
+	mu sync.Mutex
+	// spares are the state directories with the fifos of the shim created,
+	// moved into place by the execs.
+	spares  []string
+	next    int
+	filling bool
+}
+
+// setup decodes the spec of c and starts to create the spares, once.
+func (e *execScaffold) setup(c *execution.Container) (specs.Process, error) {
+	e.once.Do(func() {
+		if e.init, e.err = initProcess(c); e.err != nil {
+			return
+		}
+		// the spares of a previous daemon are dropped, they are created
+		// again
+		if e.err = os.RemoveAll(e.dir); e.err != nil {
+			return
+		}
+		if e.err = os.Mkdir(e.dir, 0700); e.err != nil {
+			e.err = errors.Wrap(e.err, "could not create exec spares dir")
+			return
+		}
+		e.mu.Lock()
+		e.filling = true
+		e.mu.Unlock()
+		go e.fill()
+	})
+	return e.init, e.err
+}
+
+// take moves a spare state directory to dir, returning false when none is
+// left or dir exists.
+func (e *execScaffold) take(dir string) bool {
+	if _, err := os.Lstat(dir); err == nil {
+		return false
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.spares) == 0 {
+		return false
+	}
+	spare := e.spares[len(e.spares)-1]
+	e.spares = e.spares[:len(e.spares)-1]
+	if !e.filling {
+		e.filling = true
+		go e.fill()
+	}
+	// the rename fails when dir was created in the meantime, the state
+	// directories are never empty
+	if err := os.Rename(spare, dir); err != nil {
+		os.RemoveAll(spare)
+		return false
+	}
+	return true
+}
+
+// fill creates spare state directories until there are execSpares of them.
+func (e *execScaffold) fill() {
+	for {
+		e.mu.Lock()
+		if len(e.spares) >= execSpares {
+			e.filling = false
+			e.mu.Unlock()
+			return
+		}
+		spare := filepath.Join(e.dir, strconv.Itoa(e.next))
+		e.next++
+		e.mu.Unlock()
+
+		if err := newSpare(spare); err != nil {
+			// the container was deleted, or the execs create their
+			// state directories themselves until the next take
+			log.L.WithError(err).WithField("path", spare).Debug("failed to create exec spare")
+			os.RemoveAll(spare)
+			e.mu.Lock()
+			e.filling = false
+			e.mu.Unlock()
+			return
+		}
+		e.mu.Lock()
+		e.spares = append(e.spares, spare)
+		e.mu.Unlock()
+	}
+}
+
+func newSpare(dir string) error {
+	if err := os.Mkdir(dir, 0700); err != nil {
+		return err
+	}
+	return makeControlPipes(dir)
+}
+
+// execScaffold returns the scaffold of the execs of c, set up on its first
+// exec.
+func (s *ShimRuntime) execScaffold(c *execution.Container) (*execScaffold, specs.Process, error) {
+	s.mutex.Lock()
+	e, ok := s.execs[c.ID()]
+	if !ok {
+		e = &execScaffold{dir: filepath.Join(string(c.StateDir()), execSparesDirName)}
+		// the scaffold of a deleted container is not kept
+		if _, ok := s.containers[c.ID()]; ok {
+			s.execs[c.ID()] = e
+		}
+	}
+	s.mutex.Unlock()
+	init, err := e.setup(c)
+	return e, init, err
+}
@@ -0,0 +1,78 @@
+package shim
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/docker/containerd/execution"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestExecScaffold(t *testing.T) {
+	root, err := ioutil.TempDir("", "shim-exec-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	bundle := filepath.Join(root, "bundle")
+	if err := os.Mkdir(bundle, 0700); err != nil {
+		t.Fatal(err)
+	}
+	spec := specs.Spec{Process: specs.Process{NoNewPrivileges: true}}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(bundle, "config.json"), data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := execution.NewContainer(root, "test", bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &ShimRuntime{
+		containers: map[string]*execution.Container{c.ID(): c},
+		execs:      make(map[string]*execScaffold),
+	}
+
+	e, init, err := s.execScaffold(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !init.NoNewPrivileges {
+		t.Fatal("expected the execs to inherit the init process of the spec")
+	}
+	if cached, _, _ := s.execScaffold(c); cached != e {
+		t.Fatal("expected the scaffold to be shared by the execs of the container")
+	}
+	for i := 0; ; i++ {
+		e.mu.Lock()
+		filled := !e.filling
+		e.mu.Unlock()
+		if filled {
+			break
+		}
+		if i == 1000 {
+			t.Fatal("expected the spares to be created")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	dir := c.StateDir().ProcessDir("exec")
+	if !e.take(dir) {
+		t.Fatal("expected a spare to be taken")
+	}
+	exitPipe, controlPipe, err := openControlPipes(dir)
+	if err != nil {
+		t.Fatalf("expected the fifos of the shim in the spare: %v", err)
+	}
+	exitPipe.Close()
+	controlPipe.Close()
+	if e.take(dir) {
+		t.Fatal("expected an existing state directory not to be replaced")
+	}
+}
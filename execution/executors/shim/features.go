@@ -0,0 +1,78 @@
+package shim
+
+import (
+	"bufio"
+	"os/exec"
+	"strings"
+
+	"github.com/docker/containerd/cgroups"
+	"github.com/docker/containerd/execution"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const seccompModeFilter = 2
+
+// probeFeatures detects the features of the runtime binary, failing when it
+// cannot be executed.
+func probeFeatures(runtime string, runtimeArgs []string) (execution.Features, error) {
+	out, err := exec.Command(runtime, append(runtimeArgs, "--version")...).Output()
+	if err != nil {
+		if exErr, ok := err.(*exec.Error); ok && exErr.Err == exec.ErrNotFound {
+			return execution.Features{}, errors.Errorf("runtime %s is not installed", runtime)
+		}
+		return execution.Features{}, errors.Wrapf(err, "failed to probe runtime %s", runtime)
+	}
+	features := parseVersion(string(out))
+	features.Seccomp = seccompSupported()
+
+	features.CgroupV2 = cgroups.DetectMode() == cgroups.Unified
+	if path, err := exec.LookPath("criu"); err == nil {
+		features.Criu = path
+	}
+	return features, nil
+}
+
+// parseVersion parses the output of runc --version:
+//
+//	runc version 1.0.0-rc2
+//	commit: c91b5bea4830a57eac7882d7455d59518cdf70ec
+//	spec: 1.0.0-rc2-dev
+//	libseccomp: 2.3.1
+//
+// Only recent versions of runc report the libseccomp version.
+func parseVersion(out string) execution.Features {
+	var features execution.Features
+	s := bufio.NewScanner(strings.NewReader(out))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if i := strings.Index(line, " version "); i >= 0 && features.Version == "" {
+			features.Version = strings.TrimSpace(line[i+len(" version "):])
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(parts[1])
+		switch parts[0] {
+		case "commit":
+			features.Commit = value
+		case "spec":
+			features.Spec = value
+		case "libseccomp":
+			features.Libseccomp = value
+		}
+	}
+	return features
+}
+
+// seccompSupported returns true when the kernel was built with seccomp
+// filter support.
+func seccompSupported() bool {
+	if err := unix.Prctl(unix.PR_GET_SECCOMP, 0, 0, 0, 0); err == unix.EINVAL {
+		return false
+	}
+	// with filter support the kernel rejects the nil filter with EFAULT
+	return unix.Prctl(unix.PR_SET_SECCOMP, seccompModeFilter, 0, 0, 0) != unix.EINVAL
+}
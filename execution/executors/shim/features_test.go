@@ -0,0 +1,28 @@
+package shim
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	features := parseVersion(`runc version 1.0.0-rc2
+commit: c91b5bea4830a57eac7882d7455d59518cdf70ec
+spec: 1.0.0-rc2-dev
+libseccomp: 2.3.1
+`)
+	if features.Version != "1.0.0-rc2" {
+		t.Fatalf("unexpected version %q", features.Version)
+	}
+	if features.Commit != "c91b5bea4830a57eac7882d7455d59518cdf70ec" {
+		t.Fatalf("unexpected commit %q", features.Commit)
+	}
+	if features.Spec != "1.0.0-rc2-dev" {
+		t.Fatalf("unexpected spec %q", features.Spec)
+	}
+	if features.Libseccomp != "2.3.1" {
+		t.Fatalf("unexpected libseccomp %q", features.Libseccomp)
+	}
+
+	features = parseVersion("runc version 0.1.1\nspec: 0.6.0\n")
+	if features.Version != "0.1.1" || features.Spec != "0.6.0" || features.Libseccomp != "" {
+		t.Fatalf("unexpected features %+v", features)
+	}
+}
@@ -0,0 +1,142 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+	"time"
+
+	shimapi "github.com/docker/containerd/api/shim"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// FailurePolicy selects what happens to the processes of a failed shim.
+type FailurePolicy string
+
+const (
+	// FailurePolicyFence kills all the processes of the container.
+	FailurePolicyFence FailurePolicy = "fence"
+	// FailurePolicyKeep leaves the processes running. Their exit is noticed
+	// by the health check, without an exit status.
+	FailurePolicyKeep FailurePolicy = "keep"
+)
+
+// HealthCheck configures the health checking of the shims.
+type HealthCheck struct {
+	// Interval between the checks of each shim, checks are disabled when
+	// zero. Shims exiting are noticed regardless.
+	Interval time.Duration
+	// Timeout of a single check.
+	Timeout time.Duration
+	Policy  FailurePolicy
+}
+
+// DefaultHealthCheck checks the shims every 10 seconds and fences the
+// containers of failed shims.
+var DefaultHealthCheck = HealthCheck{
+	Interval: 10 * time.Second,
+	Timeout:  5 * time.Second,
+	Policy:   FailurePolicyFence,
+}
+
+func (h HealthCheck) validate() error {
+	switch h.Policy {
+	case FailurePolicyFence:
+	case FailurePolicyKeep:
+		if h.Interval == 0 {
+			return errors.New("the keep failure policy requires a health check interval")
+		}
+	default:
+		return errors.Errorf("unknown shim failure policy %q", h.Policy)
+	}
+	return nil
+}
+
+// RuntimeFailures returns the channel shim failures are reported on.
+func (s *ShimRuntime) RuntimeFailures() <-chan execution.RuntimeFailure {
+	return s.failures
+}
+
+// checkHealth periodically checks that the shim of each running process
+// responds, and notices the exit of processes kept running after their shim
+// failed.
+func (s *ShimRuntime) checkHealth() {
+	ticker := time.NewTicker(s.health.Interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		containers, _ := s.List(s.ctx)
+		for _, c := range containers {
+			for _, proc := range c.Processes() {
+				s.checkProcess(proc.(*process))
+			}
+		}
+	}
+}
+
+func (s *ShimRuntime) checkProcess(p *process) {
+	if p.isOrphaned() {
+		if !p.isAlive() {
+			p.setOrphaned(false)
+			p.exit()
+		}
+		return
+	}
+	if p.shim == nil || p.hasFailed() || p.Status() == execution.Stopped {
+		return
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, s.health.Timeout)
+	_, err := p.shim.State(ctx, &shimapi.StateRequest{})
+	cancel()
+	// a shim exiting along with its process is not a failure
+	if err != nil && p.isAlive() {
+		s.shimFailed(p, fmt.Sprintf("shim health check failed: %v", err))
+	}
+}
+
+// shimFailed handles the failure of the shim of p according to the policy,
+// and reports it. It returns whether the process was left running.
+func (s *ShimRuntime) shimFailed(p *process, reason string) (kept bool) {
+	if !p.setFailed() {
+		return p.isOrphaned()
+	}
+	f := execution.RuntimeFailure{
+		ContainerID: p.containerID,
+		ProcessID:   p.id,
+		Reason:      reason,
+	}
+	entry := log.G(p.ctx).WithFields(logrus.Fields{"process-id": p.id, "reason": reason})
+	switch s.health.Policy {
+	case FailurePolicyFence:
+		if err := s.fence(p); err != nil {
+			entry.WithError(err).Error("failed to fence process of failed shim")
+		} else {
+			f.Fenced = true
+		}
+	case FailurePolicyKeep:
+		kept = true
+	}
+	entry.WithField("fenced", f.Fenced).Warn("shim failed")
+	select {
+	case s.failures <- f:
+	default:
+		entry.Warn("runtime failure dropped, no one is receiving them")
+	}
+	return kept
+}
+
+// fence kills all the processes of the container of an init process, and
+// the process alone otherwise.
+func (s *ShimRuntime) fence(p *process) error {
+	if c := s.getContainer(p.containerID); c != nil && p.id == initProcessID {
+		if err := s.runtimeKillAll(s.ctx, c, syscall.SIGKILL); err == nil {
+			return nil
+		}
+	}
+	if err := syscall.Kill(int(p.pid), syscall.SIGKILL); err != nil && err != syscall.ESRCH {
+		return errors.Wrap(err, "failed to kill process")
+	}
+	return nil
+}
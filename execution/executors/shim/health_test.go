@@ -0,0 +1,52 @@
+package shim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/containerd/execution"
+)
+
+func TestHealthCheckValidate(t *testing.T) {
+	if err := DefaultHealthCheck.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := (HealthCheck{Policy: FailurePolicyKeep}).validate(); err == nil {
+		t.Fatal("expected the keep policy without an interval to be rejected")
+	}
+	if err := (HealthCheck{Policy: "respawn"}).validate(); err == nil {
+		t.Fatal("expected an unknown policy to be rejected")
+	}
+}
+
+func TestShimFailedKeep(t *testing.T) {
+	s := &ShimRuntime{
+		ctx:      context.Background(),
+		health:   HealthCheck{Interval: DefaultHealthCheck.Interval, Policy: FailurePolicyKeep},
+		failures: make(chan execution.RuntimeFailure, 1),
+	}
+	p := &process{
+		id:          initProcessID,
+		containerID: "test",
+		ctx:         context.Background(),
+	}
+	if !s.shimFailed(p, "shim exited") {
+		t.Fatal("expected the process to be kept running")
+	}
+	p.setOrphaned(true)
+	// a failure is only handled and reported once
+	if !s.shimFailed(p, "shim health check failed") {
+		t.Fatal("expected the process to be kept running")
+	}
+	close(s.failures)
+	var failures []execution.RuntimeFailure
+	for f := range s.failures {
+		failures = append(failures, f)
+	}
+	if len(failures) != 1 {
+		t.Fatalf("expected a single failure, got %v", failures)
+	}
+	if f := failures[0]; f.ContainerID != "test" || f.ProcessID != initProcessID || f.Fenced {
+		t.Fatalf("unexpected failure %+v", f)
+	}
+}
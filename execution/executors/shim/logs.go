@@ -0,0 +1,114 @@
+package shim
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/docker/containerd/execution"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	shimLogFilename    = "shim-log.json"
+	runtimeLogFilename = "log.json"
+)
+
+// logEntry is an entry of the json logs written by the shim and the runtime
+// in the process state directory.
+type logEntry struct {
+	Level   string    `json:"level"`
+	Message string    `json:"msg"`
+	Time    time.Time `json:"time"`
+}
+
+// readRuntimeLogs returns the shim and runtime logs of the process with the
+// state directory root, ordered by time.
+func readRuntimeLogs(root string) ([]execution.RuntimeLog, error) {
+	shimLogs, err := readLogFile(filepath.Join(root, shimLogFilename), execution.RuntimeLogSourceShim)
+	if err != nil {
+		return nil, err
+	}
+	runtimeLogs, err := readLogFile(filepath.Join(root, runtimeLogFilename), execution.RuntimeLogSourceRuntime)
+	if err != nil {
+		return nil, err
+	}
+	logs := append(shimLogs, runtimeLogs...)
+	sort.Stable(byTimestamp(logs))
+	return logs, nil
+}
+
+// readLogFile reads the entries of a json log. A missing log has no
+// entries, and a partially written entry at the end of the log is ignored.
+func readLogFile(path, source string) ([]execution.RuntimeLog, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to open %s log", source)
+	}
+	defer f.Close()
+
+	var logs []execution.RuntimeLog
+	dec := json.NewDecoder(f)
+	for {
+		var e logEntry
+		if err := dec.Decode(&e); err != nil {
+			break
+		}
+		logs = append(logs, execution.RuntimeLog{
+			Source:    source,
+			Level:     e.Level,
+			Message:   e.Message,
+			Timestamp: e.Time,
+		})
+	}
+	return logs, nil
+}
+
+// runtimeError annotates err with the last error logged for the process
+// with the state directory root. The runtime reports why it failed only
+// through its log, so the error is otherwise lost.
+func runtimeError(root string, err error) error {
+	logs, lerr := readRuntimeLogs(root)
+	if lerr != nil {
+		return err
+	}
+	for i := len(logs) - 1; i >= 0; i-- {
+		switch logs[i].Level {
+		case "error", "fatal", "panic":
+			return errors.Wrapf(err, "%s: %s", logs[i].Source, logs[i].Message)
+		}
+	}
+	return err
+}
+
+// logRuntimeLogs forwards the shim and runtime logs of the process with the
+// state directory root to the daemon log at debug level.
+func logRuntimeLogs(entry *logrus.Entry, root string) {
+	if entry.Logger.Level < logrus.DebugLevel {
+		return
+	}
+	logs, err := readRuntimeLogs(root)
+	if err != nil {
+		entry.WithError(err).Warn("failed to read runtime logs")
+		return
+	}
+	for _, l := range logs {
+		entry.WithFields(logrus.Fields{
+			"source":        l.Source,
+			"runtime-level": l.Level,
+			"runtime-time":  l.Timestamp,
+		}).Debug(l.Message)
+	}
+}
+
+type byTimestamp []execution.RuntimeLog
+
+func (b byTimestamp) Len() int           { return len(b) }
+func (b byTimestamp) Less(i, j int) bool { return b[i].Timestamp.Before(b[j].Timestamp) }
+func (b byTimestamp) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
@@ -0,0 +1,69 @@
+package shim
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/containerd/execution"
+	"github.com/pkg/errors"
+)
+
+func TestRuntimeLogs(t *testing.T) {
+	root, err := ioutil.TempDir("", "shim-logs-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	shimLog := `{"level":"debug","msg":"runc create","time":"2017-02-01T10:00:00Z"}
+{"level":"error","msg":"shim failure","time":"2017-02-01T10:00:03Z"}
+{"level":"error","msg":"trunc`
+	runtimeLog := `{"level":"warning","msg":"no cgroup","time":"2017-02-01T10:00:01Z"}
+{"level":"error","msg":"container_linux.go: exec: not found","time":"2017-02-01T10:00:02Z"}
+`
+	if err := ioutil.WriteFile(filepath.Join(root, shimLogFilename), []byte(shimLog), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, runtimeLogFilename), []byte(runtimeLog), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	logs, err := readRuntimeLogs(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct{ source, message string }{
+		{execution.RuntimeLogSourceShim, "runc create"},
+		{execution.RuntimeLogSourceRuntime, "no cgroup"},
+		{execution.RuntimeLogSourceRuntime, "container_linux.go: exec: not found"},
+		{execution.RuntimeLogSourceShim, "shim failure"},
+	}
+	if len(logs) != len(expected) {
+		t.Fatalf("expected %d entries, got %d: %v", len(expected), len(logs), logs)
+	}
+	for i, e := range expected {
+		if logs[i].Source != e.source || logs[i].Message != e.message {
+			t.Fatalf("unexpected entry %d: %v", i, logs[i])
+		}
+	}
+
+	cause := errors.New("shim exited")
+	err = runtimeError(root, cause)
+	if errors.Cause(err) != cause {
+		t.Fatalf("expected cause to be preserved, got %v", err)
+	}
+	if err.Error() != "shim: shim failure: shim exited" {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	empty, err := ioutil.TempDir("", "shim-logs-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(empty)
+	if err := runtimeError(empty, cause); err != cause {
+		t.Fatalf("expected error to be unchanged without logs, got %v", err)
+	}
+}
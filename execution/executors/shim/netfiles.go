@@ -0,0 +1,46 @@
+package shim
+
+import (
+	"sort"
+
+	"github.com/docker/containerd/execution"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// networkFileMounts bind mounts the hostname, hosts and resolv.conf of the
+// container generated into its state directory by execution.WriteNetworkFiles
+// over those of the rootfs. They are updated in place as the daemon learns
+// the address of the container and as the resolv.conf of the host changes.
+func networkFileMounts(c *execution.Container, n execution.NetworkFiles, spec *specs.Spec) error {
+	if n.Hostname != "" {
+		if !execution.HasNamespace(spec, specs.UTSNamespace) {
+			return errors.New("setting the hostname requires a uts namespace")
+		}
+		spec.Hostname = n.Hostname
+	}
+	paths := execution.NetworkFilePaths(c)
+	var targets []string
+	for target := range paths {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+	for _, target := range targets {
+		if target == "/etc/hostname" && n.Hostname == "" {
+			continue
+		}
+		mounts := spec.Mounts[:0]
+		for _, m := range spec.Mounts {
+			if m.Destination != target {
+				mounts = append(mounts, m)
+			}
+		}
+		spec.Mounts = append(mounts, specs.Mount{
+			Destination: target,
+			Type:        "bind",
+			Source:      paths[target],
+			Options:     []string{"rbind", "rprivate", "nosuid", "nodev", "noexec"},
+		})
+	}
+	return nil
+}
@@ -2,17 +2,23 @@ package shim
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
 	"sync"
 	"syscall"
+	"time"
 
+	shimapi "github.com/docker/containerd/api/shim"
 	"github.com/docker/containerd/execution"
 	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/ttrpc"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/unix"
@@ -21,22 +27,44 @@ import (
 	starttime "github.com/opencontainers/runc/libcontainer/system"
 )
 
+// pidPollInterval is the interval the pid file of a process started by its
+// shim is polled on.
+const pidPollInterval = time.Millisecond
+
 type newProcessOpts struct {
 	shimBinary  string
 	runtime     string
 	runtimeArgs []string
 	container   *execution.Container
+	bundle      string
 	exec        bool
+	// checkpointPath is the checkpoint the init process is restored from,
+	// if any.
+	checkpointPath string
+	// scaffold provides the state directories of execs, it is nil for the
+	// init process.
+	scaffold *execScaffold
+	// sandbox is the shim shared by the sandbox of the container the process
+	// is created by, it is nil when the process has a shim of its own.
+	sandbox *sandboxShim
 	execution.StartProcessOpts
 }
 
 func newProcess(ctx context.Context, o newProcessOpts) (*process, error) {
-	procStateDir, err := o.container.StateDir().NewProcess(o.ID)
-	if err != nil {
-		return nil, err
+	var (
+		procStateDir = o.container.StateDir().ProcessDir(o.ID)
+		exitPipe     *os.File
+		controlPipe  *os.File
+		err          error
+	)
+	if o.scaffold != nil && o.scaffold.take(procStateDir) {
+		exitPipe, controlPipe, err = openControlPipes(procStateDir)
+	} else {
+		if procStateDir, err = o.container.StateDir().NewProcess(o.ID); err != nil {
+			return nil, err
+		}
+		exitPipe, controlPipe, err = getControlPipes(procStateDir)
 	}
-
-	exitPipe, controlPipe, err := getControlPipes(procStateDir)
 	if err != nil {
 		return nil, err
 	}
@@ -47,32 +75,42 @@ func newProcess(ctx context.Context, o newProcessOpts) (*process, error) {
 		}
 	}()
 
-	cmdc err := newShim(o, procStateDir)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if err != nil {
-			cmd.Process.Kill()
-			cmd.Wait()
+	// the shim of a sandbox creates the process before answering, its
+	// failure failing the request rather than aborting the wait for the pid
+	var abortCh chan syscall.WaitStatus
+	if o.sandbox != nil {
+		if err = o.sandbox.create(ctx, o, procStateDir); err != nil {
+			logRuntimeLogs(log.G(ctx).WithField("process-id", o.ID), procStateDir)
+			return nil, runtimeError(procStateDir, err)
 		}
-	}()
-
-	abortCh := make(chan syscall.WaitStatus, 1)
-	go func() {
-		var shimStatus syscall.WaitStatus
-		if err := cmd.Wait(); err != nil {
-			shimStatus = execution.UnknownStatusCode
-		} else {
-			shimStatus = cmd.ProcessState.Sys().(syscall.WaitStatus)
+	} else {
+		var cmd *exec.Cmd
+		if cmd, err = newShim(o, procStateDir); err != nil {
+			return nil, err
 		}
-		abortCh <- shimStatus
-		close(abortCh)
-	}()
+		defer func() {
+			if err != nil {
+				cmd.Process.Kill()
+				cmd.Wait()
+			}
+		}()
+		abortCh = make(chan syscall.WaitStatus, 1)
+		go func() {
+			var shimStatus syscall.WaitStatus
+			if err := cmd.Wait(); err != nil {
+				shimStatus = execution.UnknownStatusCode
+			} else {
+				shimStatus = cmd.ProcessState.Sys().(syscall.WaitStatus)
+			}
+			abortCh <- shimStatus
+			close(abortCh)
+		}()
+	}
 
 	process := &process{
 		root:        procStateDir,
 		id:          o.ID,
+		containerID: o.container.ID(),
 		exitChan:    make(chan struct{}),
 		exitPipe:    exitPipe,
 		controlPipe: controlPipe,
@@ -80,16 +118,24 @@ func newProcess(ctx context.Context, o newProcessOpts) (*process, error) {
 
 	pid, stime, status, err := waitForPid(ctx, abortCh, procStateDir)
 	if err != nil {
-		return nil, err
+		logRuntimeLogs(log.G(ctx).WithField("process-id", o.ID), procStateDir)
+		return nil, runtimeError(procStateDir, err)
 	}
 	process.pid = int64(pid)
 	process.status = status
 	process.startTime = stime
 
+	// the shim listens before creating the process, so a failure here
+	// means the shim is already gone and the exit pipe will report it.
+	if process.shim, err = connectShim(procStateDir); err != nil {
+		log.G(ctx).WithError(err).WithField("process-id", o.ID).Warn("failed to connect to shim")
+		err = nil
+	}
+
 	return process, nil
 }
 
-func loadProcess(root, id string) (*process, error) {
+func loadProcess(root, containerID, id string) (*process, error) {
 	pid, err := runc.ReadPidFile(filepath.Join(root, pidFilename))
 	if err != nil {
 		return nil, err
@@ -125,6 +171,7 @@ func loadProcess(root, id string) (*process, error) {
 	p := &process{
 		root:        root,
 		id:          id,
+		containerID: containerID,
 		pid:         int64(pid),
 		exitChan:    make(chan struct{}),
 		exitPipe:    exitPipe,
@@ -137,6 +184,11 @@ func loadProcess(root, id string) (*process, error) {
 	}
 
 	markAsStopped := func(p *process) (*process, error) {
+		// a shim still running has yet to record the exit status, the
+		// process is stopped once the shim exits and closes the exit pipe.
+		if p.shim, _ = connectShim(root); p.shim != nil {
+			return p, nil
+		}
 		p.setStatus(execution.Stopped)
 		return p, nil
 	}
@@ -160,9 +212,41 @@ func loadProcess(root, id string) (*process, error) {
 		return markAsStopped(p)
 	}
 
+	// processes started by an older shim have no socket to reattach to and
+	// are managed through their pid alone.
+	p.shim, _ = connectShim(root)
+
 	return p, nil
 }
 
+// shimAddress returns the abstract socket address of the shim managing the
+// process with the state directory root. The address is derived from the
+// directory as the full path may not fit in a socket address.
+func shimAddress(root string) string {
+	return fmt.Sprintf("@containerd-shim/%x", sha256.Sum256([]byte(root)))
+}
+
+func connectShim(root string) (*shimClient, error) {
+	conn, err := net.Dial("unix", shimAddress(root))
+	if err != nil {
+		return nil, err
+	}
+	client := ttrpc.NewClient(conn)
+	return &shimClient{
+		ShimServer: shimapi.NewShimTTRPCClient(client),
+		client:     client,
+	}, nil
+}
+
+type shimClient struct {
+	shimapi.ShimServer
+	client *ttrpc.Client
+}
+
+func (c *shimClient) Close() error {
+	return c.client.Close()
+}
+
 type process struct {
 	root        string
 	id          string
@@ -173,7 +257,18 @@ type process struct {
 	startTime   string
 	status      execution.Status
 	ctx         context.Context
+	containerID string
 	mu          sync.Mutex
+	// failed is set once the failure of the shim was handled.
+	failed bool
+	// orphaned is set while the process runs on after its shim failed.
+	orphaned bool
+	// exited is set once exitChan is closed, the funcs of exitFns being
+	// called then.
+	exited  bool
+	exitFns []func()
+	// shim is nil when the shim api is unavailable.
+	shim *shimClient
 }
 
 func (p *process) ID() string {
@@ -193,6 +288,9 @@ func (p *process) Wait() (uint32, error) {
 	// Cleanup those fds
 	p.exitPipe.Close()
 	p.controlPipe.Close()
+	if p.shim != nil {
+		p.shim.Close()
+	}
 
 	// If the container process is still alive, it means the shim crashed
 	// and the child process had updated it PDEATHSIG to something
@@ -206,6 +304,16 @@ func (p *process) Wait() (uint32, error) {
 		return uint32(128 + int(syscall.SIGKILL)), nil
 	}
 
+	status, err := p.ExitStatus()
+	if err != nil {
+		return status, err
+	}
+	p.setStatus(execution.Stopped)
+	return status, nil
+}
+
+// ExitStatus returns the exit status of the process recorded by its shim.
+func (p *process) ExitStatus() (uint32, error) {
 	data, err := ioutil.ReadFile(filepath.Join(p.root, exitStatusFilename))
 	if err != nil {
 		return execution.UnknownStatusCode, errors.Wrap(err, "failed to read process exit status")
@@ -219,12 +327,19 @@ func (p *process) Wait() (uint32, error) {
 	if err != nil {
 		return execution.UnknownStatusCode, errors.Wrapf(err, "failed to parse exit status")
 	}
-
-	p.setStatus(execution.Stopped)
 	return uint32(status), nil
 }
 
 func (p *process) Signal(sig os.Signal) error {
+	if p.shim != nil {
+		_, err := p.shim.Signal(context.Background(), &shimapi.SignalRequest{
+			Signal: uint32(sig.(syscall.Signal)),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to signal process")
+		}
+		return nil
+	}
 	err := syscall.Kill(int(p.pid), sig.(syscall.Signal))
 	if err != nil {
 		return errors.Wrap(err, "failed to signal process")
@@ -232,6 +347,34 @@ func (p *process) Signal(sig os.Signal) error {
 	return nil
 }
 
+// Resize resizes the console of the process, through the control pipe of
+// shims without an api.
+func (p *process) Resize(width, height uint32) error {
+	if p.shim != nil {
+		if _, err := p.shim.Pty(context.Background(), &shimapi.PtyRequest{
+			Width:  width,
+			Height: height,
+		}); err != nil {
+			return errors.Wrap(err, "failed to resize the console of the process")
+		}
+		return nil
+	}
+	if _, err := fmt.Fprintf(p.controlPipe, "%d %d %d\n", 1, width, height); err != nil {
+		return errors.Wrap(err, "failed to resize the console of the process")
+	}
+	return nil
+}
+
+// ReopenStdio has the shim reopen the stdout and stderr fifos of the process
+// created again after they were removed, through its control pipe. The shim
+// reopens them asynchronously.
+func (p *process) ReopenStdio() error {
+	if _, err := fmt.Fprintf(p.controlPipe, "%d %d %d\n", 2, 0, 0); err != nil {
+		return errors.Wrap(err, "failed to reopen the stdio of the process")
+	}
+	return nil
+}
+
 func (p *process) Status() execution.Status {
 	p.mu.Lock()
 	s := p.status
@@ -239,12 +382,70 @@ func (p *process) Status() execution.Status {
 	return s
 }
 
+// NotifyExit calls fn once the exit of the process is detected by the loop
+// of the runtime.
+func (p *process) NotifyExit(fn func()) error {
+	p.mu.Lock()
+	if p.exited {
+		p.mu.Unlock()
+		fn()
+		return nil
+	}
+	p.exitFns = append(p.exitFns, fn)
+	p.mu.Unlock()
+	return nil
+}
+
+// exit closes exitChan, releasing Wait, and calls the funcs notified of the
+// exit.
+func (p *process) exit() {
+	p.mu.Lock()
+	p.exited = true
+	fns := p.exitFns
+	p.exitFns = nil
+	close(p.exitChan)
+	p.mu.Unlock()
+	for _, fn := range fns {
+		fn()
+	}
+}
+
 func (p *process) setStatus(s execution.Status) {
 	p.mu.Lock()
 	p.status = s
 	p.mu.Unlock()
 }
 
+// setFailed marks the shim of the process as failed, returning false when it
+// already was.
+func (p *process) setFailed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failed {
+		return false
+	}
+	p.failed = true
+	return true
+}
+
+func (p *process) hasFailed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.failed
+}
+
+func (p *process) setOrphaned(orphaned bool) {
+	p.mu.Lock()
+	p.orphaned = orphaned
+	p.mu.Unlock()
+}
+
+func (p *process) isOrphaned() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.orphaned
+}
+
 func (p *process) isAlive() bool {
 	if err := syscall.Kill(int(p.pid), 0); err != nil {
 		if err == syscall.ESRCH {
@@ -275,9 +476,20 @@ func (p *process) isAlive() bool {
 
 func waitForPid(ctx context.Context, abortCh chan syscall.WaitStatus, root string) (pid int, stime string, status execution.Status, err error) {
 	status = execution.Unknown
+	// the pid file is polled rather than spun on, the runtime taking a
+	// while to start the process
+	ticker := time.NewTicker(pidPollInterval)
+	defer ticker.Stop()
 	for {
+		pid, err = runc.ReadPidFile(filepath.Join(root, pidFilename))
+		if err == nil {
+			break
+		} else if !os.IsNotExist(err) {
+			return
+		}
 		select {
 		case <-ctx.Done():
+			err = ctx.Err()
 			return
 		case wait := <-abortCh:
 			if wait.Signaled() {
@@ -286,13 +498,7 @@ func waitForPid(ctx context.Context, abortCh chan syscall.WaitStatus, root strin
 			}
 			err = errors.Errorf("shim exited prematurarily with exit code %v", wait.ExitStatus())
 			return
-		default:
-		}
-		pid, err = runc.ReadPidFile(filepath.Join(root, pidFilename))
-		if err == nil {
-			break
-		} else if !os.IsNotExist(err) {
-			return
+		case <-ticker.C:
 		}
 	}
 	status = execution.Created
@@ -324,12 +530,24 @@ func waitForPid(ctx context.Context, abortCh chan syscall.WaitStatus, root strin
 }
 
 func newShim(o newProcessOpts, workDir string) (*exec.Cmd, error) {
-	cmd := exec.Command(o.shimBinary, o.container.ID(), o.container.Bundle(), o.runtime)
+	cmd := exec.Command(o.shimBinary, "-socket", shimAddress(workDir), o.container.ID(), o.bundle, o.runtime)
 	cmd.Dir = workDir
 	cmd.SysProcAttr = &syscall.SysProcAttr{
 		Setpgid: true,
 	}
+	if err := writeProcessState(o, workDir); err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Wrapf(err, "failed to start shim for container %s", o.container.ID())
+	}
 
+	return cmd, nil
+}
+
+// writeProcessState writes the process.json the shim creates the process
+// from in its state directory.
+func writeProcessState(o newProcessOpts, workDir string) error {
 	state := processState{
 		Process:        o.Spec,
 		Exec:           o.exec,
@@ -338,44 +556,50 @@ func newShim(o newProcessOpts, workDir string) (*exec.Cmd, error) {
 		Stderr:         o.Stderr,
 		RuntimeArgs:    o.runtimeArgs,
 		NoPivotRoot:    false,
-		CheckpointPath: "",
+		CheckpointPath: o.checkpointPath,
 		RootUID:        int(o.Spec.User.UID),
 		RootGID:        int(o.Spec.User.GID),
 	}
 
 	f, err := os.Create(filepath.Join(workDir, "process.json"))
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create shim's process.json for container %s", o.container.ID())
+		return errors.Wrapf(err, "failed to create shim's process.json for container %s", o.container.ID())
 	}
 	defer f.Close()
 
 	if err := json.NewEncoder(f).Encode(state); err != nil {
-		return nil, errors.Wrapf(err, "failed to create shim's processState for container %s", o.container.ID())
+		return errors.Wrapf(err, "failed to create shim's processState for container %s", o.container.ID())
 	}
+	return nil
+}
 
-	if err := cmd.Start(); err != nil {
-		return nil, errors.Wrapf(err, "failed to start shim for container %s", o.container.ID())
+func getControlPipes(root string) (exitPipe *os.File, controlPipe *os.File, err error) {
+	if err := makeControlPipes(root); err != nil {
+		return nil, nil, err
 	}
+	return openControlPipes(root)
+}
 
-	return cmd, nil
+// makeControlPipes creates the exit and control fifos of the shim in root.
+func makeControlPipes(root string) error {
+	if err := unix.Mkfifo(filepath.Join(root, exitPipeFilename), 0700); err != nil {
+		return errors.Wrap(err, "failed to create shim exit fifo")
+	}
+	if err := unix.Mkfifo(filepath.Join(root, controlPipeFilename), 0700); err != nil {
+		return errors.Wrap(err, "failed to create shim control fifo")
+	}
+	return nil
 }
 
-func getControlPipes(root string) (exitPipe *os.File, controlPipe *os.File, err error) {
+func openControlPipes(root string) (exitPipe *os.File, controlPipe *os.File, err error) {
 	path := filepath.Join(root, exitPipeFilename)
-	if err = unix.Mkfifo(path, 0700); err != nil {
-		return exitPipe, controlPipe, errors.Wrap(err, "failed to create shim exit fifo")
-	}
 	if exitPipe, err = os.OpenFile(path, syscall.O_RDONLY|syscall.O_NONBLOCK, 0); err != nil {
-		return exitPipe, controlPipe, errors.Wrap(err, "failed to open shim exit fifo")
+		return nil, nil, errors.Wrap(err, "failed to open shim exit fifo")
 	}
-
 	path = filepath.Join(root, controlPipeFilename)
-	if err = unix.Mkfifo(path, 0700); err != nil {
-		return exitPipe, controlPipe, errors.Wrap(err, "failed to create shim control fifo")
-	}
 	if controlPipe, err = os.OpenFile(path, syscall.O_RDWR|syscall.O_NONBLOCK, 0); err != nil {
-		return exitPipe, controlPipe, errors.Wrap(err, "failed to open shim control fifo")
+		exitPipe.Close()
+		return nil, nil, errors.Wrap(err, "failed to open shim control fifo")
 	}
-
 	return exitPipe, controlPipe, nil
 }
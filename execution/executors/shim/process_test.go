@@ -0,0 +1,60 @@
+package shim
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/docker/containerd/execution"
+)
+
+func TestLoadExitedProcess(t *testing.T) {
+	root, err := ioutil.TempDir("", "shim-process-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	// a process that exited while the daemon was down, its shim is gone
+	// and recorded the exit status.
+	cmd := exec.Command("true")
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+	exitPipe, controlPipe, err := getControlPipes(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exitPipe.Close()
+	controlPipe.Close()
+	for name, data := range map[string]string{
+		pidFilename:        strconv.Itoa(cmd.Process.Pid),
+		startTimeFilename:  "0",
+		exitStatusFilename: "3",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(root, name), []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p, err := loadProcess(root, "test", initProcessID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.ctx = context.Background()
+	if p.Status() != execution.Stopped {
+		t.Fatalf("expected the process to be stopped, got %s", p.Status())
+	}
+	close(p.exitChan)
+	status, err := p.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != 3 {
+		t.Fatalf("expected the recorded exit status 3, got %d", status)
+	}
+}
@@ -0,0 +1,296 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	shimapi "github.com/docker/containerd/api/shim"
+	"github.com/docker/containerd/cgroups"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/ttrpc"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	sandboxFilename = "sandbox"
+	// sandboxCgroupRoot is the cgroup parent of the sandboxes.
+	sandboxCgroupRoot = "/containerd/sandboxes"
+	// sandboxShimsDir holds the state directories of the shims shared by
+	// the sandboxes, in the root of the runtime.
+	sandboxShimsDir = ".sandbox-shims"
+	// sandboxShimTimeout is how long a shim started for a sandbox is waited
+	// for to serve its api.
+	sandboxShimTimeout = 5 * time.Second
+)
+
+func sandboxCgroup(id string) string {
+	return path.Join(sandboxCgroupRoot, id)
+}
+
+// sandboxSpec joins the spec of the container to the network namespace of a
+// running container of the sandbox of the create options and places it
+// under the sandbox's cgroup parent.
+func (s *ShimRuntime) sandboxSpec(id string, o execution.CreateOpts, spec *specs.Spec) error {
+	if o.RuntimeOptions.SystemdCgroup {
+		return errors.Wrap(execution.ErrNotSupported, "sandboxes with the systemd cgroup driver")
+	}
+	if o.CgroupParent != "" {
+		return errors.New("the cgroup parent of a container in a sandbox is the sandbox's")
+	}
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	// the first container of the sandbox creates the network namespace
+	for _, m := range s.sandboxContainers(o.Sandbox) {
+		if p, ok := m.GetProcess(initProcessID).(*process); ok && p.isAlive() {
+			setNamespace(spec.Linux, specs.NetworkNamespace, fmt.Sprintf("/proc/%d/ns/net", p.pid))
+			break
+		}
+	}
+	cgroupsPath := path.Join(sandboxCgroup(o.Sandbox), id)
+	spec.Linux.CgroupsPath = &cgroupsPath
+	return nil
+}
+
+// joinSandbox adds the container to the sandbox of the create options.
+func joinSandbox(c *execution.Container, o execution.CreateOpts) error {
+	if err := ioutil.WriteFile(filepath.Join(string(c.StateDir()), sandboxFilename), []byte(o.Sandbox), 0600); err != nil {
+		return errors.Wrap(err, "failed to save sandbox to disk")
+	}
+	c.SetSandbox(o.Sandbox)
+	return nil
+}
+
+// setNamespace joins the namespace of type typ at path, replacing any
+// namespace of that type in the spec.
+func setNamespace(l *specs.Linux, typ specs.LinuxNamespaceType, path string) {
+	for i, ns := range l.Namespaces {
+		if ns.Type == typ {
+			l.Namespaces[i].Path = path
+			return
+		}
+	}
+	l.Namespaces = append(l.Namespaces, specs.LinuxNamespace{Type: typ, Path: path})
+}
+
+func (s *ShimRuntime) sandboxContainers(id string) []*execution.Container {
+	containers, _ := s.List(s.ctx)
+	return execution.SandboxContainers(containers, id)
+}
+
+// KillSandbox sends sig to all the processes of the sandbox's containers.
+func (s *ShimRuntime) KillSandbox(ctx context.Context, id string, sig os.Signal) error {
+	members := s.sandboxContainers(id)
+	if len(members) == 0 {
+		return errors.Wrapf(execution.ErrSandboxNotFound, "%q", id)
+	}
+	for _, c := range members {
+		p, ok := c.GetProcess(initProcessID).(*process)
+		if !ok || p.Status() == execution.Stopped {
+			continue
+		}
+		if err := s.signalAll(ctx, c, p, sig.(syscall.Signal)); err != nil {
+			return errors.Wrapf(err, "failed to signal container %s", c.ID())
+		}
+	}
+	return nil
+}
+
+// KillAll sends sig to all the processes of the container.
+func (s *ShimRuntime) KillAll(ctx context.Context, c *execution.Container, sig os.Signal) error {
+	p, ok := c.GetProcess(initProcessID).(*process)
+	if !ok {
+		return execution.ErrProcessNotFound
+	}
+	if p.Status() == execution.Stopped {
+		return errors.Errorf("container %s is not running", c.ID())
+	}
+	return s.signalAll(ctx, c, p, sig.(syscall.Signal))
+}
+
+// signalAll sends sig to all the processes of the container with the init
+// process p.
+func (s *ShimRuntime) signalAll(ctx context.Context, c *execution.Container, p *process, sig syscall.Signal) error {
+	if p.shim != nil {
+		_, err := p.shim.Signal(ctx, &shimapi.SignalRequest{
+			Signal: uint32(sig),
+			All:    true,
+		})
+		return err
+	}
+	return s.runtimeKillAll(ctx, c, sig)
+}
+
+// SandboxStats returns the resource usage of the sandbox's cgroup parent.
+// Only cgroup v2 hosts are supported.
+func (s *ShimRuntime) SandboxStats(ctx context.Context, id string) (*cgroups.Metrics, error) {
+	if len(s.sandboxContainers(id)) == 0 {
+		return nil, errors.Wrapf(execution.ErrSandboxNotFound, "%q", id)
+	}
+	if !s.features.CgroupV2 {
+		return nil, errors.Wrap(execution.ErrNotSupported, "sandbox stats on cgroup v1")
+	}
+	m, err := cgroups.Load(sandboxCgroup(id))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load cgroup of sandbox %s", id)
+	}
+	return m.Stat()
+}
+
+// removeSandboxCgroup removes the cgroup parent of a sandbox once its last
+// container is deleted.
+func (s *ShimRuntime) removeSandboxCgroup(id string) {
+	if len(s.sandboxContainers(id)) != 0 {
+		return
+	}
+	dirs := []string{filepath.Join(cgroups.Root, sandboxCgroup(id))}
+	if !s.features.CgroupV2 {
+		dirs, _ = filepath.Glob(filepath.Join(cgroups.Root, "*", sandboxCgroup(id)))
+	}
+	for _, dir := range dirs {
+		os.Remove(dir)
+	}
+}
+
+// sandboxShim is the shim shared by the containers of a sandbox, creating
+// their processes. Each process has a state directory and a shim api of its
+// own, the runtime managing it as the process of a shim of its own.
+type sandboxShim struct {
+	shimapi.SandboxServer
+	client *ttrpc.Client
+}
+
+func connectSandboxShim(address string) (*sandboxShim, error) {
+	conn, err := net.Dial("unix", address)
+	if err != nil {
+		return nil, err
+	}
+	client := ttrpc.NewClient(conn)
+	return &sandboxShim{
+		SandboxServer: shimapi.NewSandboxTTRPCClient(client),
+		client:        client,
+	}, nil
+}
+
+// create has the shim create the process of o in its state directory root.
+func (sh *sandboxShim) create(ctx context.Context, o newProcessOpts, root string) error {
+	if err := writeProcessState(o, root); err != nil {
+		return err
+	}
+	_, err := sh.Create(ctx, &shimapi.CreateRequest{
+		StateDir: root,
+		ID:       o.container.ID(),
+		Bundle:   o.bundle,
+		Runtime:  o.runtime,
+		Socket:   shimAddress(root),
+	})
+	return err
+}
+
+func (sh *sandboxShim) alive() bool {
+	select {
+	case <-sh.client.Done():
+		return false
+	default:
+		return true
+	}
+}
+
+// sandboxShim returns the shim shared by the containers of the sandbox id,
+// starting it unless it is running.
+func (s *ShimRuntime) sandboxShim(id string) (*sandboxShim, error) {
+	s.sandboxMu.Lock()
+	defer s.sandboxMu.Unlock()
+
+	if sh, ok := s.sandboxShims[id]; ok && sh.alive() {
+		return sh, nil
+	}
+	dir := filepath.Join(s.root, sandboxShimsDir, id)
+	// the shim started by a previous daemon is still running
+	sh, err := connectSandboxShim(shimAddress(dir))
+	if err != nil {
+		if sh, err = s.startSandboxShim(dir); err != nil {
+			return nil, err
+		}
+	}
+	s.sandboxShims[id] = sh
+	return sh, nil
+}
+
+// startSandboxShim starts a shim with the state directory dir, returning once
+// it serves its api.
+func (s *ShimRuntime) startSandboxShim(dir string) (*sandboxShim, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	address := shimAddress(dir)
+	cmd := exec.Command(s.binaryName, "-sandbox", "-socket", address)
+	cmd.Dir = dir
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid: true,
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Wrap(err, "failed to start the shim of the sandbox")
+	}
+	exited := make(chan struct{})
+	go func() {
+		cmd.Wait()
+		close(exited)
+	}()
+	ticker := time.NewTicker(pidPollInterval)
+	defer ticker.Stop()
+	timeout := time.After(sandboxShimTimeout)
+	for {
+		sh, err := connectSandboxShim(address)
+		if err == nil {
+			return sh, nil
+		}
+		select {
+		case <-exited:
+			logRuntimeLogs(log.G(s.ctx).WithField("sandbox-shim", dir), dir)
+			return nil, errors.New("the shim of the sandbox exited prematurely")
+		case <-timeout:
+			cmd.Process.Kill()
+			return nil, errors.New("timeout waiting for the shim of the sandbox")
+		case <-ticker.C:
+		}
+	}
+}
+
+// stopSandboxShim shuts the shim of a sandbox down once its last container is
+// deleted, the shim exiting once its last process is done.
+func (s *ShimRuntime) stopSandboxShim(id string) {
+	if len(s.sandboxContainers(id)) != 0 {
+		return
+	}
+	s.sandboxMu.Lock()
+	defer s.sandboxMu.Unlock()
+
+	dir := filepath.Join(s.root, sandboxShimsDir, id)
+	sh, ok := s.sandboxShims[id]
+	delete(s.sandboxShims, id)
+	if !ok || !sh.alive() {
+		var err error
+		if sh, err = connectSandboxShim(shimAddress(dir)); err != nil {
+			// the shim is gone already
+			os.RemoveAll(dir)
+			return
+		}
+	}
+	defer sh.client.Close()
+	if _, err := sh.Shutdown(s.ctx, &shimapi.ShutdownRequest{}); err != nil {
+		log.G(s.ctx).WithError(err).WithField("sandbox", id).Warn("failed to shut the shim of the sandbox down")
+		return
+	}
+	os.RemoveAll(dir)
+}
@@ -0,0 +1,26 @@
+package shim
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestSetNamespace(t *testing.T) {
+	l := &specs.Linux{
+		Namespaces: []specs.LinuxNamespace{
+			{Type: specs.PIDNamespace},
+			{Type: specs.NetworkNamespace},
+		},
+	}
+	setNamespace(l, specs.NetworkNamespace, "/proc/1/ns/net")
+	if len(l.Namespaces) != 2 || l.Namespaces[1].Path != "/proc/1/ns/net" {
+		t.Fatalf("expected the network namespace to be joined, got %v", l.Namespaces)
+	}
+
+	l = &specs.Linux{}
+	setNamespace(l, specs.NetworkNamespace, "/proc/1/ns/net")
+	if len(l.Namespaces) != 1 || l.Namespaces[0].Path != "/proc/1/ns/net" {
+		t.Fatalf("expected the network namespace to be added, got %v", l.Namespaces)
+	}
+}
@@ -0,0 +1,82 @@
+package shim
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/docker/containerd/execution"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const secretsDirname = "secrets"
+
+// secretMounts bind mounts each of the secrets read only at its target, from
+// the files written by mountSecrets.
+func secretMounts(c *execution.Container, secrets []execution.Secret, spec *specs.Spec) {
+	dir := filepath.Join(string(c.StateDir()), secretsDirname)
+	for i, s := range secrets {
+		spec.Mounts = append(spec.Mounts, specs.Mount{
+			Destination: s.Target,
+			Type:        "bind",
+			Source:      filepath.Join(dir, secretFilename(i, s)),
+			Options:     []string{"rbind", "ro", "nosuid", "nodev", "noexec"},
+		})
+	}
+}
+
+// mountSecrets writes the secrets to a tmpfs in the container's state
+// directory, so they never reach the disk, for secretMounts to mount them.
+// The files are owned by the user of the init process, as seen from the host
+// when the container runs in a user namespace.
+func mountSecrets(c *execution.Container, secrets []execution.Secret, userns *execution.UserNamespace, spec *specs.Spec) error {
+	dir := filepath.Join(string(c.StateDir()), secretsDirname)
+	if err := os.Mkdir(dir, 0700); err != nil {
+		return errors.Wrap(err, "failed to create secrets directory")
+	}
+	data := "mode=0700,size=1m"
+	if spec.Linux != nil && spec.Linux.MountLabel != "" {
+		data += fmt.Sprintf(",context=%q", spec.Linux.MountLabel)
+	}
+	if err := syscall.Mount("tmpfs", dir, "tmpfs", syscall.MS_NOEXEC|syscall.MS_NOSUID|syscall.MS_NODEV, data); err != nil {
+		return errors.Wrap(err, "failed to mount secrets tmpfs")
+	}
+	uid, gid := spec.Process.User.UID, spec.Process.User.GID
+	if userns != nil {
+		uid, _ = userns.HostUID(uid)
+		gid, _ = userns.HostGID(gid)
+	}
+	for i, s := range secrets {
+		mode := s.Mode
+		if mode == 0 {
+			mode = 0400
+		}
+		p := filepath.Join(dir, secretFilename(i, s))
+		if err := ioutil.WriteFile(p, s.Data, mode); err != nil {
+			unmountSecrets(c)
+			return errors.Wrapf(err, "failed to write secret %q", s.Name)
+		}
+		if err := os.Chown(p, int(uid), int(gid)); err != nil {
+			unmountSecrets(c)
+			return errors.Wrapf(err, "failed to chown secret %q", s.Name)
+		}
+	}
+	return nil
+}
+
+// secretFilename is the file of the i-th secret s in the secrets tmpfs.
+func secretFilename(i int, s execution.Secret) string {
+	return fmt.Sprintf("%d-%s", i, s.Name)
+}
+
+// unmountSecrets removes the secrets of the container, if any.
+func unmountSecrets(c *execution.Container) error {
+	dir := filepath.Join(string(c.StateDir()), secretsDirname)
+	if err := syscall.Unmount(dir, syscall.MNT_DETACH); err != nil && err != syscall.EINVAL && err != syscall.ENOENT {
+		return errors.Wrap(err, "failed to unmount secrets")
+	}
+	return os.RemoveAll(dir)
+}
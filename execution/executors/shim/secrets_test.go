@@ -0,0 +1,58 @@
+package shim
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/containerd/execution"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestMountSecrets(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("mounting a tmpfs requires root")
+	}
+	root, err := ioutil.TempDir("", "shim-secrets-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	c, err := execution.NewContainer(root, "test", "/bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := specs.Spec{Process: specs.Process{User: specs.User{UID: 1000, GID: 1000}}}
+	secrets := []execution.Secret{{Name: "token", Target: "/run/secrets/token", Data: []byte("s3cr3t")}}
+	secretMounts(c, secrets, &spec)
+	if err := mountSecrets(c, secrets, nil, &spec); err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Mounts) != 1 || spec.Mounts[0].Destination != "/run/secrets/token" {
+		t.Fatalf("unexpected mounts %v", spec.Mounts)
+	}
+	src := spec.Mounts[0].Source
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "s3cr3t" {
+		t.Fatalf("unexpected secret %q", data)
+	}
+	fi, err := os.Stat(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0400 {
+		t.Errorf("unexpected mode %v", fi.Mode())
+	}
+
+	if err := unmountSecrets(c); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(string(c.StateDir()), secretsDirname)); !os.IsNotExist(err) {
+		t.Fatalf("expected the secrets to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package shim
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/selinux"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+const selinuxLabelFilename = "selinux-label"
+
+// labelSpec labels the processes and mounts of the container with an MCS
+// level of its own, when the host enforces SELinux and the bundle does not
+// label the container itself. It returns the process label of the
+// container, empty when it is left unlabeled, which is released by the
+// caller if the container is not created.
+func (s *ShimRuntime) labelSpec(o execution.CreateOpts, spec *specs.Spec) (string, error) {
+	if s.labels == nil || o.NoSelinuxLabel || spec.Process.SelinuxLabel != "" {
+		return "", nil
+	}
+	labels, err := s.labels.Allocate()
+	if err != nil {
+		return "", err
+	}
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	spec.Process.SelinuxLabel = labels.Process
+	spec.Linux.MountLabel = labels.Mount
+	return labels.Process, nil
+}
+
+// relabelContainer relabels the rootfs of the container with the mount label
+// of the spec set by labelSpec and records the process label label.
+func relabelContainer(c *execution.Container, o execution.CreateOpts, spec *specs.Spec, label string) error {
+	rootfs := spec.Root.Path
+	if !filepath.IsAbs(rootfs) {
+		rootfs = filepath.Join(o.Bundle, rootfs)
+	}
+	if err := selinux.Relabel(rootfs, spec.Linux.MountLabel); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(string(c.StateDir()), selinuxLabelFilename), []byte(label), 0600); err != nil {
+		return errors.Wrap(err, "failed to save selinux label to disk")
+	}
+	return nil
+}
+
+// reserveLabel reserves the level of a container labeled before the daemon
+// restarted.
+func (s *ShimRuntime) reserveLabel(stateDir execution.StateDir) {
+	if s.labels == nil {
+		return
+	}
+	if label, err := ioutil.ReadFile(filepath.Join(string(stateDir), selinuxLabelFilename)); err == nil {
+		s.labels.Reserve(string(label))
+	}
+}
+
+// releaseLabel frees the level of a container being deleted.
+func (s *ShimRuntime) releaseLabel(c *execution.Container) {
+	if s.labels == nil {
+		return
+	}
+	if label, err := ioutil.ReadFile(filepath.Join(string(c.StateDir()), selinuxLabelFilename)); err == nil {
+		s.labels.Release(string(label))
+	}
+}
@@ -1,17 +1,26 @@
 package shim
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"sync"
 	"syscall"
+	"time"
 
+	shimapi "github.com/docker/containerd/api/shim"
+	"github.com/docker/containerd/apparmor"
+	"github.com/docker/containerd/cgroups"
 	"github.com/docker/containerd/execution"
 	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/selinux"
+	"github.com/docker/containerd/tracing"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -26,13 +35,29 @@ const (
 	controlPipeFilename = "control"
 	initProcessID       = "init"
 	exitStatusFilename  = "exitStatus"
+	optionsFilename     = "runtime-options.json"
 )
 
-func New(ctx context.Context, root, shim, runtime string, runtimeArgs []string) (*ShimRuntime, error) {
+func New(ctx context.Context, root, shim, runtime string, runtimeArgs []string, health HealthCheck) (*ShimRuntime, error) {
+	if err := health.validate(); err != nil {
+		return nil, err
+	}
 	fd, err := syscall.EpollCreate1(0)
 	if err != nil {
 		return nil, errors.Wrap(err, "epollcreate1 failed")
 	}
+	features, err := probeFeatures(runtime, runtimeArgs)
+	if err != nil {
+		syscall.Close(fd)
+		return nil, err
+	}
+	log.G(ctx).WithFields(logrus.Fields{
+		"runtime":   runtime,
+		"version":   features.Version,
+		"seccomp":   features.Seccomp,
+		"cgroup-v2": features.CgroupV2,
+		"criu":      features.Criu,
+	}).Info("probed runtime features")
 	s := &ShimRuntime{
 		ctx:          ctx,
 		epollFd:      fd,
@@ -40,13 +65,26 @@ func New(ctx context.Context, root, shim, runtime string, runtimeArgs []string)
 		binaryName:   shim,
 		runtime:      runtime,
 		runtimeArgs:  runtimeArgs,
+		features:     features,
+		health:       health,
+		failures:     make(chan execution.RuntimeFailure, 64),
 		exitChannels: make(map[int]*process),
 		containers:   make(map[string]*execution.Container),
+		options:      make(map[string]execution.RuntimeOptions),
+		execs:        make(map[string]*execScaffold),
+		sandboxShims: make(map[string]*sandboxShim),
+	}
+	if selinux.IsEnforcing() {
+		s.labels = selinux.NewAllocator()
+		log.G(ctx).Info("selinux is enforcing, containers are labeled")
 	}
 
 	s.loadContainers()
 
 	go s.monitor()
+	if health.Interval > 0 {
+		go s.checkHealth()
+	}
 
 	return s, nil
 }
@@ -54,15 +92,37 @@ func New(ctx context.Context, root, shim, runtime string, runtimeArgs []string)
 type ShimRuntime struct {
 	ctx context.Context
 
-	mutex        sync.Mutex
+	// exitMu guards the processes monitored by the fd of their exit pipe,
+	// apart from the containers for the exits not to contend with the
+	// requests.
+	exitMu       sync.Mutex
 	exitChannels map[int]*process
-	containers   map[string]*execution.Container
+
+	// mutex guards the containers and what is kept about them, it is only
+	// held to look them up and record them.
+	mutex      sync.RWMutex
+	containers map[string]*execution.Container
+	// options holds the runtime options each container was created with
+	options map[string]execution.RuntimeOptions
+	// execs holds the scaffolds of the execs of the containers
+	execs map[string]*execScaffold
 
 	epollFd     int
 	root        string
 	binaryName  string
 	runtime     string
 	runtimeArgs []string
+	features    execution.Features
+	health      HealthCheck
+	failures    chan execution.RuntimeFailure
+	// labels allocates the selinux labels of containers, it is nil when
+	// selinux is not enforcing.
+	labels *selinux.Allocator
+
+	// sandboxMu guards the shims shared by the sandboxes, held while they
+	// are started.
+	sandboxMu    sync.Mutex
+	sandboxShims map[string]*sandboxShim
 }
 
 type ProcessOpts struct {
@@ -107,6 +167,24 @@ func (s *ShimRuntime) Create(ctx context.Context, id string, o execution.CreateO
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to save bundle path to disk")
 	}
+	options, err := json.Marshal(o.RuntimeOptions)
+	if err != nil {
+		return nil, err
+	}
+	err = ioutil.WriteFile(filepath.Join(string(container.StateDir()), optionsFilename), options, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save runtime options to disk")
+	}
+	s.mutex.Lock()
+	s.options[id] = o.RuntimeOptions
+	s.mutex.Unlock()
+	defer func() {
+		if err != nil {
+			s.mutex.Lock()
+			delete(s.options, id)
+			s.mutex.Unlock()
+		}
+	}()
 
 	// extract Process spec from bundle's config.json
 	var spec specs.Spec
@@ -119,12 +197,140 @@ func (s *ShimRuntime) Create(ctx context.Context, id string, o execution.CreateO
 		return nil, errors.Wrap(err, "failed to decode container OCI specs")
 	}
 
+	if o.SeccompProfile != "" {
+		if err = applySeccompProfile(o.SeccompProfile, &spec); err != nil {
+			return nil, err
+		}
+	}
+	if o.ApparmorProfile != "" {
+		spec.Process.ApparmorProfile = o.ApparmorProfile
+	}
+	userns := o.UserNamespace
+	if userns == nil {
+		userns = o.SpecDefaults.UserNamespace
+	}
+	if userns != nil {
+		if err = applyUserNamespace(userns, &spec); err != nil {
+			return nil, err
+		}
+	}
+	if !o.Capabilities.IsZero() {
+		if spec.Process.Capabilities, err = adjustCapabilities(spec.Process.Capabilities, o.Capabilities); err != nil {
+			return nil, err
+		}
+	}
+	o.Paths.Apply(&spec)
+	// the spec is completed before it is checked, the changes outside of
+	// it are made once the container is admitted
+	label, err := s.labelSpec(o, &spec)
+	if err != nil {
+		return nil, err
+	}
+	if label != "" {
+		defer func() {
+			if err != nil {
+				s.labels.Release(label)
+			}
+		}()
+	}
+	if len(o.Secrets) > 0 {
+		secretMounts(container, o.Secrets, &spec)
+	}
+	if !o.NetworkFiles.IsZero() {
+		if err = networkFileMounts(container, o.NetworkFiles, &spec); err != nil {
+			return nil, err
+		}
+	}
+	if err = s.checkFeatures(&spec, o.RuntimeOptions); err != nil {
+		return nil, err
+	}
+	if o.CheckpointPath != "" {
+		if err = checkCheckpoint(o.CheckpointPath); err != nil {
+			return nil, err
+		}
+	}
+	bundle := o.Bundle
+	// the selinux label is allocated by the executor, not set by the options
+	rewrite := o.ChangesSpec() || label != ""
+	if rewrite {
+		o.SpecDefaults.Apply(&spec)
+		if err = applyCgroupOpts(id, o, &spec); err != nil {
+			return nil, err
+		}
+		if o.Sandbox != "" {
+			if err = s.sandboxSpec(id, o, &spec); err != nil {
+				return nil, err
+			}
+		}
+		if o.NetNSPath != "" {
+			if spec.Linux == nil {
+				spec.Linux = &specs.Linux{}
+			}
+			setNamespace(spec.Linux, specs.NetworkNamespace, o.NetNSPath)
+		}
+		for _, ns := range o.Namespaces {
+			setNamespace(spec.Linux, ns.Type, ns.Path)
+		}
+	}
+	if err = o.Admission.Check(&spec, o.Bundle); err != nil {
+		return nil, err
+	}
+	if label != "" {
+		if err = relabelContainer(container, o, &spec, label); err != nil {
+			return nil, err
+		}
+	}
+	if len(o.Secrets) > 0 {
+		if err = mountSecrets(container, o.Secrets, userns, &spec); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				unmountSecrets(container)
+			}
+		}()
+	}
+	if !o.NetworkFiles.IsZero() {
+		if err = execution.WriteNetworkFiles(container, o.NetworkFiles); err != nil {
+			return nil, err
+		}
+	}
+	if o.Sandbox != "" {
+		if err = joinSandbox(container, o); err != nil {
+			return nil, err
+		}
+	}
+	if userns != nil {
+		rootfs := spec.Root.Path
+		if !filepath.IsAbs(rootfs) {
+			rootfs = filepath.Join(o.Bundle, rootfs)
+		}
+		var restore func() error
+		if restore, err = remapRootfs(userns, rootfs); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				if rerr := restore(); rerr != nil {
+					log.G(s.ctx).WithError(rerr).WithField("container", id).Warn("failed to restore the owners of the rootfs")
+				}
+			}
+		}()
+	}
+	if rewrite {
+		if bundle, err = writeRuntimeBundle(container, o.Bundle, &spec); err != nil {
+			return nil, err
+		}
+	}
+
 	processOpts := newProcessOpts{
-		shimBinary:  s.binaryName,
-		runtime:     s.runtime,
-		runtimeArgs: s.runtimeArgs,
-		container:   container,
-		exec:        false,
+		shimBinary:     s.binaryName,
+		runtime:        s.runtime,
+		runtimeArgs:    s.containerRuntimeArgs(container),
+		container:      container,
+		bundle:         bundle,
+		exec:           false,
+		checkpointPath: o.CheckpointPath,
 		StartProcessOpts: execution.StartProcessOpts{
 			ID:      initProcessID,
 			Spec:    spec.Process,
@@ -134,12 +340,30 @@ func (s *ShimRuntime) Create(ctx context.Context, id string, o execution.CreateO
 			Stderr:  o.Stderr,
 		},
 	}
+	if o.Sandbox != "" {
+		if processOpts.sandbox, err = s.sandboxShim(o.Sandbox); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				s.stopSandboxShim(o.Sandbox)
+			}
+		}()
+	}
 
-	process, err := newProcess(ctx, processOpts)
+	// the shim runs the runtime's create before reporting the pid
+	span, sctx := tracing.StartSpan(ctx, "runtime.create")
+	span.SetTag("runtime", s.runtime)
+	process, err := newProcess(sctx, processOpts)
+	span.Finish(err)
 	if err != nil {
 		return nil, err
 	}
-	process.ctx = log.WithModule(log.WithModule(s.ctx, "container"), id)
+	process.ctx = s.processContext(id, process.id)
+	if o.CheckpointPath != "" {
+		// the runtime restores the container running
+		process.status = execution.Running
+	}
 
 	s.monitorProcess(process)
 	container.AddProcess(process, true)
@@ -149,10 +373,68 @@ func (s *ShimRuntime) Create(ctx context.Context, id string, o execution.CreateO
 	return container, nil
 }
 
-func (s *ShimRuntime) Start(ctx context.Context, c *execution.Container) error {
-	log.G(s.ctx).WithFields(logrus.Fields{"container": c}).Debug("Start()")
+// processContext returns the context of a process, logging in the module of
+// its container with the ids of the container and process.
+func (s *ShimRuntime) processContext(containerID, id string) context.Context {
+	ctx := log.WithModule(log.WithModule(s.ctx, "container"), containerID)
+	return log.WithLogger(ctx, log.G(ctx).WithFields(logrus.Fields{
+		"container":  containerID,
+		"process-id": id,
+	}))
+}
+
+// Features returns the features of the runtime probed on startup.
+func (s *ShimRuntime) Features() execution.Features {
+	return s.features
+}
+
+// checkFeatures fails when the container requires a feature that is not
+// available, rather than leaving the runtime to fail obscurely.
+func (s *ShimRuntime) checkFeatures(spec *specs.Spec, o execution.RuntimeOptions) error {
+	if spec.Linux != nil && spec.Linux.Seccomp != nil && !s.features.Seccomp {
+		return errors.Wrap(execution.ErrFeatureUnavailable, "seccomp is not supported by the kernel")
+	}
+	if err := checkApparmor(spec.Process.ApparmorProfile); err != nil {
+		return err
+	}
+	if o.CriuPath != "" {
+		if _, err := exec.LookPath(o.CriuPath); err != nil {
+			return errors.Wrapf(execution.ErrFeatureUnavailable, "criu %s is not installed", o.CriuPath)
+		}
+	}
+	return nil
+}
+
+// checkApparmor fails when a process is confined by an AppArmor profile the
+// host can not apply.
+func checkApparmor(profile string) error {
+	if profile == "" || profile == apparmor.Unconfined {
+		return nil
+	}
+	if !apparmor.IsEnabled() {
+		return errors.Wrapf(execution.ErrFeatureUnavailable, "apparmor profile %s: apparmor is not enabled", profile)
+	}
+	return nil
+}
+
+func (s *ShimRuntime) Start(ctx context.Context, c *execution.Container) (err error) {
+	log.G(s.ctx).WithFields(logrus.Fields{"container": c.ID()}).Debug("Start()")
+
+	span, ctx := tracing.StartSpan(ctx, "runtime.start")
+	span.SetTag("runtime", s.runtime)
+	defer func() {
+		span.Finish(err)
+	}()
+
+	if p, ok := c.GetProcess(initProcessID).(*process); ok && p.shim != nil {
+		if _, err := p.shim.Start(ctx, &shimapi.StartRequest{}); err != nil {
+			logRuntimeLogs(log.G(p.ctx).WithField("process-id", p.id), p.root)
+			return errors.Wrap(runtimeError(p.root, err), "failed to start container")
+		}
+		return nil
+	}
 
-	cmd := exec.CommandContext(ctx, s.runtime, append(s.runtimeArgs, "start", c.ID())...)
+	cmd := exec.CommandContext(ctx, s.runtime, append(s.containerRuntimeArgs(c), "start", c.ID())...)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		return errors.Wrapf(err, "'%s start' failed with output: %v", s.runtime, string(out))
@@ -163,12 +445,12 @@ func (s *ShimRuntime) Start(ctx context.Context, c *execution.Container) error {
 func (s *ShimRuntime) List(ctx context.Context) ([]*execution.Container, error) {
 	log.G(s.ctx).Debug("List()")
 
-	containers := make([]*execution.Container, 0)
-	s.mutex.Lock()
+	s.mutex.RLock()
+	containers := make([]*execution.Container, 0, len(s.containers))
 	for _, c := range s.containers {
 		containers = append(containers, c)
 	}
-	s.mutex.Unlock()
+	s.mutex.RUnlock()
 
 	return containers, nil
 }
@@ -176,9 +458,9 @@ func (s *ShimRuntime) List(ctx context.Context) ([]*execution.Container, error)
 func (s *ShimRuntime) Load(ctx context.Context, id string) (*execution.Container, error) {
 	log.G(s.ctx).WithFields(logrus.Fields{"container-id": id}).Debug("Start()")
 
-	s.mutex.Lock()
+	s.mutex.RLock()
 	c, ok := s.containers[id]
-	s.mutex.Unlock()
+	s.mutex.RUnlock()
 
 	if !ok {
 		return nil, errors.New(execution.ErrContainerNotFound.Error())
@@ -188,21 +470,57 @@ func (s *ShimRuntime) Load(ctx context.Context, id string) (*execution.Container
 }
 
 func (s *ShimRuntime) Delete(ctx context.Context, c *execution.Container) error {
-	log.G(s.ctx).WithFields(logrus.Fields{"container": c}).Debug("Delete()")
+	log.G(s.ctx).WithFields(logrus.Fields{"container": c.ID()}).Debug("Delete()")
 
 	if c.Status() != execution.Stopped {
 		return errors.Errorf("cannot delete a container in the '%s' state", c.Status())
 	}
 
+	if p, ok := c.GetProcess(initProcessID).(*process); ok && p.hasFailed() {
+		// the shim did not get to delete the container from the runtime
+		if err := s.runtimeDelete(ctx, c); err != nil {
+			log.G(s.ctx).WithError(err).WithField("container", c.ID()).Warn("failed to delete container from the runtime")
+		}
+	}
+	s.releaseLabel(c)
+	if err := unmountSecrets(c); err != nil {
+		log.G(s.ctx).WithError(err).WithField("container", c.ID()).Warn("failed to remove secrets")
+	}
 	c.StateDir().Delete()
 	s.removeContainer(c)
+	if c.Sandbox() != "" {
+		s.removeSandboxCgroup(c.Sandbox())
+		s.stopSandboxShim(c.Sandbox())
+	}
+	return nil
+}
+
+// runtimeDelete deletes the state of the container kept by the runtime,
+// killing its remaining processes.
+func (s *ShimRuntime) runtimeDelete(ctx context.Context, c *execution.Container) error {
+	cmd := exec.CommandContext(ctx, s.runtime, append(s.containerRuntimeArgs(c), "delete", "--force", c.ID())...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "'%s delete' failed with output: %v", s.runtime, string(out))
+	}
+	return nil
+}
+
+// runtimeKillAll sends sig to all the processes of the container through the
+// runtime.
+func (s *ShimRuntime) runtimeKillAll(ctx context.Context, c *execution.Container, sig syscall.Signal) error {
+	cmd := exec.CommandContext(ctx, s.runtime, append(s.containerRuntimeArgs(c), "kill", "--all", c.ID(), strconv.Itoa(int(sig)))...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "'%s kill' failed with output: %v", s.runtime, string(out))
+	}
 	return nil
 }
 
 func (s *ShimRuntime) Pause(ctx context.Context, c *execution.Container) error {
-	log.G(s.ctx).WithFields(logrus.Fields{"container": c}).Debug("Pause()")
+	log.G(s.ctx).WithFields(logrus.Fields{"container": c.ID()}).Debug("Pause()")
 
-	cmd := exec.CommandContext(ctx, s.runtime, append(s.runtimeArgs, "pause", c.ID())...)
+	cmd := exec.CommandContext(ctx, s.runtime, append(s.containerRuntimeArgs(c), "pause", c.ID())...)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		return errors.Wrapf(err, "'%s pause' failed with output: %v", s.runtime, string(out))
@@ -211,9 +529,9 @@ func (s *ShimRuntime) Pause(ctx context.Context, c *execution.Container) error {
 }
 
 func (s *ShimRuntime) Resume(ctx context.Context, c *execution.Container) error {
-	log.G(s.ctx).WithFields(logrus.Fields{"container": c}).Debug("Resume()")
+	log.G(s.ctx).WithFields(logrus.Fields{"container": c.ID()}).Debug("Resume()")
 
-	cmd := exec.CommandContext(ctx, s.runtime, append(s.runtimeArgs, "resume", c.ID())...)
+	cmd := exec.CommandContext(ctx, s.runtime, append(s.containerRuntimeArgs(c), "resume", c.ID())...)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		return errors.Wrapf(err, "'%s resume' failed with output: %v", s.runtime, string(out))
@@ -222,22 +540,49 @@ func (s *ShimRuntime) Resume(ctx context.Context, c *execution.Container) error
 }
 
 func (s *ShimRuntime) StartProcess(ctx context.Context, c *execution.Container, o execution.StartProcessOpts) (p execution.Process, err error) {
-	log.G(s.ctx).WithFields(logrus.Fields{"container": c, "options": o}).Debug("StartProcess()")
+	log.G(s.ctx).WithFields(logrus.Fields{"container": c.ID(), "options": o}).Debug("StartProcess()")
 
+	if err := checkApparmor(o.Spec.ApparmorProfile); err != nil {
+		return nil, err
+	}
+	scaffold, init, err := s.execScaffold(c)
+	if err != nil {
+		return nil, err
+	}
+	if o.Spec.Capabilities == nil {
+		o.Spec.Capabilities = init.Capabilities
+	}
+	o.Spec.NoNewPrivileges = init.NoNewPrivileges
+	if o.NoNewPrivileges != nil {
+		o.Spec.NoNewPrivileges = *o.NoNewPrivileges
+	}
+	if !o.Capabilities.IsZero() {
+		if o.Spec.Capabilities, err = adjustCapabilities(o.Spec.Capabilities, o.Capabilities); err != nil {
+			return nil, err
+		}
+	}
 	processOpts := newProcessOpts{
 		shimBinary:       s.binaryName,
 		runtime:          s.runtime,
-		runtimeArgs:      s.runtimeArgs,
+		runtimeArgs:      s.containerRuntimeArgs(c),
 		container:        c,
+		bundle:           runtimeBundle(c),
 		exec:             true,
+		scaffold:         scaffold,
 		StartProcessOpts: o,
 	}
+	if c.Sandbox() != "" {
+		if processOpts.sandbox, err = s.sandboxShim(c.Sandbox()); err != nil {
+			return nil, err
+		}
+	}
 	process, err := newProcess(ctx, processOpts)
 	if err != nil {
 		return nil, err
 	}
 
 	process.status = execution.Running
+	process.ctx = s.processContext(c.ID(), process.id)
 	s.monitorProcess(process)
 
 	c.AddProcess(process, false)
@@ -245,22 +590,176 @@ func (s *ShimRuntime) StartProcess(ctx context.Context, c *execution.Container,
 }
 
 func (s *ShimRuntime) SignalProcess(ctx context.Context, c *execution.Container, id string, sig os.Signal) error {
-	log.G(s.ctx).WithFields(logrus.Fields{"container": c, "process-id": id, "signal": sig}).
+	log.G(s.ctx).WithFields(logrus.Fields{"container": c.ID(), "process-id": id, "signal": sig}).
 		Debug("SignalProcess()")
 
 	process := c.GetProcess(id)
 	if process == nil {
 		return errors.Errorf("no such process %s", id)
 	}
-	err := syscall.Kill(int(process.Pid()), sig.(syscall.Signal))
-	if err != nil {
+	if err := process.Signal(sig); err != nil {
 		return errors.Wrapf(err, "failed to send %v signal to process %v", sig, process.Pid())
 	}
-	return err
+	return nil
+}
+
+func (s *ShimRuntime) RuntimeLogs(ctx context.Context, c *execution.Container, id string) ([]execution.RuntimeLog, error) {
+	if id == "" {
+		id = initProcessID
+	}
+	process, ok := c.GetProcess(id).(*process)
+	if !ok {
+		return nil, execution.ErrProcessNotFound
+	}
+	return readRuntimeLogs(process.root)
+}
+
+// Update applies the resources to the container. On cgroup v2 hosts the
+// resources are translated and written to the container's cgroup directly.
+func (s *ShimRuntime) Update(ctx context.Context, c *execution.Container, resources *specs.LinuxResources) error {
+	log.G(s.ctx).WithFields(logrus.Fields{"container": c.ID()}).Debug("Update()")
+
+	if s.features.CgroupV2 {
+		m, err := s.cgroup(c)
+		if err != nil {
+			return err
+		}
+		return m.Update(resources)
+	}
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, s.runtime, append(s.containerRuntimeArgs(c), "update", "--resources", "-", c.ID())...)
+	cmd.Stdin = bytes.NewReader(data)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "'%s update' failed with output: %v", s.runtime, string(out))
+	}
+	return nil
+}
+
+// WatchOOM reports oom kills in the container. Only cgroup v2 hosts are
+// supported.
+func (s *ShimRuntime) WatchOOM(ctx context.Context, c *execution.Container) (<-chan uint64, error) {
+	if !s.features.CgroupV2 {
+		return nil, errors.Wrap(execution.ErrNotSupported, "oom events on cgroup v1")
+	}
+	m, err := s.cgroup(c)
+	if err != nil {
+		return nil, err
+	}
+	return m.WatchOOM(ctx)
+}
+
+// Stats returns the resource usage of the container's cgroup. Only cgroup v2
+// hosts are supported.
+func (s *ShimRuntime) Stats(ctx context.Context, c *execution.Container) (*cgroups.Metrics, error) {
+	if !s.features.CgroupV2 {
+		return nil, errors.Wrap(execution.ErrNotSupported, "stats on cgroup v1")
+	}
+	m, err := s.cgroup(c)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := m.Stat()
+	if err != nil {
+		return nil, err
+	}
+	// the network stats are read from the namespace of the init process,
+	// those of the cgroup are returned alone once it is gone
+	p, ok := c.GetProcess(initProcessID).(*process)
+	if !ok {
+		return stats, nil
+	}
+	// the interfaces of the host are not those of the container
+	if host, err := hostNetwork(int(p.pid)); err == nil && !host {
+		if stats.Network, err = cgroups.ReadNetworkStats(int(p.pid)); err != nil {
+			return nil, errors.Wrapf(err, "failed to read network stats of container %s", c.ID())
+		}
+	}
+	return stats, nil
+}
+
+// Inspect returns the spec the container runs with and the stdio of its
+// processes, read from the state of their shims.
+func (s *ShimRuntime) Inspect(ctx context.Context, c *execution.Container) (*execution.ContainerInfo, error) {
+	spec, err := runtimeSpec(c)
+	if err != nil {
+		return nil, err
+	}
+	info := &execution.ContainerInfo{
+		Spec:  spec,
+		Stdio: make(map[string]execution.Stdio),
+	}
+	for _, p := range c.Processes() {
+		sp, ok := p.(*process)
+		if !ok {
+			continue
+		}
+		f, err := os.Open(filepath.Join(sp.root, "process.json"))
+		if err != nil {
+			log.G(ctx).WithError(err).WithFields(logrus.Fields{"container": c.ID(), "process-id": p.ID()}).Warn("failed to read the state of the process")
+			continue
+		}
+		var state processState
+		err = json.NewDecoder(f).Decode(&state)
+		f.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the state of process %s", p.ID())
+		}
+		info.Stdio[p.ID()] = execution.Stdio{
+			Stdin:  state.Stdin,
+			Stdout: state.Stdout,
+			Stderr: state.Stderr,
+		}
+	}
+	return info, nil
+}
+
+// Pids returns the processes of the container's cgroup. Only cgroup v2
+// hosts are supported.
+func (s *ShimRuntime) Pids(ctx context.Context, c *execution.Container) ([]int, error) {
+	if !s.features.CgroupV2 {
+		return nil, errors.Wrap(execution.ErrNotSupported, "pids on cgroup v1")
+	}
+	m, err := s.cgroup(c)
+	if err != nil {
+		return nil, err
+	}
+	return m.Pids()
+}
+
+// hostNetwork returns whether the process pid is in the network namespace of
+// the daemon.
+func hostNetwork(pid int) (bool, error) {
+	ns, err := os.Stat(fmt.Sprintf("/proc/%d/ns/net", pid))
+	if err != nil {
+		return false, err
+	}
+	self, err := os.Stat("/proc/self/ns/net")
+	if err != nil {
+		return false, err
+	}
+	return os.SameFile(ns, self), nil
+}
+
+// cgroup returns the unified hierarchy cgroup of the container's init
+// process.
+func (s *ShimRuntime) cgroup(c *execution.Container) (*cgroups.Manager, error) {
+	p, ok := c.GetProcess(initProcessID).(*process)
+	if !ok {
+		return nil, execution.ErrProcessNotFound
+	}
+	m, err := cgroups.LoadPid(int(p.pid))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load cgroup of container %s", c.ID())
+	}
+	return m, nil
 }
 
 func (s *ShimRuntime) DeleteProcess(ctx context.Context, c *execution.Container, id string) error {
-	log.G(s.ctx).WithFields(logrus.Fields{"container": c, "process-id": id}).
+	log.G(s.ctx).WithFields(logrus.Fields{"container": c.ID(), "process-id": id}).
 		Debug("DeleteProcess()")
 
 	c.RemoveProcess(id)
@@ -285,10 +784,10 @@ func (s *ShimRuntime) monitor() {
 		for i := 0; i < n; i++ {
 			fd := int(events[i].Fd)
 
-			s.mutex.Lock()
+			s.exitMu.Lock()
 			p := s.exitChannels[fd]
 			delete(s.exitChannels, fd)
-			s.mutex.Unlock()
+			s.exitMu.Unlock()
 
 			if err = syscall.EpollCtl(s.epollFd, syscall.EPOLL_CTL_DEL, fd, &syscall.EpollEvent{
 				Events: syscall.EPOLLHUP,
@@ -297,7 +796,13 @@ func (s *ShimRuntime) monitor() {
 				log.G(s.ctx).Error("epollctl deletion failed:", err)
 			}
 
-			close(p.exitChan)
+			// the shim is gone, its process should be too
+			if p.isAlive() && s.shimFailed(p, "shim exited") {
+				p.setOrphaned(true)
+				continue
+			}
+			logRuntimeLogs(log.G(p.ctx).WithField("process-id", p.id), p.root)
+			p.exit()
 		}
 	}
 }
@@ -311,13 +816,27 @@ func (s *ShimRuntime) addContainer(c *execution.Container) {
 func (s *ShimRuntime) removeContainer(c *execution.Container) {
 	s.mutex.Lock()
 	delete(s.containers, c.ID())
+	delete(s.options, c.ID())
+	delete(s.execs, c.ID())
 	s.mutex.Unlock()
 }
 
+// containerRuntimeArgs returns the runtime arguments for invocations of the
+// runtime against c, including the container's runtime options.
+func (s *ShimRuntime) containerRuntimeArgs(c *execution.Container) []string {
+	s.mutex.RLock()
+	options := s.options[c.ID()]
+	s.mutex.RUnlock()
+
+	args := make([]string, 0, len(s.runtimeArgs))
+	args = append(args, s.runtimeArgs...)
+	return append(args, options.Args()...)
+}
+
 func (s *ShimRuntime) getContainer(id string) *execution.Container {
-	s.mutex.Lock()
+	s.mutex.RLock()
 	c := s.containers[id]
-	s.mutex.Unlock()
+	s.mutex.RUnlock()
 
 	return c
 }
@@ -328,7 +847,7 @@ func (s *ShimRuntime) getContainer(id string) *execution.Container {
 // this will ensure the process gets killed
 func (s *ShimRuntime) monitorProcess(p *process) {
 	if p.status == execution.Stopped {
-		close(p.exitChan)
+		p.exit()
 		return
 	}
 
@@ -337,14 +856,14 @@ func (s *ShimRuntime) monitorProcess(p *process) {
 		Fd:     int32(fd),
 		Events: syscall.EPOLLHUP,
 	}
-	s.mutex.Lock()
+	s.exitMu.Lock()
 	s.exitChannels[fd] = p
-	s.mutex.Unlock()
+	s.exitMu.Unlock()
 	if err := syscall.EpollCtl(s.epollFd, syscall.EPOLL_CTL_ADD, fd, &event); err != nil {
-		s.mutex.Lock()
+		s.exitMu.Lock()
 		delete(s.exitChannels, fd)
-		s.mutex.Unlock()
-		close(p.exitChan)
+		s.exitMu.Unlock()
+		p.exit()
 		return
 	}
 
@@ -352,14 +871,14 @@ func (s *ShimRuntime) monitorProcess(p *process) {
 }
 
 func (s *ShimRuntime) unmonitorProcess(p *process) {
-	s.mutex.Lock()
+	s.exitMu.Lock()
 	for fd, proc := range s.exitChannels {
 		if proc == p {
 			delete(s.exitChannels, fd)
 			break
 		}
 	}
-	s.mutex.Unlock()
+	s.exitMu.Unlock()
 }
 
 func (s *ShimRuntime) loadContainers() {
@@ -370,10 +889,26 @@ func (s *ShimRuntime) loadContainers() {
 		return
 	}
 
+	var (
+		start = time.Now()
+		found int
+	)
+	defer func() {
+		s.mutex.RLock()
+		loaded := len(s.containers)
+		s.mutex.RUnlock()
+		log.G(s.ctx).WithFields(logrus.Fields{
+			"statedir": s.root,
+			"loaded":   loaded,
+			"failed":   found - loaded,
+			"duration": time.Since(start),
+		}).Info("loaded containers")
+	}()
 	for _, c := range cs {
-		if !c.IsDir() {
+		if !c.IsDir() || c.Name() == sandboxShimsDir {
 			continue
 		}
+		found++
 
 		stateDir, err := execution.LoadStateDir(s.root, c.Name())
 		if err != nil {
@@ -390,7 +925,23 @@ func (s *ShimRuntime) loadContainers() {
 			continue
 		}
 
+		var options execution.RuntimeOptions
+		if b, err := ioutil.ReadFile(filepath.Join(string(stateDir), optionsFilename)); err == nil {
+			if err := json.Unmarshal(b, &options); err != nil {
+				log.G(s.ctx).WithField("container", c.Name()).
+					Warn("failed to decode container runtime options:", err)
+				continue
+			}
+		}
+		s.mutex.Lock()
+		s.options[c.Name()] = options
+		s.mutex.Unlock()
+		s.reserveLabel(stateDir)
+
 		container := execution.LoadContainer(stateDir, c.Name(), string(bundle), execution.Unknown)
+		if sandbox, err := ioutil.ReadFile(filepath.Join(string(stateDir), sandboxFilename)); err == nil {
+			container.SetSandbox(string(sandbox))
+		}
 		s.addContainer(container)
 
 		processDirs, err := stateDir.Processes()
@@ -402,7 +953,7 @@ func (s *ShimRuntime) loadContainers() {
 
 		for _, procStateRoot := range processDirs {
 			id := filepath.Base(procStateRoot)
-			proc, err := loadProcess(procStateRoot, id)
+			proc, err := loadProcess(procStateRoot, c.Name(), id)
 			if err != nil {
 				log.G(s.ctx).WithFields(logrus.Fields{"container": c.Name(), "process": id}).
 					Warn("failed to load process:", err)
@@ -412,7 +963,7 @@ func (s *ShimRuntime) loadContainers() {
 				}
 				break
 			}
-			proc.ctx = log.WithModule(log.WithModule(s.ctx, "container"), container.ID())
+			proc.ctx = s.processContext(container.ID(), proc.id)
 			container.AddProcess(proc, proc.ID() == initProcessID)
 			s.monitorProcess(proc)
 		}
@@ -0,0 +1,62 @@
+package shim
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/selinux"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+func TestCreateAdmissionBeforeChanges(t *testing.T) {
+	root, err := ioutil.TempDir("", "shim-create-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	bundle := filepath.Join(root, "bundle")
+	if err := os.MkdirAll(filepath.Join(bundle, "rootfs"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(specs.Spec{Root: specs.Root{Path: "rootfs"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(bundle, "config.json"), data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	s := &ShimRuntime{
+		ctx:        context.Background(),
+		root:       root,
+		containers: make(map[string]*execution.Container),
+		options:    make(map[string]execution.RuntimeOptions),
+		labels:     selinux.NewAllocator(),
+	}
+
+	// the rootfs is relabeled and the sandbox joined once the container is
+	// admitted only, a rejected container leaving them unchanged
+	_, err = s.Create(context.Background(), "test", execution.CreateOpts{
+		Bundle:    bundle,
+		Sandbox:   "pod",
+		Admission: &execution.AdmissionPolicy{RequireSeccomp: true},
+	})
+	if _, ok := errors.Cause(err).(*execution.AdmissionError); !ok {
+		t.Fatalf("expected the container to be rejected by the admission policy, got %v", err)
+	}
+	if _, err := unix.Getxattr(filepath.Join(bundle, "rootfs"), "security.selinux", make([]byte, 256)); err != unix.ENODATA && err != unix.ENOTSUP {
+		t.Fatalf("expected the rootfs not to be relabeled, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "test")); !os.IsNotExist(err) {
+		t.Fatalf("expected the state of the container to be removed, got %v", err)
+	}
+	if _, ok := s.options["test"]; ok {
+		t.Fatal("expected the runtime options of the container to be removed")
+	}
+}
@@ -0,0 +1,26 @@
+package execution
+
+// Features describe what a runtime supports on the host, as probed when the
+// daemon starts.
+type Features struct {
+	// Version, Commit and Spec are reported by the runtime binary. Spec is
+	// the version of the OCI runtime spec implemented.
+	Version string
+	Commit  string
+	Spec    string
+	// Seccomp is true when the kernel supports seccomp filters.
+	Seccomp bool
+	// Libseccomp is the version of libseccomp the runtime was built with,
+	// when reported by the runtime.
+	Libseccomp string
+	// CgroupV2 is true when the host uses the unified cgroup hierarchy.
+	CgroupV2 bool
+	// Criu is the path of criu, empty when it is not installed.
+	Criu string
+}
+
+// FeatureDetector is implemented by executors that probe the features of
+// their runtime.
+type FeatureDetector interface {
+	Features() Features
+}
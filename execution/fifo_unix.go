@@ -0,0 +1,18 @@
+// +build !windows
+
+package execution
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// recreateFifo creates the removed stdio fifo at path again, along with its
+// directory.
+func recreateFifo(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return syscall.Mkfifo(path, 0700)
+}
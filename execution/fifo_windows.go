@@ -0,0 +1,7 @@
+package execution
+
+// recreateFifo fails, the stdio of processes being named pipes which are not
+// created again.
+func recreateFifo(path string) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,39 @@
+package execution
+
+import "context"
+
+// RuntimeFailure reports a process whose shim failed, by exiting or by not
+// responding, while the process itself may have survived.
+type RuntimeFailure struct {
+	ContainerID string
+	ProcessID   string
+	Reason      string
+	// Fenced is set when the processes of the container were killed as a
+	// result of the failure.
+	Fenced bool
+}
+
+// FailureReporter is implemented by executors that health check the shims
+// managing their processes.
+type FailureReporter interface {
+	// RuntimeFailures returns the channel the failures are reported on.
+	RuntimeFailures() <-chan RuntimeFailure
+}
+
+// mergeFailures forwards the failures reported by the executors to a single
+// channel.
+func mergeFailures(ctx context.Context, reporters []FailureReporter) <-chan RuntimeFailure {
+	out := make(chan RuntimeFailure, 64)
+	for _, r := range reporters {
+		go func(ch <-chan RuntimeFailure) {
+			for f := range ch {
+				select {
+				case out <- f:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(r.RuntimeFailures())
+	}
+	return out
+}
@@ -0,0 +1,181 @@
+package execution
+
+import (
+	"sort"
+	"sync"
+
+	api "github.com/docker/containerd/api/execution"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// containerIndex keeps the containers as they are listed, updated by the
+// requests and events along their lifecycle, for List not to query the
+// executor nor read the network of each container. An entry marked stale,
+// such as when the shim of its container failed, is loaded again from the
+// executor by the next List.
+type containerIndex struct {
+	mu      sync.RWMutex
+	entries map[string]*indexEntry
+}
+
+type indexEntry struct {
+	container api.Container
+	stale     bool
+}
+
+func newContainerIndex() *containerIndex {
+	return &containerIndex{
+		entries: make(map[string]*indexEntry),
+	}
+}
+
+// put adds the container to the index, or replaces its entry.
+func (i *containerIndex) put(c *Container) {
+	e := &indexEntry{container: *toGRPCContainer(c)}
+	i.mu.Lock()
+	i.entries[c.ID()] = e
+	i.mu.Unlock()
+}
+
+// update replaces the entry of the container, the containers deleted in the
+// meantime are not added back.
+func (i *containerIndex) update(c *Container) {
+	e := &indexEntry{container: *toGRPCContainer(c)}
+	i.mu.Lock()
+	if _, ok := i.entries[c.ID()]; ok {
+		i.entries[c.ID()] = e
+	}
+	i.mu.Unlock()
+}
+
+// setStatus sets the status of the container. A stopped container is not
+// set running again, its exit may be handled before its start returns.
+func (i *containerIndex) setStatus(id string, status Status) {
+	i.mu.Lock()
+	if e, ok := i.entries[id]; ok && e.container.Status != api.Status_STOPPED {
+		updated := *e
+		updated.container.Status = toGRPCStatus(status)
+		i.entries[id] = &updated
+	}
+	i.mu.Unlock()
+}
+
+func (i *containerIndex) markStale(id string) {
+	i.mu.Lock()
+	if e, ok := i.entries[id]; ok {
+		updated := *e
+		updated.stale = true
+		i.entries[id] = &updated
+	}
+	i.mu.Unlock()
+}
+
+func (i *containerIndex) remove(id string) {
+	i.mu.Lock()
+	delete(i.entries, id)
+	i.mu.Unlock()
+}
+
+// list returns the containers whose id matches, along with the ids of the
+// stale ones.
+func (i *containerIndex) list(match func(id string) bool) (containers []*api.Container, stale []string) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	for id, e := range i.entries {
+		if !match(id) {
+			continue
+		}
+		if e.stale {
+			stale = append(stale, id)
+			continue
+		}
+		c := e.container
+		containers = append(containers, &c)
+	}
+	return containers, stale
+}
+
+// get returns the containers of ids from the index, along with the ids of
+// the stale ones. The ids no longer indexed are skipped.
+func (i *containerIndex) get(ids []string) (containers []*api.Container, stale []string) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	for _, id := range ids {
+		e, ok := i.entries[id]
+		if !ok {
+			continue
+		}
+		if e.stale {
+			stale = append(stale, id)
+			continue
+		}
+		c := e.container
+		containers = append(containers, &c)
+	}
+	return containers, stale
+}
+
+// ids returns the sorted ids of the indexed containers which match.
+func (i *containerIndex) ids(match func(id string) bool) []string {
+	i.mu.RLock()
+	ids := make([]string, 0, len(i.entries))
+	for id := range i.entries {
+		if match(id) {
+			ids = append(ids, id)
+		}
+	}
+	i.mu.RUnlock()
+	sort.Strings(ids)
+	return ids
+}
+
+// listContainers returns the containers whose id matches from the index,
+// loading the stale ones from the executor.
+func (s *Service) listContainers(ctx context.Context, match func(id string) bool) ([]*api.Container, error) {
+	containers, stale := s.index.list(match)
+	return s.loadStale(ctx, containers, stale)
+}
+
+// listContainerBatches calls send with the containers whose id matches, n
+// at most at a time, for the whole index not to be copied at once.
+func (s *Service) listContainerBatches(ctx context.Context, match func(id string) bool, n int, send func([]*api.Container) error) error {
+	ids := s.index.ids(match)
+	for len(ids) > 0 {
+		batch := ids
+		if len(batch) > n {
+			batch = batch[:n]
+		}
+		ids = ids[len(batch):]
+		containers, stale := s.index.get(batch)
+		containers, err := s.loadStale(ctx, containers, stale)
+		if err != nil {
+			return err
+		}
+		if len(containers) == 0 {
+			continue
+		}
+		if err := send(containers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadStale appends the stale containers to containers, loading them from
+// the executor. The containers no longer found are removed from the index.
+func (s *Service) loadStale(ctx context.Context, containers []*api.Container, stale []string) ([]*api.Container, error) {
+	for _, id := range stale {
+		c, err := s.containers.load(ctx, s.executor, id)
+		if err != nil {
+			if errors.Cause(err) == ErrContainerNotFound {
+				s.index.remove(id)
+				continue
+			}
+			return nil, err
+		}
+		s.index.update(c)
+		containers = append(containers, toGRPCContainer(c))
+	}
+	return containers, nil
+}
@@ -0,0 +1,116 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	api "github.com/docker/containerd/api/execution"
+)
+
+// listingExecutor counts the containers listed and loaded.
+type listingExecutor struct {
+	*testExecutor
+	lists, loads int
+}
+
+func (e *listingExecutor) List(ctx context.Context) ([]*Container, error) {
+	e.lists++
+	return e.testExecutor.List(ctx)
+}
+
+func (e *listingExecutor) Load(ctx context.Context, id string) (*Container, error) {
+	e.loads++
+	return e.testExecutor.Load(ctx, id)
+}
+
+func TestContainerIndex(t *testing.T) {
+	s, testExecutor, cleanup := serviceEnv(t)
+	defer cleanup()
+	root := testExecutor.root
+	executor := &listingExecutor{testExecutor: testExecutor}
+	s.executor = executor
+	processes := make(map[string]*testProcess)
+	for _, id := range []string{"paused", "stopped", "failed", "deleted"} {
+		c, err := NewContainer(root, id, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		processes[id] = &testProcess{status: Running}
+		c.AddProcess(processes[id], true)
+		executor.containers[id] = c
+		s.index.put(c)
+	}
+	s.index.setStatus("paused", Paused)
+	s.index.setStatus("stopped", Stopped)
+	// the start of a container returns after its exit was handled
+	s.index.setStatus("stopped", Running)
+	s.index.markStale("failed")
+	processes["failed"].status = Stopped
+	s.index.markStale("deleted")
+	delete(executor.containers, "deleted")
+
+	containers, err := s.listContainers(context.Background(), func(string) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses := make(map[string]api.Status)
+	for _, c := range containers {
+		statuses[c.ID] = c.Status
+	}
+	for id, status := range map[string]api.Status{
+		"paused":  api.Status_PAUSED,
+		"stopped": api.Status_STOPPED,
+		"failed":  api.Status_STOPPED,
+	} {
+		if statuses[id] != status {
+			t.Errorf("expected %s to be listed %s, got %s", id, status, statuses[id])
+		}
+	}
+	if len(statuses) != 3 {
+		t.Errorf("expected the deleted container not to be listed, got %v", statuses)
+	}
+	if executor.lists != 0 || executor.loads != 2 {
+		t.Errorf("expected only the stale containers to be loaded, got %d lists and %d loads", executor.lists, executor.loads)
+	}
+
+	// the stale containers are loaded once
+	if _, err := s.listContainers(context.Background(), func(string) bool { return true }); err != nil {
+		t.Fatal(err)
+	}
+	if executor.loads != 2 {
+		t.Errorf("expected the refreshed containers to be listed from the index, got %d loads", executor.loads)
+	}
+}
+
+func TestListContainerBatches(t *testing.T) {
+	s, executor, cleanup := serviceEnv(t)
+	defer cleanup()
+	root := executor.root
+	for _, id := range []string{"e", "d", "c", "b", "a"} {
+		c, err := NewContainer(root, id, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.AddProcess(&testProcess{status: Running}, true)
+		s.index.put(c)
+	}
+	// the deleted containers are skipped without sending empty batches
+	s.index.markStale("c")
+	s.index.markStale("d")
+
+	var batches [][]string
+	if err := s.listContainerBatches(context.Background(), func(string) bool { return true }, 2, func(containers []*api.Container) error {
+		var ids []string
+		for _, c := range containers {
+			ids = append(ids, c.ID)
+		}
+		batches = append(batches, ids)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(batches) != "[[a b] [e]]" {
+		t.Fatalf("expected the containers to be sent in sorted batches of 2, got %v", batches)
+	}
+}
@@ -0,0 +1,67 @@
+package execution
+
+import "sync"
+
+// containerLocks serializes the requests changing a container, such as its
+// deletion, without those of a container blocking the requests of the
+// others. The execs of processes share the lock of their container, and the
+// requests only reading the containers do not lock them.
+type containerLocks struct {
+	mu    sync.Mutex
+	locks map[string]*containerLock
+}
+
+type containerLock struct {
+	sync.RWMutex
+	// refs is the number of requests holding or waiting for the lock, it
+	// is dropped once there are none.
+	refs int
+}
+
+func newContainerLocks() *containerLocks {
+	return &containerLocks{
+		locks: make(map[string]*containerLock),
+	}
+}
+
+// lock locks the container id, returning the func unlocking it.
+func (l *containerLocks) lock(id string) func() {
+	cl := l.get(id)
+	cl.Lock()
+	return func() {
+		cl.Unlock()
+		l.put(id, cl)
+	}
+}
+
+// rlock locks the container id for a request sharing it with the others
+// doing so, returning the func unlocking it.
+func (l *containerLocks) rlock(id string) func() {
+	cl := l.get(id)
+	cl.RLock()
+	return func() {
+		cl.RUnlock()
+		l.put(id, cl)
+	}
+}
+
+func (l *containerLocks) get(id string) *containerLock {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cl, ok := l.locks[id]
+	if !ok {
+		cl = &containerLock{}
+		l.locks[id] = cl
+	}
+	cl.refs++
+	return cl
+}
+
+func (l *containerLocks) put(id string, cl *containerLock) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cl.refs--
+	if cl.refs == 0 {
+		delete(l.locks, id)
+	}
+}
@@ -0,0 +1,47 @@
+package execution
+
+import (
+	"testing"
+	"time"
+)
+
+func TestContainerLocks(t *testing.T) {
+	l := newContainerLocks()
+
+	unlock := l.lock("deleted")
+	// the requests of the other containers are not blocked
+	done := make(chan struct{})
+	go func() {
+		l.lock("other")()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the lock of a container not to block the others")
+	}
+
+	// nor are the execs of a container by each other
+	runlock := l.rlock("exec")
+	l.rlock("exec")()
+	runlock()
+
+	locked := make(chan struct{})
+	go func() {
+		l.rlock("deleted")()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("expected the exec to wait for the container to be unlocked")
+	case <-time.After(10 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.locks) != 0 {
+		t.Fatalf("expected the unused locks to be dropped, got %d", len(l.locks))
+	}
+}
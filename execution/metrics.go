@@ -0,0 +1,33 @@
+package execution
+
+import metrics "github.com/docker/go-metrics"
+
+var (
+	restoreNamespace   = metrics.NewNamespace("containerd", "restore", nil)
+	restoredContainers = restoreNamespace.NewGauge("found", "The number of containers found on startup", metrics.Unit("containers"))
+	restoredProcesses  = restoreNamespace.NewGauge("monitored", "The number of processes monitored again on startup", metrics.Unit("processes"))
+	synthesizedExits   = restoreNamespace.NewGauge("synthesized", "The number of processes found exited on startup, whose exit events are published on restore", metrics.Unit("exits"))
+	lostStdio          = restoreNamespace.NewGauge("lost_stdio", "The number of stdio fifos of running processes found removed on startup which could not be reopened", metrics.Unit("fifos"))
+	restoreTimeouts    = restoreNamespace.NewGauge("timed_out", "The number of containers whose restore on startup timed out, left to finish in the background", metrics.Unit("containers"))
+	restoreDuration    = restoreNamespace.NewGauge("duration", "The time taken to restore the containers on startup", metrics.Seconds)
+)
+
+var (
+	watchdogNamespace = metrics.NewNamespace("containerd", "watchdog", nil)
+	monitorGoroutines = watchdogNamespace.NewGauge("monitor_goroutines", "The number of goroutines monitoring containers", metrics.Unit("goroutines"))
+	leakedGoroutines  = watchdogNamespace.NewGauge("leaked_goroutines", "The number of goroutines monitoring deleted containers", metrics.Unit("goroutines"))
+	leakedFifos       = watchdogNamespace.NewGauge("leaked_fifos", "The number of stdio fifos of deleted containers still open", metrics.Unit("fifos"))
+	openFDsGauge      = watchdogNamespace.NewLabeledGauge("open_fds", "The number of file descriptors open in the daemon by type", metrics.Unit("fds"), "type")
+)
+
+var (
+	cacheNamespace       = metrics.NewNamespace("containerd", "container_cache", nil)
+	containerCacheHits   = cacheNamespace.NewCounter("hits", "The number of containers of requests found in the cache")
+	containerCacheMisses = cacheNamespace.NewCounter("misses", "The number of containers of requests loaded from the executor")
+)
+
+func init() {
+	metrics.Register(restoreNamespace)
+	metrics.Register(watchdogNamespace)
+	metrics.Register(cacheNamespace)
+}
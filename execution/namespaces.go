@@ -0,0 +1,60 @@
+package execution
+
+import (
+	"context"
+	"strings"
+
+	"github.com/docker/containerd/namespaces"
+	"github.com/pkg/errors"
+)
+
+// namespaceSeparator separates the namespace from the id of the containers
+// and sandboxes of the namespaces other than the default one, in the ids
+// the executors know them by. Namespaces cannot contain it.
+const namespaceSeparator = "+"
+
+// scopedID returns the id the executors know the container or sandbox id
+// of the namespace of ctx by. The ids of the default namespace are left
+// unchanged, for the containers created before namespaces to remain in it,
+// so ids containing namespaceSeparator are rejected not to reach those of
+// another namespace.
+func scopedID(ctx context.Context, id string) (string, error) {
+	if err := validateID(id); err != nil {
+		return "", err
+	}
+	ns := namespaces.Namespace(ctx)
+	if ns == namespaces.Default || id == "" {
+		return id, nil
+	}
+	return ns + namespaceSeparator + id, nil
+}
+
+// splitID returns the namespace and the id of the namespace of an id
+// returned by scopedID.
+func splitID(scoped string) (namespace, id string) {
+	if i := strings.Index(scoped, namespaceSeparator); i >= 0 {
+		return scoped[:i], scoped[i+1:]
+	}
+	return namespaces.Default, scoped
+}
+
+// unscopedID returns the id of the container or sandbox in its namespace.
+func unscopedID(scoped string) string {
+	_, id := splitID(scoped)
+	return id
+}
+
+// inNamespace reports whether the scoped id belongs to the namespace of ctx.
+func inNamespace(ctx context.Context, scoped string) bool {
+	ns, _ := splitID(scoped)
+	return ns == namespaces.Namespace(ctx)
+}
+
+// validateID rejects the ids of containers and sandboxes which could not
+// be told apart from those of another namespace.
+func validateID(id string) error {
+	if strings.Contains(id, namespaceSeparator) {
+		return errors.Errorf("invalid id %q: %q is reserved", id, namespaceSeparator)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package execution
+
+import (
+	"context"
+	"reflect"
+	"syscall"
+	"testing"
+
+	api "github.com/docker/containerd/api/execution"
+	"github.com/docker/containerd/namespaces"
+)
+
+func TestScopedID(t *testing.T) {
+	for _, tc := range []struct {
+		namespace string
+		scoped    string
+	}{
+		{namespaces.Default, "web"},
+		{"k8s.io", "k8s.io+web"},
+	} {
+		ctx := namespaces.WithNamespace(context.Background(), tc.namespace)
+		scoped, err := scopedID(ctx, "web")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if scoped != tc.scoped {
+			t.Fatalf("expected %q in namespace %s, got %q", tc.scoped, tc.namespace, scoped)
+		}
+		ns, id := splitID(scoped)
+		if ns != tc.namespace || id != "web" {
+			t.Fatalf("expected %s/web, got %s/%s", tc.namespace, ns, id)
+		}
+		if !inNamespace(ctx, scoped) {
+			t.Fatalf("expected %q to be in namespace %s", scoped, tc.namespace)
+		}
+	}
+	if inNamespace(context.Background(), "k8s.io+web") {
+		t.Fatal("expected the container of another namespace not to be in the default one")
+	}
+	if _, err := scopedID(context.Background(), "k8s.io+web"); err == nil {
+		t.Fatal("expected the separator to be rejected in ids")
+	}
+}
+
+func TestCrossNamespaceAccess(t *testing.T) {
+	s, executor, cleanup := serviceEnv(t)
+	defer cleanup()
+	victim, err := NewContainer(executor.root, "k8s.io+victim", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	victim.AddProcess(&testProcess{status: Running}, true)
+	executor.containers[victim.ID()] = victim
+	s.executor = &testSandboxer{testExecutor: executor}
+
+	// the scoped id of a container of another namespace does not reach it
+	// from the default namespace
+	ctx := context.Background()
+	for name, call := range map[string]func() error{
+		"get": func() error {
+			_, err := s.Get(ctx, &api.GetContainerRequest{ID: victim.ID()})
+			return err
+		},
+		"delete": func() error {
+			_, err := s.Delete(ctx, &api.DeleteContainerRequest{ID: victim.ID()})
+			return err
+		},
+		"signal": func() error {
+			_, err := s.SignalProcess(ctx, &api.SignalProcessRequest{ContainerID: victim.ID(), ProcessID: "init", Signal: uint32(syscall.SIGKILL)})
+			return err
+		},
+		"kill sandbox": func() error {
+			_, err := s.KillSandbox(ctx, &api.KillSandboxRequest{ID: "k8s.io+pod", Signal: uint32(syscall.SIGKILL)})
+			return err
+		},
+		"join sandbox": func() error {
+			_, err := s.Create(ctx, &api.CreateContainerRequest{ID: "web", Sandbox: "k8s.io+pod"})
+			return err
+		},
+	} {
+		if err := call(); err == nil {
+			t.Errorf("%s: expected the id of another namespace to be rejected", name)
+		}
+	}
+	if p := victim.GetProcess("init").(*testProcess); len(p.received) > 0 {
+		t.Fatalf("expected the container of another namespace not to be signaled, got %v", p.received)
+	}
+	if _, ok := executor.containers[victim.ID()]; !ok {
+		t.Fatal("expected the container of another namespace not to be deleted")
+	}
+
+	resp, err := s.Get(namespaces.WithNamespace(ctx, "k8s.io"), &api.GetContainerRequest{ID: "victim"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Container.ID != "victim" {
+		t.Fatalf("expected the container in its namespace, got %q", resp.Container.ID)
+	}
+}
+
+func TestDumpStateNamespace(t *testing.T) {
+	s, executor, cleanup := serviceEnv(t)
+	defer cleanup()
+	for _, id := range []string{"web", "k8s.io+web", "k8s.io+db"} {
+		c, err := NewContainer(executor.root, id, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		executor.containers[id] = c
+	}
+
+	states, err := s.DumpState(namespaces.WithNamespace(context.Background(), "k8s.io"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, state := range states {
+		if state.Namespace != "k8s.io" {
+			t.Fatalf("expected the containers of the namespace only, got %+v", state)
+		}
+		ids = append(ids, state.ID)
+	}
+	if !reflect.DeepEqual(ids, []string{"db", "web"}) {
+		t.Fatalf("expected the containers db and web, got %v", ids)
+	}
+}
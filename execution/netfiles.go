@@ -0,0 +1,271 @@
+package execution
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/containerd/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	// networkFilesDirname is the directory of a container's state the
+	// managed files are written to, they are bind mounted from there.
+	networkFilesDirname = "etc"
+	networkFilesOptions = "options.json"
+)
+
+// HostResolvConf is the resolv.conf of the host, that of the containers
+// follows unless they set their own name servers.
+var HostResolvConf = "/etc/resolv.conf"
+
+// defaultNameservers are used when the host only lists loopback name servers,
+// which are not reachable from the network namespace of a container.
+var defaultNameservers = []string{"8.8.8.8", "8.8.4.4"}
+
+// NetworkFiles are the options the /etc/hostname, /etc/hosts and
+// /etc/resolv.conf files of a container are generated from. The files of the
+// bundle's rootfs are used when they are all unset.
+type NetworkFiles struct {
+	Hostname string `json:"hostname,omitempty"`
+	// ExtraHosts are added to /etc/hosts.
+	ExtraHosts []HostEntry `json:"extraHosts,omitempty"`
+	// DNSServers replace the name servers of the host's resolv.conf.
+	// DNSSearch and DNSOptions replace its search domains and options.
+	DNSServers []string `json:"dnsServers,omitempty"`
+	DNSSearch  []string `json:"dnsSearch,omitempty"`
+	DNSOptions []string `json:"dnsOptions,omitempty"`
+}
+
+// HostEntry is a line of /etc/hosts.
+type HostEntry struct {
+	IP    string   `json:"ip"`
+	Names []string `json:"names"`
+}
+
+func (n NetworkFiles) IsZero() bool {
+	return n.Hostname == "" && len(n.ExtraHosts) == 0 && len(n.DNSServers) == 0 && len(n.DNSSearch) == 0 && len(n.DNSOptions) == 0
+}
+
+// Validate checks the addresses of the options.
+func (n NetworkFiles) Validate() error {
+	for _, h := range n.ExtraHosts {
+		if net.ParseIP(h.IP) == nil {
+			return errors.Errorf("invalid address %q of extra host", h.IP)
+		}
+		if len(h.Names) == 0 {
+			return errors.Errorf("extra host %s without a name", h.IP)
+		}
+	}
+	for _, s := range n.DNSServers {
+		if net.ParseIP(s) == nil {
+			return errors.Errorf("invalid dns server %q", s)
+		}
+	}
+	return nil
+}
+
+// Hosts returns the content of /etc/hosts, resolving the hostname to ip when
+// both are set.
+func (n NetworkFiles) Hosts(ip string) []byte {
+	var b bytes.Buffer
+	b.WriteString("127.0.0.1\tlocalhost\n")
+	b.WriteString("::1\tlocalhost ip6-localhost ip6-loopback\n")
+	if ip != "" && n.Hostname != "" {
+		fmt.Fprintf(&b, "%s\t%s\n", ip, n.Hostname)
+	}
+	for _, h := range n.ExtraHosts {
+		fmt.Fprintf(&b, "%s\t%s\n", h.IP, strings.Join(h.Names, " "))
+	}
+	return b.Bytes()
+}
+
+// ResolvConf returns the content of /etc/resolv.conf, based on that of the
+// host. Loopback name servers of the host are left out.
+func (n NetworkFiles) ResolvConf(host []byte) []byte {
+	var nameservers, search, options []string
+	s := bufio.NewScanner(bytes.NewReader(host))
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "nameserver":
+			if ip := net.ParseIP(fields[1]); ip != nil && !ip.IsLoopback() {
+				nameservers = append(nameservers, fields[1])
+			}
+		case "search", "domain":
+			search = fields[1:]
+		case "options":
+			options = append(options, fields[1:]...)
+		}
+	}
+	if len(nameservers) == 0 {
+		nameservers = defaultNameservers
+	}
+	if len(n.DNSServers) > 0 {
+		nameservers = n.DNSServers
+	}
+	if len(n.DNSSearch) > 0 {
+		search = n.DNSSearch
+	}
+	if len(n.DNSOptions) > 0 {
+		options = n.DNSOptions
+	}
+	var b bytes.Buffer
+	for _, ns := range nameservers {
+		fmt.Fprintf(&b, "nameserver %s\n", ns)
+	}
+	if len(search) > 0 {
+		fmt.Fprintf(&b, "search %s\n", strings.Join(search, " "))
+	}
+	if len(options) > 0 {
+		fmt.Fprintf(&b, "options %s\n", strings.Join(options, " "))
+	}
+	return b.Bytes()
+}
+
+// NetworkFilePaths returns the paths of the managed files of the container,
+// keyed by their path in the container.
+func NetworkFilePaths(c *Container) map[string]string {
+	dir := filepath.Join(string(c.StateDir()), networkFilesDirname)
+	return map[string]string{
+		"/etc/hostname":    filepath.Join(dir, "hostname"),
+		"/etc/hosts":       filepath.Join(dir, "hosts"),
+		"/etc/resolv.conf": filepath.Join(dir, "resolv.conf"),
+	}
+}
+
+// WriteNetworkFiles generates the managed files of the container into its
+// state directory. The options are recorded along with them, for the files
+// to be generated again as the address of the container is known and as the
+// resolv.conf of the host changes.
+func WriteNetworkFiles(c *Container, n NetworkFiles) error {
+	dir := filepath.Join(string(c.StateDir()), networkFilesDirname)
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return errors.Wrap(err, "failed to create network files directory")
+	}
+	data, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, networkFilesOptions), data, 0600); err != nil {
+		return errors.Wrap(err, "failed to save network files options to disk")
+	}
+	host, err := ioutil.ReadFile(HostResolvConf)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to read the resolv.conf of the host")
+	}
+	paths := NetworkFilePaths(c)
+	for target, content := range map[string][]byte{
+		"/etc/hostname":    []byte(n.Hostname + "\n"),
+		"/etc/hosts":       n.Hosts(""),
+		"/etc/resolv.conf": n.ResolvConf(host),
+	} {
+		if err := ioutil.WriteFile(paths[target], content, 0644); err != nil {
+			return errors.Wrapf(err, "failed to write %s", target)
+		}
+	}
+	return nil
+}
+
+// readNetworkFiles returns the options the managed files of the container
+// were generated from, nil when it has none.
+func readNetworkFiles(c *Container) (*NetworkFiles, error) {
+	data, err := ioutil.ReadFile(filepath.Join(string(c.StateDir()), networkFilesDirname, networkFilesOptions))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var n NetworkFiles
+	if err := json.Unmarshal(data, &n); err != nil {
+		return nil, errors.Wrap(err, "invalid network files options")
+	}
+	return &n, nil
+}
+
+// rewriteNetworkFile replaces the content of a managed file in place, the
+// file being bind mounted into the container.
+func rewriteNetworkFile(path string, content []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_TRUNC, 0)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// resolvConfInterval is the interval the resolv.conf of the host is checked
+// for changes at.
+var resolvConfInterval = 5 * time.Second
+
+// setHostsAddress resolves the hostname of the container to its address in
+// the CNI network, once it is added to it.
+func setHostsAddress(c *Container, ip string) error {
+	n, err := readNetworkFiles(c)
+	if err != nil || n == nil || n.Hostname == "" {
+		return err
+	}
+	return rewriteNetworkFile(NetworkFilePaths(c)["/etc/hosts"], n.Hosts(ip))
+}
+
+// watchResolvConf generates the resolv.conf of the containers again when that
+// of the host changes, such as when the host moves to another network.
+func (s *Service) watchResolvConf(ctx context.Context) {
+	var last time.Time
+	if fi, err := os.Stat(HostResolvConf); err == nil {
+		last = fi.ModTime()
+	}
+	ticker := time.NewTicker(resolvConfInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		fi, err := os.Stat(HostResolvConf)
+		if err != nil || !fi.ModTime().After(last) {
+			continue
+		}
+		last = fi.ModTime()
+		host, err := ioutil.ReadFile(HostResolvConf)
+		if err != nil {
+			log.G(ctx).WithError(err).Warn("failed to read the resolv.conf of the host")
+			continue
+		}
+		containers, err := s.executor.List(ctx)
+		if err != nil {
+			log.G(ctx).WithError(err).Warn("failed to list containers to update their resolv.conf")
+			continue
+		}
+		for _, c := range containers {
+			if err := updateResolvConf(c, host); err != nil {
+				log.G(ctx).WithError(err).WithField("container", c.ID()).Warn("failed to update resolv.conf of container")
+			}
+		}
+	}
+}
+
+func updateResolvConf(c *Container, host []byte) error {
+	n, err := readNetworkFiles(c)
+	if err != nil || n == nil {
+		return err
+	}
+	return rewriteNetworkFile(NetworkFilePaths(c)["/etc/resolv.conf"], n.ResolvConf(host))
+}
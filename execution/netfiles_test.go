@@ -0,0 +1,62 @@
+package execution
+
+import "testing"
+
+func TestNetworkFilesResolvConf(t *testing.T) {
+	host := []byte(`# managed by the host
+nameserver 127.0.0.53
+nameserver 10.0.0.2
+search corp.example.com
+options edns0
+`)
+	for _, tc := range []struct {
+		name     string
+		n        NetworkFiles
+		host     []byte
+		expected string
+	}{
+		{
+			name:     "host",
+			host:     host,
+			expected: "nameserver 10.0.0.2\nsearch corp.example.com\noptions edns0\n",
+		},
+		{
+			name: "overrides",
+			n: NetworkFiles{
+				DNSServers: []string{"1.1.1.1"},
+				DNSSearch:  []string{"example.com"},
+			},
+			host:     host,
+			expected: "nameserver 1.1.1.1\nsearch example.com\noptions edns0\n",
+		},
+		{
+			name:     "loopback only",
+			host:     []byte("nameserver 127.0.0.53\n"),
+			expected: "nameserver 8.8.8.8\nnameserver 8.8.4.4\n",
+		},
+	} {
+		if actual := string(tc.n.ResolvConf(tc.host)); actual != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func TestNetworkFilesHosts(t *testing.T) {
+	n := NetworkFiles{
+		Hostname: "web",
+		ExtraHosts: []HostEntry{
+			{IP: "10.0.0.5", Names: []string{"db", "db.local"}},
+		},
+	}
+	expected := "127.0.0.1\tlocalhost\n::1\tlocalhost ip6-localhost ip6-loopback\n10.88.0.2\tweb\n10.0.0.5\tdb db.local\n"
+	if actual := string(n.Hosts("10.88.0.2")); actual != expected {
+		t.Fatalf("expected %q, got %q", expected, actual)
+	}
+	if err := n.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	n.DNSServers = []string{"dns.example.com"}
+	if err := n.Validate(); err == nil {
+		t.Fatal("expected an invalid dns server to be rejected")
+	}
+}
@@ -0,0 +1,195 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/containerd/cni"
+	"github.com/docker/containerd/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	cniResultFilename = "cni-result"
+	// cniInterface is the interface of the network in the container.
+	cniInterface = "eth0"
+)
+
+// setupNetwork adds the container to the CNI network of the daemon, unless it
+// shares the network namespace of the host or of another container of its
+// sandbox, or joins a network namespace of its creator. The result is
+// recorded with the container for its teardown.
+func (s *Service) setupNetwork(ctx context.Context, c *Container, init Process, o CreateOpts) error {
+	if s.network == nil || o.NetNSPath != "" {
+		return nil
+	}
+	if c.Sandbox() != "" {
+		containers, err := s.executor.List(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range SandboxContainers(containers, c.Sandbox()) {
+			if r, _ := networkResult(m); m.ID() != c.ID() && r != nil {
+				return nil
+			}
+		}
+	}
+	netns := fmt.Sprintf("/proc/%d/ns/net", init.Pid())
+	host, err := sameFile(netns, "/proc/self/ns/net")
+	if err != nil {
+		return errors.Wrap(err, "failed to inspect the network namespace of the container")
+	}
+	if host {
+		return nil
+	}
+	r, err := s.network.Setup(ctx, c.ID(), netns, cniInterface)
+	if err != nil {
+		return err
+	}
+	if err := writeNetworkResult(filepath.Join(string(c.StateDir()), cniResultFilename), r); err != nil {
+		s.network.Teardown(ctx, c.ID(), netns, cniInterface, r)
+		return err
+	}
+	return nil
+}
+
+// teardownNetwork removes the deleted container id from the CNI network it
+// was added to with the result r. Its network namespace is gone along with
+// its init process, the plugins release the resources of the container, such
+// as its addresses, regardless.
+func (s *Service) teardownNetwork(ctx context.Context, id string, r *cni.Result) error {
+	if s.network == nil {
+		return errors.New("no cni network configured")
+	}
+	return s.network.Teardown(ctx, id, "", cniInterface, r)
+}
+
+// replayNetwork tears down the attachments recorded by the CNI network for
+// the containers, network namespaces and sandboxes that are gone, such as
+// when the daemon stopped before their teardown completed, along with those
+// whose setup was interrupted. The plugins release their addresses.
+func (s *Service) replayNetwork(ctx context.Context) {
+	if s.network == nil || s.network.State == nil {
+		return
+	}
+	attachments, err := s.network.State.List()
+	if err != nil {
+		log.G(ctx).WithError(err).Error("failed to list cni attachments")
+		return
+	}
+	for _, a := range attachments {
+		if a.Result != nil && s.networkOwnerExists(ctx, a.ID) {
+			continue
+		}
+		netns := a.NetNS
+		if _, err := os.Stat(netns); err != nil {
+			netns = ""
+		}
+		if err := s.network.Teardown(ctx, a.ID, netns, a.IfName, a.Result); err != nil {
+			log.G(ctx).WithError(err).WithField("id", a.ID).Error("failed to tear down cni attachment")
+			continue
+		}
+		log.G(ctx).WithField("id", a.ID).Info("tore down stale cni attachment")
+	}
+}
+
+// networkOwnerExists returns whether the container, network namespace or
+// sandbox added to the CNI network as id still exists.
+func (s *Service) networkOwnerExists(ctx context.Context, id string) bool {
+	if _, err := s.containers.load(ctx, s.executor, id); err == nil {
+		return true
+	}
+	if name := strings.TrimPrefix(id, netnsID("")); name != id && s.netns != nil {
+		if _, err := s.netns.Path(name); err == nil {
+			return true
+		}
+	}
+	if sid := strings.TrimPrefix(id, sandboxNetworkID("")); sid != id && s.sandboxes != nil {
+		if _, err := s.sandboxes.Get(sid); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// recordedNetworkResult returns the result of the CNI network setup of id as
+// recorded by the network, nil when there is none.
+func (s *Service) recordedNetworkResult(id string) (*cni.Result, error) {
+	if s.network == nil || s.network.State == nil {
+		return nil, nil
+	}
+	a, err := s.network.State.Get(id)
+	if err != nil || a == nil {
+		return nil, err
+	}
+	return a.Result, nil
+}
+
+// networkResult returns the result of the CNI network setup of the container,
+// nil when it was not added to the network.
+func networkResult(c *Container) (*cni.Result, error) {
+	return readNetworkResult(filepath.Join(string(c.StateDir()), cniResultFilename))
+}
+
+func writeNetworkResult(path string, r *cni.Result) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return errors.Wrap(err, "failed to save cni result to disk")
+	}
+	return nil
+}
+
+func readNetworkResult(path string) (*cni.Result, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var r cni.Result
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, errors.Wrap(err, "invalid cni result")
+	}
+	return &r, nil
+}
+
+// sameFile returns whether the paths refer to the same file, such as the
+// same namespace.
+func sameFile(a, b string) (bool, error) {
+	sa, err := os.Stat(a)
+	if err != nil {
+		return false, err
+	}
+	sb, err := os.Stat(b)
+	if err != nil {
+		return false, err
+	}
+	return os.SameFile(sa, sb), nil
+}
+
+// networkPath returns the path of the network namespace a container joins,
+// network being the name of a namespace of the store or a path.
+func (s *Service) networkPath(network string) (string, error) {
+	if network == "" || filepath.IsAbs(network) {
+		return network, nil
+	}
+	if s.netns == nil {
+		return "", errors.Wrap(ErrNotSupported, "network namespaces")
+	}
+	return s.netns.Path(network)
+}
+
+// netnsID is the id the network namespace name is added to the CNI network
+// as, distinct from the ids of containers.
+func netnsID(name string) string {
+	return "netns-" + name
+}
@@ -0,0 +1,199 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+
+	api "github.com/docker/containerd/api/execution"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/portforward"
+	"github.com/pkg/errors"
+)
+
+// portsFilename records the ports forwarded to a container, for them to be
+// forwarded again once the daemon restarts.
+const portsFilename = "ports"
+
+// forwardPorts forwards the ports of the host to the container at ip, until
+// the container is deleted.
+func (s *Service) forwardPorts(ctx context.Context, c *Container, ip string, mappings []portforward.Mapping) error {
+	var forwards []*portforward.Forward
+	for _, m := range mappings {
+		f, err := portforward.Listen(m, ip)
+		if err != nil {
+			closeForwards(forwards)
+			return errors.Wrapf(err, "failed to forward port %d", m.HostPort)
+		}
+		forwards = append(forwards, f)
+	}
+	data, err := json.Marshal(forwards)
+	if err != nil {
+		closeForwards(forwards)
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(string(c.StateDir()), portsFilename), data, 0600); err != nil {
+		closeForwards(forwards)
+		return errors.Wrap(err, "failed to save forwarded ports to disk")
+	}
+	s.portsMu.Lock()
+	s.ports[c.ID()] = forwards
+	s.portsMu.Unlock()
+	log.G(ctx).WithField("container", c.ID()).WithField("ports", len(forwards)).Debug("forwarding ports")
+	return nil
+}
+
+// restorePorts forwards the ports recorded for the container again.
+func (s *Service) restorePorts(ctx context.Context, c *Container) {
+	data, err := ioutil.ReadFile(filepath.Join(string(c.StateDir()), portsFilename))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.G(ctx).WithError(err).WithField("container", c.ID()).Warn("failed to read the forwarded ports of the container")
+		}
+		return
+	}
+	var recorded []*portforward.Forward
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		log.G(ctx).WithError(err).WithField("container", c.ID()).Warn("invalid forwarded ports")
+		return
+	}
+	var forwards []*portforward.Forward
+	for _, r := range recorded {
+		f, err := portforward.Listen(r.Mapping, r.ContainerIP)
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("container", c.ID()).WithField("port", r.HostPort).Error("failed to forward port")
+			continue
+		}
+		forwards = append(forwards, f)
+	}
+	s.portsMu.Lock()
+	s.ports[c.ID()] = forwards
+	s.portsMu.Unlock()
+}
+
+// closePorts stops forwarding the ports of the container id.
+func (s *Service) closePorts(id string) {
+	s.portsMu.Lock()
+	forwards := s.ports[id]
+	delete(s.ports, id)
+	s.portsMu.Unlock()
+	closeForwards(forwards)
+}
+
+func closeForwards(forwards []*portforward.Forward) {
+	for _, f := range forwards {
+		f.Close()
+	}
+}
+
+// containerIP returns the address the ports of the container are forwarded
+// to, that of its network namespace in the CNI network. The container may have
+// joined the named network namespace network, or share the namespace of
+// the pause process or of another container of its sandbox.
+func (s *Service) containerIP(ctx context.Context, c *Container, network string) (string, error) {
+	r, err := s.pauseSandboxResult(c.Sandbox())
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case r != nil:
+	case network != "" && !filepath.IsAbs(network) && s.netns != nil:
+		r, err = readNetworkResult(filepath.Join(s.netns.Dir(network), cniResultFilename))
+	case network == "":
+		r, err = networkResult(c)
+		if err == nil && r == nil && c.Sandbox() != "" {
+			var containers []*Container
+			if containers, err = s.executor.List(ctx); err != nil {
+				return "", err
+			}
+			for _, m := range SandboxContainers(containers, c.Sandbox()) {
+				if r, _ = networkResult(m); r != nil {
+					break
+				}
+			}
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	if r != nil {
+		for _, addr := range r.IPs {
+			if ip, _, err := net.ParseCIDR(addr); err == nil {
+				return ip.String(), nil
+			}
+			if ip := net.ParseIP(addr); ip != nil {
+				return ip.String(), nil
+			}
+		}
+	}
+	return "", errors.New("the container has no address in the cni network to forward ports to")
+}
+
+func (s *Service) listPorts(ctx context.Context, id string) []*api.ForwardedPort {
+	s.portsMu.Lock()
+	defer s.portsMu.Unlock()
+	var out []*api.ForwardedPort
+	for cid, forwards := range s.ports {
+		if !inNamespace(ctx, cid) || id != "" && unscopedID(cid) != id {
+			continue
+		}
+		for _, f := range forwards {
+			out = append(out, &api.ForwardedPort{
+				ContainerID: unscopedID(cid),
+				Mapping:     toGRPCPortMapping(f.Mapping),
+				ContainerIP: f.ContainerIP,
+			})
+		}
+	}
+	sort.Sort(byContainerPort(out))
+	return out
+}
+
+func fromGRPCPortMappings(ports []*api.PortMapping) ([]portforward.Mapping, error) {
+	var out []portforward.Mapping
+	for _, p := range ports {
+		switch p.Protocol {
+		case "", portforward.TCP, portforward.UDP:
+		default:
+			return nil, errors.Errorf("unknown protocol %q of port %d", p.Protocol, p.HostPort)
+		}
+		if p.HostPort == 0 || p.HostPort > 65535 || p.ContainerPort == 0 || p.ContainerPort > 65535 {
+			return nil, errors.Errorf("invalid port mapping %d:%d", p.HostPort, p.ContainerPort)
+		}
+		if p.HostIP != "" && net.ParseIP(p.HostIP) == nil {
+			return nil, errors.Errorf("invalid host ip %q", p.HostIP)
+		}
+		out = append(out, portforward.Mapping{
+			Protocol:      p.Protocol,
+			HostIP:        p.HostIP,
+			HostPort:      uint16(p.HostPort),
+			ContainerPort: uint16(p.ContainerPort),
+		})
+	}
+	return out, nil
+}
+
+func toGRPCPortMapping(m portforward.Mapping) *api.PortMapping {
+	return &api.PortMapping{
+		Protocol:      m.Protocol,
+		HostIP:        m.HostIP,
+		HostPort:      uint32(m.HostPort),
+		ContainerPort: uint32(m.ContainerPort),
+	}
+}
+
+// byContainerPort sorts the forwarded ports by container, then host port.
+type byContainerPort []*api.ForwardedPort
+
+func (b byContainerPort) Len() int { return len(b) }
+func (b byContainerPort) Less(i, j int) bool {
+	if b[i].ContainerID != b[j].ContainerID {
+		return b[i].ContainerID < b[j].ContainerID
+	}
+	return b[i].Mapping.HostPort < b[j].Mapping.HostPort
+}
+func (b byContainerPort) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
@@ -10,3 +10,31 @@ type Process interface {
 	Signal(os.Signal) error
 	Status() Status
 }
+
+// ExitStatusReader is implemented by processes reporting their exit status
+// once they are stopped.
+type ExitStatusReader interface {
+	ExitStatus() (uint32, error)
+}
+
+// ExitNotifier is implemented by the processes whose exits are detected by
+// their executor on a loop watching all of its processes, for no goroutine
+// to be blocked in Wait for each process.
+type ExitNotifier interface {
+	// NotifyExit calls fn once the process exited, Wait then returning
+	// without blocking. fn is called from the loop of the executor and must
+	// not block.
+	NotifyExit(fn func()) error
+}
+
+// Resizer is implemented by processes whose console can be resized.
+type Resizer interface {
+	Resize(width, height uint32) error
+}
+
+// StdioReopener is implemented by processes whose output is copied to their
+// stdout and stderr fifos by a shim, which reopens the fifos once they were
+// created again.
+type StdioReopener interface {
+	ReopenStdio() error
+}
@@ -0,0 +1,104 @@
+package execution
+
+import (
+	"sync"
+	"time"
+
+	"github.com/docker/containerd/log"
+	"golang.org/x/net/context"
+)
+
+const (
+	// maxConcurrentRestores bounds the containers restored at once on
+	// startup.
+	maxConcurrentRestores = 32
+	// restoreTimeout bounds the time startup waits for the restore of a
+	// container, a container whose shim is slow to answer being left to
+	// finish its restore in the background.
+	restoreTimeout = 10 * time.Second
+)
+
+// restoreCounts are the counts of the restore of the containers found on
+// startup.
+type restoreCounts struct {
+	processes int
+	exited    int
+	lost      int
+	timedOut  int
+}
+
+func (c *restoreCounts) add(o restoreCounts) {
+	c.processes += o.processes
+	c.exited += o.exited
+	c.lost += o.lost
+	c.timedOut += o.timedOut
+}
+
+// restoreContainers restores the containers found on startup, at most
+// maxConcurrentRestores at once, waiting at most timeout for each of them.
+func (s *Service) restoreContainers(ctx context.Context, containers []*Container, timeout time.Duration) restoreCounts {
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		counts restoreCounts
+		sem    = make(chan struct{}, maxConcurrentRestores)
+	)
+	for _, c := range containers {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(c *Container) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			n := s.restoreContainer(ctx, c, timeout)
+			mu.Lock()
+			counts.add(n)
+			mu.Unlock()
+		}(c)
+	}
+	wg.Wait()
+	return counts
+}
+
+// restoreContainer monitors the processes of the container c again, and
+// restores its stdio, oom events and forwarded ports once the executor
+// answers, for at most timeout. The monitors outlive the restore and publish
+// with ctx.
+func (s *Service) restoreContainer(ctx context.Context, c *Container, timeout time.Duration) restoreCounts {
+	var counts restoreCounts
+	for _, p := range c.Processes() {
+		if p.Status() == Stopped {
+			counts.exited++
+		}
+		counts.processes++
+		s.monitorProcess(ctx, c, p)
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	lost := make(chan int, 1)
+	go func() {
+		n := s.restoreStdio(ctx, c)
+		if c.Status() != Stopped {
+			s.monitorOOM(ctx, c)
+		}
+		s.restorePorts(ctx, c)
+		lost <- n
+	}()
+	select {
+	case counts.lost = <-lost:
+	case <-tctx.Done():
+		counts.timedOut++
+		log.G(ctx).WithField("container", c.ID()).Warn("timed out restoring the container, its restore goes on in the background")
+		// the fifos the restore finds lost once startup is done are
+		// still counted
+		go func() {
+			if n := <-lost; n > 0 {
+				lostStdio.Add(float64(n))
+				log.G(ctx).WithField("container", c.ID()).WithField("lost-stdio", n).Warn("restored the container in the background")
+			}
+		}()
+	}
+	return counts
+}
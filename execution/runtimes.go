@@ -0,0 +1,408 @@
+package execution
+
+import (
+	"context"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/docker/containerd/cgroups"
+	"github.com/docker/containerd/tracing"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// DefaultRuntime is the name the daemon's default runtime is registered as.
+const DefaultRuntime = "runc"
+
+// Capabilities describe the operations a runtime supports. Sandboxed
+// runtimes, such as those running containers in a VM or a user space kernel,
+// may not implement all of them.
+type Capabilities struct {
+	Pause bool
+	Exec  bool
+	Stats bool
+}
+
+// FullCapabilities are the capabilities of runc.
+var FullCapabilities = Capabilities{
+	Pause: true,
+	Exec:  true,
+	Stats: true,
+}
+
+// Runtime is an executor registered under a name.
+type Runtime struct {
+	Name         string
+	Executor     Executor
+	Capabilities Capabilities
+	// SpecDefaults are applied to the specs of the runtime's containers.
+	SpecDefaults SpecDefaults
+	// Admission restricts the specs of the runtime's containers.
+	Admission *AdmissionPolicy
+}
+
+// Runtimes is an Executor dispatching each container to the runtime it was
+// created with, allowing trusted and sandboxed workloads to run side by side.
+type Runtimes struct {
+	defaultRuntime string
+	runtimes       map[string]Runtime
+
+	// mu guards the runtime of each container, it is only held to look it
+	// up and record it, never across the requests of the runtimes.
+	mu         sync.RWMutex
+	containers map[string]string
+
+	failures <-chan RuntimeFailure
+}
+
+// NewRuntimes returns an executor for the provided runtimes, restoring the
+// containers each of them already manages.
+func NewRuntimes(ctx context.Context, defaultRuntime string, runtimes ...Runtime) (*Runtimes, error) {
+	r := &Runtimes{
+		defaultRuntime: defaultRuntime,
+		runtimes:       make(map[string]Runtime),
+		containers:     make(map[string]string),
+	}
+	var reporters []FailureReporter
+	for _, rt := range runtimes {
+		if reporter, ok := rt.Executor.(FailureReporter); ok {
+			reporters = append(reporters, reporter)
+		}
+		if _, ok := r.runtimes[rt.Name]; ok {
+			return nil, errors.Errorf("runtime %q registered twice", rt.Name)
+		}
+		r.runtimes[rt.Name] = rt
+		containers, err := rt.Executor.List(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list containers of runtime %q", rt.Name)
+		}
+		for _, c := range containers {
+			r.containers[c.ID()] = rt.Name
+		}
+	}
+	if _, ok := r.runtimes[defaultRuntime]; !ok {
+		return nil, errors.Wrapf(ErrRuntimeNotFound, "default runtime %q", defaultRuntime)
+	}
+	r.failures = mergeFailures(ctx, reporters)
+	return r, nil
+}
+
+// Runtime returns the runtime registered as name.
+func (r *Runtimes) Runtime(name string) (Runtime, error) {
+	if name == "" {
+		name = r.defaultRuntime
+	}
+	rt, ok := r.runtimes[name]
+	if !ok {
+		return Runtime{}, errors.Wrapf(ErrRuntimeNotFound, "%q", name)
+	}
+	return rt, nil
+}
+
+// Default returns the name of the default runtime.
+func (r *Runtimes) Default() string {
+	return r.defaultRuntime
+}
+
+// Names returns the names of the registered runtimes.
+func (r *Runtimes) Names() []string {
+	var names []string
+	for name := range r.runtimes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RuntimeOf returns the runtime managing the container with id.
+func (r *Runtimes) RuntimeOf(id string) (Runtime, error) {
+	r.mu.RLock()
+	name, ok := r.containers[id]
+	r.mu.RUnlock()
+	if !ok {
+		return Runtime{}, ErrContainerNotFound
+	}
+	return r.runtimes[name], nil
+}
+
+// RuntimeFailures returns the failures reported by all the runtimes.
+func (r *Runtimes) RuntimeFailures() <-chan RuntimeFailure {
+	return r.failures
+}
+
+func (r *Runtimes) Create(ctx context.Context, id string, o CreateOpts) (*Container, error) {
+	rt, err := r.Runtime(o.Runtime)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	if _, ok := r.containers[id]; ok {
+		r.mu.Unlock()
+		return nil, ErrContainerExists
+	}
+	// reserve the id while the runtime creates the container
+	r.containers[id] = rt.Name
+	r.mu.Unlock()
+
+	c, err := r.create(ctx, rt, id, o)
+	if err != nil {
+		r.mu.Lock()
+		delete(r.containers, id)
+		r.mu.Unlock()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *Runtimes) create(ctx context.Context, rt Runtime, id string, o CreateOpts) (*Container, error) {
+	if o.Sandbox != "" {
+		// the containers of a sandbox share namespaces and must be managed
+		// by the same runtime.
+		srt, err := r.sandboxRuntime(ctx, o.Sandbox)
+		switch {
+		case err == nil && srt.Name != rt.Name:
+			return nil, errors.Errorf("sandbox %q runs on runtime %q", o.Sandbox, srt.Name)
+		case err != nil && errors.Cause(err) != ErrSandboxNotFound:
+			return nil, err
+		}
+		if _, ok := rt.Executor.(Sandboxer); !ok {
+			return nil, errors.Wrapf(ErrNotSupported, "%s: sandboxes", rt.Name)
+		}
+	}
+	if span := tracing.FromContext(ctx); span != nil {
+		span.SetTag("runtime", rt.Name)
+	}
+	o.SpecDefaults = rt.SpecDefaults
+	o.Admission = rt.Admission
+	return rt.Executor.Create(ctx, id, o)
+}
+
+// sandboxRuntime returns the runtime managing the containers of the sandbox
+// id.
+func (r *Runtimes) sandboxRuntime(ctx context.Context, id string) (Runtime, error) {
+	containers, err := r.List(ctx)
+	if err != nil {
+		return Runtime{}, err
+	}
+	members := SandboxContainers(containers, id)
+	if len(members) == 0 {
+		return Runtime{}, errors.Wrapf(ErrSandboxNotFound, "%q", id)
+	}
+	return r.RuntimeOf(members[0].ID())
+}
+
+func (r *Runtimes) Start(ctx context.Context, c *Container) error {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return err
+	}
+	return rt.Executor.Start(ctx, c)
+}
+
+func (r *Runtimes) Pause(ctx context.Context, c *Container) error {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return err
+	}
+	if !rt.Capabilities.Pause {
+		return errors.Wrapf(ErrNotSupported, "%s: pause", rt.Name)
+	}
+	return rt.Executor.Pause(ctx, c)
+}
+
+func (r *Runtimes) Resume(ctx context.Context, c *Container) error {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return err
+	}
+	if !rt.Capabilities.Pause {
+		return errors.Wrapf(ErrNotSupported, "%s: resume", rt.Name)
+	}
+	return rt.Executor.Resume(ctx, c)
+}
+
+func (r *Runtimes) List(ctx context.Context) ([]*Container, error) {
+	var out []*Container
+	for _, name := range r.Names() {
+		containers, err := r.runtimes[name].Executor.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, containers...)
+	}
+	return out, nil
+}
+
+func (r *Runtimes) Load(ctx context.Context, id string) (*Container, error) {
+	rt, err := r.RuntimeOf(id)
+	if err != nil {
+		return nil, err
+	}
+	return rt.Executor.Load(ctx, id)
+}
+
+func (r *Runtimes) Delete(ctx context.Context, c *Container) error {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return err
+	}
+	if err := rt.Executor.Delete(ctx, c); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	delete(r.containers, c.ID())
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *Runtimes) StartProcess(ctx context.Context, c *Container, o StartProcessOpts) (Process, error) {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return nil, err
+	}
+	if !rt.Capabilities.Exec {
+		return nil, errors.Wrapf(ErrNotSupported, "%s: exec", rt.Name)
+	}
+	return rt.Executor.StartProcess(ctx, c, o)
+}
+
+func (r *Runtimes) SignalProcess(ctx context.Context, c *Container, id string, sig os.Signal) error {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return err
+	}
+	return rt.Executor.SignalProcess(ctx, c, id, sig)
+}
+
+func (r *Runtimes) DeleteProcess(ctx context.Context, c *Container, id string) error {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return err
+	}
+	return rt.Executor.DeleteProcess(ctx, c, id)
+}
+
+func (r *Runtimes) RuntimeLogs(ctx context.Context, c *Container, id string) ([]RuntimeLog, error) {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return nil, err
+	}
+	logger, ok := rt.Executor.(RuntimeLogger)
+	if !ok {
+		return nil, errors.Wrapf(ErrNotSupported, "%s: runtime logs", rt.Name)
+	}
+	return logger.RuntimeLogs(ctx, c, id)
+}
+
+func (r *Runtimes) Update(ctx context.Context, c *Container, resources *specs.LinuxResources) error {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return err
+	}
+	updater, ok := rt.Executor.(Updater)
+	if !ok {
+		return errors.Wrapf(ErrNotSupported, "%s: update", rt.Name)
+	}
+	return updater.Update(ctx, c, resources)
+}
+
+func (r *Runtimes) Checkpoint(ctx context.Context, c *Container, o CheckpointOpts) error {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return err
+	}
+	checkpointer, ok := rt.Executor.(Checkpointer)
+	if !ok {
+		return errors.Wrapf(ErrNotSupported, "%s: checkpoint", rt.Name)
+	}
+	return checkpointer.Checkpoint(ctx, c, o)
+}
+
+func (r *Runtimes) WatchOOM(ctx context.Context, c *Container) (<-chan uint64, error) {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return nil, err
+	}
+	watcher, ok := rt.Executor.(OOMWatcher)
+	if !ok {
+		return nil, errors.Wrapf(ErrNotSupported, "%s: oom events", rt.Name)
+	}
+	return watcher.WatchOOM(ctx, c)
+}
+
+func (r *Runtimes) Stats(ctx context.Context, c *Container) (*cgroups.Metrics, error) {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return nil, err
+	}
+	reader, ok := rt.Executor.(StatsReader)
+	if !rt.Capabilities.Stats || !ok {
+		return nil, errors.Wrapf(ErrNotSupported, "%s: stats", rt.Name)
+	}
+	return reader.Stats(ctx, c)
+}
+
+func (r *Runtimes) Pids(ctx context.Context, c *Container) ([]int, error) {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return nil, err
+	}
+	lister, ok := rt.Executor.(PidLister)
+	if !ok {
+		return nil, errors.Wrapf(ErrNotSupported, "%s: pids", rt.Name)
+	}
+	return lister.Pids(ctx, c)
+}
+
+func (r *Runtimes) Inspect(ctx context.Context, c *Container) (*ContainerInfo, error) {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return nil, err
+	}
+	inspector, ok := rt.Executor.(Inspector)
+	if !ok {
+		return nil, errors.Wrapf(ErrNotSupported, "%s: inspect", rt.Name)
+	}
+	return inspector.Inspect(ctx, c)
+}
+
+func (r *Runtimes) KillAll(ctx context.Context, c *Container, sig os.Signal) error {
+	rt, err := r.RuntimeOf(c.ID())
+	if err != nil {
+		return err
+	}
+	killer, ok := rt.Executor.(Killer)
+	if !ok {
+		return errors.Wrapf(ErrNotSupported, "%s: kill all", rt.Name)
+	}
+	return killer.KillAll(ctx, c, sig)
+}
+
+func (r *Runtimes) sandboxer(ctx context.Context, id string) (Sandboxer, error) {
+	rt, err := r.sandboxRuntime(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	sandboxer, ok := rt.Executor.(Sandboxer)
+	if !ok {
+		return nil, errors.Wrapf(ErrNotSupported, "%s: sandboxes", rt.Name)
+	}
+	return sandboxer, nil
+}
+
+func (r *Runtimes) KillSandbox(ctx context.Context, id string, sig os.Signal) error {
+	sandboxer, err := r.sandboxer(ctx, id)
+	if err != nil {
+		return err
+	}
+	return sandboxer.KillSandbox(ctx, id, sig)
+}
+
+func (r *Runtimes) SandboxStats(ctx context.Context, id string) (*cgroups.Metrics, error) {
+	sandboxer, err := r.sandboxer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return sandboxer.SandboxStats(ctx, id)
+}
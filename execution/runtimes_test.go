@@ -0,0 +1,179 @@
+package execution
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/docker/containerd/cgroups"
+	"github.com/pkg/errors"
+)
+
+type testExecutor struct {
+	Executor
+	root       string
+	containers map[string]*Container
+}
+
+func (e *testExecutor) Create(ctx context.Context, id string, o CreateOpts) (*Container, error) {
+	c, err := NewContainer(e.root, id, o.Bundle)
+	if err != nil {
+		return nil, err
+	}
+	c.SetSandbox(o.Sandbox)
+	e.containers[id] = c
+	return c, nil
+}
+
+func (e *testExecutor) List(ctx context.Context) ([]*Container, error) {
+	var out []*Container
+	for _, c := range e.containers {
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func (e *testExecutor) Load(ctx context.Context, id string) (*Container, error) {
+	c, ok := e.containers[id]
+	if !ok {
+		return nil, ErrContainerNotFound
+	}
+	return c, nil
+}
+
+func (e *testExecutor) Pause(ctx context.Context, c *Container) error {
+	return nil
+}
+
+type testSandboxer struct {
+	*testExecutor
+	killed []string
+}
+
+func (e *testSandboxer) KillSandbox(ctx context.Context, id string, sig os.Signal) error {
+	e.killed = append(e.killed, id)
+	return nil
+}
+
+func (e *testSandboxer) SandboxStats(ctx context.Context, id string) (*cgroups.Metrics, error) {
+	return &cgroups.Metrics{}, nil
+}
+
+func runtimesEnv(t *testing.T) (map[string]*testExecutor, func()) {
+	tmpdir, err := ioutil.TempDir("", "execution-runtimes-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	executors := make(map[string]*testExecutor)
+	for _, name := range []string{DefaultRuntime, "sandbox"} {
+		executors[name] = &testExecutor{
+			root:       tmpdir,
+			containers: make(map[string]*Container),
+		}
+	}
+	return executors, func() {
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func TestRuntimes(t *testing.T) {
+	executors, cleanup := runtimesEnv(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	runtimes, err := NewRuntimes(ctx, DefaultRuntime,
+		Runtime{Name: DefaultRuntime, Executor: executors[DefaultRuntime], Capabilities: FullCapabilities},
+		Runtime{Name: "sandbox", Executor: executors["sandbox"], Capabilities: Capabilities{Exec: true}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trusted, err := runtimes.Create(ctx, "trusted", CreateOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	untrusted, err := runtimes.Create(ctx, "untrusted", CreateOpts{Runtime: "sandbox"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := executors["sandbox"].containers["untrusted"]; !ok {
+		t.Fatal("expected container to be created by the sandbox runtime")
+	}
+	if _, err := runtimes.Create(ctx, "trusted", CreateOpts{Runtime: "sandbox"}); err != ErrContainerExists {
+		t.Fatalf("expected duplicate id across runtimes to fail, got %v", err)
+	}
+	if _, err := runtimes.Create(ctx, "other", CreateOpts{Runtime: "missing"}); errors.Cause(err) != ErrRuntimeNotFound {
+		t.Fatalf("expected unknown runtime to fail, got %v", err)
+	}
+
+	if err := runtimes.Pause(ctx, trusted); err != nil {
+		t.Fatal(err)
+	}
+	if err := runtimes.Pause(ctx, untrusted); errors.Cause(err) != ErrNotSupported {
+		t.Fatalf("expected pause to be unsupported by the sandbox, got %v", err)
+	}
+
+	containers, err := runtimes.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(containers) != 2 {
+		t.Fatalf("expected containers of all runtimes, got %d", len(containers))
+	}
+
+	// containers are restored to the runtime that manages them
+	restored, err := NewRuntimes(ctx, DefaultRuntime,
+		Runtime{Name: DefaultRuntime, Executor: executors[DefaultRuntime], Capabilities: FullCapabilities},
+		Runtime{Name: "sandbox", Executor: executors["sandbox"], Capabilities: Capabilities{Exec: true}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt, err := restored.RuntimeOf("untrusted")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rt.Name != "sandbox" {
+		t.Fatalf("unexpected runtime %q for restored container", rt.Name)
+	}
+}
+
+func TestRuntimesSandbox(t *testing.T) {
+	executors, cleanup := runtimesEnv(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	sandboxer := &testSandboxer{testExecutor: executors[DefaultRuntime]}
+	runtimes, err := NewRuntimes(ctx, DefaultRuntime,
+		Runtime{Name: DefaultRuntime, Executor: sandboxer, Capabilities: FullCapabilities},
+		Runtime{Name: "sandbox", Executor: executors["sandbox"], Capabilities: Capabilities{Exec: true}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"pause", "app"} {
+		if _, err := runtimes.Create(ctx, id, CreateOpts{Sandbox: "pod"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := runtimes.Create(ctx, "other", CreateOpts{Runtime: "sandbox", Sandbox: "pod"}); err == nil {
+		t.Fatal("expected joining a sandbox with another runtime to fail")
+	}
+	if _, err := runtimes.Create(ctx, "other", CreateOpts{Runtime: "sandbox", Sandbox: "other"}); errors.Cause(err) != ErrNotSupported {
+		t.Fatalf("expected sandboxes to be unsupported by the runtime, got %v", err)
+	}
+
+	if err := runtimes.KillSandbox(ctx, "pod", syscall.SIGKILL); err != nil {
+		t.Fatal(err)
+	}
+	if len(sandboxer.killed) != 1 || sandboxer.killed[0] != "pod" {
+		t.Fatalf("unexpected sandboxes killed %v", sandboxer.killed)
+	}
+	if err := runtimes.KillSandbox(ctx, "missing", syscall.SIGKILL); errors.Cause(err) != ErrSandboxNotFound {
+		t.Fatalf("expected missing sandbox to fail, got %v", err)
+	}
+}
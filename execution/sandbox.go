@@ -0,0 +1,127 @@
+package execution
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	api "github.com/docker/containerd/api/execution"
+	"github.com/docker/containerd/cgroups"
+	"github.com/docker/containerd/cni"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/sandbox"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// Sandboxer is implemented by executors grouping containers into sandboxes,
+// such as the containers of a kubernetes pod. The containers of a sandbox
+// share a network namespace and a cgroup parent, so they are signaled and
+// accounted for as a group.
+type Sandboxer interface {
+	// KillSandbox sends sig to all the processes of the sandbox.
+	KillSandbox(ctx context.Context, id string, sig os.Signal) error
+	// SandboxStats returns the resource usage of the sandbox's cgroup
+	// parent.
+	SandboxStats(ctx context.Context, id string) (*cgroups.Metrics, error)
+}
+
+// SandboxContainers returns the containers in the sandbox id.
+func SandboxContainers(containers []*Container, id string) []*Container {
+	var out []*Container
+	for _, c := range containers {
+		if c.Sandbox() == id {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// joinPauseSandbox joins the container to the namespaces of the pause process
+// of its sandbox, when the sandbox was created with one. The network of the
+// sandbox is that of the container.
+func (s *Service) joinPauseSandbox(o *CreateOpts) error {
+	if o.Sandbox == "" || s.sandboxes == nil {
+		return nil
+	}
+	sb, err := s.sandboxes.Get(o.Sandbox)
+	if err != nil {
+		if errors.Cause(err) == sandbox.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	if !sb.Running() {
+		return errors.Wrapf(sandbox.ErrNotRunning, "%q", sb.ID)
+	}
+	if o.NetNSPath != "" {
+		return errors.New("the containers of a sandbox with a pause process join its network")
+	}
+	if o.NetworkFiles.Hostname != "" {
+		return errors.New("the hostname of a container is that of its sandbox")
+	}
+	o.NetNSPath = sb.NamespacePath("net")
+	o.Namespaces = []specs.LinuxNamespace{
+		{Type: specs.IPCNamespace, Path: sb.NamespacePath("ipc")},
+		{Type: specs.UTSNamespace, Path: sb.NamespacePath("uts")},
+	}
+	return nil
+}
+
+// pauseSandboxResult returns the result of the CNI network setup of the pause
+// sandbox id, nil when there is no such sandbox or it is not in the network.
+func (s *Service) pauseSandboxResult(id string) (*cni.Result, error) {
+	if id == "" || s.sandboxes == nil {
+		return nil, nil
+	}
+	if _, err := s.sandboxes.Get(id); err != nil {
+		return nil, nil
+	}
+	return readNetworkResult(filepath.Join(s.sandboxes.Dir(id), cniResultFilename))
+}
+
+// sandboxNetworkID is the id the sandbox id is added to the CNI network as,
+// distinct from the ids of containers.
+func sandboxNetworkID(id string) string {
+	return "sandbox-" + id
+}
+
+// monitorSandbox publishes the exit of the pause process of the sandbox.
+func (s *Service) monitorSandbox(ctx context.Context, sb *sandbox.Sandbox) {
+	go func() {
+		<-sb.Done()
+		log.G(ctx).WithField("sandbox", sb.ID).Debug("pause process exited")
+		s.publishSandboxEvent(ctx, sb.ID, "exit")
+	}()
+}
+
+func (s *Service) publishSandboxEvent(ctx context.Context, id, action string) {
+	ns, sid := splitID(id)
+	s.publishEvent(ctx, id, GetSandboxEventTopic(id), &SandboxEvent{
+		Timestamp: time.Now(),
+		Namespace: ns,
+		ID:        sid,
+		Action:    action,
+	})
+}
+
+func (s *Service) toGRPCSandbox(ctx context.Context, sb *sandbox.Sandbox) *api.Sandbox {
+	out := &api.Sandbox{
+		ID:       unscopedID(sb.ID),
+		Pid:      int64(sb.Pid),
+		Hostname: sb.Hostname,
+		Running:  sb.Running(),
+	}
+	if r, err := s.pauseSandboxResult(sb.ID); err == nil && r != nil {
+		out.IPs = r.IPs
+	}
+	if containers, err := s.executor.List(ctx); err == nil {
+		for _, c := range SandboxContainers(containers, sb.ID) {
+			out.Containers = append(out.Containers, unscopedID(c.ID()))
+		}
+		sort.Strings(out.Containers)
+	}
+	return out
+}
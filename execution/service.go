@@ -1,15 +1,33 @@
 package execution
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	api "github.com/docker/containerd/api/execution"
+	"github.com/docker/containerd/bundle"
+	"github.com/docker/containerd/cni"
 	"github.com/docker/containerd/events"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/namespaces"
+	"github.com/docker/containerd/netns"
+	"github.com/docker/containerd/portforward"
+	"github.com/docker/containerd/sandbox"
+	"github.com/docker/containerd/secrets"
+	"github.com/docker/containerd/tracing"
 	google_protobuf "github.com/golang/protobuf/ptypes/empty"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
 
@@ -17,73 +35,243 @@ var (
 	emptyResponse = &google_protobuf.Empty{}
 )
 
-func New(ctx context.Context, executor Executor) (*Service, error) {
+// defaultStopTimeout is how long a forced delete waits for a container to
+// exit on SIGTERM before sending SIGKILL.
+const defaultStopTimeout = 10 * time.Second
+
+// listBatchSize is the largest number of containers or processes sent in a
+// response of the list streams.
+const listBatchSize = 100
+
+// New returns the execution service for the executor. The resource usage of
+// containers is read from stats, the Stats rpc is not supported when it is
+// nil. Secrets are read from the backend, containers can not mount secrets
+// when it is nil. Containers with a network namespace of their own are added
+// to the CNI network, if any. Named network namespaces are kept in the netns
+// store, they are not supported when it is nil. The pause processes of
+// sandboxes are kept in the sandbox store, sandboxes are created along with
+// their first container when it is nil. Ports forwarded to containers
+// are forwarded again for the restored containers.
+func New(ctx context.Context, executor Executor, stats StatsReader, secrets secrets.Backend, network *cni.Network, netns *netns.Store, sandboxes *sandbox.Store) (*Service, error) {
 	svc := &Service{
-		executor: executor,
+		executor:   executor,
+		stats:      stats,
+		secrets:    secrets,
+		network:    network,
+		netns:      netns,
+		sandboxes:  sandboxes,
+		ports:      make(map[string][]*portforward.Forward),
+		watchdog:   newWatchdog(),
+		containers: newContainerCache(),
+		locks:      newContainerLocks(),
+		index:      newContainerIndex(),
 	}
 
-	// List existing container, some of them may have died away if
-	// we've been restarted
+	// Reattach to the processes of existing containers, some of them may
+	// have exited while we were down. Executors restore their processes
+	// from their own state so Wait reports the recorded exit status, and
+	// exit events are generated for anything that already stopped. The
+	// stdio and oom events of the containers still running are monitored
+	// again as they are on create, their stats are sampled by listing the
+	// executor. The containers are restored concurrently, for the daemon
+	// not to wait on them one after the other.
+	start := time.Now()
 	containers, err := executor.List(ctx)
 	if err != nil {
 		return nil, err
 	}
-
+	svc.replayNetwork(ctx)
+	counts := svc.restoreContainers(ctx, containers, restoreTimeout)
 	for _, c := range containers {
-		status := c.Status()
-		// generate exit event for all processes, (generate event for init last)
-		processes := c.Processes()
-		processes = append(processes[1:], processes[0])
-		for _, p := range c.processes {
-			if status == Stopped || status == Deleted {
-				if p.Status() != Stopped {
-					p.Signal(os.Kill)
-				}
-				sc, err := p.Wait()
-				if err != nil {
-					sc = UnknownStatusCode
-				}
-				topic := GetContainerProcessEventTopic(c.ID(), p.ID())
-				svc.publishEvent(ctx, topic, &ContainerExitEvent{
-					ContainerEvent: ContainerEvent{
-						Timestamp: time.Now(),
-						ID:        c.ID(),
-						Action:    "exit",
-					},
-					PID:        p.ID(),
-					StatusCode: sc,
-				})
-			} else {
-				svc.monitorProcess(ctx, c, p)
-			}
+		svc.index.put(c)
+	}
+	d := time.Since(start)
+	restoredContainers.Set(float64(len(containers)))
+	restoredProcesses.Set(float64(counts.processes))
+	synthesizedExits.Set(float64(counts.exited))
+	// the fifos of the restores which timed out are added once they are
+	// done
+	lostStdio.Add(float64(counts.lost))
+	restoreTimeouts.Set(float64(counts.timedOut))
+	restoreDuration.Set(d.Seconds())
+	log.G(ctx).WithFields(logrus.Fields{
+		"containers": len(containers),
+		"processes":  counts.processes,
+		"exited":     counts.exited,
+		"lost-stdio": counts.lost,
+		"timed-out":  counts.timedOut,
+		"duration":   d,
+	}).Info("restored containers")
+	if reporter, ok := executor.(FailureReporter); ok {
+		go svc.publishFailures(ctx, reporter.RuntimeFailures())
+	}
+	if sandboxes != nil {
+		for _, sb := range sandboxes.List() {
+			svc.monitorSandbox(ctx, sb)
 		}
 	}
+	go svc.watchResolvConf(ctx)
 
 	return svc, nil
 }
 
 type Service struct {
-	executor Executor
+	executor  Executor
+	stats     StatsReader
+	secrets   secrets.Backend
+	network   *cni.Network
+	netns     *netns.Store
+	sandboxes *sandbox.Store
+	watchdog  *watchdog
+	// containers are the containers loaded by the requests.
+	containers *containerCache
+	// locks serializes the requests changing each container.
+	locks *containerLocks
+	// index keeps the containers as they are listed.
+	index *containerIndex
+
+	portsMu sync.Mutex
+	ports   map[string][]*portforward.Forward
 }
 
 func (s *Service) Create(ctx context.Context, r *api.CreateContainerRequest) (*api.CreateContainerResponse, error) {
-	var err error
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	sandboxID, err := scopedID(ctx, r.Sandbox)
+	if err != nil {
+		return nil, err
+	}
 
-	container, err := s.executor.Create(ctx, r.ID, CreateOpts{
+	opts := CreateOpts{
+		Runtime: r.Runtime,
+		Sandbox: sandboxID,
 		Bundle:  r.BundlePath,
 		Console: r.Console,
 		Stdin:   r.Stdin,
 		Stdout:  r.Stdout,
 		Stderr:  r.Stderr,
-	})
+
+		PidsLimit:    r.PidsLimit,
+		CgroupParent: r.CgroupParent,
+
+		SeccompProfile:  r.SeccompProfile,
+		ApparmorProfile: r.ApparmorProfile,
+		NoSelinuxLabel:  r.NoSelinuxLabel,
+		Capabilities: CapabilityOpts{
+			Add:        r.CapAdd,
+			Drop:       r.CapDrop,
+			Privileged: r.Privileged,
+		},
+		Paths: PathOpts{
+			MaskedPaths:    r.MaskedPaths,
+			ReadonlyPaths:  r.ReadonlyPaths,
+			ReadonlyRootfs: r.ReadonlyRootfs,
+		},
+	}
+	if len(r.UIDMappings) > 0 || len(r.GIDMappings) > 0 {
+		opts.UserNamespace = &UserNamespace{
+			UIDMappings: fromGRPCIDMappings(r.UIDMappings),
+			GIDMappings: fromGRPCIDMappings(r.GIDMappings),
+		}
+	}
+	if r.CheckpointPath != "" {
+		if _, ok := s.executor.(Checkpointer); !ok {
+			return nil, errors.Wrap(ErrNotSupported, "restore")
+		}
+		if !filepath.IsAbs(r.CheckpointPath) {
+			return nil, errors.Errorf("checkpoint path %q is not absolute", r.CheckpointPath)
+		}
+		opts.CheckpointPath = r.CheckpointPath
+	}
+	if opts.Secrets, err = s.readSecrets(ctx, r.Secrets); err != nil {
+		return nil, err
+	}
+	if opts.NetNSPath, err = s.networkPath(r.Network); err != nil {
+		return nil, err
+	}
+	ports, err := fromGRPCPortMappings(r.Ports)
+	if err != nil {
+		return nil, err
+	}
+	if r.NetNSPath != "" {
+		if r.Network != "" {
+			return nil, errors.New("network and netns path are mutually exclusive")
+		}
+		if len(ports) > 0 {
+			return nil, errors.New("ports cannot be forwarded to a network namespace of the caller")
+		}
+		if err := netns.Check(r.NetNSPath); err != nil {
+			return nil, err
+		}
+		opts.NetNSPath = r.NetNSPath
+	}
+	opts.NetworkFiles = NetworkFiles{
+		Hostname:   r.Hostname,
+		ExtraHosts: fromGRPCHostEntries(r.ExtraHosts),
+		DNSServers: r.DNSServers,
+		DNSSearch:  r.DNSSearch,
+		DNSOptions: r.DNSOptions,
+	}
+	if err := opts.NetworkFiles.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.joinPauseSandbox(&opts); err != nil {
+		return nil, err
+	}
+	if o := r.RuntimeOptions; o != nil {
+		opts.RuntimeOptions = RuntimeOptions{
+			SystemdCgroup: o.SystemdCgroup,
+			Root:          o.Root,
+			CriuPath:      o.CriuPath,
+			Debug:         o.Debug,
+		}
+	}
+	span, sctx := tracing.StartSpan(ctx, "executor.create")
+	span.SetTag("container", r.ID)
+	container, err := s.executor.Create(sctx, id, opts)
+	span.Finish(err)
 	if err != nil {
 		return nil, err
 	}
 
 	procs := container.Processes()
 	initProcess := procs[0]
+	s.watchdog.addFifos(container.ID(), r.Stdin, r.Stdout, r.Stderr)
 
 	s.monitorProcess(ctx, container, initProcess)
+	s.monitorOOM(ctx, container)
+
+	if err := s.setupNetwork(ctx, container, initProcess, opts); err != nil {
+		// the container is left to be deleted by the caller once stopped
+		initProcess.Signal(syscall.SIGKILL)
+		return nil, errors.Wrap(err, "failed to set up the network of the container")
+	}
+	if len(ports) > 0 {
+		ip, err := s.containerIP(ctx, container, r.Network)
+		if err == nil {
+			err = s.forwardPorts(ctx, container, ip, ports)
+		}
+		if err != nil {
+			initProcess.Signal(syscall.SIGKILL)
+			return nil, errors.Wrap(err, "failed to forward the ports of the container")
+		}
+	}
+	if opts.NetworkFiles.Hostname != "" && s.network != nil {
+		if ip, err := s.containerIP(ctx, container, r.Network); err == nil {
+			if err := setHostsAddress(container, ip); err != nil {
+				log.G(ctx).WithError(err).WithField("container", container.ID()).Warn("failed to add the address of the container to its hosts")
+			}
+		}
+	}
+	// the caller gave up on the request, such as when the daemon stops
+	// before it completes, the container is not left half created
+	if err := ctx.Err(); err != nil {
+		s.rollbackCreate(container)
+		return nil, err
+	}
+	s.index.put(container)
 
 	return &api.CreateContainerResponse{
 		Container:   toGRPCContainer(container),
@@ -91,69 +279,434 @@ func (s *Service) Create(ctx context.Context, r *api.CreateContainerRequest) (*a
 	}, nil
 }
 
+// rollbackCreate stops and deletes a container whose creation was canceled.
+func (s *Service) rollbackCreate(container *Container) {
+	ctx := context.Background()
+	ns, id := splitID(container.ID())
+	ctx = namespaces.WithNamespace(ctx, ns)
+	if _, err := s.Delete(ctx, &api.DeleteContainerRequest{
+		ID:    id,
+		Force: true,
+	}); err != nil {
+		log.G(ctx).WithError(err).WithField("container", container.ID()).Error("failed to roll back the creation of the container")
+	}
+}
+
 func (s *Service) Delete(ctx context.Context, r *api.DeleteContainerRequest) (*google_protobuf.Empty, error) {
-	container, err := s.executor.Load(ctx, r.ID)
+	id, err := scopedID(ctx, r.ID)
 	if err != nil {
 		return emptyResponse, err
 	}
+	defer s.locks.lock(id)()
+	container, err := s.containers.load(ctx, s.executor, id)
+	if err != nil {
+		return emptyResponse, err
+	}
+	if r.Force {
+		timeout := time.Duration(r.Timeout)
+		if timeout == 0 {
+			timeout = defaultStopTimeout
+		}
+		if err := s.stopContainer(ctx, container, timeout); err != nil {
+			return emptyResponse, errors.Wrapf(err, "failed to stop container %s", container.ID())
+		}
+	}
 
+	network, err := networkResult(container)
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("container", container.ID()).Warn("failed to read the network of the container")
+	}
+	if network == nil {
+		// the result may only have been recorded by the network
+		if network, err = s.recordedNetworkResult(container.ID()); err != nil {
+			log.G(ctx).WithError(err).WithField("container", container.ID()).Warn("failed to read the recorded network of the container")
+		}
+	}
 	if err = s.executor.Delete(ctx, container); err != nil {
 		return emptyResponse, err
 	}
+	s.containers.evict(container.ID())
+	s.index.remove(container.ID())
+	s.closePorts(container.ID())
+	if network != nil {
+		if err := s.teardownNetwork(ctx, container.ID(), network); err != nil {
+			log.G(ctx).WithError(err).WithField("container", container.ID()).Error("failed to tear down the network of the container")
+		}
+	}
 	return emptyResponse, nil
 }
 
-func (s *Service) List(ctx context.Context, r *api.ListContainersRequest) (*api.ListContainersResponse, error) {
+// Inspect returns the state kept by the executor for the container. Only the
+// spec of the bundle is returned when the executor does not support it.
+func (s *Service) Inspect(ctx context.Context, r *api.InspectContainerRequest) (*api.InspectContainerResponse, error) {
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	container, err := s.containers.load(ctx, s.executor, id)
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.containerInfo(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := json.Marshal(info.Spec)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.InspectContainerResponse{
+		Spec: spec,
+	}
+	for _, p := range container.Processes() {
+		stdio, ok := info.Stdio[p.ID()]
+		if !ok {
+			continue
+		}
+		resp.Stdio = append(resp.Stdio, &api.ProcessStdio{
+			ProcessID: p.ID(),
+			Stdin:     stdio.Stdin,
+			Stdout:    stdio.Stdout,
+			Stderr:    stdio.Stderr,
+		})
+	}
+	return resp, nil
+}
+
+// containerInfo returns the state kept by the executor for container, only
+// the spec of its bundle when the executor does not support it.
+func (s *Service) containerInfo(ctx context.Context, container *Container) (*ContainerInfo, error) {
+	if inspector, ok := s.executor.(Inspector); ok {
+		info, err := inspector.Inspect(ctx, container)
+		if err != nil && errors.Cause(err) != ErrNotSupported {
+			return nil, err
+		}
+		if info != nil {
+			return info, nil
+		}
+	}
+	b, err := bundle.Load(container.Bundle())
+	if err != nil {
+		return nil, err
+	}
+	spec, err := b.Config()
+	if err != nil {
+		return nil, err
+	}
+	return &ContainerInfo{Spec: spec}, nil
+}
+
+// ContainerAnnotations are the annotations of the spec of a container.
+type ContainerAnnotations struct {
+	Namespace   string
+	ID          string
+	Annotations map[string]string
+}
+
+// Annotations returns the annotations of the specs of the containers of all
+// namespaces. It fails when the spec of a container cannot be read, for the
+// resources it references not to be taken as unused.
+func (s *Service) Annotations(ctx context.Context) ([]ContainerAnnotations, error) {
 	containers, err := s.executor.List(ctx)
 	if err != nil {
 		return nil, err
 	}
-	resp := &api.ListContainersResponse{}
+	var annotations []ContainerAnnotations
 	for _, c := range containers {
-		resp.Containers = append(resp.Containers, toGRPCContainer(c))
+		info, err := s.containerInfo(ctx, c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the spec of container %s", c.ID())
+		}
+		ns, id := splitID(c.ID())
+		a := ContainerAnnotations{Namespace: ns, ID: id}
+		if info.Spec != nil {
+			a.Annotations = info.Spec.Annotations
+		}
+		annotations = append(annotations, a)
 	}
-	return resp, nil
+	return annotations, nil
+}
+
+// PruneStopped deletes the stopped containers of the namespace of ctx,
+// returning their ids. The containers which fail to be deleted are logged
+// and left out. Nothing is deleted when dryRun is set.
+func (s *Service) PruneStopped(ctx context.Context, dryRun bool) ([]string, error) {
+	containers, err := s.executor.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, c := range containers {
+		if !inNamespace(ctx, c.ID()) || c.Status() != Stopped {
+			continue
+		}
+		id := unscopedID(c.ID())
+		if !dryRun {
+			if _, err := s.Delete(ctx, &api.DeleteContainerRequest{ID: id}); err != nil {
+				log.G(ctx).WithError(err).WithField("container", c.ID()).Warn("failed to delete stopped container")
+				continue
+			}
+		}
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
 }
+
+// stopContainer sends SIGTERM to the init process of container and SIGKILL
+// once timeout is over, until the container is stopped.
+func (s *Service) stopContainer(ctx context.Context, container *Container, timeout time.Duration) error {
+	if container.Status() == Stopped {
+		return nil
+	}
+	init := container.initProcess()
+	if init == nil {
+		return errors.New("no init process")
+	}
+	if container.Status() == Paused {
+		// the signals are not delivered to frozen processes
+		if err := s.executor.Resume(ctx, container); err != nil {
+			return err
+		}
+	}
+	if err := init.Signal(syscall.SIGTERM); err != nil {
+		log.G(ctx).WithError(err).WithField("container", container.ID()).Warn("failed to send SIGTERM")
+	}
+	if waitStopped(ctx, container, timeout) {
+		return nil
+	}
+	log.G(ctx).WithField("container", container.ID()).Info("container still running, sending SIGKILL")
+	if err := init.Signal(syscall.SIGKILL); err != nil {
+		log.G(ctx).WithError(err).WithField("container", container.ID()).Warn("failed to send SIGKILL")
+	}
+	if waitStopped(ctx, container, timeout) {
+		return nil
+	}
+	return errors.Errorf("container still %s after SIGKILL", container.Status())
+}
+
+// waitStopped polls the status of container until it is stopped, returning
+// false if it is still running once timeout is over.
+func waitStopped(ctx context.Context, container *Container, timeout time.Duration) bool {
+	t := time.NewTicker(100 * time.Millisecond)
+	defer t.Stop()
+	deadline := time.After(timeout)
+	for container.Status() != Stopped {
+		select {
+		case <-t.C:
+		case <-deadline:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// List returns the containers of the namespace as they are indexed, without
+// querying the executor but for the containers whose state was lost, such as
+// when their shim failed. Get queries the executor for the state of a
+// container.
+func (s *Service) List(ctx context.Context, r *api.ListContainersRequest) (*api.ListContainersResponse, error) {
+	containers, err := s.listContainers(ctx, func(id string) bool {
+		return inNamespace(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.ListContainersResponse{
+		Containers: containers,
+	}, nil
+}
+
+func (s *Service) ListStream(r *api.ListContainersRequest, stream api.ExecutionService_ListStreamServer) error {
+	ctx := stream.Context()
+	return s.listContainerBatches(ctx, func(id string) bool {
+		return inNamespace(ctx, id)
+	}, listBatchSize, func(containers []*api.Container) error {
+		return stream.Send(&api.ListContainersResponse{
+			Containers: containers,
+		})
+	})
+}
+
 func (s *Service) Get(ctx context.Context, r *api.GetContainerRequest) (*api.GetContainerResponse, error) {
-	container, err := s.executor.Load(ctx, r.ID)
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	container, err := s.containers.load(ctx, s.executor, id)
 	if err != nil {
 		return nil, err
 	}
+	s.index.update(container)
 	return &api.GetContainerResponse{
 		Container: toGRPCContainer(container),
 	}, nil
 }
 
+func (s *Service) Stats(ctx context.Context, r *api.StatsContainerRequest) (*api.StatsContainerResponse, error) {
+	if s.stats == nil {
+		return nil, errors.Wrap(ErrNotSupported, "stats")
+	}
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	container, err := s.containers.load(ctx, s.executor, id)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := s.stats.Stats(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := json.Marshal(metrics)
+	if err != nil {
+		return nil, err
+	}
+	return &api.StatsContainerResponse{
+		Stats: stats,
+	}, nil
+}
+
 func (s *Service) Update(ctx context.Context, r *api.UpdateContainerRequest) (*google_protobuf.Empty, error) {
-	return emptyResponse, nil
+	if len(r.Resources) == 0 {
+		return emptyResponse, nil
+	}
+	updater, ok := s.executor.(Updater)
+	if !ok {
+		return nil, errors.Wrap(ErrNotSupported, "update")
+	}
+	var resources specs.LinuxResources
+	if err := json.Unmarshal(r.Resources, &resources); err != nil {
+		return nil, errors.Wrap(err, "failed to decode resources")
+	}
+	id, err := scopedID(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer s.locks.lock(id)()
+	container, err := s.containers.load(ctx, s.executor, id)
+	if err != nil {
+		return nil, err
+	}
+	return emptyResponse, updater.Update(ctx, container, &resources)
+}
+
+func (s *Service) Checkpoint(ctx context.Context, r *api.CheckpointContainerRequest) (*google_protobuf.Empty, error) {
+	checkpointer, ok := s.executor.(Checkpointer)
+	if !ok {
+		return nil, errors.Wrap(ErrNotSupported, "checkpoint")
+	}
+	if !filepath.IsAbs(r.Path) {
+		return nil, errors.Errorf("checkpoint path %q is not absolute", r.Path)
+	}
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer s.locks.lock(id)()
+	container, err := s.containers.load(ctx, s.executor, id)
+	if err != nil {
+		return nil, err
+	}
+	if container.Status() != Running {
+		return nil, errors.Errorf("cannot checkpoint a container in the '%s' state", container.Status())
+	}
+	if r.PreDump && r.Exit {
+		return nil, errors.New("a pre-dump leaves the container running")
+	}
+	if filepath.IsAbs(r.ParentPath) {
+		return nil, errors.Errorf("parent path %q is not relative to the checkpoint", r.ParentPath)
+	}
+	if r.PreDumps > 0 && (r.PreDump || r.ParentPath != "") {
+		return nil, errors.New("the pre-dumps are the parent of a checkpoint")
+	}
+	opts := CheckpointOpts{
+		Path:           r.Path,
+		Exit:           r.Exit,
+		TCPEstablished: r.TCPEstablished,
+		UnixSockets:    r.UnixSockets,
+		Shell:          r.Shell,
+		EmptyNS:        r.EmptyNS,
+		PreDump:        r.PreDump,
+		ParentPath:     r.ParentPath,
+	}
+	if r.PreDumps > 0 {
+		if err := preDump(ctx, checkpointer, container, opts, int(r.PreDumps), r.PreDumpThreshold); err != nil {
+			return nil, err
+		}
+		opts.ParentPath = PreDumpDir
+	}
+	defer s.containers.evict(container.ID())
+	return emptyResponse, checkpointer.Checkpoint(ctx, container, opts)
 }
 
 func (s *Service) Pause(ctx context.Context, r *api.PauseContainerRequest) (*google_protobuf.Empty, error) {
-	container, err := s.executor.Load(ctx, r.ID)
+	id, err := scopedID(ctx, r.ID)
 	if err != nil {
 		return nil, err
 	}
-	return emptyResponse, s.executor.Pause(ctx, container)
+	defer s.locks.lock(id)()
+	container, err := s.containers.load(ctx, s.executor, id)
+	if err != nil {
+		return nil, err
+	}
+	defer s.containers.evict(container.ID())
+	if err := s.executor.Pause(ctx, container); err != nil {
+		return emptyResponse, err
+	}
+	s.index.setStatus(container.ID(), Paused)
+	return emptyResponse, nil
 }
 
 func (s *Service) Resume(ctx context.Context, r *api.ResumeContainerRequest) (*google_protobuf.Empty, error) {
-	container, err := s.executor.Load(ctx, r.ID)
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer s.locks.lock(id)()
+	container, err := s.containers.load(ctx, s.executor, id)
 	if err != nil {
 		return nil, err
 	}
-	return emptyResponse, s.executor.Resume(ctx, container)
+	defer s.containers.evict(container.ID())
+	if err := s.executor.Resume(ctx, container); err != nil {
+		return emptyResponse, err
+	}
+	s.index.setStatus(container.ID(), Running)
+	return emptyResponse, nil
 }
 
 func (s *Service) Start(ctx context.Context, r *api.StartContainerRequest) (*google_protobuf.Empty, error) {
-	container, err := s.executor.Load(ctx, r.ID)
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer s.locks.lock(id)()
+	container, err := s.containers.load(ctx, s.executor, id)
 	if err != nil {
 		return nil, err
 	}
-	return emptyResponse, s.executor.Start(ctx, container)
+	defer s.containers.evict(container.ID())
+	span, sctx := tracing.StartSpan(ctx, "executor.start")
+	span.SetTag("container", r.ID)
+	err = s.executor.Start(sctx, container)
+	span.Finish(err)
+	if err == nil {
+		s.index.setStatus(container.ID(), Running)
+	}
+	return emptyResponse, err
 }
 
 func (s *Service) StartProcess(ctx context.Context, r *api.StartProcessRequest) (*api.StartProcessResponse, error) {
-	container, err := s.executor.Load(ctx, r.ContainerID)
+	id, err := scopedID(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer s.locks.rlock(id)()
+	container, err := s.containers.load(ctx, s.executor, id)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +720,16 @@ func (s *Service) StartProcess(ctx context.Context, r *api.StartProcessRequest)
 		Args:            r.Process.Args,
 		Env:             r.Process.Env,
 		Cwd:             r.Process.Cwd,
-		NoNewPrivileges: true,
+		ApparmorProfile: r.Process.ApparmorProfile,
+	}
+	var noNewPrivileges *bool
+	switch r.NewPrivileges {
+	case api.NewPrivileges_DENY_NEW_PRIVILEGES:
+		deny := true
+		noNewPrivileges = &deny
+	case api.NewPrivileges_ALLOW_NEW_PRIVILEGES:
+		deny := false
+		noNewPrivileges = &deny
 	}
 
 	process, err := s.executor.StartProcess(ctx, container, StartProcessOpts{
@@ -177,10 +739,18 @@ func (s *Service) StartProcess(ctx context.Context, r *api.StartProcessRequest)
 		Stdin:   r.Stdin,
 		Stdout:  r.Stdout,
 		Stderr:  r.Stderr,
+		Capabilities: CapabilityOpts{
+			Add:        r.CapAdd,
+			Drop:       r.CapDrop,
+			Privileged: r.Privileged,
+		},
+		NoNewPrivileges: noNewPrivileges,
 	})
 	if err != nil {
 		return nil, err
 	}
+	s.containers.evict(container.ID())
+	s.watchdog.addFifos(container.ID(), r.Stdin, r.Stdout, r.Stderr)
 
 	s.monitorProcess(ctx, container, process)
 
@@ -191,7 +761,11 @@ func (s *Service) StartProcess(ctx context.Context, r *api.StartProcessRequest)
 
 // containerd managed execs + system pids forked in container
 func (s *Service) GetProcess(ctx context.Context, r *api.GetProcessRequest) (*api.GetProcessResponse, error) {
-	container, err := s.executor.Load(ctx, r.ContainerID)
+	id, err := scopedID(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	container, err := s.containers.load(ctx, s.executor, id)
 	if err != nil {
 		return nil, err
 	}
@@ -205,10 +779,21 @@ func (s *Service) GetProcess(ctx context.Context, r *api.GetProcessRequest) (*ap
 }
 
 func (s *Service) SignalProcess(ctx context.Context, r *api.SignalProcessRequest) (*google_protobuf.Empty, error) {
-	container, err := s.executor.Load(ctx, r.ContainerID)
+	id, err := scopedID(ctx, r.ContainerID)
 	if err != nil {
 		return emptyResponse, err
 	}
+	container, err := s.containers.load(ctx, s.executor, id)
+	if err != nil {
+		return emptyResponse, err
+	}
+	if r.All {
+		killer, ok := s.executor.(Killer)
+		if !ok {
+			return nil, errors.Wrap(ErrNotSupported, "kill all")
+		}
+		return emptyResponse, killer.KillAll(ctx, container, syscall.Signal(r.Signal))
+	}
 	process := container.GetProcess(r.ProcessID)
 	if process == nil {
 		return nil, fmt.Errorf("Make me a constant! Process not foumd!")
@@ -216,25 +801,420 @@ func (s *Service) SignalProcess(ctx context.Context, r *api.SignalProcessRequest
 	return emptyResponse, process.Signal(syscall.Signal(r.Signal))
 }
 
+func (s *Service) ResizeProcess(ctx context.Context, r *api.ResizeProcessRequest) (*google_protobuf.Empty, error) {
+	if r.Width == 0 || r.Height == 0 || r.Width > math.MaxUint16 || r.Height > math.MaxUint16 {
+		return nil, errors.Errorf("invalid console size %dx%d", r.Width, r.Height)
+	}
+	id, err := scopedID(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	container, err := s.containers.load(ctx, s.executor, id)
+	if err != nil {
+		return nil, err
+	}
+	process := container.GetProcess(r.ProcessID)
+	if process == nil {
+		return nil, ErrProcessNotFound
+	}
+	resizer, ok := process.(Resizer)
+	if !ok {
+		return nil, errors.Wrap(ErrNotSupported, "resize")
+	}
+	return emptyResponse, resizer.Resize(r.Width, r.Height)
+}
+
 func (s *Service) DeleteProcess(ctx context.Context, r *api.DeleteProcessRequest) (*google_protobuf.Empty, error) {
-	container, err := s.executor.Load(ctx, r.ContainerID)
+	id, err := scopedID(ctx, r.ContainerID)
+	if err != nil {
+		return emptyResponse, err
+	}
+	defer s.locks.rlock(id)()
+	container, err := s.containers.load(ctx, s.executor, id)
 	if err != nil {
 		return emptyResponse, err
 	}
 	if err := s.executor.DeleteProcess(ctx, container, r.ProcessID); err != nil {
 		return emptyResponse, err
 	}
+	s.containers.evict(container.ID())
 	return emptyResponse, nil
 }
 
 func (s *Service) ListProcesses(ctx context.Context, r *api.ListProcessesRequest) (*api.ListProcessesResponse, error) {
-	container, err := s.executor.Load(ctx, r.ID)
+	return s.listProcesses(ctx, r)
+}
+
+func (s *Service) ListProcessesStream(r *api.ListProcessesRequest, stream api.ExecutionService_ListProcessesStreamServer) error {
+	resp, err := s.listProcesses(stream.Context(), r)
+	if err != nil {
+		return err
+	}
+	processes, hostProcesses := resp.Processes, resp.HostProcesses
+	for len(processes) > 0 || len(hostProcesses) > 0 {
+		batch := &api.ListProcessesResponse{}
+		if n := len(processes); n > 0 {
+			if n > listBatchSize {
+				n = listBatchSize
+			}
+			batch.Processes, processes = processes[:n], processes[n:]
+		}
+		if n := listBatchSize - len(batch.Processes); n > 0 && len(hostProcesses) > 0 {
+			if n > len(hostProcesses) {
+				n = len(hostProcesses)
+			}
+			batch.HostProcesses, hostProcesses = hostProcesses[:n], hostProcesses[n:]
+		}
+		if err := stream.Send(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listProcesses returns the processes of the container of the request along
+// with those of its cgroup.
+func (s *Service) listProcesses(ctx context.Context, r *api.ListProcessesRequest) (*api.ListProcessesResponse, error) {
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	container, err := s.containers.load(ctx, s.executor, id)
 	if err != nil {
 		return nil, err
 	}
 	processes := container.Processes()
-	return &api.ListProcessesResponse{
+	resp := &api.ListProcessesResponse{
 		Processes: toGRPCProcesses(processes),
+	}
+	lister, ok := s.executor.(PidLister)
+	if !ok || container.Status() == Stopped {
+		return resp, nil
+	}
+	pids, err := lister.Pids(ctx, container)
+	if err != nil {
+		if errors.Cause(err) != ErrNotSupported {
+			log.G(ctx).WithError(err).WithField("container", container.ID()).Warn("failed to list the pids of the container")
+		}
+		return resp, nil
+	}
+	ids := make(map[int64]string)
+	for _, p := range processes {
+		ids[p.Pid()] = p.ID()
+	}
+	for _, pid := range pids {
+		// the process may have exited since it was listed
+		args, _ := processArgs(pid)
+		resp.HostProcesses = append(resp.HostProcesses, &api.HostProcess{
+			Pid:       int64(pid),
+			Args:      args,
+			ProcessID: ids[int64(pid)],
+		})
+	}
+	return resp, nil
+}
+
+// processArgs returns the command line of the process pid.
+func processArgs(pid int) ([]string, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimRight(string(data), "\x00"), "\x00"), nil
+}
+
+func (s *Service) GetRuntimeLogs(ctx context.Context, r *api.GetRuntimeLogsRequest) (*api.GetRuntimeLogsResponse, error) {
+	logger, ok := s.executor.(RuntimeLogger)
+	if !ok {
+		return nil, errors.Wrap(ErrNotSupported, "runtime logs")
+	}
+	id, err := scopedID(ctx, r.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+	container, err := s.containers.load(ctx, s.executor, id)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := logger.RuntimeLogs(ctx, container, r.ProcessID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.GetRuntimeLogsResponse{}
+	for _, l := range logs {
+		resp.Logs = append(resp.Logs, &api.RuntimeLog{
+			Source:    l.Source,
+			Level:     l.Level,
+			Message:   l.Message,
+			Timestamp: l.Timestamp.UnixNano(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Service) ListRuntimes(ctx context.Context, r *api.ListRuntimesRequest) (*api.ListRuntimesResponse, error) {
+	runtimes, ok := s.executor.(*Runtimes)
+	if !ok {
+		return nil, errors.Wrap(ErrNotSupported, "runtime introspection")
+	}
+	resp := &api.ListRuntimesResponse{}
+	for _, name := range runtimes.Names() {
+		rt, err := runtimes.Runtime(name)
+		if err != nil {
+			return nil, err
+		}
+		info := &api.RuntimeInfo{
+			Name:    rt.Name,
+			Default: rt.Name == runtimes.Default(),
+			Capabilities: &api.RuntimeCapabilities{
+				Pause: rt.Capabilities.Pause,
+				Exec:  rt.Capabilities.Exec,
+				Stats: rt.Capabilities.Stats,
+			},
+		}
+		if d, ok := rt.Executor.(FeatureDetector); ok {
+			f := d.Features()
+			info.Features = &api.RuntimeFeatures{
+				Version:    f.Version,
+				Commit:     f.Commit,
+				Spec:       f.Spec,
+				Seccomp:    f.Seccomp,
+				Libseccomp: f.Libseccomp,
+				CgroupV2:   f.CgroupV2,
+				Criu:       f.Criu,
+			}
+		}
+		resp.Runtimes = append(resp.Runtimes, info)
+	}
+	return resp, nil
+}
+
+func (s *Service) KillSandbox(ctx context.Context, r *api.KillSandboxRequest) (*google_protobuf.Empty, error) {
+	sandboxer, ok := s.executor.(Sandboxer)
+	if !ok {
+		return nil, errors.Wrap(ErrNotSupported, "sandboxes")
+	}
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	return emptyResponse, sandboxer.KillSandbox(ctx, id, syscall.Signal(r.Signal))
+}
+
+func (s *Service) SandboxStats(ctx context.Context, r *api.SandboxStatsRequest) (*api.SandboxStatsResponse, error) {
+	sandboxer, ok := s.executor.(Sandboxer)
+	if !ok {
+		return nil, errors.Wrap(ErrNotSupported, "sandboxes")
+	}
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := sandboxer.SandboxStats(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := json.Marshal(metrics)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SandboxStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+func (s *Service) CreateSandbox(ctx context.Context, r *api.CreateSandboxRequest) (*api.CreateSandboxResponse, error) {
+	if s.sandboxes == nil {
+		return nil, errors.Wrap(ErrNotSupported, "sandboxes with a pause process")
+	}
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	sb, err := s.sandboxes.Create(id, sandbox.Opts{
+		Hostname: r.Hostname,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if s.network != nil {
+		netns := sb.NamespacePath("net")
+		result, err := s.network.Setup(ctx, sandboxNetworkID(sb.ID), netns, cniInterface)
+		if err == nil {
+			if err = writeNetworkResult(filepath.Join(s.sandboxes.Dir(sb.ID), cniResultFilename), result); err != nil {
+				s.network.Teardown(ctx, sandboxNetworkID(sb.ID), netns, cniInterface, result)
+			}
+		}
+		if err != nil {
+			s.sandboxes.Delete(sb.ID)
+			return nil, errors.Wrap(err, "failed to set up the network of the sandbox")
+		}
+	}
+	s.publishSandboxEvent(ctx, sb.ID, "create")
+	s.monitorSandbox(ctx, sb)
+	return &api.CreateSandboxResponse{
+		Sandbox: s.toGRPCSandbox(ctx, sb),
+	}, nil
+}
+
+func (s *Service) DeleteSandbox(ctx context.Context, r *api.DeleteSandboxRequest) (*google_protobuf.Empty, error) {
+	if s.sandboxes == nil {
+		return nil, errors.Wrap(ErrNotSupported, "sandboxes with a pause process")
+	}
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	sb, err := s.sandboxes.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	containers, err := s.executor.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n := len(SandboxContainers(containers, sb.ID)); n > 0 {
+		return nil, errors.Errorf("sandbox %q still has %d containers", r.ID, n)
+	}
+	result, err := s.pauseSandboxResult(sb.ID)
+	if err != nil {
+		return nil, err
+	}
+	if result != nil && s.network != nil {
+		var netns string
+		if sb.Running() {
+			netns = sb.NamespacePath("net")
+		}
+		if err := s.network.Teardown(ctx, sandboxNetworkID(sb.ID), netns, cniInterface, result); err != nil {
+			return nil, errors.Wrap(err, "failed to tear down the network of the sandbox")
+		}
+	}
+	if err := s.sandboxes.Delete(sb.ID); err != nil {
+		return nil, err
+	}
+	s.publishSandboxEvent(ctx, sb.ID, "delete")
+	return emptyResponse, nil
+}
+
+func (s *Service) GetSandbox(ctx context.Context, r *api.GetSandboxRequest) (*api.GetSandboxResponse, error) {
+	if s.sandboxes == nil {
+		return nil, errors.Wrap(ErrNotSupported, "sandboxes with a pause process")
+	}
+	id, err := scopedID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	sb, err := s.sandboxes.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetSandboxResponse{
+		Sandbox: s.toGRPCSandbox(ctx, sb),
+	}, nil
+}
+
+func (s *Service) ListSandboxes(ctx context.Context, r *api.ListSandboxesRequest) (*api.ListSandboxesResponse, error) {
+	if s.sandboxes == nil {
+		return nil, errors.Wrap(ErrNotSupported, "sandboxes with a pause process")
+	}
+	resp := &api.ListSandboxesResponse{}
+	for _, sb := range s.sandboxes.List() {
+		if !inNamespace(ctx, sb.ID) {
+			continue
+		}
+		resp.Sandboxes = append(resp.Sandboxes, s.toGRPCSandbox(ctx, sb))
+	}
+	return resp, nil
+}
+
+func (s *Service) CreateNetworkNamespace(ctx context.Context, r *api.CreateNetworkNamespaceRequest) (*api.CreateNetworkNamespaceResponse, error) {
+	if s.netns == nil {
+		return nil, errors.Wrap(ErrNotSupported, "network namespaces")
+	}
+	path, err := s.netns.Create(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	ns := &api.NetworkNamespace{
+		Name: r.Name,
+		Path: path,
+	}
+	if s.network != nil {
+		result, err := s.network.Setup(ctx, netnsID(r.Name), path, cniInterface)
+		if err == nil {
+			if err = writeNetworkResult(filepath.Join(s.netns.Dir(r.Name), cniResultFilename), result); err != nil {
+				s.network.Teardown(ctx, netnsID(r.Name), path, cniInterface, result)
+			}
+		}
+		if err != nil {
+			s.netns.Delete(r.Name)
+			return nil, errors.Wrap(err, "failed to set up the network of the namespace")
+		}
+		ns.IPs = result.IPs
+	}
+	return &api.CreateNetworkNamespaceResponse{
+		NetworkNamespace: ns,
+	}, nil
+}
+
+func (s *Service) DeleteNetworkNamespace(ctx context.Context, r *api.DeleteNetworkNamespaceRequest) (*google_protobuf.Empty, error) {
+	if s.netns == nil {
+		return nil, errors.Wrap(ErrNotSupported, "network namespaces")
+	}
+	path, err := s.netns.Path(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	result, err := readNetworkResult(filepath.Join(s.netns.Dir(r.Name), cniResultFilename))
+	if err != nil {
+		return nil, err
+	}
+	if result != nil && s.network != nil {
+		if err := s.network.Teardown(ctx, netnsID(r.Name), path, cniInterface, result); err != nil {
+			return nil, errors.Wrap(err, "failed to tear down the network of the namespace")
+		}
+	}
+	return emptyResponse, s.netns.Delete(r.Name)
+}
+
+func (s *Service) ListNetworkNamespaces(ctx context.Context, r *api.ListNetworkNamespacesRequest) (*api.ListNetworkNamespacesResponse, error) {
+	if s.netns == nil {
+		return nil, errors.Wrap(ErrNotSupported, "network namespaces")
+	}
+	names, err := s.netns.List()
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.ListNetworkNamespacesResponse{}
+	for _, name := range names {
+		path, err := s.netns.Path(name)
+		if err != nil {
+			continue
+		}
+		ns := &api.NetworkNamespace{
+			Name: name,
+			Path: path,
+		}
+		if result, err := readNetworkResult(filepath.Join(s.netns.Dir(name), cniResultFilename)); err == nil && result != nil {
+			ns.IPs = result.IPs
+		}
+		resp.NetworkNamespaces = append(resp.NetworkNamespaces, ns)
+	}
+	return resp, nil
+}
+
+func (s *Service) ListPorts(ctx context.Context, r *api.ListPortsRequest) (*api.ListPortsResponse, error) {
+	if r.ID != "" {
+		id, err := scopedID(ctx, r.ID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.containers.load(ctx, s.executor, id); err != nil {
+			return nil, err
+		}
+	}
+	return &api.ListPortsResponse{
+		Ports: s.listPorts(ctx, r.ID),
 	}, nil
 }
 
@@ -242,55 +1222,282 @@ var (
 	_ = (api.ExecutionServiceServer)(&Service{})
 )
 
-func (s *Service) publishEvent(ctx context.Context, topic string, v interface{}) {
-	ctx = events.WithTopic(ctx, topic)
+// publishEvent publishes the event v of the container or sandbox id, in
+// order with its other events.
+func (s *Service) publishEvent(ctx context.Context, id, topic string, v interface{}) {
+	span, ctx := tracing.StartSpan(ctx, "events.publish")
+	span.SetTag("topic", topic)
+	ctx = events.WithTopic(events.WithOrderingKey(ctx, id), topic)
 	events.GetPoster(ctx).Post(ctx, v)
+	span.Finish(nil)
 }
 
+// monitorProcess publishes the exit of the process. The exits notified by
+// the executor are handled in a goroutine started on exit, otherwise a
+// goroutine waits for the process.
 func (s *Service) monitorProcess(ctx context.Context, container *Container, process Process) {
-	go func() {
-		status, err := process.Wait()
+	if notifier, ok := process.(ExitNotifier); ok {
+		done := s.watchdog.addMonitor(container.ID())
+		err := notifier.NotifyExit(func() {
+			go func() {
+				defer done()
+				s.processExited(ctx, container, process)
+			}()
+		})
 		if err == nil {
-			topic := GetContainerProcessEventTopic(container.ID(), process.ID())
-			s.publishEvent(ctx, topic, &ContainerExitEvent{
-				ContainerEvent: ContainerEvent{
-					Timestamp: time.Now(),
-					ID:        container.ID(),
-					Action:    "exit",
-				},
-				PID:        process.ID(),
-				StatusCode: status,
+			return
+		}
+		done()
+		log.G(ctx).WithError(err).WithField("container", container.ID()).Warn("failed to watch the exit of the process, waiting for it")
+	}
+	s.watchdog.goMonitor(container.ID(), func() {
+		s.processExited(ctx, container, process)
+	})
+}
+
+// processExited waits for the exited process and publishes its exit.
+func (s *Service) processExited(ctx context.Context, container *Container, process Process) {
+	status, err := process.Wait()
+	s.containers.evict(container.ID())
+	switch {
+	case err != nil:
+		s.index.markStale(container.ID())
+	case process == container.initProcess():
+		s.index.setStatus(container.ID(), Stopped)
+	}
+	if err == nil {
+		topic := GetContainerProcessEventTopic(container.ID(), process.ID())
+		ns, id := splitID(container.ID())
+		s.publishEvent(ctx, container.ID(), topic, &ContainerExitEvent{
+			ContainerEvent: ContainerEvent{
+				Timestamp: time.Now(),
+				Namespace: ns,
+				ID:        id,
+				Action:    "exit",
+			},
+			PID:        process.ID(),
+			StatusCode: status,
+		})
+	}
+}
+
+// restoreStdio records the stdio fifos of the processes of a restored
+// container with the watchdog, as they are on create and exec, and returns
+// the number of fifos of running processes removed while the daemon was
+// down. The stdout and stderr fifos of processes implementing StdioReopener
+// are created again for their shims to reopen them. The other removed fifos
+// are lost, as is the stdin fifo read by the process and the console of
+// processes without a shim.
+func (s *Service) restoreStdio(ctx context.Context, container *Container) int {
+	inspector, ok := s.executor.(Inspector)
+	if !ok {
+		return 0
+	}
+	info, err := inspector.Inspect(ctx, container)
+	if err != nil {
+		if errors.Cause(err) != ErrNotSupported {
+			log.G(ctx).WithError(err).WithField("container", container.ID()).Warn("failed to restore the stdio of the container")
+		}
+		return 0
+	}
+	var lost int
+	for _, p := range container.Processes() {
+		stdio, ok := info.Stdio[p.ID()]
+		if !ok {
+			continue
+		}
+		s.watchdog.addFifos(container.ID(), stdio.Stdin, stdio.Stdout, stdio.Stderr)
+		if p.Status() == Stopped {
+			continue
+		}
+		fields := logrus.Fields{
+			"container":  container.ID(),
+			"process-id": p.ID(),
+		}
+		reopener, _ := p.(StdioReopener)
+		var recreated int
+		for _, path := range []string{stdio.Stdin, stdio.Stdout, stdio.Stderr} {
+			if path == "" {
+				continue
+			}
+			if _, err := os.Stat(path); !os.IsNotExist(err) {
+				continue
+			}
+			if reopener != nil && path != stdio.Stdin {
+				if err := recreateFifo(path); err == nil {
+					recreated++
+					continue
+				}
+				log.G(ctx).WithError(err).WithFields(fields).WithField("path", path).Warn("failed to create the stdio fifo again")
+			}
+			lost++
+			log.G(ctx).WithFields(fields).WithField("path", path).Warn("stdio fifo of a running process was removed")
+		}
+		if recreated == 0 {
+			continue
+		}
+		if err := reopener.ReopenStdio(); err != nil {
+			lost += recreated
+			log.G(ctx).WithError(err).WithFields(fields).Warn("failed to reopen the stdio of a running process")
+			continue
+		}
+		log.G(ctx).WithFields(fields).WithField("fifos", recreated).Info("reopened the removed stdio fifos of a running process")
+	}
+	return lost
+}
+
+// monitorOOM publishes an event each time processes of the container are
+// killed by the oom killer, when the executor reports them.
+func (s *Service) monitorOOM(ctx context.Context, container *Container) {
+	watcher, ok := s.executor.(OOMWatcher)
+	if !ok {
+		return
+	}
+	// the watch outlives the request and ends with the container
+	ch, err := watcher.WatchOOM(context.Background(), container)
+	if err != nil {
+		if errors.Cause(err) != ErrNotSupported {
+			log.G(ctx).WithError(err).WithField("container", container.ID()).Warn("failed to watch oom events")
+		}
+		return
+	}
+	s.watchdog.goMonitor(container.ID(), func() {
+		ns, id := splitID(container.ID())
+		for range ch {
+			s.publishEvent(ctx, container.ID(), GetContainerEventTopic(container.ID()), &ContainerEvent{
+				Timestamp: time.Now(),
+				Namespace: ns,
+				ID:        id,
+				Action:    "oom",
 			})
 		}
-	}()
+	})
+}
+
+// publishFailures publishes an event for each failure reported by the
+// executor.
+func (s *Service) publishFailures(ctx context.Context, failures <-chan RuntimeFailure) {
+	for f := range failures {
+		s.containers.evict(f.ContainerID)
+		s.index.markStale(f.ContainerID)
+		ns, id := splitID(f.ContainerID)
+		s.publishEvent(ctx, f.ContainerID, GetContainerEventTopic(f.ContainerID), &RuntimeFailureEvent{
+			ContainerEvent: ContainerEvent{
+				Timestamp: time.Now(),
+				Namespace: ns,
+				ID:        id,
+				Action:    "runtime-failure",
+			},
+			PID:    f.ProcessID,
+			Reason: f.Reason,
+			Fenced: f.Fenced,
+		})
+	}
 }
 
+// GetContainerEventTopic returns the topic of the events of the container
+// known by the executor as id.
 func GetContainerEventTopic(id string) string {
-	return fmt.Sprintf(containerEventsTopicFormat, id)
+	ns, id := splitID(id)
+	return namespaceTopic(ns, fmt.Sprintf(containerEventsTopicFormat, id))
 }
 
+// GetSandboxEventTopic returns the topic of the events of the sandbox kept
+// as id.
+func GetSandboxEventTopic(id string) string {
+	ns, id := splitID(id)
+	return namespaceTopic(ns, fmt.Sprintf(sandboxEventsTopicFormat, id))
+}
+
+// GetContainerProcessEventTopic returns the topic of the events of a
+// process of the container known by the executor as containerID.
 func GetContainerProcessEventTopic(containerID, processID string) string {
-	return fmt.Sprintf(containerProcessEventsTopicFormat, containerID, processID)
+	ns, id := splitID(containerID)
+	return namespaceTopic(ns, fmt.Sprintf(containerProcessEventsTopicFormat, id, processID))
+}
+
+func namespaceTopic(namespace, topic string) string {
+	if namespace == namespaces.Default {
+		return topic
+	}
+	return namespace + "." + topic
+}
+
+func fromGRPCIDMappings(mappings []*api.IDMapping) []specs.LinuxIDMapping {
+	var out []specs.LinuxIDMapping
+	for _, m := range mappings {
+		out = append(out, specs.LinuxIDMapping{
+			ContainerID: m.ContainerID,
+			HostID:      m.HostID,
+			Size:        m.Length,
+		})
+	}
+	return out
+}
+
+func fromGRPCHostEntries(entries []*api.HostEntry) []HostEntry {
+	var out []HostEntry
+	for _, e := range entries {
+		out = append(out, HostEntry{
+			IP:    e.IP,
+			Names: e.Names,
+		})
+	}
+	return out
+}
+
+// readSecrets reads the data of the secrets to mount from the backend.
+func (s *Service) readSecrets(ctx context.Context, mounts []*api.SecretMount) ([]Secret, error) {
+	if len(mounts) == 0 {
+		return nil, nil
+	}
+	if s.secrets == nil {
+		return nil, errors.New("secrets require a secret backend configured on the daemon")
+	}
+	var out []Secret
+	for _, m := range mounts {
+		if !filepath.IsAbs(m.Target) {
+			return nil, errors.Errorf("target of secret %q must be an absolute path", m.Name)
+		}
+		data, err := s.secrets.Get(ctx, m.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read secret")
+		}
+		out = append(out, Secret{
+			Name:   m.Name,
+			Target: m.Target,
+			Mode:   os.FileMode(m.Mode),
+			Data:   data,
+		})
+	}
+	return out, nil
 }
 
 func toGRPCContainer(container *Container) *api.Container {
+	var ips []string
+	if r, err := networkResult(container); err == nil && r != nil {
+		ips = r.IPs
+	}
 	c := &api.Container{
-		ID:         container.ID(),
+		ID:         unscopedID(container.ID()),
 		BundlePath: container.Bundle(),
+		Sandbox:    unscopedID(container.Sandbox()),
+		IPs:        ips,
 	}
-	status := container.Status()
+	c.Status = toGRPCStatus(container.Status())
+	return c
+}
+
+func toGRPCStatus(status Status) api.Status {
 	switch status {
-	case "created":
-		c.Status = api.Status_CREATED
-	case "running":
-		c.Status = api.Status_RUNNING
-	case "stopped":
-		c.Status = api.Status_STOPPED
-	case "paused":
-		c.Status = api.Status_PAUSED
+	case Running:
+		return api.Status_RUNNING
+	case Stopped:
+		return api.Status_STOPPED
+	case Paused:
+		return api.Status_PAUSED
 	}
-
-	return c
+	return api.Status_CREATED
 }
 
 func toGRPCProcesses(processes []Process) []*api.Process {
@@ -302,8 +1509,15 @@ func toGRPCProcesses(processes []Process) []*api.Process {
 }
 
 func toGRPCProcess(process Process) *api.Process {
-	return &api.Process{
-		ID:  process.ID(),
-		Pid: process.Pid(),
+	p := &api.Process{
+		ID:     process.ID(),
+		Pid:    process.Pid(),
+		Status: toGRPCStatus(process.Status()),
+	}
+	if r, ok := process.(ExitStatusReader); ok && p.Status == api.Status_STOPPED {
+		if status, err := r.ExitStatus(); err == nil {
+			p.ExitStatus = status
+		}
 	}
+	return p
 }
@@ -0,0 +1,288 @@
+package execution
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	api "github.com/docker/containerd/api/execution"
+	"github.com/docker/containerd/events"
+	"github.com/docker/containerd/portforward"
+)
+
+// testProcess stops on the signals of stopOn only.
+type testProcess struct {
+	stopOn map[os.Signal]bool
+
+	mu       sync.Mutex
+	status   Status
+	received []os.Signal
+}
+
+func (p *testProcess) ID() string { return "init" }
+
+func (p *testProcess) Pid() int64 { return 1 }
+
+func (p *testProcess) Wait() (uint32, error) { return 0, nil }
+
+func (p *testProcess) Signal(sig os.Signal) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.received = append(p.received, sig)
+	if p.stopOn[sig] {
+		p.status = Stopped
+	}
+	return nil
+}
+
+func (p *testProcess) Status() Status {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.status
+}
+
+// serviceEnv returns a service of a test executor rooted in a temporary
+// directory, and the function removing it.
+func serviceEnv(t *testing.T) (*Service, *testExecutor, func()) {
+	root, err := ioutil.TempDir("", "execution-service-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	executor := &testExecutor{root: root, containers: make(map[string]*Container)}
+	s := &Service{
+		executor:   executor,
+		ports:      make(map[string][]*portforward.Forward),
+		watchdog:   newWatchdog(),
+		containers: newContainerCache(),
+		locks:      newContainerLocks(),
+		index:      newContainerIndex(),
+	}
+	return s, executor, func() {
+		os.RemoveAll(root)
+	}
+}
+
+func TestStopContainer(t *testing.T) {
+	s, executor, cleanup := serviceEnv(t)
+	defer cleanup()
+	root := executor.root
+
+	for _, tc := range []struct {
+		name     string
+		stopOn   map[os.Signal]bool
+		received []os.Signal
+	}{
+		{"term", map[os.Signal]bool{syscall.SIGTERM: true}, []os.Signal{syscall.SIGTERM}},
+		{"kill", map[os.Signal]bool{syscall.SIGKILL: true}, []os.Signal{syscall.SIGTERM, syscall.SIGKILL}},
+		{"stuck", nil, []os.Signal{syscall.SIGTERM, syscall.SIGKILL}},
+	} {
+		c, err := NewContainer(root, tc.name, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := &testProcess{stopOn: tc.stopOn, status: Running}
+		c.AddProcess(p, true)
+		err = s.stopContainer(context.Background(), c, 200*time.Millisecond)
+		if (err != nil) != (tc.stopOn == nil) {
+			t.Fatalf("%s: unexpected error %v", tc.name, err)
+		}
+		if len(p.received) != len(tc.received) {
+			t.Fatalf("%s: expected signals %v, got %v", tc.name, tc.received, p.received)
+		}
+		for i := range p.received {
+			if p.received[i] != tc.received[i] {
+				t.Fatalf("%s: expected signals %v, got %v", tc.name, tc.received, p.received)
+			}
+		}
+	}
+}
+
+type testInspector struct {
+	*testExecutor
+	info *ContainerInfo
+}
+
+func (e *testInspector) Inspect(ctx context.Context, c *Container) (*ContainerInfo, error) {
+	return e.info, nil
+}
+
+// reopenProcess is a process whose shim reopens its stdio fifos.
+type reopenProcess struct {
+	*testProcess
+	id       string
+	reopened int
+}
+
+func (p *reopenProcess) ID() string { return p.id }
+
+func (p *reopenProcess) ReopenStdio() error {
+	p.reopened++
+	return nil
+}
+
+func TestRestoreStdio(t *testing.T) {
+	s, executor, cleanup := serviceEnv(t)
+	defer cleanup()
+	root := executor.root
+	stdout := filepath.Join(root, "stdout")
+	if err := syscall.Mkfifo(stdout, 0600); err != nil {
+		t.Fatal(err)
+	}
+	execStdout := filepath.Join(root, "exec", "stdout")
+	s.executor = &testInspector{
+		testExecutor: executor,
+		info: &ContainerInfo{Stdio: map[string]Stdio{
+			"init": {Stdout: stdout, Stderr: filepath.Join(root, "stderr")},
+			"exec": {Stdin: filepath.Join(root, "exec", "stdin"), Stdout: execStdout},
+		}},
+	}
+	c, err := NewContainer(root, "restored", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.AddProcess(&testProcess{status: Running}, true)
+	exec := &reopenProcess{testProcess: &testProcess{status: Running}, id: "exec"}
+	c.AddProcess(exec, false)
+
+	// the output fifos of the processes with a shim are created again for
+	// it to reopen them, the stdin fifos and those of the other processes
+	// being lost
+	if lost := s.restoreStdio(context.Background(), c); lost != 2 {
+		t.Fatalf("expected the removed stderr and stdin fifos to be reported, got %d lost fifos", lost)
+	}
+	if s.watchdog.fifos[stdout] != "restored" {
+		t.Fatalf("expected the stdout fifo to be tracked, got %v", s.watchdog.fifos)
+	}
+	if fi, err := os.Stat(execStdout); err != nil || fi.Mode()&os.ModeNamedPipe == 0 {
+		t.Fatalf("expected the stdout fifo of the exec process to be created again: %v", err)
+	}
+	if exec.reopened != 1 {
+		t.Fatalf("expected the stdio of the exec process to be reopened once, got %d", exec.reopened)
+	}
+}
+
+// slowInspector answers for the container slow once release is closed,
+// sending the error of the context of the request to answered.
+type slowInspector struct {
+	*testExecutor
+	release  chan struct{}
+	answered chan error
+}
+
+func (e *slowInspector) Inspect(ctx context.Context, c *Container) (*ContainerInfo, error) {
+	if c.ID() == "slow" {
+		select {
+		case <-e.release:
+		case <-ctx.Done():
+		}
+		e.answered <- ctx.Err()
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return &ContainerInfo{}, nil
+}
+
+func TestRestoreContainers(t *testing.T) {
+	s, executor, cleanup := serviceEnv(t)
+	defer cleanup()
+	root := executor.root
+	inspector := &slowInspector{
+		testExecutor: executor,
+		release:      make(chan struct{}),
+		answered:     make(chan error, 1),
+	}
+	s.executor = inspector
+	var containers []*Container
+	for i, id := range []string{"slow", "running", "stopped"} {
+		c, err := NewContainer(root, id, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		status := Running
+		if i == 2 {
+			status = Stopped
+		}
+		c.AddProcess(&testProcess{status: status}, true)
+		containers = append(containers, c)
+	}
+
+	start := time.Now()
+	counts := s.restoreContainers(context.Background(), containers, 100*time.Millisecond)
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("expected the slow container not to delay the restore, took %v", d)
+	}
+	if counts.processes != 3 || counts.exited != 1 || counts.timedOut != 1 {
+		t.Fatalf("expected 3 processes, 1 exited and 1 timed out, got %+v", counts)
+	}
+	// the restore which timed out goes on in the background
+	close(inspector.release)
+	select {
+	case err := <-inspector.answered:
+		if err != nil {
+			t.Fatalf("expected the restore of the slow container not to be canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the slow container to be restored in the background")
+	}
+}
+
+// notifyingProcess is a process whose exit is notified by its executor.
+type notifyingProcess struct {
+	*testProcess
+	notify func()
+}
+
+func (p *notifyingProcess) NotifyExit(fn func()) error {
+	p.notify = fn
+	return nil
+}
+
+type exitPoster chan events.Event
+
+func (p exitPoster) Post(ctx context.Context, e events.Event) {
+	p <- e
+}
+
+func TestMonitorNotifiedExit(t *testing.T) {
+	s, executor, cleanup := serviceEnv(t)
+	defer cleanup()
+	c, err := NewContainer(executor.root, "test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &notifyingProcess{testProcess: &testProcess{status: Running}}
+	c.AddProcess(p, true)
+	s.index.put(c)
+	poster := make(exitPoster, 1)
+
+	s.monitorProcess(events.WithPoster(context.Background(), poster), c, p)
+	if p.notify == nil {
+		t.Fatal("expected the exit of the process to be watched")
+	}
+	// the watch is accounted without a goroutine waiting for the process
+	if monitors, _ := s.watchdog.containerState("test"); monitors != 1 {
+		t.Fatalf("expected the watch to be accounted to the container, got %d", monitors)
+	}
+	p.notify()
+	select {
+	case e := <-poster:
+		if exit, ok := e.(*ContainerExitEvent); !ok || exit.PID != "init" {
+			t.Fatalf("expected the exit of the init process, got %#v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the exit to be published")
+	}
+	containers, err := s.listContainers(context.Background(), func(string) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(containers) != 1 || containers[0].Status != api.Status_STOPPED {
+		t.Fatalf("expected the container to be listed stopped, got %v", containers)
+	}
+}
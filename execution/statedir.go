@@ -56,7 +56,7 @@ func (s StateDir) ProcessDir(id string) string {
 func (s StateDir) DeleteProcess(id string) error {
 	err := os.RemoveAll(filepath.Join(s.processesDir(), id))
 	if err != nil {
-		return errors.Wrapf(err, "failed to remove process %d statedir", id)
+		return errors.Wrapf(err, "failed to remove process %s statedir", id)
 	}
 	return nil
 }
@@ -0,0 +1,94 @@
+package execution
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/docker/containerd/cgroups"
+	"github.com/docker/containerd/log"
+	"github.com/pkg/errors"
+)
+
+// DefaultStatsInterval is the interval containers are sampled on by default.
+const DefaultStatsInterval = 10 * time.Second
+
+// StatsCache samples the cgroups of all the running containers of an
+// executor on an interval, so that frequent readers such as the metrics
+// endpoint do not read the cgroup filesystem for every container on each
+// request.
+type StatsCache struct {
+	executor Executor
+	reader   StatsReader
+	interval time.Duration
+
+	mu    sync.Mutex
+	stats map[string]*cgroups.Metrics
+}
+
+// NewStatsCache returns a cache of the stats of executor's containers. The
+// cache is only filled once Run is called.
+func NewStatsCache(executor Executor, interval time.Duration) (*StatsCache, error) {
+	reader, ok := executor.(StatsReader)
+	if !ok {
+		return nil, errors.Wrap(ErrNotSupported, "stats")
+	}
+	if interval <= 0 {
+		return nil, errors.Errorf("invalid stats interval %s", interval)
+	}
+	return &StatsCache{
+		executor: executor,
+		reader:   reader,
+		interval: interval,
+		stats:    make(map[string]*cgroups.Metrics),
+	}, nil
+}
+
+// Run samples the containers until ctx is done.
+func (c *StatsCache) Run(ctx context.Context) {
+	t := time.NewTicker(c.interval)
+	defer t.Stop()
+	for {
+		c.sample(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (c *StatsCache) sample(ctx context.Context) {
+	containers, err := c.executor.List(ctx)
+	if err != nil {
+		log.G(ctx).WithError(err).Error("stats: failed to list containers")
+		return
+	}
+	stats := make(map[string]*cgroups.Metrics)
+	for _, container := range containers {
+		if s := container.Status(); s != Running && s != Paused {
+			continue
+		}
+		m, err := c.reader.Stats(ctx, container)
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("container", container.ID()).Debug("stats: failed to sample container")
+			continue
+		}
+		stats[container.ID()] = m
+	}
+	c.mu.Lock()
+	c.stats = stats
+	c.mu.Unlock()
+}
+
+// Stats returns the last sample of the container. The cgroup is read when
+// the container was not sampled yet, such as right after it started.
+func (c *StatsCache) Stats(ctx context.Context, container *Container) (*cgroups.Metrics, error) {
+	c.mu.Lock()
+	m, ok := c.stats[container.ID()]
+	c.mu.Unlock()
+	if ok {
+		return m, nil
+	}
+	return c.reader.Stats(ctx, container)
+}
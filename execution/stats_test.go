@@ -0,0 +1,61 @@
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/docker/containerd/cgroups"
+)
+
+type testStatsReader struct {
+	*testExecutor
+	reads int
+}
+
+func (e *testStatsReader) Stats(ctx context.Context, c *Container) (*cgroups.Metrics, error) {
+	e.reads++
+	return &cgroups.Metrics{Pids: cgroups.PidsStat{Current: uint64(e.reads)}}, nil
+}
+
+func TestStatsCache(t *testing.T) {
+	executors, cleanup := runtimesEnv(t)
+	defer cleanup()
+	e := &testStatsReader{testExecutor: executors[DefaultRuntime]}
+	e.containers["running"] = LoadContainer("", "running", "", Running)
+	e.containers["stopped"] = LoadContainer("", "stopped", "", Stopped)
+
+	cache, err := NewStatsCache(e, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	cache.sample(ctx)
+	if e.reads != 1 {
+		t.Fatalf("expected only the running container to be sampled, got %d reads", e.reads)
+	}
+	for i := 0; i < 3; i++ {
+		m, err := cache.Stats(ctx, e.containers["running"])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Pids.Current != 1 {
+			t.Fatalf("expected the sampled stats, got %+v", m.Pids)
+		}
+	}
+	if e.reads != 1 {
+		t.Fatalf("expected cached stats to be served, got %d reads", e.reads)
+	}
+
+	// containers not sampled yet are read directly
+	if _, err := cache.Stats(ctx, e.containers["stopped"]); err != nil {
+		t.Fatal(err)
+	}
+	if e.reads != 2 {
+		t.Fatalf("expected a direct read, got %d reads", e.reads)
+	}
+
+	if _, err := NewStatsCache(executors["sandbox"], time.Minute); err == nil {
+		t.Fatal("expected an error for an executor without stats")
+	}
+}
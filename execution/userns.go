@@ -0,0 +1,85 @@
+package execution
+
+import (
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// UserNamespace maps the users and groups of a container to ids of the host,
+// so that root in the container is unprivileged on the host.
+type UserNamespace struct {
+	UIDMappings []specs.LinuxIDMapping `json:"uidMappings" toml:"uidMappings"`
+	GIDMappings []specs.LinuxIDMapping `json:"gidMappings" toml:"gidMappings"`
+}
+
+// Validate fails unless both users and groups are mapped, by ranges that do
+// not overlap.
+func (u *UserNamespace) Validate() error {
+	for _, m := range []struct {
+		kind     string
+		mappings []specs.LinuxIDMapping
+	}{
+		{"uid", u.UIDMappings},
+		{"gid", u.GIDMappings},
+	} {
+		if len(m.mappings) == 0 {
+			return errors.Errorf("user namespace: no %s mapping", m.kind)
+		}
+		for i, a := range m.mappings {
+			if a.Size == 0 {
+				return errors.Errorf("user namespace: empty %s mapping", m.kind)
+			}
+			for _, b := range m.mappings[i+1:] {
+				if overlap(a.ContainerID, b.ContainerID, a.Size, b.Size) || overlap(a.HostID, b.HostID, a.Size, b.Size) {
+					return errors.Errorf("user namespace: overlapping %s mappings", m.kind)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Apply runs the container in a user namespace of its own with the mappings,
+// replacing those of the spec.
+func (u *UserNamespace) Apply(spec *specs.Spec) {
+	if spec.Linux == nil {
+		spec.Linux = &specs.Linux{}
+	}
+	found := false
+	for _, ns := range spec.Linux.Namespaces {
+		if ns.Type == specs.UserNamespace {
+			found = true
+			break
+		}
+	}
+	if !found {
+		spec.Linux.Namespaces = append(spec.Linux.Namespaces, specs.LinuxNamespace{Type: specs.UserNamespace})
+	}
+	spec.Linux.UIDMappings = append([]specs.LinuxIDMapping(nil), u.UIDMappings...)
+	spec.Linux.GIDMappings = append([]specs.LinuxIDMapping(nil), u.GIDMappings...)
+}
+
+// HostUID returns the host id of a uid of the container, false when the uid
+// is not mapped.
+func (u *UserNamespace) HostUID(uid uint32) (uint32, bool) {
+	return hostID(u.UIDMappings, uid)
+}
+
+// HostGID returns the host id of a gid of the container, false when the gid
+// is not mapped.
+func (u *UserNamespace) HostGID(gid uint32) (uint32, bool) {
+	return hostID(u.GIDMappings, gid)
+}
+
+func hostID(mappings []specs.LinuxIDMapping, id uint32) (uint32, bool) {
+	for _, m := range mappings {
+		if id >= m.ContainerID && id-m.ContainerID < m.Size {
+			return m.HostID + id - m.ContainerID, true
+		}
+	}
+	return 0, false
+}
+
+func overlap(a, b, sizeA, sizeB uint32) bool {
+	return uint64(a) < uint64(b)+uint64(sizeB) && uint64(b) < uint64(a)+uint64(sizeA)
+}
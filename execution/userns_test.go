@@ -0,0 +1,49 @@
+package execution
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestUserNamespace(t *testing.T) {
+	u := &UserNamespace{
+		UIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 100000, Size: 65536}},
+		GIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 200000, Size: 1000}},
+	}
+	if err := u.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if id, ok := u.HostUID(1000); !ok || id != 101000 {
+		t.Errorf("unexpected host uid %d", id)
+	}
+	if _, ok := u.HostGID(1000); ok {
+		t.Error("expected gid 1000 to be unmapped")
+	}
+
+	spec := &specs.Spec{Linux: &specs.Linux{Namespaces: []specs.LinuxNamespace{{Type: specs.PIDNamespace}}}}
+	u.Apply(spec)
+	u.Apply(spec)
+	if len(spec.Linux.Namespaces) != 2 || spec.Linux.Namespaces[1].Type != specs.UserNamespace {
+		t.Errorf("expected a single user namespace, got %v", spec.Linux.Namespaces)
+	}
+	if len(spec.Linux.UIDMappings) != 1 || spec.Linux.GIDMappings[0].HostID != 200000 {
+		t.Errorf("unexpected mappings %v %v", spec.Linux.UIDMappings, spec.Linux.GIDMappings)
+	}
+
+	for _, invalid := range []*UserNamespace{
+		{UIDMappings: u.UIDMappings},
+		{UIDMappings: u.UIDMappings, GIDMappings: []specs.LinuxIDMapping{{HostID: 1000}}},
+		{
+			UIDMappings: []specs.LinuxIDMapping{
+				{ContainerID: 0, HostID: 100000, Size: 1000},
+				{ContainerID: 500, HostID: 300000, Size: 1000},
+			},
+			GIDMappings: u.GIDMappings,
+		},
+	} {
+		if err := invalid.Validate(); err == nil {
+			t.Errorf("expected %+v to be invalid", invalid)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package execution
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/containerd/log"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultLeakCheckInterval is the interval the watchdog checks for leaks on
+// by default.
+const DefaultLeakCheckInterval = time.Minute
+
+// Types of the file descriptors open in the daemon.
+const (
+	FDFifo   = "fifo"
+	FDSocket = "socket"
+	FDPipe   = "pipe"
+	FDFile   = "file"
+	FDOther  = "other"
+)
+
+// FD is a file descriptor open in the daemon.
+type FD struct {
+	Type string
+	// Path is the file the descriptor refers to, only set for fifos and
+	// files.
+	Path string
+}
+
+// Leaks reports the goroutines and fifos of the daemon along with those that
+// outlived their container.
+type Leaks struct {
+	// Monitors is the number of goroutines and exit watches monitoring
+	// each container.
+	Monitors map[string]int
+	// FDs is the number of file descriptors open in the daemon by type.
+	FDs map[string]int
+	// LeakedMonitors is the number of goroutines and exit watches still
+	// monitoring each deleted container.
+	LeakedMonitors map[string]int
+	// LeakedFifos maps the stdio fifos still open to their deleted
+	// container.
+	LeakedFifos map[string]string
+}
+
+// watchdog tracks the goroutines monitoring containers and the stdio fifos of
+// their processes, so that those outliving their container are reported.
+type watchdog struct {
+	mu       sync.Mutex
+	monitors map[string]int
+	fifos    map[string]string
+}
+
+func newWatchdog() *watchdog {
+	return &watchdog{
+		monitors: make(map[string]int),
+		fifos:    make(map[string]string),
+	}
+}
+
+// goMonitor runs fn in a goroutine accounted to the container.
+func (w *watchdog) goMonitor(id string, fn func()) {
+	done := w.addMonitor(id)
+	go func() {
+		defer done()
+		fn()
+	}()
+}
+
+// addMonitor accounts a monitor to the container, such as a watch of the
+// exit of one of its processes, until the returned func is called.
+func (w *watchdog) addMonitor(id string) (done func()) {
+	w.mu.Lock()
+	w.monitors[id]++
+	w.mu.Unlock()
+	return func() {
+		w.mu.Lock()
+		if w.monitors[id]--; w.monitors[id] <= 0 {
+			delete(w.monitors, id)
+		}
+		w.mu.Unlock()
+	}
+}
+
+// addFifos records the stdio fifos of a process of the container.
+func (w *watchdog) addFifos(id string, paths ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, p := range paths {
+		if p != "" {
+			w.fifos[filepath.Clean(p)] = id
+		}
+	}
+}
+
+// containerState returns the number of goroutines monitoring the container
+// id and its stdio fifos.
+func (w *watchdog) containerState(id string) (int, []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var fifos []string
+	for p, cid := range w.fifos {
+		if cid == id {
+			fifos = append(fifos, p)
+		}
+	}
+	sort.Strings(fifos)
+	return w.monitors[id], fifos
+}
+
+// check reports the leaks given the containers that exist and the file
+// descriptors open in the daemon. The fifos of deleted containers that are
+// closed are forgotten.
+func (w *watchdog) check(containers map[string]bool, fds []FD) *Leaks {
+	leaks := &Leaks{
+		Monitors:       make(map[string]int),
+		FDs:            make(map[string]int),
+		LeakedMonitors: make(map[string]int),
+		LeakedFifos:    make(map[string]string),
+	}
+	open := make(map[string]bool)
+	for _, fd := range fds {
+		leaks.FDs[fd.Type]++
+		if fd.Type == FDFifo {
+			open[fd.Path] = true
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for id, n := range w.monitors {
+		leaks.Monitors[id] = n
+		if !containers[id] {
+			leaks.LeakedMonitors[id] = n
+		}
+	}
+	for p, id := range w.fifos {
+		if containers[id] {
+			continue
+		}
+		if open[p] {
+			leaks.LeakedFifos[p] = id
+		} else {
+			delete(w.fifos, p)
+		}
+	}
+	return leaks
+}
+
+// Leaks reports the goroutines and fifos that outlived their container.
+func (s *Service) Leaks(ctx context.Context) (*Leaks, error) {
+	list, err := s.executor.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	containers := make(map[string]bool)
+	for _, c := range list {
+		containers[c.ID()] = true
+	}
+	fds, err := openFDs()
+	if err != nil {
+		log.G(ctx).WithError(err).Warn("watchdog: failed to list open file descriptors")
+	}
+	leaks := s.watchdog.check(containers, fds)
+
+	var monitors, leakedMonitors int
+	for _, n := range leaks.Monitors {
+		monitors += n
+	}
+	for _, n := range leaks.LeakedMonitors {
+		leakedMonitors += n
+	}
+	monitorGoroutines.Set(float64(monitors))
+	leakedGoroutines.Set(float64(leakedMonitors))
+	leakedFifos.Set(float64(len(leaks.LeakedFifos)))
+	for _, typ := range []string{FDFifo, FDSocket, FDPipe, FDFile, FDOther} {
+		openFDsGauge.WithValues(typ).Set(float64(leaks.FDs[typ]))
+	}
+	return leaks, nil
+}
+
+// RunWatchdog checks for leaks on interval until ctx is done, logging those
+// found.
+func (s *Service) RunWatchdog(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		leaks, err := s.Leaks(ctx)
+		if err != nil {
+			log.G(ctx).WithError(err).Error("watchdog: failed to check for leaks")
+			continue
+		}
+		for id, n := range leaks.LeakedMonitors {
+			log.G(ctx).WithFields(logrus.Fields{"container": id, "goroutines": n}).Warn("watchdog: goroutines monitoring deleted container")
+		}
+		for p, id := range leaks.LeakedFifos {
+			log.G(ctx).WithFields(logrus.Fields{"container": id, "fifo": p}).Warn("watchdog: fifo of deleted container still open")
+		}
+	}
+}
+
+// openFDs returns the file descriptors open in the daemon.
+func openFDs() ([]FD, error) {
+	dir := "/proc/self/fd"
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var fds []FD
+	for _, e := range entries {
+		p := filepath.Join(dir, e.Name())
+		target, err := os.Readlink(p)
+		if err != nil {
+			// closed since the directory was read
+			continue
+		}
+		switch {
+		case strings.HasPrefix(target, "socket:"):
+			fds = append(fds, FD{Type: FDSocket})
+		case strings.HasPrefix(target, "pipe:"):
+			fds = append(fds, FD{Type: FDPipe})
+		case strings.HasPrefix(target, "/"):
+			fi, err := os.Stat(p)
+			if err != nil {
+				continue
+			}
+			if fi.Mode()&os.ModeNamedPipe != 0 {
+				fds = append(fds, FD{Type: FDFifo, Path: target})
+			} else {
+				fds = append(fds, FD{Type: FDFile, Path: target})
+			}
+		default:
+			fds = append(fds, FD{Type: FDOther})
+		}
+	}
+	return fds, nil
+}
@@ -0,0 +1,70 @@
+package execution
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWatchdog(t *testing.T) {
+	w := newWatchdog()
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	w.goMonitor("running", func() { <-done })
+	w.goMonitor("deleted", func() { <-done })
+	w.goMonitor("deleted", func() { close(exited) })
+	w.addFifos("running", "/run/running/stdout", "")
+	w.addFifos("deleted", "/run/deleted/stdout", "/run/deleted/stderr")
+	<-exited
+
+	containers := map[string]bool{"running": true}
+	fds := []FD{
+		{Type: FDFifo, Path: "/run/running/stdout"},
+		{Type: FDFifo, Path: "/run/deleted/stdout"},
+		{Type: FDSocket},
+	}
+	var leaks *Leaks
+	// the exited monitor is accounted for once its goroutine returns
+	for i := 0; i < 100; i++ {
+		if leaks = w.check(containers, fds); leaks.Monitors["deleted"] == 1 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if leaks.Monitors["running"] != 1 || leaks.Monitors["deleted"] != 1 {
+		t.Fatalf("unexpected monitors %v", leaks.Monitors)
+	}
+	if len(leaks.LeakedMonitors) != 1 || leaks.LeakedMonitors["deleted"] != 1 {
+		t.Fatalf("unexpected leaked monitors %v", leaks.LeakedMonitors)
+	}
+	if len(leaks.LeakedFifos) != 1 || leaks.LeakedFifos["/run/deleted/stdout"] != "deleted" {
+		t.Fatalf("unexpected leaked fifos %v", leaks.LeakedFifos)
+	}
+	if leaks.FDs[FDFifo] != 2 || leaks.FDs[FDSocket] != 1 {
+		t.Fatalf("unexpected fds %v", leaks.FDs)
+	}
+	// the closed fifo of the deleted container is forgotten
+	if _, ok := w.fifos["/run/deleted/stderr"]; ok {
+		t.Fatal("expected the closed fifo to be forgotten")
+	}
+
+	close(done)
+	for i := 0; i < 100; i++ {
+		if leaks = w.check(containers, nil); len(leaks.Monitors) == 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if len(leaks.Monitors) != 0 || len(leaks.LeakedFifos) != 0 {
+		t.Fatalf("expected no monitors nor leaks, got %+v", leaks)
+	}
+}
+
+func TestOpenFDs(t *testing.T) {
+	fds, err := openFDs()
+	if err != nil {
+		t.Skip(err)
+	}
+	if len(fds) == 0 {
+		t.Fatal("expected open file descriptors")
+	}
+}
@@ -0,0 +1,421 @@
+package gc
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/leases"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/snapshot/overlay"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+const (
+	// AnnotationSnapshot is the annotation of the spec of a container
+	// naming the snapshot its root filesystem was prepared from, the image
+	// it was created from being named by images.AnnotationImageName.
+	AnnotationSnapshot = "io.containerd.snapshot.name"
+
+	// maxManifestSize bounds the size of the blobs of unknown media type
+	// read to find whether they are manifests referencing other blobs.
+	maxManifestSize = 4 << 20
+)
+
+// ImageLister lists the images of all namespaces by namespace.
+type ImageLister interface {
+	AllImages() (map[string][]images.Image, error)
+}
+
+// ManifestLister lists manifests kept outside of the namespaces, such as those
+// of the registry cache.
+type ManifestLister interface {
+	Manifests() ([]images.Image, error)
+}
+
+// ContainerLister lists the annotations of the containers of all namespaces.
+type ContainerLister interface {
+	Annotations(ctx context.Context) ([]execution.ContainerAnnotations, error)
+}
+
+// Resource is a resource removed by a collection, its size being that of the
+// disk space reclaimed.
+type Resource struct {
+	Type string
+	ID   string
+	Size int64
+}
+
+// Report is the outcome of a collection.
+type Report struct {
+	DryRun bool
+	// Scanned is the number of blobs and committed snapshots which could be
+	// removed.
+	Scanned int
+	// Removed are the resources removed, or which would be by a dry run.
+	Removed []Resource
+	// Reclaimed is the disk space in bytes of the removed resources.
+	Reclaimed int64
+}
+
+func (r *Report) add(res Resource) {
+	r.Removed = append(r.Removed, res)
+	r.Reclaimed += res.Size
+}
+
+// Collector removes the content and the committed snapshots which are
+// referenced by no lease, image, manifest added, container or active
+// snapshot.
+type Collector struct {
+	content    *content.ContentStore
+	snapshots  *overlay.Overlayfs
+	leases     *leases.Store
+	images     ImageLister
+	containers ContainerLister
+	// manifests are the listers of the manifests kept outside of the
+	// namespaces, by name.
+	manifests map[string]ManifestLister
+	// pruneContainers and pruneImages remove the containers and images
+	// of the prunes, when set.
+	pruneContainers ContainerPruner
+	pruneImages     ImageRemover
+
+	// mu serializes the collections.
+	mu sync.Mutex
+}
+
+// NewCollector returns a collector of the content of cs and the snapshots of
+// the driver snapshots, following the references of the leases, images and
+// containers.
+func NewCollector(cs *content.ContentStore, snapshots *overlay.Overlayfs, leases *leases.Store, images ImageLister, containers ContainerLister) *Collector {
+	return &Collector{
+		content:    cs,
+		snapshots:  snapshots,
+		leases:     leases,
+		images:     images,
+		containers: containers,
+		manifests:  make(map[string]ManifestLister),
+	}
+}
+
+// AddManifests adds the manifests of the lister to the roots of the
+// collections, along with the blobs they reference. It must be called before
+// the first collection.
+func (c *Collector) AddManifests(name string, l ManifestLister) {
+	c.manifests[name] = l
+}
+
+// Collect removes the content and committed snapshots reachable from no
+// lease, image, container or active snapshot, reporting what was removed.
+// Nothing is removed when dryRun is set. The removals stop once ctx is done,
+// what was removed until then being reported along with the error.
+//
+// The references are read from the leases, then the images, then the
+// containers: a reference moved from one to the next is not missed as long
+// as it is added before it is removed. The collection waits for the holds of
+// the content store to be released and keeps new ones from being taken until
+// it completes, the writers of blobs and snapshots holding the store until
+// they referenced them.
+func (c *Collector) Collect(ctx context.Context, dryRun bool) (Report, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	report := Report{DryRun: dryRun}
+	err := c.collect(ctx, &report, nil, nil)
+	return report, err
+}
+
+// collect removes the unreachable content and committed snapshots of the
+// types, all when nil, adding them to report. The nodes of pruned are left
+// out of the roots, for the dry run of a prune to report what the removal
+// of its containers and images makes unreachable.
+func (c *Collector) collect(ctx context.Context, report *Report, pruned, types map[string]bool) error {
+	unlock, err := c.content.LockCollection(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	v, err := c.view(ctx)
+	if err != nil {
+		return err
+	}
+	report.Scanned = len(v.all)
+	var roots []string
+	for _, n := range v.roots {
+		if !pruned[n] {
+			roots = append(roots, n)
+		}
+	}
+	unreachable := Tricolor(roots, v.all, v.refs)
+
+	var snapshots, blobs []string
+	for _, n := range unreachable {
+		typ, id := splitNode(n)
+		if types != nil && !types[typ] {
+			continue
+		}
+		switch typ {
+		case leases.ResourceSnapshot:
+			snapshots = append(snapshots, id)
+		case leases.ResourceContent:
+			blobs = append(blobs, id)
+		}
+	}
+	// the children are removed before their parents
+	depths := make(map[string]int, len(snapshots))
+	for _, name := range snapshots {
+		for p := name; p != ""; p = v.parents[p] {
+			depths[name]++
+		}
+	}
+	sort.Sort(byDepth{names: snapshots, depths: depths})
+	sort.Strings(blobs)
+
+	for _, name := range snapshots {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "collection interrupted")
+		}
+		usage, err := c.snapshots.Usage(name)
+		if err != nil {
+			log.G(ctx).WithError(err).WithField("snapshot", name).Warn("failed to read the usage of snapshot")
+		}
+		if !report.DryRun {
+			if err := c.snapshots.Remove(name); err != nil {
+				log.G(ctx).WithError(err).WithField("snapshot", name).Warn("failed to remove snapshot")
+				continue
+			}
+		}
+		report.add(Resource{Type: leases.ResourceSnapshot, ID: name, Size: usage.Size})
+	}
+	for _, ref := range blobs {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "collection interrupted")
+		}
+		dgst := digest.Digest(ref)
+		var size int64
+		if path, err := c.content.GetPath(dgst); err == nil {
+			if fi, err := os.Stat(path); err == nil {
+				size = fi.Size()
+			}
+		}
+		if !report.DryRun {
+			if err := c.content.Delete(dgst); err != nil {
+				log.G(ctx).WithError(err).WithField("digest", dgst).Warn("failed to remove blob")
+				continue
+			}
+		}
+		report.add(Resource{Type: leases.ResourceContent, ID: ref, Size: size})
+	}
+	return nil
+}
+
+// view is the graph of the references between the resources, read at once.
+// The nodes are named by the type of the resource and its id, scoped by
+// namespace for the images and containers.
+type view struct {
+	content *content.ContentStore
+	roots   []string
+	// all are the content and committed snapshots which may be removed.
+	all   []string
+	edges map[string][]string
+	// descs are the descriptors of the blobs known from the images.
+	descs map[digest.Digest]images.Descriptor
+	// parents are the parents of the snapshots.
+	parents map[string]string
+}
+
+func (c *Collector) view(ctx context.Context) (*view, error) {
+	v := &view{
+		content: c.content,
+		edges:   make(map[string][]string),
+		descs:   make(map[digest.Digest]images.Descriptor),
+		parents: make(map[string]string),
+	}
+	ls, err := c.leases.List("")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the leases")
+	}
+	for _, l := range ls {
+		for _, r := range l.Resources {
+			if r.Type == leases.ResourceImage {
+				v.roots = append(v.roots, node(r.Type, l.Namespace, r.ID))
+			} else {
+				v.roots = append(v.roots, node(r.Type, r.ID))
+			}
+		}
+	}
+
+	all, err := c.images.AllImages()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the images")
+	}
+	for ns, is := range all {
+		for _, image := range is {
+			n := node(leases.ResourceImage, ns, image.Name)
+			v.roots = append(v.roots, n)
+			v.descs[image.Target.Digest] = image.Target
+			v.edges[n] = append(v.edges[n], node(leases.ResourceContent, image.Target.Digest.String()))
+			// the layers of the images which cannot be resolved for the
+			// platform of the daemon were not unpacked
+			if details, err := images.Resolve(c.content, image); err == nil {
+				for _, l := range details.Layers {
+					v.edges[n] = append(v.edges[n], node(leases.ResourceSnapshot, l.ChainID.String()))
+				}
+			}
+		}
+	}
+
+	for name, l := range c.manifests {
+		ms, err := l.Manifests()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list the manifests of %s", name)
+		}
+		for _, m := range ms {
+			n := node("manifests", name, m.Name)
+			v.roots = append(v.roots, n)
+			v.descs[m.Target.Digest] = m.Target
+			v.edges[n] = append(v.edges[n], node(leases.ResourceContent, m.Target.Digest.String()))
+		}
+	}
+
+	containers, err := c.containers.Annotations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the containers")
+	}
+	for _, a := range containers {
+		n := node(ResourceContainer, a.Namespace, a.ID)
+		v.roots = append(v.roots, n)
+		if name := a.Annotations[images.AnnotationImageName]; name != "" {
+			v.edges[n] = append(v.edges[n], node(leases.ResourceImage, a.Namespace, name))
+		}
+		if name := a.Annotations[AnnotationSnapshot]; name != "" {
+			v.edges[n] = append(v.edges[n], node(leases.ResourceSnapshot, name))
+		}
+	}
+
+	infos, err := c.snapshots.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the snapshots")
+	}
+	for _, info := range infos {
+		n := node(leases.ResourceSnapshot, info.Name)
+		if info.Parent != "" {
+			v.parents[info.Name] = info.Parent
+			v.edges[n] = []string{node(leases.ResourceSnapshot, info.Parent)}
+		}
+		if info.Active {
+			v.roots = append(v.roots, n)
+			continue
+		}
+		v.all = append(v.all, n)
+	}
+
+	if err := c.content.Walk(func(path string, dgst digest.Digest) error {
+		v.all = append(v.all, node(leases.ResourceContent, dgst.String()))
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to walk the content")
+	}
+	return v, nil
+}
+
+// refs returns the nodes referenced by the node n, reading the manifests and
+// indexes of the content store.
+func (v *view) refs(n string) []string {
+	typ, id := splitNode(n)
+	if typ != leases.ResourceContent {
+		return v.edges[n]
+	}
+	dgst := digest.Digest(id)
+	desc, ok := v.descs[dgst]
+	if !ok {
+		if desc, ok = v.sniff(dgst); !ok {
+			return nil
+		}
+	}
+	children, err := images.Children(v.content, desc)
+	if err != nil {
+		// a blob missing from the store references nothing
+		return nil
+	}
+	var refs []string
+	for _, c := range children {
+		v.descs[c.Digest] = c
+		refs = append(refs, node(leases.ResourceContent, c.Digest.String()))
+	}
+	return refs
+}
+
+// sniff returns the descriptor of the blob dgst when it holds a manifest or
+// an index, such as the blobs pinned by leases which no image references.
+func (v *view) sniff(dgst digest.Digest) (images.Descriptor, bool) {
+	path, err := v.content.GetPath(dgst)
+	if err != nil {
+		return images.Descriptor{}, false
+	}
+	fi, err := os.Stat(path)
+	if err != nil || fi.Size() > maxManifestSize {
+		return images.Descriptor{}, false
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return images.Descriptor{}, false
+	}
+	var m struct {
+		MediaType string          `json:"mediaType"`
+		Manifests json.RawMessage `json:"manifests"`
+		Layers    json.RawMessage `json:"layers"`
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return images.Descriptor{}, false
+	}
+	desc := images.Descriptor{
+		MediaType: m.MediaType,
+		Digest:    dgst,
+		Size:      fi.Size(),
+	}
+	if desc.MediaType == "" {
+		switch {
+		case m.Manifests != nil:
+			desc.MediaType = images.MediaTypeOCIIndex
+		case m.Layers != nil:
+			desc.MediaType = images.MediaTypeOCIManifest
+		}
+	}
+	return desc, true
+}
+
+func node(typ string, id ...string) string {
+	return typ + "/" + strings.Join(id, "/")
+}
+
+func splitNode(n string) (typ, id string) {
+	parts := strings.SplitN(n, "/", 2)
+	if len(parts) != 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// byDepth sorts snapshots from the deepest, then by name.
+type byDepth struct {
+	names  []string
+	depths map[string]int
+}
+
+func (b byDepth) Len() int { return len(b.names) }
+func (b byDepth) Less(i, j int) bool {
+	if di, dj := b.depths[b.names[i]], b.depths[b.names[j]]; di != dj {
+		return di > dj
+	}
+	return b.names[i] < b.names[j]
+}
+func (b byDepth) Swap(i, j int) { b.names[i], b.names[j] = b.names[j], b.names[i] }
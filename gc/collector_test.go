@@ -0,0 +1,236 @@
+package gc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/leases"
+	"github.com/docker/containerd/snapshot/overlay"
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/net/context"
+)
+
+type testImages map[string][]images.Image
+
+func (i testImages) AllImages() (map[string][]images.Image, error) {
+	return i, nil
+}
+
+type testManifests []images.Image
+
+func (m testManifests) Manifests() ([]images.Image, error) {
+	return m, nil
+}
+
+type testContainers []execution.ContainerAnnotations
+
+func (c testContainers) Annotations(ctx context.Context) ([]execution.ContainerAnnotations, error) {
+	return c, nil
+}
+
+type collectorEnv struct {
+	cs        *content.ContentStore
+	snapshots *overlay.Overlayfs
+	leases    *leases.Store
+}
+
+func newCollectorEnv(t *testing.T) (*collectorEnv, func()) {
+	root, err := ioutil.TempDir("", "gc-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := &collectorEnv{}
+	if env.cs, err = content.OpenContentStore(filepath.Join(root, "content")); err != nil {
+		os.RemoveAll(root)
+		t.Fatal(err)
+	}
+	if env.snapshots, err = overlay.NewOverlayfs(filepath.Join(root, "snapshots")); err != nil {
+		os.RemoveAll(root)
+		t.Fatal(err)
+	}
+	if env.leases, err = leases.NewStore(filepath.Join(root, "leases")); err != nil {
+		os.RemoveAll(root)
+		t.Fatal(err)
+	}
+	return env, func() {
+		os.RemoveAll(root)
+	}
+}
+
+func (env *collectorEnv) blob(t *testing.T, mediaType string, v interface{}) images.Descriptor {
+	p, ok := v.([]byte)
+	if !ok {
+		var err error
+		if p, err = json.Marshal(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dgst := digest.FromBytes(p)
+	if err := content.WriteBlob(env.cs, bytes.NewReader(p), int64(len(p)), dgst); err != nil {
+		t.Fatal(err)
+	}
+	return images.Descriptor{MediaType: mediaType, Digest: dgst, Size: int64(len(p))}
+}
+
+func (env *collectorEnv) commit(t *testing.T, name, parent string) {
+	if _, err := env.snapshots.Prepare("/tmp/"+name, parent); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.snapshots.Commit(name, "/tmp/"+name); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	env, cleanup := newCollectorEnv(t)
+	defer cleanup()
+
+	diffID := digest.FromString("diff")
+	layer := env.blob(t, "application/vnd.oci.image.layer.v1.tar", []byte("layer"))
+	config := env.blob(t, images.MediaTypeOCIConfig, images.Config{
+		RootFS: images.RootFS{Type: "layers", DiffIDs: []digest.Digest{diffID}},
+	})
+	manifest := env.blob(t, images.MediaTypeOCIManifest, images.Manifest{
+		SchemaVersion: 2,
+		Config:        config,
+		Layers:        []images.Descriptor{layer},
+	})
+	// leased manifest without media type in the lease, its layer is found
+	// by reading it
+	leasedLayer := env.blob(t, "application/vnd.oci.image.layer.v1.tar", []byte("leased layer"))
+	leasedManifest := env.blob(t, "", images.Manifest{
+		SchemaVersion: 2,
+		Config:        config,
+		Layers:        []images.Descriptor{leasedLayer},
+	})
+	orphan := env.blob(t, "", []byte("orphan"))
+
+	env.commit(t, diffID.String(), "")
+	env.commit(t, "orphan", "")
+	env.commit(t, "orphan-child", "orphan")
+	env.commit(t, "base", "")
+	env.commit(t, "container", "")
+	env.commit(t, "leased", "")
+	if _, err := env.snapshots.Prepare("/tmp/active", "base"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := env.leases.Create("ci", "pull", 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []leases.Resource{
+		{Type: leases.ResourceContent, ID: leasedManifest.Digest.String()},
+		{Type: leases.ResourceSnapshot, ID: "leased"},
+	} {
+		if err := env.leases.AddResource("ci", "pull", r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := NewCollector(env.cs, env.snapshots, env.leases, testImages{
+		"default": {{Name: "app", Target: manifest}},
+	}, testContainers{
+		{Namespace: "ci", ID: "app", Annotations: map[string]string{AnnotationSnapshot: "container"}},
+	})
+
+	// the collection waits for the writers holding the store
+	release, err := env.cs.Hold()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	_, err = c.Collect(ctx, true)
+	cancel()
+	if err == nil {
+		t.Fatal("expected the collection to wait for the hold of the content store")
+	}
+	if err := release(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Resource{
+		{Type: leases.ResourceSnapshot, ID: "orphan-child"},
+		{Type: leases.ResourceSnapshot, ID: "orphan"},
+		{Type: leases.ResourceContent, ID: orphan.Digest.String(), Size: orphan.Size},
+	}
+	for _, dryRun := range []bool{true, false} {
+		report, err := c.Collect(context.Background(), dryRun)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(report.Removed, expected) {
+			t.Fatalf("expected %v to be removed, got %v", expected, report.Removed)
+		}
+		// the 6 blobs and 6 committed snapshots
+		if report.Scanned != 12 || report.Reclaimed != orphan.Size {
+			t.Fatalf("expected 12 resources scanned and %d bytes reclaimed, got %+v", orphan.Size, report)
+		}
+	}
+	if _, err := env.cs.GetPath(orphan.Digest); err == nil {
+		t.Fatal("expected the orphan blob to be removed")
+	}
+	for _, dgst := range []digest.Digest{layer.Digest, config.Digest, manifest.Digest, leasedLayer.Digest, leasedManifest.Digest} {
+		if _, err := env.cs.GetPath(dgst); err != nil {
+			t.Fatalf("expected blob %s to be kept: %v", dgst, err)
+		}
+	}
+	infos, err := env.snapshots.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 5 {
+		t.Fatalf("expected the image, base, container, leased and active snapshots to be kept, got %v", infos)
+	}
+
+	report, err := c.Collect(context.Background(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Removed) != 0 {
+		t.Fatalf("expected nothing left to remove, got %v", report.Removed)
+	}
+}
+
+func TestCollectManifests(t *testing.T) {
+	env, cleanup := newCollectorEnv(t)
+	defer cleanup()
+
+	layer := env.blob(t, "application/vnd.oci.image.layer.v1.tar", []byte("cached layer"))
+	config := env.blob(t, images.MediaTypeOCIConfig, images.Config{})
+	manifest := env.blob(t, images.MediaTypeOCIManifest, images.Manifest{
+		SchemaVersion: 2,
+		Config:        config,
+		Layers:        []images.Descriptor{layer},
+	})
+	orphan := env.blob(t, "", []byte("orphan"))
+
+	// the manifests of the registry cache belong to no namespace
+	c := NewCollector(env.cs, env.snapshots, env.leases, testImages{}, testContainers{})
+	c.AddManifests("registry-cache", testManifests{
+		{Name: "library/app@" + manifest.Digest.String(), Target: manifest},
+	})
+	report, err := c.Collect(context.Background(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Resource{
+		{Type: leases.ResourceContent, ID: orphan.Digest.String(), Size: orphan.Size},
+	}
+	if !reflect.DeepEqual(report.Removed, expected) {
+		t.Fatalf("expected %v to be removed, got %v", expected, report.Removed)
+	}
+	for _, dgst := range []digest.Digest{layer.Digest, config.Digest, manifest.Digest} {
+		if _, err := env.cs.GetPath(dgst); err != nil {
+			t.Fatalf("expected cached blob %s to be kept: %v", dgst, err)
+		}
+	}
+}
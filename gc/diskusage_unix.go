@@ -0,0 +1,19 @@
+// +build !windows
+
+package gc
+
+import "syscall"
+
+// diskUsage returns the percentage of the disk of path used, as reported by
+// df.
+func diskUsage(path string) (float64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, err
+	}
+	total := st.Blocks - st.Bfree + st.Bavail
+	if total == 0 {
+		return 0, nil
+	}
+	return 100 * float64(st.Blocks-st.Bfree) / float64(total), nil
+}
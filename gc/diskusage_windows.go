@@ -0,0 +1,32 @@
+package gc
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var procGetDiskFreeSpaceEx = syscall.NewLazyDLL("kernel32.dll").NewProc("GetDiskFreeSpaceExW")
+
+// diskUsage returns the percentage of the disk of path used, counting the
+// space available to the daemon only as df does.
+func diskUsage(path string) (float64, error) {
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+	var avail, total, free uint64
+	r, _, err := procGetDiskFreeSpaceEx.Call(
+		uintptr(unsafe.Pointer(p)),
+		uintptr(unsafe.Pointer(&avail)),
+		uintptr(unsafe.Pointer(&total)),
+		uintptr(unsafe.Pointer(&free)),
+	)
+	if r == 0 {
+		return 0, err
+	}
+	used := total - free
+	if used+avail == 0 {
+		return 0, nil
+	}
+	return 100 * float64(used) / float64(used+avail), nil
+}
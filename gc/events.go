@@ -0,0 +1,53 @@
+package gc
+
+import (
+	"time"
+
+	"github.com/docker/containerd/events"
+	"golang.org/x/net/context"
+)
+
+// CollectionEventTopic is the topic of the reports of the collections, their
+// subject being containerd.gc.collection.
+const CollectionEventTopic = "collection"
+
+// CollectionEvent reports a collection once it is done.
+type CollectionEvent struct {
+	Timestamp time.Time
+	// Reason is why the collection ran: "request", "deletions", "window"
+	// or "disk-pressure".
+	Reason string
+	// Duration is the time taken by the collection.
+	Duration  time.Duration
+	Scanned   int
+	Removed   []Resource
+	Reclaimed int64
+	// Error is set when the collection failed or was interrupted, Removed
+	// being what was removed until then.
+	Error string `json:",omitempty"`
+}
+
+// Class returns the class the events are counted under.
+func (e *CollectionEvent) Class() string {
+	return "collection"
+}
+
+// publishReport records the metrics of a collection for reason started at
+// start and publishes its report.
+func publishReport(ctx context.Context, reason string, start time.Time, report Report, err error) {
+	observe(reason, start, report, err)
+	now := time.Now()
+	e := &CollectionEvent{
+		Timestamp: now,
+		Reason:    reason,
+		Duration:  now.Sub(start),
+		Scanned:   report.Scanned,
+		Removed:   report.Removed,
+		Reclaimed: report.Reclaimed,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	ctx = events.WithTopic(ctx, CollectionEventTopic)
+	events.GetPoster(ctx).Post(ctx, e)
+}
@@ -0,0 +1,35 @@
+package gc
+
+import (
+	"time"
+
+	metrics "github.com/docker/go-metrics"
+)
+
+var (
+	gcNamespace        = metrics.NewNamespace("containerd", "gc", nil)
+	collectionDuration = gcNamespace.NewLabeledTimer("collection_duration", "The time taken by the collections by reason", "reason")
+	collectionFailures = gcNamespace.NewLabeledCounter("collection_failures", "The number of collections which failed or were interrupted by reason", "reason")
+	scannedResources   = gcNamespace.NewCounter("scanned", "The number of blobs and committed snapshots scanned by the collections")
+	removedResources   = gcNamespace.NewLabeledCounter("removed", "The number of resources removed by the collections by type", "type")
+	reclaimedBytes     = gcNamespace.NewCounter("reclaimed_bytes", "The disk space reclaimed by the collections in bytes")
+	lastReclaimed      = gcNamespace.NewLabeledGauge("last_reclaimed", "The disk space reclaimed by the last collection by reason", metrics.Bytes, "reason")
+)
+
+func init() {
+	metrics.Register(gcNamespace)
+}
+
+// observe records a collection for reason started at start.
+func observe(reason string, start time.Time, report Report, err error) {
+	collectionDuration.WithValues(reason).UpdateSince(start)
+	if err != nil {
+		collectionFailures.WithValues(reason).Inc()
+	}
+	scannedResources.Inc(float64(report.Scanned))
+	for _, r := range report.Removed {
+		removedResources.WithValues(r.Type).Inc()
+	}
+	reclaimedBytes.Inc(float64(report.Reclaimed))
+	lastReclaimed.WithValues(reason).Set(float64(report.Reclaimed))
+}
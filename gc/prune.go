@@ -0,0 +1,151 @@
+package gc
+
+import (
+	"sort"
+
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/leases"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/namespaces"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// ResourceContainer is the type of the containers deleted by a prune.
+const ResourceContainer = "container"
+
+// ErrPruneUnsupported is returned by the prunes of the containers or images
+// of a collector which cannot remove them.
+var ErrPruneUnsupported = errors.New("pruning is not supported")
+
+// ContainerPruner deletes the stopped containers of a namespace.
+type ContainerPruner interface {
+	// PruneStopped deletes the stopped containers of the namespace of ctx,
+	// returning their ids. Nothing is deleted when dryRun is set.
+	PruneStopped(ctx context.Context, dryRun bool) ([]string, error)
+}
+
+// ImageRemover removes the images of a namespace.
+type ImageRemover interface {
+	// RemoveImage removes the image name of the namespace of ctx.
+	RemoveImage(ctx context.Context, name string) error
+}
+
+// PruneOpts select the resources removed by Prune.
+type PruneOpts struct {
+	// Containers deletes the stopped containers of the namespace.
+	Containers bool
+	// Images removes the images of the namespace which are used by no
+	// container and pinned by no lease.
+	Images bool
+	// Content and Snapshots remove the blobs and the committed snapshots
+	// referenced by nothing.
+	Content   bool
+	Snapshots bool
+	// DryRun reports what would be removed without removing anything.
+	DryRun bool
+}
+
+// SetPruners sets what removes the containers and the images of the prunes.
+// It must be called before the first prune.
+func (c *Collector) SetPruners(containers ContainerPruner, images ImageRemover) {
+	c.pruneContainers = containers
+	c.pruneImages = images
+}
+
+// Prune removes the resources selected by opts, reporting what was removed.
+// The stopped containers and the unused images are those of the namespace
+// of ctx, the content and the committed snapshots are collected as by
+// Collect once the containers and images are removed. A dry run reports the
+// content and snapshots the removal of the containers and images would make
+// unreachable.
+func (c *Collector) Prune(ctx context.Context, opts PruneOpts) (Report, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	report := Report{DryRun: opts.DryRun}
+	ns := namespaces.Namespace(ctx)
+	pruned := make(map[string]bool)
+	if opts.Containers {
+		if c.pruneContainers == nil {
+			return report, errors.Wrap(ErrPruneUnsupported, "containers")
+		}
+		ids, err := c.pruneContainers.PruneStopped(ctx, opts.DryRun)
+		if err != nil {
+			return report, err
+		}
+		for _, id := range ids {
+			pruned[node(ResourceContainer, ns, id)] = true
+			report.add(Resource{Type: ResourceContainer, ID: id})
+		}
+	}
+	if opts.Images {
+		if c.pruneImages == nil {
+			return report, errors.Wrap(ErrPruneUnsupported, "images")
+		}
+		unused, err := c.unusedImages(ctx, ns, pruned)
+		if err != nil {
+			return report, err
+		}
+		for _, name := range unused {
+			if !opts.DryRun {
+				if err := c.pruneImages.RemoveImage(ctx, name); err != nil {
+					log.G(ctx).WithError(err).WithField("image", name).Warn("failed to remove image")
+					continue
+				}
+			}
+			pruned[node(leases.ResourceImage, ns, name)] = true
+			report.add(Resource{Type: leases.ResourceImage, ID: name})
+		}
+	}
+	if !opts.Content && !opts.Snapshots {
+		return report, nil
+	}
+	types := map[string]bool{
+		leases.ResourceContent:  opts.Content,
+		leases.ResourceSnapshot: opts.Snapshots,
+	}
+	err := c.collect(ctx, &report, pruned, types)
+	return report, err
+}
+
+// unusedImages returns the names of the images of the namespace ns which
+// are used by no container, but those of pruned, and pinned by no lease.
+func (c *Collector) unusedImages(ctx context.Context, ns string, pruned map[string]bool) ([]string, error) {
+	used := make(map[string]bool)
+	ls, err := c.leases.List(ns)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the leases")
+	}
+	for _, l := range ls {
+		for _, r := range l.Resources {
+			if r.Type == leases.ResourceImage {
+				used[r.ID] = true
+			}
+		}
+	}
+	containers, err := c.containers.Annotations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the containers")
+	}
+	for _, a := range containers {
+		if a.Namespace != ns || pruned[node(ResourceContainer, a.Namespace, a.ID)] {
+			continue
+		}
+		if name := a.Annotations[images.AnnotationImageName]; name != "" {
+			used[name] = true
+		}
+	}
+	all, err := c.images.AllImages()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the images")
+	}
+	var unused []string
+	for _, image := range all[ns] {
+		if !used[image.Name] {
+			unused = append(unused, image.Name)
+		}
+	}
+	sort.Strings(unused)
+	return unused, nil
+}
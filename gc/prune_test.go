@@ -0,0 +1,164 @@
+package gc
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/leases"
+	"github.com/docker/containerd/namespaces"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// testExecution lists the containers and deletes those which are stopped.
+type testExecution struct {
+	containers testContainers
+	stopped    map[string]bool
+}
+
+func (e *testExecution) Annotations(ctx context.Context) ([]execution.ContainerAnnotations, error) {
+	return e.containers, nil
+}
+
+func (e *testExecution) PruneStopped(ctx context.Context, dryRun bool) ([]string, error) {
+	var (
+		ids  []string
+		kept testContainers
+	)
+	for _, a := range e.containers {
+		if a.Namespace != namespaces.Namespace(ctx) || !e.stopped[a.ID] {
+			kept = append(kept, a)
+			continue
+		}
+		ids = append(ids, a.ID)
+	}
+	if !dryRun {
+		e.containers = kept
+	}
+	return ids, nil
+}
+
+func (i testImages) RemoveImage(ctx context.Context, name string) error {
+	ns := namespaces.Namespace(ctx)
+	for n, image := range i[ns] {
+		if image.Name == name {
+			i[ns] = append(i[ns][:n], i[ns][n+1:]...)
+			return nil
+		}
+	}
+	return errors.Errorf("image %s not found", name)
+}
+
+func TestPrune(t *testing.T) {
+	env, cleanup := newCollectorEnv(t)
+	defer cleanup()
+
+	manifest := func(layer string) (images.Descriptor, []Resource) {
+		l := env.blob(t, "application/vnd.oci.image.layer.v1.tar", []byte(layer))
+		c := env.blob(t, images.MediaTypeOCIConfig, images.Config{RootFS: images.RootFS{Type: layer}})
+		m := env.blob(t, images.MediaTypeOCIManifest, images.Manifest{
+			SchemaVersion: 2,
+			Config:        c,
+			Layers:        []images.Descriptor{l},
+		})
+		var blobs []Resource
+		for _, d := range []images.Descriptor{l, c, m} {
+			blobs = append(blobs, Resource{Type: leases.ResourceContent, ID: d.Digest.String(), Size: d.Size})
+		}
+		sort.Sort(byID(blobs))
+		return m, blobs
+	}
+	app, appBlobs := manifest("app")
+	db, _ := manifest("db")
+	leased, _ := manifest("leased")
+	env.commit(t, "web", "")
+	usage, err := env.snapshots.Usage("web")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := env.leases.Create("default", "pin", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.leases.AddResource("default", "pin", leases.Resource{Type: leases.ResourceImage, ID: "leased"}); err != nil {
+		t.Fatal(err)
+	}
+	imgs := testImages{
+		"default": {
+			{Name: "app", Target: app},
+			{Name: "db", Target: db},
+			{Name: "leased", Target: leased},
+		},
+		// the images of the other namespaces are left alone
+		"ci": {{Name: "app", Target: app}},
+	}
+	exec := &testExecution{
+		containers: testContainers{
+			{Namespace: "default", ID: "web", Annotations: map[string]string{
+				images.AnnotationImageName: "app",
+				AnnotationSnapshot:         "web",
+			}},
+			{Namespace: "default", ID: "db", Annotations: map[string]string{
+				images.AnnotationImageName: "db",
+			}},
+			{Namespace: "ci", ID: "web"},
+		},
+		stopped: map[string]bool{"web": true},
+	}
+	c := NewCollector(env.cs, env.snapshots, env.leases, imgs, exec)
+	ctx := namespaces.WithNamespace(context.Background(), "default")
+	if _, err := c.Prune(ctx, PruneOpts{Containers: true}); errors.Cause(err) != ErrPruneUnsupported {
+		t.Fatalf("expected the prune of containers to be unsupported without pruners, got %v", err)
+	}
+	c.SetPruners(exec, imgs)
+
+	// the blobs of app are kept by the image of the ci namespace
+	expected := []Resource{
+		{Type: ResourceContainer, ID: "web"},
+		{Type: leases.ResourceImage, ID: "app"},
+		{Type: leases.ResourceSnapshot, ID: "web", Size: usage.Size},
+	}
+	report, err := c.Prune(ctx, PruneOpts{Containers: true, Images: true, Content: true, Snapshots: true, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report.Removed, expected) {
+		t.Fatalf("expected %v to be removed by the dry run, got %v", expected, report.Removed)
+	}
+	if len(exec.containers) != 3 || len(imgs["default"]) != 3 {
+		t.Fatalf("expected the dry run to remove nothing, got %v and %v", exec.containers, imgs)
+	}
+
+	// the content is left when only the snapshots are pruned
+	delete(imgs, "ci")
+	report, err = c.Prune(ctx, PruneOpts{Containers: true, Images: true, Snapshots: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report.Removed, expected) {
+		t.Fatalf("expected %v to be removed, got %v", expected, report.Removed)
+	}
+	if len(exec.containers) != 2 || !reflect.DeepEqual(imgs["default"], []images.Image{{Name: "db", Target: db}, {Name: "leased", Target: leased}}) {
+		t.Fatalf("expected the stopped container and the unused image to be removed, got %v and %v", exec.containers, imgs)
+	}
+	if env.snapshots.Committed("web") {
+		t.Fatal("expected the snapshot of the container to be removed")
+	}
+
+	report, err = c.Prune(ctx, PruneOpts{Content: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report.Removed, appBlobs) {
+		t.Fatalf("expected the blobs of the image %v to be removed, got %v", appBlobs, report.Removed)
+	}
+}
+
+type byID []Resource
+
+func (b byID) Len() int           { return len(b) }
+func (b byID) Less(i, j int) bool { return b[i].ID < b[j].ID }
+func (b byID) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
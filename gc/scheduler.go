@@ -0,0 +1,398 @@
+package gc
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/containerd/log"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+const (
+	// DefaultCheckInterval is the interval the disk usage and the windows
+	// are checked on, unless configured otherwise.
+	DefaultCheckInterval = time.Minute
+	// DefaultPause is how long the collections are paused for when no
+	// duration is given.
+	DefaultPause = 10 * time.Minute
+	// ReportInterval is how long the report of a dry run is served for
+	// before Report runs another one.
+	ReportInterval = time.Minute
+)
+
+// reasonDiskPressure is the reason of the collections due to the disk
+// usage.
+const reasonDiskPressure = "disk-pressure"
+
+// ErrPaused is returned by the collections requested while the collections
+// are paused or held.
+var ErrPaused = errors.New("collections are paused")
+
+// Policy configures when the collections are due.
+type Policy struct {
+	// DeletionThreshold is the number of deletions of references after
+	// which a collection is due. None is due after deletions when it is
+	// zero, unless windows are set, a single deletion making one due then.
+	DeletionThreshold int
+	// Windows are the times of the day the collections due after
+	// deletions are deferred to, any time when empty.
+	Windows []Window
+	// Idle defers the collections due after deletions until no container
+	// started and no reference was deleted for that long, within the
+	// windows when they are set. The collections do not wait for the
+	// daemon to be idle when it is zero.
+	Idle time.Duration
+	// HighWatermark is the percentage of the disk of the content and
+	// snapshots used above which a collection is due, whatever the
+	// windows, none when zero. No other collection is due for the disk
+	// usage until it falls below LowWatermark, the high watermark when
+	// zero.
+	HighWatermark float64
+	LowWatermark  float64
+	// CheckInterval is the interval the disk usage and the windows are
+	// checked on, DefaultCheckInterval when zero.
+	CheckInterval time.Duration
+}
+
+// Window is a time of the day, as offsets from midnight in local time. A
+// window ending before it starts spans midnight.
+type Window struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// ParseWindow parses a window such as "01:00-05:30".
+func ParseWindow(s string) (Window, error) {
+	var w Window
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return w, errors.Errorf("invalid window %q, expected HH:MM-HH:MM", s)
+	}
+	for i, dst := range []*time.Duration{&w.Start, &w.End} {
+		t, err := time.Parse("15:04", strings.TrimSpace(parts[i]))
+		if err != nil {
+			return w, errors.Errorf("invalid window %q, expected HH:MM-HH:MM", s)
+		}
+		*dst = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	return w, nil
+}
+
+// Contains returns whether t is in the window, in the location of t.
+func (w Window) Contains(t time.Time) bool {
+	y, m, d := t.Date()
+	offset := t.Sub(time.Date(y, m, d, 0, 0, 0, 0, t.Location()))
+	if w.Start <= w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	return offset >= w.Start || offset < w.End
+}
+
+// Scheduler runs the collections of a collector when they are due by its
+// policy or requested, unless they are paused or held off.
+type Scheduler struct {
+	collector *Collector
+	policy    Policy
+	// usage returns the percentage of the disk used.
+	usage   func() (float64, error)
+	clock   func() time.Time
+	trigger chan struct{}
+
+	mu        sync.Mutex
+	deletions int
+	// pressured is set once a collection ran for the disk usage, until it
+	// falls below the low watermark.
+	pressured bool
+	// active is when a container last started or a reference was last
+	// deleted.
+	active      time.Time
+	holds       int
+	pausedUntil time.Time
+	running     map[*context.CancelFunc]struct{}
+	// collections counts the collections run, for the reports of the dry
+	// runs racing them not to be served.
+	collections int
+	report      Report
+	reportedAt  time.Time
+
+	// reportMu serializes the dry runs of Report.
+	reportMu sync.Mutex
+}
+
+// NewScheduler returns a scheduler of the collections of collector, the disk
+// usage being that of the filesystem of root.
+func NewScheduler(collector *Collector, root string, policy Policy) *Scheduler {
+	return &Scheduler{
+		collector: collector,
+		policy:    policy,
+		usage: func() (float64, error) {
+			return diskUsage(root)
+		},
+		clock:   time.Now,
+		trigger: make(chan struct{}, 1),
+		running: make(map[*context.CancelFunc]struct{}),
+		// the daemon is busy restoring its containers on startup
+		active: time.Now(),
+	}
+}
+
+// Run runs the collections due until ctx is done, the running collection
+// being interrupted then.
+func (s *Scheduler) Run(ctx context.Context) {
+	interval := s.policy.CheckInterval
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.trigger:
+		}
+		if reason := s.due(); reason != "" {
+			s.run(ctx, reason)
+		}
+	}
+}
+
+// Collect runs a collection at once, failing with ErrPaused while the
+// collections are paused or held.
+func (s *Scheduler) Collect(ctx context.Context) (Report, error) {
+	return s.collect(ctx, "request")
+}
+
+// Prune runs a prune at once, failing with ErrPaused while the collections
+// are paused or held unless it is a dry run.
+func (s *Scheduler) Prune(ctx context.Context, opts PruneOpts) (Report, error) {
+	if opts.DryRun {
+		return s.collector.Prune(ctx, opts)
+	}
+	return s.remove(ctx, "prune", func(ctx context.Context) (Report, error) {
+		return s.collector.Prune(ctx, opts)
+	})
+}
+
+// DryRun reports what a collection would remove, even while the collections
+// are paused or held.
+func (s *Scheduler) DryRun(ctx context.Context) (Report, error) {
+	return s.collector.Collect(ctx, true)
+}
+
+// Report returns the report of the last dry run, and when it ran, running
+// one when none ran within ReportInterval or a collection ran since. The
+// callers waiting for a dry run are served its report.
+func (s *Scheduler) Report(ctx context.Context) (Report, time.Time, error) {
+	s.reportMu.Lock()
+	defer s.reportMu.Unlock()
+	s.mu.Lock()
+	report, at, collections := s.report, s.reportedAt, s.collections
+	s.mu.Unlock()
+	if !at.IsZero() && s.clock().Sub(at) < ReportInterval {
+		return report, at, nil
+	}
+	at = s.clock()
+	report, err := s.collector.Collect(ctx, true)
+	if err != nil {
+		return Report{}, time.Time{}, err
+	}
+	s.mu.Lock()
+	if s.collections == collections {
+		s.report, s.reportedAt = report, at
+	}
+	s.mu.Unlock()
+	return report, at, nil
+}
+
+// collect runs a collection for reason, publishing its report.
+func (s *Scheduler) collect(ctx context.Context, reason string) (Report, error) {
+	return s.remove(ctx, reason, func(ctx context.Context) (Report, error) {
+		return s.collector.Collect(ctx, false)
+	})
+}
+
+// remove runs the removals of fn for reason, as a collection, publishing
+// its report.
+func (s *Scheduler) remove(ctx context.Context, reason string, fn func(context.Context) (Report, error)) (Report, error) {
+	start := time.Now()
+	ctx, done, err := s.start(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+	defer done()
+	s.mu.Lock()
+	deletions := s.deletions
+	s.mu.Unlock()
+	report, err := fn(ctx)
+	if err == nil {
+		// the deletions made during the collection are left for the next
+		s.mu.Lock()
+		s.deletions -= deletions
+		s.mu.Unlock()
+	}
+	// the last report is stale once anything may have been removed
+	s.mu.Lock()
+	s.collections++
+	s.reportedAt = time.Time{}
+	s.mu.Unlock()
+	publishReport(ctx, reason, start, report, err)
+	return report, err
+}
+
+// Deleted records the deletion of a reference, making a collection due once
+// the deletion threshold is reached.
+func (s *Scheduler) Deleted() {
+	now := s.clock()
+	s.mu.Lock()
+	s.deletions++
+	s.active = now
+	s.mu.Unlock()
+	s.notify()
+}
+
+// Hold interrupts the running collections and defers the next ones until
+// the returned function is called, such as while a container starts.
+func (s *Scheduler) Hold() func() {
+	s.mu.Lock()
+	s.holds++
+	s.cancelRunning()
+	s.mu.Unlock()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			now := s.clock()
+			s.mu.Lock()
+			s.holds--
+			s.active = now
+			s.mu.Unlock()
+			s.notify()
+		})
+	}
+}
+
+// Pause interrupts the running collections and defers the next ones for d,
+// DefaultPause when it is zero.
+func (s *Scheduler) Pause(d time.Duration) {
+	if d <= 0 {
+		d = DefaultPause
+	}
+	s.mu.Lock()
+	s.pausedUntil = s.clock().Add(d)
+	s.cancelRunning()
+	s.mu.Unlock()
+}
+
+// Resume ends a pause.
+func (s *Scheduler) Resume() {
+	s.mu.Lock()
+	s.pausedUntil = time.Time{}
+	s.mu.Unlock()
+	s.notify()
+}
+
+// due returns why a collection is due, empty when none is.
+func (s *Scheduler) due() string {
+	var usage float64
+	if s.policy.HighWatermark > 0 {
+		var err error
+		if usage, err = s.usage(); err != nil {
+			log.L.WithError(err).Warn("failed to read the disk usage")
+		}
+	}
+	now := s.clock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.holds > 0 || now.Before(s.pausedUntil) {
+		return ""
+	}
+	if s.policy.HighWatermark > 0 {
+		low := s.policy.LowWatermark
+		if low == 0 {
+			low = s.policy.HighWatermark
+		}
+		if usage < low {
+			s.pressured = false
+		}
+		if usage >= s.policy.HighWatermark && !s.pressured {
+			return reasonDiskPressure
+		}
+	}
+	threshold := s.policy.DeletionThreshold
+	if len(s.policy.Windows) == 0 && s.policy.Idle == 0 {
+		if threshold > 0 && s.deletions >= threshold {
+			return "deletions"
+		}
+		return ""
+	}
+	if threshold == 0 {
+		threshold = 1
+	}
+	if s.deletions < threshold {
+		return ""
+	}
+	if s.policy.Idle > 0 && now.Sub(s.active) < s.policy.Idle {
+		return ""
+	}
+	if len(s.policy.Windows) == 0 {
+		return "idle"
+	}
+	for _, w := range s.policy.Windows {
+		if w.Contains(now) {
+			return "window"
+		}
+	}
+	return ""
+}
+
+func (s *Scheduler) run(ctx context.Context, reason string) {
+	logger := log.G(ctx).WithField("reason", reason)
+	report, err := s.collect(ctx, reason)
+	switch {
+	case err == ErrPaused:
+		return
+	case err != nil:
+		logger = logger.WithError(err)
+	case reason == reasonDiskPressure:
+		s.mu.Lock()
+		s.pressured = true
+		s.mu.Unlock()
+	}
+	logger.WithField("scanned", report.Scanned).WithField("removed", len(report.Removed)).WithField("reclaimed", report.Reclaimed).Info("collection done")
+}
+
+// start registers a collection, returning its context, canceled when the
+// collections are paused or held, and the function to call once it is done.
+func (s *Scheduler) start(ctx context.Context) (context.Context, func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.holds > 0 || s.clock().Before(s.pausedUntil) {
+		return nil, nil, ErrPaused
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s.running[&cancel] = struct{}{}
+	return ctx, func() {
+		s.mu.Lock()
+		delete(s.running, &cancel)
+		s.mu.Unlock()
+		cancel()
+	}, nil
+}
+
+func (s *Scheduler) cancelRunning() {
+	for cancel := range s.running {
+		(*cancel)()
+	}
+}
+
+// notify wakes up the loop of Run for it to check whether a collection is
+// due.
+func (s *Scheduler) notify() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,251 @@
+package gc
+
+import (
+	stdcontext "context"
+	"testing"
+	"time"
+
+	"github.com/docker/containerd/events"
+	"golang.org/x/net/context"
+)
+
+func TestWindow(t *testing.T) {
+	for _, tc := range []struct {
+		window   string
+		at       string
+		contains bool
+	}{
+		{"01:00-05:30", "03:00", true},
+		{"01:00-05:30", "05:30", false},
+		{"01:00-05:30", "00:59", false},
+		{"22:00-02:00", "23:00", true},
+		{"22:00-02:00", "01:00", true},
+		{"22:00-02:00", "12:00", false},
+	} {
+		w, err := ParseWindow(tc.window)
+		if err != nil {
+			t.Fatal(err)
+		}
+		at, err := time.Parse("15:04", tc.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w.Contains(at) != tc.contains {
+			t.Errorf("expected %s in %s to be %v", tc.at, tc.window, tc.contains)
+		}
+	}
+	for _, v := range []string{"", "01:00", "1-2", "25:00-01:00"} {
+		if _, err := ParseWindow(v); err == nil {
+			t.Errorf("expected window %q to be invalid", v)
+		}
+	}
+}
+
+func TestSchedulerDue(t *testing.T) {
+	night := time.Date(2017, time.June, 1, 3, 0, 0, 0, time.Local)
+	day := night.Add(12 * time.Hour)
+	window, err := ParseWindow("01:00-05:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name      string
+		policy    Policy
+		deletions int
+		usage     float64
+		now       time.Time
+		// idle is how long before now the daemon was last active
+		idle time.Duration
+		due  string
+	}{
+		{"nothing", Policy{DeletionThreshold: 2}, 1, 0, day, 0, ""},
+		{"deletions", Policy{DeletionThreshold: 2}, 2, 0, day, 0, "deletions"},
+		{"no threshold", Policy{}, 10, 0, day, 0, ""},
+		{"outside window", Policy{Windows: []Window{window}}, 10, 0, day, 0, ""},
+		{"window", Policy{Windows: []Window{window}}, 1, 0, night, 0, "window"},
+		{"busy", Policy{Idle: time.Hour}, 1, 0, day, time.Minute, ""},
+		{"idle", Policy{Idle: time.Hour}, 1, 0, day, 2 * time.Hour, "idle"},
+		{"idle without deletions", Policy{Idle: time.Hour}, 0, 0, day, 2 * time.Hour, ""},
+		{"busy in window", Policy{Idle: time.Hour, Windows: []Window{window}}, 1, 0, night, time.Minute, ""},
+		{"idle in window", Policy{Idle: time.Hour, Windows: []Window{window}}, 1, 0, night, 2 * time.Hour, "window"},
+		{"idle outside window", Policy{Idle: time.Hour, Windows: []Window{window}}, 1, 0, day, 2 * time.Hour, ""},
+		{"window without deletions", Policy{Windows: []Window{window}}, 0, 0, night, 0, ""},
+		{"pressure", Policy{HighWatermark: 90, Windows: []Window{window}}, 0, 95, day, 0, reasonDiskPressure},
+		{"below watermark", Policy{HighWatermark: 90}, 0, 85, day, 0, ""},
+	} {
+		s := NewScheduler(nil, "", tc.policy)
+		s.usage = func() (float64, error) { return tc.usage, nil }
+		s.clock = func() time.Time { return tc.now }
+		s.deletions = tc.deletions
+		s.active = tc.now.Add(-tc.idle)
+		if due := s.due(); due != tc.due {
+			t.Errorf("%s: expected %q to be due, got %q", tc.name, tc.due, due)
+		}
+	}
+}
+
+func TestSchedulerPressureRearm(t *testing.T) {
+	usage := 95.0
+	s := NewScheduler(nil, "", Policy{HighWatermark: 90, LowWatermark: 80})
+	s.usage = func() (float64, error) { return usage, nil }
+	s.pressured = true
+	if due := s.due(); due != "" {
+		t.Fatalf("expected no collection until the usage falls below the low watermark, got %q", due)
+	}
+	usage = 79
+	if due := s.due(); due != "" {
+		t.Fatalf("expected no collection below the watermarks, got %q", due)
+	}
+	usage = 91
+	if due := s.due(); due != reasonDiskPressure {
+		t.Fatalf("expected a collection once the usage reached the high watermark again, got %q", due)
+	}
+}
+
+func TestSchedulerPause(t *testing.T) {
+	s := NewScheduler(nil, "", Policy{DeletionThreshold: 1})
+	s.Deleted()
+
+	release := s.Hold()
+	if _, err := s.Collect(context.Background()); err != ErrPaused {
+		t.Fatalf("expected the collections to be held, got %v", err)
+	}
+	if due := s.due(); due != "" {
+		t.Fatalf("expected no collection to be due while held, got %q", due)
+	}
+	release()
+	release()
+	if s.holds != 0 {
+		t.Fatalf("expected the hold to be released once, got %d holds", s.holds)
+	}
+
+	ctx, done, err := s.start(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer done()
+	s.Pause(time.Minute)
+	if ctx.Err() == nil {
+		t.Fatal("expected the running collection to be interrupted")
+	}
+	if _, err := s.Collect(context.Background()); err != ErrPaused {
+		t.Fatalf("expected the collections to be paused, got %v", err)
+	}
+	s.Resume()
+	if due := s.due(); due != "deletions" {
+		t.Fatalf("expected the deletions to make a collection due once resumed, got %q", due)
+	}
+}
+
+func TestSchedulerActive(t *testing.T) {
+	now := time.Date(2017, time.June, 1, 3, 0, 0, 0, time.Local)
+	s := NewScheduler(nil, "", Policy{Idle: time.Hour})
+	s.clock = func() time.Time { return now }
+	s.Deleted()
+	now = now.Add(2 * time.Hour)
+	if due := s.due(); due != "idle" {
+		t.Fatalf("expected a collection to be due once idle, got %q", due)
+	}
+
+	// a container starting makes the daemon active again
+	s.Hold()()
+	if due := s.due(); due != "" {
+		t.Fatalf("expected no collection to be due once a container started, got %q", due)
+	}
+}
+
+func TestSchedulerRunCanceled(t *testing.T) {
+	s := NewScheduler(nil, "", Policy{})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the scheduler to stop once its context is canceled")
+	}
+}
+
+type testPoster struct {
+	events []interface{}
+}
+
+func (p *testPoster) Post(ctx stdcontext.Context, e events.Event) {
+	p.events = append(p.events, e)
+}
+
+func TestSchedulerReport(t *testing.T) {
+	env, cleanup := newCollectorEnv(t)
+	defer cleanup()
+	orphan := env.blob(t, "", []byte("orphan"))
+	c := NewCollector(env.cs, env.snapshots, env.leases, testImages{}, testContainers{})
+	s := NewScheduler(c, "", Policy{})
+	poster := &testPoster{}
+	ctx := events.WithPoster(context.Background(), poster)
+
+	s.Pause(time.Minute)
+	report, err := s.DryRun(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Removed) != 1 || report.Reclaimed != orphan.Size {
+		t.Fatalf("expected the orphan blob to be reported while paused, got %+v", report)
+	}
+	if len(poster.events) != 0 {
+		t.Fatalf("expected no event for a dry run, got %v", poster.events)
+	}
+	s.Resume()
+
+	if _, err := s.Collect(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(poster.events) != 1 {
+		t.Fatalf("expected a report of the collection, got %v", poster.events)
+	}
+	e, ok := poster.events[0].(*CollectionEvent)
+	if !ok || e.Reason != "request" || len(e.Removed) != 1 || e.Reclaimed != orphan.Size || e.Duration <= 0 {
+		t.Fatalf("unexpected report %+v", poster.events[0])
+	}
+}
+
+func TestSchedulerReportCached(t *testing.T) {
+	env, cleanup := newCollectorEnv(t)
+	defer cleanup()
+	env.blob(t, "", []byte("orphan"))
+	c := NewCollector(env.cs, env.snapshots, env.leases, testImages{}, testContainers{})
+	s := NewScheduler(c, "", Policy{})
+	now := time.Date(2017, time.June, 1, 3, 0, 0, 0, time.Local)
+	s.clock = func() time.Time { return now }
+	ctx := context.Background()
+
+	report, at, err := s.Report(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Removed) != 1 || !at.Equal(now) {
+		t.Fatalf("expected the orphan blob to be reported, got %+v at %v", report, at)
+	}
+
+	// the report is served until it expires
+	env.blob(t, "", []byte("other"))
+	now = now.Add(ReportInterval / 2)
+	if report, at, err = s.Report(ctx); err != nil || len(report.Removed) != 1 || at.Equal(now) {
+		t.Fatalf("expected the last report to be served, got %+v at %v: %v", report, at, err)
+	}
+	now = now.Add(ReportInterval)
+	if report, at, err = s.Report(ctx); err != nil || len(report.Removed) != 2 || !at.Equal(now) {
+		t.Fatalf("expected an expired report to be run again, got %+v at %v: %v", report, at, err)
+	}
+
+	// a collection makes the last report stale
+	if _, err := s.Collect(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if report, _, err = s.Report(ctx); err != nil || len(report.Removed) != 0 {
+		t.Fatalf("expected the report to be run again after a collection, got %+v: %v", report, err)
+	}
+}
@@ -0,0 +1,109 @@
+package gc
+
+import (
+	"time"
+
+	api "github.com/docker/containerd/api/gc"
+	google_protobuf "github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var emptyResponse = &google_protobuf.Empty{}
+
+// NewService returns the GC service running the collections with scheduler.
+func NewService(scheduler *Scheduler) *Service {
+	return &Service{scheduler: scheduler}
+}
+
+type Service struct {
+	scheduler *Scheduler
+}
+
+var _ = (api.GCServiceServer)(&Service{})
+
+func (s *Service) Collect(ctx context.Context, r *api.CollectRequest) (*api.CollectResponse, error) {
+	var (
+		report Report
+		err    error
+	)
+	if r.DryRun {
+		report, err = s.scheduler.DryRun(ctx)
+	} else {
+		report, err = s.scheduler.Collect(ctx)
+	}
+	if err == ErrPaused {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &api.CollectResponse{
+		Removed:   toGRPCResources(report.Removed),
+		Reclaimed: report.Reclaimed,
+		Scanned:   int64(report.Scanned),
+	}, nil
+}
+
+func (s *Service) Prune(ctx context.Context, r *api.PruneRequest) (*api.PruneResponse, error) {
+	report, err := s.scheduler.Prune(ctx, PruneOpts{
+		Containers: r.Containers,
+		Images:     r.Images,
+		Content:    r.Content,
+		Snapshots:  r.Snapshots,
+		DryRun:     r.DryRun,
+	})
+	switch errors.Cause(err) {
+	case nil:
+	case ErrPaused:
+		return nil, grpc.Errorf(codes.FailedPrecondition, "%v", err)
+	case ErrPruneUnsupported:
+		return nil, grpc.Errorf(codes.Unimplemented, "%v", err)
+	default:
+		return nil, err
+	}
+	return &api.PruneResponse{
+		Removed:   toGRPCResources(report.Removed),
+		Reclaimed: report.Reclaimed,
+	}, nil
+}
+
+func (s *Service) Report(ctx context.Context, r *api.ReportRequest) (*api.ReportResponse, error) {
+	report, at, err := s.scheduler.Report(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ReportResponse{
+		Removable:   toGRPCResources(report.Removed),
+		Reclaimable: report.Reclaimed,
+		Scanned:     int64(report.Scanned),
+		Timestamp:   at.UnixNano(),
+	}, nil
+}
+
+func (s *Service) Pause(ctx context.Context, r *api.PauseRequest) (*google_protobuf.Empty, error) {
+	if r.Duration < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid pause duration %v", time.Duration(r.Duration))
+	}
+	s.scheduler.Pause(time.Duration(r.Duration))
+	return emptyResponse, nil
+}
+
+func (s *Service) Resume(ctx context.Context, r *api.ResumeRequest) (*google_protobuf.Empty, error) {
+	s.scheduler.Resume()
+	return emptyResponse, nil
+}
+
+func toGRPCResources(resources []Resource) []*api.Resource {
+	var out []*api.Resource
+	for _, r := range resources {
+		out = append(out, &api.Resource{
+			Type:  r.Type,
+			ID:    r.ID,
+			Size_: r.Size,
+		})
+	}
+	return out
+}
@@ -0,0 +1,28 @@
+// Package identity carries the identity of the caller of a request through
+// its context, for auditing and authorization.
+package identity
+
+import "context"
+
+// Peer is the process connected to the daemon over its unix socket, as
+// reported by the kernel when the connection was accepted.
+type Peer struct {
+	PID int32  `json:"pid"`
+	UID uint32 `json:"uid"`
+	// GID is the primary group of the process.
+	GID uint32 `json:"gid"`
+}
+
+type peerKey struct{}
+
+// WithPeer returns a context carrying the peer that made the request.
+func WithPeer(ctx context.Context, p Peer) context.Context {
+	return context.WithValue(ctx, peerKey{}, p)
+}
+
+// PeerFromContext returns the peer that made the request, if the request
+// came over the unix socket.
+func PeerFromContext(ctx context.Context) (Peer, bool) {
+	p, ok := ctx.Value(peerKey{}).(Peer)
+	return p, ok
+}
@@ -0,0 +1,18 @@
+package identity
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPeerContext(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := PeerFromContext(ctx); ok {
+		t.Fatal("expected no peer in an empty context")
+	}
+	p := Peer{PID: 42, UID: 1000, GID: 100}
+	got, ok := PeerFromContext(WithPeer(ctx, p))
+	if !ok || got != p {
+		t.Fatalf("expected peer %v, got %v", p, got)
+	}
+}
@@ -0,0 +1,627 @@
+package images
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/containerd/content"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MediaTypeCheckpointConfig is the config of the manifest of a
+	// checkpoint, its first layer is the checkpoint of MediaTypeCheckpoint.
+	MediaTypeCheckpointConfig = "application/vnd.containerd.checkpoint.config.v1+json"
+	// MediaTypeCheckpoint is a tar archive of the directory of a checkpoint.
+	MediaTypeCheckpoint = "application/vnd.containerd.checkpoint.criu.v1.tar"
+	// MediaTypeCheckpointSpec is the runtime spec of the container a
+	// checkpoint was taken of, an optional layer of its manifest.
+	MediaTypeCheckpointSpec = "application/vnd.containerd.checkpoint.spec.v1+json"
+	// MediaTypeCheckpointRootfs is a tar archive of the root filesystem of
+	// the container a checkpoint was taken of, an optional layer of its
+	// manifest.
+	MediaTypeCheckpointRootfs = "application/vnd.containerd.checkpoint.rootfs.v1.tar"
+	// MediaTypeCheckpointRWLayer is a tar archive of the changes of the
+	// writable snapshot of the root filesystem of the container a checkpoint
+	// was taken of, with the whiteouts of the layers of the images, an
+	// optional layer of its manifest applied over the rootfs.
+	MediaTypeCheckpointRWLayer = "application/vnd.containerd.checkpoint.rw.v1.tar"
+
+	// CheckpointParentDir is the directory of a checkpoint holding the
+	// pre-dump it is incremental to, the checkpoint being taken with it as
+	// its parent path, as the pre-dumps of execution.PreDumpDir. The
+	// pre-dump is archived as a checkpoint of its own.
+	CheckpointParentDir = "pre-dump"
+
+	// checkpointWorkDir holds the logs of criu in the directory of a
+	// checkpoint, it is not archived.
+	checkpointWorkDir = "criu.work"
+
+	// whiteoutPrefix marks the entries of a layer removing the file of the
+	// same name without it, and whiteoutOpaque the directories whose
+	// entries are replaced by those of the layer.
+	whiteoutPrefix = ".wh."
+	whiteoutOpaque = whiteoutPrefix + whiteoutPrefix + ".opq"
+	// overlayOpaque is the xattr of the opaque directories of overlay.
+	overlayOpaque = "trusted.overlay.opaque"
+)
+
+// CheckpointConfig describes the container a checkpoint was taken of.
+type CheckpointConfig struct {
+	ContainerID string    `json:"containerID"`
+	Created     time.Time `json:"created"`
+	// Parent is the manifest of the pre-dump the checkpoint is incremental
+	// to, extracted into its CheckpointParentDir.
+	Parent *Descriptor `json:"parent,omitempty"`
+	// EmptyNS are the namespaces left out of the checkpoint, those of the
+	// container it is restored into being joined instead.
+	EmptyNS []string `json:"emptyNS,omitempty"`
+}
+
+// CheckpointFiles are the files of a checkpoint archived by WriteCheckpoint
+// and extracted by ReadCheckpoint, the spec, rootfs and writable layer being
+// left out when their path is empty.
+type CheckpointFiles struct {
+	// Dir is the directory of the images of criu.
+	Dir string
+	// Spec is the runtime spec of the container, the config.json of its
+	// bundle.
+	Spec string
+	// Rootfs is the root filesystem of the container. It replaces the
+	// existing one when extracted, the files missing from the archive being
+	// removed.
+	Rootfs string
+	// RWLayer is the upper directory of the overlay of the writable snapshot
+	// of the root filesystem of the container, archived as its changes. They
+	// are applied over Rootfs when extracted, along with their whiteouts.
+	RWLayer string
+}
+
+// WriteCheckpoint archives the checkpoint files into the content store,
+// returning the descriptor of a manifest with config as its config, which is
+// pushed and pulled as an image. The pre-dumps in the CheckpointParentDir of
+// the directory of the checkpoint are archived as its parent, unless config
+// has one already written.
+func WriteCheckpoint(cs *content.ContentStore, files CheckpointFiles, config CheckpointConfig) (Descriptor, error) {
+	if config.Parent == nil {
+		parent := filepath.Join(files.Dir, CheckpointParentDir)
+		if _, err := os.Stat(parent); err == nil {
+			desc, err := WriteCheckpoint(cs, CheckpointFiles{Dir: parent}, CheckpointConfig{
+				ContainerID: config.ContainerID,
+				Created:     config.Created,
+			})
+			if err != nil {
+				return Descriptor{}, err
+			}
+			config.Parent = &desc
+		} else if !os.IsNotExist(err) {
+			return Descriptor{}, err
+		}
+	}
+	layer, err := writeArchive(cs, MediaTypeCheckpoint, files.Dir, false, func(name string) bool {
+		return name == checkpointWorkDir || name == CheckpointParentDir
+	})
+	if err != nil {
+		return Descriptor{}, errors.Wrapf(err, "failed to archive checkpoint %s", files.Dir)
+	}
+	layers := []Descriptor{layer}
+	if files.Spec != "" {
+		p, err := ioutil.ReadFile(files.Spec)
+		if err != nil {
+			return Descriptor{}, errors.Wrap(err, "failed to read spec")
+		}
+		layer, err := writeBlob(cs, MediaTypeCheckpointSpec, p)
+		if err != nil {
+			return Descriptor{}, err
+		}
+		layers = append(layers, layer)
+	}
+	if files.Rootfs != "" {
+		layer, err := writeArchive(cs, MediaTypeCheckpointRootfs, files.Rootfs, false, func(string) bool { return false })
+		if err != nil {
+			return Descriptor{}, errors.Wrapf(err, "failed to archive rootfs %s", files.Rootfs)
+		}
+		layers = append(layers, layer)
+	}
+	if files.RWLayer != "" {
+		layer, err := writeArchive(cs, MediaTypeCheckpointRWLayer, files.RWLayer, true, func(string) bool { return false })
+		if err != nil {
+			return Descriptor{}, errors.Wrapf(err, "failed to archive writable layer %s", files.RWLayer)
+		}
+		layers = append(layers, layer)
+	}
+	p, err := json.Marshal(config)
+	if err != nil {
+		return Descriptor{}, err
+	}
+	configDesc, err := writeBlob(cs, MediaTypeCheckpointConfig, p)
+	if err != nil {
+		return Descriptor{}, err
+	}
+	if p, err = json.Marshal(Manifest{
+		SchemaVersion: 2,
+		MediaType:     MediaTypeOCIManifest,
+		Config:        configDesc,
+		Layers:        layers,
+	}); err != nil {
+		return Descriptor{}, err
+	}
+	return writeBlob(cs, MediaTypeOCIManifest, p)
+}
+
+// ReadCheckpoint extracts the checkpoint of the manifest desc, written by
+// WriteCheckpoint, into the files along with the pre-dumps it is incremental
+// to, returning its config. The spec and rootfs are extracted when the
+// checkpoint has them and their path is set, the rootfs replacing the
+// existing one and the writable layer being applied over the rootfs,
+// extracted or not.
+func ReadCheckpoint(cs *content.ContentStore, desc Descriptor, files CheckpointFiles) (CheckpointConfig, error) {
+	var (
+		config   CheckpointConfig
+		manifest Manifest
+	)
+	if desc.MediaType != MediaTypeOCIManifest && desc.MediaType != MediaTypeDockerManifest {
+		return config, errors.Errorf("%s is not a checkpoint", desc.Digest)
+	}
+	if err := readJSON(cs, desc, &manifest); err != nil {
+		return config, errors.Wrapf(err, "failed to read manifest %v", desc.Digest)
+	}
+	layers, err := checkpointLayers(manifest)
+	if err != nil {
+		return config, errors.Wrapf(err, "%s is not a checkpoint", desc.Digest)
+	}
+	if err := readJSON(cs, manifest.Config, &config); err != nil {
+		return config, errors.Wrapf(err, "failed to read checkpoint config %v", manifest.Config.Digest)
+	}
+	if err := extractBlob(cs, layers[MediaTypeCheckpoint], files.Dir, extractOver); err != nil {
+		return config, errors.Wrapf(err, "failed to extract checkpoint %v", layers[MediaTypeCheckpoint].Digest)
+	}
+	if config.Parent != nil {
+		if _, err := ReadCheckpoint(cs, *config.Parent, CheckpointFiles{Dir: filepath.Join(files.Dir, CheckpointParentDir)}); err != nil {
+			return config, errors.Wrapf(err, "failed to read the pre-dump of %v", desc.Digest)
+		}
+	}
+	if spec, ok := layers[MediaTypeCheckpointSpec]; ok && files.Spec != "" {
+		var p json.RawMessage
+		if err := readJSON(cs, spec, &p); err != nil {
+			return config, errors.Wrapf(err, "failed to read spec %v", spec.Digest)
+		}
+		if err := ioutil.WriteFile(files.Spec, p, 0644); err != nil {
+			return config, err
+		}
+	}
+	if rootfs, ok := layers[MediaTypeCheckpointRootfs]; ok && files.Rootfs != "" {
+		if err := extractBlob(cs, rootfs, files.Rootfs, extractReplace); err != nil {
+			return config, errors.Wrapf(err, "failed to extract rootfs %v", rootfs.Digest)
+		}
+	}
+	if rw, ok := layers[MediaTypeCheckpointRWLayer]; ok && files.Rootfs != "" {
+		if err := extractBlob(cs, rw, files.Rootfs, extractLayer); err != nil {
+			return config, errors.Wrapf(err, "failed to apply writable layer %v", rw.Digest)
+		}
+	}
+	return config, nil
+}
+
+// CheckpointHasRootfs reports whether the checkpoint of the manifest desc has
+// a rootfs or a writable layer, extracted into the rootfs of a bundle.
+func CheckpointHasRootfs(cs *content.ContentStore, desc Descriptor) (bool, error) {
+	var manifest Manifest
+	if err := readJSON(cs, desc, &manifest); err != nil {
+		return false, errors.Wrapf(err, "failed to read manifest %v", desc.Digest)
+	}
+	layers, err := checkpointLayers(manifest)
+	if err != nil {
+		return false, errors.Wrapf(err, "%s is not a checkpoint", desc.Digest)
+	}
+	_, rootfs := layers[MediaTypeCheckpointRootfs]
+	_, rw := layers[MediaTypeCheckpointRWLayer]
+	return rootfs || rw, nil
+}
+
+// checkpointLayers returns the layers of the manifest of a checkpoint by
+// media type, the checkpoint of criu being the first and the others
+// optional.
+func checkpointLayers(manifest Manifest) (map[string]Descriptor, error) {
+	if manifest.Config.MediaType != MediaTypeCheckpointConfig || len(manifest.Layers) == 0 || manifest.Layers[0].MediaType != MediaTypeCheckpoint {
+		return nil, errors.New("unexpected config or layers")
+	}
+	layers := make(map[string]Descriptor)
+	for _, l := range manifest.Layers {
+		switch l.MediaType {
+		case MediaTypeCheckpoint, MediaTypeCheckpointSpec, MediaTypeCheckpointRootfs, MediaTypeCheckpointRWLayer:
+		default:
+			return nil, errors.Errorf("unexpected layer of type %s", l.MediaType)
+		}
+		if _, ok := layers[l.MediaType]; ok {
+			return nil, errors.Errorf("duplicate layer of type %s", l.MediaType)
+		}
+		layers[l.MediaType] = l
+	}
+	return layers, nil
+}
+
+func extractBlob(cs *content.ContentStore, desc Descriptor, dir string, mode extractMode) error {
+	rc, err := content.OpenBlob(cs, desc.Digest)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return extractArchive(rc, dir, mode)
+}
+
+func writeBlob(cs *content.ContentStore, mediaType string, p []byte) (Descriptor, error) {
+	desc := Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromBytes(p),
+		Size:      int64(len(p)),
+	}
+	if _, err := cs.GetPath(desc.Digest); err == nil {
+		return desc, nil
+	}
+	return desc, content.WriteBlob(cs, bytes.NewReader(p), desc.Size, desc.Digest)
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+// writeArchive writes a tar archive of dir of mediaType into the content
+// store, as the size and digest of the archive are only known once it is
+// written. The entries at the top of dir for which skip returns true are
+// left out. The whiteouts of dir are those of overlay when overlay is set,
+// archived as those of the layers of the images.
+func writeArchive(cs *content.ContentStore, mediaType, dir string, overlay bool, skip func(name string) bool) (Descriptor, error) {
+	cw, err := cs.Begin(fmt.Sprintf("checkpoint-%d", time.Now().UnixNano()))
+	if err != nil {
+		return Descriptor{}, err
+	}
+	defer cw.Close()
+	digester := digest.Canonical.Digester()
+	w := &countingWriter{w: io.MultiWriter(cw, digester.Hash())}
+	tw := tar.NewWriter(w)
+	// the files linked more than once are archived once, as links to the
+	// first path they were found at
+	links := make(map[[2]uint64]string)
+	err = filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil || name == "." {
+			return err
+		}
+		if filepath.Dir(name) == "." && skip(name) {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		var link string
+		if fi.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+		if fi.Mode()&os.ModeSocket != 0 {
+			return nil
+		}
+		if overlay && isOverlayWhiteout(fi) {
+			return tw.WriteHeader(&tar.Header{
+				Name:     filepath.ToSlash(filepath.Join(filepath.Dir(name), whiteoutPrefix+fi.Name())),
+				Typeflag: tar.TypeReg,
+				Mode:     0600,
+				ModTime:  fi.ModTime(),
+			})
+		}
+		hdr, err := tar.FileInfoHeader(fi, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(name)
+		if key, ok := hardLinkKey(fi); ok {
+			if first, ok := links[key]; ok {
+				hdr.Typeflag = tar.TypeLink
+				hdr.Linkname = first
+				hdr.Size = 0
+			} else {
+				links[key] = hdr.Name
+			}
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if overlay && fi.IsDir() && isOverlayOpaque(path) {
+			if err := tw.WriteHeader(&tar.Header{
+				Name:     hdr.Name + "/" + whiteoutOpaque,
+				Typeflag: tar.TypeReg,
+				Mode:     0600,
+				ModTime:  fi.ModTime(),
+			}); err != nil {
+				return err
+			}
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return Descriptor{}, err
+	}
+	if err := tw.Close(); err != nil {
+		return Descriptor{}, err
+	}
+	desc := Descriptor{
+		MediaType: mediaType,
+		Digest:    digester.Digest(),
+		Size:      w.n,
+	}
+	return desc, cw.Commit(desc.Size, desc.Digest)
+}
+
+// extractMode is how an archive is extracted over the files of a directory.
+type extractMode int
+
+const (
+	// extractOver keeps the files missing from the archive.
+	extractOver extractMode = iota
+	// extractReplace removes the files missing from the archive, the archive
+	// being the whole tree.
+	extractReplace
+	// extractLayer keeps the files missing from the archive but those its
+	// whiteouts mark, the archive being the changes of a layer.
+	extractLayer
+)
+
+// extractArchive extracts an archive written by writeArchive into dir,
+// rejecting the entries outside of dir, including through the symlinks of
+// the archive. The entries replace the files found at their path, they are
+// owned by their uid and gid when extracted as root. The files of dir
+// missing from the archive are removed or kept depending on mode.
+func extractArchive(r io.Reader, dir string, mode extractMode) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	var (
+		tr    = tar.NewReader(r)
+		chown = os.Geteuid() == 0
+		// the times of the directories are set once their entries are
+		// extracted
+		dirs []*tar.Header
+		// extracted are the entries of the archive, kept in the opaque
+		// directories
+		extracted = make(map[string]bool)
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name, err := entryName(dir, hdr.Name)
+		if err != nil {
+			return err
+		}
+		if name == "." && hdr.Typeflag != tar.TypeDir {
+			// the directory itself would be replaced, the later entries
+			// being extracted through it
+			return errors.Errorf("invalid entry %s of the archive", hdr.Name)
+		}
+		path := filepath.Join(dir, name)
+		if base := filepath.Base(name); mode == extractLayer && strings.HasPrefix(base, whiteoutPrefix) {
+			if base == whiteoutOpaque {
+				err = clearDir(filepath.Dir(path), extracted)
+			} else if removed := strings.TrimPrefix(base, whiteoutPrefix); removed == "." || removed == ".." || removed == "" {
+				return errors.Errorf("invalid whiteout %s of the archive", hdr.Name)
+			} else {
+				err = os.RemoveAll(filepath.Join(filepath.Dir(path), removed))
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		extracted[path] = true
+		perm := os.FileMode(hdr.Mode) & os.ModePerm
+		if hdr.Typeflag != tar.TypeDir {
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+		} else if fi, err := os.Lstat(path); err == nil && !fi.IsDir() {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, perm); err != nil {
+				return err
+			}
+			dirs = append(dirs, hdr)
+		case tar.TypeReg, tar.TypeRegA:
+			f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(hdr.Linkname, path); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			target, err := entryName(dir, hdr.Linkname)
+			if err != nil {
+				return err
+			}
+			if err := os.Link(filepath.Join(dir, target), path); err != nil {
+				return err
+			}
+			continue
+		case tar.TypeChar, tar.TypeBlock, tar.TypeFifo:
+			if !chown {
+				// devices cannot be created unprivileged
+				continue
+			}
+			if err := mknod(path, hdr, perm); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+		if chown {
+			if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+				return err
+			}
+		}
+		if hdr.Typeflag == tar.TypeSymlink {
+			continue
+		}
+		// the mode is set past the umask, and the special bits once chown
+		// cleared them
+		if err := os.Chmod(path, perm|tarSpecialBits(hdr.Mode)); err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeDir {
+			if err := os.Chtimes(path, hdr.ModTime, hdr.ModTime); err != nil {
+				return err
+			}
+		}
+	}
+	if mode == extractReplace {
+		// the files are removed before the times of their directories are
+		// set
+		if err := pruneDir(dir, extracted); err != nil {
+			return err
+		}
+	}
+	for i := len(dirs) - 1; i >= 0; i-- {
+		// the directories replaced by later entries, or under a later
+		// symlink, are skipped for their time not to be set through it
+		name, err := entryName(dir, dirs[i].Name)
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		if fi, err := os.Lstat(path); err != nil || !fi.IsDir() {
+			continue
+		}
+		if err := os.Chtimes(path, dirs[i].ModTime, dirs[i].ModTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clearDir removes the entries of dir but those extracted.
+func clearDir(dir string, extracted map[string]bool) error {
+	names, err := readDirNames(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, name := range names {
+		if path := filepath.Join(dir, name); !extracted[path] {
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pruneDir removes the entries of the tree of dir but those extracted.
+func pruneDir(dir string, extracted map[string]bool) error {
+	names, err := readDirNames(dir)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if !extracted[path] {
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+			continue
+		}
+		if fi, err := os.Lstat(path); err == nil && fi.IsDir() {
+			if err := pruneDir(path, extracted); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readDirNames(dir string) ([]string, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return f.Readdirnames(-1)
+}
+
+// entryName returns the path in dir of the entry of an archive, rejecting
+// the paths leaving dir, including through symlinks.
+func entryName(dir, entry string) (string, error) {
+	name := filepath.Clean(filepath.FromSlash(entry))
+	if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		return "", errors.Errorf("invalid entry %s of the archive", entry)
+	}
+	for parent := filepath.Dir(name); parent != "."; parent = filepath.Dir(parent) {
+		if fi, err := os.Lstat(filepath.Join(dir, parent)); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+			return "", errors.Errorf("invalid entry %s of the archive, %s is a symlink", entry, parent)
+		}
+	}
+	return name, nil
+}
+
+// tarSpecialBits returns the setuid, setgid and sticky bits of the mode of a
+// tar header.
+func tarSpecialBits(mode int64) os.FileMode {
+	var m os.FileMode
+	if mode&04000 != 0 {
+		m |= os.ModeSetuid
+	}
+	if mode&02000 != 0 {
+		m |= os.ModeSetgid
+	}
+	if mode&01000 != 0 {
+		m |= os.ModeSticky
+	}
+	return m
+}
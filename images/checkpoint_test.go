@@ -0,0 +1,277 @@
+package images
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestCheckpoint(t *testing.T) {
+	cs, cleanup := contentStoreEnv(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "images-checkpoint-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src, dst := filepath.Join(dir, "src"), filepath.Join(dir, "dst")
+	for _, d := range []string{"criu.work", "sub", CheckpointParentDir} {
+		if err := os.MkdirAll(filepath.Join(src, d), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files := map[string]string{
+		"config.json":          `{"tcp":true}`,
+		"pages-1.img":          "pages",
+		"sub/fdinfo.img":       "fdinfo",
+		"criu.work/d.log":      "log",
+		"pre-dump/pages-1.img": "pre-dumped pages",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(src, name), []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rootfs := filepath.Join(dir, "rootfs")
+	if err := os.MkdirAll(filepath.Join(rootfs, "bin"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(rootfs, "bin", "sh"), []byte("sh"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Link(filepath.Join(rootfs, "bin", "sh"), filepath.Join(rootfs, "bin", "ash")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("bin", filepath.Join(rootfs, "sbin")); err != nil {
+		t.Fatal(err)
+	}
+
+	spec := filepath.Join(dir, "config.json")
+	if err := ioutil.WriteFile(spec, []byte(`{"ociVersion":"1.0.0"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := CheckpointConfig{ContainerID: "test", Created: time.Unix(1, 0).UTC()}
+	parent, err := WriteCheckpoint(cs, CheckpointFiles{Dir: filepath.Join(src, CheckpointParentDir)}, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Parent = &parent
+	desc, err := WriteCheckpoint(cs, CheckpointFiles{Dir: src, Spec: spec, Rootfs: rootfs}, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.MediaType != MediaTypeOCIManifest {
+		t.Fatalf("unexpected manifest %+v", desc)
+	}
+	if ok, err := CheckpointHasRootfs(cs, desc); err != nil || !ok {
+		t.Fatalf("expected the checkpoint to have a rootfs: %v", err)
+	}
+	if ok, err := CheckpointHasRootfs(cs, parent); err != nil || ok {
+		t.Fatalf("expected the pre-dump not to have a rootfs: %v", err)
+	}
+	var manifest Manifest
+	if err := readJSON(cs, desc, &manifest); err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Layers) != 3 || manifest.Layers[1].MediaType != MediaTypeCheckpointSpec || manifest.Layers[2].MediaType != MediaTypeCheckpointRootfs {
+		t.Fatalf("expected the checkpoint, spec and rootfs layers, got %+v", manifest.Layers)
+	}
+	dstRootfs, dstSpec := filepath.Join(dir, "dst-rootfs"), filepath.Join(dir, "dst-config.json")
+	for _, name := range []string{"removed", "bin/removed"} {
+		if err := os.MkdirAll(filepath.Join(dstRootfs, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	read, err := ReadCheckpoint(cs, desc, CheckpointFiles{Dir: dst, Spec: dstSpec, Rootfs: dstRootfs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.ContainerID != config.ContainerID || !read.Created.Equal(config.Created) || read.Parent == nil || read.Parent.Digest != parent.Digest {
+		t.Fatalf("expected config %+v, got %+v", config, read)
+	}
+	if p, err := ioutil.ReadFile(dstSpec); err != nil || string(p) != `{"ociVersion":"1.0.0"}` {
+		t.Fatalf("expected the spec to be extracted, got %q: %v", p, err)
+	}
+	if p, err := ioutil.ReadFile(filepath.Join(dstRootfs, "sbin", "ash")); err != nil || string(p) != "sh" {
+		t.Fatalf("expected the rootfs to be extracted, got %q: %v", p, err)
+	}
+	for _, name := range []string{"removed", "bin/removed"} {
+		if _, err := os.Lstat(filepath.Join(dstRootfs, name)); !os.IsNotExist(err) {
+			t.Fatalf("expected %s missing from the rootfs to be removed, got %v", name, err)
+		}
+	}
+	fi, err := os.Stat(filepath.Join(dstRootfs, "bin", "sh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0755 || fi.Sys().(*syscall.Stat_t).Nlink != 2 {
+		t.Fatalf("expected the hard link to be kept with the mode of the file, got %v", fi.Mode())
+	}
+	for name, data := range files {
+		p, err := ioutil.ReadFile(filepath.Join(dst, name))
+		if name == "criu.work/d.log" {
+			if !os.IsNotExist(err) {
+				t.Fatalf("expected the work directory not to be archived, got %v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(p) != data {
+			t.Fatalf("expected %s to be %q, got %q", name, data, p)
+		}
+	}
+
+	// the pre-dump is archived as the parent unless one is given
+	config.Parent = nil
+	if desc, err = WriteCheckpoint(cs, CheckpointFiles{Dir: src}, config); err != nil {
+		t.Fatal(err)
+	}
+	children, err := Children(cs, desc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parents, err := Children(cs, children[0]); err != nil || len(parents) != 1 || parents[0].Digest != parent.Digest {
+		t.Fatalf("expected the pre-dump %s to be the parent, got %v: %v", parent.Digest, parents, err)
+	}
+
+	image := writeJSON(t, cs, MediaTypeOCIManifest, Manifest{
+		SchemaVersion: 2,
+		Config:        Descriptor{MediaType: MediaTypeOCIConfig},
+	})
+	if _, err := ReadCheckpoint(cs, image, CheckpointFiles{Dir: filepath.Join(dir, "image")}); err == nil {
+		t.Fatal("expected the manifest of an image not to be read as a checkpoint")
+	}
+}
+
+func TestExtractArchiveEscape(t *testing.T) {
+	dir, err := ioutil.TempDir("", "images-archive-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, entries := range [][]*tar.Header{
+		{{Name: "../escaped", Typeflag: tar.TypeReg, Mode: 0600}},
+		{
+			{Name: "etc", Typeflag: tar.TypeSymlink, Linkname: dir},
+			{Name: "etc/escaped", Typeflag: tar.TypeReg, Mode: 0600},
+		},
+		{{Name: "passwd", Typeflag: tar.TypeLink, Linkname: "../../etc/passwd"}},
+		{
+			{Name: "./", Typeflag: tar.TypeSymlink, Linkname: dir},
+			{Name: "escaped", Typeflag: tar.TypeReg, Mode: 0600},
+		},
+		{{Name: ".wh...", Typeflag: tar.TypeReg, Mode: 0600}},
+	} {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, hdr := range entries {
+			if err := tw.WriteHeader(hdr); err != nil {
+				t.Fatal(err)
+			}
+		}
+		tw.Close()
+		if err := extractArchive(&buf, filepath.Join(dir, "rootfs"), extractLayer); err == nil {
+			t.Errorf("expected the archive %s to be rejected", entries[len(entries)-1].Name)
+		}
+		os.RemoveAll(filepath.Join(dir, "rootfs"))
+	}
+
+	// the time of a directory replaced by a symlink is not set through it
+	outside := filepath.Join(dir, "outside")
+	if err := os.Mkdir(outside, 0700); err != nil {
+		t.Fatal(err)
+	}
+	before, err := os.Stat(outside)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, hdr := range []*tar.Header{
+		{Name: "a", Typeflag: tar.TypeDir, Mode: 0700, ModTime: time.Unix(0, 0)},
+		{Name: "a", Typeflag: tar.TypeSymlink, Linkname: outside},
+	} {
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tw.Close()
+	if err := extractArchive(&buf, filepath.Join(dir, "rootfs"), extractLayer); err != nil {
+		t.Fatal(err)
+	}
+	if after, err := os.Stat(outside); err != nil || !after.ModTime().Equal(before.ModTime()) {
+		t.Fatalf("expected the time of the symlink target to be kept, got %v", err)
+	}
+}
+
+func TestCheckpointRWLayer(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("the whiteouts of overlay can only be created as root")
+	}
+	cs, cleanup := contentStoreEnv(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "images-checkpoint-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	criu, upper, rootfs := filepath.Join(dir, "criu"), filepath.Join(dir, "upper"), filepath.Join(dir, "rootfs")
+	for _, d := range []string{criu, filepath.Join(upper, "etc"), filepath.Join(upper, "var"), filepath.Join(rootfs, "etc"), filepath.Join(rootfs, "var")} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"etc/hostname", "etc/removed", "etc/kept", "var/old"} {
+		if err := ioutil.WriteFile(filepath.Join(rootfs, name), []byte("lower"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"etc/hostname", "var/new"} {
+		if err := ioutil.WriteFile(filepath.Join(upper, name), []byte("upper"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := syscall.Mknod(filepath.Join(upper, "etc", "removed"), syscall.S_IFCHR, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := syscall.Setxattr(filepath.Join(upper, "var"), overlayOpaque, []byte("y"), 0); err != nil {
+		t.Skipf("the opaque directories of overlay are not supported: %v", err)
+	}
+
+	desc, err := WriteCheckpoint(cs, CheckpointFiles{Dir: criu, RWLayer: upper}, CheckpointConfig{ContainerID: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadCheckpoint(cs, desc, CheckpointFiles{Dir: filepath.Join(dir, "dst"), Rootfs: rootfs}); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range map[string]string{
+		"etc/hostname": "upper",
+		"etc/kept":     "lower",
+		"etc/removed":  "",
+		"var/new":      "upper",
+		"var/old":      "",
+	} {
+		p, err := ioutil.ReadFile(filepath.Join(rootfs, name))
+		if data == "" {
+			if !os.IsNotExist(err) {
+				t.Errorf("expected %s to be removed, got %v", name, err)
+			}
+			continue
+		}
+		if err != nil || string(p) != data {
+			t.Errorf("expected %s to be %q, got %q: %v", name, data, p, err)
+		}
+	}
+}
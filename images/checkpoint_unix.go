@@ -0,0 +1,49 @@
+// +build !windows
+
+package images
+
+import (
+	"archive/tar"
+	"os"
+	"syscall"
+)
+
+// hardLinkKey returns the device and inode of the regular file fi when it
+// has other links, identifying the links to the same file in an archive.
+func hardLinkKey(fi os.FileInfo) ([2]uint64, bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok || !fi.Mode().IsRegular() || st.Nlink <= 1 {
+		return [2]uint64{}, false
+	}
+	return [2]uint64{uint64(st.Dev), st.Ino}, true
+}
+
+// mknod creates the device or fifo of the header hdr at path.
+func mknod(path string, hdr *tar.Header, perm os.FileMode) error {
+	typ := map[byte]uint32{
+		tar.TypeChar:  syscall.S_IFCHR,
+		tar.TypeBlock: syscall.S_IFBLK,
+		tar.TypeFifo:  syscall.S_IFIFO,
+	}[hdr.Typeflag]
+	return syscall.Mknod(path, typ|uint32(perm), int(mkdev(hdr.Devmajor, hdr.Devminor)))
+}
+
+// isOverlayWhiteout reports whether fi is a whiteout of overlay, a character
+// device numbered 0/0.
+func isOverlayWhiteout(fi os.FileInfo) bool {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	return ok && fi.Mode()&os.ModeCharDevice != 0 && st.Rdev == 0
+}
+
+// isOverlayOpaque reports whether the directory path is opaque to overlay,
+// hiding the directory of the same path of the lower layers.
+func isOverlayOpaque(path string) bool {
+	p := make([]byte, 1)
+	n, err := syscall.Getxattr(path, overlayOpaque, p)
+	return err == nil && n == 1 && p[0] == 'y'
+}
+
+// mkdev returns the device number of major and minor as encoded by Linux.
+func mkdev(major, minor int64) uint64 {
+	return uint64(minor&0xff) | uint64(major&0xfff)<<8 | uint64(minor&^0xff)<<12 | uint64(major&^0xfff)<<32
+}
@@ -0,0 +1,26 @@
+package images
+
+import (
+	"archive/tar"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// hardLinkKey reports no links on Windows, the links of a file are archived
+// as copies.
+func hardLinkKey(fi os.FileInfo) ([2]uint64, bool) {
+	return [2]uint64{}, false
+}
+
+func mknod(path string, hdr *tar.Header, perm os.FileMode) error {
+	return errors.Errorf("cannot create %s, devices are not supported on windows", hdr.Name)
+}
+
+func isOverlayWhiteout(fi os.FileInfo) bool {
+	return false
+}
+
+func isOverlayOpaque(path string) bool {
+	return false
+}
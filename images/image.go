@@ -0,0 +1,18 @@
+package images
+
+import "github.com/opencontainers/go-digest"
+
+// Image provides the model for how containerd views container images. An
+// image is simply a name pointing at a target descriptor, typically a
+// manifest, in the content store.
+type Image struct {
+	Name   string
+	Target Descriptor
+}
+
+// Descriptor describes a blob in the content store.
+type Descriptor struct {
+	MediaType string        `json:"mediaType,omitempty"`
+	Digest    digest.Digest `json:"digest"`
+	Size      int64         `json:"size"`
+}
@@ -0,0 +1,50 @@
+package images
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
+	"github.com/docker/containerd/content"
+	"github.com/pkg/errors"
+)
+
+// gzipMagic starts the layers compressed with gzip.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// ApplyLayer extracts the layer l over dir, removing the files its whiteouts
+// mark, such as onto the mount of a snapshot of its parent. The layer may be
+// compressed with gzip, its uncompressed content is verified against its
+// diff id.
+func ApplyLayer(cs *content.ContentStore, l Layer, dir string) error {
+	rc, err := content.OpenBlob(cs, l.Descriptor.Digest)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	br := bufio.NewReader(rc)
+	var r io.Reader = br
+	if magic, err := br.Peek(len(gzipMagic)); err == nil && bytes.Equal(magic, gzipMagic) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	verifier := l.DiffID.Verifier()
+	r = io.TeeReader(r, verifier)
+	if err := extractArchive(r, dir, extractLayer); err != nil {
+		return err
+	}
+	// the padding of the archive is part of the diff id
+	if _, err := io.Copy(ioutil.Discard, r); err != nil {
+		return err
+	}
+	if !verifier.Verified() {
+		return errors.Errorf("layer %s does not match its diff id %s", l.Descriptor.Digest, l.DiffID)
+	}
+	return nil
+}
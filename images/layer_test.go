@@ -0,0 +1,76 @@
+package images
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/containerd/content"
+	"github.com/opencontainers/go-digest"
+)
+
+func TestApplyLayer(t *testing.T) {
+	cs, cleanup := contentStoreEnv(t)
+	defer cleanup()
+	dir, err := ioutil.TempDir("", "images-layer-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"removed", "kept"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var tarBuf bytes.Buffer
+	tw := tar.NewWriter(&tarBuf)
+	for _, hdr := range []*tar.Header{
+		{Name: "added", Typeflag: tar.TypeReg, Mode: 0644, Size: 5},
+		{Name: whiteoutPrefix + "removed", Typeflag: tar.TypeReg, Mode: 0644},
+	} {
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Size > 0 {
+			tw.Write([]byte("added"))
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var gzBuf bytes.Buffer
+	gz := gzip.NewWriter(&gzBuf)
+	gz.Write(tarBuf.Bytes())
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	l := Layer{
+		Descriptor: Descriptor{Digest: digest.FromBytes(gzBuf.Bytes()), Size: int64(gzBuf.Len())},
+		DiffID:     digest.FromBytes(tarBuf.Bytes()),
+	}
+	if err := content.WriteBlob(cs, bytes.NewReader(gzBuf.Bytes()), l.Descriptor.Size, l.Descriptor.Digest); err != nil {
+		t.Fatal(err)
+	}
+
+	// a layer whose content does not match its diff id is rejected
+	if err := ApplyLayer(cs, Layer{Descriptor: l.Descriptor, DiffID: digest.FromString("other")}, dir); err == nil {
+		t.Fatal("expected a layer not matching its diff id to be rejected")
+	}
+	if err := ApplyLayer(cs, l, dir); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := ioutil.ReadFile(filepath.Join(dir, "added")); err != nil || string(b) != "added" {
+		t.Fatalf("expected the file of the layer to be added, got %q: %v", b, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "removed")); !os.IsNotExist(err) {
+		t.Fatalf("expected the file of the whiteout to be removed, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "kept")); err != nil {
+		t.Fatalf("expected the files missing from the layer to be kept: %v", err)
+	}
+}
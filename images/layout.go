@@ -0,0 +1,190 @@
+package images
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/docker/containerd/content"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+const (
+	layoutFilename = "oci-layout"
+	indexFilename  = "index.json"
+	layoutVersion  = "1.0.0"
+
+	// AnnotationImageName holds the name of an image in the index of a
+	// layout. AnnotationRefName is read when it is missing.
+	AnnotationImageName = "io.containerd.image.name"
+	AnnotationRefName   = "org.opencontainers.image.ref.name"
+)
+
+// layoutDescriptor is an entry of the index of an image layout.
+type layoutDescriptor struct {
+	Descriptor
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type layoutIndex struct {
+	SchemaVersion int                `json:"schemaVersion"`
+	Manifests     []layoutDescriptor `json:"manifests"`
+}
+
+// Export writes the images to w as a tar archive of an OCI image layout. The
+// manifest of platform is exported for the images whose target is an index,
+// as the manifests of the other platforms are not fetched.
+func Export(cs *content.ContentStore, w io.Writer, images []Image, platform Platform) error {
+	tw := tar.NewWriter(w)
+	written := make(map[digest.Digest]bool)
+	index := layoutIndex{SchemaVersion: 2}
+	for _, image := range images {
+		desc, err := SelectManifest(cs, image.Target, platform)
+		if err != nil {
+			return errors.Wrapf(err, "failed to export %s", image.Name)
+		}
+		var manifest Manifest
+		if err := readJSON(cs, desc, &manifest); err != nil {
+			return errors.Wrapf(err, "failed to read manifest %v", desc.Digest)
+		}
+		for _, blob := range append([]Descriptor{desc, manifest.Config}, manifest.Layers...) {
+			if written[blob.Digest] {
+				continue
+			}
+			if err := writeLayoutBlob(tw, cs, blob.Digest); err != nil {
+				return errors.Wrapf(err, "failed to export %s", image.Name)
+			}
+			written[blob.Digest] = true
+		}
+		index.Manifests = append(index.Manifests, layoutDescriptor{
+			Descriptor: desc,
+			Annotations: map[string]string{
+				AnnotationImageName: image.Name,
+			},
+		})
+	}
+	layout, err := json.Marshal(struct {
+		Version string `json:"imageLayoutVersion"`
+	}{layoutVersion})
+	if err != nil {
+		return err
+	}
+	if err := writeLayoutFile(tw, layoutFilename, layout); err != nil {
+		return err
+	}
+	p, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	if err := writeLayoutFile(tw, indexFilename, p); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func writeLayoutBlob(tw *tar.Writer, cs *content.ContentStore, dgst digest.Digest) error {
+	p, err := cs.GetPath(dgst)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name: path.Join("blobs", dgst.Algorithm().String(), dgst.Hex()),
+		Mode: 0444,
+		Size: fi.Size(),
+	}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+func writeLayoutFile(tw *tar.Writer, name string, p []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0444,
+		Size: int64(len(p)),
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(p)
+	return err
+}
+
+// Import reads a tar archive of an OCI image layout from r into the content
+// store, returning the images of its index. The images are named by their
+// annotations, those without a name are left out.
+func Import(cs *content.ContentStore, r io.Reader) ([]Image, error) {
+	tr := tar.NewReader(r)
+	var index *layoutIndex
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			continue
+		}
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "./"))
+		switch {
+		case name == indexFilename:
+			p, err := ioutil.ReadAll(io.LimitReader(tr, 4<<20))
+			if err != nil {
+				return nil, err
+			}
+			index = &layoutIndex{}
+			if err := json.Unmarshal(p, index); err != nil {
+				return nil, errors.Wrap(err, "invalid index of the image layout")
+			}
+		case strings.HasPrefix(name, "blobs/"):
+			parts := strings.Split(name, "/")
+			if len(parts) != 3 {
+				continue
+			}
+			dgst := digest.NewDigestFromHex(parts[1], parts[2])
+			if err := dgst.Validate(); err != nil {
+				return nil, errors.Wrapf(err, "invalid blob %s of the image layout", name)
+			}
+			if _, err := cs.GetPath(dgst); err == nil {
+				continue
+			}
+			if err := content.WriteBlob(cs, tr, hdr.Size, dgst); err != nil {
+				return nil, errors.Wrapf(err, "failed to import blob %s", dgst)
+			}
+		}
+	}
+	if index == nil {
+		return nil, errors.New("the archive is not an image layout, it has no index.json")
+	}
+	var images []Image
+	for _, m := range index.Manifests {
+		if _, err := cs.GetPath(m.Digest); err != nil {
+			return nil, errors.Wrapf(err, "the image layout lacks manifest %s", m.Digest)
+		}
+		name := m.Annotations[AnnotationImageName]
+		if name == "" {
+			name = m.Annotations[AnnotationRefName]
+		}
+		if name == "" {
+			continue
+		}
+		images = append(images, Image{Name: name, Target: m.Descriptor})
+	}
+	return images, nil
+}
@@ -0,0 +1,104 @@
+package images
+
+import (
+	"bytes"
+	"io/ioutil"
+	"runtime"
+	"testing"
+
+	"github.com/docker/containerd/content"
+	"github.com/opencontainers/go-digest"
+)
+
+func TestExportImport(t *testing.T) {
+	cs, cleanup := contentStoreEnv(t)
+	defer cleanup()
+
+	layer := []byte("layer")
+	layerDesc := Descriptor{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: digest.FromBytes(layer), Size: int64(len(layer))}
+	if err := content.WriteBlob(cs, bytes.NewReader(layer), layerDesc.Size, layerDesc.Digest); err != nil {
+		t.Fatal(err)
+	}
+	configDesc := writeJSON(t, cs, MediaTypeOCIConfig, Config{
+		OS:           runtime.GOOS,
+		Architecture: runtime.GOARCH,
+		RootFS:       RootFS{Type: "layers", DiffIDs: []digest.Digest{digest.FromBytes(layer)}},
+	})
+	manifestDesc := writeJSON(t, cs, MediaTypeOCIManifest, Manifest{
+		SchemaVersion: 2,
+		Config:        configDesc,
+		Layers:        []Descriptor{layerDesc},
+	})
+	// the manifest of the other platform is not in the content store
+	indexDesc := writeJSON(t, cs, MediaTypeOCIIndex, Index{
+		SchemaVersion: 2,
+		Manifests: []PlatformManifest{
+			{
+				Descriptor: Descriptor{MediaType: MediaTypeOCIManifest, Digest: digest.FromString("other"), Size: 5},
+				Platform:   Platform{OS: "plan9", Architecture: "mips"},
+			},
+			{
+				Descriptor: manifestDesc,
+				Platform:   DefaultPlatform(),
+			},
+		},
+	})
+
+	var buf bytes.Buffer
+	err := Export(cs, &buf, []Image{
+		{Name: "docker.io/library/app:latest", Target: indexDesc},
+		{Name: "docker.io/library/app:v1", Target: manifestDesc},
+	}, DefaultPlatform())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imported, cleanup := contentStoreEnv(t)
+	defer cleanup()
+	images, err := Import(imported, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 || images[0].Name != "docker.io/library/app:latest" || images[1].Name != "docker.io/library/app:v1" {
+		t.Fatalf("unexpected images %v", images)
+	}
+	for _, image := range images {
+		if image.Target != manifestDesc {
+			t.Fatalf("expected %s to be exported as the manifest of the platform, got %v", image.Name, image.Target)
+		}
+	}
+	details, err := Resolve(imported, images[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc, err := content.OpenBlob(imported, details.Layers[0].Descriptor.Digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	if p, err := ioutil.ReadAll(rc); err != nil || !bytes.Equal(p, layer) {
+		t.Fatalf("unexpected imported layer %q: %v", p, err)
+	}
+
+	if _, err := Import(imported, bytes.NewReader(nil)); err == nil {
+		t.Fatal("expected an empty archive to be rejected")
+	}
+}
+
+func TestParsePlatform(t *testing.T) {
+	p, err := ParsePlatform("linux/arm64/v8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != (Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}) || p.String() != "linux/arm64/v8" {
+		t.Fatalf("unexpected platform %+v", p)
+	}
+	if !(Platform{OS: "linux", Architecture: "arm64"}).Match(p) || p.Match(Platform{OS: "linux", Architecture: "arm64"}) {
+		t.Fatal("expected the variant to be matched only when set")
+	}
+	for _, s := range []string{"linux", "linux/", "/amd64", "linux/arm/v7/x"} {
+		if _, err := ParsePlatform(s); err == nil {
+			t.Fatalf("expected %q to be invalid", s)
+		}
+	}
+}
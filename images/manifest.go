@@ -0,0 +1,209 @@
+package images
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/docker/containerd/content"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+// Media types understood when resolving the contents of an image.
+const (
+	MediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	MediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	MediaTypeDockerConfig       = "application/vnd.docker.container.image.v1+json"
+	MediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+	MediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+	MediaTypeOCIConfig          = "application/vnd.oci.image.config.v1+json"
+)
+
+var ErrUnknownMediaType = errors.New("unknown media type")
+
+// Manifest is the subset of an OCI or docker schema2 manifest used by
+// containerd.
+type Manifest struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType,omitempty"`
+	Config        Descriptor   `json:"config"`
+	Layers        []Descriptor `json:"layers"`
+}
+
+// Index is the subset of an OCI index or docker manifest list used by
+// containerd.
+type Index struct {
+	SchemaVersion int                `json:"schemaVersion"`
+	MediaType     string             `json:"mediaType,omitempty"`
+	Manifests     []PlatformManifest `json:"manifests"`
+}
+
+// PlatformManifest is an index entry, describing the manifest for a single
+// platform.
+type PlatformManifest struct {
+	Descriptor
+	Platform Platform `json:"platform"`
+}
+
+type Platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// Config is the image configuration, as referenced by the manifest.
+type Config struct {
+	Architecture string          `json:"architecture"`
+	OS           string          `json:"os"`
+	Config       ContainerConfig `json:"config"`
+	RootFS       RootFS          `json:"rootfs"`
+}
+
+// ContainerConfig carries the execution defaults for containers created
+// from the image.
+type ContainerConfig struct {
+	User       string            `json:"User,omitempty"`
+	Env        []string          `json:"Env,omitempty"`
+	Entrypoint []string          `json:"Entrypoint,omitempty"`
+	Cmd        []string          `json:"Cmd,omitempty"`
+	WorkingDir string            `json:"WorkingDir,omitempty"`
+	Labels     map[string]string `json:"Labels,omitempty"`
+	StopSignal string            `json:"StopSignal,omitempty"`
+}
+
+type RootFS struct {
+	Type    string          `json:"type"`
+	DiffIDs []digest.Digest `json:"diff_ids"`
+}
+
+// Layer pairs a layer blob with the identifiers of its uncompressed content.
+type Layer struct {
+	Descriptor Descriptor
+	DiffID     digest.Digest
+	ChainID    digest.Digest
+}
+
+// Details is the fully resolved view of an image for the current platform.
+type Details struct {
+	Image            Image
+	Manifest         Descriptor
+	ConfigDescriptor Descriptor
+	Config           Config
+	Layers           []Layer
+}
+
+// Resolve walks the image target in the content store, selecting the
+// manifest for the current platform, and returns its config and layers.
+func Resolve(cs *content.ContentStore, image Image) (*Details, error) {
+	desc, err := SelectManifest(cs, image.Target, DefaultPlatform())
+	if err != nil {
+		return nil, err
+	}
+	var manifest Manifest
+	if err := readJSON(cs, desc, &manifest); err != nil {
+		return nil, errors.Wrapf(err, "failed to read manifest %v", desc.Digest)
+	}
+	var config Config
+	if err := readJSON(cs, manifest.Config, &config); err != nil {
+		return nil, errors.Wrapf(err, "failed to read config %v", manifest.Config.Digest)
+	}
+	if len(config.RootFS.DiffIDs) != len(manifest.Layers) {
+		return nil, errors.Errorf("mismatched layers and diff ids: %d != %d", len(manifest.Layers), len(config.RootFS.DiffIDs))
+	}
+	chain := ChainIDs(config.RootFS.DiffIDs)
+	layers := make([]Layer, len(manifest.Layers))
+	for i, l := range manifest.Layers {
+		layers[i] = Layer{
+			Descriptor: l,
+			DiffID:     config.RootFS.DiffIDs[i],
+			ChainID:    chain[i],
+		}
+	}
+	return &Details{
+		Image:            image,
+		Manifest:         desc,
+		ConfigDescriptor: manifest.Config,
+		Config:           config,
+		Layers:           layers,
+	}, nil
+}
+
+// ChainIDs computes the chain identifier for each layer from the ordered
+// list of diff ids, as defined by the OCI image specification.
+func ChainIDs(diffIDs []digest.Digest) []digest.Digest {
+	chain := make([]digest.Digest, len(diffIDs))
+	for i, d := range diffIDs {
+		if i == 0 {
+			chain[i] = d
+			continue
+		}
+		chain[i] = digest.FromString(chain[i-1].String() + " " + d.String())
+	}
+	return chain
+}
+
+// SelectManifest returns the manifest of platform, desc itself when it is
+// a manifest rather than an index.
+func SelectManifest(cs *content.ContentStore, desc Descriptor, platform Platform) (Descriptor, error) {
+	switch desc.MediaType {
+	case MediaTypeDockerManifest, MediaTypeOCIManifest:
+		return desc, nil
+	case MediaTypeDockerManifestList, MediaTypeOCIIndex:
+		var index Index
+		if err := readJSON(cs, desc, &index); err != nil {
+			return Descriptor{}, errors.Wrapf(err, "failed to read index %v", desc.Digest)
+		}
+		return index.Manifest(platform)
+	}
+	return Descriptor{}, errors.Wrapf(ErrUnknownMediaType, "%q", desc.MediaType)
+}
+
+// Children returns the descriptors referenced by the manifest or index desc,
+// or the pre-dump of the checkpoint config desc, none for the other blobs.
+// The manifests of an index are returned whether they are in the content
+// store or not.
+func Children(cs *content.ContentStore, desc Descriptor) ([]Descriptor, error) {
+	switch desc.MediaType {
+	case MediaTypeDockerManifest, MediaTypeOCIManifest:
+		var manifest Manifest
+		if err := readJSON(cs, desc, &manifest); err != nil {
+			return nil, errors.Wrapf(err, "failed to read manifest %v", desc.Digest)
+		}
+		return append([]Descriptor{manifest.Config}, manifest.Layers...), nil
+	case MediaTypeDockerManifestList, MediaTypeOCIIndex:
+		var index Index
+		if err := readJSON(cs, desc, &index); err != nil {
+			return nil, errors.Wrapf(err, "failed to read index %v", desc.Digest)
+		}
+		var children []Descriptor
+		for _, m := range index.Manifests {
+			children = append(children, m.Descriptor)
+		}
+		return children, nil
+	case MediaTypeCheckpointConfig:
+		var config CheckpointConfig
+		if err := readJSON(cs, desc, &config); err != nil {
+			return nil, errors.Wrapf(err, "failed to read checkpoint config %v", desc.Digest)
+		}
+		if config.Parent != nil {
+			return []Descriptor{*config.Parent}, nil
+		}
+	}
+	return nil, nil
+}
+
+func readJSON(cs *content.ContentStore, desc Descriptor, v interface{}) error {
+	rc, err := content.OpenBlob(cs, desc.Digest)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package images
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/docker/containerd/content"
+	"github.com/opencontainers/go-digest"
+)
+
+func contentStoreEnv(t *testing.T) (*content.ContentStore, func()) {
+	tmpdir, err := ioutil.TempDir("", "images-content-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err := content.OpenContentStore(tmpdir)
+	if err != nil {
+		os.RemoveAll(tmpdir)
+		t.Fatal(err)
+	}
+	return cs, func() {
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func writeJSON(t *testing.T, cs *content.ContentStore, mediaType string, v interface{}) Descriptor {
+	p, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromBytes(p),
+		Size:      int64(len(p)),
+	}
+	if err := content.WriteBlob(cs, bytes.NewReader(p), desc.Size, desc.Digest); err != nil {
+		t.Fatal(err)
+	}
+	return desc
+}
+
+func TestResolve(t *testing.T) {
+	cs, cleanup := contentStoreEnv(t)
+	defer cleanup()
+
+	diffIDs := []digest.Digest{
+		digest.FromString("layer0"),
+		digest.FromString("layer1"),
+	}
+	config := Config{
+		OS:           runtime.GOOS,
+		Architecture: runtime.GOARCH,
+		Config: ContainerConfig{
+			Entrypoint: []string{"/bin/sh"},
+			Env:        []string{"PATH=/bin"},
+			Labels:     map[string]string{"maintainer": "containerd"},
+		},
+		RootFS: RootFS{
+			Type:    "layers",
+			DiffIDs: diffIDs,
+		},
+	}
+	configDesc := writeJSON(t, cs, MediaTypeOCIConfig, config)
+	layers := []Descriptor{
+		{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: digest.FromString("blob0"), Size: 5},
+		{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: digest.FromString("blob1"), Size: 5},
+	}
+	manifestDesc := writeJSON(t, cs, MediaTypeOCIManifest, Manifest{
+		SchemaVersion: 2,
+		Config:        configDesc,
+		Layers:        layers,
+	})
+	indexDesc := writeJSON(t, cs, MediaTypeOCIIndex, Index{
+		SchemaVersion: 2,
+		Manifests: []PlatformManifest{
+			{
+				Descriptor: Descriptor{MediaType: MediaTypeOCIManifest, Digest: digest.FromString("other"), Size: 5},
+				Platform:   Platform{OS: "plan9", Architecture: "mips"},
+			},
+			{
+				Descriptor: manifestDesc,
+				Platform:   Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH},
+			},
+		},
+	})
+
+	details, err := Resolve(cs, Image{Name: "docker.io/library/app:latest", Target: indexDesc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Manifest != manifestDesc {
+		t.Fatalf("unexpected manifest: %v != %v", details.Manifest, manifestDesc)
+	}
+	if details.ConfigDescriptor != configDesc {
+		t.Fatalf("unexpected config descriptor: %v != %v", details.ConfigDescriptor, configDesc)
+	}
+	if !reflect.DeepEqual(details.Config, config) {
+		t.Fatalf("unexpected config: %#v", details.Config)
+	}
+	if len(details.Layers) != len(layers) {
+		t.Fatalf("expected %d layers, got %d", len(layers), len(details.Layers))
+	}
+	chainIDs := []digest.Digest{
+		diffIDs[0],
+		digest.FromString(diffIDs[0].String() + " " + diffIDs[1].String()),
+	}
+	for i, l := range details.Layers {
+		if l.Descriptor != layers[i] {
+			t.Fatalf("layer %d: unexpected descriptor %v", i, l.Descriptor)
+		}
+		if l.DiffID != diffIDs[i] {
+			t.Fatalf("layer %d: unexpected diff id %v", i, l.DiffID)
+		}
+		if l.ChainID != chainIDs[i] {
+			t.Fatalf("layer %d: unexpected chain id %v", i, l.ChainID)
+		}
+	}
+
+	children, err := Children(cs, indexDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 2 || children[1] != manifestDesc {
+		t.Fatalf("unexpected children of the index: %v", children)
+	}
+	children, err = Children(cs, manifestDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(children, append([]Descriptor{configDesc}, layers...)) {
+		t.Fatalf("unexpected children of the manifest: %v", children)
+	}
+	if children, err := Children(cs, configDesc); err != nil || len(children) != 0 {
+		t.Fatalf("expected a config to have no children, got %v, %v", children, err)
+	}
+}
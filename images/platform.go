@@ -0,0 +1,57 @@
+package images
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultPlatform returns the platform containerd runs on.
+func DefaultPlatform() Platform {
+	return Platform{
+		OS:           runtime.GOOS,
+		Architecture: runtime.GOARCH,
+	}
+}
+
+// ParsePlatform parses a platform formatted as os/architecture[/variant],
+// such as "linux/arm64/v8".
+func ParsePlatform(s string) (Platform, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return Platform{}, errors.Errorf("invalid platform %q, expected os/architecture[/variant]", s)
+	}
+	p := Platform{
+		OS:           parts[0],
+		Architecture: parts[1],
+	}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+func (p Platform) String() string {
+	s := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		s += "/" + p.Variant
+	}
+	return s
+}
+
+// Match returns whether other is the platform p, the variant only being
+// compared when p has one.
+func (p Platform) Match(other Platform) bool {
+	return p.OS == other.OS && p.Architecture == other.Architecture && (p.Variant == "" || p.Variant == other.Variant)
+}
+
+// Manifest returns the first manifest of the index matching platform.
+func (i Index) Manifest(platform Platform) (Descriptor, error) {
+	for _, m := range i.Manifests {
+		if platform.Match(m.Platform) {
+			return m.Descriptor, nil
+		}
+	}
+	return Descriptor{}, errors.Errorf("no manifest for platform %s", platform)
+}
@@ -0,0 +1,271 @@
+package images
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	api "github.com/docker/containerd/api/images"
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/namespaces"
+	google_protobuf "github.com/golang/protobuf/ptypes/empty"
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/net/context"
+)
+
+var emptyResponse = &google_protobuf.Empty{}
+
+// UnpackedFunc reports whether the layer chain identified by chainID has
+// been unpacked into a snapshot.
+type UnpackedFunc func(chainID digest.Digest) bool
+
+// NewService returns the image service backed by store and the content in cs.
+// The unpacked func may be nil, in which case no image is reported as
+// unpacked. The store holds the images of the default namespace, those of
+// the other namespaces are kept in stores under its root.
+func NewService(store *Store, cs *content.ContentStore, unpacked UnpackedFunc) *Service {
+	return &Service{
+		store:    store,
+		content:  cs,
+		unpacked: unpacked,
+		stores:   make(map[string]*Store),
+	}
+}
+
+type Service struct {
+	store    *Store
+	content  *content.ContentStore
+	unpacked UnpackedFunc
+
+	mu     sync.Mutex
+	stores map[string]*Store
+}
+
+// namespaceStore returns the store of the images of the namespace of ctx.
+func (s *Service) namespaceStore(ctx context.Context) (*Store, error) {
+	return s.storeOf(namespaces.Namespace(ctx))
+}
+
+// storeOf returns the store of the images of the namespace ns.
+func (s *Service) storeOf(ns string) (*Store, error) {
+	if ns == namespaces.Default {
+		return s.store, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if store, ok := s.stores[ns]; ok {
+		return store, nil
+	}
+	store, err := NewStore(filepath.Join(s.store.root, "namespaces", ns))
+	if err != nil {
+		return nil, err
+	}
+	store.SetCommitInterval(s.store.committer.Interval())
+	s.stores[ns] = store
+	return store, nil
+}
+
+// AllImages returns the images of all namespaces by namespace.
+func (s *Service) AllImages() (map[string][]Image, error) {
+	all := make(map[string][]Image)
+	nss := []string{namespaces.Default}
+	fis, err := ioutil.ReadDir(filepath.Join(s.store.root, "namespaces"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, fi := range fis {
+		if fi.IsDir() {
+			nss = append(nss, fi.Name())
+		}
+	}
+	for _, ns := range nss {
+		store, err := s.storeOf(ns)
+		if err != nil {
+			return nil, err
+		}
+		images, err := store.List()
+		if err != nil {
+			return nil, err
+		}
+		if len(images) > 0 {
+			all[ns] = images
+		}
+	}
+	return all, nil
+}
+
+var _ = (api.ImageServiceServer)(&Service{})
+
+func (s *Service) Get(ctx context.Context, r *api.GetImageRequest) (*api.GetImageResponse, error) {
+	store, err := s.namespaceStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	image, err := store.Get(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetImageResponse{
+		Image: toGRPCImage(image),
+	}, nil
+}
+
+func (s *Service) List(ctx context.Context, r *api.ListImagesRequest) (*api.ListImagesResponse, error) {
+	store, err := s.namespaceStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	images, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.ListImagesResponse{}
+	for _, image := range images {
+		resp.Images = append(resp.Images, toGRPCImage(image))
+	}
+	return resp, nil
+}
+
+func (s *Service) Put(ctx context.Context, r *api.PutImageRequest) (*google_protobuf.Empty, error) {
+	if r.Image == nil || r.Image.Target == nil {
+		return nil, ErrInvalidName
+	}
+	store, err := s.namespaceStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return emptyResponse, store.Put(r.Image.Name, fromGRPCDescriptor(r.Image.Target))
+}
+
+// GetImage returns the image name of the namespace of ctx, as Get.
+func (s *Service) GetImage(ctx context.Context, name string) (Image, error) {
+	store, err := s.namespaceStore(ctx)
+	if err != nil {
+		return Image{}, err
+	}
+	return store.Get(name)
+}
+
+// PutImage names the image target name in the namespace of ctx, as Put.
+func (s *Service) PutImage(ctx context.Context, name string, target Descriptor) error {
+	store, err := s.namespaceStore(ctx)
+	if err != nil {
+		return err
+	}
+	return store.Put(name, target)
+}
+
+// RemoveImage removes the image name of the namespace of ctx, as Untag.
+func (s *Service) RemoveImage(ctx context.Context, name string) error {
+	store, err := s.namespaceStore(ctx)
+	if err != nil {
+		return err
+	}
+	return store.Untag(name)
+}
+
+func (s *Service) Tag(ctx context.Context, r *api.TagImageRequest) (*api.TagImageResponse, error) {
+	store, err := s.namespaceStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	image, err := store.Tag(r.Name, r.Source)
+	if err != nil {
+		return nil, err
+	}
+	return &api.TagImageResponse{
+		Image: toGRPCImage(image),
+	}, nil
+}
+
+func (s *Service) Untag(ctx context.Context, r *api.UntagImageRequest) (*google_protobuf.Empty, error) {
+	store, err := s.namespaceStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return emptyResponse, store.Untag(r.Name)
+}
+
+func (s *Service) Inspect(ctx context.Context, r *api.InspectImageRequest) (*api.InspectImageResponse, error) {
+	store, err := s.namespaceStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	image, err := store.Get(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	details, err := Resolve(s.content, image)
+	if err != nil {
+		return nil, err
+	}
+	config := details.Config
+	resp := &api.InspectImageResponse{
+		Image:    toGRPCImage(image),
+		Manifest: toGRPCDescriptor(details.Manifest),
+		Config: &api.ImageConfig{
+			Blob:         toGRPCDescriptor(details.ConfigDescriptor),
+			OS:           config.OS,
+			Architecture: config.Architecture,
+			User:         config.Config.User,
+			Env:          config.Config.Env,
+			Entrypoint:   config.Config.Entrypoint,
+			Cmd:          config.Config.Cmd,
+			WorkingDir:   config.Config.WorkingDir,
+			Labels:       toGRPCLabels(config.Config.Labels),
+		},
+		Unpacked: s.unpacked != nil,
+	}
+	for _, l := range details.Layers {
+		unpacked := s.unpacked != nil && s.unpacked(l.ChainID)
+		resp.Layers = append(resp.Layers, &api.Layer{
+			Blob:     toGRPCDescriptor(l.Descriptor),
+			DiffID:   l.DiffID.String(),
+			ChainID:  l.ChainID.String(),
+			Unpacked: unpacked,
+		})
+		resp.Unpacked = resp.Unpacked && unpacked
+	}
+	return resp, nil
+}
+
+func toGRPCImage(image Image) *api.Image {
+	return &api.Image{
+		Name:   image.Name,
+		Target: toGRPCDescriptor(image.Target),
+	}
+}
+
+func toGRPCLabels(labels map[string]string) []*api.Label {
+	var keys []string
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var out []*api.Label
+	for _, k := range keys {
+		out = append(out, &api.Label{
+			Key:   k,
+			Value: labels[k],
+		})
+	}
+	return out
+}
+
+func toGRPCDescriptor(desc Descriptor) *api.Descriptor {
+	return &api.Descriptor{
+		MediaType: desc.MediaType,
+		Digest:    desc.Digest.String(),
+		Size_:     desc.Size,
+	}
+}
+
+func fromGRPCDescriptor(desc *api.Descriptor) Descriptor {
+	return Descriptor{
+		MediaType: desc.MediaType,
+		Digest:    digest.Digest(desc.Digest),
+		Size:      desc.Size_,
+	}
+}
@@ -0,0 +1,75 @@
+package images
+
+import (
+	"testing"
+
+	api "github.com/docker/containerd/api/images"
+	"github.com/docker/containerd/namespaces"
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/net/context"
+)
+
+func TestServiceNamespaces(t *testing.T) {
+	store, cleanup := storeEnv(t)
+	defer cleanup()
+	s := NewService(store, nil, nil)
+
+	ci := namespaces.WithNamespace(context.Background(), "ci")
+	if _, err := s.Put(ci, &api.PutImageRequest{
+		Image: &api.Image{
+			Name: "docker.io/library/app:latest",
+			Target: &api.Descriptor{
+				MediaType: "application/vnd.oci.image.manifest.v1+json",
+				Digest:    digest.FromString("manifest").String(),
+				Size_:     8,
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(ci, &api.GetImageRequest{Name: "docker.io/library/app:latest"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(context.Background(), &api.GetImageRequest{Name: "docker.io/library/app:latest"}); err != ErrImageNotFound {
+		t.Fatalf("expected the image not to be found in the default namespace, got %v", err)
+	}
+	resp, err := s.List(context.Background(), &api.ListImagesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Images) != 0 {
+		t.Fatalf("expected no images in the default namespace, got %d", len(resp.Images))
+	}
+}
+
+func TestServiceAllImages(t *testing.T) {
+	store, cleanup := storeEnv(t)
+	defer cleanup()
+	s := NewService(store, nil, nil)
+
+	target := &api.Descriptor{
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Digest:    digest.FromString("manifest").String(),
+		Size_:     8,
+	}
+	for _, ns := range []string{namespaces.Default, "ci"} {
+		ctx := namespaces.WithNamespace(context.Background(), ns)
+		if _, err := s.Put(ctx, &api.PutImageRequest{
+			Image: &api.Image{Name: ns + "/app:latest", Target: target},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	all, err := s.AllImages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("expected the images of 2 namespaces, got %v", all)
+	}
+	for ns, images := range all {
+		if len(images) != 1 || images[0].Name != ns+"/app:latest" {
+			t.Fatalf("unexpected images %v in namespace %s", images, ns)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package images
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/docker/containerd/metadata"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrImageNotFound = errors.New("image not found")
+	ErrInvalidName   = errors.New("invalid image name")
+)
+
+const imagesFilename = "images.json"
+
+// Store maintains the mapping of image names to their targets. Removing a
+// name from the store never removes the content it points to.
+type Store struct {
+	root      string
+	committer *metadata.Committer
+
+	mu     sync.Mutex
+	images map[string]Image
+	// committed are the images last written to disk, restored once a
+	// commit failed.
+	committed map[string]Image
+}
+
+// NewStore opens the image store located at root, loading any names
+// previously written to disk.
+func NewStore(root string) (*Store, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+	s := &Store{
+		root:   root,
+		images: make(map[string]Image),
+	}
+	s.committer = metadata.NewCommitter("images", s.flush)
+	s.committer.SetRollback(&s.mu, s.rollback)
+	b, err := ioutil.ReadFile(filepath.Join(root, imagesFilename))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		var images []Image
+		if err := json.Unmarshal(b, &images); err != nil {
+			return nil, errors.Wrap(err, "failed to decode image store")
+		}
+		for _, image := range images {
+			s.images[image.Name] = image
+		}
+	}
+	s.committed = copyImages(s.images)
+	return s, nil
+}
+
+// Get returns the image registered under name.
+func (s *Store) Get(name string) (Image, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	image, ok := s.images[name]
+	if !ok {
+		return Image{}, ErrImageNotFound
+	}
+	return image, nil
+}
+
+// List returns all images in the store, sorted by name.
+func (s *Store) List() ([]Image, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	images := make([]Image, 0, len(s.images))
+	for _, image := range s.images {
+		images = append(images, image)
+	}
+	sort.Sort(byName(images))
+	return images, nil
+}
+
+// Put registers name as pointing to target, replacing any existing image
+// with the same name.
+func (s *Store) Put(name string, target Descriptor) error {
+	if name == "" {
+		return ErrInvalidName
+	}
+	if err := target.Digest.Validate(); err != nil {
+		return errors.Wrapf(err, "invalid target for image %q", name)
+	}
+
+	s.mu.Lock()
+	s.images[name] = Image{Name: name, Target: target}
+	wait := s.committer.Add()
+	s.mu.Unlock()
+	return wait()
+}
+
+// Tag creates name as an additional name for the target of source. The
+// source may either be the name of an existing image or the digest of an
+// image target already known to the store.
+func (s *Store) Tag(name, source string) (Image, error) {
+	if name == "" {
+		return Image{}, ErrInvalidName
+	}
+
+	s.mu.Lock()
+	target, ok := s.resolve(source)
+	if !ok {
+		s.mu.Unlock()
+		return Image{}, errors.Wrapf(ErrImageNotFound, "tag source %q", source)
+	}
+	image := Image{Name: name, Target: target}
+	s.images[name] = image
+	wait := s.committer.Add()
+	s.mu.Unlock()
+	if err := wait(); err != nil {
+		return Image{}, err
+	}
+	return image, nil
+}
+
+// Untag removes name from the store. The content referenced by the image is
+// left untouched.
+func (s *Store) Untag(name string) error {
+	s.mu.Lock()
+	if _, ok := s.images[name]; !ok {
+		s.mu.Unlock()
+		return ErrImageNotFound
+	}
+	delete(s.images, name)
+	wait := s.committer.Add()
+	s.mu.Unlock()
+	return wait()
+}
+
+// SetCommitInterval sets how long the changes to the store wait for others
+// to be written with them, see metadata.Committer.
+func (s *Store) SetCommitInterval(d time.Duration) {
+	s.committer.SetInterval(d)
+}
+
+func (s *Store) resolve(source string) (Descriptor, bool) {
+	if image, ok := s.images[source]; ok {
+		return image.Target, true
+	}
+	dgst, err := digest.Parse(source)
+	if err != nil {
+		return Descriptor{}, false
+	}
+	for _, image := range s.images {
+		if image.Target.Digest == dgst {
+			return image.Target, true
+		}
+	}
+	return Descriptor{}, false
+}
+
+// flush atomically writes the current set of images to disk, it is called
+// by the committer of the store.
+func (s *Store) flush() error {
+	s.mu.Lock()
+	written := copyImages(s.images)
+	s.mu.Unlock()
+	images := make([]Image, 0, len(written))
+	for _, image := range written {
+		images = append(images, image)
+	}
+	sort.Sort(byName(images))
+	b, err := json.Marshal(images)
+	if err != nil {
+		return err
+	}
+	if err := metadata.WriteFile(s.root, imagesFilename, b); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.committed = written
+	s.mu.Unlock()
+	return nil
+}
+
+// rollback restores the images last written to disk, it is called by the
+// committer of the store with s.mu held once a commit failed.
+func (s *Store) rollback() {
+	s.images = copyImages(s.committed)
+}
+
+func copyImages(images map[string]Image) map[string]Image {
+	c := make(map[string]Image, len(images))
+	for name, image := range images {
+		c[name] = image
+	}
+	return c
+}
+
+type byName []Image
+
+func (b byName) Len() int           { return len(b) }
+func (b byName) Less(i, j int) bool { return b[i].Name < b[j].Name }
+func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
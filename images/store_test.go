@@ -0,0 +1,116 @@
+package images
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+)
+
+func storeEnv(t *testing.T) (*Store, func()) {
+	tmpdir, err := ioutil.TempDir("", "images-store-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := NewStore(tmpdir)
+	if err != nil {
+		os.RemoveAll(tmpdir)
+		t.Fatal(err)
+	}
+	return store, func() {
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func TestTagUntag(t *testing.T) {
+	store, cleanup := storeEnv(t)
+	defer cleanup()
+
+	target := Descriptor{
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Digest:    digest.FromString("manifest"),
+		Size:      8,
+	}
+	if err := store.Put("docker.io/library/app:candidate", target); err != nil {
+		t.Fatal(err)
+	}
+
+	image, err := store.Tag("docker.io/library/app:stable", "docker.io/library/app:candidate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image.Target != target {
+		t.Fatalf("unexpected target: %v != %v", image.Target, target)
+	}
+
+	// tagging by digest resolves against existing targets
+	if _, err := store.Tag("app:latest", target.Digest.String()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.Tag("app:missing", "does-not-exist"); err == nil {
+		t.Fatal("expected error tagging unknown source")
+	}
+
+	if err := store.Untag("docker.io/library/app:candidate"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get("docker.io/library/app:candidate"); err != ErrImageNotFound {
+		t.Fatalf("expected %v, got %v", ErrImageNotFound, err)
+	}
+	if err := store.Untag("docker.io/library/app:candidate"); err != ErrImageNotFound {
+		t.Fatalf("expected %v on second untag, got %v", ErrImageNotFound, err)
+	}
+
+	// the remaining names must survive reopening the store
+	reopened, err := NewStore(store.root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	images, err := reopened.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 {
+		t.Fatalf("expected 2 images, got %v", images)
+	}
+	for _, image := range images {
+		if image.Target != target {
+			t.Fatalf("unexpected target for %s: %v", image.Name, image.Target)
+		}
+	}
+}
+
+func TestPutRollback(t *testing.T) {
+	store, cleanup := storeEnv(t)
+	defer cleanup()
+
+	target := Descriptor{
+		MediaType: "application/vnd.oci.image.manifest.v1+json",
+		Digest:    digest.FromString("manifest"),
+		Size:      8,
+	}
+	if err := store.Put("app:stable", target); err != nil {
+		t.Fatal(err)
+	}
+	// the store cannot be written once its root is a file
+	if err := os.RemoveAll(store.root); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(store.root, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("app:candidate", target); err == nil {
+		t.Fatal("expected the put to fail")
+	}
+	if _, err := store.Get("app:candidate"); err != ErrImageNotFound {
+		t.Fatalf("expected the failed put to be rolled back, got %v", err)
+	}
+	if err := store.Untag("app:stable"); err == nil {
+		t.Fatal("expected the untag to fail")
+	}
+	if _, err := store.Get("app:stable"); err != nil {
+		t.Fatalf("expected the failed untag to be rolled back, got %v", err)
+	}
+}
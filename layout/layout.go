@@ -0,0 +1,235 @@
+// Package layout defines the directories the daemon keeps its state in,
+// versioning them so that they are migrated as they change and locking them
+// so that a single daemon uses them at a time.
+package layout
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/docker/containerd/sys"
+	"github.com/pkg/errors"
+)
+
+// Version is the version of the layout defined by the package.
+const Version = 1
+
+const (
+	versionFilename = "version"
+	lockFilename    = "containerd.lock"
+)
+
+// Layout is the directories of the state of a daemon. Root and State may be
+// the same directory.
+type Layout struct {
+	// Root holds the state persisted across reboots: the content, images,
+	// leases and snapshots.
+	Root string
+	// State holds the state which does not outlive the containers: the
+	// sockets, fifos and the state of the runtimes and sandboxes.
+	State string
+}
+
+// Content is the directory of the content store.
+func (l Layout) Content() string { return filepath.Join(l.Root, "content") }
+
+// Images is the directory of the image store.
+func (l Layout) Images() string { return filepath.Join(l.Root, "images") }
+
+// Leases is the directory of the lease store.
+func (l Layout) Leases() string { return filepath.Join(l.Root, "leases") }
+
+// Snapshots is the directory of the snapshots of driver.
+func (l Layout) Snapshots(driver string) string { return filepath.Join(l.Root, "snapshot", driver) }
+
+// RegistryCache is the directory of the manifests of the registry cache.
+func (l Layout) RegistryCache() string { return filepath.Join(l.Root, "registry-cache") }
+
+// Runtime is the directory of the state of the containers of the runtime
+// name, the default runtime being named by the executor it uses.
+func (l Layout) Runtime(name string) string { return filepath.Join(l.State, name) }
+
+// Sandboxes is the directory of the pause processes of the sandboxes.
+func (l Layout) Sandboxes() string { return filepath.Join(l.State, "sandboxes") }
+
+// NetNS is the directory of the network namespaces persisted by name.
+func (l Layout) NetNS() string { return filepath.Join(l.State, "netns") }
+
+// CNI is the directory of the state of the networks of the containers.
+func (l Layout) CNI() string { return filepath.Join(l.State, "cni") }
+
+// Checkpoints is the directory the checkpoints kept in the content store are
+// taken and extracted in.
+func (l Layout) Checkpoints() string { return filepath.Join(l.State, "checkpoints") }
+
+// Migrations is the directory of the checkpoints of the containers being
+// migrated to or from the daemon.
+func (l Layout) Migrations() string { return filepath.Join(l.State, "migrations") }
+
+// persistent are the directories of the root, as named in it.
+var persistent = []string{"content", "images", "leases", "snapshot", "registry-cache"}
+
+// Migration upgrades a layout from the version preceding the one it is
+// registered for.
+type Migration func(l Layout) error
+
+// migrations are indexed by the version they upgrade the layout to, a
+// migration being added along with each new version.
+var migrations = map[int]Migration{
+	1: splitRoot,
+}
+
+// Lock holds the locks of the directories of a layout.
+type Lock struct {
+	files []*os.File
+}
+
+// Release releases the locks of the layout.
+func (l *Lock) Release() error {
+	var err error
+	for _, f := range l.files {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	l.files = nil
+	return err
+}
+
+// Open creates the directories of l, locks them for the process and
+// migrates them to Version. It fails when another process holds the locks.
+func Open(l Layout) (*Lock, error) {
+	if l.Root == "" || l.State == "" {
+		return nil, errors.New("the root and state directories must be set")
+	}
+	lock := &Lock{}
+	dirs := []string{l.Root}
+	if filepath.Clean(l.State) != filepath.Clean(l.Root) {
+		dirs = append(dirs, l.State)
+	}
+	for _, dir := range dirs {
+		if err := os.MkdirAll(dir, 0711); err != nil {
+			lock.Release()
+			return nil, err
+		}
+		f, err := lockDir(dir)
+		if err != nil {
+			lock.Release()
+			return nil, err
+		}
+		lock.files = append(lock.files, f)
+	}
+	if err := migrate(l); err != nil {
+		lock.Release()
+		return nil, err
+	}
+	return lock, nil
+}
+
+// lockDir takes the lock of dir, recording the pid of the process in it.
+func lockDir(dir string) (*os.File, error) {
+	path := filepath.Join(dir, lockFilename)
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := sys.LockFile(f, true, false); err != nil {
+		f.Close()
+		if err == syscall.EWOULDBLOCK {
+			pid, _ := ioutil.ReadFile(path)
+			return nil, errors.Errorf("%s is in use by another daemon (pid %s)", dir, strings.TrimSpace(string(pid)))
+		}
+		return nil, errors.Wrapf(err, "failed to lock %s", dir)
+	}
+	if err := f.Truncate(0); err == nil {
+		f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0)
+	}
+	return f, nil
+}
+
+// migrate upgrades the layout to Version, recording the version reached
+// after each migration.
+func migrate(l Layout) error {
+	version, err := readVersion(l.Root)
+	if err != nil {
+		return err
+	}
+	if version > Version {
+		return errors.Errorf("the layout of %s is at version %d, newer than the version %d supported", l.Root, version, Version)
+	}
+	for v := version + 1; v <= Version; v++ {
+		if err := migrations[v](l); err != nil {
+			return errors.Wrapf(err, "failed to migrate the layout of %s to version %d", l.Root, v)
+		}
+		if err := writeVersion(l.Root, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readVersion returns the version of the layout of root, 0 when it was
+// never recorded.
+func readVersion(root string) (int, error) {
+	b, err := ioutil.ReadFile(filepath.Join(root, versionFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid layout version in %s", root)
+	}
+	return v, nil
+}
+
+func writeVersion(root string, v int) error {
+	f, err := ioutil.TempFile(root, ".version-")
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(f, "%d\n", v); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(root, versionFilename))
+}
+
+// splitRoot moves the persistent state out of the state directory, which
+// held all of the state before the layout was versioned.
+func splitRoot(l Layout) error {
+	if filepath.Clean(l.State) == filepath.Clean(l.Root) {
+		return nil
+	}
+	for _, name := range persistent {
+		from, to := filepath.Join(l.State, name), filepath.Join(l.Root, name)
+		if _, err := os.Stat(from); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if _, err := os.Stat(to); err == nil {
+			return errors.Errorf("both %s and %s exist", from, to)
+		}
+		if err := os.Rename(from, to); err != nil {
+			if lerr, ok := err.(*os.LinkError); ok && lerr.Err == syscall.EXDEV {
+				return errors.Errorf("%s must be moved to %s, which is on another filesystem, while the daemon is stopped", from, to)
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package layout
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func layoutEnv(t *testing.T) (Layout, func()) {
+	tmpdir, err := ioutil.TempDir("", "layout-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Layout{
+		Root:  filepath.Join(tmpdir, "lib"),
+		State: filepath.Join(tmpdir, "run"),
+	}, func() {
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func TestLock(t *testing.T) {
+	l, cleanup := layoutEnv(t)
+	defer cleanup()
+
+	lock, err := Open(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(l); err == nil || !strings.Contains(err.Error(), "in use") {
+		t.Fatalf("expected the layout to be in use, got %v", err)
+	}
+	// a daemon sharing only the state directory is rejected as well
+	if _, err := Open(Layout{Root: l.Root + "2", State: l.State}); err == nil {
+		t.Fatal("expected the state directory to be in use")
+	}
+	if err := lock.Release(); err != nil {
+		t.Fatal(err)
+	}
+	lock, err = Open(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lock.Release()
+}
+
+func TestMigrateSplitRoot(t *testing.T) {
+	l, cleanup := layoutEnv(t)
+	defer cleanup()
+
+	// the state directory held all of the state
+	for _, dir := range []string{"content/blobs", "shim"} {
+		if err := os.MkdirAll(filepath.Join(l.State, dir), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lock, err := Open(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+	if _, err := os.Stat(filepath.Join(l.Content(), "blobs")); err != nil {
+		t.Fatalf("expected the content to be moved to the root: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(l.State, "content")); !os.IsNotExist(err) {
+		t.Fatalf("expected the content to be moved out of the state directory: %v", err)
+	}
+	if _, err := os.Stat(l.Runtime("shim")); err != nil {
+		t.Fatalf("expected the state of the runtime to be left: %v", err)
+	}
+	if v, err := readVersion(l.Root); err != nil || v != Version {
+		t.Fatalf("expected version %d, got %d (%v)", Version, v, err)
+	}
+}
+
+func TestNewerVersion(t *testing.T) {
+	l, cleanup := layoutEnv(t)
+	defer cleanup()
+
+	if err := os.MkdirAll(l.Root, 0711); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeVersion(l.Root, Version+1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(l); err == nil {
+		t.Fatal("expected an error opening a newer layout")
+	}
+}
@@ -0,0 +1,122 @@
+package leases
+
+import (
+	"time"
+
+	api "github.com/docker/containerd/api/leases"
+	"github.com/docker/containerd/namespaces"
+	google_protobuf "github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var emptyResponse = &google_protobuf.Empty{}
+
+// NewService returns the lease service backed by store, the leases being
+// those of the namespace of each request.
+func NewService(store *Store) *Service {
+	return &Service{store: store}
+}
+
+type Service struct {
+	store *Store
+}
+
+var _ = (api.LeaseServiceServer)(&Service{})
+
+func (s *Service) Create(ctx context.Context, r *api.CreateLeaseRequest) (*api.CreateLeaseResponse, error) {
+	l, err := s.store.Create(namespaces.Namespace(ctx), r.ID, time.Duration(r.TTL))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &api.CreateLeaseResponse{
+		Lease: toGRPCLease(l),
+	}, nil
+}
+
+func (s *Service) Renew(ctx context.Context, r *api.RenewLeaseRequest) (*api.RenewLeaseResponse, error) {
+	l, err := s.store.Renew(namespaces.Namespace(ctx), r.ID, time.Duration(r.TTL))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &api.RenewLeaseResponse{
+		Lease: toGRPCLease(l),
+	}, nil
+}
+
+func (s *Service) Delete(ctx context.Context, r *api.DeleteLeaseRequest) (*google_protobuf.Empty, error) {
+	return emptyResponse, toGRPCError(s.store.Delete(namespaces.Namespace(ctx), r.ID))
+}
+
+func (s *Service) List(ctx context.Context, r *api.ListLeasesRequest) (*api.ListLeasesResponse, error) {
+	namespace := namespaces.Namespace(ctx)
+	if r.AllNamespaces {
+		namespace = ""
+	}
+	leases, err := s.store.List(namespace)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.ListLeasesResponse{}
+	for _, l := range leases {
+		resp.Leases = append(resp.Leases, toGRPCLease(l))
+	}
+	return resp, nil
+}
+
+func (s *Service) AddResource(ctx context.Context, r *api.AddResourceRequest) (*google_protobuf.Empty, error) {
+	if r.Resource == nil {
+		return nil, toGRPCError(errors.Wrap(ErrInvalidResource, "missing resource"))
+	}
+	return emptyResponse, toGRPCError(s.store.AddResource(namespaces.Namespace(ctx), r.ID, fromGRPCResource(r.Resource)))
+}
+
+func (s *Service) DeleteResource(ctx context.Context, r *api.DeleteResourceRequest) (*google_protobuf.Empty, error) {
+	if r.Resource == nil {
+		return nil, toGRPCError(errors.Wrap(ErrInvalidResource, "missing resource"))
+	}
+	return emptyResponse, toGRPCError(s.store.DeleteResource(namespaces.Namespace(ctx), r.ID, fromGRPCResource(r.Resource)))
+}
+
+// toGRPCError returns err with the code of the errors of the store, for the
+// clients to tell them apart.
+func toGRPCError(err error) error {
+	switch errors.Cause(err) {
+	case nil:
+		return nil
+	case ErrNotFound:
+		return grpc.Errorf(codes.NotFound, "%v", err)
+	case ErrExists:
+		return grpc.Errorf(codes.AlreadyExists, "%v", err)
+	case ErrInvalidID, ErrInvalidResource:
+		return grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return err
+}
+
+func toGRPCLease(l Lease) *api.Lease {
+	out := &api.Lease{
+		ID:        l.ID,
+		Namespace: l.Namespace,
+		CreatedAt: l.CreatedAt.UnixNano(),
+	}
+	if !l.ExpiresAt.IsZero() {
+		out.ExpiresAt = l.ExpiresAt.UnixNano()
+	}
+	for _, r := range l.Resources {
+		out.Resources = append(out.Resources, &api.Resource{
+			Type: r.Type,
+			ID:   r.ID,
+		})
+	}
+	return out
+}
+
+func fromGRPCResource(r *api.Resource) Resource {
+	return Resource{
+		Type: r.Type,
+		ID:   r.ID,
+	}
+}
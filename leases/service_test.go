@@ -0,0 +1,35 @@
+package leases
+
+import (
+	"testing"
+
+	api "github.com/docker/containerd/api/leases"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestServiceErrorCodes(t *testing.T) {
+	store, cleanup := storeEnv(t)
+	defer cleanup()
+	s := NewService(store)
+	ctx := context.Background()
+
+	if _, err := s.Create(ctx, &api.CreateLeaseRequest{ID: "pull"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"existing lease", func() error { _, err := s.Create(ctx, &api.CreateLeaseRequest{ID: "pull"}); return err }(), codes.AlreadyExists},
+		{"invalid id", func() error { _, err := s.Create(ctx, &api.CreateLeaseRequest{ID: "-"}); return err }(), codes.InvalidArgument},
+		{"missing lease", func() error { _, err := s.Renew(ctx, &api.RenewLeaseRequest{ID: "push"}); return err }(), codes.NotFound},
+		{"missing resource", func() error { _, err := s.AddResource(ctx, &api.AddResourceRequest{ID: "pull"}); return err }(), codes.InvalidArgument},
+	} {
+		if grpc.Code(tc.err) != tc.code {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.code, tc.err)
+		}
+	}
+}
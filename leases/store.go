@@ -0,0 +1,372 @@
+// Package leases pins resources against pruning while clients assemble
+// them, such as between the pull of an image and the creation of its
+// container.
+package leases
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/metadata"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+)
+
+// Types of the resources pinned by leases.
+const (
+	ResourceContent  = "content"
+	ResourceSnapshot = "snapshot"
+	ResourceImage    = "image"
+)
+
+var (
+	ErrNotFound        = errors.New("lease not found")
+	ErrExists          = errors.New("lease already exists")
+	ErrInvalidID       = errors.New("invalid lease id")
+	ErrInvalidResource = errors.New("invalid lease resource")
+)
+
+const leasesFilename = "leases.json"
+
+var validID = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// Resource is pinned by a lease. The content is identified by its digest,
+// the snapshots and images by their name.
+type Resource struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Lease pins its resources until it is deleted or expires.
+type Lease struct {
+	ID        string    `json:"id"`
+	Namespace string    `json:"namespace"`
+	CreatedAt time.Time `json:"createdAt"`
+	// ExpiresAt is zero for the leases which do not expire.
+	ExpiresAt time.Time  `json:"expiresAt,omitempty"`
+	Resources []Resource `json:"resources,omitempty"`
+}
+
+// Expired reports whether the lease expired at now.
+func (l Lease) Expired(now time.Time) bool {
+	return !l.ExpiresAt.IsZero() && !now.Before(l.ExpiresAt)
+}
+
+type leaseKey struct {
+	namespace, id string
+}
+
+// Store keeps the leases of all namespaces in a single file, so that the
+// resources of a lease are released at once when it is deleted or expires.
+type Store struct {
+	root      string
+	committer *metadata.Committer
+	// now returns the current time, the expired leases being dropped.
+	now func() time.Time
+
+	mu     sync.Mutex
+	leases map[leaseKey]Lease
+	// committed are the leases last written to disk, restored once a
+	// commit failed.
+	committed map[leaseKey]Lease
+	// expired is called with each lease dropped once expired.
+	expired func(Lease)
+}
+
+// NewStore opens the lease store located at root, loading the leases
+// previously written to disk.
+func NewStore(root string) (*Store, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+	s := &Store{
+		root:   root,
+		now:    time.Now,
+		leases: make(map[leaseKey]Lease),
+	}
+	s.committer = metadata.NewCommitter("leases", s.flush)
+	s.committer.SetRollback(&s.mu, s.rollback)
+	b, err := ioutil.ReadFile(filepath.Join(root, leasesFilename))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		var leases []Lease
+		if err := json.Unmarshal(b, &leases); err != nil {
+			return nil, errors.Wrap(err, "failed to decode lease store")
+		}
+		for _, l := range leases {
+			s.leases[leaseKey{l.Namespace, l.ID}] = l
+		}
+	}
+	s.committed = copyLeases(s.leases)
+	return s, nil
+}
+
+// Create creates the lease id of namespace, which expires after ttl unless
+// it is zero.
+func (s *Store) Create(namespace, id string, ttl time.Duration) (Lease, error) {
+	if !validID.MatchString(id) {
+		return Lease{}, errors.Wrapf(ErrInvalidID, "%q", id)
+	}
+	if ttl < 0 {
+		return Lease{}, errors.Errorf("invalid ttl %s", ttl)
+	}
+	s.mu.Lock()
+	s.expire()
+	key := leaseKey{namespace, id}
+	if _, ok := s.leases[key]; ok {
+		s.mu.Unlock()
+		return Lease{}, errors.Wrapf(ErrExists, "%q", id)
+	}
+	l := Lease{
+		ID:        id,
+		Namespace: namespace,
+		CreatedAt: s.now().UTC(),
+	}
+	if ttl > 0 {
+		l.ExpiresAt = l.CreatedAt.Add(ttl)
+	}
+	s.leases[key] = l
+	wait := s.committer.Add()
+	s.mu.Unlock()
+	if err := wait(); err != nil {
+		return Lease{}, err
+	}
+	return l, nil
+}
+
+// Renew extends the lease id of namespace to expire after ttl from now, or
+// never when it is zero. Clients renew their leases periodically while they
+// use them, for the resources to be released soon after they stop.
+func (s *Store) Renew(namespace, id string, ttl time.Duration) (Lease, error) {
+	if ttl < 0 {
+		return Lease{}, errors.Errorf("invalid ttl %s", ttl)
+	}
+	s.mu.Lock()
+	s.expire()
+	key := leaseKey{namespace, id}
+	updated, ok := s.leases[key]
+	if !ok {
+		s.mu.Unlock()
+		return Lease{}, ErrNotFound
+	}
+	updated.ExpiresAt = time.Time{}
+	if ttl > 0 {
+		updated.ExpiresAt = s.now().UTC().Add(ttl)
+	}
+	s.leases[key] = updated
+	wait := s.committer.Add()
+	s.mu.Unlock()
+	if err := wait(); err != nil {
+		return Lease{}, err
+	}
+	return updated, nil
+}
+
+// Delete removes the lease id of namespace, releasing all of its resources.
+func (s *Store) Delete(namespace, id string) error {
+	s.mu.Lock()
+	s.expire()
+	key := leaseKey{namespace, id}
+	if _, ok := s.leases[key]; !ok {
+		s.mu.Unlock()
+		return ErrNotFound
+	}
+	delete(s.leases, key)
+	wait := s.committer.Add()
+	s.mu.Unlock()
+	return wait()
+}
+
+// List returns the leases of namespace which have not expired, those of all
+// namespaces when it is empty, sorted by namespace and id.
+func (s *Store) List(namespace string) ([]Lease, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expire()
+	var leases []Lease
+	for key, l := range s.leases {
+		if namespace == "" || key.namespace == namespace {
+			leases = append(leases, l)
+		}
+	}
+	sort.Sort(byNamespace(leases))
+	return leases, nil
+}
+
+// AddResource pins r with the lease id of namespace.
+func (s *Store) AddResource(namespace, id string, r Resource) error {
+	switch r.Type {
+	case ResourceContent, ResourceSnapshot, ResourceImage:
+	default:
+		return errors.Wrapf(ErrInvalidResource, "unknown type %q", r.Type)
+	}
+	if r.ID == "" {
+		return errors.Wrap(ErrInvalidResource, "empty id")
+	}
+	s.mu.Lock()
+	s.expire()
+	key := leaseKey{namespace, id}
+	l, ok := s.leases[key]
+	if !ok {
+		s.mu.Unlock()
+		return ErrNotFound
+	}
+	for _, pinned := range l.Resources {
+		if pinned == r {
+			s.mu.Unlock()
+			return nil
+		}
+	}
+	updated := l
+	updated.Resources = append(append([]Resource(nil), l.Resources...), r)
+	s.leases[key] = updated
+	wait := s.committer.Add()
+	s.mu.Unlock()
+	return wait()
+}
+
+// DeleteResource releases r from the lease id of namespace.
+func (s *Store) DeleteResource(namespace, id string, r Resource) error {
+	s.mu.Lock()
+	s.expire()
+	key := leaseKey{namespace, id}
+	l, ok := s.leases[key]
+	if !ok {
+		s.mu.Unlock()
+		return ErrNotFound
+	}
+	updated := l
+	updated.Resources = nil
+	for _, pinned := range l.Resources {
+		if pinned != r {
+			updated.Resources = append(updated.Resources, pinned)
+		}
+	}
+	s.leases[key] = updated
+	wait := s.committer.Add()
+	s.mu.Unlock()
+	return wait()
+}
+
+// OnExpire sets fn to be called with each lease dropped once expired, such
+// as to make a collection of the resources it released due. fn is called
+// with the store locked and must not call it.
+func (s *Store) OnExpire(fn func(Lease)) {
+	s.mu.Lock()
+	s.expired = fn
+	s.mu.Unlock()
+}
+
+// Run drops the expired leases every interval until ctx is done, for them
+// to be released even when no lease is used.
+func (s *Store) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := s.Expire(); err != nil {
+			log.G(ctx).WithError(err).Warn("failed to remove the expired leases")
+		}
+	}
+}
+
+// Expire drops the expired leases, removing them from disk.
+func (s *Store) Expire() error {
+	s.mu.Lock()
+	if s.expire() == 0 {
+		s.mu.Unlock()
+		return nil
+	}
+	wait := s.committer.Add()
+	s.mu.Unlock()
+	return wait()
+}
+
+// SetCommitInterval sets how long the changes to the store wait for others
+// to be written with them, see metadata.Committer.
+func (s *Store) SetCommitInterval(d time.Duration) {
+	s.committer.SetInterval(d)
+}
+
+// expire drops the expired leases from memory, returning how many, they are
+// removed from disk by the next commit. The caller must hold s.mu.
+func (s *Store) expire() int {
+	now := s.now()
+	var n int
+	for key, l := range s.leases {
+		if l.Expired(now) {
+			delete(s.leases, key)
+			if s.expired != nil {
+				s.expired(l)
+			}
+			n++
+		}
+	}
+	return n
+}
+
+// flush atomically writes the current set of leases to disk, it is called
+// by the committer of the store.
+func (s *Store) flush() error {
+	s.mu.Lock()
+	written := copyLeases(s.leases)
+	s.mu.Unlock()
+	leases := make([]Lease, 0, len(written))
+	for _, l := range written {
+		leases = append(leases, l)
+	}
+	sort.Sort(byNamespace(leases))
+	b, err := json.Marshal(leases)
+	if err != nil {
+		return err
+	}
+	if err := metadata.WriteFile(s.root, leasesFilename, b); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.committed = written
+	s.mu.Unlock()
+	return nil
+}
+
+// rollback restores the leases last written to disk, it is called by the
+// committer of the store with s.mu held once a commit failed. The leases
+// expired since are dropped again by the next change.
+func (s *Store) rollback() {
+	s.leases = copyLeases(s.committed)
+}
+
+// copyLeases copies leases, the resources of a lease being replaced rather
+// than changed in place.
+func copyLeases(leases map[leaseKey]Lease) map[leaseKey]Lease {
+	c := make(map[leaseKey]Lease, len(leases))
+	for key, l := range leases {
+		c[key] = l
+	}
+	return c
+}
+
+type byNamespace []Lease
+
+func (b byNamespace) Len() int { return len(b) }
+func (b byNamespace) Less(i, j int) bool {
+	if b[i].Namespace != b[j].Namespace {
+		return b[i].Namespace < b[j].Namespace
+	}
+	return b[i].ID < b[j].ID
+}
+func (b byNamespace) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
@@ -0,0 +1,182 @@
+package leases
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func storeEnv(t *testing.T) (*Store, func()) {
+	tmpdir, err := ioutil.TempDir("", "leases-store-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := NewStore(tmpdir)
+	if err != nil {
+		os.RemoveAll(tmpdir)
+		t.Fatal(err)
+	}
+	return store, func() {
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func TestLeaseResources(t *testing.T) {
+	store, cleanup := storeEnv(t)
+	defer cleanup()
+
+	if _, err := store.Create("default", "pull", 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Create("default", "pull", 0); err == nil {
+		t.Fatal("expected an error creating an existing lease")
+	}
+	// the ids of the leases are per namespace
+	if _, err := store.Create("ci", "pull", 0); err != nil {
+		t.Fatal(err)
+	}
+	content := Resource{Type: ResourceContent, ID: "sha256:0123"}
+	snapshot := Resource{Type: ResourceSnapshot, ID: "sha256:4567"}
+	for _, r := range []Resource{content, snapshot, content} {
+		if err := store.AddResource("default", "pull", r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.AddResource("default", "pull", Resource{Type: "container", ID: "web"}); err == nil {
+		t.Fatal("expected an error pinning an unknown type of resource")
+	}
+	if err := store.DeleteResource("default", "pull", snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	// the leases are persisted
+	reopened, err := NewStore(store.root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leases, err := reopened.List("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leases) != 1 || len(leases[0].Resources) != 1 || leases[0].Resources[0] != content {
+		t.Fatalf("expected the lease to pin %v only, got %+v", content, leases)
+	}
+	if leases, _ := reopened.List(""); len(leases) != 2 {
+		t.Fatalf("expected the leases of all namespaces, got %+v", leases)
+	}
+
+	if err := reopened.Delete("default", "pull"); err != nil {
+		t.Fatal(err)
+	}
+	if err := reopened.Delete("default", "pull"); err != ErrNotFound {
+		t.Fatalf("expected %v deleting a deleted lease, got %v", ErrNotFound, err)
+	}
+}
+
+func TestLeaseExpiry(t *testing.T) {
+	store, cleanup := storeEnv(t)
+	defer cleanup()
+
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	if _, err := store.Create("default", "short", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Create("default", "forever", 0); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(time.Minute)
+	leases, err := store.List("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leases) != 1 || leases[0].ID != "forever" {
+		t.Fatalf("expected the short lease to expire, got %+v", leases)
+	}
+	if err := store.AddResource("default", "short", Resource{Type: ResourceImage, ID: "app"}); err != ErrNotFound {
+		t.Fatalf("expected %v pinning with an expired lease, got %v", ErrNotFound, err)
+	}
+}
+
+func TestLeaseRenew(t *testing.T) {
+	store, cleanup := storeEnv(t)
+	defer cleanup()
+
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	var expired []string
+	store.OnExpire(func(l Lease) {
+		expired = append(expired, l.ID)
+	})
+	if _, err := store.Create("default", "pull", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Create("default", "crashed", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(50 * time.Second)
+	l, err := store.Renew("default", "pull", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l.ExpiresAt.Equal(now.UTC().Add(time.Minute)) {
+		t.Fatalf("expected the lease to expire a minute after its renewal, got %v", l.ExpiresAt)
+	}
+
+	now = now.Add(time.Minute - time.Second)
+	if err := store.Expire(); err != nil {
+		t.Fatal(err)
+	}
+	if len(expired) != 1 || expired[0] != "crashed" {
+		t.Fatalf("expected the lease which was not renewed to expire, got %v", expired)
+	}
+	if _, err := store.Renew("default", "crashed", time.Minute); err != ErrNotFound {
+		t.Fatalf("expected %v renewing an expired lease, got %v", ErrNotFound, err)
+	}
+	// the expired leases are removed from disk
+	reopened, err := NewStore(store.root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reopened.now = store.now
+	if leases, _ := reopened.List("default"); len(leases) != 1 || leases[0].ID != "pull" {
+		t.Fatalf("expected the renewed lease only, got %+v", leases)
+	}
+
+	if l, err = store.Renew("default", "pull", 0); err != nil {
+		t.Fatal(err)
+	}
+	if !l.ExpiresAt.IsZero() {
+		t.Fatalf("expected the lease renewed without ttl never to expire, got %v", l.ExpiresAt)
+	}
+}
+
+func TestLeaseRollback(t *testing.T) {
+	store, cleanup := storeEnv(t)
+	defer cleanup()
+
+	if _, err := store.Create("default", "pull", 0); err != nil {
+		t.Fatal(err)
+	}
+	// the store cannot be written once its root is a file
+	if err := os.RemoveAll(store.root); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(store.root, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.AddResource("default", "pull", Resource{Type: ResourceContent, ID: "sha256:0123"}); err == nil {
+		t.Fatal("expected the resource not to be added")
+	}
+	if err := store.Delete("default", "pull"); err == nil {
+		t.Fatal("expected the lease not to be deleted")
+	}
+	leases, err := store.List("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leases) != 1 || len(leases[0].Resources) != 0 {
+		t.Fatalf("expected the failed changes to be rolled back, got %v", leases)
+	}
+}
@@ -0,0 +1,151 @@
+package log
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ModuleLevels holds the log levels of modules. The level of a module applies
+// to its submodules, unless they have their own. Modules are referred to by
+// their full path, such as "containerd/execution/shim", or by the last element
+// of the path, such as "shim".
+type ModuleLevels map[string]logrus.Level
+
+var (
+	levelsMu     sync.RWMutex
+	defaultLevel = logrus.InfoLevel
+	levels       = ModuleLevels{}
+	installOnce  sync.Once
+)
+
+// SetLevels sets the default log level along with the levels of modules,
+// replacing the levels previously set.
+func SetLevels(def logrus.Level, modules ModuleLevels) {
+	installOnce.Do(install)
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	defaultLevel = def
+	levels = ModuleLevels{}
+	for m, l := range modules {
+		levels[m] = l
+	}
+	updateLevel()
+}
+
+// SetModuleLevel sets the level of a module, the default level when module
+// is empty.
+func SetModuleLevel(module string, level logrus.Level) {
+	installOnce.Do(install)
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	if module == "" {
+		defaultLevel = level
+	} else {
+		levels[module] = level
+	}
+	updateLevel()
+}
+
+// ResetModuleLevel removes the level of a module, which falls back to the
+// level of its parent.
+func ResetModuleLevel(module string) {
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	delete(levels, module)
+	updateLevel()
+}
+
+// Levels returns the default level and the levels of the modules.
+func Levels() (logrus.Level, ModuleLevels) {
+	levelsMu.RLock()
+	defer levelsMu.RUnlock()
+	modules := ModuleLevels{}
+	for m, l := range levels {
+		modules[m] = l
+	}
+	return defaultLevel, modules
+}
+
+// Modules returns the modules with a level, sorted.
+func (ml ModuleLevels) Modules() []string {
+	var modules []string
+	for m := range ml {
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+	return modules
+}
+
+// ParseModuleLevels parses levels of the form "module=level,module=level".
+func ParseModuleLevels(s string) (ModuleLevels, error) {
+	modules := ModuleLevels{}
+	for _, kv := range strings.Split(s, ",") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid module level %q", kv)
+		}
+		l, err := logrus.ParseLevel(parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "module %s", parts[0])
+		}
+		modules[parts[0]] = l
+	}
+	return modules, nil
+}
+
+// levelOf returns the level of the module path. It must be called with
+// levelsMu held.
+func levelOf(module string) logrus.Level {
+	for p := module; p != "" && p != "." && p != "/"; p = path.Dir(p) {
+		if l, ok := levels[p]; ok {
+			return l
+		}
+		if l, ok := levels[path.Base(p)]; ok {
+			return l
+		}
+	}
+	return defaultLevel
+}
+
+// updateLevel sets the level of the logger to the most verbose level set, so
+// that entries are only filtered by module in the formatter. It must be
+// called with levelsMu held.
+func updateLevel() {
+	max := defaultLevel
+	for _, l := range levels {
+		if l > max {
+			max = l
+		}
+	}
+	logrus.SetLevel(max)
+}
+
+func install() {
+	logger := logrus.StandardLogger()
+	logger.Formatter = &moduleFormatter{Formatter: logger.Formatter}
+}
+
+// moduleFormatter drops the entries that are more verbose than the level of
+// their module.
+type moduleFormatter struct {
+	logrus.Formatter
+}
+
+func (f *moduleFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	module, _ := entry.Data["module"].(string)
+	levelsMu.RLock()
+	level := levelOf(module)
+	levelsMu.RUnlock()
+	if entry.Level > level {
+		return nil, nil
+	}
+	return f.Formatter.Format(entry)
+}
@@ -0,0 +1,64 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseModuleLevels(t *testing.T) {
+	levels, err := ParseModuleLevels("shim=debug, containerd/images=warn,")
+	assert.NoError(t, err)
+	assert.Equal(t, ModuleLevels{"shim": logrus.DebugLevel, "containerd/images": logrus.WarnLevel}, levels)
+	assert.Equal(t, []string{"containerd/images", "shim"}, levels.Modules())
+
+	for _, invalid := range []string{"shim", "=debug", "shim=verbose"} {
+		_, err := ParseModuleLevels(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestModuleLevels(t *testing.T) {
+	logger := logrus.StandardLogger()
+	out, formatter, level := logger.Out, logger.Formatter, logger.Level
+	defer func() {
+		logger.Out, logger.Formatter, logger.Level = out, formatter, level
+		levels, defaultLevel = ModuleLevels{}, logrus.InfoLevel
+		installOnce = sync.Once{}
+	}()
+	buf := &bytes.Buffer{}
+	logger.Out = buf
+
+	SetLevels(logrus.InfoLevel, ModuleLevels{
+		"shim":                 logrus.DebugLevel,
+		"containerd/execution": logrus.ErrorLevel,
+	})
+	assert.Equal(t, logrus.DebugLevel, logger.Level)
+
+	ctx := WithModule(context.Background(), "containerd")
+	G(ctx).Debug("containerd debug")
+	G(ctx).Info("containerd info")
+	ctx = WithModule(ctx, "execution")
+	G(ctx).Warn("execution warn")
+	ctx = WithModule(ctx, "shim")
+	G(ctx).Debug("shim debug")
+	G(WithModule(ctx, "container")).Debug("container debug")
+
+	logged := buf.String()
+	assert.NotContains(t, logged, "containerd debug")
+	assert.Contains(t, logged, "containerd info")
+	assert.NotContains(t, logged, "execution warn")
+	assert.Contains(t, logged, "shim debug")
+	assert.Contains(t, logged, "container debug")
+
+	ResetModuleLevel("shim")
+	def, modules := Levels()
+	assert.Equal(t, logrus.InfoLevel, def)
+	assert.Equal(t, ModuleLevels{"containerd/execution": logrus.ErrorLevel}, modules)
+	assert.Equal(t, logrus.ErrorLevel, levelOf("containerd/execution/shim"))
+	assert.Equal(t, logrus.InfoLevel, logger.Level)
+}
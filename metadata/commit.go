@@ -0,0 +1,174 @@
+// Package metadata commits the changes to the stores of the daemon to disk,
+// such as its images and leases.
+package metadata
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Committer groups the commits of a store. A commit writes the whole store,
+// the changes made while one is being written are committed together by the
+// next one instead of each syncing the store, such as when many containers
+// are deleted at once as a node drains.
+type Committer struct {
+	store  string
+	commit func() error
+	// locker guards the store rolled back by rollback, nil when the store
+	// is not rolled back.
+	locker   sync.Locker
+	rollback func()
+
+	mu       sync.Mutex
+	interval time.Duration
+	// next is the group committed by the next commit, nil when there is no
+	// change to commit.
+	next    *group
+	running bool
+}
+
+type group struct {
+	changes int
+	done    chan struct{}
+	err     error
+}
+
+// NewCommitter returns the committer of the store named store, committing
+// its changes with commit. commit is never called concurrently.
+func NewCommitter(store string, commit func() error) *Committer {
+	return &Committer{
+		store:  store,
+		commit: commit,
+	}
+}
+
+// SetInterval sets how long a commit waits for more changes to group before
+// writing the store. The changes wait for longer to be committed, for the
+// store to be synced less often when it changes in bursts. The commits do
+// not wait when it is zero, grouping only the changes made while the store
+// is written.
+func (c *Committer) SetInterval(d time.Duration) {
+	c.mu.Lock()
+	c.interval = d
+	c.mu.Unlock()
+}
+
+// Interval returns how long a commit waits for more changes to group.
+func (c *Committer) Interval() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.interval
+}
+
+// SetRollback sets rollback to restore the store to its last successful
+// commit once a commit failed, rather than leaving its changes in memory
+// for the next commit to write. rollback is called with locker, the lock of
+// the store, held. The changes are then failed along with those made since,
+// which were rolled back with them, so the changes must be made and added
+// with locker held.
+func (c *Committer) SetRollback(locker sync.Locker, rollback func()) {
+	c.mu.Lock()
+	c.locker = locker
+	c.rollback = rollback
+	c.mu.Unlock()
+}
+
+// Commit waits for the changes made to the store before it was called to be
+// written, returning the error of their commit. The changes of a failed
+// commit are written by the next one unless the store is rolled back.
+func (c *Committer) Commit() error {
+	return c.Add()()
+}
+
+// Add adds the changes made to the store to the next commit, returning a
+// function waiting for them to be written. Stores rolled back on failure
+// call it with their lock held, and wait once they released it.
+func (c *Committer) Add() func() error {
+	c.mu.Lock()
+	if c.next == nil {
+		c.next = &group{done: make(chan struct{})}
+	}
+	g := c.next
+	g.changes++
+	if !c.running {
+		c.running = true
+		go c.run()
+	}
+	c.mu.Unlock()
+
+	return func() error {
+		<-g.done
+		return g.err
+	}
+}
+
+func (c *Committer) run() {
+	for {
+		c.mu.Lock()
+		interval := c.interval
+		c.mu.Unlock()
+		if interval > 0 {
+			time.Sleep(interval)
+		}
+
+		c.mu.Lock()
+		g := c.next
+		if g == nil {
+			c.running = false
+			c.mu.Unlock()
+			return
+		}
+		c.next = nil
+		locker, rollback := c.locker, c.rollback
+		c.mu.Unlock()
+
+		start := time.Now()
+		g.err = c.commit()
+		commitLatency.WithValues(c.store).UpdateSince(start)
+		if g.err != nil {
+			commitFailures.WithValues(c.store).Inc()
+		}
+		committedChanges.WithValues(c.store).Inc(float64(g.changes))
+		if g.err != nil && rollback != nil {
+			// the changes added since the commit are rolled back with it
+			locker.Lock()
+			rollback()
+			c.mu.Lock()
+			if next := c.next; next != nil {
+				c.next = nil
+				next.err = g.err
+				close(next.done)
+			}
+			c.mu.Unlock()
+			locker.Unlock()
+		}
+		close(g.done)
+	}
+}
+
+// WriteFile atomically replaces the file name of dir with data, syncing it
+// to disk.
+func WriteFile(dir, name string, data []byte) error {
+	f, err := ioutil.TempFile(dir, "."+name+"-")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(dir, name))
+}
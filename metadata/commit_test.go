@@ -0,0 +1,130 @@
+package metadata
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCommitterGroupsCommits(t *testing.T) {
+	var (
+		commits int
+		blocked = make(chan struct{})
+		release = make(chan struct{})
+	)
+	c := NewCommitter("test", func() error {
+		commits++
+		if commits == 1 {
+			close(blocked)
+			<-release
+		}
+		return nil
+	})
+
+	first := make(chan error)
+	go func() {
+		first <- c.Commit()
+	}()
+	<-blocked
+
+	// the changes made while the first commit is written are grouped into
+	// the next one
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.Commit(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	for {
+		c.mu.Lock()
+		waiting := c.next != nil && c.next.changes == 100
+		c.mu.Unlock()
+		if waiting {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+	if commits != 2 {
+		t.Fatalf("expected the changes to be written by 2 commits, got %d", commits)
+	}
+}
+
+func TestCommitterInterval(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		commits int
+	)
+	errCommit := errors.New("commit failed")
+	c := NewCommitter("test", func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		commits++
+		return errCommit
+	})
+	c.SetInterval(50 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.Commit(); err != errCommit {
+				t.Errorf("expected the error of the commit, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if commits != 1 {
+		t.Fatalf("expected the changes made within the interval to be written by a commit, got %d", commits)
+	}
+}
+
+func TestCommitterRollback(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		rollbacks int
+		blocked   = make(chan struct{})
+		release   = make(chan struct{})
+	)
+	errCommit := errors.New("commit failed")
+	c := NewCommitter("test", func() error {
+		close(blocked)
+		<-release
+		return errCommit
+	})
+	c.SetRollback(&mu, func() {
+		rollbacks++
+	})
+
+	first := make(chan error)
+	go func() {
+		first <- c.Commit()
+	}()
+	<-blocked
+
+	// the changes added while the failed commit is written are rolled back
+	// with it, they fail without being written
+	mu.Lock()
+	wait := c.Add()
+	mu.Unlock()
+	close(release)
+	if err := <-first; err != errCommit {
+		t.Fatalf("expected the error of the commit, got %v", err)
+	}
+	if err := wait(); err != errCommit {
+		t.Fatalf("expected the rolled back change to fail, got %v", err)
+	}
+	if rollbacks != 1 {
+		t.Fatalf("expected the store to be rolled back once, got %d", rollbacks)
+	}
+}
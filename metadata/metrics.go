@@ -0,0 +1,14 @@
+package metadata
+
+import metrics "github.com/docker/go-metrics"
+
+var (
+	metadataNamespace = metrics.NewNamespace("containerd", "metadata", nil)
+	commitLatency     = metadataNamespace.NewLabeledTimer("commit_latency", "The time taken to write the stores by store", "store")
+	commitFailures    = metadataNamespace.NewLabeledCounter("commit_failures", "The number of commits which failed to write the stores by store", "store")
+	committedChanges  = metadataNamespace.NewLabeledCounter("committed_changes", "The number of changes committed to the stores by store, grouped into fewer commits", "store")
+)
+
+func init() {
+	metrics.Register(metadataNamespace)
+}
@@ -0,0 +1,100 @@
+package migration
+
+import (
+	"sync"
+	"time"
+
+	execapi "github.com/docker/containerd/api/execution"
+	api "github.com/docker/containerd/api/migration"
+	"github.com/docker/containerd/images"
+	"github.com/opencontainers/go-digest"
+)
+
+// Stages of a migration, as reported by its progress.
+const (
+	StagePreDump    = "pre-dump"
+	StageCheckpoint = "checkpoint"
+	StagePush       = "push"
+	StageFetch      = "fetch"
+	StageExtract    = "extract"
+	StageRestore    = "restore"
+	StageDone       = "done"
+)
+
+// progressInterval is the minimum interval between the progress reported for
+// the transfer of a blob, until it is done.
+const progressInterval = 100 * time.Millisecond
+
+// sender is the stream of the progress of a migration.
+type sender interface {
+	Send(*api.Progress) error
+}
+
+// progress reports the stages of a migration and the transfers of its blobs
+// to the stream of the request. It is safe for concurrent use, the blobs being
+// transferred concurrently.
+type progress struct {
+	stream sender
+	clock  func() time.Time
+
+	mu    sync.Mutex
+	stage string
+	sent  map[digest.Digest]time.Time
+	// err is the error of the first send which failed, no more progress is
+	// sent once it is set.
+	err error
+}
+
+func newProgress(stream sender) *progress {
+	return &progress{
+		stream: stream,
+		clock:  time.Now,
+	}
+}
+
+// start reports the start of stage, returning the error of the stream, if
+// any, for the migration to be abandoned.
+func (p *progress) start(stage string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stage = stage
+	p.sent = make(map[digest.Digest]time.Time)
+	return p.send(&api.Progress{Stage: stage})
+}
+
+// transfer reports the bytes of desc transferred so far, it is a
+// remotes.Progress.
+func (p *progress) transfer(desc images.Descriptor, done int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.clock()
+	if last, ok := p.sent[desc.Digest]; ok && done < desc.Size && now.Sub(last) < progressInterval {
+		return
+	}
+	p.sent[desc.Digest] = now
+	p.send(&api.Progress{
+		Stage:  p.stage,
+		Digest: desc.Digest.String(),
+		Offset: done,
+		Total:  desc.Size,
+	})
+}
+
+// done reports the end of the migration, with the checkpoint pushed or
+// fetched and the container restored, if any.
+func (p *progress) done(reference string, container *execapi.CreateContainerResponse) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.send(&api.Progress{
+		Stage:     StageDone,
+		Reference: reference,
+		Container: container,
+	})
+}
+
+func (p *progress) send(m *api.Progress) error {
+	if p.err == nil {
+		p.err = p.stream.Send(m)
+	}
+	return p.err
+}
@@ -0,0 +1,71 @@
+package migration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	api "github.com/docker/containerd/api/migration"
+	"github.com/docker/containerd/images"
+	"github.com/opencontainers/go-digest"
+)
+
+type testStream struct {
+	sent []*api.Progress
+	err  error
+}
+
+func (s *testStream) Send(p *api.Progress) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, p)
+	return nil
+}
+
+func TestProgressThrottle(t *testing.T) {
+	stream := &testStream{}
+	now := time.Now()
+	p := newProgress(stream)
+	p.clock = func() time.Time { return now }
+
+	blob := images.Descriptor{Digest: digest.FromString("blob"), Size: 100}
+	if err := p.start(StagePush); err != nil {
+		t.Fatal(err)
+	}
+	p.transfer(blob, 10)
+	p.transfer(blob, 20)
+	now = now.Add(progressInterval)
+	p.transfer(blob, 30)
+	p.transfer(blob, 100)
+	if err := p.done("example.com/app@"+blob.Digest.String(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var offsets []int64
+	for _, m := range stream.sent[1 : len(stream.sent)-1] {
+		if m.Stage != StagePush || m.Digest != blob.Digest.String() || m.Total != blob.Size {
+			t.Fatalf("unexpected progress %+v", m)
+		}
+		offsets = append(offsets, m.Offset)
+	}
+	if len(offsets) != 3 || offsets[0] != 10 || offsets[1] != 30 || offsets[2] != 100 {
+		t.Fatalf("expected the progress to be sent at 10, 30 and 100 bytes, got %v", offsets)
+	}
+	if last := stream.sent[len(stream.sent)-1]; last.Stage != StageDone || last.Reference == "" {
+		t.Fatalf("expected the migration to be done, got %+v", last)
+	}
+}
+
+func TestProgressSendFailure(t *testing.T) {
+	stream := &testStream{err: errors.New("canceled")}
+	p := newProgress(stream)
+	if err := p.start(StageFetch); err != stream.err {
+		t.Fatalf("expected the failure of the stream, got %v", err)
+	}
+	stream.err = nil
+	p.transfer(images.Descriptor{Digest: digest.FromString("blob"), Size: 1}, 1)
+	if err := p.start(StageExtract); err == nil || len(stream.sent) != 0 {
+		t.Fatalf("expected no progress to be sent once the stream failed, got %v", stream.sent)
+	}
+}
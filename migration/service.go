@@ -0,0 +1,489 @@
+// Package migration moves running containers between hosts, checkpointing
+// them on the source with a pre-copy of their memory and restoring them on
+// the target from the checkpoints transferred through a registry.
+package migration
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	execapi "github.com/docker/containerd/api/execution"
+	api "github.com/docker/containerd/api/migration"
+	"github.com/docker/containerd/bundle"
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/execution"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/leases"
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/namespaces"
+	"github.com/docker/containerd/remotes"
+	"github.com/docker/containerd/snapshot/overlay"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// pinTTL is how long the checkpoints are pinned in the content store
+	// between the steps of a migration.
+	pinTTL = time.Hour
+	// checkpointDir is the directory of the checkpoint of a container in
+	// the directory of its migration, the pre-dump of its pre-copy being in
+	// its images.CheckpointParentDir.
+	checkpointDir = "checkpoint"
+	// preCopyFile records the manifest of the pre-copy of a container in
+	// the directory of its migration.
+	preCopyFile = "pre-copy.json"
+)
+
+// ImageNamer names the images of the image store.
+type ImageNamer interface {
+	PutImage(ctx context.Context, name string, target images.Descriptor) error
+}
+
+// NewService returns the migration service of the containers of execution,
+// keeping the state of the migrations under root. The checkpoints are written
+// to cs, pinned by leases of store between the steps of the migrations, and
+// transferred with the registries of resolver. The writable layers of the
+// containers are those of the active snapshots of snapshots, which may be nil
+// for the containers to be migrated without them.
+func NewService(root string, cs *content.ContentStore, store *leases.Store, resolver *remotes.Resolver, execution *execution.Service, images ImageNamer, snapshots *overlay.Overlayfs) *Service {
+	return &Service{
+		root:      root,
+		content:   cs,
+		leases:    store,
+		resolver:  resolver,
+		execution: execution,
+		images:    images,
+		snapshots: snapshots,
+	}
+}
+
+type Service struct {
+	root      string
+	content   *content.ContentStore
+	leases    *leases.Store
+	resolver  *remotes.Resolver
+	execution *execution.Service
+	images    ImageNamer
+	snapshots *overlay.Overlayfs
+}
+
+var _ = (api.MigrationServiceServer)(&Service{})
+
+func (s *Service) PreCopy(r *api.PreCopyRequest, stream api.MigrationService_PreCopyServer) error {
+	ctx := stream.Context()
+	ref, registry, err := s.registry(r.Reference)
+	if err != nil {
+		return err
+	}
+	dir, err := s.dir(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+	// a previous pre-copy is replaced
+	predump := filepath.Join(dir, checkpointDir, images.CheckpointParentDir)
+	if err := os.RemoveAll(predump); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(predump, 0700); err != nil {
+		return err
+	}
+
+	p := newProgress(stream)
+	if err := p.start(StagePreDump); err != nil {
+		return err
+	}
+	if _, err := s.execution.Checkpoint(ctx, &execapi.CheckpointContainerRequest{
+		ID:      r.ID,
+		Path:    predump,
+		PreDump: true,
+	}); err != nil {
+		return err
+	}
+	var desc images.Descriptor
+	if err := s.held(func() (err error) {
+		desc, err = images.WriteCheckpoint(s.content, images.CheckpointFiles{Dir: predump}, images.CheckpointConfig{
+			ContainerID: r.ID,
+			Created:     time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+		// the pre-copy is kept until the handoff references it
+		return s.pin(ctx, desc.Digest)
+	}); err != nil {
+		return err
+	}
+
+	if err := p.start(StagePush); err != nil {
+		return err
+	}
+	if err := remotes.Push(ctx, registry, s.content, ref.Name, desc.Digest.String(), desc, images.DefaultPlatform(), p.transfer); err != nil {
+		return errors.Wrapf(err, "failed to push the pre-copy of %s", r.ID)
+	}
+	if err := writePreCopy(dir, desc); err != nil {
+		return err
+	}
+	return p.done(byDigest(ref, desc.Digest), nil)
+}
+
+func (s *Service) Handoff(r *api.HandoffRequest, stream api.MigrationService_HandoffServer) error {
+	ctx := stream.Context()
+	ref, registry, err := s.registry(r.Reference)
+	if err != nil {
+		return err
+	}
+	if ref.Digest != "" {
+		return grpc.Errorf(codes.InvalidArgument, "checkpoint %q must be pushed under a tag", r.Reference)
+	}
+	dir, err := s.dir(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+	c, err := s.execution.Get(ctx, &execapi.GetContainerRequest{ID: r.ID})
+	if err != nil {
+		return err
+	}
+	b, err := bundle.Load(c.Container.BundlePath)
+	if err != nil {
+		return err
+	}
+	files := images.CheckpointFiles{Spec: b.ConfigPath()}
+	if r.Rootfs {
+		if files.Rootfs, err = b.Rootfs(); err != nil {
+			return err
+		}
+	}
+	if r.Snapshot != "" {
+		if s.snapshots == nil {
+			return grpc.Errorf(codes.FailedPrecondition, "snapshots are not supported by the daemon")
+		}
+		if files.RWLayer, err = s.snapshots.Upper(r.Snapshot); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+	parent, err := readPreCopy(dir)
+	if err != nil {
+		return err
+	}
+	// the files of a failed handoff are replaced, the pre-dump is kept
+	files.Dir = filepath.Join(dir, checkpointDir)
+	if err := clearCheckpoint(files.Dir); err != nil {
+		return err
+	}
+
+	p := newProgress(stream)
+	if err := p.start(StageCheckpoint); err != nil {
+		return err
+	}
+	req := &execapi.CheckpointContainerRequest{
+		ID:             r.ID,
+		Path:           files.Dir,
+		Exit:           true,
+		TCPEstablished: r.TCPEstablished,
+		UnixSockets:    r.UnixSockets,
+		Shell:          r.Shell,
+		EmptyNS:        r.EmptyNS,
+	}
+	if parent != nil {
+		req.ParentPath = images.CheckpointParentDir
+	}
+	if _, err := s.execution.Checkpoint(ctx, req); err != nil {
+		return err
+	}
+	var desc images.Descriptor
+	if err := s.held(func() (err error) {
+		desc, err = images.WriteCheckpoint(s.content, files, images.CheckpointConfig{
+			ContainerID: r.ID,
+			Created:     time.Now().UTC(),
+			Parent:      parent,
+			EmptyNS:     r.EmptyNS,
+		})
+		if err != nil {
+			return err
+		}
+		// the checkpoint is kept as an image, for the container to be
+		// restored on the source should the cutover fail
+		return s.images.PutImage(ctx, ref.String(), desc)
+	}); err != nil {
+		return err
+	}
+	if parent != nil {
+		s.unpin(ctx, parent.Digest)
+	}
+
+	if err := p.start(StagePush); err != nil {
+		return err
+	}
+	// the pre-copy may have been pushed to another repository
+	if parent != nil {
+		if err := remotes.Push(ctx, registry, s.content, ref.Name, parent.Digest.String(), *parent, images.DefaultPlatform(), p.transfer); err != nil {
+			return errors.Wrapf(err, "failed to push the pre-copy of %s", r.ID)
+		}
+	}
+	if err := remotes.Push(ctx, registry, s.content, ref.Name, ref.Object(), desc, images.DefaultPlatform(), p.transfer); err != nil {
+		return errors.Wrapf(err, "failed to push the checkpoint of %s", r.ID)
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		log.G(ctx).WithError(err).WithField("id", r.ID).Warn("failed to remove the migration directory")
+	}
+	return p.done(byDigest(ref, desc.Digest), nil)
+}
+
+func (s *Service) Prepare(r *api.PrepareRequest, stream api.MigrationService_PrepareServer) error {
+	ctx := stream.Context()
+	ref, registry, err := s.registry(r.Reference)
+	if err != nil {
+		return err
+	}
+	p := newProgress(stream)
+	if err := p.start(StageFetch); err != nil {
+		return err
+	}
+	var desc images.Descriptor
+	if err := s.held(func() (err error) {
+		desc, err = remotes.Fetch(ctx, registry, s.content, ref.Name, ref.Object(), images.DefaultPlatform(), p.transfer)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch pre-copy %s", r.Reference)
+		}
+		return s.pin(ctx, desc.Digest)
+	}); err != nil {
+		return err
+	}
+	return p.done(byDigest(ref, desc.Digest), nil)
+}
+
+func (s *Service) Cutover(r *api.CutoverRequest, stream api.MigrationService_CutoverServer) error {
+	ctx := stream.Context()
+	if r.Container == nil {
+		return grpc.Errorf(codes.InvalidArgument, "container to restore must be provided")
+	}
+	ref, registry, err := s.registry(r.Reference)
+	if err != nil {
+		return err
+	}
+	dir, err := s.dir(ctx, r.Container.ID)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	p := newProgress(stream)
+	if err := p.start(StageFetch); err != nil {
+		return err
+	}
+	var (
+		desc   images.Descriptor
+		pinned []digest.Digest
+	)
+	defer func() {
+		for _, dgst := range pinned {
+			s.unpin(ctx, dgst)
+		}
+	}()
+	if err := s.held(func() (err error) {
+		desc, err = remotes.Fetch(ctx, registry, s.content, ref.Name, ref.Object(), images.DefaultPlatform(), p.transfer)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch checkpoint %s", r.Reference)
+		}
+		if err := s.pin(ctx, desc.Digest); err != nil {
+			return err
+		}
+		pinned = append(pinned, desc.Digest)
+		// the pre-copies are fetched unless they were prepared, the blobs
+		// already fetched are not fetched again
+		for parent := desc; ; {
+			var ok bool
+			if parent, ok, err = checkpointParent(s.content, parent); err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+			if parent, err = remotes.Fetch(ctx, registry, s.content, ref.Name, parent.Digest.String(), images.DefaultPlatform(), p.transfer); err != nil {
+				return errors.Wrapf(err, "failed to fetch the pre-copy of %s", r.Reference)
+			}
+			if err := s.pin(ctx, parent.Digest); err != nil {
+				return err
+			}
+			pinned = append(pinned, parent.Digest)
+		}
+	}); err != nil {
+		return err
+	}
+
+	if err := p.start(StageExtract); err != nil {
+		return err
+	}
+	var rootfs string
+	if r.Container.BundlePath != "" {
+		ok, err := images.CheckpointHasRootfs(s.content, desc)
+		if err != nil {
+			return err
+		}
+		// the archives of the registry are extracted as root
+		if ok {
+			if rootfs, err = bundle.ExtractRootfs(r.Container.BundlePath); err != nil {
+				return grpc.Errorf(codes.InvalidArgument, "%v", err)
+			}
+		}
+	}
+	ckpt := filepath.Join(dir, checkpointDir)
+	if _, err := images.ReadCheckpoint(s.content, desc, images.CheckpointFiles{Dir: ckpt, Rootfs: rootfs}); err != nil {
+		return err
+	}
+
+	if err := p.start(StageRestore); err != nil {
+		return err
+	}
+	req := *r.Container
+	req.CheckpointPath = ckpt
+	resp, err := s.execution.Create(ctx, &req)
+	if err != nil {
+		return err
+	}
+	return p.done(byDigest(ref, desc.Digest), resp)
+}
+
+// registry returns the parsed reference and its registry.
+func (s *Service) registry(reference string) (remotes.Reference, *remotes.Registry, error) {
+	ref, err := remotes.ParseReference(reference)
+	if err != nil {
+		return ref, nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	registry, err := s.resolver.Registry(ref.RegistryHost())
+	if err != nil {
+		return ref, nil, err
+	}
+	return ref, registry, nil
+}
+
+// dir returns the directory of the migration of the container id of the
+// namespace of ctx, creating it if needed.
+func (s *Service) dir(ctx context.Context, id string) (string, error) {
+	if id == "" || id == "." || id == ".." || filepath.Base(id) != id {
+		return "", grpc.Errorf(codes.InvalidArgument, "invalid container id %q", id)
+	}
+	dir := filepath.Join(s.root, namespaces.Namespace(ctx), id)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// pin pins the checkpoint dgst in the content store for pinTTL, by a lease
+// of the namespace of ctx.
+func (s *Service) pin(ctx context.Context, dgst digest.Digest) error {
+	ns, id := namespaces.Namespace(ctx), leaseID(dgst)
+	if _, err := s.leases.Create(ns, id, pinTTL); err != nil {
+		if errors.Cause(err) != leases.ErrExists {
+			return err
+		}
+		if _, err := s.leases.Renew(ns, id, pinTTL); err != nil {
+			return err
+		}
+	}
+	return s.leases.AddResource(ns, id, leases.Resource{
+		Type: leases.ResourceContent,
+		ID:   dgst.String(),
+	})
+}
+
+// held runs fn holding the content store, for the blobs it writes not to be
+// collected before it pins or names them.
+func (s *Service) held(fn func() error) error {
+	release, err := s.content.Hold()
+	if err != nil {
+		return err
+	}
+	defer release()
+	return fn()
+}
+
+// unpin releases the checkpoint dgst pinned by pin.
+func (s *Service) unpin(ctx context.Context, dgst digest.Digest) {
+	if err := s.leases.Delete(namespaces.Namespace(ctx), leaseID(dgst)); err != nil && err != leases.ErrNotFound {
+		log.G(ctx).WithError(err).WithField("digest", dgst).Warn("failed to release checkpoint")
+	}
+}
+
+func leaseID(dgst digest.Digest) string {
+	return "migration-" + dgst.Hex()
+}
+
+// byDigest returns the reference of the manifest dgst in the repository of
+// ref.
+func byDigest(ref remotes.Reference, dgst digest.Digest) string {
+	ref.Tag, ref.Digest = "", dgst
+	return ref.String()
+}
+
+// checkpointParent returns the pre-copy the checkpoint desc is incremental
+// to, if any.
+func checkpointParent(cs *content.ContentStore, desc images.Descriptor) (images.Descriptor, bool, error) {
+	children, err := images.Children(cs, desc)
+	if err != nil {
+		return images.Descriptor{}, false, err
+	}
+	for _, c := range children {
+		if c.MediaType != images.MediaTypeCheckpointConfig {
+			continue
+		}
+		parents, err := images.Children(cs, c)
+		if err != nil || len(parents) == 0 {
+			return images.Descriptor{}, false, err
+		}
+		return parents[0], true, nil
+	}
+	return images.Descriptor{}, false, errors.Errorf("%s is not a checkpoint", desc.Digest)
+}
+
+// clearCheckpoint removes the files of the checkpoint in dir but its pre-dump.
+func clearCheckpoint(dir string) error {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return os.MkdirAll(dir, 0700)
+		}
+		return err
+	}
+	for _, fi := range fis {
+		if fi.Name() == images.CheckpointParentDir {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, fi.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readPreCopy returns the pre-copy recorded in dir, nil when there is none.
+func readPreCopy(dir string) (*images.Descriptor, error) {
+	p, err := ioutil.ReadFile(filepath.Join(dir, preCopyFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var desc images.Descriptor
+	if err := json.Unmarshal(p, &desc); err != nil {
+		return nil, errors.Wrap(err, "failed to read the pre-copy")
+	}
+	return &desc, nil
+}
+
+func writePreCopy(dir string, desc images.Descriptor) error {
+	p, err := json.Marshal(desc)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, preCopyFile), p, 0600)
+}
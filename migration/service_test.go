@@ -0,0 +1,156 @@
+package migration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/leases"
+	"github.com/docker/containerd/namespaces"
+	"github.com/docker/containerd/remotes"
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func serviceEnv(t *testing.T) (*Service, func()) {
+	tmpdir, err := ioutil.TempDir("", "migration-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err := content.OpenContentStore(filepath.Join(tmpdir, "content"))
+	if err != nil {
+		os.RemoveAll(tmpdir)
+		t.Fatal(err)
+	}
+	store, err := leases.NewStore(filepath.Join(tmpdir, "leases"))
+	if err != nil {
+		os.RemoveAll(tmpdir)
+		t.Fatal(err)
+	}
+	return NewService(filepath.Join(tmpdir, "migrations"), cs, store, nil, nil, nil, nil), func() {
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func TestDir(t *testing.T) {
+	s, cleanup := serviceEnv(t)
+	defer cleanup()
+	ctx := namespaces.WithNamespace(context.Background(), "ci")
+
+	for _, id := range []string{"", ".", "..", "a/b", "../c"} {
+		if _, err := s.dir(ctx, id); grpc.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected container id %q to be rejected, got %v", id, err)
+		}
+	}
+	dir, err := s.dir(ctx, "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := filepath.Join(s.root, "ci", "c"); dir != expected {
+		t.Fatalf("expected the directory of the migration to be %s, got %s", expected, dir)
+	}
+	if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+		t.Fatalf("expected the directory of the migration to be created: %v", err)
+	}
+}
+
+func TestPin(t *testing.T) {
+	s, cleanup := serviceEnv(t)
+	defer cleanup()
+	ctx := namespaces.WithNamespace(context.Background(), "ci")
+	dgst := digest.FromString("checkpoint")
+
+	// a checkpoint pinned again is pinned by the same lease
+	for i := 0; i < 2; i++ {
+		if err := s.pin(ctx, dgst); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ls, err := s.leases.List("ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 1 || ls[0].ID != leaseID(dgst) || ls[0].ExpiresAt.IsZero() || len(ls[0].Resources) != 1 || ls[0].Resources[0].ID != dgst.String() {
+		t.Fatalf("expected a lease expiring on the checkpoint, got %+v", ls)
+	}
+	if ls, err := s.leases.List("default"); err != nil || len(ls) != 0 {
+		t.Fatalf("expected the checkpoint to be pinned in the namespace of the migration, got %+v: %v", ls, err)
+	}
+
+	s.unpin(ctx, dgst)
+	if ls, err := s.leases.List("ci"); err != nil || len(ls) != 0 {
+		t.Fatalf("expected the checkpoint to be unpinned, got %+v: %v", ls, err)
+	}
+	// a checkpoint not pinned is unpinned without error
+	s.unpin(ctx, dgst)
+}
+
+func TestPreCopy(t *testing.T) {
+	s, cleanup := serviceEnv(t)
+	defer cleanup()
+	dir, err := s.dir(context.Background(), "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if desc, err := readPreCopy(dir); err != nil || desc != nil {
+		t.Fatalf("expected no pre-copy, got %+v: %v", desc, err)
+	}
+	expected := images.Descriptor{
+		MediaType: images.MediaTypeOCIManifest,
+		Digest:    digest.FromString("pre-copy"),
+		Size:      8,
+	}
+	if err := writePreCopy(dir, expected); err != nil {
+		t.Fatal(err)
+	}
+	if desc, err := readPreCopy(dir); err != nil || desc == nil || *desc != expected {
+		t.Fatalf("expected the pre-copy %+v, got %+v: %v", expected, desc, err)
+	}
+}
+
+func TestClearCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migration-checkpoint-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ckpt := filepath.Join(dir, checkpointDir)
+
+	if err := clearCheckpoint(ckpt); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(ckpt, images.CheckpointParentDir), 0700); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"pages-1.img", filepath.Join(images.CheckpointParentDir, "pages-1.img")} {
+		if err := ioutil.WriteFile(filepath.Join(ckpt, name), []byte("pages"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := clearCheckpoint(ckpt); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(ckpt, "pages-1.img")); !os.IsNotExist(err) {
+		t.Fatalf("expected the files of the checkpoint to be removed, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(ckpt, images.CheckpointParentDir, "pages-1.img")); err != nil {
+		t.Fatalf("expected the pre-dump to be kept: %v", err)
+	}
+}
+
+func TestByDigest(t *testing.T) {
+	ref, err := remotes.ParseReference("localhost:5000/app:migration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dgst := digest.FromString("checkpoint")
+	if s, expected := byDigest(ref, dgst), "localhost:5000/app@"+dgst.String(); s != expected {
+		t.Fatalf("expected %s, got %s", expected, s)
+	}
+}
@@ -0,0 +1,9 @@
+package containerd
+
+import "errors"
+
+// MountFS fails on Windows, where the layers of the containers are stacked
+// by the compute system rather than mounted.
+func MountFS(mounts []Mount, target string) error {
+	return errors.New("mounts are not supported on windows")
+}
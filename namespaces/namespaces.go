@@ -0,0 +1,45 @@
+// Package namespaces names the namespace of the requests of clients, so
+// that several clients can share a daemon.
+package namespaces
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// GRPCHeader is the metadata key of the namespace of a request.
+	GRPCHeader = "containerd-namespace"
+	// Default is the namespace of the requests which do not set one.
+	Default = "default"
+)
+
+var nameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9._-]{0,61}[a-z0-9])?$`)
+
+// Validate returns an error if name is not a valid namespace: up to 63
+// lowercase alphanumeric characters, '.', '_' or '-', starting and ending
+// with an alphanumeric character.
+func Validate(name string) error {
+	if !nameRegexp.MatchString(name) {
+		return errors.Errorf("invalid namespace %q", name)
+	}
+	return nil
+}
+
+type namespaceKey struct{}
+
+// WithNamespace returns a context carrying the namespace of a request.
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, namespace)
+}
+
+// Namespace returns the namespace of the request of ctx, Default when it
+// carries none.
+func Namespace(ctx context.Context) string {
+	if ns, ok := ctx.Value(namespaceKey{}).(string); ok && ns != "" {
+		return ns
+	}
+	return Default
+}
@@ -0,0 +1,30 @@
+package namespaces
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	for _, name := range []string{Default, "k8s.io", "ci-runner_2", "a", strings.Repeat("a", 63)} {
+		if err := Validate(name); err != nil {
+			t.Fatalf("expected %q to be valid, got %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "Default", "-ci", "ci.", "a/b", "a b", strings.Repeat("a", 64)} {
+		if err := Validate(name); err == nil {
+			t.Fatalf("expected %q to be invalid", name)
+		}
+	}
+}
+
+func TestNamespaceContext(t *testing.T) {
+	ctx := context.Background()
+	if ns := Namespace(ctx); ns != Default {
+		t.Fatalf("expected the default namespace, got %q", ns)
+	}
+	if ns := Namespace(WithNamespace(ctx, "k8s.io")); ns != "k8s.io" {
+		t.Fatalf("expected namespace k8s.io, got %q", ns)
+	}
+}
@@ -0,0 +1,120 @@
+// Package netns manages network namespaces persisted by name, so that they
+// outlive the processes in them and can be shared by containers.
+package netns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const nsFilename = "net"
+
+var (
+	ErrNotFound      = errors.New("network namespace not found")
+	ErrExists        = errors.New("network namespace already exists")
+	ErrInvalidName   = errors.New("invalid network namespace name")
+	ErrNotANamespace = errors.New("not a network namespace")
+)
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// Store holds a directory per network namespace under its root, in which the
+// namespace is bind mounted along with any state of its users.
+type Store struct {
+	root string
+}
+
+// NewStore returns the store of the network namespaces under root.
+func NewStore(root string) (*Store, error) {
+	if err := os.MkdirAll(root, 0711); err != nil {
+		return nil, err
+	}
+	return &Store{root: root}, nil
+}
+
+// Create creates the network namespace name, returning its path.
+func (s *Store) Create(name string) (string, error) {
+	if !validName.MatchString(name) {
+		return "", errors.Wrapf(ErrInvalidName, "%q", name)
+	}
+	dir := s.Dir(name)
+	if err := os.Mkdir(dir, 0711); err != nil {
+		if os.IsExist(err) {
+			return "", errors.Wrapf(ErrExists, "%q", name)
+		}
+		return "", err
+	}
+	p := filepath.Join(dir, nsFilename)
+	if err := create(p); err != nil {
+		os.RemoveAll(dir)
+		return "", errors.Wrapf(err, "failed to create network namespace %q", name)
+	}
+	return p, nil
+}
+
+// Delete deletes the network namespace name along with its directory. The
+// namespace lives on until the processes in it exit.
+func (s *Store) Delete(name string) error {
+	p, err := s.Path(name)
+	if err != nil {
+		return err
+	}
+	if err := remove(p); err != nil {
+		return errors.Wrapf(err, "failed to delete network namespace %q", name)
+	}
+	return os.RemoveAll(s.Dir(name))
+}
+
+// Path returns the path of the network namespace name, which processes join.
+func (s *Store) Path(name string) (string, error) {
+	if !validName.MatchString(name) {
+		return "", errors.Wrapf(ErrInvalidName, "%q", name)
+	}
+	p := filepath.Join(s.Dir(name), nsFilename)
+	if _, err := os.Stat(p); err != nil {
+		if os.IsNotExist(err) {
+			return "", errors.Wrapf(ErrNotFound, "%q", name)
+		}
+		return "", err
+	}
+	return p, nil
+}
+
+// Check returns an error unless path is the absolute path of a network
+// namespace, such as a namespace bind mounted by its creator or
+// /proc/<pid>/ns/net.
+func Check(path string) error {
+	if !filepath.IsAbs(path) {
+		return errors.Wrapf(ErrNotANamespace, "%q is not an absolute path", path)
+	}
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+	return check(path)
+}
+
+// Dir returns the directory of the network namespace name.
+func (s *Store) Dir(name string) string {
+	return filepath.Join(s.root, name)
+}
+
+// List returns the names of the network namespaces, sorted.
+func (s *Store) List() ([]string, error) {
+	entries, err := ioutil.ReadDir(s.root)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,102 @@
+package netns
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// nsfsMagic is the filesystem of namespace files, procfsMagic that of
+	// the namespace files of kernels before 3.19.
+	nsfsMagic   = 0x6e736673
+	procfsMagic = 0x9fa0
+
+	// nsGetNSType is the NS_GET_NSTYPE ioctl, returning the type of a
+	// namespace since linux 4.11.
+	nsGetNSType = 0xb703
+)
+
+// create bind mounts a new network namespace at path. The namespace is
+// created by a thread of its own, which is discarded rather than reused if it
+// fails to return to the namespace of the daemon.
+func create(path string) error {
+	f, err := os.OpenFile(path, os.O_RDONLY|os.O_CREATE|os.O_EXCL, 0444)
+	if err != nil {
+		return err
+	}
+	f.Close()
+	errCh := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+		self := fmt.Sprintf("/proc/self/task/%d/ns/net", syscall.Gettid())
+		orig, err := os.Open(self)
+		if err != nil {
+			runtime.UnlockOSThread()
+			errCh <- err
+			return
+		}
+		defer orig.Close()
+		if err := syscall.Unshare(syscall.CLONE_NEWNET); err != nil {
+			runtime.UnlockOSThread()
+			errCh <- err
+			return
+		}
+		err = syscall.Mount(self, path, "none", syscall.MS_BIND, "")
+		if unix.Setns(int(orig.Fd()), syscall.CLONE_NEWNET) == nil {
+			runtime.UnlockOSThread()
+		}
+		errCh <- err
+	}()
+	if err := <-errCh; err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+func check(path string) error {
+	var fs unix.Statfs_t
+	if err := unix.Statfs(path, &fs); err != nil {
+		return err
+	}
+	switch fs.Type {
+	case nsfsMagic:
+	case procfsMagic:
+		// only the links of /proc/<pid>/ns tell their type
+		if l, err := os.Readlink(path); err != nil || !strings.HasPrefix(l, "net:") {
+			return errors.Wrapf(ErrNotANamespace, "%q", path)
+		}
+		return nil
+	default:
+		return errors.Wrapf(ErrNotANamespace, "%q", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	typ, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), nsGetNSType, 0)
+	switch {
+	case errno == unix.ENOTTY:
+		// the kernel cannot tell the type of the namespace
+		return nil
+	case errno != 0:
+		return errno
+	case typ != syscall.CLONE_NEWNET:
+		return errors.Wrapf(ErrNotANamespace, "%q is a namespace of another type", path)
+	}
+	return nil
+}
+
+func remove(path string) error {
+	if err := syscall.Unmount(path, syscall.MNT_DETACH); err != nil && err != syscall.EINVAL {
+		return err
+	}
+	return os.Remove(path)
+}
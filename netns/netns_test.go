@@ -0,0 +1,85 @@
+package netns
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"syscall"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestStore(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("creating network namespaces requires root")
+	}
+	root, err := ioutil.TempDir("", "netns-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	s, err := NewStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := s.Create("pod1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Create("pod1"); errors.Cause(err) != ErrExists {
+		t.Fatalf("expected ErrExists, got %v", err)
+	}
+	if _, err := s.Create("../pod"); errors.Cause(err) != ErrInvalidName {
+		t.Fatalf("expected ErrInvalidName, got %v", err)
+	}
+	var ns, host syscall.Stat_t
+	if err := syscall.Stat(p, &ns); err != nil {
+		t.Fatal(err)
+	}
+	if err := syscall.Stat("/proc/self/ns/net", &host); err != nil {
+		t.Fatal(err)
+	}
+	if ns.Ino == host.Ino && ns.Dev == host.Dev {
+		t.Fatal("expected a namespace other than the host's")
+	}
+	names, err := s.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{"pod1"}) {
+		t.Fatalf("unexpected namespaces %v", names)
+	}
+
+	if err := s.Delete("pod1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Path("pod1"); errors.Cause(err) != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	if err := Check("/proc/self/ns/net"); err != nil {
+		t.Fatalf("expected the namespace of the test to be a network namespace, got %v", err)
+	}
+	if err := Check("/proc/self/ns/ipc"); errors.Cause(err) != ErrNotANamespace {
+		t.Fatalf("expected ErrNotANamespace for an ipc namespace, got %v", err)
+	}
+	if err := Check("/proc/self/status"); errors.Cause(err) != ErrNotANamespace {
+		t.Fatalf("expected ErrNotANamespace for a file of procfs, got %v", err)
+	}
+	if err := Check("proc/self/ns/net"); errors.Cause(err) != ErrNotANamespace {
+		t.Fatalf("expected ErrNotANamespace for a relative path, got %v", err)
+	}
+	f, err := ioutil.TempFile("", "netns-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	if err := Check(f.Name()); errors.Cause(err) != ErrNotANamespace {
+		t.Fatalf("expected ErrNotANamespace for a regular file, got %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+// +build !linux
+
+package netns
+
+import "github.com/pkg/errors"
+
+func create(path string) error {
+	return errors.New("network namespaces are only supported on linux")
+}
+
+func check(path string) error {
+	return errors.New("network namespaces are only supported on linux")
+}
+
+func remove(path string) error {
+	return errors.New("network namespaces are only supported on linux")
+}
@@ -0,0 +1,209 @@
+// Package portforward forwards ports of the host to containers with a
+// userspace proxy.
+package portforward
+
+import (
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Protocols of the forwarded ports.
+const (
+	TCP = "tcp"
+	UDP = "udp"
+)
+
+// UDPIdleTimeout is the time after which a UDP client that sent nothing is
+// forgotten, along with its connection to the container.
+var UDPIdleTimeout = 30 * time.Second
+
+// Mapping forwards a port of the host to a port of a container.
+type Mapping struct {
+	// Protocol is tcp or udp, tcp when empty.
+	Protocol string `json:"protocol,omitempty"`
+	// HostIP is the address of the host to listen on, all of them when
+	// empty.
+	HostIP        string `json:"hostIP,omitempty"`
+	HostPort      uint16 `json:"hostPort"`
+	ContainerPort uint16 `json:"containerPort"`
+}
+
+func (m Mapping) protocol() string {
+	if m.Protocol == "" {
+		return TCP
+	}
+	return m.Protocol
+}
+
+// Forward is an established mapping, forwarding to a container address.
+type Forward struct {
+	Mapping
+	// ContainerIP is the address of the container the port is forwarded to.
+	ContainerIP string
+
+	close func() error
+}
+
+// Listen establishes the mapping, forwarding the connections to the host
+// port to the port of the container at ip.
+func Listen(m Mapping, ip string) (*Forward, error) {
+	if m.HostPort == 0 || m.ContainerPort == 0 {
+		return nil, errors.New("portforward: host and container ports must be provided")
+	}
+	var (
+		host    = net.JoinHostPort(m.HostIP, strconv.Itoa(int(m.HostPort)))
+		backend = net.JoinHostPort(ip, strconv.Itoa(int(m.ContainerPort)))
+		f       = &Forward{Mapping: m, ContainerIP: ip}
+	)
+	switch m.protocol() {
+	case TCP:
+		l, err := net.Listen("tcp", host)
+		if err != nil {
+			return nil, err
+		}
+		go proxyTCP(l, backend)
+		f.close = l.Close
+	case UDP:
+		addr, err := net.ResolveUDPAddr("udp", host)
+		if err != nil {
+			return nil, err
+		}
+		conn, err := net.ListenUDP("udp", addr)
+		if err != nil {
+			return nil, err
+		}
+		p := &udpProxy{
+			conn:    conn,
+			backend: backend,
+			clients: make(map[string]*net.UDPConn),
+		}
+		go p.run()
+		f.close = p.close
+	default:
+		return nil, errors.Errorf("portforward: unknown protocol %q", m.Protocol)
+	}
+	return f, nil
+}
+
+// Close stops forwarding the port, the connections already forwarded are
+// left to end on their own.
+func (f *Forward) Close() error {
+	return f.close()
+}
+
+func proxyTCP(l net.Listener, backend string) {
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return
+		}
+		go func() {
+			defer c.Close()
+			b, err := net.DialTimeout("tcp", backend, 10*time.Second)
+			if err != nil {
+				logrus.WithError(err).WithField("backend", backend).Warn("portforward: failed to connect to container")
+				return
+			}
+			defer b.Close()
+			done := make(chan struct{}, 2)
+			pipe := func(dst, src net.Conn) {
+				io.Copy(dst, src)
+				if tc, ok := dst.(*net.TCPConn); ok {
+					tc.CloseWrite()
+				}
+				done <- struct{}{}
+			}
+			go pipe(b, c)
+			go pipe(c, b)
+			<-done
+			<-done
+		}()
+	}
+}
+
+// udpProxy relays the datagrams of each client through a connection of its
+// own to the container, so that the replies are returned to the client.
+type udpProxy struct {
+	conn    *net.UDPConn
+	backend string
+
+	mu      sync.Mutex
+	clients map[string]*net.UDPConn
+}
+
+func (p *udpProxy) run() {
+	buf := make([]byte, 65535)
+	for {
+		n, client, err := p.conn.ReadFromUDP(buf)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return
+		}
+		b, err := p.clientConn(client)
+		if err != nil {
+			logrus.WithError(err).WithField("backend", p.backend).Warn("portforward: failed to connect to container")
+			continue
+		}
+		b.Write(buf[:n])
+	}
+}
+
+// clientConn returns the connection to the container of the client, relaying
+// the replies of the container until the client is idle.
+func (p *udpProxy) clientConn(client *net.UDPAddr) (*net.UDPConn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if b, ok := p.clients[client.String()]; ok {
+		return b, nil
+	}
+	addr, err := net.ResolveUDPAddr("udp", p.backend)
+	if err != nil {
+		return nil, err
+	}
+	b, err := net.DialUDP("udp", nil, addr)
+	if err != nil {
+		return nil, err
+	}
+	p.clients[client.String()] = b
+	go func() {
+		defer func() {
+			p.mu.Lock()
+			delete(p.clients, client.String())
+			p.mu.Unlock()
+			b.Close()
+		}()
+		buf := make([]byte, 65535)
+		for {
+			b.SetReadDeadline(time.Now().Add(UDPIdleTimeout))
+			n, err := b.Read(buf)
+			if err != nil {
+				return
+			}
+			if _, err := p.conn.WriteToUDP(buf[:n], client); err != nil {
+				return
+			}
+		}
+	}()
+	return b, nil
+}
+
+func (p *udpProxy) close() error {
+	err := p.conn.Close()
+	p.mu.Lock()
+	for _, b := range p.clients {
+		b.Close()
+	}
+	p.mu.Unlock()
+	return err
+}
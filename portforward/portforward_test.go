@@ -0,0 +1,134 @@
+package portforward
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func freePort(t *testing.T, network string) uint16 {
+	switch network {
+	case "udp":
+		c, err := net.ListenPacket("udp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer c.Close()
+		return uint16(c.LocalAddr().(*net.UDPAddr).Port)
+	default:
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+		return uint16(l.Addr().(*net.TCPAddr).Port)
+	}
+}
+
+func TestForwardTCP(t *testing.T) {
+	backend, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backend.Close()
+	go func() {
+		for {
+			c, err := backend.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				line, _ := bufio.NewReader(c).ReadString('\n')
+				c.Write([]byte("echo " + line))
+			}()
+		}
+	}()
+
+	m := Mapping{
+		HostIP:        "127.0.0.1",
+		HostPort:      freePort(t, "tcp"),
+		ContainerPort: uint16(backend.Addr().(*net.TCPAddr).Port),
+	}
+	f, err := Listen(m, "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := net.Dial("tcp", net.JoinHostPort(m.HostIP, itoa(m.HostPort)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := c.Write([]byte("hello\n")); err != nil {
+		t.Fatal(err)
+	}
+	reply, err := bufio.NewReader(c).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply != "echo hello\n" {
+		t.Fatalf("unexpected reply %q", reply)
+	}
+
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := net.Dial("tcp", net.JoinHostPort(m.HostIP, itoa(m.HostPort))); err == nil {
+		c.Close()
+		t.Fatal("expected the port to be closed")
+	}
+}
+
+func TestForwardUDP(t *testing.T) {
+	backend, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backend.Close()
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := backend.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			backend.WriteTo(append([]byte("echo "), buf[:n]...), addr)
+		}
+	}()
+
+	m := Mapping{
+		Protocol:      UDP,
+		HostIP:        "127.0.0.1",
+		HostPort:      freePort(t, "udp"),
+		ContainerPort: uint16(backend.LocalAddr().(*net.UDPAddr).Port),
+	}
+	f, err := Listen(m, "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	c, err := net.Dial("udp", net.JoinHostPort(m.HostIP, itoa(m.HostPort)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := c.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 1024)
+	n, err := c.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf[:n]) != "echo hello" {
+		t.Fatalf("unexpected reply %q", buf[:n])
+	}
+}
+
+func itoa(p uint16) string {
+	return strconv.Itoa(int(p))
+}
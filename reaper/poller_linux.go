@@ -0,0 +1,136 @@
+package reaper
+
+import (
+	"os"
+	"sync"
+	"syscall"
+
+	"github.com/docker/containerd/log"
+	"github.com/docker/containerd/sys"
+	starttime "github.com/opencontainers/runc/libcontainer/system"
+	"github.com/pkg/errors"
+)
+
+// Poller notifies the exits of processes, polling a pidfd of each process on
+// a single epoll loop rather than blocking a goroutine per process in wait.
+// The exited processes are not reaped, their parent still waits for them.
+type Poller struct {
+	epfd    int
+	mu      sync.Mutex
+	watches map[int]func()
+	// err is set once the loop failed, the watches failing with it from
+	// then on
+	err error
+}
+
+// NewPoller returns a poller running its loop until the process exits.
+func NewPoller() (*Poller, error) {
+	fd, err := syscall.EpollCreate1(syscall.EPOLL_CLOEXEC)
+	if err != nil {
+		return nil, errors.Wrap(err, "epollcreate1 failed")
+	}
+	p := &Poller{
+		epfd:    fd,
+		watches: make(map[int]func()),
+	}
+	go p.run()
+	return p, nil
+}
+
+// Watch calls fn once the process pid exited, right away if it already did.
+// When startTime is set, a process started at another time is a reuse of
+// the pid, the watched process having exited already. fn is called from the
+// loop of the poller and must not block. Watch fails with ENOSYS when the
+// kernel has no pidfds.
+//
+// Should the loop fail, fn is called for all the watches, their processes
+// being left to the callers to wait for.
+func (p *Poller) Watch(pid int, startTime string, fn func()) error {
+	fd, err := sys.PidfdOpen(pid)
+	if err != nil {
+		if err == syscall.ESRCH {
+			fn()
+			return nil
+		}
+		return err
+	}
+	// the pidfd pins the pid, the start time checked after opening it is
+	// the one of the watched process
+	if startTime != "" {
+		stime, err := starttime.GetProcessStartTime(pid)
+		if err != nil && !os.IsNotExist(err) {
+			syscall.Close(fd)
+			return err
+		}
+		if stime != startTime {
+			syscall.Close(fd)
+			fn()
+			return nil
+		}
+	}
+	p.mu.Lock()
+	if p.err != nil {
+		p.mu.Unlock()
+		syscall.Close(fd)
+		return p.err
+	}
+	p.watches[fd] = fn
+	p.mu.Unlock()
+	if err := syscall.EpollCtl(p.epfd, syscall.EPOLL_CTL_ADD, fd, &syscall.EpollEvent{
+		Events: syscall.EPOLLIN,
+		Fd:     int32(fd),
+	}); err != nil {
+		p.mu.Lock()
+		_, ok := p.watches[fd]
+		delete(p.watches, fd)
+		p.mu.Unlock()
+		// a failing loop closed the pidfd and notified the watch already
+		if !ok {
+			return nil
+		}
+		syscall.Close(fd)
+		return errors.Wrap(err, "epollctl failed")
+	}
+	return nil
+}
+
+// fail stops the poller with err, notifying all its watches.
+func (p *Poller) fail(err error) {
+	log.L.WithError(err).Error("reaper: poller failed")
+	p.mu.Lock()
+	p.err = err
+	watches := p.watches
+	p.watches = make(map[int]func())
+	p.mu.Unlock()
+	for fd, fn := range watches {
+		syscall.Close(fd)
+		fn()
+	}
+	syscall.Close(p.epfd)
+}
+
+func (p *Poller) run() {
+	var events [128]syscall.EpollEvent
+	for {
+		n, err := syscall.EpollWait(p.epfd, events[:], -1)
+		if err != nil {
+			if err == syscall.EINTR {
+				continue
+			}
+			p.fail(errors.Wrap(err, "epollwait failed"))
+			return
+		}
+		for i := 0; i < n; i++ {
+			fd := int(events[i].Fd)
+			p.mu.Lock()
+			fn := p.watches[fd]
+			delete(p.watches, fd)
+			p.mu.Unlock()
+			// closing the pidfd removes it from the epoll set
+			syscall.Close(fd)
+			if fn != nil {
+				fn()
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package reaper
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestPollerWatch(t *testing.T) {
+	p, err := NewPoller()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	exited := make(chan struct{})
+	if err := p.Watch(cmd.Process.Pid, "", func() { close(exited) }); err != nil {
+		if err == syscall.ENOSYS {
+			t.Skip("pidfds are not supported")
+		}
+		t.Fatal(err)
+	}
+	select {
+	case <-exited:
+		t.Fatal("expected the exit of a running process not to be notified")
+	case <-time.After(10 * time.Millisecond):
+	}
+	cmd.Process.Kill()
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+		t.Fatal("expected the exit of the process to be notified")
+	}
+	// the exited process is left to its parent to reap
+	if err := cmd.Wait(); err == nil {
+		t.Fatal("expected the killed process to fail")
+	}
+
+	// the processes which already exited are notified right away
+	notified := false
+	if err := p.Watch(cmd.Process.Pid, "", func() { notified = true }); err != nil {
+		t.Fatal(err)
+	}
+	if !notified {
+		t.Fatal("expected the exit of a reaped process to be notified")
+	}
+}
+
+func TestPollerWatchStartTime(t *testing.T) {
+	p, err := NewPoller()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+
+	// a process started at another time reuses the pid of the watched one
+	notified := false
+	if err := p.Watch(cmd.Process.Pid, "0", func() { notified = true }); err != nil {
+		if err == syscall.ENOSYS {
+			t.Skip("pidfds are not supported")
+		}
+		t.Fatal(err)
+	}
+	if !notified {
+		t.Fatal("expected the exit of a process whose pid is reused to be notified")
+	}
+}
+
+func TestPollerFail(t *testing.T) {
+	p, err := NewPoller()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+	notified := false
+	if err := p.Watch(cmd.Process.Pid, "", func() { notified = true }); err != nil {
+		if err == syscall.ENOSYS {
+			t.Skip("pidfds are not supported")
+		}
+		t.Fatal(err)
+	}
+
+	// the watches are notified for their callers to wait for the processes
+	p.fail(syscall.EBADF)
+	if !notified {
+		t.Fatal("expected the watches to be notified once the poller failed")
+	}
+	if err := p.Watch(cmd.Process.Pid, "", func() {}); err != syscall.EBADF {
+		t.Fatalf("expected the watches to fail once the poller failed, got %v", err)
+	}
+}
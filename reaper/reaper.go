@@ -0,0 +1,164 @@
+// +build !windows
+
+// Package reaper routes the exit statuses of child processes to the callers
+// waiting for them.
+//
+// A process acting as a subreaper reaps all of its children, and the
+// children of its children, with wait4(-1) on SIGCHLD. Waiting for a child
+// with exec.Cmd.Wait would race with the reaping, so children are started
+// and waited for through a Monitor instead.
+package reaper
+
+import (
+	"bytes"
+	"os/exec"
+	"strconv"
+	"sync"
+
+	"github.com/docker/containerd/sys"
+	"github.com/pkg/errors"
+)
+
+// maxUnclaimed bounds the exit statuses kept for processes no one is
+// waiting for yet.
+const maxUnclaimed = 1024
+
+// Default is the monitor of the current process.
+var Default = New()
+
+// Reap reaps the exited children of the process and routes their statuses
+// through the Default monitor. It is called on SIGCHLD.
+func Reap() ([]sys.Exit, error) {
+	return Default.Reap()
+}
+
+// ExitError is returned when a command exits with a non-zero status.
+type ExitError struct {
+	Status int
+}
+
+func (e *ExitError) Error() string {
+	return "exit status " + strconv.Itoa(e.Status)
+}
+
+// Monitor routes the exit statuses of reaped children to their waiters.
+type Monitor struct {
+	mu      sync.Mutex
+	waiters map[int][]chan int
+	// unclaimed holds the statuses of processes that exited before anyone
+	// waited for them, oldest first in order.
+	unclaimed map[int]int
+	order     []int
+}
+
+// New returns a new monitor.
+func New() *Monitor {
+	return &Monitor{
+		waiters:   make(map[int][]chan int),
+		unclaimed: make(map[int]int),
+	}
+}
+
+// Reap reaps the exited children of the process, routing their statuses
+// to the waiters and returning them.
+func (m *Monitor) Reap() ([]sys.Exit, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	exits, err := sys.Reap(false)
+	for _, e := range exits {
+		m.notify(e)
+	}
+	return exits, err
+}
+
+func (m *Monitor) notify(e sys.Exit) {
+	if waiters, ok := m.waiters[e.Pid]; ok {
+		for _, w := range waiters {
+			w <- e.Status
+		}
+		delete(m.waiters, e.Pid)
+		return
+	}
+	if len(m.order) >= maxUnclaimed {
+		delete(m.unclaimed, m.order[0])
+		m.order = m.order[1:]
+	}
+	m.unclaimed[e.Pid] = e.Status
+	m.order = append(m.order, e.Pid)
+}
+
+// register returns a channel receiving the exit status of pid. The caller
+// must hold the lock.
+func (m *Monitor) register(pid int) chan int {
+	ch := make(chan int, 1)
+	if status, ok := m.unclaimed[pid]; ok {
+		delete(m.unclaimed, pid)
+		for i, p := range m.order {
+			if p == pid {
+				m.order = append(m.order[:i], m.order[i+1:]...)
+				break
+			}
+		}
+		ch <- status
+		return ch
+	}
+	m.waiters[pid] = append(m.waiters[pid], ch)
+	return ch
+}
+
+// Start starts the command. Its exit status is routed to Wait.
+func (m *Monitor) Start(c *exec.Cmd) (<-chan int, error) {
+	// reaping is held off until the command is registered, so its status
+	// cannot be taken by a concurrent Reap.
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := c.Start(); err != nil {
+		return nil, err
+	}
+	return m.register(c.Process.Pid), nil
+}
+
+// Wait waits for a command started with Start, returning its exit status.
+func (m *Monitor) Wait(c *exec.Cmd, ch <-chan int) int {
+	status := <-ch
+	// the process is already reaped, so the command's wait fails but
+	// releases its io.
+	c.Wait()
+	return status
+}
+
+// Run starts the command and waits for it, returning an *ExitError when it
+// exits with a non-zero status.
+func (m *Monitor) Run(c *exec.Cmd) error {
+	ch, err := m.Start(c)
+	if err != nil {
+		return err
+	}
+	if status := m.Wait(c, ch); status != 0 {
+		return &ExitError{Status: status}
+	}
+	return nil
+}
+
+// CombinedOutput runs the command and returns its combined standard output
+// and standard error.
+func (m *Monitor) CombinedOutput(c *exec.Cmd) ([]byte, error) {
+	if c.Stdout != nil || c.Stderr != nil {
+		return nil, errors.New("reaper: stdout or stderr already set")
+	}
+	var b bytes.Buffer
+	c.Stdout = &b
+	c.Stderr = &b
+	err := m.Run(c)
+	return b.Bytes(), err
+}
+
+// WaitPid waits for a child the process adopted as subreaper, such as the
+// init process of a container, returning its exit status. The status of a
+// process that already exited is returned if it was not claimed before.
+func (m *Monitor) WaitPid(pid int) int {
+	m.mu.Lock()
+	ch := m.register(pid)
+	m.mu.Unlock()
+	return <-ch
+}
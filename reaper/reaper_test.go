@@ -0,0 +1,91 @@
+// +build linux
+
+package reaper
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/docker/containerd/sys"
+)
+
+// reap reaps the children of the test until done is closed, standing in for
+// the SIGCHLD handler.
+func reap(t *testing.T, m *Monitor) (done chan struct{}) {
+	done = make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				if _, err := m.Reap(); err != nil {
+					t.Error(err)
+				}
+			}
+		}
+	}()
+	return done
+}
+
+func TestRun(t *testing.T) {
+	m := New()
+	defer close(reap(t, m))
+
+	if err := m.Run(exec.Command("true")); err != nil {
+		t.Fatal(err)
+	}
+	err := m.Run(exec.Command("sh", "-c", "exit 3"))
+	if e, ok := err.(*ExitError); !ok || e.Status != 3 {
+		t.Fatalf("expected exit status 3 but received %v", err)
+	}
+}
+
+func TestCombinedOutput(t *testing.T) {
+	m := New()
+	defer close(reap(t, m))
+
+	out, err := m.CombinedOutput(exec.Command("sh", "-c", "echo out; echo err >&2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "out\nerr\n" {
+		t.Fatalf("unexpected output %q", out)
+	}
+}
+
+func TestWaitPidAfterReap(t *testing.T) {
+	m := New()
+	cmd := exec.Command("sh", "-c", "exit 5")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	// reap the process before anyone waits for it
+	for reaped := false; !reaped; {
+		exits, err := m.Reap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range exits {
+			reaped = reaped || e.Pid == cmd.Process.Pid
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if status := m.WaitPid(cmd.Process.Pid); status != 5 {
+		t.Fatalf("expected exit status 5 but received %d", status)
+	}
+}
+
+func TestUnclaimedBounded(t *testing.T) {
+	m := New()
+	for pid := 1; pid <= maxUnclaimed+10; pid++ {
+		m.notify(sys.Exit{Pid: pid})
+	}
+	if len(m.unclaimed) != maxUnclaimed || len(m.order) != maxUnclaimed {
+		t.Fatalf("expected %d unclaimed statuses but found %d", maxUnclaimed, len(m.unclaimed))
+	}
+	if _, ok := m.unclaimed[1]; ok {
+		t.Fatal("expected the oldest status to be dropped")
+	}
+}
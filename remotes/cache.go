@@ -0,0 +1,427 @@
+package remotes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/log"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+// maxManifestSize bounds the size of manifests accepted from upstream.
+const maxManifestSize = 4 << 20
+
+// Cache serves the read-only portion of the registry v2 API from the local
+// content store, fetching and storing any content missing locally from the
+// upstream registry.
+//
+// Manifests requested by tag are always resolved against upstream so that
+// updated tags are picked up, falling back to the last cached resolution when
+// upstream cannot be reached. Content addressed by digest never changes and is
+// served locally once cached, concurrent misses of a blob waiting for a single
+// fetch from upstream.
+type Cache struct {
+	upstream  *Registry
+	content   *content.ContentStore
+	manifests *images.Store
+
+	mu sync.Mutex
+	// fetches are the blobs being fetched from upstream, each channel closed
+	// once its fetch is done
+	fetches map[digest.Digest]chan struct{}
+}
+
+// NewCache returns a Cache backed by cs. The manifests store records the
+// descriptors of manifests fetched through the cache, keyed by repository
+// and reference.
+func NewCache(upstream *Registry, cs *content.ContentStore, manifests *images.Store) *Cache {
+	return &Cache{
+		upstream:  upstream,
+		content:   cs,
+		manifests: manifests,
+		fetches:   make(map[digest.Digest]chan struct{}),
+	}
+}
+
+func (c *Cache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := log.WithModule(r.Context(), "registry-cache")
+	if r.Method != "GET" && r.Method != "HEAD" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+	if r.URL.Path == "/v2/" || r.URL.Path == "/v2" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	name, kind, ref, ok := parsePath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var err error
+	switch kind {
+	case "manifests":
+		err = c.serveManifest(ctx, w, r, name, ref)
+	case "blobs":
+		err = c.serveBlob(ctx, w, r, name, ref)
+	}
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("path", r.URL.Path).Error("failed to serve request")
+		if errors.Cause(err) == ErrNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadGateway)
+	}
+}
+
+func (c *Cache) serveManifest(ctx context.Context, w http.ResponseWriter, r *http.Request, name, ref string) error {
+	key := manifestKey(name, ref)
+	if dgst, err := digest.Parse(ref); err == nil {
+		if image, err := c.manifests.Get(key); err == nil {
+			if ok, err := c.serveLocal(w, r, image.Target); ok || err != nil {
+				return err
+			}
+		}
+		desc, err := c.fetchManifest(ctx, name, dgst.String())
+		if err != nil {
+			return err
+		}
+		return c.serveContent(w, r, desc)
+	}
+
+	desc, err := c.fetchManifest(ctx, name, ref)
+	if err != nil {
+		image, lerr := c.manifests.Get(key)
+		if lerr != nil {
+			return err
+		}
+		log.G(ctx).WithError(err).WithField("ref", key).Warn("upstream unavailable, serving cached manifest")
+		desc = image.Target
+	}
+	return c.serveContent(w, r, desc)
+}
+
+// Manifests returns the manifests fetched through the cache, for them and the
+// blobs they reference not to be collected.
+func (c *Cache) Manifests() ([]images.Image, error) {
+	return c.manifests.List()
+}
+
+// fetchManifest fetches the manifest from upstream into the content store,
+// recording its descriptor under the tag or digest it was requested by.
+func (c *Cache) fetchManifest(ctx context.Context, name, ref string) (images.Descriptor, error) {
+	// the manifest is not collected before it is recorded
+	release, err := c.content.Hold()
+	if err != nil {
+		return images.Descriptor{}, err
+	}
+	defer release()
+	desc, err := fetchManifest(ctx, c.upstream, c.content, name, ref)
+	if err != nil {
+		return images.Descriptor{}, err
+	}
+	if err := c.manifests.Put(manifestKey(name, desc.Digest.String()), desc); err != nil {
+		return images.Descriptor{}, err
+	}
+	if ref != desc.Digest.String() {
+		if err := c.manifests.Put(manifestKey(name, ref), desc); err != nil {
+			return images.Descriptor{}, err
+		}
+	}
+	return desc, nil
+}
+
+func (c *Cache) serveBlob(ctx context.Context, w http.ResponseWriter, r *http.Request, name, ref string) error {
+	dgst, err := digest.Parse(ref)
+	if err != nil {
+		return errors.Wrapf(ErrNotFound, "invalid digest %q", ref)
+	}
+	desc := images.Descriptor{
+		MediaType: "application/octet-stream",
+		Digest:    dgst,
+	}
+	for {
+		ok, err := c.reachable(name, dgst)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if ok, err := c.serveLocal(w, r, desc); ok || err != nil {
+				return err
+			}
+		}
+		c.mu.Lock()
+		done, ok := c.fetches[dgst]
+		if !ok {
+			done = make(chan struct{})
+			c.fetches[dgst] = done
+			c.mu.Unlock()
+			defer func() {
+				c.mu.Lock()
+				delete(c.fetches, dgst)
+				c.mu.Unlock()
+				close(done)
+			}()
+			return c.fetchBlob(ctx, w, r, name, desc)
+		}
+		c.mu.Unlock()
+		// the blob is served locally once fetched, or fetched again if the
+		// fetch failed
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// fetchBlob serves the blob desc from upstream, storing it in the content
+// store as it is served.
+func (c *Cache) fetchBlob(ctx context.Context, w http.ResponseWriter, r *http.Request, name string, desc images.Descriptor) error {
+	dgst := desc.Digest
+	rc, size, err := c.upstream.FetchBlob(ctx, name, dgst)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	w.Header().Set("Content-Type", desc.MediaType)
+	w.Header().Set("Docker-Content-Digest", dgst.String())
+	if size >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	}
+	if r.Method == "HEAD" {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+	if _, err := c.content.GetPath(dgst); err == nil {
+		// the blob is stored for another repository, upstream decides
+		// whether this one has it
+		_, err := io.Copy(w, rc)
+		return errors.Wrap(err, "failed copying blob to client")
+	}
+
+	// the blob is not collected while it is ingested, the manifests
+	// referencing it keeping it once committed
+	release, err := c.content.Hold()
+	if err != nil {
+		return err
+	}
+	defer release()
+	cw, offset, err := c.beginIngest(dgst)
+	if err != nil {
+		log.G(ctx).WithError(err).WithField("digest", dgst).Warn("blob ingest in progress, serving uncached")
+		_, err := io.Copy(w, rc)
+		return errors.Wrap(err, "failed copying blob to client")
+	}
+	defer cw.Close()
+
+	// the interrupted fetch being resumed ingested the start of the blob
+	n, err := io.Copy(io.MultiWriter(w, &skipWriter{w: cw, n: offset}), rc)
+	if err != nil {
+		// headers have already been sent, the client will see a short read.
+		log.G(ctx).WithError(err).WithField("digest", dgst).Error("failed copying blob from upstream")
+		return nil
+	}
+	if err := cw.Commit(n, dgst); err != nil {
+		log.G(ctx).WithError(err).WithField("digest", dgst).Error("failed to commit blob to content store")
+		// the ingest does not match the blob, the next fetch starts over
+		cw.Close()
+		if err := c.content.Abort(dgst.Hex()); err != nil {
+			log.G(ctx).WithError(err).WithField("digest", dgst).Error("failed to remove blob ingest")
+		}
+	}
+	return nil
+}
+
+// beginIngest starts the ingest of the blob dgst, resuming the one left by an
+// interrupted fetch, and returns the size it already ingested.
+func (c *Cache) beginIngest(dgst digest.Digest) (*content.ContentWriter, int64, error) {
+	cw, err := c.content.Begin(dgst.Hex())
+	if err == nil || !os.IsExist(err) {
+		return cw, 0, err
+	}
+	cw, err = c.content.Resume(dgst.Hex())
+	if err != nil {
+		return nil, 0, err
+	}
+	st, err := c.content.Stat(dgst.Hex())
+	if err != nil {
+		cw.Close()
+		return nil, 0, err
+	}
+	return cw, st.Size, nil
+}
+
+// skipWriter discards the first n bytes written to w.
+type skipWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (s *skipWriter) Write(p []byte) (int, error) {
+	if s.n >= int64(len(p)) {
+		s.n -= int64(len(p))
+		return len(p), nil
+	}
+	skipped := s.n
+	s.n = 0
+	n, err := s.w.Write(p[skipped:])
+	return int(skipped) + n, err
+}
+
+// reachable returns whether the blob dgst is referenced by a manifest fetched
+// through the cache for the repository name. The blobs of the content store
+// are only served to the clients of the repositories they belong to, the
+// store holding those of the daemon as well.
+func (c *Cache) reachable(name string, dgst digest.Digest) (bool, error) {
+	ms, err := c.manifests.List()
+	if err != nil {
+		return false, err
+	}
+	var queue []images.Descriptor
+	for _, m := range ms {
+		if strings.HasPrefix(m.Name, name+":") || strings.HasPrefix(m.Name, name+"@") {
+			queue = append(queue, m.Target)
+		}
+	}
+	seen := make(map[digest.Digest]bool)
+	for len(queue) > 0 {
+		desc := queue[0]
+		queue = queue[1:]
+		if desc.Digest == dgst {
+			return true, nil
+		}
+		if seen[desc.Digest] {
+			continue
+		}
+		seen[desc.Digest] = true
+		children, err := images.Children(c.content, desc)
+		if err != nil {
+			// the manifests of an index not fetched reference nothing
+			continue
+		}
+		queue = append(queue, children...)
+	}
+	return false, nil
+}
+
+// serveLocal serves desc from the content store, returning false if it is
+// not present.
+func (c *Cache) serveLocal(w http.ResponseWriter, r *http.Request, desc images.Descriptor) (bool, error) {
+	if _, err := c.content.GetPath(desc.Digest); err != nil {
+		if errors.Cause(err) == content.ErrBlobNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, c.serveContent(w, r, desc)
+}
+
+func (c *Cache) serveContent(w http.ResponseWriter, r *http.Request, desc images.Descriptor) error {
+	path, err := c.content.GetPath(desc.Digest)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", desc.MediaType)
+	w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+	w.Header().Set("Etag", `"`+desc.Digest.String()+`"`)
+	http.ServeContent(w, r, "", fi.ModTime(), f)
+	return nil
+}
+
+// parsePath splits a registry API path of the form
+// /v2/<name>/(manifests|blobs)/<reference>.
+func parsePath(path string) (name, kind, ref string, ok bool) {
+	if !strings.HasPrefix(path, "/v2/") {
+		return "", "", "", false
+	}
+	path = strings.TrimPrefix(path, "/v2/")
+	for _, kind := range []string{"manifests", "blobs"} {
+		i := strings.LastIndex(path, "/"+kind+"/")
+		if i <= 0 {
+			continue
+		}
+		name, ref := path[:i], path[i+len(kind)+2:]
+		if ref == "" || strings.Contains(ref, "/") {
+			return "", "", "", false
+		}
+		return name, kind, ref, true
+	}
+	return "", "", "", false
+}
+
+func manifestKey(name, ref string) string {
+	if strings.Contains(ref, ":") {
+		return name + "@" + ref
+	}
+	return name + ":" + ref
+}
+
+// fetchManifest fetches the manifest ref of the repository name into the
+// content store.
+func fetchManifest(ctx context.Context, registry *Registry, cs *content.ContentStore, name, ref string) (images.Descriptor, error) {
+	rc, desc, err := registry.FetchManifest(ctx, name, ref)
+	if err != nil {
+		return images.Descriptor{}, err
+	}
+	defer rc.Close()
+	p, err := ioutil.ReadAll(io.LimitReader(rc, maxManifestSize+1))
+	if err != nil {
+		return images.Descriptor{}, err
+	}
+	if len(p) > maxManifestSize {
+		return images.Descriptor{}, errors.Errorf("manifest %s:%s exceeds maximum size", name, ref)
+	}
+	desc.Size = int64(len(p))
+	if desc.MediaType == "" || desc.MediaType == "application/json" {
+		desc.MediaType = detectManifestType(p)
+	}
+	if err := content.WriteBlob(cs, bytes.NewReader(p), desc.Size, desc.Digest); err != nil {
+		if _, serr := cs.GetPath(desc.Digest); serr != nil {
+			return images.Descriptor{}, errors.Wrapf(err, "failed to store manifest %s:%s", name, ref)
+		}
+	}
+	return desc, nil
+}
+
+// detectManifestType guesses the media type of a manifest served without
+// one, as is allowed for OCI content.
+func detectManifestType(p []byte) string {
+	var m struct {
+		MediaType string          `json:"mediaType"`
+		Manifests json.RawMessage `json:"manifests"`
+	}
+	if err := json.Unmarshal(p, &m); err != nil {
+		return images.MediaTypeOCIManifest
+	}
+	if m.MediaType != "" {
+		return m.MediaType
+	}
+	if m.Manifests != nil {
+		return images.MediaTypeOCIIndex
+	}
+	return images.MediaTypeOCIManifest
+}
@@ -0,0 +1,245 @@
+package remotes
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/images"
+	"github.com/opencontainers/go-digest"
+)
+
+type upstreamEnv struct {
+	manifest []byte
+	blob     []byte
+	requests int32
+	down     int32
+}
+
+func (u *upstreamEnv) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&u.requests, 1)
+	if atomic.LoadInt32(&u.down) != 0 {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	switch r.URL.Path {
+	case "/v2/library/app/manifests/latest":
+		w.Header().Set("Content-Type", images.MediaTypeDockerManifest)
+		w.Header().Set("Docker-Content-Digest", digest.FromBytes(u.manifest).String())
+		w.Write(u.manifest)
+	case "/v2/library/app/blobs/" + digest.FromBytes(u.blob).String():
+		w.Write(u.blob)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// testManifest returns a manifest referencing the layer blob.
+func testManifest(t *testing.T, blob []byte) []byte {
+	p, err := json.Marshal(images.Manifest{
+		SchemaVersion: 2,
+		Config:        images.Descriptor{MediaType: images.MediaTypeOCIConfig, Digest: digest.FromString("config")},
+		Layers:        []images.Descriptor{{MediaType: "application/vnd.oci.image.layer.v1.tar", Digest: digest.FromBytes(blob), Size: int64(len(blob))}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func cacheEnv(t *testing.T, upstream http.Handler) (*httptest.Server, *content.ContentStore, func()) {
+	tmpdir, err := ioutil.TempDir("", "remotes-cache-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err := content.OpenContentStore(filepath.Join(tmpdir, "content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifests, err := images.NewStore(filepath.Join(tmpdir, "manifests"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	us := httptest.NewServer(upstream)
+	registry, err := NewRegistry(us.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := httptest.NewServer(NewCache(registry, cs, manifests))
+	return cache, cs, func() {
+		cache.Close()
+		us.Close()
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func get(t *testing.T, url string) (*http.Response, []byte) {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	p, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, p
+}
+
+func TestCache(t *testing.T) {
+	upstream := &upstreamEnv{
+		blob: []byte("layer content"),
+	}
+	upstream.manifest = testManifest(t, upstream.blob)
+	cache, cs, cleanup := cacheEnv(t, upstream)
+	defer cleanup()
+
+	manifestURL := cache.URL + "/v2/library/app/manifests/latest"
+	resp, p := get(t, manifestURL)
+	if resp.StatusCode != http.StatusOK || string(p) != string(upstream.manifest) {
+		t.Fatalf("unexpected manifest response: %v %q", resp.Status, p)
+	}
+	if mt := resp.Header.Get("Content-Type"); mt != images.MediaTypeDockerManifest {
+		t.Fatalf("unexpected media type %q", mt)
+	}
+
+	blobURL := cache.URL + "/v2/library/app/blobs/" + digest.FromBytes(upstream.blob).String()
+	for i := 0; i < 2; i++ {
+		resp, p := get(t, blobURL)
+		if resp.StatusCode != http.StatusOK || string(p) != string(upstream.blob) {
+			t.Fatalf("unexpected blob response: %v %q", resp.Status, p)
+		}
+	}
+	if n := atomic.LoadInt32(&upstream.requests); n != 2 {
+		t.Fatalf("expected the manifest and the blob to be fetched from upstream once, got %d requests", n)
+	}
+	if _, err := cs.GetPath(digest.FromBytes(upstream.blob)); err != nil {
+		t.Fatal(err)
+	}
+
+	// the blobs of a repository are not served to the clients of another
+	resp, _ = get(t, cache.URL+"/v2/library/other/blobs/"+digest.FromBytes(upstream.blob).String())
+	if resp.StatusCode == http.StatusOK {
+		t.Fatal("expected the blob of another repository not to be served")
+	}
+
+	// tags are served from the cache when upstream fails
+	atomic.StoreInt32(&upstream.down, 1)
+	resp, p = get(t, manifestURL)
+	if resp.StatusCode != http.StatusOK || string(p) != string(upstream.manifest) {
+		t.Fatalf("unexpected manifest response with upstream down: %v %q", resp.Status, p)
+	}
+	resp, _ = get(t, cache.URL+"/v2/library/app/manifests/"+digest.FromBytes(upstream.manifest).String())
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected cached manifest by digest, got %v", resp.Status)
+	}
+
+	resp, _ = get(t, cache.URL+"/v2/library/app/blobs/"+digest.FromString("missing").String())
+	if resp.StatusCode == http.StatusOK {
+		t.Fatal("expected missing blob to fail")
+	}
+}
+
+func TestCacheConcurrentMisses(t *testing.T) {
+	upstream := &upstreamEnv{
+		blob: []byte("layer content"),
+	}
+	upstream.manifest = testManifest(t, upstream.blob)
+	release := make(chan struct{})
+	cache, _, cleanup := cacheEnv(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/blobs/") {
+			<-release
+		}
+		upstream.ServeHTTP(w, r)
+	}))
+	defer cleanup()
+	if resp, _ := get(t, cache.URL+"/v2/library/app/manifests/latest"); resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected manifest response: %v", resp.Status)
+	}
+
+	blobURL := cache.URL + "/v2/library/app/blobs/" + digest.FromBytes(upstream.blob).String()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, p := get(t, blobURL)
+			if resp.StatusCode != http.StatusOK || string(p) != string(upstream.blob) {
+				t.Errorf("unexpected blob response: %v %q", resp.Status, p)
+			}
+		}()
+	}
+	// the misses wait for the fetch of the first one
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n := atomic.LoadInt32(&upstream.requests); n != 2 {
+		t.Fatalf("expected the manifest and the blob to be fetched from upstream once, got %d requests", n)
+	}
+}
+
+func TestCacheStaleIngest(t *testing.T) {
+	upstream := &upstreamEnv{
+		blob: []byte("layer content"),
+	}
+	cache, cs, cleanup := cacheEnv(t, upstream)
+	defer cleanup()
+	dgst := digest.FromBytes(upstream.blob)
+	blobURL := cache.URL + "/v2/library/app/blobs/" + dgst.String()
+
+	for _, tc := range []struct {
+		stale string
+		gets  int
+	}{
+		// an interrupted fetch is resumed
+		{stale: "layer", gets: 1},
+		// an ingest not matching the blob is removed, the next fetch
+		// starting over
+		{stale: "other", gets: 2},
+	} {
+		cw, err := cs.Begin(dgst.Hex())
+		if err != nil {
+			t.Fatal(err)
+		}
+		cw.Write([]byte(tc.stale))
+		cw.Close()
+
+		for i := 0; i < tc.gets; i++ {
+			resp, p := get(t, blobURL)
+			if resp.StatusCode != http.StatusOK || string(p) != string(upstream.blob) {
+				t.Fatalf("unexpected blob response: %v %q", resp.Status, p)
+			}
+		}
+		if _, err := cs.GetPath(dgst); err != nil {
+			t.Fatalf("expected the blob to be cached over a stale ingest of %q: %v", tc.stale, err)
+		}
+		if err := cs.Delete(dgst); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/app:pull"`)
+	if scheme != "Bearer" {
+		t.Fatalf("unexpected scheme %q", scheme)
+	}
+	for k, v := range map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/app:pull",
+	} {
+		if params[k] != v {
+			t.Fatalf("unexpected %s: %q != %q", k, params[k], v)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package remotes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// HostConfig configures how a single registry host is contacted.
+type HostConfig struct {
+	// PlainHTTP selects http rather than https for the registry.
+	PlainHTTP bool `json:"plain_http,omitempty" toml:"plain_http"`
+
+	// CAFile is a PEM bundle of certificate authorities trusted in addition
+	// to the system pool.
+	CAFile string `json:"ca_file,omitempty" toml:"ca_file"`
+	// CertFile and KeyFile hold the client certificate presented to the
+	// registry.
+	CertFile string `json:"cert_file,omitempty" toml:"cert_file"`
+	KeyFile  string `json:"key_file,omitempty" toml:"key_file"`
+	// InsecureSkipVerify disables verification of the registry certificate.
+	InsecureSkipVerify bool `json:"insecure_skip_verify,omitempty" toml:"insecure_skip_verify"`
+
+	// HTTPProxy and HTTPSProxy override the proxy used for the registry.
+	// When unset, HTTP_PROXY, HTTPS_PROXY and NO_PROXY are taken from the
+	// environment.
+	HTTPProxy  string `json:"http_proxy,omitempty" toml:"http_proxy"`
+	HTTPSProxy string `json:"https_proxy,omitempty" toml:"https_proxy"`
+
+	// Mirrors are the base urls of the registries content is pulled from
+	// before the registry itself, such as "https://mirror.example.com".
+	// The mirrors are configured by the entries of their own host.
+	Mirrors []string `json:"mirrors,omitempty" toml:"mirrors"`
+}
+
+// NewClient returns an http client applying the TLS and proxy settings in
+// config.
+func NewClient(config HostConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if config.CAFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		p, err := ioutil.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read registry ca file")
+		}
+		if !pool.AppendCertsFromPEM(p) {
+			return nil, errors.Errorf("no certificates found in %s", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.CertFile != "" || config.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load registry client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	proxy, err := proxyFunc(config)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: proxy,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSClientConfig:       tlsConfig,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+	}, nil
+}
+
+func proxyFunc(config HostConfig) (func(*http.Request) (*url.URL, error), error) {
+	if config.HTTPProxy == "" && config.HTTPSProxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	proxies := make(map[string]*url.URL)
+	for scheme, p := range map[string]string{
+		"http":  config.HTTPProxy,
+		"https": config.HTTPSProxy,
+	} {
+		if p == "" {
+			continue
+		}
+		u, err := url.Parse(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s proxy", scheme)
+		}
+		proxies[scheme] = u
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		return proxies[req.URL.Scheme], nil
+	}, nil
+}
+
+// Resolver returns registries for hosts, configured according to the
+// HostConfig registered for each. Hosts without a configuration use https
+// with the system trust and proxy settings.
+type Resolver struct {
+	hosts map[string]HostConfig
+
+	mu         sync.Mutex
+	registries map[string]*Registry
+}
+
+func NewResolver(hosts map[string]HostConfig) *Resolver {
+	if hosts == nil {
+		hosts = make(map[string]HostConfig)
+	}
+	return &Resolver{
+		hosts:      hosts,
+		registries: make(map[string]*Registry),
+	}
+}
+
+// Registry returns the registry for host, such as "registry-1.docker.io" or
+// "localhost:5000".
+func (r *Resolver) Registry(host string) (*Registry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if registry, ok := r.registries[host]; ok {
+		return registry, nil
+	}
+	config := r.hosts[host]
+	client, err := NewClient(config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to configure registry %s", host)
+	}
+	scheme := "https"
+	if config.PlainHTTP {
+		scheme = "http"
+	}
+	registry, err := NewRegistry(scheme+"://"+host, client)
+	if err != nil {
+		return nil, err
+	}
+	mirrors, err := r.mirrors(host)
+	if err != nil {
+		return nil, err
+	}
+	registry.SetMirrors(mirrors)
+	r.registries[host] = registry
+	return registry, nil
+}
+
+// SetHosts replaces the configurations of the hosts. The registries already
+// returned keep their client and credentials, only their mirrors are
+// updated. The mirrors of a registry are left unchanged when those of the
+// new configuration are invalid.
+func (r *Resolver) SetHosts(hosts map[string]HostConfig) error {
+	if hosts == nil {
+		hosts = make(map[string]HostConfig)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.hosts = hosts
+	var errs []string
+	for host, registry := range r.registries {
+		mirrors, err := r.mirrors(host)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		registry.SetMirrors(mirrors)
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// mirrors returns the registries configured as the mirrors of host.
+func (r *Resolver) mirrors(host string) ([]*Registry, error) {
+	var mirrors []*Registry
+	for _, m := range r.hosts[host].Mirrors {
+		u, err := url.Parse(m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid mirror of registry %s", host)
+		}
+		client, err := NewClient(r.hosts[u.Host])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to configure mirror %s", m)
+		}
+		mirror, err := NewRegistry(m, client)
+		if err != nil {
+			return nil, err
+		}
+		mirrors = append(mirrors, mirror)
+	}
+	return mirrors, nil
+}
@@ -0,0 +1,88 @@
+package remotes
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestHostProxy(t *testing.T) {
+	client, err := NewClient(HostConfig{
+		HTTPSProxy: "http://proxy.example.com:3128",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy := client.Transport.(*http.Transport).Proxy
+	for url, expected := range map[string]string{
+		"https://registry.example.com/v2/": "http://proxy.example.com:3128",
+		"http://registry.example.com/v2/":  "",
+	} {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := proxy(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var actual string
+		if u != nil {
+			actual = u.String()
+		}
+		if actual != expected {
+			t.Fatalf("unexpected proxy for %s: %q != %q", url, actual, expected)
+		}
+	}
+}
+
+func TestResolverHostConfig(t *testing.T) {
+	resolver := NewResolver(map[string]HostConfig{
+		"localhost:5000": {PlainHTTP: true},
+		"broken.example": {CAFile: "/nonexistent/ca.pem"},
+	})
+	registry, err := resolver.Registry("localhost:5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if registry.base.Scheme != "http" {
+		t.Fatalf("expected plain http registry, got %s", registry.base.Scheme)
+	}
+	registry, err = resolver.Registry("registry.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if registry.base.Scheme != "https" {
+		t.Fatalf("expected https registry, got %s", registry.base.Scheme)
+	}
+	if _, err := resolver.Registry("broken.example"); err == nil {
+		t.Fatal("expected error for missing ca file")
+	}
+}
+
+func TestResolverSetHosts(t *testing.T) {
+	resolver := NewResolver(nil)
+	registry, err := resolver.Registry("registry.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(registry.mirrors) != 0 {
+		t.Fatalf("expected no mirrors, got %d", len(registry.mirrors))
+	}
+	if err := resolver.SetHosts(map[string]HostConfig{
+		"registry.example.com": {Mirrors: []string{"http://mirror.example.com"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(registry.mirrors) != 1 || registry.mirrors[0].base.Host != "mirror.example.com" {
+		t.Fatalf("expected the mirror to be set, got %v", registry.mirrors)
+	}
+	if err := resolver.SetHosts(map[string]HostConfig{
+		"registry.example.com": {Mirrors: []string{"http://broken.example"}},
+		"broken.example":       {CAFile: "/nonexistent/ca.pem"},
+	}); err == nil {
+		t.Fatal("expected error for missing ca file")
+	}
+	if len(registry.mirrors) != 1 || registry.mirrors[0].base.Host != "mirror.example.com" {
+		t.Fatalf("expected the mirror to be kept, got %v", registry.mirrors)
+	}
+}
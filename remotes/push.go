@@ -0,0 +1,84 @@
+package remotes
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+
+	"github.com/docker/containerd/images"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+// StatBlob returns whether the blob dgst is in the repository name.
+func (r *Registry) StatBlob(ctx context.Context, name string, dgst digest.Digest) (bool, error) {
+	resp, err := r.doRequest(ctx, name, request{
+		method: "HEAD",
+		path:   "blobs/" + dgst.String(),
+		push:   true,
+	})
+	if err != nil {
+		if errors.Cause(err) == ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	resp.Body.Close()
+	return true, nil
+}
+
+// PushBlob uploads the blob desc to the repository name in a single request,
+// its content being read from open.
+func (r *Registry) PushBlob(ctx context.Context, name string, desc images.Descriptor, open func() (io.ReadCloser, error)) error {
+	resp, err := r.doRequest(ctx, name, request{
+		method: "POST",
+		path:   "blobs/uploads/",
+		push:   true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to start the upload of %s", desc.Digest)
+	}
+	resp.Body.Close()
+	location, err := resp.Location()
+	if err != nil {
+		return errors.Wrapf(err, "registry returned no upload location for %s", desc.Digest)
+	}
+	q := location.Query()
+	q.Set("digest", desc.Digest.String())
+	location.RawQuery = q.Encode()
+	resp, err = r.doRequest(ctx, name, request{
+		method:   "PUT",
+		path:     "blobs/uploads/",
+		location: location,
+		headers:  map[string]string{"Content-Type": "application/octet-stream"},
+		body:     open,
+		size:     desc.Size,
+		push:     true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to upload %s", desc.Digest)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// PushManifest puts the manifest desc, of content p, in the repository name
+// under ref.
+func (r *Registry) PushManifest(ctx context.Context, name, ref string, desc images.Descriptor, p []byte) error {
+	resp, err := r.doRequest(ctx, name, request{
+		method:  "PUT",
+		path:    "manifests/" + ref,
+		headers: map[string]string{"Content-Type": desc.MediaType},
+		body: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(p)), nil
+		},
+		size: int64(len(p)),
+		push: true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to push manifest %s:%s", name, ref)
+	}
+	resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,100 @@
+package remotes
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+// dockerHub is the host of the images named without one, whose api is served
+// by dockerHubRegistry.
+const (
+	dockerHub         = "docker.io"
+	dockerHubRegistry = "registry-1.docker.io"
+)
+
+var (
+	namePattern = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	tagPattern  = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+)
+
+// Reference names an image of a registry, such as
+// "docker.io/library/ubuntu:16.04".
+type Reference struct {
+	// Host is the registry of the image, "docker.io" when unset.
+	Host string
+	// Name is the repository of the image in the registry.
+	Name string
+	// Tag is set unless the image is referenced by digest, "latest" when
+	// neither is given.
+	Tag    string
+	Digest digest.Digest
+}
+
+// ParseReference parses an image reference of the docker cli, such as
+// "ubuntu", "localhost:5000/app:v1" or "app@sha256:...", normalized with the
+// defaults of the docker hub.
+func ParseReference(s string) (Reference, error) {
+	var r Reference
+	rest := s
+	if i := strings.Index(rest, "@"); i >= 0 {
+		dgst, err := digest.Parse(rest[i+1:])
+		if err != nil {
+			return Reference{}, errors.Wrapf(err, "invalid reference %q", s)
+		}
+		r.Digest, rest = dgst, rest[:i]
+	}
+	if i := strings.LastIndex(rest, ":"); i >= 0 && !strings.Contains(rest[i+1:], "/") {
+		r.Tag, rest = rest[i+1:], rest[:i]
+		if !tagPattern.MatchString(r.Tag) {
+			return Reference{}, errors.Errorf("invalid tag in reference %q", s)
+		}
+	}
+	if i := strings.Index(rest, "/"); i >= 0 && (strings.ContainsAny(rest[:i], ".:") || rest[:i] == "localhost") {
+		r.Host, rest = rest[:i], rest[i+1:]
+	} else {
+		r.Host = dockerHub
+	}
+	if r.Host == dockerHub && !strings.Contains(rest, "/") {
+		rest = "library/" + rest
+	}
+	if !namePattern.MatchString(rest) {
+		return Reference{}, errors.Errorf("invalid repository name in reference %q", s)
+	}
+	r.Name = rest
+	if r.Tag == "" && r.Digest == "" {
+		r.Tag = "latest"
+	}
+	return r, nil
+}
+
+// String returns the normalized reference, under which the image is stored.
+func (r Reference) String() string {
+	s := r.Host + "/" + r.Name
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest.String()
+	}
+	return s
+}
+
+// Object returns the digest or tag the manifest of the image is requested
+// by.
+func (r Reference) Object() string {
+	if r.Digest != "" {
+		return r.Digest.String()
+	}
+	return r.Tag
+}
+
+// RegistryHost returns the host serving the registry api of the image.
+func (r Reference) RegistryHost() string {
+	if r.Host == dockerHub {
+		return dockerHubRegistry
+	}
+	return r.Host
+}
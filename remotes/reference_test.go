@@ -0,0 +1,31 @@
+package remotes
+
+import "testing"
+
+func TestParseReference(t *testing.T) {
+	dgst := "sha256:" + "ab12ab12ab12ab12ab12ab12ab12ab12ab12ab12ab12ab12ab12ab12ab12ab12"
+	for _, tc := range []struct {
+		in, normalized, host, object string
+	}{
+		{"ubuntu", "docker.io/library/ubuntu:latest", "registry-1.docker.io", "latest"},
+		{"ubuntu:16.04", "docker.io/library/ubuntu:16.04", "registry-1.docker.io", "16.04"},
+		{"user/app", "docker.io/user/app:latest", "registry-1.docker.io", "latest"},
+		{"localhost:5000/app:v1", "localhost:5000/app:v1", "localhost:5000", "v1"},
+		{"localhost/app", "localhost/app:latest", "localhost", "latest"},
+		{"quay.io/org/app@" + dgst, "quay.io/org/app@" + dgst, "quay.io", dgst},
+		{"app:v2@" + dgst, "docker.io/library/app:v2@" + dgst, "registry-1.docker.io", dgst},
+	} {
+		r, err := ParseReference(tc.in)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.in, err)
+		}
+		if r.String() != tc.normalized || r.RegistryHost() != tc.host || r.Object() != tc.object {
+			t.Fatalf("%s: unexpected reference %s on %s for %s", tc.in, r, r.RegistryHost(), r.Object())
+		}
+	}
+	for _, in := range []string{"", "Ubuntu", "app:", "app@sha256:12", "app:-v1", "/app"} {
+		if _, err := ParseReference(in); err == nil {
+			t.Fatalf("expected %q to be invalid", in)
+		}
+	}
+}
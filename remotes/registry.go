@@ -0,0 +1,320 @@
+package remotes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/docker/containerd/images"
+	"github.com/docker/containerd/log"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+var ErrNotFound = errors.New("not found in registry")
+
+// manifestTypes are the media types accepted when fetching manifests.
+var manifestTypes = []string{
+	images.MediaTypeDockerManifest,
+	images.MediaTypeDockerManifestList,
+	images.MediaTypeOCIManifest,
+	images.MediaTypeOCIIndex,
+}
+
+// Registry fetches and pushes content to a single registry host using the
+// docker registry v2 API. Bearer tokens are requested as challenged by the
+// registry, anonymously unless credentials are set, and reused for the
+// repository and actions they were issued for.
+type Registry struct {
+	base   url.URL
+	client *http.Client
+
+	mu sync.Mutex
+	// mirrors are tried in order before the registry by the fetches.
+	mirrors  []*Registry
+	tokens   map[string]string
+	username string
+	secret   string
+}
+
+// NewRegistry returns a Registry for the base url, such as
+// "https://registry-1.docker.io". If client is nil, http.DefaultClient is
+// used.
+func NewRegistry(base string, client *http.Client) (*Registry, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("registry url %q must include scheme and host", base)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Registry{
+		base:   *u,
+		client: client,
+		tokens: make(map[string]string),
+	}, nil
+}
+
+// SetCredentials sets the credentials presented to the registry and its token
+// service, the secret being a password or an identity token.
+func (r *Registry) SetCredentials(username, secret string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.username, r.secret = username, secret
+	r.tokens = make(map[string]string)
+}
+
+// SetMirrors replaces the registries tried before this one by the fetches.
+func (r *Registry) SetMirrors(mirrors []*Registry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mirrors = mirrors
+}
+
+// FetchManifest returns the manifest for ref, which may be either a tag or a
+// digest, in repository name. The returned descriptor carries the media type
+// and digest reported by the registry.
+func (r *Registry) FetchManifest(ctx context.Context, name, ref string) (io.ReadCloser, images.Descriptor, error) {
+	resp, err := r.do(ctx, name, "manifests/"+ref, map[string]string{
+		"Accept": strings.Join(manifestTypes, ", "),
+	})
+	if err != nil {
+		return nil, images.Descriptor{}, err
+	}
+	desc := images.Descriptor{
+		MediaType: resp.Header.Get("Content-Type"),
+		Digest:    digest.Digest(resp.Header.Get("Docker-Content-Digest")),
+		Size:      resp.ContentLength,
+	}
+	if dgst, err := digest.Parse(ref); err == nil {
+		desc.Digest = dgst
+	}
+	if err := desc.Digest.Validate(); err != nil {
+		resp.Body.Close()
+		return nil, images.Descriptor{}, errors.Wrapf(err, "registry returned invalid digest for %s:%s", name, ref)
+	}
+	return resp.Body, desc, nil
+}
+
+// FetchBlob returns the blob identified by dgst in repository name, along
+// with its size.
+func (r *Registry) FetchBlob(ctx context.Context, name string, dgst digest.Digest) (io.ReadCloser, int64, error) {
+	resp, err := r.do(ctx, name, "blobs/"+dgst.String(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// request is a request of the registry api for a repository.
+type request struct {
+	method string
+	// path is relative to the api of the repository, unless location is
+	// set.
+	path     string
+	location *url.URL
+	headers  map[string]string
+	// body returns the body of the request, for each attempt.
+	body func() (io.ReadCloser, error)
+	size int64
+	// push requests are authorized to push to the repository.
+	push bool
+}
+
+func (r *Registry) do(ctx context.Context, name, path string, headers map[string]string) (*http.Response, error) {
+	r.mu.Lock()
+	mirrors := r.mirrors
+	r.mu.Unlock()
+	for _, m := range mirrors {
+		resp, err := m.do(ctx, name, path, headers)
+		if err == nil {
+			return resp, nil
+		}
+		log.G(ctx).WithError(err).WithField("mirror", m.base.String()).Debug("falling back from registry mirror")
+	}
+	return r.doRequest(ctx, name, request{method: "GET", path: path, headers: headers})
+}
+
+func (r *Registry) doRequest(ctx context.Context, name string, rq request) (*http.Response, error) {
+	u := r.base
+	u.Path = fmt.Sprintf("/v2/%s/%s", name, rq.path)
+	if rq.location != nil {
+		u = *rq.location
+	}
+	action := "pull"
+	if rq.push {
+		action = "pull,push"
+	}
+
+	for retried := false; ; retried = true {
+		var body io.ReadCloser
+		if rq.body != nil {
+			var err error
+			if body, err = rq.body(); err != nil {
+				return nil, err
+			}
+		}
+		req, err := http.NewRequest(rq.method, u.String(), body)
+		if err != nil {
+			if body != nil {
+				body.Close()
+			}
+			return nil, err
+		}
+		if body != nil {
+			req.ContentLength = rq.size
+		}
+		for k, v := range rq.headers {
+			req.Header.Set(k, v)
+		}
+		if auth := r.authorization(name, action); auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		resp, err := r.client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case resp.StatusCode >= 200 && resp.StatusCode < 300:
+			return resp, nil
+		case resp.StatusCode == http.StatusUnauthorized && !retried:
+			challenge := resp.Header.Get("WWW-Authenticate")
+			resp.Body.Close()
+			if err := r.authorize(ctx, name, action, challenge); err != nil {
+				return nil, err
+			}
+			continue
+		case resp.StatusCode == http.StatusNotFound:
+			resp.Body.Close()
+			return nil, errors.Wrapf(ErrNotFound, "%s/%s", name, rq.path)
+		}
+		resp.Body.Close()
+		return nil, errors.Errorf("unexpected status from registry for %s %s/%s: %s", rq.method, name, rq.path, resp.Status)
+	}
+}
+
+func (r *Registry) authorization(name, action string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.tokens[name+":"+action]
+}
+
+// authorize requests a token from the realm named in a bearer challenge, or
+// answers a basic challenge with the credentials of the registry.
+func (r *Registry) authorize(ctx context.Context, name, action, challenge string) error {
+	r.mu.Lock()
+	username, secret := r.username, r.secret
+	r.mu.Unlock()
+	scheme, params := parseChallenge(challenge)
+	if strings.EqualFold(scheme, "basic") {
+		if username == "" {
+			return errors.Errorf("registry %s requires credentials", r.base.Host)
+		}
+		req := http.Request{Header: make(http.Header)}
+		req.SetBasicAuth(username, secret)
+		r.setAuthorization(name, action, req.Header.Get("Authorization"))
+		return nil
+	}
+	if !strings.EqualFold(scheme, "bearer") || params["realm"] == "" {
+		return errors.Errorf("unsupported authentication challenge %q", challenge)
+	}
+	u, err := url.Parse(params["realm"])
+	if err != nil {
+		return err
+	}
+	q := u.Query()
+	if service := params["service"]; service != "" {
+		q.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:%s", name, action)
+	}
+	q.Set("scope", scope)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	if username != "" {
+		req.SetBasicAuth(username, secret)
+	}
+	resp, err := r.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to fetch token from %s: %s", u.Host, resp.Status)
+	}
+	var tr struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return errors.Wrap(err, "failed to decode token response")
+	}
+	token := tr.Token
+	if token == "" {
+		token = tr.AccessToken
+	}
+	if token == "" {
+		return errors.Errorf("no token returned from %s", u.Host)
+	}
+	r.setAuthorization(name, action, "Bearer "+token)
+	return nil
+}
+
+func (r *Registry) setAuthorization(name, action, auth string) {
+	r.mu.Lock()
+	r.tokens[name+":"+action] = auth
+	r.mu.Unlock()
+}
+
+// parseChallenge splits a WWW-Authenticate header into its scheme and
+// parameters.
+func parseChallenge(header string) (string, map[string]string) {
+	params := make(map[string]string)
+	parts := strings.SplitN(strings.TrimSpace(header), " ", 2)
+	if len(parts) != 2 {
+		return parts[0], params
+	}
+	rest := parts[1]
+	for rest != "" {
+		i := strings.Index(rest, "=")
+		if i < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:i]))
+		rest = strings.TrimSpace(rest[i+1:])
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				value, rest = rest[1:], ""
+			} else {
+				value, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else {
+			end := strings.Index(rest, ",")
+			if end < 0 {
+				value, rest = rest, ""
+			} else {
+				value, rest = rest[:end], rest[end:]
+			}
+		}
+		params[key] = value
+		rest = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+		rest = strings.TrimSpace(rest)
+	}
+	return parts[0], params
+}
@@ -0,0 +1,186 @@
+package remotes
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/images"
+	"github.com/pkg/errors"
+)
+
+// Progress is called as the blobs of an image are transferred, with the
+// bytes of desc transferred so far. It is called with the size of the blob
+// once it is done, also when the blob was already at its destination, and may
+// be called concurrently for different blobs.
+type Progress func(desc images.Descriptor, done int64)
+
+// maxConcurrentTransfers bounds the blobs of an image transferred at once.
+const maxConcurrentTransfers = 3
+
+// Fetch fetches the image ref of the repository name from the registry into
+// the content store, returning its descriptor. Only the manifest of platform
+// is fetched when ref is an index, along with the index itself.
+func Fetch(ctx context.Context, registry *Registry, cs *content.ContentStore, name, ref string, platform images.Platform, progress Progress) (images.Descriptor, error) {
+	target, err := fetchManifest(ctx, registry, cs, name, ref)
+	if err != nil {
+		return images.Descriptor{}, err
+	}
+	progress(target, target.Size)
+	desc, err := images.SelectManifest(cs, target, platform)
+	if err != nil {
+		return images.Descriptor{}, err
+	}
+	if desc.Digest != target.Digest {
+		if desc, err = fetchManifest(ctx, registry, cs, name, desc.Digest.String()); err != nil {
+			return images.Descriptor{}, err
+		}
+		progress(desc, desc.Size)
+	}
+	manifest, err := readManifest(cs, desc)
+	if err != nil {
+		return images.Descriptor{}, err
+	}
+	err = transfer(append([]images.Descriptor{manifest.Config}, manifest.Layers...), func(blob images.Descriptor) error {
+		if _, err := cs.GetPath(blob.Digest); err == nil {
+			progress(blob, blob.Size)
+			return nil
+		}
+		rc, _, err := registry.FetchBlob(ctx, name, blob.Digest)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		if err := content.WriteBlob(cs, &progressReader{r: rc, desc: blob, progress: progress}, blob.Size, blob.Digest); err != nil {
+			return errors.Wrapf(err, "failed to fetch %s", blob.Digest)
+		}
+		return nil
+	})
+	if err != nil {
+		return images.Descriptor{}, err
+	}
+	return target, nil
+}
+
+// Push pushes the image target from the content store to the repository name
+// under ref. Only the manifest of platform is pushed when target is an index,
+// as the manifests of the other platforms are not fetched.
+func Push(ctx context.Context, registry *Registry, cs *content.ContentStore, name, ref string, target images.Descriptor, platform images.Platform, progress Progress) error {
+	desc, err := images.SelectManifest(cs, target, platform)
+	if err != nil {
+		return err
+	}
+	manifest, err := readManifest(cs, desc)
+	if err != nil {
+		return err
+	}
+	err = transfer(append([]images.Descriptor{manifest.Config}, manifest.Layers...), func(blob images.Descriptor) error {
+		exists, err := registry.StatBlob(ctx, name, blob.Digest)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			err = registry.PushBlob(ctx, name, blob, func() (io.ReadCloser, error) {
+				rc, err := content.OpenBlob(cs, blob.Digest)
+				if err != nil {
+					return nil, err
+				}
+				return &progressReader{r: rc, c: rc, desc: blob, progress: progress}, nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		progress(blob, blob.Size)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	p, err := readBlob(cs, desc)
+	if err != nil {
+		return err
+	}
+	if err := registry.PushManifest(ctx, name, ref, desc, p); err != nil {
+		return err
+	}
+	progress(desc, desc.Size)
+	return nil
+}
+
+// transfer calls fn for each blob, with at most maxConcurrentTransfers at
+// once, returning the first error.
+func transfer(blobs []images.Descriptor, fn func(images.Descriptor) error) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		ferr error
+		sem  = make(chan struct{}, maxConcurrentTransfers)
+	)
+	for _, blob := range blobs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(blob images.Descriptor) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(blob); err != nil {
+				mu.Lock()
+				if ferr == nil {
+					ferr = err
+				}
+				mu.Unlock()
+			}
+		}(blob)
+	}
+	wg.Wait()
+	return ferr
+}
+
+func readManifest(cs *content.ContentStore, desc images.Descriptor) (images.Manifest, error) {
+	var manifest images.Manifest
+	p, err := readBlob(cs, desc)
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(p, &manifest); err != nil {
+		return manifest, errors.Wrapf(err, "failed to read manifest %v", desc.Digest)
+	}
+	return manifest, nil
+}
+
+func readBlob(cs *content.ContentStore, desc images.Descriptor) ([]byte, error) {
+	rc, err := content.OpenBlob(cs, desc.Digest)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return ioutil.ReadAll(rc)
+}
+
+// progressReader reports the bytes of desc read so far.
+type progressReader struct {
+	r        io.Reader
+	c        io.Closer
+	desc     images.Descriptor
+	progress Progress
+	n        int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	r.progress(r.desc, r.n)
+	return n, err
+}
+
+func (r *progressReader) Close() error {
+	if r.c == nil {
+		return nil
+	}
+	return r.c.Close()
+}
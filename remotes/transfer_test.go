@@ -0,0 +1,204 @@
+package remotes
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/docker/containerd/content"
+	"github.com/docker/containerd/images"
+	"github.com/opencontainers/go-digest"
+)
+
+// memoryRegistry serves the pull and push api of a single repository from
+// memory, requiring basic credentials when user is set.
+type memoryRegistry struct {
+	user, password string
+
+	mu        sync.Mutex
+	blobs     map[digest.Digest][]byte
+	manifests map[string][]byte
+	types     map[string]string
+}
+
+func newMemoryRegistry() *memoryRegistry {
+	return &memoryRegistry{
+		blobs:     make(map[digest.Digest][]byte),
+		manifests: make(map[string][]byte),
+		types:     make(map[string]string),
+	}
+}
+
+func (m *memoryRegistry) putManifest(ref, mediaType string, p []byte) {
+	for _, r := range []string{ref, digest.FromBytes(p).String()} {
+		m.manifests[r] = p
+		m.types[r] = mediaType
+	}
+}
+
+func (m *memoryRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if m.user != "" {
+		if user, password, ok := r.BasicAuth(); !ok || user != m.user || password != m.password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	const prefix = "/v2/library/app/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		http.NotFound(w, r)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, prefix)
+	switch {
+	case path == "blobs/uploads/" && r.Method == "POST":
+		w.Header().Set("Location", prefix+"blobs/uploads/1")
+		w.WriteHeader(http.StatusAccepted)
+	case path == "blobs/uploads/1" && r.Method == "PUT":
+		p, _ := ioutil.ReadAll(r.Body)
+		dgst := digest.Digest(r.URL.Query().Get("digest"))
+		if digest.FromBytes(p) != dgst {
+			http.Error(w, "digest mismatch", http.StatusBadRequest)
+			return
+		}
+		m.blobs[dgst] = p
+		w.WriteHeader(http.StatusCreated)
+	case strings.HasPrefix(path, "blobs/"):
+		p, ok := m.blobs[digest.Digest(strings.TrimPrefix(path, "blobs/"))]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method != "HEAD" {
+			w.Write(p)
+		}
+	case strings.HasPrefix(path, "manifests/") && r.Method == "PUT":
+		p, _ := ioutil.ReadAll(r.Body)
+		m.putManifest(strings.TrimPrefix(path, "manifests/"), r.Header.Get("Content-Type"), p)
+		w.WriteHeader(http.StatusCreated)
+	case strings.HasPrefix(path, "manifests/"):
+		ref := strings.TrimPrefix(path, "manifests/")
+		p, ok := m.manifests[ref]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", m.types[ref])
+		w.Header().Set("Docker-Content-Digest", digest.FromBytes(p).String())
+		w.Write(p)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func marshal(t *testing.T, v interface{}) []byte {
+	p, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func descriptor(mediaType string, p []byte) images.Descriptor {
+	return images.Descriptor{MediaType: mediaType, Digest: digest.FromBytes(p), Size: int64(len(p))}
+}
+
+func TestFetchPush(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "remotes-transfer-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+	cs, err := content.OpenContentStore(filepath.Join(tmpdir, "content"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := newMemoryRegistry()
+	layer := []byte("layer")
+	config := marshal(t, images.Config{RootFS: images.RootFS{Type: "layers", DiffIDs: []digest.Digest{digest.FromBytes(layer)}}})
+	manifest := marshal(t, images.Manifest{
+		SchemaVersion: 2,
+		MediaType:     images.MediaTypeDockerManifest,
+		Config:        descriptor(images.MediaTypeDockerConfig, config),
+		Layers:        []images.Descriptor{descriptor("application/vnd.docker.image.rootfs.diff.tar.gzip", layer)},
+	})
+	// the manifest of another platform is not fetched
+	other := []byte(`{"schemaVersion":2}`)
+	index := marshal(t, images.Index{
+		SchemaVersion: 2,
+		MediaType:     images.MediaTypeDockerManifestList,
+		Manifests: []images.PlatformManifest{
+			{Descriptor: descriptor(images.MediaTypeDockerManifest, other), Platform: images.Platform{OS: "windows", Architecture: "amd64"}},
+			{Descriptor: descriptor(images.MediaTypeDockerManifest, manifest), Platform: images.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}},
+		},
+	})
+	src.blobs[digest.FromBytes(layer)] = layer
+	src.blobs[digest.FromBytes(config)] = config
+	src.putManifest(digest.FromBytes(manifest).String(), images.MediaTypeDockerManifest, manifest)
+	src.putManifest("latest", images.MediaTypeDockerManifestList, index)
+	srcServer := httptest.NewServer(src)
+	defer srcServer.Close()
+
+	var mu sync.Mutex
+	done := make(map[digest.Digest]int64)
+	progress := func(desc images.Descriptor, n int64) {
+		mu.Lock()
+		done[desc.Digest] = n
+		mu.Unlock()
+	}
+	platform := images.Platform{OS: "linux", Architecture: "arm64"}
+	registry, err := NewRegistry(srcServer.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, err := Fetch(context.Background(), registry, cs, "library/app", "latest", platform, progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Digest != digest.FromBytes(index) || target.MediaType != images.MediaTypeDockerManifestList {
+		t.Fatalf("unexpected target %+v", target)
+	}
+	for _, p := range [][]byte{index, manifest, config, layer} {
+		if _, err := cs.GetPath(digest.FromBytes(p)); err != nil {
+			t.Fatalf("expected %s to be fetched: %v", p, err)
+		}
+		if done[digest.FromBytes(p)] != int64(len(p)) {
+			t.Fatalf("expected the progress of %s to be complete, got %d", p, done[digest.FromBytes(p)])
+		}
+	}
+	if _, err := cs.GetPath(digest.FromBytes(other)); err == nil {
+		t.Fatal("expected the manifest of the other platform not to be fetched")
+	}
+
+	dst := newMemoryRegistry()
+	dst.user, dst.password = "user", "secret"
+	dstServer := httptest.NewServer(dst)
+	defer dstServer.Close()
+	registry, err = NewRegistry(dstServer.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Push(context.Background(), registry, cs, "library/app", "v1", target, platform, progress); err == nil {
+		t.Fatal("expected the push without credentials to fail")
+	}
+	registry.SetCredentials("user", "secret")
+	if err := Push(context.Background(), registry, cs, "library/app", "v1", target, platform, progress); err != nil {
+		t.Fatal(err)
+	}
+	if string(dst.manifests["v1"]) != string(manifest) || dst.types["v1"] != images.MediaTypeDockerManifest {
+		t.Fatalf("unexpected pushed manifest %s", dst.manifests["v1"])
+	}
+	if string(dst.blobs[digest.FromBytes(layer)]) != string(layer) || string(dst.blobs[digest.FromBytes(config)]) != string(config) {
+		t.Fatal("expected the blobs to be pushed")
+	}
+}
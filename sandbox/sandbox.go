@@ -0,0 +1,305 @@
+// Package sandbox manages pause processes holding the namespaces shared by
+// the containers of a sandbox, such as a kubernetes pod. A sandbox outlives
+// its containers, and the daemon, until it is deleted.
+package sandbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/containerd/log"
+	"github.com/pkg/errors"
+)
+
+const stateFilename = "state.json"
+
+var (
+	ErrNotFound   = errors.New("sandbox not found")
+	ErrExists     = errors.New("sandbox already exists")
+	ErrInvalidID  = errors.New("invalid sandbox id")
+	ErrNotRunning = errors.New("sandbox is not running")
+)
+
+// validID matches the ids of sandboxes, '+' separating the namespace of
+// the sandboxes of the namespaces of the execution service.
+var validID = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.+-]*$`)
+
+// Namespaces are the namespaces of the pause process, which the containers
+// of the sandbox join.
+var Namespaces = []string{"net", "ipc", "uts"}
+
+// pollInterval is the interval the pause processes of a previous daemon are
+// checked for their exit at.
+var pollInterval = time.Second
+
+// Opts configure the pause process of a sandbox.
+type Opts struct {
+	// Hostname of the sandbox's uts namespace.
+	Hostname string
+}
+
+// Sandbox is a pause process, its namespaces being those of the sandbox.
+type Sandbox struct {
+	ID       string    `json:"id"`
+	Pid      int       `json:"pid"`
+	Hostname string    `json:"hostname,omitempty"`
+	Created  time.Time `json:"created"`
+	// StartTime is the start time of the pause process, telling it apart
+	// from a process that reused its pid.
+	StartTime string `json:"startTime"`
+
+	done chan struct{}
+}
+
+// NamespacePath returns the path of the namespace typ of the sandbox, such as
+// "net".
+func (sb *Sandbox) NamespacePath(typ string) string {
+	return fmt.Sprintf("/proc/%d/ns/%s", sb.Pid, typ)
+}
+
+// Done is closed once the pause process exited.
+func (sb *Sandbox) Done() <-chan struct{} {
+	return sb.done
+}
+
+// Running returns whether the pause process is running.
+func (sb *Sandbox) Running() bool {
+	select {
+	case <-sb.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Store holds a directory per sandbox under its root, recording the pause
+// process of the sandbox along with any state of its users.
+type Store struct {
+	root   string
+	binary string
+
+	mu        sync.Mutex
+	sandboxes map[string]*Sandbox
+}
+
+// NewStore returns the store of the sandboxes under root, whose pause
+// processes run binary with -pause. The sandboxes of a previous daemon are
+// loaded back.
+func NewStore(root, binary string) (*Store, error) {
+	if err := os.MkdirAll(root, 0711); err != nil {
+		return nil, err
+	}
+	s := &Store{
+		root:      root,
+		binary:    binary,
+		sandboxes: make(map[string]*Sandbox),
+	}
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		sb, err := s.load(e.Name())
+		if os.IsNotExist(err) {
+			// the daemon stopped before the pause process of the sandbox
+			// was recorded, it is not known to run
+			log.L.WithField("sandbox", e.Name()).Warn("removing sandbox without state")
+			if err := os.RemoveAll(s.Dir(e.Name())); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load sandbox %q", e.Name())
+		}
+		s.sandboxes[sb.ID] = sb
+	}
+	return s, nil
+}
+
+func (s *Store) load(id string) (*Sandbox, error) {
+	data, err := ioutil.ReadFile(filepath.Join(s.Dir(id), stateFilename))
+	if err != nil {
+		return nil, err
+	}
+	var sb Sandbox
+	if err := json.Unmarshal(data, &sb); err != nil {
+		return nil, err
+	}
+	sb.done = make(chan struct{})
+	go func() {
+		for alive(sb.Pid, sb.StartTime) {
+			time.Sleep(pollInterval)
+		}
+		close(sb.done)
+	}()
+	return &sb, nil
+}
+
+// Create starts the pause process of the sandbox id.
+func (s *Store) Create(id string, o Opts) (*Sandbox, error) {
+	if !validID.MatchString(id) {
+		return nil, errors.Wrapf(ErrInvalidID, "%q", id)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.sandboxes[id]; ok {
+		return nil, errors.Wrapf(ErrExists, "%q", id)
+	}
+	dir := s.Dir(id)
+	if err := os.Mkdir(dir, 0711); err != nil {
+		if os.IsExist(err) {
+			return nil, errors.Wrapf(ErrExists, "%q", id)
+		}
+		return nil, err
+	}
+	args := []string{"-pause"}
+	if o.Hostname != "" {
+		args = append(args, "-hostname", o.Hostname)
+	}
+	attr, err := pauseAttr()
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	cmd := exec.Command(s.binary, args...)
+	cmd.Dir = dir
+	cmd.SysProcAttr = attr
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(dir)
+		return nil, errors.Wrapf(err, "failed to start the pause process of sandbox %q", id)
+	}
+	sb := &Sandbox{
+		ID:       id,
+		Pid:      cmd.Process.Pid,
+		Hostname: o.Hostname,
+		Created:  time.Now().UTC(),
+		done:     make(chan struct{}),
+	}
+	go func() {
+		cmd.Wait()
+		close(sb.done)
+	}()
+	if sb.StartTime, _, err = startTime(sb.Pid); err == nil {
+		err = s.save(sb)
+	}
+	if err != nil {
+		cmd.Process.Kill()
+		<-sb.done
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	s.sandboxes[id] = sb
+	return sb, nil
+}
+
+func (s *Store) save(sb *Sandbox) error {
+	data, err := json.Marshal(sb)
+	if err != nil {
+		return err
+	}
+	// the state is renamed into place for it to be found whole or not at
+	// all once the daemon restarts
+	path := filepath.Join(s.Dir(sb.ID), stateFilename)
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return errors.Wrap(err, "failed to save sandbox to disk")
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return errors.Wrap(err, "failed to save sandbox to disk")
+	}
+	return nil
+}
+
+// Delete stops the pause process of the sandbox id and removes its
+// directory.
+func (s *Store) Delete(id string) error {
+	sb, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+	if sb.Running() {
+		if p, err := os.FindProcess(sb.Pid); err == nil {
+			p.Kill()
+		}
+		select {
+		case <-sb.done:
+		case <-time.After(10 * time.Second):
+			return errors.Errorf("pause process of sandbox %q did not exit", id)
+		}
+	}
+	s.mu.Lock()
+	delete(s.sandboxes, id)
+	s.mu.Unlock()
+	return os.RemoveAll(s.Dir(id))
+}
+
+// Get returns the sandbox id.
+func (s *Store) Get(id string) (*Sandbox, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sb, ok := s.sandboxes[id]
+	if !ok {
+		return nil, errors.Wrapf(ErrNotFound, "%q", id)
+	}
+	return sb, nil
+}
+
+// List returns the sandboxes, sorted by id.
+func (s *Store) List() []*Sandbox {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []*Sandbox
+	for _, sb := range s.sandboxes {
+		out = append(out, sb)
+	}
+	sort.Sort(byID(out))
+	return out
+}
+
+// Dir returns the directory of the sandbox id.
+func (s *Store) Dir(id string) string {
+	return filepath.Join(s.root, id)
+}
+
+// startTime returns the start time of the process pid, in clock ticks since
+// boot, and whether it is a zombie.
+func startTime(pid int) (string, bool, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return "", false, err
+	}
+	// the command may contain spaces and parentheses, the fields follow
+	// the last parenthesis
+	stat := string(data)
+	fields := strings.Fields(stat[strings.LastIndex(stat, ")")+1:])
+	if len(fields) < 20 {
+		return "", false, errors.Errorf("invalid stat of process %d", pid)
+	}
+	return fields[19], fields[0] == "Z", nil
+}
+
+// alive returns whether the process pid started at start is running. A pause
+// process of a previous daemon may have been reparented to the daemon, as a
+// subreaper, and not reaped yet.
+func alive(pid int, start string) bool {
+	s, zombie, err := startTime(pid)
+	return err == nil && s == start && !zombie
+}
+
+type byID []*Sandbox
+
+func (b byID) Len() int           { return len(b) }
+func (b byID) Less(i, j int) bool { return b[i].ID < b[j].ID }
+func (b byID) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
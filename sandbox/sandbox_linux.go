@@ -0,0 +1,12 @@
+package sandbox
+
+import "syscall"
+
+// pauseAttr starts the pause process in namespaces of its own, in a session
+// of its own so that it outlives the daemon.
+func pauseAttr() (*syscall.SysProcAttr, error) {
+	return &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWNET | syscall.CLONE_NEWIPC | syscall.CLONE_NEWUTS,
+		Setsid:     true,
+	}, nil
+}
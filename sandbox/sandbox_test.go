@@ -0,0 +1,103 @@
+package sandbox
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestStore(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("creating namespaces requires root")
+	}
+	root, err := ioutil.TempDir("", "sandbox-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	// the pause process ignores its flags
+	pause := filepath.Join(root, "pause")
+	if err := ioutil.WriteFile(pause, []byte("#!/bin/sh\nexec sleep 60\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewStore(filepath.Join(root, "sandboxes"), pause)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sb, err := s.Create("pod1", Opts{Hostname: "pod1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sb.Running() {
+		t.Fatal("expected the sandbox to be running")
+	}
+	own, err := os.Readlink("/proc/self/ns/net")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, err := os.Readlink(sb.NamespacePath("net"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ns == own {
+		t.Fatal("expected the sandbox to have a network namespace of its own")
+	}
+	if _, err := s.Create("pod1", Opts{}); errors.Cause(err) != ErrExists {
+		t.Fatalf("expected ErrExists, got %v", err)
+	}
+
+	// a restarted daemon finds the running sandbox
+	restored, err := NewStore(filepath.Join(root, "sandboxes"), pause)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsb, err := restored.Get("pod1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsb.Pid != sb.Pid || !rsb.Running() {
+		t.Fatalf("unexpected restored sandbox %+v", rsb)
+	}
+
+	if err := s.Delete("pod1"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-rsb.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the exit of the pause process to be noticed")
+	}
+	if _, err := s.Get("pod1"); errors.Cause(err) != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if len(s.List()) != 0 {
+		t.Fatalf("unexpected sandboxes %v", s.List())
+	}
+}
+
+func TestStoreWithoutState(t *testing.T) {
+	root, err := ioutil.TempDir("", "sandbox-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	if err := os.Mkdir(filepath.Join(root, "pod1"), 0711); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := NewStore(root, "pause")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get("pod1"); errors.Cause(err) != ErrNotFound {
+		t.Fatalf("expected the sandbox without state not to be loaded, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "pod1")); !os.IsNotExist(err) {
+		t.Fatalf("expected the directory of the sandbox to be removed, got %v", err)
+	}
+}
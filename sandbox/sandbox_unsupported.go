@@ -0,0 +1,13 @@
+// +build !linux
+
+package sandbox
+
+import (
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+func pauseAttr() (*syscall.SysProcAttr, error) {
+	return nil, errors.New("sandboxes are only supported on linux")
+}
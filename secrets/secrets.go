@@ -0,0 +1,92 @@
+// Package secrets provides the secrets mounted into containers from the
+// backend configured on the daemon, so that they are neither baked into
+// images nor visible in specs.
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultTimeout bounds the time a plugin backend takes to return a secret.
+const DefaultTimeout = 5 * time.Second
+
+var (
+	ErrNotFound    = errors.New("secret not found")
+	ErrInvalidName = errors.New("invalid secret name")
+)
+
+// Backend returns the data of secrets by name.
+type Backend interface {
+	Get(ctx context.Context, name string) ([]byte, error)
+}
+
+// NewDirBackend returns a backend reading each secret from the file of the
+// same name in dir.
+func NewDirBackend(dir string) Backend {
+	return &dirBackend{dir: dir}
+}
+
+type dirBackend struct {
+	dir string
+}
+
+func (b *dirBackend) Get(ctx context.Context, name string) ([]byte, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(filepath.Join(b.dir, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrapf(ErrNotFound, "%q", name)
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// NewExecBackend returns a backend running the plugin binary with the name
+// of each secret as its argument, the plugin writes the secret on its
+// stdout.
+func NewExecBackend(path string, timeout time.Duration) Backend {
+	return &execBackend{
+		path:    path,
+		timeout: timeout,
+	}
+}
+
+type execBackend struct {
+	path    string
+	timeout time.Duration
+}
+
+func (b *execBackend) Get(ctx context.Context, name string) ([]byte, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, b.path, name)
+	cmd.Stderr = &stderr
+	data, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "secret plugin %s failed: %s", b.path, strings.TrimSpace(stderr.String()))
+	}
+	return data, nil
+}
+
+func validateName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsRune(name, '/') {
+		return errors.Wrapf(ErrInvalidName, "%q", name)
+	}
+	return nil
+}
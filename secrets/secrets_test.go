@@ -0,0 +1,63 @@
+package secrets
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestDirBackend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "token"), []byte("s3cr3t"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	b := NewDirBackend(dir)
+	data, err := b.Get(context.Background(), "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "s3cr3t" {
+		t.Fatalf("unexpected secret %q", data)
+	}
+	if _, err := b.Get(context.Background(), "missing"); errors.Cause(err) != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := b.Get(context.Background(), "../token"); errors.Cause(err) != ErrInvalidName {
+		t.Fatalf("expected ErrInvalidName, got %v", err)
+	}
+}
+
+func TestExecBackend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	plugin := filepath.Join(dir, "plugin")
+	script := "#!/bin/sh\n[ \"$1\" = token ] || { echo \"unknown secret $1\" >&2; exit 1; }\nprintf s3cr3t\n"
+	if err := ioutil.WriteFile(plugin, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	b := NewExecBackend(plugin, time.Second)
+	data, err := b.Get(context.Background(), "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "s3cr3t" {
+		t.Fatalf("unexpected secret %q", data)
+	}
+	if _, err := b.Get(context.Background(), "other"); err == nil {
+		t.Fatal("expected the plugin to fail")
+	}
+}
@@ -0,0 +1,170 @@
+// Package selinux labels containers with SELinux contexts, each container
+// getting a unique MCS level so that containers can not access each other's
+// files.
+package selinux
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultProcessContext is the context of container processes when the
+	// policy does not configure one.
+	DefaultProcessContext = "system_u:system_r:container_t:s0"
+	// DefaultFileContext is the context of container files when the policy
+	// does not configure one.
+	DefaultFileContext = "system_u:object_r:container_file_t:s0"
+
+	// categories is the number of MCS categories levels are allocated from.
+	categories = 1024
+)
+
+// ErrLevelsExhausted is returned when all the MCS levels are allocated.
+var ErrLevelsExhausted = errors.New("selinux: no MCS level left")
+
+// lxcContextsPath is the file the targeted policy configures the contexts of
+// containers in.
+var lxcContextsPath = "/etc/selinux/targeted/contexts/lxc_contexts"
+
+// Labels are the process and mount labels of a container.
+type Labels struct {
+	Process string
+	Mount   string
+}
+
+// Allocator allocates the MCS levels of containers, so that no two
+// containers share one.
+type Allocator struct {
+	mu   sync.Mutex
+	used map[string]bool
+
+	processContext string
+	fileContext    string
+}
+
+// NewAllocator returns an allocator of labels derived from the contexts of
+// the policy, or the default contexts when the policy has none.
+func NewAllocator() *Allocator {
+	process, file := policyContexts()
+	return &Allocator{
+		used:           make(map[string]bool),
+		processContext: process,
+		fileContext:    file,
+	}
+}
+
+// Allocate returns the labels of a new container, with a level not used by
+// other containers.
+func (a *Allocator) Allocate() (Labels, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.used) >= categories*(categories-1)/2 {
+		return Labels{}, ErrLevelsExhausted
+	}
+	for {
+		c1, c2, err := randomCategories()
+		if err != nil {
+			return Labels{}, err
+		}
+		level := fmt.Sprintf("s0:c%d,c%d", c1, c2)
+		if a.used[level] {
+			continue
+		}
+		a.used[level] = true
+		return Labels{
+			Process: withLevel(a.processContext, level),
+			Mount:   withLevel(a.fileContext, level),
+		}, nil
+	}
+}
+
+// Reserve marks the level of label as used, for containers labeled before
+// the allocator was created.
+func (a *Allocator) Reserve(label string) {
+	if level := levelOf(label); level != "" {
+		a.mu.Lock()
+		a.used[level] = true
+		a.mu.Unlock()
+	}
+}
+
+// Release frees the level of label once its container is deleted.
+func (a *Allocator) Release(label string) {
+	if level := levelOf(label); level != "" {
+		a.mu.Lock()
+		delete(a.used, level)
+		a.mu.Unlock()
+	}
+}
+
+// levelOf returns the MCS level of a label of the form
+// user:role:type:level, empty when the label has none.
+func levelOf(label string) string {
+	parts := strings.SplitN(label, ":", 4)
+	if len(parts) < 4 {
+		return ""
+	}
+	return parts[3]
+}
+
+// withLevel replaces the level of the context.
+func withLevel(context, level string) string {
+	parts := strings.SplitN(context, ":", 4)
+	if len(parts) < 3 {
+		return context
+	}
+	return strings.Join(append(parts[:3], level), ":")
+}
+
+// randomCategories returns two distinct categories in increasing order.
+func randomCategories() (int, int, error) {
+	var b [4]byte
+	for {
+		if _, err := rand.Read(b[:]); err != nil {
+			return 0, 0, err
+		}
+		c1 := int(binary.LittleEndian.Uint16(b[:2])) % categories
+		c2 := int(binary.LittleEndian.Uint16(b[2:])) % categories
+		switch {
+		case c1 < c2:
+			return c1, c2, nil
+		case c2 < c1:
+			return c2, c1, nil
+		}
+	}
+}
+
+// policyContexts returns the process and file contexts of containers
+// configured by the policy.
+func policyContexts() (string, string) {
+	process, file := DefaultProcessContext, DefaultFileContext
+	f, err := os.Open(lxcContextsPath)
+	if err != nil {
+		return process, file
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// lines are of the form key = "context"
+		kv := strings.SplitN(s.Text(), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value := strings.Trim(strings.TrimSpace(kv[1]), `"`)
+		switch strings.TrimSpace(kv[0]) {
+		case "process":
+			process = value
+		case "file":
+			file = value
+		}
+	}
+	return process, file
+}
@@ -0,0 +1,36 @@
+package selinux
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const xattrLabel = "security.selinux"
+
+// IsEnforcing returns whether SELinux is enabled and enforcing on the host.
+func IsEnforcing() bool {
+	data, err := ioutil.ReadFile("/sys/fs/selinux/enforce")
+	return err == nil && bytes.HasPrefix(data, []byte("1"))
+}
+
+// Relabel sets the label of path and all the files under it. Symlinks are
+// skipped, as setting their label would set the label of their target.
+func Relabel(path, label string) error {
+	return filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return nil
+		}
+		if err := unix.Setxattr(p, xattrLabel, []byte(label), 0); err != nil {
+			return errors.Wrapf(err, "failed to relabel %s", p)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,66 @@
+package selinux
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAllocator(t *testing.T) {
+	a := &Allocator{
+		used:           make(map[string]bool),
+		processContext: DefaultProcessContext,
+		fileContext:    DefaultFileContext,
+	}
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		l, err := a.Allocate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		level := levelOf(l.Process)
+		if seen[level] {
+			t.Fatalf("level %s allocated twice", level)
+		}
+		seen[level] = true
+		if levelOf(l.Mount) != level {
+			t.Fatalf("expected the labels to share a level, got %+v", l)
+		}
+	}
+
+	a.Reserve("system_u:system_r:container_t:s0:c1,c2")
+	if !a.used["s0:c1,c2"] {
+		t.Fatal("expected the level to be reserved")
+	}
+	a.Release("system_u:system_r:container_t:s0:c1,c2")
+	if a.used["s0:c1,c2"] {
+		t.Fatal("expected the level to be released")
+	}
+}
+
+func TestPolicyContexts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "selinux-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := lxcContextsPath
+	defer func() { lxcContextsPath = path }()
+
+	lxcContextsPath = filepath.Join(dir, "lxc_contexts")
+	if process, file := policyContexts(); process != DefaultProcessContext || file != DefaultFileContext {
+		t.Fatalf("expected the default contexts, got %s and %s", process, file)
+	}
+	data := "process = \"system_u:system_r:svirt_lxc_net_t:s0\"\nfile = \"system_u:object_r:svirt_sandbox_file_t:s0\"\n"
+	if err := ioutil.WriteFile(lxcContextsPath, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	process, file := policyContexts()
+	if process != "system_u:system_r:svirt_lxc_net_t:s0" || file != "system_u:object_r:svirt_sandbox_file_t:s0" {
+		t.Fatalf("unexpected contexts %s and %s", process, file)
+	}
+	if l := withLevel(process, "s0:c1,c2"); l != "system_u:system_r:svirt_lxc_net_t:s0:c1,c2" {
+		t.Fatalf("unexpected label %s", l)
+	}
+}
@@ -0,0 +1,19 @@
+// +build !linux
+
+package selinux
+
+import (
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+// IsEnforcing returns false, SELinux is only available on linux.
+func IsEnforcing() bool {
+	return false
+}
+
+// Relabel fails, SELinux is only available on linux.
+func Relabel(path, label string) error {
+	return errors.Errorf("selinux is not supported on %s", runtime.GOOS)
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/docker/containerd"
+	"github.com/docker/containerd/snapshot"
 	"github.com/stevvooe/go-btrfs"
 )
 
@@ -19,7 +21,9 @@ func NewBtrfs(device, root string) (*Btrfs, error) {
 	return &Btrfs{device: device, root: root}, nil
 }
 
-func (lm *Btrfs) Prepare(key, parent string) ([]containerd.Mount, error) {
+func (lm *Btrfs) Prepare(key, parent string) (_ []containerd.Mount, err error) {
+	defer snapshot.Observe("btrfs", snapshot.OpPrepare, time.Now(), &err)
+
 	active := filepath.Join(lm.root, "active")
 	if err := os.MkdirAll(active, 0755); err != nil {
 		return nil, err
@@ -59,7 +63,9 @@ func (lm *Btrfs) Prepare(key, parent string) ([]containerd.Mount, error) {
 	}, nil
 }
 
-func (lm *Btrfs) Commit(name, key string) error {
+func (lm *Btrfs) Commit(name, key string) (err error) {
+	defer snapshot.Observe("btrfs", snapshot.OpCommit, time.Now(), &err)
+
 	dir := filepath.Join(lm.root, "active", hash(key))
 
 	fmt.Println("commit to", name)
@@ -0,0 +1,48 @@
+package snapshot
+
+import (
+	"time"
+
+	metrics "github.com/docker/go-metrics"
+)
+
+// Operations of the snapshot drivers recorded by Observe.
+const (
+	OpPrepare  = "prepare"
+	OpCommit   = "commit"
+	OpRemove   = "remove"
+	OpRollback = "rollback"
+)
+
+var (
+	snapshotNamespace = metrics.NewNamespace("containerd", "snapshot", nil)
+	operationLatency  = snapshotNamespace.NewLabeledTimer("operation_latency", "The latency of snapshot operations by driver", "driver", "operation")
+	operationFailures = snapshotNamespace.NewLabeledCounter("operation_failures", "The number of failed snapshot operations by driver", "driver", "operation")
+	mountedViews      = snapshotNamespace.NewLabeledGauge("mounted_views", "The number of snapshot views mounted by driver", metrics.Unit("views"), "driver")
+)
+
+func init() {
+	metrics.Register(snapshotNamespace)
+}
+
+// Observe records the latency of an operation of a driver started at start,
+// counting it as failed when *err is set. It is meant to be deferred by
+// operations returning a named error:
+//
+//	defer snapshot.Observe("naive", snapshot.OpPrepare, time.Now(), &err)
+func Observe(driver, op string, start time.Time, err *error) {
+	operationLatency.WithValues(driver, op).UpdateSince(start)
+	if *err != nil {
+		operationFailures.WithValues(driver, op).Inc()
+	}
+}
+
+// ViewMounted records that a view of a snapshot was mounted by driver, or
+// unmounted when mounted is false.
+func ViewMounted(driver string, mounted bool) {
+	if mounted {
+		mountedViews.WithValues(driver).Inc(1)
+	} else {
+		mountedViews.WithValues(driver).Dec(1)
+	}
+}
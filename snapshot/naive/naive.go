@@ -5,8 +5,10 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/docker/containerd"
+	"github.com/docker/containerd/snapshot"
 	"github.com/docker/docker/pkg/archive"
 	"github.com/pkg/errors"
 )
@@ -43,7 +45,9 @@ func NewNaive(root string) (*Naive, error) {
 //
 // For the naive driver, the data is checked out directly into dst and no
 // mounts are returned.
-func (lm *Naive) Prepare(dst, parent string) ([]containerd.Mount, error) {
+func (lm *Naive) Prepare(dst, parent string) (_ []containerd.Mount, err error) {
+	defer snapshot.Observe("naive", snapshot.OpPrepare, time.Now(), &err)
+
 	metadataRoot, err := ioutil.TempDir(lm.root, "active-")
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to created transaction dir")
@@ -80,7 +84,9 @@ func (lm *Naive) Prepare(dst, parent string) ([]containerd.Mount, error) {
 }
 
 // Commit just moves the metadata directory to the diff location.
-func (lm *Naive) Commit(diff, dst string) error {
+func (lm *Naive) Commit(diff, dst string) (err error) {
+	defer snapshot.Observe("naive", snapshot.OpCommit, time.Now(), &err)
+
 	active, ok := lm.active[dst]
 	if !ok {
 		return errors.Errorf("%v is not an active transaction", dst)
@@ -102,7 +108,9 @@ func (lm *Naive) Commit(diff, dst string) error {
 	return nil
 }
 
-func (lm *Naive) Rollback(dst string) error {
+func (lm *Naive) Rollback(dst string) (err error) {
+	defer snapshot.Observe("naive", snapshot.OpRollback, time.Now(), &err)
+
 	active, ok := lm.active[dst]
 	if !ok {
 		return fmt.Errorf("%q must be an active snapshot", dst)
@@ -0,0 +1,180 @@
+package overlay
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/docker/containerd"
+	"github.com/docker/containerd/snapshot"
+	"github.com/pkg/errors"
+)
+
+// Info describes a snapshot of the driver as found on disk. Active snapshots
+// are named by the key they were prepared with.
+type Info struct {
+	Name   string `json:"name"`
+	Parent string `json:"parent,omitempty"`
+	Active bool   `json:"active"`
+}
+
+// Usage is the disk usage of the changes of a snapshot, excluding its parents.
+type Usage struct {
+	Size   int64 `json:"size"`
+	Inodes int64 `json:"inodes"`
+}
+
+// List returns the committed and active snapshots found under the root of the
+// driver.
+func (o *Overlayfs) List() ([]Info, error) {
+	var infos []Info
+	snapshots, err := ioutil.ReadDir(filepath.Join(o.root, "snapshots"))
+	if err != nil {
+		return nil, err
+	}
+	for _, fi := range snapshots {
+		info, err := o.info(filepath.Join(o.root, "snapshots", fi.Name()), fi.Name())
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	active, err := ioutil.ReadDir(filepath.Join(o.root, "active"))
+	if err != nil {
+		return nil, err
+	}
+	for _, fi := range active {
+		path := filepath.Join(o.root, "active", fi.Name())
+		// active directories are named by the hash of their key, which was
+		// not recorded by older versions of the driver
+		name := fi.Name()
+		if key, err := ioutil.ReadFile(filepath.Join(path, "key")); err == nil {
+			name = string(key)
+		}
+		info, err := o.info(path, name)
+		if err != nil {
+			return nil, err
+		}
+		info.Active = true
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (o *Overlayfs) info(path, name string) (Info, error) {
+	info := Info{Name: name}
+	parent, err := o.cache.get(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return info, nil
+		}
+		return info, err
+	}
+	if info.Parent, err = filepath.Rel(filepath.Join(o.root, "snapshots"), parent); err != nil {
+		return info, err
+	}
+	return info, nil
+}
+
+// Usage returns the disk usage of the changes of the snapshot name, committed
+// or active.
+func (o *Overlayfs) Usage(name string) (Usage, error) {
+	var usage Usage
+	path, err := o.path(name)
+	if err != nil {
+		return usage, err
+	}
+	err = filepath.Walk(filepath.Join(path, "fs"), func(_ string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		usage.Inodes++
+		if fi.Mode().IsRegular() {
+			usage.Size += fi.Size()
+		}
+		return nil
+	})
+	return usage, err
+}
+
+// Remove removes the snapshot name, committed or active. Snapshots which are
+// the parent of another one cannot be removed.
+func (o *Overlayfs) Remove(name string) (err error) {
+	defer snapshot.Observe("overlay", snapshot.OpRemove, time.Now(), &err)
+
+	path, err := o.path(name)
+	if err != nil {
+		return err
+	}
+	if path == filepath.Join(o.root, "snapshots", name) {
+		infos, err := o.List()
+		if err != nil {
+			return err
+		}
+		for _, info := range infos {
+			if info.Parent == name {
+				return errors.Errorf("snapshot %s is the parent of %s", name, info.Name)
+			}
+		}
+	} else if err := o.releaseView(path); err != nil {
+		return err
+	}
+	o.cache.mu.Lock()
+	delete(o.cache.parents, path)
+	o.cache.mu.Unlock()
+	return os.RemoveAll(path)
+}
+
+// Mounts returns the mounts of the active snapshot key, as returned by the
+// Prepare call which created it.
+func (o *Overlayfs) Mounts(key string) ([]containerd.Mount, error) {
+	active := o.getActive(key)
+	if _, err := os.Stat(active.path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Errorf("%s is not an active snapshot", key)
+		}
+		return nil, err
+	}
+	return active.mounts(o.cache)
+}
+
+// Upper returns the directory of the changes of the active snapshot key, the
+// upper directory of its overlay with the whiteouts of overlay.
+func (o *Overlayfs) Upper(key string) (string, error) {
+	active := o.getActive(key)
+	if _, err := os.Stat(active.path); err != nil {
+		if os.IsNotExist(err) {
+			return "", errors.Errorf("%s is not an active snapshot", key)
+		}
+		return "", err
+	}
+	return filepath.Join(active.path, "fs"), nil
+}
+
+// Committed reports whether the snapshot name is committed.
+func (o *Overlayfs) Committed(name string) bool {
+	if validName(name) != nil {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(o.root, "snapshots", name))
+	return err == nil
+}
+
+// path returns the directory of the snapshot name, looking up the committed
+// snapshots before the active ones. Names which cannot be committed are only
+// looked up as active keys, for them not to resolve outside of the root.
+func (o *Overlayfs) path(name string) (string, error) {
+	paths := []string{o.getActive(name).path}
+	if validName(name) == nil {
+		paths = append([]string{filepath.Join(o.root, "snapshots", name)}, paths...)
+	}
+	for _, path := range paths {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return "", errors.Errorf("snapshot %s does not exist", name)
+}
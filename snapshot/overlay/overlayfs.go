@@ -4,12 +4,16 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/docker/containerd"
+	"github.com/docker/containerd/snapshot"
+	"github.com/pkg/errors"
 )
 
 func NewOverlayfs(root string) (*Overlayfs, error) {
@@ -19,23 +23,31 @@ func NewOverlayfs(root string) (*Overlayfs, error) {
 	for _, p := range []string{
 		"snapshots",
 		"active",
+		"views",
 	} {
 		if err := os.MkdirAll(filepath.Join(root, p), 0700); err != nil {
 			return nil, err
 		}
 	}
-	return &Overlayfs{
+	o := &Overlayfs{
 		root:  root,
 		cache: newCache(),
-	}, nil
+	}
+	if err := o.restoreViews(); err != nil {
+		return nil, err
+	}
+	return o, nil
 }
 
 type Overlayfs struct {
 	root  string
 	cache *cache
+	views views
 }
 
-func (o *Overlayfs) Prepare(key string, parentName string) ([]containerd.Mount, error) {
+func (o *Overlayfs) Prepare(key string, parentName string) (_ []containerd.Mount, err error) {
+	defer snapshot.Observe("overlay", snapshot.OpPrepare, time.Now(), &err)
+
 	if err := validKey(key); err != nil {
 		return nil, err
 	}
@@ -44,15 +56,70 @@ func (o *Overlayfs) Prepare(key string, parentName string) ([]containerd.Mount,
 		return nil, err
 	}
 	if parentName != "" {
+		if err := validName(parentName); err != nil {
+			active.delete()
+			return nil, err
+		}
 		if err := active.setParent(parentName); err != nil {
 			return nil, err
 		}
+		if err := o.stackOnView(active, parentName); err != nil {
+			active.delete()
+			return nil, err
+		}
 	}
 	return active.mounts(o.cache)
 }
 
-func (o *Overlayfs) Commit(name, key string) error {
+// stackOnView stacks the active snapshot on the view of its parent, when the
+// parent has parents of its own, instead of on the layers of the parent.
+func (o *Overlayfs) stackOnView(active *activeDir, parentName string) error {
+	parents, err := o.cache.parentDirs(active.path)
+	if err != nil || len(parents) < 2 {
+		return err
+	}
+	target, err := o.view(parentName, filepath.Base(active.path))
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(active.path, "view"), []byte(target), 0600); err != nil {
+		o.release(parentName, filepath.Base(active.path))
+		return err
+	}
+	return nil
+}
+
+// releaseView releases the view the active snapshot at path is stacked on,
+// if any.
+func (o *Overlayfs) releaseView(path string) error {
+	if _, err := os.Stat(filepath.Join(path, "view")); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	parent, err := os.Readlink(filepath.Join(path, "parent"))
+	if err != nil {
+		return err
+	}
+	if err := o.release(filepath.Base(parent), filepath.Base(path)); err != nil {
+		return err
+	}
+	return os.Remove(filepath.Join(path, "view"))
+}
+
+func (o *Overlayfs) Commit(name, key string) (err error) {
+	defer snapshot.Observe("overlay", snapshot.OpCommit, time.Now(), &err)
+
+	if err := validName(name); err != nil {
+		return err
+	}
 	active := o.getActive(key)
+	// the committed snapshot is stacked on the layers of its parent when it
+	// is prepared upon
+	if err := o.releaseView(active.path); err != nil {
+		return err
+	}
 	return active.commit(name)
 }
 
@@ -74,6 +141,11 @@ func (o *Overlayfs) newActiveDir(key string) (*activeDir, error) {
 			return nil, err
 		}
 	}
+	// the key is recorded for List, the directory is named by its hash
+	if err := ioutil.WriteFile(filepath.Join(path, "key"), []byte(key), 0600); err != nil {
+		a.delete()
+		return nil, err
+	}
 	return a, nil
 }
 
@@ -89,6 +161,15 @@ func validKey(key string) error {
 	return err
 }
 
+// validName returns an error unless name can name a directory of the
+// committed snapshots, which are not hashed like the active ones.
+func validName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsRune(name, filepath.Separator) {
+		return errors.Errorf("invalid snapshot name %q", name)
+	}
+	return nil
+}
+
 func hash(k string) string {
 	h := md5.New()
 	h.Write([]byte(k))
@@ -116,19 +197,15 @@ func (a *activeDir) commit(name string) error {
 }
 
 func (a *activeDir) mounts(c *cache) ([]containerd.Mount, error) {
-	var (
-		parents []string
-		err     error
-		current = a.path
-	)
-	for {
-		if current, err = c.get(current); err != nil {
-			if os.IsNotExist(err) {
-				break
-			}
-			return nil, err
-		}
-		parents = append(parents, filepath.Join(current, "fs"))
+	parents, err := c.parentDirs(a.path)
+	if err != nil {
+		return nil, err
+	}
+	view, err := ioutil.ReadFile(filepath.Join(a.path, "view"))
+	if err == nil {
+		parents = []string{string(view)}
+	} else if !os.IsNotExist(err) {
+		return nil, err
 	}
 	if len(parents) == 0 {
 		// if we only have one layer/no parents then just return a bind mount as overlay
@@ -147,7 +224,7 @@ func (a *activeDir) mounts(c *cache) ([]containerd.Mount, error) {
 	options := []string{
 		fmt.Sprintf("workdir=%s", filepath.Join(a.path, "work")),
 		fmt.Sprintf("upperdir=%s", filepath.Join(a.path, "fs")),
-		fmt.Sprintf("lowerdir=%s", strings.Join(parents, ":")),
+		lowerDirs(parents),
 	}
 	return []containerd.Mount{
 		{
@@ -169,6 +246,24 @@ type cache struct {
 	parents map[string]string
 }
 
+// parentDirs returns the fs directories of the parents of the snapshot at
+// path, from its parent to the base snapshot.
+func (c *cache) parentDirs(path string) ([]string, error) {
+	var (
+		parents []string
+		err     error
+	)
+	for {
+		if path, err = c.get(path); err != nil {
+			if os.IsNotExist(err) {
+				return parents, nil
+			}
+			return nil, err
+		}
+		parents = append(parents, filepath.Join(path, "fs"))
+	}
+}
+
 func (c *cache) get(path string) (string, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -67,10 +67,53 @@ func TestOverlayfsCommit(t *testing.T) {
 		t.Error(err)
 		return
 	}
+	if o.Committed("base") {
+		t.Fatal("expected the snapshot not to be committed before it is")
+	}
 	if err := o.Commit("base", key); err != nil {
 		t.Error(err)
 		return
 	}
+	if !o.Committed("base") || o.Committed(key) {
+		t.Fatal("expected the committed snapshot only to be reported committed")
+	}
+}
+
+func TestOverlayfsInvalidName(t *testing.T) {
+	root, err := ioutil.TempDir("", "overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	o, err := NewOverlayfs(filepath.Join(root, "overlay"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	outside := filepath.Join(root, "outside")
+	if err := os.Mkdir(outside, 0700); err != nil {
+		t.Fatal(err)
+	}
+	key := "/tmp/test"
+	if _, err := o.Prepare(key, ""); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"", ".", "..", "../../outside", "a/b"} {
+		if err := o.Commit(name, key); err == nil {
+			t.Errorf("expected the commit as %q to be refused", name)
+		}
+		if _, err := o.Prepare("/tmp/child", name); name != "" && err == nil {
+			t.Errorf("expected the parent %q to be refused", name)
+		}
+	}
+	if _, err := o.Upper(key); err != nil {
+		t.Fatalf("expected the refused commits to keep the active snapshot: %v", err)
+	}
+	if err := o.Remove("../../outside"); err == nil {
+		t.Error("expected the removal of a path outside of the root to fail")
+	}
+	if _, err := os.Stat(outside); err != nil {
+		t.Errorf("expected the directory outside of the root to be kept: %v", err)
+	}
 }
 
 func TestOverlayfsOverlayMount(t *testing.T) {
@@ -178,3 +221,216 @@ func TestOverlayfsOverlayRead(t *testing.T) {
 		return
 	}
 }
+
+func TestOverlayfsEscapedLowerdir(t *testing.T) {
+	root, err := ioutil.TempDir("", "overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	o, err := NewOverlayfs(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := "/tmp/test"
+	mounts, err := o.Prepare(key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(mounts[0].Source, "foo"), []byte("hi"), 0660); err != nil {
+		t.Fatal(err)
+	}
+	// the layers are committed under their chain id, the colons of which
+	// would separate the lower dirs
+	if err := o.Commit("sha256:base", key); err != nil {
+		t.Fatal(err)
+	}
+	if mounts, err = o.Prepare("/tmp/layer2", "sha256:base"); err != nil {
+		t.Fatal(err)
+	}
+	lower := "lowerdir=" + filepath.Join(root, "snapshots", `sha256\:base`, "fs")
+	if m := mounts[0]; m.Options[2] != lower {
+		t.Fatalf("expected %q but received %q", lower, m.Options[2])
+	}
+	if os.Getuid() != 0 {
+		t.Skip("not running as root")
+	}
+	dest := filepath.Join(root, "dest")
+	if err := os.Mkdir(dest, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := containerd.MountFS(mounts, dest); err != nil {
+		t.Fatal(err)
+	}
+	defer syscall.Unmount(dest, 0)
+	data, err := ioutil.ReadFile(filepath.Join(dest, "foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := string(data); e != "hi" {
+		t.Errorf("expected file contents hi but got %q", e)
+	}
+}
+
+func TestOverlayfsInspect(t *testing.T) {
+	root, err := ioutil.TempDir("", "overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	o, err := NewOverlayfs(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mounts, err := o.Prepare("/tmp/test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(mounts[0].Source, "foo"), []byte("hi"), 0660); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Commit("base", "/tmp/test"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Prepare("/tmp/layer2", "base"); err != nil {
+		t.Fatal(err)
+	}
+	infos, err := o.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Info{
+		{Name: "base"},
+		{Name: "/tmp/layer2", Parent: "base", Active: true},
+	}
+	if len(infos) != len(expected) {
+		t.Fatalf("expected %v but received %v", expected, infos)
+	}
+	for i, info := range infos {
+		if info != expected[i] {
+			t.Errorf("expected %v but received %v", expected[i], info)
+		}
+	}
+	usage, err := o.Usage("base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Size != 2 || usage.Inodes != 2 {
+		t.Errorf("expected a usage of 2 bytes and 2 inodes but received %+v", usage)
+	}
+	mounts, err = o.Mounts("/tmp/layer2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mounts) != 1 || mounts[0].Type != "overlay" {
+		t.Errorf("expected an overlay mount but received %v", mounts)
+	}
+	upper, err := o.Upper("/tmp/layer2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "upperdir=" + upper; len(mounts) == 1 && mounts[0].Options[1] != expected {
+		t.Errorf("expected the option %s but received %v", expected, mounts[0].Options)
+	}
+	if _, err := o.Upper("base"); err == nil {
+		t.Error("expected a committed snapshot to have no upper directory")
+	}
+	if err := o.Remove("base"); err == nil {
+		t.Error("expected the removal of a parent to fail")
+	}
+	if err := o.Remove("/tmp/layer2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Remove("base"); err != nil {
+		t.Fatal(err)
+	}
+	if infos, err = o.List(); err != nil || len(infos) != 0 {
+		t.Errorf("expected no snapshot but received %v, %v", infos, err)
+	}
+}
+
+func TestOverlayfsView(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("not running as root")
+	}
+	root, err := ioutil.TempDir("", "overlay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	o, err := NewOverlayfs(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range []struct{ name, parent, file string }{
+		{"base", "", "foo"},
+		{"layer2", "base", "bar"},
+	} {
+		if _, err := o.Prepare(l.name, l.parent); err != nil {
+			t.Fatal(err)
+		}
+		upper, err := o.Upper(l.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(upper, l.file), []byte("hi"), 0660); err != nil {
+			t.Fatal(err)
+		}
+		if err := o.Commit(l.name, l.name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	view := filepath.Join(o.viewPath("layer2"), "fs")
+	defer syscall.Unmount(view, syscall.MNT_DETACH)
+
+	// the containers of the image are stacked on the same view of its layers
+	for _, key := range []string{"/tmp/c1", "/tmp/c2"} {
+		mounts, err := o.Prepare(key, "layer2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lower := mounts[0].Options[2]; lower != "lowerdir="+view {
+			t.Errorf("expected %s to be stacked on the view, got %s", key, lower)
+		}
+	}
+	for _, file := range []string{"foo", "bar"} {
+		if _, err := os.Stat(filepath.Join(view, file)); err != nil {
+			t.Errorf("expected the view to stack the layers: %v", err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(view, "baz"), nil, 0660); err == nil {
+		t.Error("expected the view to be read only")
+	}
+	mounts, err := o.Mounts("/tmp/c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := filepath.Join(root, "dest")
+	if err := os.Mkdir(dest, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := containerd.MountFS(mounts, dest); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "foo")); err != nil {
+		t.Errorf("expected the container to see the layers of the image: %v", err)
+	}
+	syscall.Unmount(dest, 0)
+
+	// the references survive a restart of the daemon
+	if o, err = NewOverlayfs(root); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Remove("/tmp/c1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(view, "bar")); err != nil {
+		t.Errorf("expected the view to stay mounted for its other reference: %v", err)
+	}
+	if err := o.Commit("layer3", "/tmp/c2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(view); !os.IsNotExist(err) {
+		t.Errorf("expected the view to be unmounted, got %v", err)
+	}
+}
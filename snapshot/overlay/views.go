@@ -0,0 +1,188 @@
+package overlay
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/docker/containerd/snapshot"
+	"github.com/pkg/errors"
+)
+
+// views are the read only mounts of the committed snapshots stacked on their
+// parents, shared by the active snapshots prepared on them: the overlay of an
+// active snapshot has the view of its parent as its single lower directory,
+// for the containers of an image not to mount the same lowerdir stack over
+// and over. Each view is a directory of views/, named by the hash of its
+// snapshot, holding the mount point fs, the name of the snapshot and the
+// references to the view in refs/, a file per active snapshot holding it.
+// The references are kept on disk for the views to stay mounted as long as
+// the active snapshots holding them, across restarts of the daemon.
+type views struct {
+	mu sync.Mutex
+}
+
+// view takes the reference of the active snapshot holder to the view of the
+// committed snapshot name, mounting the view unless it is already mounted,
+// and returns the directory it is mounted on.
+func (o *Overlayfs) view(name, holder string) (string, error) {
+	o.views.mu.Lock()
+	defer o.views.mu.Unlock()
+
+	dir := o.viewPath(name)
+	refs, err := viewRefs(dir)
+	if err != nil {
+		return "", err
+	}
+	target := filepath.Join(dir, "fs")
+	if len(refs) == 0 {
+		if err := o.mountView(name, dir); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "refs", holder), nil, 0600); err != nil {
+		if len(refs) == 0 {
+			o.unmountView(dir)
+		}
+		return "", err
+	}
+	return target, nil
+}
+
+// release releases the reference of the active snapshot holder to the view
+// of the snapshot name, unmounting the view when it was the last one.
+func (o *Overlayfs) release(name, holder string) error {
+	o.views.mu.Lock()
+	defer o.views.mu.Unlock()
+
+	dir := o.viewPath(name)
+	if err := os.Remove(filepath.Join(dir, "refs", holder)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	refs, err := viewRefs(dir)
+	if err != nil || len(refs) > 0 {
+		return err
+	}
+	return o.unmountView(dir)
+}
+
+// mountView mounts the view of the committed snapshot name in dir.
+func (o *Overlayfs) mountView(name, dir string) error {
+	path := filepath.Join(o.root, "snapshots", name)
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return errors.Errorf("%s is not a committed snapshot", name)
+		}
+		return err
+	}
+	parents, err := o.cache.parentDirs(path)
+	if err != nil {
+		return err
+	}
+	target := filepath.Join(dir, "fs")
+	for _, p := range []string{target, filepath.Join(dir, "refs")} {
+		if err := os.MkdirAll(p, 0700); err != nil {
+			return err
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "name"), []byte(name), 0600); err != nil {
+		return err
+	}
+	// a view left mounted by a previous daemon is replaced, the overlays
+	// stacked on it keeping it until they are unmounted
+	if err := unmount(target); err != nil {
+		return err
+	}
+	lower := lowerDirs(append([]string{filepath.Join(path, "fs")}, parents...))
+	if err := mountReadOnly(lower, target); err != nil {
+		return errors.Wrapf(err, "failed to mount the view of %s", name)
+	}
+	snapshot.ViewMounted("overlay", true)
+	return nil
+}
+
+func (o *Overlayfs) unmountView(dir string) error {
+	if err := unmount(filepath.Join(dir, "fs")); err != nil {
+		return err
+	}
+	snapshot.ViewMounted("overlay", false)
+	return os.RemoveAll(dir)
+}
+
+// restoreViews mounts the views referenced by the active snapshots again,
+// after a restart of the daemon or of the host, and removes the others. The
+// references of the active snapshots which no longer exist are dropped.
+func (o *Overlayfs) restoreViews() error {
+	o.views.mu.Lock()
+	defer o.views.mu.Unlock()
+
+	entries, err := ioutil.ReadDir(filepath.Join(o.root, "views"))
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		dir := filepath.Join(o.root, "views", e.Name())
+		refs, err := viewRefs(dir)
+		if err != nil {
+			return err
+		}
+		held := false
+		for _, holder := range refs {
+			if _, err := os.Stat(filepath.Join(o.root, "active", holder)); err == nil {
+				held = true
+			} else if os.IsNotExist(err) {
+				os.Remove(filepath.Join(dir, "refs", holder))
+			} else {
+				return err
+			}
+		}
+		name, err := ioutil.ReadFile(filepath.Join(dir, "name"))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if !held || len(name) == 0 {
+			if err := unmount(filepath.Join(dir, "fs")); err != nil {
+				return err
+			}
+			if err := os.RemoveAll(dir); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := o.mountView(string(name), dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *Overlayfs) viewPath(name string) string {
+	return filepath.Join(o.root, "views", hash(name))
+}
+
+// viewRefs returns the active snapshots holding the view in dir.
+func viewRefs(dir string) ([]string, error) {
+	f, err := os.Open(filepath.Join(dir, "refs"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	return f.Readdirnames(-1)
+}
+
+// lowerDirs returns the lowerdir option of overlay stacking dirs, from the
+// top to the bottom one. The colons of the directories, such as those of the
+// chain ids naming the snapshots, are escaped not to separate them.
+func lowerDirs(dirs []string) string {
+	escaped := make([]string, len(dirs))
+	for i, d := range dirs {
+		escaped[i] = strings.Replace(d, ":", `\:`, -1)
+	}
+	return "lowerdir=" + strings.Join(escaped, ":")
+}
@@ -0,0 +1,18 @@
+package overlay
+
+import "syscall"
+
+// mountReadOnly mounts an overlay of the lower directories of the option
+// lower, without an upper one, on target.
+func mountReadOnly(lower, target string) error {
+	return syscall.Mount("overlay", target, "overlay", syscall.MS_RDONLY, lower)
+}
+
+// unmount lazily unmounts target, the files still open in the view being
+// read until they are closed.
+func unmount(target string) error {
+	if err := syscall.Unmount(target, syscall.MNT_DETACH); err != nil && err != syscall.EINVAL && err != syscall.ENOENT {
+		return err
+	}
+	return nil
+}
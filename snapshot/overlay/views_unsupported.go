@@ -0,0 +1,13 @@
+// +build !linux
+
+package overlay
+
+import "github.com/pkg/errors"
+
+func mountReadOnly(lower, target string) error {
+	return errors.New("overlay is only supported on linux")
+}
+
+func unmount(target string) error {
+	return nil
+}
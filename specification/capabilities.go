@@ -0,0 +1,133 @@
+package specification
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultCapabilities are the capabilities kept by unprivileged containers.
+var DefaultCapabilities = []string{
+	"CAP_CHOWN",
+	"CAP_DAC_OVERRIDE",
+	"CAP_FSETID",
+	"CAP_FOWNER",
+	"CAP_MKNOD",
+	"CAP_NET_RAW",
+	"CAP_SETGID",
+	"CAP_SETUID",
+	"CAP_SETFCAP",
+	"CAP_SETPCAP",
+	"CAP_NET_BIND_SERVICE",
+	"CAP_SYS_CHROOT",
+	"CAP_KILL",
+	"CAP_AUDIT_WRITE",
+}
+
+// allCapabilities are the capabilities known to the kernel.
+var allCapabilities = []string{
+	"CAP_CHOWN",
+	"CAP_DAC_OVERRIDE",
+	"CAP_DAC_READ_SEARCH",
+	"CAP_FOWNER",
+	"CAP_FSETID",
+	"CAP_KILL",
+	"CAP_SETGID",
+	"CAP_SETUID",
+	"CAP_SETPCAP",
+	"CAP_LINUX_IMMUTABLE",
+	"CAP_NET_BIND_SERVICE",
+	"CAP_NET_BROADCAST",
+	"CAP_NET_ADMIN",
+	"CAP_NET_RAW",
+	"CAP_IPC_LOCK",
+	"CAP_IPC_OWNER",
+	"CAP_SYS_MODULE",
+	"CAP_SYS_RAWIO",
+	"CAP_SYS_CHROOT",
+	"CAP_SYS_PTRACE",
+	"CAP_SYS_PACCT",
+	"CAP_SYS_ADMIN",
+	"CAP_SYS_BOOT",
+	"CAP_SYS_NICE",
+	"CAP_SYS_RESOURCE",
+	"CAP_SYS_TIME",
+	"CAP_SYS_TTY_CONFIG",
+	"CAP_MKNOD",
+	"CAP_LEASE",
+	"CAP_AUDIT_WRITE",
+	"CAP_AUDIT_CONTROL",
+	"CAP_SETFCAP",
+	"CAP_MAC_OVERRIDE",
+	"CAP_MAC_ADMIN",
+	"CAP_SYSLOG",
+	"CAP_WAKE_ALARM",
+	"CAP_BLOCK_SUSPEND",
+	"CAP_AUDIT_READ",
+}
+
+// capabilities returns the default capabilities, or all of them for a
+// privileged container, adjusted by the options.
+func capabilities(o SecurityOpts) ([]string, error) {
+	return AdjustCapabilities(DefaultCapabilities, o)
+}
+
+// AdjustCapabilities returns the capabilities caps, or all of them when o is
+// privileged, adjusted by the capabilities added and dropped by o.
+func AdjustCapabilities(caps []string, o SecurityOpts) ([]string, error) {
+	if o.Privileged {
+		caps = allCapabilities
+	}
+	drop := make(map[string]bool)
+	for _, c := range o.CapDrop {
+		if strings.ToUpper(c) == "ALL" {
+			caps = nil
+			continue
+		}
+		name, err := capName(c)
+		if err != nil {
+			return nil, err
+		}
+		drop[name] = true
+	}
+	var out []string
+	seen := make(map[string]bool)
+	add := func(c string) {
+		if !drop[c] && !seen[c] {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+	for _, c := range caps {
+		add(c)
+	}
+	for _, c := range o.CapAdd {
+		if strings.ToUpper(c) == "ALL" {
+			for _, c := range allCapabilities {
+				add(c)
+			}
+			continue
+		}
+		name, err := capName(c)
+		if err != nil {
+			return nil, err
+		}
+		add(name)
+	}
+	return out, nil
+}
+
+// capName returns the canonical name of a capability, accepting names
+// without the CAP_ prefix and in any case.
+func capName(c string) (string, error) {
+	name := strings.ToUpper(c)
+	if !strings.HasPrefix(name, "CAP_") {
+		name = "CAP_" + name
+	}
+	for _, known := range allCapabilities {
+		if known == name {
+			return name, nil
+		}
+	}
+	return "", errors.Errorf("unknown capability %q", c)
+}
@@ -0,0 +1,142 @@
+package specification
+
+import (
+	"github.com/docker/containerd/apparmor"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+var (
+	defaultMaskedPaths = []string{
+		"/proc/kcore",
+		"/proc/latency_stats",
+		"/proc/timer_list",
+		"/proc/timer_stats",
+		"/proc/sched_debug",
+		"/sys/firmware",
+	}
+	defaultReadonlyPaths = []string{
+		"/proc/asound",
+		"/proc/bus",
+		"/proc/fs",
+		"/proc/irq",
+		"/proc/sys",
+		"/proc/sysrq-trigger",
+	}
+)
+
+func defaultMounts() []specs.Mount {
+	return []specs.Mount{
+		{
+			Destination: "/proc",
+			Type:        "proc",
+			Source:      "proc",
+			Options:     []string{"nosuid", "noexec", "nodev"},
+		},
+		{
+			Destination: "/dev",
+			Type:        "tmpfs",
+			Source:      "tmpfs",
+			Options:     []string{"nosuid", "strictatime", "mode=755", "size=65536k"},
+		},
+		{
+			Destination: "/dev/pts",
+			Type:        "devpts",
+			Source:      "devpts",
+			Options:     []string{"nosuid", "noexec", "newinstance", "ptmxmode=0666", "mode=0620", "gid=5"},
+		},
+		{
+			Destination: "/dev/shm",
+			Type:        "tmpfs",
+			Source:      "shm",
+			Options:     []string{"nosuid", "noexec", "nodev", "mode=1777", "size=65536k"},
+		},
+		{
+			Destination: "/dev/mqueue",
+			Type:        "mqueue",
+			Source:      "mqueue",
+			Options:     []string{"nosuid", "noexec", "nodev"},
+		},
+		{
+			Destination: "/sys",
+			Type:        "sysfs",
+			Source:      "sysfs",
+			Options:     []string{"nosuid", "noexec", "nodev", "ro"},
+		},
+		{
+			Destination: "/sys/fs/cgroup",
+			Type:        "cgroup",
+			Source:      "cgroup",
+			Options:     []string{"nosuid", "noexec", "nodev", "relatime", "ro"},
+		},
+	}
+}
+
+// populatePlatform sets the linux defaults of the spec along with the
+// mounts, resources and security options.
+func populatePlatform(s *specs.Spec, o Opts) error {
+	caps, err := capabilities(o.Security)
+	if err != nil {
+		return err
+	}
+	s.Process.Capabilities = caps
+	s.Process.Rlimits = []specs.LinuxRlimit{
+		{
+			Type: "RLIMIT_NOFILE",
+			Hard: 1024,
+			Soft: 1024,
+		},
+	}
+	s.Mounts = mergeMounts(defaultMounts(), o.Mounts)
+
+	devices := []specs.LinuxDeviceCgroup{
+		{
+			Allow:  o.Security.Privileged,
+			Access: &rwm,
+		},
+	}
+	resources := &specs.LinuxResources{}
+	if o.Resources != nil {
+		*resources = *o.Resources
+	}
+	resources.Devices = append(devices, resources.Devices...)
+
+	s.Linux = &specs.Linux{
+		Resources: resources,
+		Namespaces: []specs.LinuxNamespace{
+			{Type: specs.PIDNamespace},
+			{Type: specs.IPCNamespace},
+			{Type: specs.UTSNamespace},
+			{Type: specs.MountNamespace},
+			{Type: specs.NetworkNamespace},
+		},
+	}
+	if !o.Security.Privileged {
+		s.Linux.MaskedPaths = append([]string{}, defaultMaskedPaths...)
+		s.Linux.ReadonlyPaths = append([]string{}, defaultReadonlyPaths...)
+	}
+	if s.Process.ApparmorProfile == "" && !o.Security.Privileged && apparmor.IsEnabled() {
+		s.Process.ApparmorProfile = apparmor.DefaultProfile
+	}
+	if !o.Security.Privileged || o.Security.Seccomp != "" {
+		if s.Linux.Seccomp, err = SeccompProfile(o.Security.Seccomp, caps); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeMounts returns the default mounts with those sharing a destination
+// with one of mounts replaced, followed by the remaining mounts.
+func mergeMounts(defaults, mounts []specs.Mount) []specs.Mount {
+	replaced := make(map[string]bool)
+	for _, m := range mounts {
+		replaced[m.Destination] = true
+	}
+	var out []specs.Mount
+	for _, m := range defaults {
+		if !replaced[m.Destination] {
+			out = append(out, m)
+		}
+	}
+	return append(out, mounts...)
+}
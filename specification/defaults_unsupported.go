@@ -0,0 +1,14 @@
+// +build !linux
+
+package specification
+
+import (
+	"runtime"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+func populatePlatform(s *specs.Spec, o Opts) error {
+	return errors.Errorf("spec generation is not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,156 @@
+package specification
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/docker/containerd/images"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// DefaultPath is the PATH of processes whose environment does not set one.
+const DefaultPath = "PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+
+// Opts are the high level options a spec is generated from. Unset options
+// fall back to the image configuration, then to the platform defaults.
+type Opts struct {
+	// Image is the configuration of the image the container runs.
+	Image *images.ContainerConfig
+	// Args replace the command of the image, following its entrypoint.
+	Args []string
+	// Env is added to the environment of the image, replacing variables
+	// with the same name.
+	Env []string
+	Cwd string
+	// User is a user and optional group, as "uid[:gid]" or names resolved
+	// against the rootfs.
+	User     string
+	Terminal bool
+	Hostname string
+	// Rootfs is the path of the root filesystem relative to the bundle,
+	// "rootfs" when empty.
+	Rootfs string
+	// RootfsDir is the host path of the root filesystem. It is required to
+	// resolve user and group names only.
+	RootfsDir      string
+	ReadonlyRootfs bool
+	// Mounts are added to the default mounts, replacing those with the same
+	// destination.
+	Mounts      []specs.Mount
+	Resources   *specs.LinuxResources
+	Annotations map[string]string
+	Security    SecurityOpts
+}
+
+// SecurityOpts confine the container beyond its namespaces.
+type SecurityOpts struct {
+	// Privileged grants all capabilities and devices and leaves the kernel
+	// paths of the platform defaults unmasked.
+	Privileged bool
+	// CapAdd and CapDrop adjust the default capabilities, "ALL" drops all
+	// of them.
+	CapAdd  []string
+	CapDrop []string
+	// AllowNewPrivileges lets processes gain privileges through setuid
+	// binaries.
+	AllowNewPrivileges bool
+	// ApparmorProfile is the AppArmor profile of the process. Containers
+	// that are not privileged are confined by the default profile when
+	// AppArmor is enabled on the host.
+	ApparmorProfile string
+	SelinuxLabel    string
+	// Seccomp is the seccomp profile of the container, SeccompDefault,
+	// SeccompUnconfined or a profile as JSON. Privileged containers are
+	// unconfined unless a profile is set.
+	Seccomp string
+}
+
+// Generate returns a spec for the options, with the defaults of the
+// platform.
+func Generate(o Opts) (*specs.Spec, error) {
+	var image images.ContainerConfig
+	if o.Image != nil {
+		image = *o.Image
+	}
+	s := &specs.Spec{
+		Version: specs.Version,
+		Platform: specs.Platform{
+			OS:   runtime.GOOS,
+			Arch: runtime.GOARCH,
+		},
+		Root: specs.Root{
+			Path:     o.Rootfs,
+			Readonly: o.ReadonlyRootfs,
+		},
+		Process: specs.Process{
+			Terminal:        o.Terminal,
+			Args:            processArgs(image, o.Args),
+			Env:             processEnv(image.Env, o.Env, o.Terminal),
+			Cwd:             firstOf(o.Cwd, image.WorkingDir, "/"),
+			NoNewPrivileges: !o.Security.AllowNewPrivileges,
+			ApparmorProfile: o.Security.ApparmorProfile,
+			SelinuxLabel:    o.Security.SelinuxLabel,
+		},
+		Hostname:    o.Hostname,
+		Annotations: make(map[string]string),
+	}
+	if s.Root.Path == "" {
+		s.Root.Path = "rootfs"
+	}
+	for k, v := range image.Labels {
+		s.Annotations[k] = v
+	}
+	for k, v := range o.Annotations {
+		s.Annotations[k] = v
+	}
+	if user := firstOf(o.User, image.User); user != "" {
+		u, err := resolveUser(o.RootfsDir, user)
+		if err != nil {
+			return nil, err
+		}
+		s.Process.User = u
+	}
+	if err := populatePlatform(s, o); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// processArgs returns the entrypoint of the image followed by args, or by
+// the command of the image when args are empty.
+func processArgs(image images.ContainerConfig, args []string) []string {
+	if len(args) == 0 {
+		args = image.Cmd
+	}
+	return append(append([]string{}, image.Entrypoint...), args...)
+}
+
+func processEnv(image, env []string, terminal bool) []string {
+	var out []string
+	index := make(map[string]int)
+	for _, kv := range append(append([]string{}, image...), env...) {
+		name := strings.SplitN(kv, "=", 2)[0]
+		if i, ok := index[name]; ok {
+			out[i] = kv
+			continue
+		}
+		index[name] = len(out)
+		out = append(out, kv)
+	}
+	if _, ok := index["PATH"]; !ok {
+		out = append(out, DefaultPath)
+	}
+	if _, ok := index["TERM"]; !ok && terminal {
+		out = append(out, "TERM=xterm")
+	}
+	return out
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
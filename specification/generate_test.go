@@ -0,0 +1,214 @@
+// +build linux
+
+package specification
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/docker/containerd/images"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestGenerateFromImage(t *testing.T) {
+	s, err := Generate(Opts{
+		Image: &images.ContainerConfig{
+			Entrypoint: []string{"/docker-entrypoint.sh"},
+			Cmd:        []string{"nginx", "-g", "daemon off;"},
+			Env:        []string{"PATH=/usr/bin", "NGINX_VERSION=1.11"},
+			WorkingDir: "/srv",
+			Labels:     map[string]string{"maintainer": "nginx"},
+		},
+		Args:     []string{"sh"},
+		Env:      []string{"NGINX_VERSION=1.12", "DEBUG=1"},
+		Terminal: true,
+		User:     "101:101",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s.Process.Args, []string{"/docker-entrypoint.sh", "sh"}) {
+		t.Errorf("unexpected args %v", s.Process.Args)
+	}
+	if !reflect.DeepEqual(s.Process.Env, []string{"PATH=/usr/bin", "NGINX_VERSION=1.12", "DEBUG=1", "TERM=xterm"}) {
+		t.Errorf("unexpected env %v", s.Process.Env)
+	}
+	if s.Process.Cwd != "/srv" || s.Root.Path != "rootfs" {
+		t.Errorf("unexpected cwd %q or root %q", s.Process.Cwd, s.Root.Path)
+	}
+	if s.Process.User.UID != 101 || s.Process.User.GID != 101 {
+		t.Errorf("unexpected user %+v", s.Process.User)
+	}
+	if s.Annotations["maintainer"] != "nginx" {
+		t.Errorf("expected image labels as annotations, got %v", s.Annotations)
+	}
+	if !s.Process.NoNewPrivileges || len(s.Linux.MaskedPaths) == 0 {
+		t.Error("expected the container to be confined by default")
+	}
+}
+
+func TestGenerateMountsAndResources(t *testing.T) {
+	limit := uint64(64 << 20)
+	s, err := Generate(Opts{
+		Args: []string{"sh"},
+		Mounts: []specs.Mount{
+			{Destination: "/dev/shm", Type: "tmpfs", Source: "shm", Options: []string{"size=1g"}},
+			{Destination: "/data", Type: "bind", Source: "/srv/data", Options: []string{"rbind"}},
+		},
+		Resources: &specs.LinuxResources{
+			Memory: &specs.LinuxMemory{Limit: &limit},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shm, data int
+	for _, m := range s.Mounts {
+		switch m.Destination {
+		case "/dev/shm":
+			shm++
+			if !reflect.DeepEqual(m.Options, []string{"size=1g"}) {
+				t.Errorf("expected the default /dev/shm mount to be replaced, got %v", m.Options)
+			}
+		case "/data":
+			data++
+		}
+	}
+	if shm != 1 || data != 1 {
+		t.Errorf("unexpected mounts %v", s.Mounts)
+	}
+	if *s.Linux.Resources.Memory.Limit != limit {
+		t.Error("expected the memory limit to be set")
+	}
+	if d := s.Linux.Resources.Devices; len(d) != 1 || d[0].Allow {
+		t.Errorf("expected devices to be denied, got %v", d)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	caps, err := capabilities(SecurityOpts{
+		CapAdd:  []string{"sys_admin", "CAP_NET_ADMIN"},
+		CapDrop: []string{"ALL"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(caps, []string{"CAP_SYS_ADMIN", "CAP_NET_ADMIN"}) {
+		t.Errorf("unexpected capabilities %v", caps)
+	}
+	caps, err = capabilities(SecurityOpts{Privileged: true, CapDrop: []string{"mknod"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(caps) != len(allCapabilities)-1 {
+		t.Errorf("expected all capabilities but mknod, got %v", caps)
+	}
+	if _, err := capabilities(SecurityOpts{CapAdd: []string{"CAP_FLY"}}); err == nil {
+		t.Error("expected an unknown capability to fail")
+	}
+	caps, err = AdjustCapabilities([]string{"CAP_KILL", "CAP_CHOWN"}, SecurityOpts{
+		CapAdd:  []string{"net_admin"},
+		CapDrop: []string{"kill"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(caps, []string{"CAP_CHOWN", "CAP_NET_ADMIN"}) {
+		t.Errorf("unexpected adjusted capabilities %v", caps)
+	}
+}
+
+func TestResolveUser(t *testing.T) {
+	dir, err := ioutil.TempDir("", "specification-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "etc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"passwd": "root:x:0:0:root:/root:/bin/sh\nnginx:x:101:102:nginx:/nonexistent:/bin/false\n",
+		"group":  "root:x:0:\nnginx:x:102:\nwww-data:x:33:\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, "etc", name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for user, expected := range map[string]specs.User{
+		"nginx":          {UID: 101, GID: 102},
+		"nginx:www-data": {UID: 101, GID: 33},
+		"101":            {UID: 101, GID: 102},
+		"1000:33":        {UID: 1000, GID: 33},
+	} {
+		u, err := resolveUser(dir, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(u, expected) {
+			t.Errorf("expected %q to resolve to %+v, got %+v", user, expected, u)
+		}
+	}
+	if _, err := resolveUser(dir, "missing"); err == nil {
+		t.Error("expected a missing user to fail")
+	}
+	if _, err := resolveUser("", "nginx"); err == nil {
+		t.Error("expected a name without a rootfs to fail")
+	}
+}
+
+func TestSeccompProfile(t *testing.T) {
+	s, err := Generate(Opts{Args: []string{"sh"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Linux.Seccomp == nil || !deniesSyscall(s.Linux.Seccomp, "mount") {
+		t.Fatalf("expected the default profile to deny mount, got %+v", s.Linux.Seccomp)
+	}
+
+	s, err = Generate(Opts{Args: []string{"sh"}, Security: SecurityOpts{CapAdd: []string{"SYS_ADMIN"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deniesSyscall(s.Linux.Seccomp, "mount") || !deniesSyscall(s.Linux.Seccomp, "reboot") {
+		t.Error("expected CAP_SYS_ADMIN to allow mount only")
+	}
+
+	s, err = Generate(Opts{Args: []string{"sh"}, Security: SecurityOpts{Privileged: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Linux.Seccomp != nil {
+		t.Error("expected a privileged container to be unconfined")
+	}
+
+	s, err = Generate(Opts{Args: []string{"sh"}, Security: SecurityOpts{
+		Seccomp: `{"defaultAction":"SCMP_ACT_ERRNO","syscalls":[{"name":"read","action":"SCMP_ACT_ALLOW"}]}`,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Linux.Seccomp.DefaultAction != specs.ActErrno || len(s.Linux.Seccomp.Syscalls) != 1 {
+		t.Errorf("expected the custom profile, got %+v", s.Linux.Seccomp)
+	}
+
+	for _, invalid := range []string{"strict", `{"syscalls":[]}`} {
+		if _, err := SeccompProfile(invalid, nil); err == nil {
+			t.Errorf("expected profile %q to fail", invalid)
+		}
+	}
+}
+
+func deniesSyscall(s *specs.LinuxSeccomp, name string) bool {
+	for _, sc := range s.Syscalls {
+		if sc.Name == name && sc.Action != specs.ActAllow {
+			return true
+		}
+	}
+	return false
+}
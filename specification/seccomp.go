@@ -0,0 +1,113 @@
+package specification
+
+import (
+	"encoding/json"
+	"runtime"
+	"sort"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// Names of the builtin seccomp profiles.
+const (
+	// SeccompDefault denies the syscalls that let a container affect the
+	// host, unless it holds the capability they require.
+	SeccompDefault = "default"
+	// SeccompUnconfined leaves syscalls unfiltered.
+	SeccompUnconfined = "unconfined"
+)
+
+// deniedSyscalls are the syscalls denied by the default profile, by the
+// capability that allows them. Those under an empty capability are always
+// denied.
+var deniedSyscalls = map[string][]string{
+	"": {
+		"add_key", "bpf", "create_module", "get_kernel_syms", "kexec_file_load",
+		"keyctl", "lookup_dcookie", "nfsservctl", "perf_event_open",
+		"query_module", "request_key", "_sysctl", "sysfs", "uselib",
+		"userfaultfd", "ustat", "vm86", "vm86old",
+	},
+	"CAP_SYS_ADMIN": {
+		"mount", "umount", "umount2", "pivot_root", "setns", "unshare",
+		"name_to_handle_at", "quotactl", "swapon", "swapoff",
+	},
+	"CAP_SYS_BOOT":        {"reboot"},
+	"CAP_SYS_MODULE":      {"init_module", "finit_module", "delete_module"},
+	"CAP_SYS_NICE":        {"get_mempolicy", "mbind", "move_pages", "set_mempolicy"},
+	"CAP_SYS_PACCT":       {"acct"},
+	"CAP_SYS_PTRACE":      {"kcmp", "process_vm_readv", "process_vm_writev", "ptrace"},
+	"CAP_SYS_RAWIO":       {"ioperm", "iopl"},
+	"CAP_SYS_TIME":        {"clock_adjtime", "clock_settime", "settimeofday", "stime"},
+	"CAP_DAC_READ_SEARCH": {"open_by_handle_at"},
+	"CAP_SYS_TTY_CONFIG":  {"vhangup"},
+}
+
+// DefaultSeccompProfile returns the default profile for a container holding
+// the capabilities caps.
+func DefaultSeccompProfile(caps []string) *specs.LinuxSeccomp {
+	held := make(map[string]bool)
+	for _, c := range caps {
+		held[c] = true
+	}
+	var capNames []string
+	for c := range deniedSyscalls {
+		capNames = append(capNames, c)
+	}
+	sort.Strings(capNames)
+	var denied []string
+	for _, c := range capNames {
+		if c != "" && held[c] {
+			continue
+		}
+		denied = append(denied, deniedSyscalls[c]...)
+	}
+	profile := &specs.LinuxSeccomp{
+		DefaultAction: specs.ActAllow,
+		Architectures: seccompArchitectures(),
+	}
+	for _, name := range denied {
+		profile.Syscalls = append(profile.Syscalls, specs.LinuxSyscall{
+			Name:   name,
+			Action: specs.ActErrno,
+		})
+	}
+	return profile
+}
+
+// SeccompProfile returns the profile named by profile, or the profile it
+// holds as JSON. The default profile is returned for an empty profile, and
+// nil for an unconfined one.
+func SeccompProfile(profile string, caps []string) (*specs.LinuxSeccomp, error) {
+	switch profile {
+	case "", SeccompDefault:
+		return DefaultSeccompProfile(caps), nil
+	case SeccompUnconfined:
+		return nil, nil
+	}
+	var s specs.LinuxSeccomp
+	if err := json.Unmarshal([]byte(profile), &s); err != nil {
+		return nil, errors.Wrap(err, "invalid seccomp profile")
+	}
+	if s.DefaultAction == "" {
+		return nil, errors.New("invalid seccomp profile: missing default action")
+	}
+	return &s, nil
+}
+
+// seccompArchitectures returns the architectures whose syscalls are
+// filtered, so that the compatibility syscalls of the platform can not be
+// used to bypass the filter.
+func seccompArchitectures() []specs.Arch {
+	switch runtime.GOARCH {
+	case "amd64":
+		return []specs.Arch{specs.ArchX86_64, specs.ArchX86, specs.ArchX32}
+	case "arm64":
+		return []specs.Arch{specs.ArchAARCH64, specs.ArchARM}
+	case "ppc64le":
+		return []specs.Arch{specs.ArchPPC64LE}
+	case "s390x":
+		return []specs.Arch{specs.ArchS390X, specs.ArchS390}
+	}
+	return nil
+}
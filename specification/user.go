@@ -0,0 +1,81 @@
+package specification
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// resolveUser resolves "user[:group]" to ids. Names are looked up in the
+// passwd and group files of the rootfs at dir; the primary group of a user
+// found in passwd is used when no group is set.
+func resolveUser(dir, user string) (specs.User, error) {
+	var u specs.User
+	parts := strings.SplitN(user, ":", 2)
+	uid, gid, err := lookupID(dir, "passwd", parts[0])
+	if err != nil {
+		return u, errors.Wrapf(err, "failed to resolve user %q", parts[0])
+	}
+	u.UID = uid
+	u.GID = gid
+	if len(parts) == 2 {
+		if u.GID, _, err = lookupID(dir, "group", parts[1]); err != nil {
+			return u, errors.Wrapf(err, "failed to resolve group %q", parts[1])
+		}
+	}
+	return u, nil
+}
+
+// lookupID returns the id of name in the passwd or group file, along with
+// the primary group of a passwd entry. Numeric names are ids already, their
+// primary group is looked up when the file is available.
+func lookupID(dir, file, name string) (id, gid uint32, err error) {
+	n, numeric := parseID(name)
+	if dir == "" {
+		if numeric {
+			return n, 0, nil
+		}
+		return 0, 0, errors.New("the rootfs is required to resolve names")
+	}
+	f, err := os.Open(filepath.Join(dir, "etc", file))
+	if err != nil {
+		if numeric && os.IsNotExist(err) {
+			return n, 0, nil
+		}
+		return 0, 0, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// name:password:id[:gid:...]
+		fields := strings.Split(s.Text(), ":")
+		if len(fields) < 3 {
+			continue
+		}
+		entryID, ok := parseID(fields[2])
+		if !ok || (fields[0] != name && !(numeric && entryID == n)) {
+			continue
+		}
+		if file == "passwd" && len(fields) > 3 {
+			gid, _ = parseID(fields[3])
+		}
+		return entryID, gid, nil
+	}
+	if err := s.Err(); err != nil {
+		return 0, 0, err
+	}
+	if numeric {
+		return n, 0, nil
+	}
+	return 0, 0, errors.Errorf("no %s entry", file)
+}
+
+func parseID(s string) (uint32, bool) {
+	n, err := strconv.ParseUint(s, 10, 32)
+	return uint32(n), err == nil
+}
@@ -0,0 +1,98 @@
+package stdio
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// ConsoleSocket is a unix socket a runtime sends the pty master of a
+// terminal process over, as done by runc with --console-socket. Creating the
+// pty in the runtime avoids racing with the container's setup of its
+// console.
+type ConsoleSocket struct {
+	dir string
+	l   *net.UnixListener
+}
+
+// NewConsoleSocket listens on a new socket in a temporary directory, as
+// the state directory of a process may exceed the length of a socket path.
+func NewConsoleSocket() (*ConsoleSocket, error) {
+	dir, err := ioutil.TempDir("", "containerd-console-")
+	if err != nil {
+		return nil, err
+	}
+	l, err := net.ListenUnix("unix", &net.UnixAddr{
+		Name: filepath.Join(dir, "pty.sock"),
+		Net:  "unix",
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, errors.Wrap(err, "failed to listen on console socket")
+	}
+	return &ConsoleSocket{
+		dir: dir,
+		l:   l,
+	}, nil
+}
+
+// Path returns the path of the socket to pass to the runtime.
+func (c *ConsoleSocket) Path() string {
+	return c.l.Addr().String()
+}
+
+// ReceiveMaster returns the pty master sent by the runtime, waiting up to
+// timeout for it to connect.
+func (c *ConsoleSocket) ReceiveMaster(timeout time.Duration) (*os.File, error) {
+	if err := c.l.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	conn, err := c.l.AcceptUnix()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to accept console socket connection")
+	}
+	defer conn.Close()
+	if err := conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+
+	// the message holds the name of the terminal and the master fd
+	name := make([]byte, 4096)
+	oob := make([]byte, unix.CmsgSpace(4))
+	n, oobn, _, _, err := conn.ReadMsgUnix(name, oob)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to receive pty master")
+	}
+	msgs, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse console socket message")
+	}
+	if len(msgs) != 1 {
+		return nil, errors.Errorf("expected a single control message, got %d", len(msgs))
+	}
+	fds, err := unix.ParseUnixRights(&msgs[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse console socket rights")
+	}
+	if len(fds) != 1 {
+		for _, fd := range fds {
+			unix.Close(fd)
+		}
+		return nil, errors.Errorf("expected a single fd, got %d", len(fds))
+	}
+	return os.NewFile(uintptr(fds[0]), string(name[:n])), nil
+}
+
+// Close stops listening and removes the socket.
+func (c *ConsoleSocket) Close() error {
+	err := c.l.Close()
+	if rerr := os.RemoveAll(c.dir); err == nil {
+		err = rerr
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package stdio
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestConsoleSocket(t *testing.T) {
+	socket, err := NewConsoleSocket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer socket.Close()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	// send a fd the way runc sends the pty master
+	go func() {
+		conn, err := net.Dial("unix", socket.Path())
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.(*net.UnixConn).WriteMsgUnix([]byte("/dev/pts/1"), unix.UnixRights(int(w.Fd())), nil)
+	}()
+
+	master, err := socket.ReceiveMaster(5 * time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+	if master.Name() != "/dev/pts/1" {
+		t.Fatalf("unexpected name %q", master.Name())
+	}
+	if _, err := master.Write([]byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, 1)
+	if _, err := r.Read(b); err != nil || b[0] != 'x' {
+		t.Fatalf("expected the received fd to be the sent one, got %q: %v", b, err)
+	}
+
+	path := socket.Path()
+	if err := socket.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected socket to be removed, got %v", err)
+	}
+}
+
+func TestConsoleSocketTimeout(t *testing.T) {
+	socket, err := NewConsoleSocket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer socket.Close()
+
+	if _, err := socket.ReceiveMaster(50 * time.Millisecond); err == nil {
+		t.Fatal("expected receive without a runtime to time out")
+	}
+}
@@ -0,0 +1,98 @@
+package stdio
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// maxSpliceSize is the most bytes moved by a splice, the default
+	// capacity of a pipe.
+	maxSpliceSize = 64 << 10
+
+	spliceMove     = 0x1 // SPLICE_F_MOVE
+	spliceNonblock = 0x2 // SPLICE_F_NONBLOCK
+)
+
+// splice moves the data of src to dst in the kernel when they are files and
+// one of them is a pipe or fifo. It is not handled when the first splice
+// fails, as the kernel does not support splicing the files.
+func splice(dst io.Writer, src io.Reader) (int64, bool, error) {
+	df, ok := dst.(*os.File)
+	if !ok {
+		return 0, false, nil
+	}
+	sf, ok := src.(*os.File)
+	if !ok || (!isPipe(df) && !isPipe(sf)) {
+		return 0, false, nil
+	}
+	rc, err := sf.SyscallConn()
+	if err != nil {
+		return 0, false, nil
+	}
+	wc, err := df.SyscallConn()
+	if err != nil {
+		return 0, false, nil
+	}
+	var written int64
+	for {
+		var (
+			n    int64
+			serr error
+			// full is set when dst is the end which would block
+			full bool
+		)
+		// the splice waits on the poller of src for its data, and on
+		// that of dst below when it is full, the descriptors being
+		// non-blocking
+		rerr := rc.Read(func(rfd uintptr) bool {
+			if err := wc.Write(func(wfd uintptr) bool {
+				n, serr = unix.Splice(int(rfd), nil, int(wfd), nil, maxSpliceSize, spliceMove|spliceNonblock)
+				if serr == unix.EAGAIN {
+					full = !writable(int(wfd))
+				}
+				return true
+			}); err != nil {
+				serr = err
+			}
+			return serr != unix.EAGAIN || full
+		})
+		if rerr != nil {
+			return written, true, rerr
+		}
+		switch {
+		case full:
+			waited := false
+			if err := wc.Write(func(uintptr) bool {
+				done := waited
+				waited = true
+				return done
+			}); err != nil {
+				return written, true, err
+			}
+		case serr != nil:
+			if written == 0 && (serr == unix.EINVAL || serr == unix.ENOSYS) {
+				return 0, false, nil
+			}
+			return written, true, os.NewSyscallError("splice", serr)
+		case n == 0:
+			return written, true, nil
+		default:
+			written += n
+		}
+	}
+}
+
+func isPipe(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeNamedPipe != 0
+}
+
+// writable reports whether data can be written to fd without blocking.
+func writable(fd int) bool {
+	fds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLOUT}}
+	n, err := unix.Poll(fds, 0)
+	return err == nil && n > 0 && fds[0].Revents&unix.POLLOUT != 0
+}
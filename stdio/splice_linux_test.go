@@ -0,0 +1,84 @@
+package stdio
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestSplice(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stdio-splice-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "stdout")
+	if err := syscall.Mkfifo(path, 0600); err != nil {
+		t.Fatal(err)
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	// the output is larger than the pipes, for the splice to wait on the
+	// fifo as it is read slowly
+	data := bytes.Repeat([]byte("0123456789abcdef"), 64<<10)
+	go func() {
+		w.Write(data)
+		w.Close()
+	}()
+	read := make(chan []byte)
+	go func() {
+		f, err := OpenReader(path, DefaultOpenTimeout)
+		if err != nil {
+			read <- nil
+			return
+		}
+		defer f.Close()
+		var out []byte
+		buf := make([]byte, 32<<10)
+		for {
+			time.Sleep(time.Millisecond)
+			n, err := f.Read(buf)
+			out = append(out, buf[:n]...)
+			if err != nil {
+				read <- out
+				return
+			}
+		}
+	}()
+	fifo, err := OpenWriter(path, DefaultOpenTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, handled, err := splice(fifo, r)
+	fifo.Close()
+	if err != nil || !handled {
+		t.Fatalf("expected the pipe to be spliced into the fifo, handled: %v: %v", handled, err)
+	}
+	if out := <-read; n != int64(len(data)) || !bytes.Equal(out, data) {
+		t.Fatalf("expected %d bytes to be spliced, got %d and %d bytes read", len(data), n, len(out))
+	}
+}
+
+func TestCopyFallback(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	go func() {
+		w.Write([]byte("hello"))
+		w.Close()
+	}()
+	var buf bytes.Buffer
+	if n, err := Copy(&buf, r); err != nil || n != 5 || buf.String() != "hello" {
+		t.Fatalf("expected the pipe to be copied to the buffer, got %q: %v", buf.String(), err)
+	}
+}
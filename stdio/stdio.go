@@ -0,0 +1,215 @@
+// Package stdio manages the fifos used to connect the stdio of container
+// processes to their clients.
+//
+// Opening a fifo blocks until its peer opens the other end. The functions of
+// this package never block indefinitely: the shim side waits with a timeout
+// and the client side opens without blocking, and fifos whose peer never
+// showed up are closed rather than leaked.
+package stdio
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tonistiigi/fifo"
+)
+
+// DefaultOpenTimeout is the time a peer has to open its end of a fifo.
+const DefaultOpenTimeout = 15 * time.Second
+
+// FIFOSet holds the paths of the fifos for the stdio of a process.
+type FIFOSet struct {
+	// Dir is the directory holding the fifos, removed on Close.
+	Dir    string
+	Stdin  string
+	Stdout string
+	Stderr string
+	// Terminal is set when the process has a console, in which case its
+	// output is only written to Stdout.
+	Terminal bool
+}
+
+// NewFIFOSet creates the fifos for the process id in a new directory under
+// root.
+func NewFIFOSet(root, id string, terminal bool) (*FIFOSet, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir(root, id+"-")
+	if err != nil {
+		return nil, err
+	}
+	s := &FIFOSet{
+		Dir:      dir,
+		Stdin:    filepath.Join(dir, "stdin"),
+		Stdout:   filepath.Join(dir, "stdout"),
+		Stderr:   filepath.Join(dir, "stderr"),
+		Terminal: terminal,
+	}
+	for _, path := range []string{s.Stdin, s.Stdout, s.Stderr} {
+		if err := syscall.Mkfifo(path, 0700); err != nil {
+			os.RemoveAll(dir)
+			return nil, errors.Wrapf(err, "failed to create fifo %s", path)
+		}
+	}
+	return s, nil
+}
+
+// Close removes the fifos.
+func (s *FIFOSet) Close() error {
+	return os.RemoveAll(s.Dir)
+}
+
+// Attach copies stdin to the process and its output to stdout and stderr.
+// The fifos are opened without waiting for the process side, which may
+// only open them once the process is created.
+func (s *FIFOSet) Attach(stdin io.Reader, stdout, stderr io.Writer) (*Attachment, error) {
+	a := &Attachment{}
+	open := func(path string, flag int) (io.ReadWriteCloser, error) {
+		f, err := fifo.OpenFifo(context.Background(), path, flag|syscall.O_NONBLOCK, 0)
+		if err != nil {
+			a.Close()
+			return nil, errors.Wrapf(err, "failed to open fifo %s", path)
+		}
+		// fifos must not be closed concurrently
+		c := &onceCloser{ReadWriteCloser: f}
+		a.closers = append(a.closers, c)
+		return c, nil
+	}
+
+	in, err := open(s.Stdin, syscall.O_WRONLY)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		io.Copy(in, stdin)
+		in.Close()
+	}()
+
+	outputs := []struct {
+		path string
+		w    io.Writer
+	}{
+		{s.Stdout, stdout},
+	}
+	if !s.Terminal {
+		outputs = append(outputs, struct {
+			path string
+			w    io.Writer
+		}{s.Stderr, stderr})
+	}
+	for _, o := range outputs {
+		r, err := open(o.path, syscall.O_RDONLY)
+		if err != nil {
+			return nil, err
+		}
+		a.wg.Add(1)
+		go func(w io.Writer) {
+			defer a.wg.Done()
+			io.Copy(w, r)
+			r.Close()
+		}(o.w)
+	}
+	return a, nil
+}
+
+// Attachment is the client side of a FIFOSet.
+type Attachment struct {
+	closers []io.Closer
+	wg      sync.WaitGroup
+}
+
+// Wait waits for the output of the process to be copied, until the process
+// closes its end of the fifos.
+func (a *Attachment) Wait() {
+	a.wg.Wait()
+}
+
+// Close closes the client ends of the fifos, including those the process
+// never opened, unblocking any pending copy.
+func (a *Attachment) Close() error {
+	var err error
+	for _, c := range a.closers {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+type onceCloser struct {
+	io.ReadWriteCloser
+	once sync.Once
+	err  error
+}
+
+func (c *onceCloser) Close() error {
+	c.once.Do(func() {
+		c.err = c.ReadWriteCloser.Close()
+	})
+	return c.err
+}
+
+// Copy copies src to dst until EOF like io.Copy. The data is spliced from
+// one to the other without being copied through the daemon where supported,
+// when both are files and one of them is a pipe or a fifo.
+func Copy(dst io.Writer, src io.Reader) (int64, error) {
+	n, handled, err := splice(dst, src)
+	if handled {
+		return n, err
+	}
+	return io.Copy(dst, src)
+}
+
+// OpenReader opens the fifo at path for reading, waiting up to timeout for
+// a writer.
+func OpenReader(path string, timeout time.Duration) (*os.File, error) {
+	return openTimeout(path, syscall.O_RDONLY, timeout)
+}
+
+// OpenWriter opens the fifo at path for writing, waiting up to timeout for
+// a reader.
+func OpenWriter(path string, timeout time.Duration) (*os.File, error) {
+	return openTimeout(path, syscall.O_WRONLY, timeout)
+}
+
+func openTimeout(path string, flag int, timeout time.Duration) (*os.File, error) {
+	type result struct {
+		f   *os.File
+		err error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		f, err := os.OpenFile(path, flag, 0)
+		ch <- result{f, err}
+	}()
+	select {
+	case r := <-ch:
+		if r.err != nil {
+			return nil, errors.Wrapf(r.err, "failed to open fifo %s", path)
+		}
+		return r.f, nil
+	case <-time.After(timeout):
+	}
+
+	// complete the pending open by opening the other end ourselves, so
+	// that neither the goroutine nor the descriptor are leaked.
+	reverse := syscall.O_WRONLY
+	if flag == syscall.O_WRONLY {
+		reverse = syscall.O_RDONLY
+	}
+	if f, err := os.OpenFile(path, reverse|syscall.O_NONBLOCK, 0); err == nil {
+		defer f.Close()
+	}
+	if r := <-ch; r.f != nil {
+		r.f.Close()
+	}
+	return nil, errors.Errorf("timed out opening fifo %s", path)
+}
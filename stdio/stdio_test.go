@@ -0,0 +1,113 @@
+package stdio
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func fifoSetEnv(t *testing.T, terminal bool) (*FIFOSet, func()) {
+	root, err := ioutil.TempDir("", "stdio-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewFIFOSet(root, "test", terminal)
+	if err != nil {
+		os.RemoveAll(root)
+		t.Fatal(err)
+	}
+	return s, func() {
+		s.Close()
+		os.RemoveAll(root)
+	}
+}
+
+func TestAttach(t *testing.T) {
+	s, cleanup := fifoSetEnv(t, false)
+	defer cleanup()
+
+	var stdout, stderr bytes.Buffer
+	a, err := s.Attach(strings.NewReader("input"), &stdout, &stderr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+
+	// the process side
+	in, err := OpenReader(s.Stdin, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := OpenWriter(s.Stdout, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errw, err := OpenWriter(s.Stderr, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, err := ioutil.ReadAll(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in.Close()
+	if string(input) != "input" {
+		t.Fatalf("unexpected input %q", input)
+	}
+	out.Write([]byte("output"))
+	out.Close()
+	errw.Write([]byte("error"))
+	errw.Close()
+
+	a.Wait()
+	if stdout.String() != "output" || stderr.String() != "error" {
+		t.Fatalf("unexpected output %q and %q", stdout.String(), stderr.String())
+	}
+
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(s.Dir); !os.IsNotExist(err) {
+		t.Fatalf("expected fifos to be removed, got %v", err)
+	}
+}
+
+func TestAttachClose(t *testing.T) {
+	s, cleanup := fifoSetEnv(t, true)
+	defer cleanup()
+
+	a, err := s.Attach(strings.NewReader(""), ioutil.Discard, ioutil.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the process never opens its end
+	done := make(chan struct{})
+	go func() {
+		a.Wait()
+		close(done)
+	}()
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait did not return after close")
+	}
+}
+
+func TestOpenTimeout(t *testing.T) {
+	s, cleanup := fifoSetEnv(t, false)
+	defer cleanup()
+
+	start := time.Now()
+	if _, err := OpenWriter(s.Stdout, 100*time.Millisecond); err == nil {
+		t.Fatal("expected open without a reader to time out")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatal("open did not time out")
+	}
+}
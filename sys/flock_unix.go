@@ -0,0 +1,22 @@
+// +build !windows
+
+package sys
+
+import (
+	"os"
+	"syscall"
+)
+
+// LockFile locks the file f, exclusively or shared with other processes,
+// until it is closed. When wait is false it fails with syscall.EWOULDBLOCK
+// rather than waiting for the lock held by another process.
+func LockFile(f *os.File, exclusive, wait bool) error {
+	how := syscall.LOCK_SH
+	if exclusive {
+		how = syscall.LOCK_EX
+	}
+	if !wait {
+		how |= syscall.LOCK_NB
+	}
+	return syscall.Flock(int(f.Fd()), how)
+}
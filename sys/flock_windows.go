@@ -0,0 +1,38 @@
+package sys
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	lockfileFailImmediately = 0x1
+	lockfileExclusiveLock   = 0x2
+
+	errorLockViolation syscall.Errno = 33
+)
+
+var procLockFileEx = syscall.NewLazyDLL("kernel32.dll").NewProc("LockFileEx")
+
+// LockFile locks the file f, exclusively or shared with other processes,
+// until it is closed. When wait is false it fails with syscall.EWOULDBLOCK
+// rather than waiting for the lock held by another process.
+func LockFile(f *os.File, exclusive, wait bool) error {
+	var flags uintptr
+	if exclusive {
+		flags |= lockfileExclusiveLock
+	}
+	if !wait {
+		flags |= lockfileFailImmediately
+	}
+	// the first byte of the file is locked, whatever its size
+	r, _, err := procLockFileEx.Call(f.Fd(), flags, 0, 1, 0, uintptr(unsafe.Pointer(new(syscall.Overlapped))))
+	if r == 0 {
+		if err == errorLockViolation {
+			return syscall.EWOULDBLOCK
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package sys
+
+import (
+	"net"
+	"syscall"
+)
+
+// GetPeerCredentials returns the credentials of the process on the other end
+// of the unix socket connection, as they were when it connected.
+func GetPeerCredentials(conn *net.UnixConn) (*syscall.Ucred, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		cred    *syscall.Ucred
+		credErr error
+	)
+	if err := raw.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil {
+		return nil, err
+	}
+	return cred, credErr
+}
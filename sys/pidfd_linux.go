@@ -0,0 +1,18 @@
+package sys
+
+import "syscall"
+
+// sysPidfdOpen is the number of the pidfd_open syscall, the same on all
+// architectures.
+const sysPidfdOpen = 434
+
+// PidfdOpen returns a file descriptor referring to the process pid, which
+// becomes readable once the process exits, with close-on-exec set. It fails
+// with ENOSYS on kernels older than 5.3.
+func PidfdOpen(pid int) (int, error) {
+	fd, _, errno := syscall.Syscall(sysPidfdOpen, uintptr(pid), 0, 0)
+	if errno != 0 {
+		return -1, errno
+	}
+	return int(fd), nil
+}
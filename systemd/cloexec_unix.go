@@ -0,0 +1,9 @@
+// +build !windows
+
+package systemd
+
+import "syscall"
+
+func closeOnExec(fd int) {
+	syscall.CloseOnExec(fd)
+}
@@ -0,0 +1,6 @@
+package systemd
+
+// closeOnExec does nothing on Windows, where the daemon is never activated
+// by systemd.
+func closeOnExec(fd int) {
+}
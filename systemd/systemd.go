@@ -0,0 +1,81 @@
+// Package systemd integrates the daemon with systemd, accepting the sockets
+// it activated the daemon with and notifying it of the state of the daemon.
+package systemd
+
+import (
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// listenFdsStart is the first file descriptor passed by socket activation.
+const listenFdsStart = 3
+
+// States reported to systemd by Notify.
+const (
+	Ready     = "READY=1"
+	Reloading = "RELOADING=1"
+	Stopping  = "STOPPING=1"
+)
+
+// Listeners returns the sockets systemd passed to the process, which was
+// started by socket activation, in the order of the socket unit. No
+// listeners are returned when the process was not socket activated. The
+// environment variables of the activation are unset, for the sockets not to
+// be inherited by the children of the process.
+func Listeners() ([]net.Listener, error) {
+	defer func() {
+		os.Unsetenv("LISTEN_PID")
+		os.Unsetenv("LISTEN_FDS")
+		os.Unsetenv("LISTEN_FDNAMES")
+	}()
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || n <= 0 {
+		return nil, nil
+	}
+	var listeners []net.Listener
+	for fd := listenFdsStart; fd < listenFdsStart+n; fd++ {
+		closeOnExec(fd)
+		f := os.NewFile(uintptr(fd), "LISTEN_FD_"+strconv.Itoa(fd))
+		l, err := net.FileListener(f)
+		// the listener holds a duplicate of the descriptor
+		f.Close()
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, errors.Wrapf(err, "invalid socket activated with file descriptor %d", fd)
+		}
+		listeners = append(listeners, l)
+	}
+	return listeners, nil
+}
+
+// Notify sends state, such as Ready, to the service manager. It reports
+// whether the notification was sent, which is not the case when the process
+// was not started by systemd as a notify service.
+func Notify(state string) (bool, error) {
+	path := os.Getenv("NOTIFY_SOCKET")
+	if path == "" {
+		return false, nil
+	}
+	// abstract sockets are prefixed with @
+	if path[0] == '@' {
+		path = "\x00" + path[1:]
+	}
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to connect to the notify socket")
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte(state)); err != nil {
+		return false, errors.Wrap(err, "failed to notify systemd")
+	}
+	return true, nil
+}
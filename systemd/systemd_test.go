@@ -0,0 +1,58 @@
+package systemd
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestNotify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "systemd-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer os.Unsetenv("NOTIFY_SOCKET")
+
+	os.Unsetenv("NOTIFY_SOCKET")
+	if sent, err := Notify(Ready); err != nil || sent {
+		t.Fatalf("expected no notification without a socket, got %v, %v", sent, err)
+	}
+
+	path := filepath.Join(dir, "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	os.Setenv("NOTIFY_SOCKET", path)
+	if sent, err := Notify(Ready); err != nil || !sent {
+		t.Fatalf("expected the notification to be sent, got %v, %v", sent, err)
+	}
+	buf := make([]byte, 64)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(buf[:n]); got != Ready {
+		t.Fatalf("expected %q, got %q", Ready, got)
+	}
+}
+
+func TestListenersNotActivated(t *testing.T) {
+	os.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()+1))
+	os.Setenv("LISTEN_FDS", "1")
+	listeners, err := Listeners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listeners) != 0 {
+		t.Fatalf("expected no listeners for another process, got %d", len(listeners))
+	}
+	if os.Getenv("LISTEN_FDS") != "" {
+		t.Fatal("expected the activation environment to be unset")
+	}
+}
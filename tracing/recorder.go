@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"github.com/docker/containerd/log"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	recorder  Recorder = logRecorder{}
+	sampleAll bool
+)
+
+// Recorder receives the finished spans of sampled traces.
+type Recorder interface {
+	Record(s *Span)
+}
+
+// SetRecorder replaces the recorder, which logs the spans by default. It is
+// meant to be called on startup.
+func SetRecorder(r Recorder) {
+	recorder = r
+}
+
+// SetSampleAll sets whether traces started by the daemon are sampled. Traces
+// propagated by callers are sampled as they decided. It is meant to be called
+// on startup.
+func SetSampleAll(all bool) {
+	sampleAll = all
+}
+
+// logRecorder logs each span with its timing.
+type logRecorder struct{}
+
+func (logRecorder) Record(s *Span) {
+	fields := logrus.Fields{
+		"trace":    s.Context.TraceID.String(),
+		"span":     s.Context.SpanID.String(),
+		"name":     s.Name,
+		"duration": s.End.Sub(s.Start),
+	}
+	if s.Parent != (SpanID{}) {
+		fields["parent"] = s.Parent.String()
+	}
+	for k, v := range s.Tags {
+		fields["tag."+k] = v
+	}
+	entry := log.L.WithFields(fields)
+	if s.Err != nil {
+		entry = entry.WithError(s.Err)
+	}
+	entry.Info("span")
+}
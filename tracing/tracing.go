@@ -0,0 +1,157 @@
+// Package tracing records the time spent in the operations serving a request
+// as the spans of a trace. The trace context of a request is taken from the
+// caller in the W3C traceparent format, so that the spans of the daemon can be
+// joined with those of its clients, and finished spans of sampled traces are
+// reported to the recorder.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TraceParentKey is the metadata key carrying the trace context of a request.
+const TraceParentKey = "traceparent"
+
+// ErrInvalidTraceParent is returned when a traceparent can not be parsed.
+var ErrInvalidTraceParent = errors.New("tracing: invalid traceparent")
+
+// TraceID identifies a trace.
+type TraceID [16]byte
+
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// SpanID identifies a span in a trace.
+type SpanID [8]byte
+
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// SpanContext is the part of a span propagated to its children.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	// Sampled is set when the spans of the trace are recorded.
+	Sampled bool
+}
+
+// TraceParent formats the span context as a traceparent header.
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceParent parses a traceparent header of the form
+// "version-traceid-spanid-flags".
+func ParseTraceParent(s string) (SpanContext, error) {
+	var sc SpanContext
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return sc, errors.Wrapf(ErrInvalidTraceParent, "%q", s)
+	}
+	var flags [1]byte
+	for _, f := range []struct {
+		value string
+		dst   []byte
+	}{
+		{parts[1], sc.TraceID[:]},
+		{parts[2], sc.SpanID[:]},
+		{parts[3], flags[:]},
+	} {
+		if len(f.value) != hex.EncodedLen(len(f.dst)) {
+			return sc, errors.Wrapf(ErrInvalidTraceParent, "%q", s)
+		}
+		if _, err := hex.Decode(f.dst, []byte(f.value)); err != nil {
+			return sc, errors.Wrapf(ErrInvalidTraceParent, "%q", s)
+		}
+	}
+	if sc.TraceID == (TraceID{}) || sc.SpanID == (SpanID{}) {
+		return sc, errors.Wrapf(ErrInvalidTraceParent, "%q", s)
+	}
+	sc.Sampled = flags[0]&1 == 1
+	return sc, nil
+}
+
+// Span is an operation of a trace.
+type Span struct {
+	Name    string
+	Context SpanContext
+	// Parent is the id of the parent span, zero for the root span of a
+	// trace.
+	Parent SpanID
+	Start  time.Time
+	End    time.Time
+	Tags   map[string]string
+	// Err is the error the operation failed with.
+	Err error
+}
+
+// SetTag annotates the span with a key and value.
+func (s *Span) SetTag(key, value string) {
+	if s.Tags == nil {
+		s.Tags = make(map[string]string)
+	}
+	s.Tags[key] = value
+}
+
+// Finish ends the span with the result of the operation, recording it when
+// the trace is sampled.
+func (s *Span) Finish(err error) {
+	s.End = time.Now()
+	s.Err = err
+	if s.Context.Sampled {
+		recorder.Record(s)
+	}
+}
+
+type (
+	spanKey   struct{}
+	remoteKey struct{}
+)
+
+// WithRemoteParent returns a context in which the root span is a child of
+// the span of a remote caller.
+func WithRemoteParent(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, remoteKey{}, sc)
+}
+
+// FromContext returns the current span of the context, nil when there is
+// none.
+func FromContext(ctx context.Context) *Span {
+	s, _ := ctx.Value(spanKey{}).(*Span)
+	return s
+}
+
+// StartSpan starts a span as a child of the current span of ctx, or of the
+// remote parent. A new trace is started when there is neither. The returned
+// context carries the new span.
+func StartSpan(ctx context.Context, name string) (*Span, context.Context) {
+	s := &Span{
+		Name:  name,
+		Start: time.Now(),
+	}
+	if parent := FromContext(ctx); parent != nil {
+		s.Context = parent.Context
+		s.Parent = parent.Context.SpanID
+	} else if remote, ok := ctx.Value(remoteKey{}).(SpanContext); ok {
+		s.Context = remote
+		s.Parent = remote.SpanID
+	} else {
+		rand.Read(s.Context.TraceID[:])
+		s.Context.Sampled = sampleAll
+	}
+	rand.Read(s.Context.SpanID[:])
+	return s, context.WithValue(ctx, spanKey{}, s)
+}
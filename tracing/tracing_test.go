@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type testRecorder struct {
+	spans []*Span
+}
+
+func (r *testRecorder) Record(s *Span) {
+	r.spans = append(r.spans, s)
+}
+
+func TestTraceParent(t *testing.T) {
+	const tp = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sc, err := ParseTraceParent(tp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sc.Sampled || sc.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" || sc.SpanID.String() != "00f067aa0ba902b7" {
+		t.Fatalf("unexpected span context %+v", sc)
+	}
+	if sc.TraceParent() != tp {
+		t.Fatalf("expected %s, got %s", tp, sc.TraceParent())
+	}
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473x-00f067aa0ba902b7-01",
+	} {
+		if _, err := ParseTraceParent(invalid); err == nil {
+			t.Errorf("expected %q to be invalid", invalid)
+		}
+	}
+}
+
+func TestSpans(t *testing.T) {
+	r := &testRecorder{}
+	SetRecorder(r)
+	defer SetRecorder(logRecorder{})
+
+	remote, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, ctx := StartSpan(WithRemoteParent(context.Background(), remote), "root")
+	child, _ := StartSpan(ctx, "child")
+	child.SetTag("runtime", "runc")
+	child.Finish(errors.New("failed"))
+	root.Finish(nil)
+
+	if len(r.spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(r.spans))
+	}
+	if root.Context.TraceID != remote.TraceID || root.Parent != remote.SpanID {
+		t.Fatalf("expected the root span to join the remote trace, got %+v", root.Context)
+	}
+	if child.Context.TraceID != remote.TraceID || child.Parent != root.Context.SpanID {
+		t.Fatalf("expected the child span to be in the trace of its parent, got %+v", child.Context)
+	}
+	if child.Context.SpanID == root.Context.SpanID {
+		t.Fatal("expected spans to have distinct ids")
+	}
+	if child.Err == nil || child.Tags["runtime"] != "runc" {
+		t.Fatalf("unexpected child span %+v", child)
+	}
+
+	// traces started locally are only recorded when sampling everything
+	s, _ := StartSpan(context.Background(), "unsampled")
+	s.Finish(nil)
+	SetSampleAll(true)
+	defer SetSampleAll(false)
+	s, _ = StartSpan(context.Background(), "sampled")
+	s.Finish(nil)
+	if len(r.spans) != 3 || r.spans[2].Name != "sampled" {
+		t.Fatalf("expected only the sampled trace to be recorded, got %d spans", len(r.spans))
+	}
+}
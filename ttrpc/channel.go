@@ -0,0 +1,114 @@
+package ttrpc
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	messageHeaderLength = 10
+	messageLengthMax    = 4 << 20
+)
+
+type messageType uint8
+
+const (
+	messageTypeRequest  messageType = 0x1
+	messageTypeResponse messageType = 0x2
+)
+
+var ErrMessageTooLarge = errors.New("ttrpc: message too large")
+
+// messageHeader precedes every message on the wire. It carries the length of
+// the payload, the stream the message belongs to and its type.
+type messageHeader struct {
+	Length   uint32
+	StreamID uint32
+	Type     messageType
+	Flags    uint8
+}
+
+func readMessageHeader(p []byte, r io.Reader) (messageHeader, error) {
+	if _, err := io.ReadFull(r, p[:messageHeaderLength]); err != nil {
+		return messageHeader{}, err
+	}
+	return messageHeader{
+		Length:   binary.BigEndian.Uint32(p[:4]),
+		StreamID: binary.BigEndian.Uint32(p[4:8]),
+		Type:     messageType(p[8]),
+		Flags:    p[9],
+	}, nil
+}
+
+func writeMessageHeader(w io.Writer, p []byte, mh messageHeader) error {
+	binary.BigEndian.PutUint32(p[:4], mh.Length)
+	binary.BigEndian.PutUint32(p[4:8], mh.StreamID)
+	p[8] = byte(mh.Type)
+	p[9] = mh.Flags
+	_, err := w.Write(p[:messageHeaderLength])
+	return err
+}
+
+// channel frames messages over a connection. Receives must be made from a
+// single goroutine, sends are serialized by the channel.
+type channel struct {
+	br    *bufio.Reader
+	hrbuf [messageHeaderLength]byte
+
+	wmu   sync.Mutex
+	bw    *bufio.Writer
+	hwbuf [messageHeaderLength]byte
+}
+
+func newChannel(conn net.Conn) *channel {
+	return &channel{
+		br: bufio.NewReader(conn),
+		bw: bufio.NewWriter(conn),
+	}
+}
+
+// recv reads the next message from the channel. A message exceeding the
+// maximum length is discarded and ErrMessageTooLarge returned, leaving the
+// channel usable.
+func (ch *channel) recv() (messageHeader, []byte, error) {
+	mh, err := readMessageHeader(ch.hrbuf[:], ch.br)
+	if err != nil {
+		return messageHeader{}, nil, err
+	}
+	if mh.Length > messageLengthMax {
+		if _, err := ch.br.Discard(int(mh.Length)); err != nil {
+			return mh, nil, err
+		}
+		return mh, nil, ErrMessageTooLarge
+	}
+	p := make([]byte, mh.Length)
+	if _, err := io.ReadFull(ch.br, p); err != nil {
+		return messageHeader{}, nil, err
+	}
+	return mh, p, nil
+}
+
+func (ch *channel) send(streamID uint32, t messageType, p []byte) error {
+	if len(p) > messageLengthMax {
+		return ErrMessageTooLarge
+	}
+	ch.wmu.Lock()
+	defer ch.wmu.Unlock()
+
+	if err := writeMessageHeader(ch.bw, ch.hwbuf[:], messageHeader{
+		Length:   uint32(len(p)),
+		StreamID: streamID,
+		Type:     t,
+	}); err != nil {
+		return err
+	}
+	if _, err := ch.bw.Write(p); err != nil {
+		return err
+	}
+	return ch.bw.Flush()
+}
@@ -0,0 +1,151 @@
+package ttrpc
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+var ErrClosed = errors.New("ttrpc: closed")
+
+// Client issues calls over a single connection. Calls may be made
+// concurrently, responses are matched to calls by stream id.
+type Client struct {
+	conn net.Conn
+	ch   *channel
+
+	mu       sync.Mutex
+	streamID uint32
+	calls    map[uint32]chan *Response
+	err      error
+	closed   chan struct{}
+}
+
+// NewClient returns a client using conn. The client owns the connection and
+// closes it when the client is closed.
+func NewClient(conn net.Conn) *Client {
+	c := &Client{
+		conn:     conn,
+		ch:       newChannel(conn),
+		streamID: 1,
+		calls:    make(map[uint32]chan *Response),
+		closed:   make(chan struct{}),
+	}
+	go c.run()
+	return c
+}
+
+// Call invokes method on service, decoding the result into resp.
+func (c *Client) Call(ctx context.Context, service, method string, req, resp interface{}) error {
+	payload, err := marshal(req)
+	if err != nil {
+		return err
+	}
+	p, err := marshal(&Request{
+		Service: service,
+		Method:  method,
+		Payload: payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	respC := make(chan *Response, 1)
+	c.mu.Lock()
+	if c.err != nil {
+		err := c.err
+		c.mu.Unlock()
+		return err
+	}
+	streamID := c.streamID
+	// client initiated streams are odd numbered
+	c.streamID += 2
+	c.calls[streamID] = respC
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.calls, streamID)
+		c.mu.Unlock()
+	}()
+
+	if err := c.ch.send(streamID, messageTypeRequest, p); err != nil {
+		return err
+	}
+
+	select {
+	case r := <-respC:
+		if r.Code != uint32(codes.OK) {
+			return &Error{Code: codes.Code(r.Code), Message: r.Message}
+		}
+		return unmarshal(r.Payload, resp)
+	case <-c.closed:
+		c.mu.Lock()
+		err := c.err
+		c.mu.Unlock()
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close closes the connection, failing any calls in progress.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	if c.err == nil {
+		c.err = ErrClosed
+	}
+	c.mu.Unlock()
+	return c.conn.Close()
+}
+
+// Done is closed once the connection of the client is closed, by the client
+// or the server.
+func (c *Client) Done() <-chan struct{} {
+	return c.closed
+}
+
+func (c *Client) run() {
+	defer close(c.closed)
+	for {
+		mh, p, err := c.ch.recv()
+		if err != nil {
+			if err == ErrMessageTooLarge {
+				c.deliver(mh.StreamID, &Response{
+					Code:    uint32(codes.ResourceExhausted),
+					Message: "response too large",
+				})
+				continue
+			}
+			c.mu.Lock()
+			if c.err == nil {
+				c.err = errors.Wrap(err, "ttrpc: connection failed")
+			}
+			c.mu.Unlock()
+			return
+		}
+		if mh.Type != messageTypeResponse {
+			continue
+		}
+		var resp Response
+		if err := unmarshal(p, &resp); err != nil {
+			resp = Response{
+				Code:    uint32(codes.Internal),
+				Message: err.Error(),
+			}
+		}
+		c.deliver(mh.StreamID, &resp)
+	}
+}
+
+func (c *Client) deliver(streamID uint32, resp *Response) {
+	c.mu.Lock()
+	respC, ok := c.calls[streamID]
+	c.mu.Unlock()
+	if ok {
+		respC <- resp
+	}
+}
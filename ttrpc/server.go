@@ -0,0 +1,259 @@
+package ttrpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/docker/containerd/log"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+var ErrServerClosed = errors.New("ttrpc: server closed")
+
+// Method handles a single call. The request payload is decoded into the
+// method's request type by calling unmarshal.
+type Method func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error)
+
+// Server serves registered services over stream connections, typically unix
+// sockets. Each connection is handled by a single reader with requests
+// dispatched concurrently, keeping the cost of an idle connection to one
+// goroutine and its buffers.
+type Server struct {
+	mu         sync.Mutex
+	services   map[string]map[string]Method
+	listeners  map[net.Listener]struct{}
+	conns      map[net.Conn]struct{}
+	active     int
+	closed     bool
+	handshaker Handshaker
+}
+
+// Handshaker checks a connection before any of its requests are served. The
+// connection is closed when it returns an error.
+type Handshaker func(conn net.Conn) error
+
+// ServerOpt configures a Server.
+type ServerOpt func(*Server)
+
+// WithServerHandshaker sets the handshaker checking the connections of the
+// server.
+func WithServerHandshaker(h Handshaker) ServerOpt {
+	return func(s *Server) {
+		s.handshaker = h
+	}
+}
+
+func NewServer(opts ...ServerOpt) *Server {
+	s := &Server{
+		services:  make(map[string]map[string]Method),
+		listeners: make(map[net.Listener]struct{}),
+		conns:     make(map[net.Conn]struct{}),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Register adds the methods of the named service to the server. It must be
+// called before Serve.
+func (s *Server) Register(name string, methods map[string]Method) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.services[name]; ok {
+		panic(errors.Errorf("ttrpc: service %v already registered", name))
+	}
+	s.services[name] = methods
+}
+
+// Serve accepts connections on l until the listener fails or the server is
+// closed.
+func (s *Server) Serve(l net.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		l.Close()
+		return ErrServerClosed
+	}
+	s.listeners[l] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.listeners, l)
+		s.mu.Unlock()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if closed {
+				return ErrServerClosed
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			return err
+		}
+		if !s.addConn(conn) {
+			conn.Close()
+			return ErrServerClosed
+		}
+		go s.handleConn(conn)
+	}
+}
+
+// Close stops all listeners and closes active connections.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	for l := range s.listeners {
+		l.Close()
+	}
+	for c := range s.conns {
+		c.Close()
+	}
+	return nil
+}
+
+// Shutdown stops accepting connections and waits for requests in progress to
+// be answered, or ctx to be done, before closing all connections.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.closed = true
+	for l := range s.listeners {
+		l.Close()
+	}
+	s.mu.Unlock()
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		s.mu.Lock()
+		active := s.active
+		s.mu.Unlock()
+		if active == 0 {
+			break
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			s.Close()
+			return ctx.Err()
+		}
+	}
+	return s.Close()
+}
+
+func (s *Server) addConn(conn net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *Server) removeConn(conn net.Conn) {
+	s.mu.Lock()
+	delete(s.conns, conn)
+	s.mu.Unlock()
+}
+
+func (s *Server) handleConn(conn net.Conn) {
+	defer s.removeConn(conn)
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if s.handshaker != nil {
+		if err := s.handshaker(conn); err != nil {
+			log.G(ctx).WithError(err).Warn("ttrpc: connection refused")
+			return
+		}
+	}
+	ch := newChannel(conn)
+	for {
+		mh, p, err := ch.recv()
+		if err != nil {
+			if err == ErrMessageTooLarge {
+				s.respond(ctx, ch, mh.StreamID, nil, Errorf(codes.ResourceExhausted, "message too large"))
+				continue
+			}
+			return
+		}
+		if mh.Type != messageTypeRequest {
+			// only requests flow from the client
+			continue
+		}
+		s.mu.Lock()
+		s.active++
+		s.mu.Unlock()
+		go func(streamID uint32, p []byte) {
+			defer func() {
+				s.mu.Lock()
+				s.active--
+				s.mu.Unlock()
+			}()
+			var req Request
+			if err := unmarshal(p, &req); err != nil {
+				s.respond(ctx, ch, streamID, nil, Errorf(codes.InvalidArgument, "invalid request: %v", err))
+				return
+			}
+			resp, err := s.dispatch(ctx, &req)
+			s.respond(ctx, ch, streamID, resp, err)
+		}(mh.StreamID, p)
+	}
+}
+
+func (s *Server) dispatch(ctx context.Context, req *Request) (interface{}, error) {
+	s.mu.Lock()
+	methods, ok := s.services[req.Service]
+	s.mu.Unlock()
+	if !ok {
+		return nil, Errorf(codes.Unimplemented, "service %v", req.Service)
+	}
+	method, ok := methods[req.Method]
+	if !ok {
+		return nil, Errorf(codes.Unimplemented, "method %v.%v", req.Service, req.Method)
+	}
+	return method(ctx, func(v interface{}) error {
+		return unmarshal(req.Payload, v)
+	})
+}
+
+func (s *Server) respond(ctx context.Context, ch *channel, streamID uint32, v interface{}, err error) {
+	var resp Response
+	if err != nil {
+		if e, ok := err.(*Error); ok {
+			resp.Code, resp.Message = uint32(e.Code), e.Message
+		} else {
+			resp.Code, resp.Message = uint32(codes.Unknown), err.Error()
+		}
+	} else {
+		p, err := marshal(v)
+		if err != nil {
+			resp.Code = uint32(codes.Internal)
+			resp.Message = err.Error()
+		}
+		resp.Payload = p
+	}
+	p, err := marshal(&resp)
+	if err == nil {
+		err = ch.send(streamID, messageTypeResponse, p)
+	}
+	if err != nil {
+		log.G(ctx).WithError(err).Error("ttrpc: failed to send response")
+	}
+}
@@ -0,0 +1,167 @@
+package ttrpc
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+const testService = "containerd.v1.test.Echo"
+
+func serverEnv(t *testing.T, opts ...ServerOpt) (*Client, func()) {
+	tmpdir, err := ioutil.TempDir("", "ttrpc-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	socket := filepath.Join(tmpdir, "ttrpc.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		os.RemoveAll(tmpdir)
+		t.Fatal(err)
+	}
+
+	server := NewServer(opts...)
+	server.Register(testService, map[string]Method{
+		"Echo": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req Request
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			if req.Method == "fail" {
+				return nil, Errorf(codes.FailedPrecondition, "asked to fail")
+			}
+			return &Response{Message: strings.ToUpper(req.Method)}, nil
+		},
+	})
+	go server.Serve(l)
+
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		server.Close()
+		os.RemoveAll(tmpdir)
+		t.Fatal(err)
+	}
+	client := NewClient(conn)
+	return client, func() {
+		client.Close()
+		server.Close()
+		os.RemoveAll(tmpdir)
+	}
+}
+
+func TestCall(t *testing.T) {
+	client, cleanup := serverEnv(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			msg := strings.Repeat("x", i)
+			var resp Response
+			if err := client.Call(ctx, testService, "Echo", &Request{Method: msg}, &resp); err != nil {
+				t.Error(err)
+				return
+			}
+			if resp.Message != strings.ToUpper(msg) {
+				t.Errorf("unexpected response %q for %q", resp.Message, msg)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestCallErrors(t *testing.T) {
+	client, cleanup := serverEnv(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	var resp Response
+	err := client.Call(ctx, testService, "Echo", &Request{Method: "fail"}, &resp)
+	if Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected failed precondition, got %v", err)
+	}
+	err = client.Call(ctx, testService, "Missing", &Request{}, &resp)
+	if Code(err) != codes.Unimplemented {
+		t.Fatalf("expected unimplemented, got %v", err)
+	}
+	err = client.Call(ctx, testService, "Echo", &Request{Payload: make([]byte, messageLengthMax)}, &resp)
+	if err != ErrMessageTooLarge {
+		t.Fatalf("expected oversized request to fail, got %v", err)
+	}
+
+	client.Close()
+	if err := client.Call(ctx, testService, "Echo", &Request{}, &resp); err != ErrClosed {
+		t.Fatalf("expected call on closed client to fail, got %v", err)
+	}
+}
+
+func TestHandshakerRefuses(t *testing.T) {
+	client, cleanup := serverEnv(t, WithServerHandshaker(func(conn net.Conn) error {
+		return errors.New("refused")
+	}))
+	defer cleanup()
+
+	var resp Response
+	if err := client.Call(context.Background(), testService, "Echo", &Request{Method: "hello"}, &resp); err == nil {
+		t.Fatal("expected the call of a refused connection to fail")
+	}
+}
+
+func TestShutdownWaitsForRequests(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "ttrpc-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+	l, err := net.Listen("unix", filepath.Join(tmpdir, "ttrpc.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started, release := make(chan struct{}), make(chan struct{})
+	server := NewServer()
+	server.Register(testService, map[string]Method{
+		"Block": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			close(started)
+			<-release
+			return &Response{Message: "done"}, nil
+		},
+	})
+	go server.Serve(l)
+
+	conn, err := net.Dial("unix", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient(conn)
+	defer client.Close()
+
+	errC := make(chan error, 1)
+	go func() {
+		var resp Response
+		errC <- client.Call(context.Background(), testService, "Block", &Request{}, &resp)
+	}()
+	<-started
+	shutdownC := make(chan error, 1)
+	go func() {
+		shutdownC <- server.Shutdown(context.Background())
+	}()
+	close(release)
+	if err := <-errC; err != nil {
+		t.Fatalf("expected in flight call to complete, got %v", err)
+	}
+	if err := <-shutdownC; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,74 @@
+package ttrpc
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+)
+
+// Request is sent by the client to invoke method on service.
+type Request struct {
+	Service string `protobuf:"bytes,1,opt,name=service,proto3"`
+	Method  string `protobuf:"bytes,2,opt,name=method,proto3"`
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3"`
+}
+
+func (r *Request) Reset()         { *r = Request{} }
+func (r *Request) String() string { return fmt.Sprintf("%+#v", r) }
+func (r *Request) ProtoMessage()  {}
+
+// Response carries the result of a request. A non-zero Code indicates the
+// request failed and Payload is empty.
+type Response struct {
+	Code    uint32 `protobuf:"varint,1,opt,name=code,proto3"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3"`
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3"`
+}
+
+func (r *Response) Reset()         { *r = Response{} }
+func (r *Response) String() string { return fmt.Sprintf("%+#v", r) }
+func (r *Response) ProtoMessage()  {}
+
+// Error is returned by calls that fail on the server.
+type Error struct {
+	Code    codes.Code
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("ttrpc: code = %s desc = %s", e.Code, e.Message)
+}
+
+// Errorf returns an error carrying code back to the client.
+func Errorf(code codes.Code, format string, args ...interface{}) error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Code returns the code of err, or codes.Unknown if err did not come from a
+// remote call.
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if e, ok := err.(*Error); ok {
+		return e.Code
+	}
+	return codes.Unknown
+}
+
+func marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("ttrpc: %T is not a proto message", v)
+	}
+	return proto.Marshal(m)
+}
+
+func unmarshal(p []byte, v interface{}) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("ttrpc: %T is not a proto message", v)
+	}
+	return proto.Unmarshal(p, m)
+}
@@ -0,0 +1,30 @@
+package ttrpc
+
+import (
+	"net"
+	"os"
+
+	"github.com/docker/containerd/sys"
+	"github.com/pkg/errors"
+)
+
+// UnixSocketRequireSameUser returns a handshaker refusing the connections of
+// unix sockets from processes not running as the user of the server. Abstract
+// sockets have no permissions of their own to keep other users out.
+func UnixSocketRequireSameUser() Handshaker {
+	uid := os.Getuid()
+	return func(conn net.Conn) error {
+		uc, ok := conn.(*net.UnixConn)
+		if !ok {
+			return errors.Errorf("ttrpc: unexpected connection type %T", conn)
+		}
+		cred, err := sys.GetPeerCredentials(uc)
+		if err != nil {
+			return errors.Wrap(err, "ttrpc: failed to get the peer credentials")
+		}
+		if int(cred.Uid) != uid {
+			return errors.Errorf("ttrpc: peer uid %d is not %d", cred.Uid, uid)
+		}
+		return nil
+	}
+}
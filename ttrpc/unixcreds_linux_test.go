@@ -0,0 +1,19 @@
+package ttrpc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUnixSocketRequireSameUser(t *testing.T) {
+	client, cleanup := serverEnv(t, WithServerHandshaker(UnixSocketRequireSameUser()))
+	defer cleanup()
+
+	var resp Response
+	if err := client.Call(context.Background(), testService, "Echo", &Request{Method: "hello"}, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Message != "HELLO" {
+		t.Fatalf("unexpected response %q", resp.Message)
+	}
+}